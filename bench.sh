@@ -5,7 +5,7 @@
 ############################
 
 SRC="./src"
-DST=$(echo "`pwd`/doc/bench.txt")
+DST=$(echo "`pwd`/doc/bench_stages.csv")
 
 cd "$SRC" || exit 1
 
@@ -16,7 +16,7 @@ then
 fi
 
 echo "[`date +"%Y-%m-%d %T"`]: Starting benchmarking, this will take some time"
-go test -bench ../. &> "$DST"
+go run . bench -csv -t "$(seq -s, 1 16)" ../resources/vsl_typed > "$DST"
 echo "[`date +"%Y-%m-%d %T"`]: Benchmarking finished!"
 echo "Results were written to $DST"
 
@@ -0,0 +1,3251 @@
+	.arch	armv8-a
+	.file	"aamanyfuncs.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	tester, %function
+tester:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+block1048588:
+	mov	x8, #10
+	str	x8, [fp, #-24]
+	mov	x8, #5
+	str	x8, [fp, #-32]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	mov	x8, x0
+	adrp	x9, _STR_1048592
+	add	x9, x9, :lo12:_STR_1048592
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	mov	x8, x0
+	adrp	x9, _STR_1048592
+	add	x9, x9, :lo12:_STR_1048592
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	tester, .-tester
+
+	.type	add, %function
+add:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048594:
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	add	x0, x8, x9
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	add, .-add
+
+	.type	sub, %function
+sub:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048596:
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	sub	x0, x8, x9
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	sub, .-sub
+
+	.type	mul, %function
+mul:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048597:
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mul	x0, x8, x9
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	mul, .-mul
+
+	.type	div, %function
+div:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048598:
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	sdiv	x0, x8, x9
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	div, .-div
+
+	.type	rshift, %function
+rshift:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048599:
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	lsl	x0, x8, x9
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	rshift, .-rshift
+
+	.type	lshift, %function
+lshift:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048600:
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	asr	x0, x8, x9
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	lshift, .-lshift
+
+	.type	and, %function
+and:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048601:
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	and	x0, x8, x9
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	and, .-and
+
+	.type	or, %function
+or:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048602:
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	orr	x0, x8, x9
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	or, .-or
+
+	.type	xor, %function
+xor:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048603:
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	eor	x0, x8, x9
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	xor, .-xor
+
+	.type	foo, %function
+foo:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+block1048604:
+	mov	x8, #50
+	str	x8, [fp, #-40]
+	mov	x8, #-2
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	adrp	x8, _STR_1048607
+	add	x8, x8, :lo12:_STR_1048607
+	adrp	x8, _STR_1048608
+	add	x8, x8, :lo12:_STR_1048608
+	mov	x0, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	foo, .-foo
+
+	.type	bar, %function
+bar:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+block1048609:
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	sub
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	ldp	x9, x8, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	div
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	add
+	str	x0, [fp, #-40]
+	mov	x8, #0
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x8, x9, [fp, #-32]
+	add	x10, x8, x9
+	ldp	x9, x8, [fp, #-48]
+	msub	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-40]
+	str	x8, [fp, #-48]
+	adrp	x8, _STR_1048610
+	add	x8, x8, :lo12:_STR_1048610
+	adrp	x8, _STR_1048611
+	add	x8, x8, :lo12:_STR_1048611
+	mov	x0, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	bar, .-bar
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048612
+	add	x0, x0, :lo12:_STR_1048612
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	tester
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048592:
+	.asciz	"%lld\n"
+_STR_1048607:
+	.asciz	"foo here"
+_STR_1048608:
+	.asciz	"foo here\n"
+_STR_1048610:
+	.asciz	"bar here"
+_STR_1048611:
+	.asciz	"bar here\n"
+_STR_1048612:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,270 @@
+	.arch	armv8-a
+	.file	"bitops.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	bitwise_operators, %function
+bitwise_operators:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048577:
+	adrp	x8, _STR_1048578
+	add	x8, x8, :lo12:_STR_1048578
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048579
+	add	x9, x9, :lo12:_STR_1048579
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048581
+	add	x10, x10, :lo12:_STR_1048581
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	bl	printf
+	ldr	x8, [fp, #-24]
+	mov	x9, #-1
+	eor	x10, x9, x8
+	str	x10, [fp, #-40]
+	adrp	x8, _STR_1048583
+	add	x8, x8, :lo12:_STR_1048583
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048584
+	add	x9, x9, :lo12:_STR_1048584
+	ldr	x9, [fp, #-40]
+	adrp	x10, _STR_1048585
+	add	x10, x10, :lo12:_STR_1048585
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	orr	x10, x8, x9
+	str	x10, [fp, #-40]
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048586
+	add	x9, x9, :lo12:_STR_1048586
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048584
+	add	x10, x10, :lo12:_STR_1048584
+	ldr	x10, [fp, #-40]
+	adrp	x11, _STR_1048587
+	add	x11, x11, :lo12:_STR_1048587
+	mov	x0, x11
+	mov	x1, x8
+	mov	x2, x9
+	mov	x3, x10
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	eor	x10, x8, x9
+	str	x10, [fp, #-40]
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048588
+	add	x9, x9, :lo12:_STR_1048588
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048584
+	add	x10, x10, :lo12:_STR_1048584
+	ldr	x10, [fp, #-40]
+	adrp	x11, _STR_1048589
+	add	x11, x11, :lo12:_STR_1048589
+	mov	x0, x11
+	mov	x1, x8
+	mov	x2, x9
+	mov	x3, x10
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	and	x10, x8, x9
+	str	x10, [fp, #-40]
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048590
+	add	x9, x9, :lo12:_STR_1048590
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048584
+	add	x10, x10, :lo12:_STR_1048584
+	ldr	x10, [fp, #-40]
+	adrp	x11, _STR_1048591
+	add	x11, x11, :lo12:_STR_1048591
+	mov	x0, x11
+	mov	x1, x8
+	mov	x2, x9
+	mov	x3, x10
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	lsl	x10, x8, x9
+	str	x10, [fp, #-40]
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048592
+	add	x9, x9, :lo12:_STR_1048592
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048584
+	add	x10, x10, :lo12:_STR_1048584
+	ldr	x10, [fp, #-40]
+	adrp	x11, _STR_1048593
+	add	x11, x11, :lo12:_STR_1048593
+	mov	x0, x11
+	mov	x1, x8
+	mov	x2, x9
+	mov	x3, x10
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	asr	x10, x8, x9
+	str	x10, [fp, #-40]
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048594
+	add	x9, x9, :lo12:_STR_1048594
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048584
+	add	x10, x10, :lo12:_STR_1048584
+	ldr	x10, [fp, #-40]
+	adrp	x11, _STR_1048595
+	add	x11, x11, :lo12:_STR_1048595
+	mov	x0, x11
+	mov	x1, x8
+	mov	x2, x9
+	mov	x3, x10
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	bitwise_operators, .-bitwise_operators
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x19, [sp, #0]
+	.cfi_offset	19, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #2
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048597
+	add	x0, x0, :lo12:_STR_1048597
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #8]	// Load argv[1]
+	sub	x1, fp, #48
+	mov	x19, #1
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-48]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #8]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-48]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #16]	// Load argv[2]
+	sub	x1, fp, #56
+	mov	x19, #2
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-56]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #16]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-56]
+_L_call:
+	ldr	x0, [fp, #-48]	// Load parsed argv[1] into register x0
+	ldr	x1, [fp, #-56]	// Load parsed argv[2] into register x1
+	bl	bitwise_operators
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+	adrp	x0, _STR_1048598
+	add	x0, x0, :lo12:_STR_1048598
+	mov	x1, x19
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048578:
+	.asciz	"a is"
+_STR_1048579:
+	.asciz	"and b is"
+_STR_1048581:
+	.asciz	"a is %lld and b is %lld\n"
+_STR_1048583:
+	.asciz	"~"
+_STR_1048584:
+	.asciz	"="
+_STR_1048585:
+	.asciz	"~ %lld = %lld\n"
+_STR_1048586:
+	.asciz	"|"
+_STR_1048587:
+	.asciz	"%lld | %lld = %lld\n"
+_STR_1048588:
+	.asciz	"^"
+_STR_1048589:
+	.asciz	"%lld ^ %lld = %lld\n"
+_STR_1048590:
+	.asciz	"&"
+_STR_1048591:
+	.asciz	"%lld & %lld = %lld\n"
+_STR_1048592:
+	.asciz	"<<"
+_STR_1048593:
+	.asciz	"%lld << %lld = %lld\n"
+_STR_1048594:
+	.asciz	">>"
+_STR_1048595:
+	.asciz	"%lld >> %lld = %lld\n"
+_STR_1048597:
+	.asciz	"Argument error: expected 2 arguments, got %d\n"
+_STR_1048598:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,254 @@
+	.arch	armv8-a
+	.file	"cast.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	casting, %function
+casting:
+	.cfi_startproc
+	sub	sp, sp, #96
+	.cfi_def_cfa_offset	96
+	stp	fp, lr, [sp, #80]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	stp	d8, d9, [sp, #8]
+	str	d10, [sp, #24]
+	.cfi_offset	28, -96
+	.cfi_offset	72, -88
+	.cfi_offset	73, -80
+	.cfi_offset	74, -72
+	add	fp, sp, #96
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	stp	d1, d0, [fp, #-48]
+block1048578:
+	ldr	x8, [fp, #-24]
+	ldr	d8, [fp, #-40]
+	scvtf	d9, x8
+	fadd	d10, d9, d8
+	fcvtns	x8, d10
+	str	x8, [fp, #-56]
+	ldr	x8, [fp, #-32]
+	ldr	d8, [fp, #-48]
+	scvtf	d9, x8
+	fadd	d10, d9, d8
+	str	d10, [fp, #-64]
+	mov	x8, #3
+	str	x8, [fp, #-56]
+	adrp	x8, _STR_1048580
+	add	x8, x8, :lo12:_STR_1048580
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048581
+	add	x9, x9, :lo12:_STR_1048581
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048582
+	add	x10, x10, :lo12:_STR_1048582
+	ldr	d8, [fp, #-40]
+	adrp	x10, _STR_1048583
+	add	x10, x10, :lo12:_STR_1048583
+	ldr	d9, [fp, #-48]
+	adrp	x10, _STR_1048585
+	add	x10, x10, :lo12:_STR_1048585
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	fmov	d0, d8
+	fmov	d1, d9
+	bl	printf
+	ldr	x8, [fp, #-56]
+	adrp	x28, _L_CONST_1048586		//Load constant 0.300000
+	ldr	d8, [x28, :lo12:_L_CONST_1048586]
+	scvtf	d9, x8
+	fmov	d0, d9
+	fmov	d1, d8
+	bl	foo
+	str	d0, [fp, #-64]
+	adrp	x8, _STR_1048587
+	add	x8, x8, :lo12:_STR_1048587
+	ldr	d8, [fp, #-64]
+	adrp	x8, _STR_1048588
+	add	x8, x8, :lo12:_STR_1048588
+	mov	x0, x8
+	fmov	d0, d8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	ldp	d8, d9, [sp, #8]
+	ldr	d10, [sp, #24]
+	.cfi_def_cfa	31, 96
+	ldp	fp, lr, [sp, #80]
+	add	sp, sp, #96
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	casting, .-casting
+
+	.type	foo, %function
+foo:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	stp	d8, d9, [sp, #0]
+	.cfi_offset	72, -48
+	.cfi_offset	73, -40
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	d1, d0, [fp, #-32]
+block1048590:
+	ldp	d9, d8, [fp, #-32]
+	fmul	d0, d8, d9
+	.cfi_remember_state
+	ldp	d8, d9, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	foo, .-foo
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #80
+	.cfi_def_cfa_offset	80
+	stp	fp, lr, [sp, #64]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x19, [sp, #0]
+	.cfi_offset	19, -80
+	add	fp, sp, #80
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #4
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048591
+	add	x0, x0, :lo12:_STR_1048591
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 80
+	ldp	fp, lr, [sp, #64]
+	add	sp, sp, #80
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #8]	// Load argv[1]
+	sub	x1, fp, #48
+	mov	x19, #1
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-48]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #8]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-48]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #16]	// Load argv[2]
+	sub	x1, fp, #56
+	mov	x19, #2
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-56]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #16]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-56]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #24]	// Load argv[3]
+	sub	x1, fp, #64
+	mov	x19, #3
+	bl	strtod
+	ldr	x9, [fp, #-64]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #24]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	d0, [fp, #-64]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #32]	// Load argv[4]
+	sub	x1, fp, #72
+	mov	x19, #4
+	bl	strtod
+	ldr	x9, [fp, #-72]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #32]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	d0, [fp, #-72]
+_L_call:
+	ldr	x0, [fp, #-48]	// Load parsed argv[1] into register x0
+	ldr	x1, [fp, #-56]	// Load parsed argv[2] into register x1
+	ldr	d0, [fp, #-64]	// Load parsed argv[3] into register d0
+	ldr	d1, [fp, #-72]	// Load parsed argv[4] into register d1
+	bl	casting
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 80
+	ldp	fp, lr, [sp, #64]
+	add	sp, sp, #80
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+	adrp	x0, _STR_1048592
+	add	x0, x0, :lo12:_STR_1048592
+	mov	x1, x19
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 80
+	ldp	fp, lr, [sp, #64]
+	add	sp, sp, #80
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_L_CONST_1048586:
+	.xword	0x3fd3333340000000	// 0.300000
+_STR_1048580:
+	.asciz	"a is"
+_STR_1048581:
+	.asciz	"b is"
+_STR_1048582:
+	.asciz	"c is"
+_STR_1048583:
+	.asciz	"d is"
+_STR_1048585:
+	.asciz	"a is %lld b is %lld c is %f d is %f\n"
+_STR_1048587:
+	.asciz	"f is"
+_STR_1048588:
+	.asciz	"f is %f\n"
+_STR_1048591:
+	.asciz	"Argument error: expected 4 arguments, got %d\n"
+_STR_1048592:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,278 @@
+	.arch	armv8-a
+	.file	"easy.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	mainfunc, %function
+mainfunc:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+	str	x0, [fp, #-24]
+block1048578:
+	adrp	x8, _STR_1048579
+	add	x8, x8, :lo12:_STR_1048579
+	adrp	x8, _STR_1048581
+	add	x8, x8, :lo12:_STR_1048581
+	mov	x0, x8
+	bl	printf
+	mov	x8, #15
+	str	x8, [fp, #-32]
+	mov	x8, #5
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-48]
+	mov	x0, x8
+	mov	x1, x9
+	bl	test
+	str	x0, [fp, #-40]
+	adrp	x8, _STR_1048584
+	add	x8, x8, :lo12:_STR_1048584
+	ldr	x8, [fp, #-40]
+	adrp	x9, _STR_1048585
+	add	x9, x9, :lo12:_STR_1048585
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	mainfunc, .-mainfunc
+
+	.type	test, %function
+test:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048587:
+	adrp	x8, _STR_1048588
+	add	x8, x8, :lo12:_STR_1048588
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048589
+	add	x9, x9, :lo12:_STR_1048589
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048590
+	add	x10, x10, :lo12:_STR_1048590
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	add	x10, x8, x9
+	str	x10, [fp, #-40]
+	adrp	x8, _STR_1048591
+	add	x8, x8, :lo12:_STR_1048591
+	ldr	x8, [fp, #-40]
+	adrp	x9, _STR_1048592
+	add	x9, x9, :lo12:_STR_1048592
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	sub	x10, x8, x9
+	str	x10, [fp, #-40]
+	adrp	x8, _STR_1048593
+	add	x8, x8, :lo12:_STR_1048593
+	ldr	x8, [fp, #-40]
+	adrp	x9, _STR_1048594
+	add	x9, x9, :lo12:_STR_1048594
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	mul	x10, x8, x9
+	str	x10, [fp, #-40]
+	adrp	x8, _STR_1048595
+	add	x8, x8, :lo12:_STR_1048595
+	ldr	x8, [fp, #-40]
+	adrp	x9, _STR_1048596
+	add	x9, x9, :lo12:_STR_1048596
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	sdiv	x10, x8, x9
+	str	x10, [fp, #-40]
+	adrp	x8, _STR_1048597
+	add	x8, x8, :lo12:_STR_1048597
+	ldr	x8, [fp, #-40]
+	adrp	x9, _STR_1048598
+	add	x9, x9, :lo12:_STR_1048598
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	adrp	x8, _STR_1048599
+	add	x8, x8, :lo12:_STR_1048599
+	ldr	x8, [fp, #-40]
+	mov	x9, #0
+	sub	x10, x9, x8
+	adrp	x8, _STR_1048600
+	add	x8, x8, :lo12:_STR_1048600
+	mov	x0, x8
+	mov	x1, x10
+	bl	printf
+	adrp	x8, _STR_1048601
+	add	x8, x8, :lo12:_STR_1048601
+	ldr	x8, [fp, #-24]
+	ldr	x9, [fp, #-24]
+	mul	x10, x8, x9
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	madd	x8, x8, x9, x10
+	adrp	x9, _STR_1048602
+	add	x9, x9, :lo12:_STR_1048602
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	sub	x0, x8, x9
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	test, .-test
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x19, [sp, #0]
+	.cfi_offset	19, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #1
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048603
+	add	x0, x0, :lo12:_STR_1048603
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #8]	// Load argv[1]
+	sub	x1, fp, #40
+	mov	x19, #1
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-40]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #8]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-40]
+_L_call:
+	ldr	x0, [fp, #-40]	// Load parsed argv[1] into register x0
+	bl	mainfunc
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+	adrp	x0, _STR_1048604
+	add	x0, x0, :lo12:_STR_1048604
+	mov	x1, x19
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048579:
+	.asciz	"Testing plain call/return and expression evaluation"
+_STR_1048581:
+	.asciz	"Testing plain call/return and expression evaluation\n"
+_STR_1048584:
+	.asciz	"The deftion returned y:="
+_STR_1048585:
+	.asciz	"The deftion returned y:= %lld\n"
+_STR_1048588:
+	.asciz	"My parameters are a:="
+_STR_1048589:
+	.asciz	"and b:="
+_STR_1048590:
+	.asciz	"My parameters are a:= %lld and b:= %lld\n"
+_STR_1048591:
+	.asciz	"Their sum is c:="
+_STR_1048592:
+	.asciz	"Their sum is c:= %lld\n"
+_STR_1048593:
+	.asciz	"Their difference is c:="
+_STR_1048594:
+	.asciz	"Their difference is c:= %lld\n"
+_STR_1048595:
+	.asciz	"Their product is c:="
+_STR_1048596:
+	.asciz	"Their product is c:= %lld\n"
+_STR_1048597:
+	.asciz	"Their ratio is c:="
+_STR_1048598:
+	.asciz	"Their ratio is c:= %lld\n"
+_STR_1048599:
+	.asciz	"(-c):="
+_STR_1048600:
+	.asciz	"(-c):= %lld\n"
+_STR_1048601:
+	.asciz	"The sum of their squares is "
+_STR_1048602:
+	.asciz	"The sum of their squares is  %lld\n"
+_STR_1048603:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048604:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
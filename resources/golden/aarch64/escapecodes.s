@@ -0,0 +1,146 @@
+	.arch	armv8-a
+	.file	"escapecodes.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	escapecodes, %function
+escapecodes:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+block1048577:
+	adrp	x8, _STR_1048578
+	add	x8, x8, :lo12:_STR_1048578
+	adrp	x8, _STR_1048579
+	add	x8, x8, :lo12:_STR_1048579
+	adrp	x8, _STR_1048581
+	add	x8, x8, :lo12:_STR_1048581
+	mov	x0, x8
+	bl	printf
+	mov	x8, #64
+	str	x8, [fp, #-24]
+	mov	x8, #42
+	str	x8, [fp, #-32]
+	adrp	x8, _STR_1048584
+	add	x8, x8, :lo12:_STR_1048584
+	adrp	x8, _STR_1048585
+	add	x8, x8, :lo12:_STR_1048585
+	mov	x0, x8
+	bl	printf
+	adrp	x8, _STR_1048586
+	add	x8, x8, :lo12:_STR_1048586
+	adrp	x8, _STR_1048587
+	add	x8, x8, :lo12:_STR_1048587
+	mov	x0, x8
+	bl	printf
+	adrp	x8, _STR_1048588
+	add	x8, x8, :lo12:_STR_1048588
+	adrp	x8, _STR_1048589
+	add	x8, x8, :lo12:_STR_1048589
+	mov	x0, x8
+	bl	printf
+	adrp	x8, _STR_1048590
+	add	x8, x8, :lo12:_STR_1048590
+	adrp	x8, _STR_1048591
+	add	x8, x8, :lo12:_STR_1048591
+	mov	x0, x8
+	bl	printf
+	adrp	x8, _STR_1048592
+	add	x8, x8, :lo12:_STR_1048592
+	adrp	x8, _STR_1048593
+	add	x8, x8, :lo12:_STR_1048593
+	adrp	x8, _STR_1048594
+	add	x8, x8, :lo12:_STR_1048594
+	mov	x0, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	escapecodes, .-escapecodes
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048596
+	add	x0, x0, :lo12:_STR_1048596
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	escapecodes
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048578:
+	.asciz	"Testing if printf format codes are left alone."
+_STR_1048579:
+	.asciz	"\\nOutput *should* contain percent characters, but no integers."
+_STR_1048581:
+	.asciz	"Testing if printf format codes are left alone. \\nOutput *should* contain percent characters, but no integers.\n"
+_STR_1048584:
+	.asciz	"\\tHello, world! %d %d"
+_STR_1048585:
+	.asciz	"\\tHello, world! %%d %%d\n"
+_STR_1048586:
+	.asciz	"Adding a splash of ANSI color codes - This will only work in a color terminal"
+_STR_1048587:
+	.asciz	"Adding a splash of ANSI color codes - This will only work in a color terminal\n"
+_STR_1048588:
+	.asciz	"\\t\\033[31mRed"
+_STR_1048589:
+	.asciz	"\\t\\033[31mRed\n"
+_STR_1048590:
+	.asciz	"\\t\\033[32mGreen"
+_STR_1048591:
+	.asciz	"\\t\\033[32mGreen\n"
+_STR_1048592:
+	.asciz	"\\t\\033[34mBlue"
+_STR_1048593:
+	.asciz	"\\033[0m"
+_STR_1048594:
+	.asciz	"\\t\\033[34mBlue \\033[0m\n"
+_STR_1048596:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,249 @@
+	.arch	armv8-a
+	.file	"euclid.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	euclid, %function
+euclid:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048578:
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #0
+	mov	x8, #0
+	cmp	x9, x8
+	b.ge	block1048581
+block1048579:
+	ldr	x8, [fp, #-24]
+	mov	x9, #0
+	sub	x10, x9, x8
+	str	x10, [fp, #-24]
+block1048581:
+	ldr	x8, [fp, #-32]
+	sub	x9, x8, #0
+	mov	x8, #0
+	cmp	x9, x8
+	b.ge	block1048583
+block1048582:
+	ldr	x8, [fp, #-32]
+	mov	x9, #0
+	sub	x10, x9, x8
+	str	x10, [fp, #-32]
+block1048583:
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	gcd
+	sub	x8, x0, #1
+	mov	x9, #0
+	cmp	x8, x9
+	b.le	block1048586
+block1048584:
+	adrp	x8, _STR_1048587
+	add	x8, x8, :lo12:_STR_1048587
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+	adrp	x8, _STR_1048588
+	add	x8, x8, :lo12:_STR_1048588
+	ldr	x8, [fp, #-32]
+	str	x8, [fp, #-48]
+	adrp	x8, _STR_1048589
+	add	x8, x8, :lo12:_STR_1048589
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	gcd
+	mov	x8, x0
+	adrp	x9, _STR_1048591
+	add	x9, x9, :lo12:_STR_1048591
+	ldp	x11, x10, [fp, #-48]
+	mov	x0, x9
+	mov	x1, x10
+	mov	x2, x11
+	mov	x3, x8
+	bl	printf
+	b	block1048592
+block1048586:
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048588
+	add	x9, x9, :lo12:_STR_1048588
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048593
+	add	x10, x10, :lo12:_STR_1048593
+	adrp	x10, _STR_1048594
+	add	x10, x10, :lo12:_STR_1048594
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	bl	printf
+block1048592:
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	euclid, .-euclid
+
+	.type	gcd, %function
+gcd:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048595:
+	ldr	x8, [fp, #-32]
+	sub	x9, x8, #0
+	mov	x8, #0
+	cmp	x9, x8
+	b.le	block1048597
+block1048596:
+	ldp	x8, x9, [fp, #-32]
+	ldp	x11, x10, [fp, #-32]
+	sdiv	x12, x10, x11
+	ldr	x10, [fp, #-32]
+	msub	x10, x12, x10, x9
+	mov	x0, x8
+	mov	x1, x10
+	bl	gcd
+	str	x0, [fp, #-40]
+	b	block1048598
+block1048597:
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-40]
+block1048598:
+	ldr	x0, [fp, #-40]
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	gcd, .-gcd
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x19, [sp, #0]
+	.cfi_offset	19, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #2
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048599
+	add	x0, x0, :lo12:_STR_1048599
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #8]	// Load argv[1]
+	sub	x1, fp, #48
+	mov	x19, #1
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-48]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #8]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-48]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #16]	// Load argv[2]
+	sub	x1, fp, #56
+	mov	x19, #2
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-56]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #16]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-56]
+_L_call:
+	ldr	x0, [fp, #-48]	// Load parsed argv[1] into register x0
+	ldr	x1, [fp, #-56]	// Load parsed argv[2] into register x1
+	bl	euclid
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+	adrp	x0, _STR_1048600
+	add	x0, x0, :lo12:_STR_1048600
+	mov	x1, x19
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048587:
+	.asciz	"Greatest common divisor of"
+_STR_1048588:
+	.asciz	"and"
+_STR_1048589:
+	.asciz	"is"
+_STR_1048591:
+	.asciz	"Greatest common divisor of %lld and %lld is %lld\n"
+_STR_1048593:
+	.asciz	"are relative primes"
+_STR_1048594:
+	.asciz	"%lld and %lld are relative primes\n"
+_STR_1048599:
+	.asciz	"Argument error: expected 2 arguments, got %d\n"
+_STR_1048600:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,177 @@
+	.arch	armv8-a
+	.file	"fibonacci_iterative.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	fibonacci_iterative, %function
+fibonacci_iterative:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+	str	x0, [fp, #-24]
+block1048577:
+	ldr	x8, [fp, #-24]
+	str	x8, [fp, #-32]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	mov	x8, #1
+	str	x8, [fp, #-48]
+	mov	x8, #1
+	str	x8, [fp, #-56]
+	ldr	x8, [fp, #-32]
+	sub	x9, x8, #0
+	mov	x8, #0
+	cmp	x9, x8
+	b.le	block1048581
+block1048579:
+	ldr	x8, [fp, #-32]
+	sub	x9, x8, #1
+	mov	x8, #0
+	cmp	x9, x8
+	b.le	block1048591
+	b	block1048582
+block1048581:
+	mov	x8, #0
+	str	x8, [fp, #-56]
+	b	block1048591
+block1048582:
+	ldr	x8, [fp, #-32]
+	sub	x9, x8, #2
+	mov	x8, #0
+	cmp	x9, x8
+	b.le	block1048591
+block1048587:
+	ldr	x8, [fp, #-32]
+	sub	x9, x8, #3
+	mov	x8, #0
+	cmp	x9, x8
+	b.le	block1048591
+block1048588:
+	ldp	x8, x9, [fp, #-48]
+	add	x10, x8, x9
+	str	x10, [fp, #-56]
+	ldr	x8, [fp, #-48]
+	str	x8, [fp, #-40]
+	ldr	x8, [fp, #-56]
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-32]
+	sub	x9, x8, #1
+	str	x9, [fp, #-32]
+	b	block1048587
+block1048591:
+	adrp	x8, _STR_1048592
+	add	x8, x8, :lo12:_STR_1048592
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048593
+	add	x9, x9, :lo12:_STR_1048593
+	ldr	x9, [fp, #-56]
+	adrp	x10, _STR_1048595
+	add	x10, x10, :lo12:_STR_1048595
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	fibonacci_iterative, .-fibonacci_iterative
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x19, [sp, #0]
+	.cfi_offset	19, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #1
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048596
+	add	x0, x0, :lo12:_STR_1048596
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #8]	// Load argv[1]
+	sub	x1, fp, #40
+	mov	x19, #1
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-40]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #8]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-40]
+_L_call:
+	ldr	x0, [fp, #-40]	// Load parsed argv[1] into register x0
+	bl	fibonacci_iterative
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+	adrp	x0, _STR_1048597
+	add	x0, x0, :lo12:_STR_1048597
+	mov	x1, x19
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048592:
+	.asciz	"Fibonacci number #"
+_STR_1048593:
+	.asciz	"is"
+_STR_1048595:
+	.asciz	"Fibonacci number # %lld is %lld\n"
+_STR_1048596:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048597:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
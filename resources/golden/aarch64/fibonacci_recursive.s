@@ -0,0 +1,180 @@
+	.arch	armv8-a
+	.file	"fibonacci_recursive.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	fibonacci_recursive, %function
+fibonacci_recursive:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	str	x0, [fp, #-24]
+block1048578:
+	ldr	x8, [fp, #-24]
+	mov	x0, x8
+	bl	fibonacci_number
+	str	x0, [fp, #-32]
+	adrp	x8, _STR_1048579
+	add	x8, x8, :lo12:_STR_1048579
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048580
+	add	x9, x9, :lo12:_STR_1048580
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048582
+	add	x10, x10, :lo12:_STR_1048582
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	fibonacci_recursive, .-fibonacci_recursive
+
+	.type	fibonacci_number, %function
+fibonacci_number:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	str	x0, [fp, #-24]
+block1048584:
+	mov	x8, #0
+	str	x8, [fp, #-32]
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #2
+	mov	x8, #0
+	cmp	x9, x8
+	b.le	block1048587
+block1048585:
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #1
+	mov	x0, x9
+	bl	fibonacci_number
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #2
+	mov	x0, x9
+	bl	fibonacci_number
+	ldr	x8, [fp, #-40]
+	add	x9, x8, x0
+	str	x9, [fp, #-32]
+	b	block1048589
+block1048587:
+	mov	x8, #1
+	str	x8, [fp, #-32]
+block1048589:
+	ldr	x0, [fp, #-32]
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	fibonacci_number, .-fibonacci_number
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x19, [sp, #0]
+	.cfi_offset	19, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #1
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048590
+	add	x0, x0, :lo12:_STR_1048590
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #8]	// Load argv[1]
+	sub	x1, fp, #40
+	mov	x19, #1
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-40]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #8]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-40]
+_L_call:
+	ldr	x0, [fp, #-40]	// Load parsed argv[1] into register x0
+	bl	fibonacci_recursive
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+	adrp	x0, _STR_1048591
+	add	x0, x0, :lo12:_STR_1048591
+	mov	x1, x19
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048579:
+	.asciz	"Fibonacci number #"
+_STR_1048580:
+	.asciz	"is"
+_STR_1048582:
+	.asciz	"Fibonacci number # %lld is %lld\n"
+_STR_1048590:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048591:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
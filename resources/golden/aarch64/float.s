@@ -0,0 +1,244 @@
+	.arch	armv8-a
+	.file	"float.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	float_test, %function
+float_test:
+	.cfi_startproc
+	sub	sp, sp, #80
+	.cfi_def_cfa_offset	80
+	stp	fp, lr, [sp, #64]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	stp	d8, d9, [sp, #8]
+	str	d10, [sp, #24]
+	.cfi_offset	28, -80
+	.cfi_offset	72, -72
+	.cfi_offset	73, -64
+	.cfi_offset	74, -56
+	add	fp, sp, #80
+	.cfi_def_cfa	29, 0
+	stp	d1, d0, [fp, #-32]
+block1048577:
+	ldp	d9, d8, [fp, #-32]
+	fadd	d10, d8, d9
+	str	d10, [fp, #-40]
+	ldr	d8, [fp, #-24]
+	adrp	x8, _STR_1048578
+	add	x8, x8, :lo12:_STR_1048578
+	ldr	d9, [fp, #-32]
+	adrp	x8, _STR_1048579
+	add	x8, x8, :lo12:_STR_1048579
+	ldr	d10, [fp, #-40]
+	adrp	x8, _STR_1048581
+	add	x8, x8, :lo12:_STR_1048581
+	mov	x0, x8
+	fmov	d0, d8
+	fmov	d1, d9
+	fmov	d2, d10
+	bl	printf
+	ldp	d9, d8, [fp, #-32]
+	fsub	d10, d8, d9
+	str	d10, [fp, #-40]
+	ldr	d8, [fp, #-24]
+	adrp	x8, _STR_1048582
+	add	x8, x8, :lo12:_STR_1048582
+	ldr	d9, [fp, #-32]
+	adrp	x8, _STR_1048579
+	add	x8, x8, :lo12:_STR_1048579
+	ldr	d10, [fp, #-40]
+	adrp	x8, _STR_1048583
+	add	x8, x8, :lo12:_STR_1048583
+	mov	x0, x8
+	fmov	d0, d8
+	fmov	d1, d9
+	fmov	d2, d10
+	bl	printf
+	ldp	d9, d8, [fp, #-32]
+	fmul	d10, d8, d9
+	str	d10, [fp, #-40]
+	ldr	d8, [fp, #-24]
+	adrp	x8, _STR_1048584
+	add	x8, x8, :lo12:_STR_1048584
+	ldr	d9, [fp, #-32]
+	adrp	x8, _STR_1048579
+	add	x8, x8, :lo12:_STR_1048579
+	ldr	d10, [fp, #-40]
+	adrp	x8, _STR_1048585
+	add	x8, x8, :lo12:_STR_1048585
+	mov	x0, x8
+	fmov	d0, d8
+	fmov	d1, d9
+	fmov	d2, d10
+	bl	printf
+	ldp	d9, d8, [fp, #-32]
+	fdiv	d10, d8, d9
+	str	d10, [fp, #-40]
+	ldr	d8, [fp, #-24]
+	adrp	x8, _STR_1048586
+	add	x8, x8, :lo12:_STR_1048586
+	ldr	d9, [fp, #-32]
+	adrp	x8, _STR_1048579
+	add	x8, x8, :lo12:_STR_1048579
+	ldr	d10, [fp, #-40]
+	adrp	x8, _STR_1048587
+	add	x8, x8, :lo12:_STR_1048587
+	mov	x0, x8
+	fmov	d0, d8
+	fmov	d1, d9
+	fmov	d2, d10
+	bl	printf
+	adrp	x28, _L_CONST_1048588		//Load constant 2.450000
+	ldr	d8, [x28, :lo12:_L_CONST_1048588]
+	str	d8, [fp, #-40]
+	adrp	x8, _STR_1048589
+	add	x8, x8, :lo12:_STR_1048589
+	ldr	d8, [fp, #-40]
+	adrp	x8, _STR_1048590
+	add	x8, x8, :lo12:_STR_1048590
+	mov	x0, x8
+	fmov	d0, d8
+	bl	printf
+	mov	x8, #1
+	scvtf	d8, x8
+	str	d8, [fp, #-40]
+	adrp	x8, _STR_1048589
+	add	x8, x8, :lo12:_STR_1048589
+	ldr	d8, [fp, #-40]
+	adrp	x8, _STR_1048590
+	add	x8, x8, :lo12:_STR_1048590
+	mov	x0, x8
+	fmov	d0, d8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	ldp	d8, d9, [sp, #8]
+	ldr	d10, [sp, #24]
+	.cfi_def_cfa	31, 80
+	ldp	fp, lr, [sp, #64]
+	add	sp, sp, #80
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	float_test, .-float_test
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x19, [sp, #0]
+	.cfi_offset	19, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #2
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048593
+	add	x0, x0, :lo12:_STR_1048593
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #8]	// Load argv[1]
+	sub	x1, fp, #48
+	mov	x19, #1
+	bl	strtod
+	ldr	x9, [fp, #-48]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #8]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	d0, [fp, #-48]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #16]	// Load argv[2]
+	sub	x1, fp, #56
+	mov	x19, #2
+	bl	strtod
+	ldr	x9, [fp, #-56]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #16]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	d0, [fp, #-56]
+_L_call:
+	ldr	d0, [fp, #-48]	// Load parsed argv[1] into register d0
+	ldr	d1, [fp, #-56]	// Load parsed argv[2] into register d1
+	bl	float_test
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+	adrp	x0, _STR_1048594
+	add	x0, x0, :lo12:_STR_1048594
+	mov	x1, x19
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_L_CONST_1048588:
+	.xword	0x40039999a0000000	// 2.450000
+_STR_1048578:
+	.asciz	"+"
+_STR_1048579:
+	.asciz	"="
+_STR_1048581:
+	.asciz	"%f + %f = %f\n"
+_STR_1048582:
+	.asciz	"-"
+_STR_1048583:
+	.asciz	"%f - %f = %f\n"
+_STR_1048584:
+	.asciz	"*"
+_STR_1048585:
+	.asciz	"%f * %f = %f\n"
+_STR_1048586:
+	.asciz	"/"
+_STR_1048587:
+	.asciz	"%f / %f = %f\n"
+_STR_1048589:
+	.asciz	"c is now a constant: "
+_STR_1048590:
+	.asciz	"c is now a constant:  %f\n"
+_STR_1048593:
+	.asciz	"Argument error: expected 2 arguments, got %d\n"
+_STR_1048594:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
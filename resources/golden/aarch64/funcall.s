@@ -0,0 +1,215 @@
+	.arch	armv8-a
+	.file	"funcall.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	defall, %function
+defall:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+block1048579:
+	mov	x8, #5
+	str	x8, [fp, #-24]
+	mov	x8, #10
+	str	x8, [fp, #-32]
+	adrp	x8, _STR_1048582
+	add	x8, x8, :lo12:_STR_1048582
+	ldp	x9, x8, [fp, #-32]
+	adrp	x10, _STR_1048584
+	add	x10, x10, :lo12:_STR_1048584
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	mov	x0, x8
+	mov	x1, x9
+	bl	my_deftion
+	str	x0, [fp, #-40]
+	adrp	x8, _STR_1048585
+	add	x8, x8, :lo12:_STR_1048585
+	ldr	x8, [fp, #-40]
+	adrp	x9, _STR_1048586
+	add	x9, x9, :lo12:_STR_1048586
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	bl	my_other_deftion
+	str	x0, [fp, #-40]
+	adrp	x8, _STR_1048587
+	add	x8, x8, :lo12:_STR_1048587
+	ldr	x8, [fp, #-40]
+	adrp	x9, _STR_1048588
+	add	x9, x9, :lo12:_STR_1048588
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	defall, .-defall
+
+	.type	my_deftion, %function
+my_deftion:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048590:
+	ldr	x8, [fp, #-24]
+	ldr	x9, [fp, #-24]
+	mul	x10, x8, x9
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	madd	x8, x8, x9, x10
+	str	x8, [fp, #-40]
+	adrp	x8, _STR_1048591
+	add	x8, x8, :lo12:_STR_1048591
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048592
+	add	x9, x9, :lo12:_STR_1048592
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	adrp	x8, _STR_1048593
+	add	x8, x8, :lo12:_STR_1048593
+	ldr	x8, [fp, #-32]
+	adrp	x9, _STR_1048594
+	add	x9, x9, :lo12:_STR_1048594
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	adrp	x8, _STR_1048595
+	add	x8, x8, :lo12:_STR_1048595
+	ldr	x8, [fp, #-40]
+	adrp	x9, _STR_1048596
+	add	x9, x9, :lo12:_STR_1048596
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	ldr	x0, [fp, #-40]
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	my_deftion, .-my_deftion
+
+	.type	my_other_deftion, %function
+my_other_deftion:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+block1048597:
+	mov	x8, #42
+	str	x8, [fp, #-24]
+	ldr	x0, [fp, #-24]
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	my_other_deftion, .-my_other_deftion
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048599
+	add	x0, x0, :lo12:_STR_1048599
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	defall
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048582:
+	.asciz	"Calling my_deftion with parameters"
+_STR_1048584:
+	.asciz	"Calling my_deftion with parameters %lld %lld\n"
+_STR_1048585:
+	.asciz	"The returned result is"
+_STR_1048586:
+	.asciz	"The returned result is %lld\n"
+_STR_1048587:
+	.asciz	"The other returned result is"
+_STR_1048588:
+	.asciz	"The other returned result is %lld\n"
+_STR_1048591:
+	.asciz	"Parameter s is"
+_STR_1048592:
+	.asciz	"Parameter s is %lld\n"
+_STR_1048593:
+	.asciz	"Parameter t is"
+_STR_1048594:
+	.asciz	"Parameter t is %lld\n"
+_STR_1048595:
+	.asciz	"The sum of their squares is"
+_STR_1048596:
+	.asciz	"The sum of their squares is %lld\n"
+_STR_1048599:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
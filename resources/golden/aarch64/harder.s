@@ -0,0 +1,217 @@
+	.arch	armv8-a
+	.file	"harder.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	start, %function
+start:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -32
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+block1048578:
+	adrp	x8, _STR_1048579
+	add	x8, x8, :lo12:_STR_1048579
+	adrp	x8, _STR_1048581
+	add	x8, x8, :lo12:_STR_1048581
+	mov	x0, x8
+	bl	printf
+	mov	x8, #1
+	mov	x0, x8
+	bl	test_me
+	str	x0, [fp, #-24]
+	adrp	x8, _STR_1048583
+	add	x8, x8, :lo12:_STR_1048583
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048584
+	add	x9, x9, :lo12:_STR_1048584
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	start, .-start
+
+	.type	test_me, %function
+test_me:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+	str	x0, [fp, #-24]
+block1048586:
+	adrp	x8, _STR_1048587
+	add	x8, x8, :lo12:_STR_1048587
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048588
+	add	x9, x9, :lo12:_STR_1048588
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x8, #2
+	str	x8, [fp, #-32]
+	adrp	x8, _STR_1048590
+	add	x8, x8, :lo12:_STR_1048590
+	ldr	x8, [fp, #-32]
+	adrp	x9, _STR_1048591
+	add	x9, x9, :lo12:_STR_1048591
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x8, #3
+	str	x8, [fp, #-48]
+	mov	x8, #4
+	str	x8, [fp, #-40]
+	adrp	x8, _STR_1048594
+	add	x8, x8, :lo12:_STR_1048594
+	ldr	x8, [fp, #-48]
+	adrp	x9, _STR_1048595
+	add	x9, x9, :lo12:_STR_1048595
+	ldr	x9, [fp, #-40]
+	adrp	x10, _STR_1048596
+	add	x10, x10, :lo12:_STR_1048596
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	bl	printf
+	mov	x8, #5
+	str	x8, [fp, #-40]
+	adrp	x8, _STR_1048598
+	add	x8, x8, :lo12:_STR_1048598
+	ldr	x8, [fp, #-40]
+	adrp	x9, _STR_1048599
+	add	x9, x9, :lo12:_STR_1048599
+	adrp	x9, _STR_1048600
+	add	x9, x9, :lo12:_STR_1048600
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	adrp	x8, _STR_1048601
+	add	x8, x8, :lo12:_STR_1048601
+	ldr	x8, [fp, #-32]
+	adrp	x9, _STR_1048602
+	add	x9, x9, :lo12:_STR_1048602
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	adrp	x8, _STR_1048603
+	add	x8, x8, :lo12:_STR_1048603
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048604
+	add	x9, x9, :lo12:_STR_1048604
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	ldr	x8, [fp, #-24]
+	sub	x0, x8, #1
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	test_me, .-test_me
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048605
+	add	x0, x0, :lo12:_STR_1048605
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	start
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048579:
+	.asciz	"Nested scopes coming up..."
+_STR_1048581:
+	.asciz	"Nested scopes coming up...\n"
+_STR_1048583:
+	.asciz	"x:="
+_STR_1048584:
+	.asciz	"x:= %lld\n"
+_STR_1048587:
+	.asciz	"Parameter a is a:="
+_STR_1048588:
+	.asciz	"Parameter a is a:= %lld\n"
+_STR_1048590:
+	.asciz	"Outer scope has a:="
+_STR_1048591:
+	.asciz	"Outer scope has a:= %lld\n"
+_STR_1048594:
+	.asciz	"Inner scope has a:="
+_STR_1048595:
+	.asciz	"and b:="
+_STR_1048596:
+	.asciz	"Inner scope has a:= %lld and b:= %lld\n"
+_STR_1048598:
+	.asciz	"b was updated to "
+_STR_1048599:
+	.asciz	"in inner scope"
+_STR_1048600:
+	.asciz	"b was updated to  %lld in inner scope\n"
+_STR_1048601:
+	.asciz	"Outer scope (still) has a:="
+_STR_1048602:
+	.asciz	"Outer scope (still) has a:= %lld\n"
+_STR_1048603:
+	.asciz	"Return expression (a-1) using a:="
+_STR_1048604:
+	.asciz	"Return expression (a-1) using a:= %lld\n"
+_STR_1048605:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
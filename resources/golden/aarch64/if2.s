@@ -0,0 +1,169 @@
+	.arch	armv8-a
+	.file	"if2.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	test, %function
+test:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -32
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	str	x0, [fp, #-24]
+block1048577:
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048579
+	add	x9, x9, :lo12:_STR_1048579
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #10
+	mov	x8, #0
+	cmp	x9, x8
+	b.le	block1048582
+block1048580:
+	adrp	x8, _STR_1048584
+	add	x8, x8, :lo12:_STR_1048584
+	adrp	x8, _STR_1048585
+	add	x8, x8, :lo12:_STR_1048585
+	mov	x0, x8
+	bl	printf
+block1048582:
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #10
+	mov	x8, #0
+	cmp	x9, x8
+	b.ge	block1048587
+block1048586:
+	adrp	x8, _STR_1048588
+	add	x8, x8, :lo12:_STR_1048588
+	adrp	x8, _STR_1048589
+	add	x8, x8, :lo12:_STR_1048589
+	mov	x0, x8
+	bl	printf
+block1048587:
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #10
+	mov	x8, #0
+	cmp	x9, x8
+	b.ne	block1048591
+block1048590:
+	adrp	x8, _STR_1048592
+	add	x8, x8, :lo12:_STR_1048592
+	adrp	x8, _STR_1048593
+	add	x8, x8, :lo12:_STR_1048593
+	mov	x0, x8
+	bl	printf
+block1048591:
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	test, .-test
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x19, [sp, #0]
+	.cfi_offset	19, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #1
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048594
+	add	x0, x0, :lo12:_STR_1048594
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #8]	// Load argv[1]
+	sub	x1, fp, #40
+	mov	x19, #1
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-40]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #8]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-40]
+_L_call:
+	ldr	x0, [fp, #-40]	// Load parsed argv[1] into register x0
+	bl	test
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+	adrp	x0, _STR_1048595
+	add	x0, x0, :lo12:_STR_1048595
+	mov	x1, x19
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048579:
+	.asciz	"%lld\n"
+_STR_1048584:
+	.asciz	"Bigger"
+_STR_1048585:
+	.asciz	"Bigger\n"
+_STR_1048588:
+	.asciz	"Smaller"
+_STR_1048589:
+	.asciz	"Smaller\n"
+_STR_1048592:
+	.asciz	"Equal"
+_STR_1048593:
+	.asciz	"Equal\n"
+_STR_1048594:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048595:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,188 @@
+	.arch	armv8-a
+	.file	"locals.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	nesting_scopes, %function
+nesting_scopes:
+	.cfi_startproc
+	sub	sp, sp, #112
+	.cfi_def_cfa_offset	112
+	stp	fp, lr, [sp, #96]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -112
+	add	fp, sp, #112
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	str	x2, [fp, #-40]
+block1048580:
+	mov	x8, #21
+	str	x8, [fp, #-48]
+	mov	x8, #42
+	str	x8, [fp, #-96]
+	adrp	x8, _STR_1048583
+	add	x8, x8, :lo12:_STR_1048583
+	ldr	x8, [fp, #-96]
+	adrp	x9, _STR_1048585
+	add	x9, x9, :lo12:_STR_1048585
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	adrp	x8, _STR_1048586
+	add	x8, x8, :lo12:_STR_1048586
+	ldr	x8, [fp, #-48]
+	adrp	x9, _STR_1048587
+	add	x9, x9, :lo12:_STR_1048587
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	adrp	x8, _STR_1048588
+	add	x8, x8, :lo12:_STR_1048588
+	adrp	x28, k
+	ldr	x8, [x28, :lo12:k]
+	adrp	x9, _STR_1048589
+	add	x9, x9, :lo12:_STR_1048589
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 112
+	ldp	fp, lr, [sp, #96]
+	add	sp, sp, #112
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	nesting_scopes, .-nesting_scopes
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x19, [sp, #0]
+	.cfi_offset	19, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #3
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048591
+	add	x0, x0, :lo12:_STR_1048591
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #8]	// Load argv[1]
+	sub	x1, fp, #40
+	mov	x19, #1
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-40]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #8]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #16]	// Load argv[2]
+	sub	x1, fp, #48
+	mov	x19, #2
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-48]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #16]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-48]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #24]	// Load argv[3]
+	sub	x1, fp, #56
+	mov	x19, #3
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-56]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #24]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-56]
+_L_call:
+	ldr	x0, [fp, #-40]	// Load parsed argv[1] into register x0
+	ldr	x1, [fp, #-48]	// Load parsed argv[2] into register x1
+	ldr	x2, [fp, #-56]	// Load parsed argv[3] into register x2
+	bl	nesting_scopes
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+	adrp	x0, _STR_1048592
+	add	x0, x0, :lo12:_STR_1048592
+	mov	x1, x19
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+i:
+	.xword	0x0
+j:
+	.xword	0x0
+k:
+	.xword	0x0
+_STR_1048583:
+	.asciz	"Inner a is "
+_STR_1048585:
+	.asciz	"Inner a is  %lld\n"
+_STR_1048586:
+	.asciz	"Outer a is "
+_STR_1048587:
+	.asciz	"Outer a is  %lld\n"
+_STR_1048588:
+	.asciz	"Global k is "
+_STR_1048589:
+	.asciz	"Global k is  %lld\n"
+_STR_1048591:
+	.asciz	"Argument error: expected 3 arguments, got %d\n"
+_STR_1048592:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
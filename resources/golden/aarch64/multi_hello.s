@@ -0,0 +1,402 @@
+	.arch	armv8-a
+	.file	"multi_hello.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	hello, %function
+hello:
+	.cfi_startproc
+	sub	sp, sp, #96
+	.cfi_def_cfa_offset	96
+	stp	fp, lr, [sp, #80]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	stp	d8, d9, [sp, #8]
+	stp	d10, d11, [sp, #24]
+	stp	d12, d13, [sp, #40]
+	stp	d14, d15, [sp, #56]
+	.cfi_offset	28, -96
+	.cfi_offset	72, -88
+	.cfi_offset	73, -80
+	.cfi_offset	74, -72
+	.cfi_offset	75, -64
+	.cfi_offset	76, -56
+	.cfi_offset	77, -48
+	.cfi_offset	78, -40
+	.cfi_offset	79, -32
+	add	fp, sp, #96
+	.cfi_def_cfa	29, 0
+block1048583:
+	adrp	x8, _STR_1048584
+	add	x8, x8, :lo12:_STR_1048584
+	adrp	x8, _STR_1048585
+	add	x8, x8, :lo12:_STR_1048585
+	adrp	x8, _STR_1048587
+	add	x8, x8, :lo12:_STR_1048587
+	mov	x0, x8
+	bl	printf
+	adrp	x8, _STR_1048588
+	add	x8, x8, :lo12:_STR_1048588
+	adrp	x8, _STR_1048589
+	add	x8, x8, :lo12:_STR_1048589
+	adrp	x8, _STR_1048590
+	add	x8, x8, :lo12:_STR_1048590
+	adrp	x8, _STR_1048591
+	add	x8, x8, :lo12:_STR_1048591
+	mov	x0, x8
+	bl	printf
+	mov	x8, #42
+	mov	x9, #43
+	mov	x10, #44
+	adrp	x11, _STR_1048595
+	add	x11, x11, :lo12:_STR_1048595
+	mov	x0, x11
+	mov	x1, x8
+	mov	x2, x9
+	mov	x3, x10
+	bl	printf
+	mov	x8, #30
+	adrp	x28, x
+	str	x8, [x28, :lo12:x]
+	mov	x8, #-15
+	adrp	x28, y
+	str	x8, [x28, :lo12:y]
+	adrp	x28, x
+	ldr	x8, [x28, :lo12:x]
+	adrp	x28, x
+	ldr	x9, [x28, :lo12:x]
+	mul	x10, x8, x9
+	adrp	x28, y
+	ldr	x8, [x28, :lo12:y]
+	adrp	x28, y
+	ldr	x9, [x28, :lo12:y]
+	madd	x8, x8, x9, x10
+	adrp	x28, z
+	str	x8, [x28, :lo12:z]
+	adrp	x8, _STR_1048598
+	add	x8, x8, :lo12:_STR_1048598
+	adrp	x28, x
+	ldr	x8, [x28, :lo12:x]
+	adrp	x28, y
+	ldr	x9, [x28, :lo12:y]
+	add	x10, x8, x9
+	adrp	x8, _STR_1048599
+	add	x8, x8, :lo12:_STR_1048599
+	mov	x0, x8
+	mov	x1, x10
+	bl	printf
+	adrp	x8, _STR_1048600
+	add	x8, x8, :lo12:_STR_1048600
+	adrp	x28, x
+	ldr	x8, [x28, :lo12:x]
+	adrp	x28, y
+	ldr	x9, [x28, :lo12:y]
+	sub	x10, x8, x9
+	adrp	x8, _STR_1048601
+	add	x8, x8, :lo12:_STR_1048601
+	mov	x0, x8
+	mov	x1, x10
+	bl	printf
+	adrp	x8, _STR_1048602
+	add	x8, x8, :lo12:_STR_1048602
+	adrp	x28, x
+	ldr	x8, [x28, :lo12:x]
+	adrp	x28, y
+	ldr	x9, [x28, :lo12:y]
+	mul	x10, x8, x9
+	adrp	x8, _STR_1048603
+	add	x8, x8, :lo12:_STR_1048603
+	mov	x0, x8
+	mov	x1, x10
+	bl	printf
+	adrp	x8, _STR_1048604
+	add	x8, x8, :lo12:_STR_1048604
+	adrp	x28, x
+	ldr	x8, [x28, :lo12:x]
+	adrp	x28, y
+	ldr	x9, [x28, :lo12:y]
+	sdiv	x10, x8, x9
+	adrp	x8, _STR_1048605
+	add	x8, x8, :lo12:_STR_1048605
+	mov	x0, x8
+	mov	x1, x10
+	bl	printf
+	mov	x8, #1
+	mov	x9, #2
+	mov	x10, #3
+	mov	x11, #4
+	mov	x12, #5
+	mov	x13, #6
+	mov	x14, #7
+	mov	x15, #8
+	scvtf	d8, x8
+	scvtf	d9, x9
+	scvtf	d10, x10
+	scvtf	d11, x11
+	scvtf	d12, x12
+	scvtf	d13, x13
+	scvtf	d14, x14
+	scvtf	d15, x15
+	fmov	d0, d8
+	fmov	d1, d9
+	fmov	d2, d10
+	fmov	d3, d11
+	fmov	d4, d12
+	fmov	d5, d13
+	fmov	d6, d14
+	fmov	d7, d15
+	bl	goodbye
+	adrp	x28, w
+	str	x0, [x28, :lo12:w]
+	adrp	x28, w
+	ldr	x8, [x28, :lo12:w]
+	adrp	x9, _STR_1048614
+	add	x9, x9, :lo12:_STR_1048614
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	ldp	d8, d9, [sp, #8]
+	ldp	d10, d11, [sp, #24]
+	ldp	d12, d13, [sp, #40]
+	ldp	d14, d15, [sp, #56]
+	.cfi_def_cfa	31, 96
+	ldp	fp, lr, [sp, #80]
+	add	sp, sp, #96
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	hello, .-hello
+
+	.type	tralala, %function
+tralala:
+	.cfi_startproc
+	sub	sp, sp, #112
+	.cfi_def_cfa_offset	112
+	stp	fp, lr, [sp, #96]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	stp	d8, d9, [sp, #8]
+	stp	d10, d11, [sp, #24]
+	str	d12, [sp, #40]
+	.cfi_offset	28, -112
+	.cfi_offset	72, -104
+	.cfi_offset	73, -96
+	.cfi_offset	74, -88
+	.cfi_offset	75, -80
+	.cfi_offset	76, -72
+	add	fp, sp, #112
+	.cfi_def_cfa	29, 0
+	str	d0, [fp, #-24]
+block1048616:
+	mov	x8, #3
+	scvtf	d8, x8
+	str	d8, [fp, #-32]
+	mov	x8, #5
+	scvtf	d8, x8
+	str	d8, [fp, #-40]
+	mov	x8, #2
+	scvtf	d8, x8
+	str	d8, [fp, #-48]
+	mov	x8, #4
+	scvtf	d8, x8
+	str	d8, [fp, #-56]
+	mov	x8, #42
+	scvtf	d8, x8
+	str	d8, [fp, #-24]
+	ldp	d9, d8, [fp, #-32]
+	ldr	d10, [fp, #-40]
+	fmul	d11, d9, d10
+	ldp	d10, d9, [fp, #-56]
+	fmul	d12, d9, d10
+	adrp	x8, _STR_1048617
+	add	x8, x8, :lo12:_STR_1048617
+	mov	x0, x8
+	fmov	d0, d8
+	fmov	d1, d11
+	fmov	d2, d12
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	ldp	d8, d9, [sp, #8]
+	ldp	d10, d11, [sp, #24]
+	ldr	d12, [sp, #40]
+	.cfi_def_cfa	31, 112
+	ldp	fp, lr, [sp, #96]
+	add	sp, sp, #112
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	tralala, .-tralala
+
+	.type	goodbye, %function
+goodbye:
+	.cfi_startproc
+	sub	sp, sp, #160
+	.cfi_def_cfa_offset	160
+	stp	fp, lr, [sp, #144]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	stp	d8, d9, [sp, #8]
+	stp	d10, d11, [sp, #24]
+	stp	d12, d13, [sp, #40]
+	stp	d14, d15, [sp, #56]
+	.cfi_offset	28, -160
+	.cfi_offset	72, -152
+	.cfi_offset	73, -144
+	.cfi_offset	74, -136
+	.cfi_offset	75, -128
+	.cfi_offset	76, -120
+	.cfi_offset	77, -112
+	.cfi_offset	78, -104
+	.cfi_offset	79, -96
+	add	fp, sp, #160
+	.cfi_def_cfa	29, 0
+	stp	d1, d0, [fp, #-32]
+	stp	d3, d2, [fp, #-48]
+	stp	d5, d4, [fp, #-64]
+	stp	d7, d6, [fp, #-80]
+block1048618:
+	ldp	d9, d8, [fp, #-32]
+	ldp	d11, d10, [fp, #-48]
+	ldp	d13, d12, [fp, #-64]
+	ldp	d15, d14, [fp, #-80]
+	adrp	x8, _STR_1048619
+	add	x8, x8, :lo12:_STR_1048619
+	mov	x0, x8
+	fmov	d0, d8
+	fmov	d1, d9
+	fmov	d2, d10
+	fmov	d3, d11
+	fmov	d4, d12
+	fmov	d5, d13
+	fmov	d6, d14
+	fmov	d7, d15
+	bl	printf
+	adrp	x8, _STR_1048620
+	add	x8, x8, :lo12:_STR_1048620
+	adrp	x8, _STR_1048621
+	add	x8, x8, :lo12:_STR_1048621
+	mov	x0, x8
+	bl	printf
+	mov	x8, #1
+	scvtf	d8, x8
+	fmov	d0, d8
+	bl	tralala
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	ldp	d8, d9, [sp, #8]
+	ldp	d10, d11, [sp, #24]
+	ldp	d12, d13, [sp, #40]
+	ldp	d14, d15, [sp, #56]
+	.cfi_def_cfa	31, 160
+	ldp	fp, lr, [sp, #144]
+	add	sp, sp, #160
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	goodbye, .-goodbye
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048622
+	add	x0, x0, :lo12:_STR_1048622
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	hello
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+w:
+	.xword	0x0
+x:
+	.xword	0x0
+y:
+	.xword	0x0
+z:
+	.xword	0x0
+_STR_1048584:
+	.asciz	"Hello,"
+_STR_1048585:
+	.asciz	"world!"
+_STR_1048587:
+	.asciz	"Hello, world!\n"
+_STR_1048588:
+	.asciz	"How"
+_STR_1048589:
+	.asciz	"are"
+_STR_1048590:
+	.asciz	"you?"
+_STR_1048591:
+	.asciz	"How are you?\n"
+_STR_1048595:
+	.asciz	"%lld %lld %lld\n"
+_STR_1048598:
+	.asciz	"x + y :="
+_STR_1048599:
+	.asciz	"x + y := %lld\n"
+_STR_1048600:
+	.asciz	"x - y :="
+_STR_1048601:
+	.asciz	"x - y := %lld\n"
+_STR_1048602:
+	.asciz	"x * y :="
+_STR_1048603:
+	.asciz	"x * y := %lld\n"
+_STR_1048604:
+	.asciz	"x / y :="
+_STR_1048605:
+	.asciz	"x / y := %lld\n"
+_STR_1048614:
+	.asciz	"%lld\n"
+_STR_1048617:
+	.asciz	"%f %f %f\n"
+_STR_1048619:
+	.asciz	"%f %f %f %f %f %f %f %f\n"
+_STR_1048620:
+	.asciz	"Morna"
+_STR_1048621:
+	.asciz	"Morna\n"
+_STR_1048622:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
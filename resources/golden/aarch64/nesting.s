@@ -0,0 +1,189 @@
+	.arch	armv8-a
+	.file	"nesting.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	hello, %function
+hello:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -32
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+block1048578:
+	adrp	x8, _STR_1048579
+	add	x8, x8, :lo12:_STR_1048579
+	adrp	x8, _STR_1048581
+	add	x8, x8, :lo12:_STR_1048581
+	mov	x0, x8
+	bl	printf
+	mov	x8, #42
+	mov	x0, x8
+	bl	test_me
+	str	x0, [fp, #-24]
+	adrp	x8, _STR_1048583
+	add	x8, x8, :lo12:_STR_1048583
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048584
+	add	x9, x9, :lo12:_STR_1048584
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	hello, .-hello
+
+	.type	test_me, %function
+test_me:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+	str	x0, [fp, #-24]
+block1048586:
+	mov	x8, #32
+	str	x8, [fp, #-32]
+	adrp	x8, _STR_1048588
+	add	x8, x8, :lo12:_STR_1048588
+	ldr	x8, [fp, #-32]
+	adrp	x9, _STR_1048589
+	add	x9, x9, :lo12:_STR_1048589
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x8, #64
+	str	x8, [fp, #-48]
+	mov	x8, #27
+	str	x8, [fp, #-40]
+	adrp	x8, _STR_1048592
+	add	x8, x8, :lo12:_STR_1048592
+	ldr	x8, [fp, #-48]
+	adrp	x9, _STR_1048593
+	add	x9, x9, :lo12:_STR_1048593
+	ldr	x9, [fp, #-40]
+	adrp	x10, _STR_1048594
+	add	x10, x10, :lo12:_STR_1048594
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	bl	printf
+	mov	x8, #128
+	str	x8, [fp, #-40]
+	adrp	x8, _STR_1048596
+	add	x8, x8, :lo12:_STR_1048596
+	ldr	x8, [fp, #-40]
+	adrp	x9, _STR_1048597
+	add	x9, x9, :lo12:_STR_1048597
+	adrp	x9, _STR_1048598
+	add	x9, x9, :lo12:_STR_1048598
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	adrp	x8, _STR_1048588
+	add	x8, x8, :lo12:_STR_1048588
+	ldr	x8, [fp, #-32]
+	adrp	x9, _STR_1048589
+	add	x9, x9, :lo12:_STR_1048589
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	ldr	x8, [fp, #-24]
+	add	x0, x8, #1
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	test_me, .-test_me
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048600
+	add	x0, x0, :lo12:_STR_1048600
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	hello
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048579:
+	.asciz	"Hello, world!"
+_STR_1048581:
+	.asciz	"Hello, world!\n"
+_STR_1048583:
+	.asciz	"x:="
+_STR_1048584:
+	.asciz	"x:= %lld\n"
+_STR_1048588:
+	.asciz	"Outer scope has a:="
+_STR_1048589:
+	.asciz	"Outer scope has a:= %lld\n"
+_STR_1048592:
+	.asciz	"I have a:="
+_STR_1048593:
+	.asciz	"and b:="
+_STR_1048594:
+	.asciz	"I have a:= %lld and b:= %lld\n"
+_STR_1048596:
+	.asciz	"B was reassigned to "
+_STR_1048597:
+	.asciz	"in inner"
+_STR_1048598:
+	.asciz	"B was reassigned to  %lld in inner\n"
+_STR_1048600:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
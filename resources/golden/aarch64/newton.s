@@ -0,0 +1,226 @@
+	.arch	armv8-a
+	.file	"newton.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	newton, %function
+newton:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	str	x0, [fp, #-24]
+block1048585:
+	ldr	x8, [fp, #-24]
+	mov	x9, #1
+	mov	x0, x8
+	mov	x1, x9
+	bl	improve
+	str	x0, [fp, #-32]
+	adrp	x8, _STR_1048587
+	add	x8, x8, :lo12:_STR_1048587
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048588
+	add	x9, x9, :lo12:_STR_1048588
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048590
+	add	x10, x10, :lo12:_STR_1048590
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	newton, .-newton
+
+	.type	improve, %function
+improve:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048592:
+	ldr	x8, [fp, #-32]
+	ldr	x9, [fp, #-32]
+	ldr	x10, [fp, #-32]
+	mul	x11, x9, x10
+	ldr	x9, [fp, #-24]
+	sub	x10, x11, x9
+	mov	x9, #2
+	ldr	x11, [fp, #-32]
+	mul	x12, x9, x11
+	sdiv	x9, x10, x12
+	sub	x10, x8, x9
+	str	x10, [fp, #-40]
+	ldp	x8, x9, [fp, #-40]
+	sub	x10, x8, x9
+	sub	x8, x10, #0
+	mov	x9, #0
+	cmp	x8, x9
+	b.ne	block1048595
+block1048594:
+	ldr	x8, [fp, #-40]
+	sub	x0, x8, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+block1048595:
+	ldr	x8, [fp, #-24]
+	ldr	x9, [fp, #-40]
+	mov	x0, x8
+	mov	x1, x9
+	bl	improve
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	improve, .-improve
+
+	.type	fourty_two, %function
+fourty_two:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	str	x0, [fp, #-24]
+block1048596:
+	ldr	x8, [fp, #-24]
+	add	x0, x8, #42
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	fourty_two, .-fourty_two
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x19, [sp, #0]
+	.cfi_offset	19, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #1
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048598
+	add	x0, x0, :lo12:_STR_1048598
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #8]	// Load argv[1]
+	sub	x1, fp, #40
+	mov	x19, #1
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-40]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #8]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-40]
+_L_call:
+	ldr	x0, [fp, #-40]	// Load parsed argv[1] into register x0
+	bl	newton
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+	adrp	x0, _STR_1048599
+	add	x0, x0, :lo12:_STR_1048599
+	mov	x1, x19
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+x:
+	.xword	0x0
+y:
+	.xword	0x0
+z:
+	.xword	0x0
+a:
+	.xword	0x0
+b:
+	.xword	0x0
+c:
+	.xword	0x0
+_STR_1048587:
+	.asciz	"The square root of"
+_STR_1048588:
+	.asciz	"is"
+_STR_1048590:
+	.asciz	"The square root of %lld is %lld\n"
+_STR_1048598:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048599:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
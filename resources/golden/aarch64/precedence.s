@@ -0,0 +1,114 @@
+	.arch	armv8-a
+	.file	"precedence.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	precedence, %function
+precedence:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+block1048577:
+	mov	x8, #2
+	str	x8, [fp, #-24]
+	mov	x8, #3
+	str	x8, [fp, #-32]
+	mov	x8, #1
+	str	x8, [fp, #-40]
+	ldp	x9, x8, [fp, #-32]
+	ldr	x10, [fp, #-40]
+	sub	x11, x9, x10
+	mul	x9, x8, x11
+	str	x9, [fp, #-48]
+	adrp	x8, _STR_1048581
+	add	x8, x8, :lo12:_STR_1048581
+	ldr	x8, [fp, #-48]
+	adrp	x9, _STR_1048583
+	add	x9, x9, :lo12:_STR_1048583
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	ldp	x9, x8, [fp, #-32]
+	mul	x10, x8, x9
+	ldr	x8, [fp, #-40]
+	sub	x9, x10, x8
+	str	x9, [fp, #-48]
+	adrp	x8, _STR_1048584
+	add	x8, x8, :lo12:_STR_1048584
+	ldr	x8, [fp, #-48]
+	adrp	x9, _STR_1048585
+	add	x9, x9, :lo12:_STR_1048585
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	precedence, .-precedence
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048587
+	add	x0, x0, :lo12:_STR_1048587
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	precedence
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048581:
+	.asciz	"2*(3-1) := "
+_STR_1048583:
+	.asciz	"2*(3-1) :=  %lld\n"
+_STR_1048584:
+	.asciz	"2*3-1 := "
+_STR_1048585:
+	.asciz	"2*3-1 :=  %lld\n"
+_STR_1048587:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,149 @@
+	.arch	armv8-a
+	.file	"prime.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	mainfunc, %function
+mainfunc:
+	.cfi_startproc
+	sub	sp, sp, #16
+	.cfi_def_cfa_offset	16
+	stp	fp, lr, [sp, #0]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #16
+	.cfi_def_cfa	29, 0
+block1048578:
+	mov	x0, #0
+	.cfi_remember_state
+	.cfi_def_cfa	31, 16
+	ldp	fp, lr, [sp, #0]
+	add	sp, sp, #16
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	mainfunc, .-mainfunc
+
+	.type	factor, %function
+factor:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	str	x0, [fp, #-24]
+block1048580:
+	ldr	x8, [fp, #-24]
+	mov	x9, #2
+	lsr	x9, x8, #63
+	add	x10, x8, x9
+	asr	x8, x10, #1
+	str	x8, [fp, #-32]
+block1048582:
+	ldp	x9, x8, [fp, #-32]
+	ldp	x11, x10, [fp, #-32]
+	sdiv	x12, x10, x11
+	msub	x9, x9, x12, x8
+	sub	x8, x9, #0
+	mov	x9, #0
+	cmp	x8, x9
+	b.le	block1048584
+block1048583:
+	ldr	x8, [fp, #-32]
+	sub	x9, x8, #1
+	str	x9, [fp, #-32]
+	b	block1048582
+block1048584:
+	ldr	x8, [fp, #-32]
+	sub	x9, x8, #1
+	sub	x8, x9, #0
+	mov	x9, #0
+	cmp	x8, x9
+	b.le	block1048587
+block1048586:
+	ldr	x8, [fp, #-32]
+	mov	x0, x8
+	bl	factor
+	str	x0, [fp, #-40]
+	ldp	x9, x8, [fp, #-32]
+	sdiv	x10, x8, x9
+	mov	x0, x10
+	bl	factor
+	str	x0, [fp, #-40]
+	b	block1048588
+block1048587:
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048589
+	add	x9, x9, :lo12:_STR_1048589
+	adrp	x9, _STR_1048591
+	add	x9, x9, :lo12:_STR_1048591
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+block1048588:
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	factor, .-factor
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048593
+	add	x0, x0, :lo12:_STR_1048593
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	mainfunc
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048589:
+	.asciz	"is a prime factor"
+_STR_1048591:
+	.asciz	"%lld is a prime factor\n"
+_STR_1048593:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
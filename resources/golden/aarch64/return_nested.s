@@ -0,0 +1,120 @@
+	.arch	armv8-a
+	.file	"return_nested.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	hello, %function
+hello:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -32
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+block1048578:
+	adrp	x8, _STR_1048579
+	add	x8, x8, :lo12:_STR_1048579
+	mov	x8, #64
+	mov	x0, x8
+	bl	test
+	mov	x8, x0
+	adrp	x9, _STR_1048582
+	add	x9, x9, :lo12:_STR_1048582
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	hello, .-hello
+
+	.type	test, %function
+test:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	str	x0, [fp, #-24]
+block1048584:
+	mov	x8, #32
+	str	x8, [fp, #-32]
+	mov	x8, #20
+	str	x8, [fp, #-40]
+	mov	x8, #64
+	str	x8, [fp, #-48]
+	ldr	x8, [fp, #-48]
+	ldr	x9, [fp, #-24]
+	add	x0, x8, x9
+	.cfi_remember_state
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	test, .-test
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048587
+	add	x0, x0, :lo12:_STR_1048587
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	hello
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048579:
+	.asciz	"t is"
+_STR_1048582:
+	.asciz	"t is %lld\n"
+_STR_1048587:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,264 @@
+	.arch	armv8-a
+	.file	"simpleif.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	dingdong, %function
+dingdong:
+	.cfi_startproc
+	sub	sp, sp, #96
+	.cfi_def_cfa_offset	96
+	stp	fp, lr, [sp, #80]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -96
+	add	fp, sp, #96
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	stp	x3, x2, [fp, #-48]
+	stp	x5, x4, [fp, #-64]
+	str	x6, [fp, #-72]
+block1048577:
+	mov	x8, #42
+	str	x8, [fp, #-80]
+	ldp	x9, x8, [fp, #-32]
+	ldp	x11, x10, [fp, #-48]
+	ldp	x13, x12, [fp, #-64]
+	ldr	x14, [fp, #-72]
+	adrp	x15, _STR_1048580
+	add	x15, x15, :lo12:_STR_1048580
+	mov	x0, x15
+	mov	x1, x8
+	mov	x2, x9
+	mov	x3, x10
+	mov	x4, x11
+	mov	x5, x12
+	mov	x6, x13
+	mov	x7, x14
+	bl	printf
+	ldr	x8, [fp, #-80]
+	sub	x9, x8, #42
+	mov	x8, #0
+	cmp	x9, x8
+	b.ne	block1048582
+block1048581:
+	adrp	x8, _STR_1048584
+	add	x8, x8, :lo12:_STR_1048584
+	adrp	x8, _STR_1048585
+	add	x8, x8, :lo12:_STR_1048585
+	mov	x0, x8
+	bl	printf
+	mov	x8, #43
+	str	x8, [fp, #-80]
+	b	block1048589
+block1048582:
+	mov	x8, #44
+	str	x8, [fp, #-80]
+block1048589:
+	ldr	x8, [fp, #-80]
+	sub	x9, x8, #0
+	mov	x8, #0
+	cmp	x9, x8
+	b.le	block1048591
+block1048590:
+	ldr	x8, [fp, #-80]
+	adrp	x9, _STR_1048592
+	add	x9, x9, :lo12:_STR_1048592
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	ldr	x8, [fp, #-80]
+	sub	x9, x8, #1
+	str	x9, [fp, #-80]
+	b	block1048589
+block1048591:
+	ldr	x0, [fp, #-80]
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 96
+	ldp	fp, lr, [sp, #80]
+	add	sp, sp, #96
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	dingdong, .-dingdong
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #96
+	.cfi_def_cfa_offset	96
+	stp	fp, lr, [sp, #80]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x19, [sp, #0]
+	.cfi_offset	19, -96
+	add	fp, sp, #96
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #7
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048594
+	add	x0, x0, :lo12:_STR_1048594
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 96
+	ldp	fp, lr, [sp, #80]
+	add	sp, sp, #96
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #8]	// Load argv[1]
+	sub	x1, fp, #40
+	mov	x19, #1
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-40]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #8]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-40]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #16]	// Load argv[2]
+	sub	x1, fp, #48
+	mov	x19, #2
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-48]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #16]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-48]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #24]	// Load argv[3]
+	sub	x1, fp, #56
+	mov	x19, #3
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-56]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #24]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-56]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #32]	// Load argv[4]
+	sub	x1, fp, #64
+	mov	x19, #4
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-64]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #32]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-64]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #40]	// Load argv[5]
+	sub	x1, fp, #72
+	mov	x19, #5
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-72]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #40]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-72]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #48]	// Load argv[6]
+	sub	x1, fp, #80
+	mov	x19, #6
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-80]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #48]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-80]
+	ldr	x8, [fp, #-32]	// Load argv
+	ldr	x0, [x8, #56]	// Load argv[7]
+	sub	x1, fp, #88
+	mov	x19, #7
+	mov	x2, #10
+	bl	strtol
+	ldr	x9, [fp, #-88]	// Load end pointer
+	ldr	x8, [fp, #-32]
+	ldr	x10, [x8, #56]
+	cmp	x9, x10
+	b.eq	_L_argv_error
+	ldrb	w10, [x9]
+	cbnz	w10, _L_argv_error
+	str	x0, [fp, #-88]
+_L_call:
+	ldr	x0, [fp, #-40]	// Load parsed argv[1] into register x0
+	ldr	x1, [fp, #-48]	// Load parsed argv[2] into register x1
+	ldr	x2, [fp, #-56]	// Load parsed argv[3] into register x2
+	ldr	x3, [fp, #-64]	// Load parsed argv[4] into register x3
+	ldr	x4, [fp, #-72]	// Load parsed argv[5] into register x4
+	ldr	x5, [fp, #-80]	// Load parsed argv[6] into register x5
+	ldr	x6, [fp, #-88]	// Load parsed argv[7] into register x6
+	bl	dingdong
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 96
+	ldp	fp, lr, [sp, #80]
+	add	sp, sp, #96
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+	adrp	x0, _STR_1048595
+	add	x0, x0, :lo12:_STR_1048595
+	mov	x1, x19
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	ldr	x19, [sp, #0]
+	.cfi_def_cfa	31, 96
+	ldp	fp, lr, [sp, #80]
+	add	sp, sp, #96
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048580:
+	.asciz	"%lld %lld %lld %lld %lld %lld %lld\n"
+_STR_1048584:
+	.asciz	"Equal!"
+_STR_1048585:
+	.asciz	"Equal!\n"
+_STR_1048592:
+	.asciz	"%lld\n"
+_STR_1048594:
+	.asciz	"Argument error: expected 7 arguments, got %d\n"
+_STR_1048595:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,167 @@
+	.arch	armv8-a
+	.file	"simplenest.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	hello, %function
+hello:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+block1048578:
+	mov	x8, #42
+	mov	x0, x8
+	bl	test
+	str	x0, [fp, #-24]
+	mov	x0, #0
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	hello, .-hello
+
+	.type	test, %function
+test:
+	.cfi_startproc
+	sub	sp, sp, #64
+	.cfi_def_cfa_offset	64
+	stp	fp, lr, [sp, #48]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -64
+	add	fp, sp, #64
+	.cfi_def_cfa	29, 0
+	str	x0, [fp, #-24]
+block1048581:
+	mov	x8, #32
+	str	x8, [fp, #-32]
+	mov	x8, #20
+	str	x8, [fp, #-40]
+	adrp	x8, _STR_1048584
+	add	x8, x8, :lo12:_STR_1048584
+	ldr	x8, [fp, #-32]
+	adrp	x9, _STR_1048585
+	add	x9, x9, :lo12:_STR_1048585
+	ldr	x9, [fp, #-40]
+	adrp	x10, _STR_1048586
+	add	x10, x10, :lo12:_STR_1048586
+	ldr	x10, [fp, #-24]
+	adrp	x11, _STR_1048588
+	add	x11, x11, :lo12:_STR_1048588
+	mov	x0, x11
+	mov	x1, x8
+	mov	x2, x9
+	mov	x3, x10
+	bl	printf
+	mov	x8, #64
+	str	x8, [fp, #-48]
+	adrp	x8, _STR_1048590
+	add	x8, x8, :lo12:_STR_1048590
+	ldr	x8, [fp, #-48]
+	adrp	x9, _STR_1048585
+	add	x9, x9, :lo12:_STR_1048585
+	ldr	x9, [fp, #-40]
+	adrp	x10, _STR_1048586
+	add	x10, x10, :lo12:_STR_1048586
+	ldr	x10, [fp, #-24]
+	adrp	x11, _STR_1048591
+	add	x11, x11, :lo12:_STR_1048591
+	mov	x0, x11
+	mov	x1, x8
+	mov	x2, x9
+	mov	x3, x10
+	bl	printf
+	adrp	x8, _STR_1048584
+	add	x8, x8, :lo12:_STR_1048584
+	ldr	x8, [fp, #-32]
+	adrp	x9, _STR_1048585
+	add	x9, x9, :lo12:_STR_1048585
+	ldr	x9, [fp, #-40]
+	adrp	x10, _STR_1048586
+	add	x10, x10, :lo12:_STR_1048586
+	ldr	x10, [fp, #-24]
+	adrp	x11, _STR_1048588
+	add	x11, x11, :lo12:_STR_1048588
+	mov	x0, x11
+	mov	x1, x8
+	mov	x2, x9
+	mov	x3, x10
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 64
+	ldp	fp, lr, [sp, #48]
+	add	sp, sp, #64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	test, .-test
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048592
+	add	x0, x0, :lo12:_STR_1048592
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	hello
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048584:
+	.asciz	"Outer x is"
+_STR_1048585:
+	.asciz	"y is"
+_STR_1048586:
+	.asciz	"parm is"
+_STR_1048588:
+	.asciz	"Outer x is %lld y is %lld parm is %lld\n"
+_STR_1048590:
+	.asciz	"Inner x is"
+_STR_1048591:
+	.asciz	"Inner x is %lld y is %lld parm is %lld\n"
+_STR_1048592:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
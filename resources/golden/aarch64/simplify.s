@@ -0,0 +1,168 @@
+	.arch	armv8-a
+	.file	"simplify.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	f, %function
+f:
+	.cfi_startproc
+	sub	sp, sp, #16
+	.cfi_def_cfa_offset	16
+	stp	fp, lr, [sp, #0]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #16
+	.cfi_def_cfa	29, 0
+block1048579:
+	mov	x0, #0
+	.cfi_remember_state
+	.cfi_def_cfa	31, 16
+	ldp	fp, lr, [sp, #0]
+	add	sp, sp, #16
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	f, .-f
+
+	.type	g, %function
+g:
+	.cfi_startproc
+	sub	sp, sp, #96
+	.cfi_def_cfa_offset	96
+	stp	fp, lr, [sp, #80]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -96
+	add	fp, sp, #96
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	str	x2, [fp, #-40]
+block1048581:
+	mov	x8, #1
+	str	x8, [fp, #-48]
+	mov	x8, #2
+	str	x8, [fp, #-56]
+	ldr	x8, [fp, #-72]
+	add	x9, x8, #1
+	ldr	x8, [fp, #-80]
+	add	x10, x8, #2
+	ldr	x8, [fp, #-88]
+	add	x11, x8, #3
+	mov	x0, x9
+	mov	x1, x10
+	mov	x2, x11
+	bl	g
+	str	x0, [fp, #-64]
+	ldp	x9, x8, [fp, #-56]
+	ldr	x10, [fp, #-64]
+	adrp	x11, _STR_1048586
+	add	x11, x11, :lo12:_STR_1048586
+	mov	x0, x11
+	mov	x1, x8
+	mov	x2, x9
+	mov	x3, x10
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 96
+	ldp	fp, lr, [sp, #80]
+	add	sp, sp, #96
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	g, .-g
+
+	.type	h, %function
+h:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	d8, [sp, #0]
+	.cfi_offset	72, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+block1048587:
+	mov	x8, #5
+	scvtf	d8, x8
+	str	d8, [fp, #-40]
+	mov	x8, #1
+	scvtf	d8, x8
+	str	d8, [fp, #-40]
+	mov	x8, #4
+	scvtf	d8, x8
+	str	d8, [fp, #-40]
+	mov	x8, #2
+	scvtf	d8, x8
+	str	d8, [fp, #-40]
+	mov	x8, #-6
+	scvtf	d8, x8
+	str	d8, [fp, #-40]
+	mov	x8, #4
+	scvtf	d8, x8
+	str	d8, [fp, #-40]
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	d8, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	h, .-h
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048591
+	add	x0, x0, :lo12:_STR_1048591
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	f
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048586:
+	.asciz	"%lld %lld %lld\n"
+_STR_1048591:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
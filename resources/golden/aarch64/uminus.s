@@ -0,0 +1,123 @@
+	.arch	armv8-a
+	.file	"uminus.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	negatives, %function
+negatives:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+block1048577:
+	mov	x8, #100
+	str	x8, [fp, #-24]
+	mov	x8, #20
+	str	x8, [fp, #-32]
+	adrp	x8, _STR_1048580
+	add	x8, x8, :lo12:_STR_1048580
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048581
+	add	x9, x9, :lo12:_STR_1048581
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048583
+	add	x10, x10, :lo12:_STR_1048583
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	bl	printf
+	adrp	x8, _STR_1048584
+	add	x8, x8, :lo12:_STR_1048584
+	ldp	x9, x8, [fp, #-32]
+	mov	x10, #0
+	sub	x11, x10, x9
+	sdiv	x9, x8, x11
+	adrp	x8, _STR_1048586
+	add	x8, x8, :lo12:_STR_1048586
+	mov	x0, x8
+	mov	x1, x9
+	bl	printf
+	adrp	x8, _STR_1048587
+	add	x8, x8, :lo12:_STR_1048587
+	mov	x8, #-5
+	adrp	x9, _STR_1048589
+	add	x9, x9, :lo12:_STR_1048589
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	negatives, .-negatives
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048590
+	add	x0, x0, :lo12:_STR_1048590
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	negatives
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048580:
+	.asciz	"a is"
+_STR_1048581:
+	.asciz	"and b is"
+_STR_1048583:
+	.asciz	"a is %lld and b is %lld\n"
+_STR_1048584:
+	.asciz	"a/(-b) is"
+_STR_1048586:
+	.asciz	"a/(-b) is %lld\n"
+_STR_1048587:
+	.asciz	"10/(-2) is"
+_STR_1048589:
+	.asciz	"10/(-2) is %lld\n"
+_STR_1048590:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
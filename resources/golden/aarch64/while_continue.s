@@ -0,0 +1,147 @@
+	.arch	armv8-a
+	.file	"while_continue.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	while_continue, %function
+while_continue:
+	.cfi_startproc
+	sub	sp, sp, #48
+	.cfi_def_cfa_offset	48
+	stp	fp, lr, [sp, #32]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -48
+	add	fp, sp, #48
+	.cfi_def_cfa	29, 0
+block1048577:
+	mov	x8, #0
+	str	x8, [fp, #-24]
+block1048579:
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #3
+	mov	x8, #0
+	cmp	x9, x8
+	b.ge	block1048581
+block1048580:
+	ldr	x8, [fp, #-24]
+	add	x9, x8, #1
+	str	x9, [fp, #-24]
+	mov	x8, #0
+	str	x8, [fp, #-32]
+	b	block1048584
+block1048581:
+	adrp	x8, _STR_1048597
+	add	x8, x8, :lo12:_STR_1048597
+	adrp	x8, _STR_1048598
+	add	x8, x8, :lo12:_STR_1048598
+	mov	x0, x8
+	bl	printf
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 48
+	ldp	fp, lr, [sp, #32]
+	add	sp, sp, #48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+block1048584:
+	ldr	x8, [fp, #-32]
+	sub	x9, x8, #2
+	mov	x8, #0
+	cmp	x9, x8
+	b.ge	block1048586
+block1048585:
+	ldr	x8, [fp, #-32]
+	add	x9, x8, #1
+	str	x9, [fp, #-32]
+	b	block1048584
+block1048586:
+	adrp	x8, _STR_1048588
+	add	x8, x8, :lo12:_STR_1048588
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048589
+	add	x9, x9, :lo12:_STR_1048589
+	ldr	x9, [fp, #-32]
+	adrp	x10, _STR_1048591
+	add	x10, x10, :lo12:_STR_1048591
+	mov	x0, x10
+	mov	x1, x8
+	mov	x2, x9
+	bl	printf
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #5
+	mov	x8, #0
+	cmp	x9, x8
+	b.lt	block1048579
+block1048594:
+	adrp	x8, _STR_1048595
+	add	x8, x8, :lo12:_STR_1048595
+	adrp	x8, _STR_1048596
+	add	x8, x8, :lo12:_STR_1048596
+	mov	x0, x8
+	bl	printf
+	b	block1048579
+	.cfi_endproc
+	.size	while_continue, .-while_continue
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048599
+	add	x0, x0, :lo12:_STR_1048599
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	while_continue
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048588:
+	.asciz	"a"
+_STR_1048589:
+	.asciz	"b"
+_STR_1048591:
+	.asciz	"a %lld b %lld\n"
+_STR_1048595:
+	.asciz	"unreachable"
+_STR_1048596:
+	.asciz	"unreachable\n"
+_STR_1048597:
+	.asciz	"done"
+_STR_1048598:
+	.asciz	"done\n"
+_STR_1048599:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,140 @@
+	.arch	armv8-a
+	.file	"while_test.vsl"
+	.text
+	.global	main
+	.type	main, %function
+
+	.type	while_test, %function
+while_test:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	str	x28, [sp, #0]
+	.cfi_offset	28, -32
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+block1048577:
+	mov	x8, #20
+	str	x8, [fp, #-24]
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048580
+	add	x9, x9, :lo12:_STR_1048580
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #0
+	mov	x8, #0
+	cmp	x9, x8
+	b.le	block1048586
+block1048581:
+	adrp	x8, _STR_1048584
+	add	x8, x8, :lo12:_STR_1048584
+	adrp	x8, _STR_1048585
+	add	x8, x8, :lo12:_STR_1048585
+	mov	x0, x8
+	bl	printf
+block1048586:
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #0
+	mov	x8, #0
+	cmp	x9, x8
+	b.le	block1048588
+block1048587:
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #10
+	mov	x8, #0
+	cmp	x9, x8
+	b.ne	block1048591
+	b	block1048589
+block1048588:
+	mov	x0, #0
+	.cfi_remember_state
+	ldr	x28, [sp, #0]
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+block1048589:
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #1
+	str	x9, [fp, #-24]
+	adrp	x8, _STR_1048593
+	add	x8, x8, :lo12:_STR_1048593
+	adrp	x8, _STR_1048594
+	add	x8, x8, :lo12:_STR_1048594
+	mov	x0, x8
+	bl	printf
+	b	block1048586
+block1048591:
+	ldr	x8, [fp, #-24]
+	sub	x9, x8, #1
+	str	x9, [fp, #-24]
+	ldr	x8, [fp, #-24]
+	adrp	x9, _STR_1048580
+	add	x9, x9, :lo12:_STR_1048580
+	mov	x0, x9
+	mov	x1, x8
+	bl	printf
+	b	block1048586
+	.cfi_endproc
+	.size	while_test, .-while_test
+
+main:
+	.cfi_startproc
+	sub	sp, sp, #32
+	.cfi_def_cfa_offset	32
+	stp	fp, lr, [sp, #16]
+	.cfi_offset	29, -16
+	.cfi_offset	30, -8
+	add	fp, sp, #32
+	.cfi_def_cfa	29, 0
+	stp	x1, x0, [fp, #-32]
+	ldr	x1, [fp, #-24]
+	sub	x1, x1, #1
+	cmp	x1, #0
+	b.eq	_L_argc_ok
+	adrp	x0, _STR_1048596
+	add	x0, x0, :lo12:_STR_1048596
+	bl	printf
+	mov	x0, #1
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+_L_call:
+	bl	while_test
+	.cfi_remember_state
+	.cfi_def_cfa	31, 32
+	ldp	fp, lr, [sp, #16]
+	add	sp, sp, #32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+_STR_1048580:
+	.asciz	"%lld\n"
+_STR_1048584:
+	.asciz	"foobar"
+_STR_1048585:
+	.asciz	"foobar\n"
+_STR_1048593:
+	.asciz	"Skip..."
+_STR_1048594:
+	.asciz	"Skip...\n"
+_STR_1048596:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,3795 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"aamanyfuncs.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	tester, %function
+tester:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r5, [sp, #4]
+	.cfi_offset	5, -20
+block1048588:
+	movw	r4, #10
+	str	r4, [fp, #-12]
+	movw	r4, #5
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	mov	r4, r0
+	movw	r5, #:lower16:(_STR_1048592-(1f+8))
+	movt	r5, #:upper16:(_STR_1048592-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	mov	r4, r0
+	movw	r5, #:lower16:(_STR_1048592-(1f+8))
+	movt	r5, #:upper16:(_STR_1048592-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	tester, .-tester
+
+	.align	2
+	.type	add, %function
+add:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048594:
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	add	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	add, .-add
+
+	.align	2
+	.type	sub, %function
+sub:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048596:
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	sub	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	sub, .-sub
+
+	.align	2
+	.type	mul, %function
+mul:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048597:
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mul	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	mul, .-mul
+
+	.align	2
+	.type	div, %function
+div:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048598:
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	sdiv	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	div, .-div
+
+	.align	2
+	.type	rshift, %function
+rshift:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048599:
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	lsl	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	rshift, .-rshift
+
+	.align	2
+	.type	lshift, %function
+lshift:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048600:
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	asr	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	lshift, .-lshift
+
+	.align	2
+	.type	and, %function
+and:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048601:
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	and	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	and, .-and
+
+	.align	2
+	.type	or, %function
+or:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048602:
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	orr	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	or, .-or
+
+	.align	2
+	.type	xor, %function
+xor:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048603:
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	eor	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	xor, .-xor
+
+	.align	2
+	.type	foo, %function
+foo:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+block1048604:
+	movw	r4, #50
+	str	r4, [fp, #-20]
+	mvn	r4, #1
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	movw	r4, #:lower16:(_STR_1048607-(1f+8))
+	movt	r4, #:upper16:(_STR_1048607-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048608-(1f+8))
+	movt	r4, #:upper16:(_STR_1048608-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	foo, .-foo
+
+	.align	2
+	.type	bar, %function
+bar:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+block1048609:
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	sub
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	div
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-12]
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	add
+	str	r0, [fp, #-20]
+	movw	r4, #0
+	str	r4, [fp, #-20]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-12]
+	add	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-24]
+	mul	r7, r4, r5
+	sub	r4, r6, r7
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	str	r4, [fp, #-24]
+	movw	r4, #:lower16:(_STR_1048610-(1f+8))
+	movt	r4, #:upper16:(_STR_1048610-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048611-(1f+8))
+	movt	r4, #:upper16:(_STR_1048611-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	bar, .-bar
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #0
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048612-(1f+8))
+	movt	r0, #:upper16:(_STR_1048612-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	bl	tester
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048592:
+	.asciz	"%d\n"
+_STR_1048607:
+	.asciz	"foo here"
+_STR_1048608:
+	.asciz	"foo here\n"
+_STR_1048610:
+	.asciz	"bar here"
+_STR_1048611:
+	.asciz	"bar here\n"
+_STR_1048612:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
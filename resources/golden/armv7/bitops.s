@@ -0,0 +1,320 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"bitops.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	bitwise_operators, %function
+bitwise_operators:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048577:
+	movw	r4, #:lower16:(_STR_1048578-(1f+8))
+	movt	r4, #:upper16:(_STR_1048578-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r5, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048579-(1f+8))
+	movt	r4, #:upper16:(_STR_1048579-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r6, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048581-(1f+8))
+	movt	r4, #:upper16:(_STR_1048581-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r5
+	mov	r2, r6
+	bl	printf
+	ldr	r4, [fp, #-12]
+	mvn	r5, #0
+	eor	r6, r5, r4
+	str	r6, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048583-(1f+8))
+	movt	r4, #:upper16:(_STR_1048583-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r5, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048584-(1f+8))
+	movt	r4, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r6, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048585-(1f+8))
+	movt	r4, #:upper16:(_STR_1048585-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r5
+	mov	r2, r6
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	orr	r6, r4, r5
+	str	r6, [fp, #-20]
+	ldr	r4, [fp, #-12]
+	movw	r5, #:lower16:(_STR_1048586-(1f+8))
+	movt	r5, #:upper16:(_STR_1048586-(1f+8))
+1:	add	r5, pc, r5
+	ldr	r5, [fp, #-16]
+	movw	r6, #:lower16:(_STR_1048584-(1f+8))
+	movt	r6, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r6, pc, r6
+	ldr	r6, [fp, #-20]
+	movw	r7, #:lower16:(_STR_1048587-(1f+8))
+	movt	r7, #:upper16:(_STR_1048587-(1f+8))
+1:	add	r7, pc, r7
+	mov	r0, r7
+	mov	r1, r4
+	mov	r2, r5
+	mov	r3, r6
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	eor	r6, r4, r5
+	str	r6, [fp, #-20]
+	ldr	r4, [fp, #-12]
+	movw	r5, #:lower16:(_STR_1048588-(1f+8))
+	movt	r5, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r5, pc, r5
+	ldr	r5, [fp, #-16]
+	movw	r6, #:lower16:(_STR_1048584-(1f+8))
+	movt	r6, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r6, pc, r6
+	ldr	r6, [fp, #-20]
+	movw	r7, #:lower16:(_STR_1048589-(1f+8))
+	movt	r7, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r7, pc, r7
+	mov	r0, r7
+	mov	r1, r4
+	mov	r2, r5
+	mov	r3, r6
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	and	r6, r4, r5
+	str	r6, [fp, #-20]
+	ldr	r4, [fp, #-12]
+	movw	r5, #:lower16:(_STR_1048590-(1f+8))
+	movt	r5, #:upper16:(_STR_1048590-(1f+8))
+1:	add	r5, pc, r5
+	ldr	r5, [fp, #-16]
+	movw	r6, #:lower16:(_STR_1048584-(1f+8))
+	movt	r6, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r6, pc, r6
+	ldr	r6, [fp, #-20]
+	movw	r7, #:lower16:(_STR_1048591-(1f+8))
+	movt	r7, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r7, pc, r7
+	mov	r0, r7
+	mov	r1, r4
+	mov	r2, r5
+	mov	r3, r6
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	lsl	r6, r4, r5
+	str	r6, [fp, #-20]
+	ldr	r4, [fp, #-12]
+	movw	r5, #:lower16:(_STR_1048592-(1f+8))
+	movt	r5, #:upper16:(_STR_1048592-(1f+8))
+1:	add	r5, pc, r5
+	ldr	r5, [fp, #-16]
+	movw	r6, #:lower16:(_STR_1048584-(1f+8))
+	movt	r6, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r6, pc, r6
+	ldr	r6, [fp, #-20]
+	movw	r7, #:lower16:(_STR_1048593-(1f+8))
+	movt	r7, #:upper16:(_STR_1048593-(1f+8))
+1:	add	r7, pc, r7
+	mov	r0, r7
+	mov	r1, r4
+	mov	r2, r5
+	mov	r3, r6
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	asr	r6, r4, r5
+	str	r6, [fp, #-20]
+	ldr	r4, [fp, #-12]
+	movw	r5, #:lower16:(_STR_1048594-(1f+8))
+	movt	r5, #:upper16:(_STR_1048594-(1f+8))
+1:	add	r5, pc, r5
+	ldr	r5, [fp, #-16]
+	movw	r6, #:lower16:(_STR_1048584-(1f+8))
+	movt	r6, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r6, pc, r6
+	ldr	r6, [fp, #-20]
+	movw	r7, #:lower16:(_STR_1048595-(1f+8))
+	movt	r7, #:upper16:(_STR_1048595-(1f+8))
+1:	add	r7, pc, r7
+	mov	r0, r7
+	mov	r1, r4
+	mov	r2, r5
+	mov	r3, r6
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	bitwise_operators, .-bitwise_operators
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #24
+	str	r4, [sp, #0]
+	.cfi_offset	4, -32
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #2
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048597-(1f+8))
+	movt	r0, #:upper16:(_STR_1048597-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #4]	@ Load argv[1]
+	mvn	r1, #19
+	add	r1, fp, r1
+	movw	r4, #1
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-20]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #4]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-20]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #8]	@ Load argv[2]
+	mvn	r1, #23
+	add	r1, fp, r1
+	movw	r4, #2
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-24]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #8]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-24]
+	ldr	r0, [fp, #-20]	@ Load parsed argv[1]
+	ldr	r1, [fp, #-24]	@ Load parsed argv[2]
+	bl	bitwise_operators
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argv_error:
+	movw	r0, #:lower16:(_STR_1048598-(1f+8))
+	movt	r0, #:upper16:(_STR_1048598-(1f+8))
+1:	add	r0, pc, r0
+	mov	r1, r4
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048578:
+	.asciz	"a is"
+_STR_1048579:
+	.asciz	"and b is"
+_STR_1048581:
+	.asciz	"a is %d and b is %d\n"
+_STR_1048583:
+	.asciz	"~"
+_STR_1048584:
+	.asciz	"="
+_STR_1048585:
+	.asciz	"~ %d = %d\n"
+_STR_1048586:
+	.asciz	"|"
+_STR_1048587:
+	.asciz	"%d | %d = %d\n"
+_STR_1048588:
+	.asciz	"^"
+_STR_1048589:
+	.asciz	"%d ^ %d = %d\n"
+_STR_1048590:
+	.asciz	"&"
+_STR_1048591:
+	.asciz	"%d & %d = %d\n"
+_STR_1048592:
+	.asciz	"<<"
+_STR_1048593:
+	.asciz	"%d << %d = %d\n"
+_STR_1048594:
+	.asciz	">>"
+_STR_1048595:
+	.asciz	"%d >> %d = %d\n"
+_STR_1048597:
+	.asciz	"Argument error: expected 2 arguments, got %d\n"
+_STR_1048598:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
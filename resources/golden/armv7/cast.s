@@ -0,0 +1,307 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"cast.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	casting, %function
+casting:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #48
+	str	r4, [sp, #0]
+	.cfi_offset	4, -56
+	str	r5, [sp, #4]
+	.cfi_offset	5, -52
+	str	r6, [sp, #8]
+	.cfi_offset	6, -48
+	vstr	s16, [sp, #12]
+	.cfi_offset	80, -44
+	vstr	s17, [sp, #16]
+	.cfi_offset	81, -40
+	vstr	s18, [sp, #20]
+	.cfi_offset	82, -36
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	vstr	s0, [fp, #-20]
+	vstr	s1, [fp, #-24]
+block1048578:
+	ldr	r4, [fp, #-12]
+	vldr	s16, [fp, #-20]
+	vmov	s17, r4
+	vcvt.f32.s32	s17, s17
+	vadd.f32	s18, s17, s16
+	vcvtr.s32.f32	s14, s18
+	vmov	r4, s14
+	str	r4, [fp, #-28]
+	ldr	r4, [fp, #-16]
+	vldr	s16, [fp, #-24]
+	vmov	s17, r4
+	vcvt.f32.s32	s17, s17
+	vadd.f32	s18, s17, s16
+	vstr	s18, [fp, #-32]
+	movw	r4, #3
+	str	r4, [fp, #-28]
+	movw	r4, #:lower16:(_STR_1048580-(1f+8))
+	movt	r4, #:upper16:(_STR_1048580-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r5, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048581-(1f+8))
+	movt	r4, #:upper16:(_STR_1048581-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r6, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048582-(1f+8))
+	movt	r4, #:upper16:(_STR_1048582-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s16, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048583-(1f+8))
+	movt	r4, #:upper16:(_STR_1048583-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s17, [fp, #-24]
+	movw	r4, #:lower16:(_STR_1048585-(1f+8))
+	movt	r4, #:upper16:(_STR_1048585-(1f+8))
+1:	add	r4, pc, r4
+	sub	sp, sp, #16
+	mov	r0, r4
+	mov	r1, r5
+	mov	r2, r6
+	vcvt.f64.f32	d7, s16
+	vstr	d7, [sp, #0]
+	vcvt.f64.f32	d7, s17
+	vstr	d7, [sp, #8]
+	bl	printf
+	add	sp, sp, #16
+	ldr	r4, [fp, #-28]
+	movw	lr, #:lower16:(_L_CONST_1048586-(1f+8))
+	movt	lr, #:upper16:(_L_CONST_1048586-(1f+8))
+1:	add	lr, pc, lr
+	vldr	s17, [lr]
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vmov.f32	s0, s16
+	vmov.f32	s1, s17
+	bl	foo
+	vstr	s0, [fp, #-32]
+	movw	r4, #:lower16:(_STR_1048587-(1f+8))
+	movt	r4, #:upper16:(_STR_1048587-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s16, [fp, #-32]
+	movw	r4, #:lower16:(_STR_1048588-(1f+8))
+	movt	r4, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	vcvt.f64.f32	d7, s16
+	vmov	r2, r3, d7
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	vldr	s16, [sp, #12]
+	vldr	s17, [sp, #16]
+	vldr	s18, [sp, #20]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	casting, .-casting
+
+	.align	2
+	.type	foo, %function
+foo:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	vstr	s16, [sp, #0]
+	.cfi_offset	80, -24
+	vstr	s17, [sp, #4]
+	.cfi_offset	81, -20
+	vstr	s0, [fp, #-12]
+	vstr	s1, [fp, #-16]
+block1048590:
+	vldr	s16, [fp, #-12]
+	vldr	s17, [fp, #-16]
+	vmul.f32	s0, s16, s17
+	.cfi_remember_state
+	vldr	s16, [sp, #0]
+	vldr	s17, [sp, #4]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	foo, .-foo
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #4
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048591-(1f+8))
+	movt	r0, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #4]	@ Load argv[1]
+	mvn	r1, #19
+	add	r1, fp, r1
+	movw	r4, #1
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-20]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #4]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-20]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #8]	@ Load argv[2]
+	mvn	r1, #23
+	add	r1, fp, r1
+	movw	r4, #2
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-24]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #8]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-24]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #12]	@ Load argv[3]
+	mvn	r1, #27
+	add	r1, fp, r1
+	movw	r4, #3
+	bl	strtod
+	vcvt.f32.f64	s0, d0
+	ldr	r2, [fp, #-28]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #12]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	vstr	s0, [fp, #-28]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #16]	@ Load argv[4]
+	mvn	r1, #31
+	add	r1, fp, r1
+	movw	r4, #4
+	bl	strtod
+	vcvt.f32.f64	s0, d0
+	ldr	r2, [fp, #-32]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #16]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	vstr	s0, [fp, #-32]
+	ldr	r0, [fp, #-20]	@ Load parsed argv[1]
+	ldr	r1, [fp, #-24]	@ Load parsed argv[2]
+	vldr	s0, [fp, #-28]	@ Load parsed argv[3]
+	vldr	s1, [fp, #-32]	@ Load parsed argv[4]
+	bl	casting
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argv_error:
+	movw	r0, #:lower16:(_STR_1048592-(1f+8))
+	movt	r0, #:upper16:(_STR_1048592-(1f+8))
+1:	add	r0, pc, r0
+	mov	r1, r4
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_L_CONST_1048586:
+	.word	0x3e99999a	@ 0.300000
+_STR_1048580:
+	.asciz	"a is"
+_STR_1048581:
+	.asciz	"b is"
+_STR_1048582:
+	.asciz	"c is"
+_STR_1048583:
+	.asciz	"d is"
+_STR_1048585:
+	.asciz	"a is %d b is %d c is %f d is %f\n"
+_STR_1048587:
+	.asciz	"f is"
+_STR_1048588:
+	.asciz	"f is %f\n"
+_STR_1048591:
+	.asciz	"Argument error: expected 4 arguments, got %d\n"
+_STR_1048592:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,329 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"easy.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	mainfunc, %function
+mainfunc:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #24
+	str	r4, [sp, #0]
+	.cfi_offset	4, -32
+	str	r5, [sp, #4]
+	.cfi_offset	5, -28
+	str	r0, [fp, #-12]
+block1048578:
+	movw	r4, #:lower16:(_STR_1048579-(1f+8))
+	movt	r4, #:upper16:(_STR_1048579-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048581-(1f+8))
+	movt	r4, #:upper16:(_STR_1048581-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r4, #15
+	str	r4, [fp, #-16]
+	movw	r4, #5
+	str	r4, [fp, #-24]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-24]
+	mov	r0, r4
+	mov	r1, r5
+	bl	test
+	str	r0, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048584-(1f+8))
+	movt	r4, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-20]
+	movw	r5, #:lower16:(_STR_1048585-(1f+8))
+	movt	r5, #:upper16:(_STR_1048585-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	mainfunc, .-mainfunc
+
+	.align	2
+	.type	test, %function
+test:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048587:
+	movw	r4, #:lower16:(_STR_1048588-(1f+8))
+	movt	r4, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r5, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048589-(1f+8))
+	movt	r4, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r6, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048590-(1f+8))
+	movt	r4, #:upper16:(_STR_1048590-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r5
+	mov	r2, r6
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	add	r6, r4, r5
+	str	r6, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048591-(1f+8))
+	movt	r4, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-20]
+	movw	r5, #:lower16:(_STR_1048592-(1f+8))
+	movt	r5, #:upper16:(_STR_1048592-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	sub	r6, r4, r5
+	str	r6, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048593-(1f+8))
+	movt	r4, #:upper16:(_STR_1048593-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-20]
+	movw	r5, #:lower16:(_STR_1048594-(1f+8))
+	movt	r5, #:upper16:(_STR_1048594-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mul	r6, r4, r5
+	str	r6, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048595-(1f+8))
+	movt	r4, #:upper16:(_STR_1048595-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-20]
+	movw	r5, #:lower16:(_STR_1048596-(1f+8))
+	movt	r5, #:upper16:(_STR_1048596-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	sdiv	r6, r4, r5
+	str	r6, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048597-(1f+8))
+	movt	r4, #:upper16:(_STR_1048597-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-20]
+	movw	r5, #:lower16:(_STR_1048598-(1f+8))
+	movt	r5, #:upper16:(_STR_1048598-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048599-(1f+8))
+	movt	r4, #:upper16:(_STR_1048599-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-20]
+	movw	r5, #0
+	sub	r6, r5, r4
+	movw	r4, #:lower16:(_STR_1048600-(1f+8))
+	movt	r4, #:upper16:(_STR_1048600-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r6
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048601-(1f+8))
+	movt	r4, #:upper16:(_STR_1048601-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-12]
+	mul	r6, r4, r5
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mul	r7, r4, r5
+	add	r4, r6, r7
+	movw	r5, #:lower16:(_STR_1048602-(1f+8))
+	movt	r5, #:upper16:(_STR_1048602-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	sub	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	test, .-test
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #1
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048603-(1f+8))
+	movt	r0, #:upper16:(_STR_1048603-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #4]	@ Load argv[1]
+	mvn	r1, #19
+	add	r1, fp, r1
+	movw	r4, #1
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-20]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #4]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-20]
+	ldr	r0, [fp, #-20]	@ Load parsed argv[1]
+	bl	mainfunc
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argv_error:
+	movw	r0, #:lower16:(_STR_1048604-(1f+8))
+	movt	r0, #:upper16:(_STR_1048604-(1f+8))
+1:	add	r0, pc, r0
+	mov	r1, r4
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048579:
+	.asciz	"Testing plain call/return and expression evaluation"
+_STR_1048581:
+	.asciz	"Testing plain call/return and expression evaluation\n"
+_STR_1048584:
+	.asciz	"The deftion returned y:="
+_STR_1048585:
+	.asciz	"The deftion returned y:= %d\n"
+_STR_1048588:
+	.asciz	"My parameters are a:="
+_STR_1048589:
+	.asciz	"and b:="
+_STR_1048590:
+	.asciz	"My parameters are a:= %d and b:= %d\n"
+_STR_1048591:
+	.asciz	"Their sum is c:="
+_STR_1048592:
+	.asciz	"Their sum is c:= %d\n"
+_STR_1048593:
+	.asciz	"Their difference is c:="
+_STR_1048594:
+	.asciz	"Their difference is c:= %d\n"
+_STR_1048595:
+	.asciz	"Their product is c:="
+_STR_1048596:
+	.asciz	"Their product is c:= %d\n"
+_STR_1048597:
+	.asciz	"Their ratio is c:="
+_STR_1048598:
+	.asciz	"Their ratio is c:= %d\n"
+_STR_1048599:
+	.asciz	"(-c):="
+_STR_1048600:
+	.asciz	"(-c):= %d\n"
+_STR_1048601:
+	.asciz	"The sum of their squares is "
+_STR_1048602:
+	.asciz	"The sum of their squares is  %d\n"
+_STR_1048603:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048604:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
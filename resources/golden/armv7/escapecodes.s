@@ -0,0 +1,173 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"escapecodes.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	escapecodes, %function
+escapecodes:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+block1048577:
+	movw	r4, #:lower16:(_STR_1048578-(1f+8))
+	movt	r4, #:upper16:(_STR_1048578-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048579-(1f+8))
+	movt	r4, #:upper16:(_STR_1048579-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048581-(1f+8))
+	movt	r4, #:upper16:(_STR_1048581-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r4, #64
+	str	r4, [fp, #-12]
+	movw	r4, #42
+	str	r4, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048584-(1f+8))
+	movt	r4, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048585-(1f+8))
+	movt	r4, #:upper16:(_STR_1048585-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048586-(1f+8))
+	movt	r4, #:upper16:(_STR_1048586-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048587-(1f+8))
+	movt	r4, #:upper16:(_STR_1048587-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048588-(1f+8))
+	movt	r4, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048589-(1f+8))
+	movt	r4, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048590-(1f+8))
+	movt	r4, #:upper16:(_STR_1048590-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048591-(1f+8))
+	movt	r4, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048592-(1f+8))
+	movt	r4, #:upper16:(_STR_1048592-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048593-(1f+8))
+	movt	r4, #:upper16:(_STR_1048593-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048594-(1f+8))
+	movt	r4, #:upper16:(_STR_1048594-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	escapecodes, .-escapecodes
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #0
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048596-(1f+8))
+	movt	r0, #:upper16:(_STR_1048596-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	bl	escapecodes
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048578:
+	.asciz	"Testing if printf format codes are left alone."
+_STR_1048579:
+	.asciz	"\\nOutput *should* contain percent characters, but no integers."
+_STR_1048581:
+	.asciz	"Testing if printf format codes are left alone. \\nOutput *should* contain percent characters, but no integers.\n"
+_STR_1048584:
+	.asciz	"\\tHello, world! %d %d"
+_STR_1048585:
+	.asciz	"\\tHello, world! %%d %%d\n"
+_STR_1048586:
+	.asciz	"Adding a splash of ANSI color codes - This will only work in a color terminal"
+_STR_1048587:
+	.asciz	"Adding a splash of ANSI color codes - This will only work in a color terminal\n"
+_STR_1048588:
+	.asciz	"\\t\\033[31mRed"
+_STR_1048589:
+	.asciz	"\\t\\033[31mRed\n"
+_STR_1048590:
+	.asciz	"\\t\\033[32mGreen"
+_STR_1048591:
+	.asciz	"\\t\\033[32mGreen\n"
+_STR_1048592:
+	.asciz	"\\t\\033[34mBlue"
+_STR_1048593:
+	.asciz	"\\033[0m"
+_STR_1048594:
+	.asciz	"\\t\\033[34mBlue \\033[0m\n"
+_STR_1048596:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
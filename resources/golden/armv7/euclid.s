@@ -0,0 +1,307 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"euclid.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	euclid, %function
+euclid:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048578:
+	ldr	r4, [fp, #-12]
+	movw	r5, #0
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	bge	block1048581
+block1048579:
+	ldr	r4, [fp, #-12]
+	movw	r5, #0
+	sub	r6, r5, r4
+	str	r6, [fp, #-12]
+block1048581:
+	ldr	r4, [fp, #-16]
+	movw	r5, #0
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	bge	block1048583
+block1048582:
+	ldr	r4, [fp, #-16]
+	movw	r5, #0
+	sub	r6, r5, r4
+	str	r6, [fp, #-16]
+block1048583:
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	gcd
+	movw	r4, #1
+	sub	r5, r0, r4
+	movw	r4, #0
+	cmp	r5, r4
+	ble	block1048586
+block1048584:
+	movw	r4, #:lower16:(_STR_1048587-(1f+8))
+	movt	r4, #:upper16:(_STR_1048587-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048588-(1f+8))
+	movt	r4, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-16]
+	str	r4, [fp, #-24]
+	movw	r4, #:lower16:(_STR_1048589-(1f+8))
+	movt	r4, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	gcd
+	mov	r4, r0
+	movw	r7, #:lower16:(_STR_1048591-(1f+8))
+	movt	r7, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r7, pc, r7
+	ldr	r5, [fp, #-20]
+	ldr	r6, [fp, #-24]
+	mov	r0, r7
+	mov	r1, r5
+	mov	r2, r6
+	mov	r3, r4
+	bl	printf
+	b	block1048592
+block1048586:
+	ldr	r5, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048588-(1f+8))
+	movt	r4, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r6, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048593-(1f+8))
+	movt	r4, #:upper16:(_STR_1048593-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048594-(1f+8))
+	movt	r4, #:upper16:(_STR_1048594-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r5
+	mov	r2, r6
+	bl	printf
+block1048592:
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	euclid, .-euclid
+
+	.align	2
+	.type	gcd, %function
+gcd:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r8, [sp, #16]
+	.cfi_offset	8, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048595:
+	ldr	r4, [fp, #-16]
+	movw	r5, #0
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	ble	block1048597
+block1048596:
+	ldr	r5, [fp, #-16]
+	ldr	r8, [fp, #-12]
+	ldr	r4, [fp, #-12]
+	ldr	r6, [fp, #-16]
+	sdiv	r7, r4, r6
+	ldr	r4, [fp, #-16]
+	mul	r6, r7, r4
+	sub	r4, r8, r6
+	mov	r0, r5
+	mov	r1, r4
+	bl	gcd
+	str	r0, [fp, #-20]
+	b	block1048598
+block1048597:
+	ldr	r4, [fp, #-12]
+	str	r4, [fp, #-20]
+block1048598:
+	ldr	r0, [fp, #-20]
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	ldr	r8, [sp, #16]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	gcd, .-gcd
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #24
+	str	r4, [sp, #0]
+	.cfi_offset	4, -32
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #2
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048599-(1f+8))
+	movt	r0, #:upper16:(_STR_1048599-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #4]	@ Load argv[1]
+	mvn	r1, #19
+	add	r1, fp, r1
+	movw	r4, #1
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-20]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #4]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-20]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #8]	@ Load argv[2]
+	mvn	r1, #23
+	add	r1, fp, r1
+	movw	r4, #2
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-24]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #8]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-24]
+	ldr	r0, [fp, #-20]	@ Load parsed argv[1]
+	ldr	r1, [fp, #-24]	@ Load parsed argv[2]
+	bl	euclid
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argv_error:
+	movw	r0, #:lower16:(_STR_1048600-(1f+8))
+	movt	r0, #:upper16:(_STR_1048600-(1f+8))
+1:	add	r0, pc, r0
+	mov	r1, r4
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048587:
+	.asciz	"Greatest common divisor of"
+_STR_1048588:
+	.asciz	"and"
+_STR_1048589:
+	.asciz	"is"
+_STR_1048591:
+	.asciz	"Greatest common divisor of %d and %d is %d\n"
+_STR_1048593:
+	.asciz	"are relative primes"
+_STR_1048594:
+	.asciz	"%d and %d are relative primes\n"
+_STR_1048599:
+	.asciz	"Argument error: expected 2 arguments, got %d\n"
+_STR_1048600:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
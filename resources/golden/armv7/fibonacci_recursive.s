@@ -0,0 +1,214 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"fibonacci_recursive.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	fibonacci_recursive, %function
+fibonacci_recursive:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #24
+	str	r4, [sp, #0]
+	.cfi_offset	4, -32
+	str	r5, [sp, #4]
+	.cfi_offset	5, -28
+	str	r6, [sp, #8]
+	.cfi_offset	6, -24
+	str	r0, [fp, #-12]
+block1048578:
+	ldr	r4, [fp, #-12]
+	mov	r0, r4
+	bl	fibonacci_number
+	str	r0, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048579-(1f+8))
+	movt	r4, #:upper16:(_STR_1048579-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r5, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048580-(1f+8))
+	movt	r4, #:upper16:(_STR_1048580-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r6, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048582-(1f+8))
+	movt	r4, #:upper16:(_STR_1048582-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r5
+	mov	r2, r6
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	fibonacci_recursive, .-fibonacci_recursive
+
+	.align	2
+	.type	fibonacci_number, %function
+fibonacci_number:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #24
+	str	r4, [sp, #0]
+	.cfi_offset	4, -32
+	str	r5, [sp, #4]
+	.cfi_offset	5, -28
+	str	r6, [sp, #8]
+	.cfi_offset	6, -24
+	str	r0, [fp, #-12]
+block1048584:
+	movw	r4, #0
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	movw	r5, #2
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	ble	block1048587
+block1048585:
+	ldr	r4, [fp, #-12]
+	movw	r6, #1
+	sub	r5, r4, r6
+	mov	r0, r5
+	bl	fibonacci_number
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-12]
+	movw	r6, #2
+	sub	r5, r4, r6
+	mov	r0, r5
+	bl	fibonacci_number
+	ldr	r4, [fp, #-20]
+	add	r5, r4, r0
+	str	r5, [fp, #-16]
+	b	block1048589
+block1048587:
+	movw	r4, #1
+	str	r4, [fp, #-16]
+block1048589:
+	ldr	r0, [fp, #-16]
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	fibonacci_number, .-fibonacci_number
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #1
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048590-(1f+8))
+	movt	r0, #:upper16:(_STR_1048590-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #4]	@ Load argv[1]
+	mvn	r1, #19
+	add	r1, fp, r1
+	movw	r4, #1
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-20]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #4]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-20]
+	ldr	r0, [fp, #-20]	@ Load parsed argv[1]
+	bl	fibonacci_recursive
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argv_error:
+	movw	r0, #:lower16:(_STR_1048591-(1f+8))
+	movt	r0, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r0, pc, r0
+	mov	r1, r4
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048579:
+	.asciz	"Fibonacci number #"
+_STR_1048580:
+	.asciz	"is"
+_STR_1048582:
+	.asciz	"Fibonacci number # %d is %d\n"
+_STR_1048590:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048591:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
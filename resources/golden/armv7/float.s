@@ -0,0 +1,308 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"float.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	float_test, %function
+float_test:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	vstr	s16, [sp, #4]
+	.cfi_offset	80, -36
+	vstr	s17, [sp, #8]
+	.cfi_offset	81, -32
+	vstr	s18, [sp, #12]
+	.cfi_offset	82, -28
+	vstr	s0, [fp, #-12]
+	vstr	s1, [fp, #-16]
+block1048577:
+	vldr	s16, [fp, #-12]
+	vldr	s17, [fp, #-16]
+	vadd.f32	s18, s16, s17
+	vstr	s18, [fp, #-20]
+	vldr	s18, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048578-(1f+8))
+	movt	r4, #:upper16:(_STR_1048578-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s16, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048579-(1f+8))
+	movt	r4, #:upper16:(_STR_1048579-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s17, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048581-(1f+8))
+	movt	r4, #:upper16:(_STR_1048581-(1f+8))
+1:	add	r4, pc, r4
+	sub	sp, sp, #16
+	mov	r0, r4
+	vcvt.f64.f32	d7, s18
+	vmov	r2, r3, d7
+	vcvt.f64.f32	d7, s16
+	vstr	d7, [sp, #0]
+	vcvt.f64.f32	d7, s17
+	vstr	d7, [sp, #8]
+	bl	printf
+	add	sp, sp, #16
+	vldr	s16, [fp, #-12]
+	vldr	s17, [fp, #-16]
+	vsub.f32	s18, s16, s17
+	vstr	s18, [fp, #-20]
+	vldr	s18, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048582-(1f+8))
+	movt	r4, #:upper16:(_STR_1048582-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s16, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048579-(1f+8))
+	movt	r4, #:upper16:(_STR_1048579-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s17, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048583-(1f+8))
+	movt	r4, #:upper16:(_STR_1048583-(1f+8))
+1:	add	r4, pc, r4
+	sub	sp, sp, #16
+	mov	r0, r4
+	vcvt.f64.f32	d7, s18
+	vmov	r2, r3, d7
+	vcvt.f64.f32	d7, s16
+	vstr	d7, [sp, #0]
+	vcvt.f64.f32	d7, s17
+	vstr	d7, [sp, #8]
+	bl	printf
+	add	sp, sp, #16
+	vldr	s16, [fp, #-12]
+	vldr	s17, [fp, #-16]
+	vmul.f32	s18, s16, s17
+	vstr	s18, [fp, #-20]
+	vldr	s18, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048584-(1f+8))
+	movt	r4, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s16, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048579-(1f+8))
+	movt	r4, #:upper16:(_STR_1048579-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s17, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048585-(1f+8))
+	movt	r4, #:upper16:(_STR_1048585-(1f+8))
+1:	add	r4, pc, r4
+	sub	sp, sp, #16
+	mov	r0, r4
+	vcvt.f64.f32	d7, s18
+	vmov	r2, r3, d7
+	vcvt.f64.f32	d7, s16
+	vstr	d7, [sp, #0]
+	vcvt.f64.f32	d7, s17
+	vstr	d7, [sp, #8]
+	bl	printf
+	add	sp, sp, #16
+	vldr	s16, [fp, #-12]
+	vldr	s17, [fp, #-16]
+	vdiv.f32	s18, s16, s17
+	vstr	s18, [fp, #-20]
+	vldr	s18, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048586-(1f+8))
+	movt	r4, #:upper16:(_STR_1048586-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s16, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048579-(1f+8))
+	movt	r4, #:upper16:(_STR_1048579-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s17, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048587-(1f+8))
+	movt	r4, #:upper16:(_STR_1048587-(1f+8))
+1:	add	r4, pc, r4
+	sub	sp, sp, #16
+	mov	r0, r4
+	vcvt.f64.f32	d7, s18
+	vmov	r2, r3, d7
+	vcvt.f64.f32	d7, s16
+	vstr	d7, [sp, #0]
+	vcvt.f64.f32	d7, s17
+	vstr	d7, [sp, #8]
+	bl	printf
+	add	sp, sp, #16
+	movw	lr, #:lower16:(_L_CONST_1048588-(1f+8))
+	movt	lr, #:upper16:(_L_CONST_1048588-(1f+8))
+1:	add	lr, pc, lr
+	vldr	s16, [lr]
+	vstr	s16, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048589-(1f+8))
+	movt	r4, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s16, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048590-(1f+8))
+	movt	r4, #:upper16:(_STR_1048590-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	vcvt.f64.f32	d7, s16
+	vmov	r2, r3, d7
+	bl	printf
+	movw	r4, #1
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vstr	s16, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048589-(1f+8))
+	movt	r4, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r4, pc, r4
+	vldr	s16, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048590-(1f+8))
+	movt	r4, #:upper16:(_STR_1048590-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	vcvt.f64.f32	d7, s16
+	vmov	r2, r3, d7
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	vldr	s16, [sp, #4]
+	vldr	s17, [sp, #8]
+	vldr	s18, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	float_test, .-float_test
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #24
+	str	r4, [sp, #0]
+	.cfi_offset	4, -32
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #2
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048593-(1f+8))
+	movt	r0, #:upper16:(_STR_1048593-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #4]	@ Load argv[1]
+	mvn	r1, #19
+	add	r1, fp, r1
+	movw	r4, #1
+	bl	strtod
+	vcvt.f32.f64	s0, d0
+	ldr	r2, [fp, #-20]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #4]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	vstr	s0, [fp, #-20]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #8]	@ Load argv[2]
+	mvn	r1, #23
+	add	r1, fp, r1
+	movw	r4, #2
+	bl	strtod
+	vcvt.f32.f64	s0, d0
+	ldr	r2, [fp, #-24]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #8]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	vstr	s0, [fp, #-24]
+	vldr	s0, [fp, #-20]	@ Load parsed argv[1]
+	vldr	s1, [fp, #-24]	@ Load parsed argv[2]
+	bl	float_test
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argv_error:
+	movw	r0, #:lower16:(_STR_1048594-(1f+8))
+	movt	r0, #:upper16:(_STR_1048594-(1f+8))
+1:	add	r0, pc, r0
+	mov	r1, r4
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_L_CONST_1048588:
+	.word	0x401ccccd	@ 2.450000
+_STR_1048578:
+	.asciz	"+"
+_STR_1048579:
+	.asciz	"="
+_STR_1048581:
+	.asciz	"%f + %f = %f\n"
+_STR_1048582:
+	.asciz	"-"
+_STR_1048583:
+	.asciz	"%f - %f = %f\n"
+_STR_1048584:
+	.asciz	"*"
+_STR_1048585:
+	.asciz	"%f * %f = %f\n"
+_STR_1048586:
+	.asciz	"/"
+_STR_1048587:
+	.asciz	"%f / %f = %f\n"
+_STR_1048589:
+	.asciz	"c is now a constant: "
+_STR_1048590:
+	.asciz	"c is now a constant:  %f\n"
+_STR_1048593:
+	.asciz	"Argument error: expected 2 arguments, got %d\n"
+_STR_1048594:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,264 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"funcall.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	defall, %function
+defall:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #24
+	str	r4, [sp, #0]
+	.cfi_offset	4, -32
+	str	r5, [sp, #4]
+	.cfi_offset	5, -28
+	str	r6, [sp, #8]
+	.cfi_offset	6, -24
+block1048579:
+	movw	r4, #5
+	str	r4, [fp, #-12]
+	movw	r4, #10
+	str	r4, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048582-(1f+8))
+	movt	r4, #:upper16:(_STR_1048582-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-12]
+	ldr	r6, [fp, #-16]
+	movw	r5, #:lower16:(_STR_1048584-(1f+8))
+	movt	r5, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	mov	r2, r6
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mov	r0, r4
+	mov	r1, r5
+	bl	my_deftion
+	str	r0, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048585-(1f+8))
+	movt	r4, #:upper16:(_STR_1048585-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-20]
+	movw	r5, #:lower16:(_STR_1048586-(1f+8))
+	movt	r5, #:upper16:(_STR_1048586-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	bl	my_other_deftion
+	str	r0, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048587-(1f+8))
+	movt	r4, #:upper16:(_STR_1048587-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-20]
+	movw	r5, #:lower16:(_STR_1048588-(1f+8))
+	movt	r5, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	defall, .-defall
+
+	.align	2
+	.type	my_deftion, %function
+my_deftion:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048590:
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-12]
+	mul	r6, r4, r5
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mul	r7, r4, r5
+	add	r4, r6, r7
+	str	r4, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048591-(1f+8))
+	movt	r4, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-12]
+	movw	r5, #:lower16:(_STR_1048592-(1f+8))
+	movt	r5, #:upper16:(_STR_1048592-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048593-(1f+8))
+	movt	r4, #:upper16:(_STR_1048593-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-16]
+	movw	r5, #:lower16:(_STR_1048594-(1f+8))
+	movt	r5, #:upper16:(_STR_1048594-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048595-(1f+8))
+	movt	r4, #:upper16:(_STR_1048595-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-20]
+	movw	r5, #:lower16:(_STR_1048596-(1f+8))
+	movt	r5, #:upper16:(_STR_1048596-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	ldr	r0, [fp, #-20]
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	my_deftion, .-my_deftion
+
+	.align	2
+	.type	my_other_deftion, %function
+my_other_deftion:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #8
+	str	r4, [sp, #0]
+	.cfi_offset	4, -16
+block1048597:
+	movw	r4, #42
+	str	r4, [fp, #-12]
+	ldr	r0, [fp, #-12]
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	my_other_deftion, .-my_other_deftion
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #0
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048599-(1f+8))
+	movt	r0, #:upper16:(_STR_1048599-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	bl	defall
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048582:
+	.asciz	"Calling my_deftion with parameters"
+_STR_1048584:
+	.asciz	"Calling my_deftion with parameters %d %d\n"
+_STR_1048585:
+	.asciz	"The returned result is"
+_STR_1048586:
+	.asciz	"The returned result is %d\n"
+_STR_1048587:
+	.asciz	"The other returned result is"
+_STR_1048588:
+	.asciz	"The other returned result is %d\n"
+_STR_1048591:
+	.asciz	"Parameter s is"
+_STR_1048592:
+	.asciz	"Parameter s is %d\n"
+_STR_1048593:
+	.asciz	"Parameter t is"
+_STR_1048594:
+	.asciz	"Parameter t is %d\n"
+_STR_1048595:
+	.asciz	"The sum of their squares is"
+_STR_1048596:
+	.asciz	"The sum of their squares is %d\n"
+_STR_1048599:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,197 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"if2.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	test, %function
+test:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r5, [sp, #4]
+	.cfi_offset	5, -20
+	str	r6, [sp, #8]
+	.cfi_offset	6, -16
+	str	r0, [fp, #-12]
+block1048577:
+	ldr	r4, [fp, #-12]
+	movw	r5, #:lower16:(_STR_1048579-(1f+8))
+	movt	r5, #:upper16:(_STR_1048579-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	ldr	r4, [fp, #-12]
+	movw	r5, #10
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	ble	block1048582
+block1048580:
+	movw	r4, #:lower16:(_STR_1048584-(1f+8))
+	movt	r4, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048585-(1f+8))
+	movt	r4, #:upper16:(_STR_1048585-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+block1048582:
+	ldr	r4, [fp, #-12]
+	movw	r5, #10
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	bge	block1048587
+block1048586:
+	movw	r4, #:lower16:(_STR_1048588-(1f+8))
+	movt	r4, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048589-(1f+8))
+	movt	r4, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+block1048587:
+	ldr	r4, [fp, #-12]
+	movw	r5, #10
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	bne	block1048591
+block1048590:
+	movw	r4, #:lower16:(_STR_1048592-(1f+8))
+	movt	r4, #:upper16:(_STR_1048592-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048593-(1f+8))
+	movt	r4, #:upper16:(_STR_1048593-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+block1048591:
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	test, .-test
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #1
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048594-(1f+8))
+	movt	r0, #:upper16:(_STR_1048594-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #4]	@ Load argv[1]
+	mvn	r1, #19
+	add	r1, fp, r1
+	movw	r4, #1
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-20]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #4]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-20]
+	ldr	r0, [fp, #-20]	@ Load parsed argv[1]
+	bl	test
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argv_error:
+	movw	r0, #:lower16:(_STR_1048595-(1f+8))
+	movt	r0, #:upper16:(_STR_1048595-(1f+8))
+1:	add	r0, pc, r0
+	mov	r1, r4
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048579:
+	.asciz	"%d\n"
+_STR_1048584:
+	.asciz	"Bigger"
+_STR_1048585:
+	.asciz	"Bigger\n"
+_STR_1048588:
+	.asciz	"Smaller"
+_STR_1048589:
+	.asciz	"Smaller\n"
+_STR_1048592:
+	.asciz	"Equal"
+_STR_1048593:
+	.asciz	"Equal\n"
+_STR_1048594:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048595:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
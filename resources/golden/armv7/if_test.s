@@ -0,0 +1,169 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"if_test.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	if_test, %function
+if_test:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #24
+	str	r4, [sp, #0]
+	.cfi_offset	4, -32
+	str	r5, [sp, #4]
+	.cfi_offset	5, -28
+	str	r6, [sp, #8]
+	.cfi_offset	6, -24
+block1048577:
+	movw	r4, #10
+	str	r4, [fp, #-12]
+	mvn	r4, #14
+	str	r4, [fp, #-16]
+	ldr	r4, [fp, #-12]
+	movw	r5, #:lower16:(_STR_1048581-(1f+8))
+	movt	r5, #:upper16:(_STR_1048581-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	ldr	r4, [fp, #-12]
+	movw	r5, #10
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	bne	block1048583
+block1048582:
+	movw	r4, #:lower16:(_STR_1048585-(1f+8))
+	movt	r4, #:upper16:(_STR_1048585-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048586-(1f+8))
+	movt	r4, #:upper16:(_STR_1048586-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+block1048583:
+	ldr	r4, [fp, #-12]
+	movw	r5, #0
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	ble	block1048588
+block1048587:
+	ldr	r4, [fp, #-16]
+	mvn	r5, #14
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	bgt	block1048589
+	b	block1048590
+block1048588:
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+block1048589:
+	movw	r4, #:lower16:(_STR_1048591-(1f+8))
+	movt	r4, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048592-(1f+8))
+	movt	r4, #:upper16:(_STR_1048592-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	b	block1048588
+block1048590:
+	movw	r4, #:lower16:(_STR_1048594-(1f+8))
+	movt	r4, #:upper16:(_STR_1048594-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048595-(1f+8))
+	movt	r4, #:upper16:(_STR_1048595-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	b	block1048588
+	.cfi_endproc
+	.size	if_test, .-if_test
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #0
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048596-(1f+8))
+	movt	r0, #:upper16:(_STR_1048596-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	bl	if_test
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048581:
+	.asciz	"%d\n"
+_STR_1048585:
+	.asciz	"A equals 10"
+_STR_1048586:
+	.asciz	"A equals 10\n"
+_STR_1048591:
+	.asciz	"B is greater than -15"
+_STR_1048592:
+	.asciz	"B is greater than -15\n"
+_STR_1048594:
+	.asciz	"B is smaller than or equal to -15"
+_STR_1048595:
+	.asciz	"B is smaller than or equal to -15\n"
+_STR_1048596:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,216 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"locals.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	nesting_scopes, %function
+nesting_scopes:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #56
+	str	r4, [sp, #0]
+	.cfi_offset	4, -64
+	str	r5, [sp, #4]
+	.cfi_offset	5, -60
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	str	r2, [fp, #-20]
+block1048580:
+	movw	r4, #21
+	str	r4, [fp, #-24]
+	movw	r4, #42
+	str	r4, [fp, #-48]
+	movw	r4, #:lower16:(_STR_1048583-(1f+8))
+	movt	r4, #:upper16:(_STR_1048583-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-48]
+	movw	r5, #:lower16:(_STR_1048585-(1f+8))
+	movt	r5, #:upper16:(_STR_1048585-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048586-(1f+8))
+	movt	r4, #:upper16:(_STR_1048586-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-24]
+	movw	r5, #:lower16:(_STR_1048587-(1f+8))
+	movt	r5, #:upper16:(_STR_1048587-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048588-(1f+8))
+	movt	r4, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r4, pc, r4
+	movw	lr, #:lower16:(k-(1f+8))
+	movt	lr, #:upper16:(k-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r4, [lr]
+	movw	r5, #:lower16:(_STR_1048589-(1f+8))
+	movt	r5, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	nesting_scopes, .-nesting_scopes
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #24
+	str	r4, [sp, #0]
+	.cfi_offset	4, -32
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #3
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048591-(1f+8))
+	movt	r0, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #4]	@ Load argv[1]
+	mvn	r1, #19
+	add	r1, fp, r1
+	movw	r4, #1
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-20]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #4]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-20]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #8]	@ Load argv[2]
+	mvn	r1, #23
+	add	r1, fp, r1
+	movw	r4, #2
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-24]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #8]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-24]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #12]	@ Load argv[3]
+	mvn	r1, #27
+	add	r1, fp, r1
+	movw	r4, #3
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-28]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #12]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-28]
+	ldr	r0, [fp, #-20]	@ Load parsed argv[1]
+	ldr	r1, [fp, #-24]	@ Load parsed argv[2]
+	ldr	r2, [fp, #-28]	@ Load parsed argv[3]
+	bl	nesting_scopes
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argv_error:
+	movw	r0, #:lower16:(_STR_1048592-(1f+8))
+	movt	r0, #:upper16:(_STR_1048592-(1f+8))
+1:	add	r0, pc, r0
+	mov	r1, r4
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+i:
+	.word	0x0
+j:
+	.word	0x0
+k:
+	.word	0x0
+_STR_1048583:
+	.asciz	"Inner a is "
+_STR_1048585:
+	.asciz	"Inner a is  %d\n"
+_STR_1048586:
+	.asciz	"Outer a is "
+_STR_1048587:
+	.asciz	"Outer a is  %d\n"
+_STR_1048588:
+	.asciz	"Global k is "
+_STR_1048589:
+	.asciz	"Global k is  %d\n"
+_STR_1048591:
+	.asciz	"Argument error: expected 3 arguments, got %d\n"
+_STR_1048592:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,552 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"multi_hello.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	hello, %function
+hello:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #64
+	str	r4, [sp, #0]
+	.cfi_offset	4, -72
+	str	r5, [sp, #4]
+	.cfi_offset	5, -68
+	str	r6, [sp, #8]
+	.cfi_offset	6, -64
+	str	r7, [sp, #12]
+	.cfi_offset	7, -60
+	str	r8, [sp, #16]
+	.cfi_offset	8, -56
+	str	r9, [sp, #20]
+	.cfi_offset	9, -52
+	str	r10, [sp, #24]
+	.cfi_offset	10, -48
+	vstr	s16, [sp, #28]
+	.cfi_offset	80, -44
+	vstr	s17, [sp, #32]
+	.cfi_offset	81, -40
+	vstr	s18, [sp, #36]
+	.cfi_offset	82, -36
+	vstr	s19, [sp, #40]
+	.cfi_offset	83, -32
+	vstr	s20, [sp, #44]
+	.cfi_offset	84, -28
+	vstr	s21, [sp, #48]
+	.cfi_offset	85, -24
+	vstr	s22, [sp, #52]
+	.cfi_offset	86, -20
+	vstr	s23, [sp, #56]
+	.cfi_offset	87, -16
+block1048583:
+	movw	r4, #:lower16:(_STR_1048584-(1f+8))
+	movt	r4, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048585-(1f+8))
+	movt	r4, #:upper16:(_STR_1048585-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048587-(1f+8))
+	movt	r4, #:upper16:(_STR_1048587-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048588-(1f+8))
+	movt	r4, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048589-(1f+8))
+	movt	r4, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048590-(1f+8))
+	movt	r4, #:upper16:(_STR_1048590-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048591-(1f+8))
+	movt	r4, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r4, #42
+	movw	r5, #43
+	movw	r6, #44
+	movw	r7, #:lower16:(_STR_1048595-(1f+8))
+	movt	r7, #:upper16:(_STR_1048595-(1f+8))
+1:	add	r7, pc, r7
+	mov	r0, r7
+	mov	r1, r4
+	mov	r2, r5
+	mov	r3, r6
+	bl	printf
+	movw	r4, #30
+	movw	lr, #:lower16:(x-(1f+8))
+	movt	lr, #:upper16:(x-(1f+8))
+1:	add	lr, pc, lr
+	str	r4, [lr]
+	mvn	r4, #14
+	movw	lr, #:lower16:(y-(1f+8))
+	movt	lr, #:upper16:(y-(1f+8))
+1:	add	lr, pc, lr
+	str	r4, [lr]
+	movw	lr, #:lower16:(x-(1f+8))
+	movt	lr, #:upper16:(x-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r4, [lr]
+	movw	lr, #:lower16:(x-(1f+8))
+	movt	lr, #:upper16:(x-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r5, [lr]
+	mul	r6, r4, r5
+	movw	lr, #:lower16:(y-(1f+8))
+	movt	lr, #:upper16:(y-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r4, [lr]
+	movw	lr, #:lower16:(y-(1f+8))
+	movt	lr, #:upper16:(y-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r5, [lr]
+	mul	r7, r4, r5
+	add	r4, r6, r7
+	movw	lr, #:lower16:(z-(1f+8))
+	movt	lr, #:upper16:(z-(1f+8))
+1:	add	lr, pc, lr
+	str	r4, [lr]
+	movw	r4, #:lower16:(_STR_1048598-(1f+8))
+	movt	r4, #:upper16:(_STR_1048598-(1f+8))
+1:	add	r4, pc, r4
+	movw	lr, #:lower16:(x-(1f+8))
+	movt	lr, #:upper16:(x-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r4, [lr]
+	movw	lr, #:lower16:(y-(1f+8))
+	movt	lr, #:upper16:(y-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r5, [lr]
+	add	r6, r4, r5
+	movw	r4, #:lower16:(_STR_1048599-(1f+8))
+	movt	r4, #:upper16:(_STR_1048599-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r6
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048600-(1f+8))
+	movt	r4, #:upper16:(_STR_1048600-(1f+8))
+1:	add	r4, pc, r4
+	movw	lr, #:lower16:(x-(1f+8))
+	movt	lr, #:upper16:(x-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r4, [lr]
+	movw	lr, #:lower16:(y-(1f+8))
+	movt	lr, #:upper16:(y-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r5, [lr]
+	sub	r6, r4, r5
+	movw	r4, #:lower16:(_STR_1048601-(1f+8))
+	movt	r4, #:upper16:(_STR_1048601-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r6
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048602-(1f+8))
+	movt	r4, #:upper16:(_STR_1048602-(1f+8))
+1:	add	r4, pc, r4
+	movw	lr, #:lower16:(x-(1f+8))
+	movt	lr, #:upper16:(x-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r4, [lr]
+	movw	lr, #:lower16:(y-(1f+8))
+	movt	lr, #:upper16:(y-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r5, [lr]
+	mul	r6, r4, r5
+	movw	r4, #:lower16:(_STR_1048603-(1f+8))
+	movt	r4, #:upper16:(_STR_1048603-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r6
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048604-(1f+8))
+	movt	r4, #:upper16:(_STR_1048604-(1f+8))
+1:	add	r4, pc, r4
+	movw	lr, #:lower16:(x-(1f+8))
+	movt	lr, #:upper16:(x-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r4, [lr]
+	movw	lr, #:lower16:(y-(1f+8))
+	movt	lr, #:upper16:(y-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r5, [lr]
+	sdiv	r6, r4, r5
+	movw	r4, #:lower16:(_STR_1048605-(1f+8))
+	movt	r4, #:upper16:(_STR_1048605-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r6
+	bl	printf
+	movw	ip, #1
+	str	ip, [fp, #-12]
+	movw	r4, #2
+	movw	r5, #3
+	movw	r6, #4
+	movw	r7, #5
+	movw	r8, #6
+	movw	r9, #7
+	movw	r10, #8
+	ldr	ip, [fp, #-12]
+	vmov	s23, ip
+	vcvt.f32.s32	s23, s23
+	vmov	s22, r4
+	vcvt.f32.s32	s22, s22
+	vmov	s21, r5
+	vcvt.f32.s32	s21, s21
+	vmov	s20, r6
+	vcvt.f32.s32	s20, s20
+	vmov	s19, r7
+	vcvt.f32.s32	s19, s19
+	vmov	s18, r8
+	vcvt.f32.s32	s18, s18
+	vmov	s16, r9
+	vcvt.f32.s32	s16, s16
+	vmov	s17, r10
+	vcvt.f32.s32	s17, s17
+	vmov.f32	s0, s23
+	vmov.f32	s1, s22
+	vmov.f32	s2, s21
+	vmov.f32	s3, s20
+	vmov.f32	s4, s19
+	vmov.f32	s5, s18
+	vmov.f32	s6, s16
+	vmov.f32	s7, s17
+	bl	goodbye
+	movw	lr, #:lower16:(w-(1f+8))
+	movt	lr, #:upper16:(w-(1f+8))
+1:	add	lr, pc, lr
+	str	r0, [lr]
+	movw	lr, #:lower16:(w-(1f+8))
+	movt	lr, #:upper16:(w-(1f+8))
+1:	add	lr, pc, lr
+	ldr	r4, [lr]
+	movw	r5, #:lower16:(_STR_1048614-(1f+8))
+	movt	r5, #:upper16:(_STR_1048614-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	ldr	r8, [sp, #16]
+	ldr	r9, [sp, #20]
+	ldr	r10, [sp, #24]
+	vldr	s16, [sp, #28]
+	vldr	s17, [sp, #32]
+	vldr	s18, [sp, #36]
+	vldr	s19, [sp, #40]
+	vldr	s20, [sp, #44]
+	vldr	s21, [sp, #48]
+	vldr	s22, [sp, #52]
+	vldr	s23, [sp, #56]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	hello, .-hello
+
+	.align	2
+	.type	tralala, %function
+tralala:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #48
+	str	r4, [sp, #0]
+	.cfi_offset	4, -56
+	vstr	s16, [sp, #4]
+	.cfi_offset	80, -52
+	vstr	s17, [sp, #8]
+	.cfi_offset	81, -48
+	vstr	s18, [sp, #12]
+	.cfi_offset	82, -44
+	vstr	s19, [sp, #16]
+	.cfi_offset	83, -40
+	vstr	s20, [sp, #20]
+	.cfi_offset	84, -36
+	vstr	s0, [fp, #-12]
+block1048616:
+	movw	r4, #3
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vstr	s16, [fp, #-16]
+	movw	r4, #5
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vstr	s16, [fp, #-20]
+	movw	r4, #2
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vstr	s16, [fp, #-24]
+	movw	r4, #4
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vstr	s16, [fp, #-28]
+	movw	r4, #42
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vstr	s16, [fp, #-12]
+	vldr	s18, [fp, #-12]
+	vldr	s17, [fp, #-16]
+	vldr	s19, [fp, #-20]
+	vmul.f32	s16, s17, s19
+	vldr	s19, [fp, #-24]
+	vldr	s20, [fp, #-28]
+	vmul.f32	s17, s19, s20
+	movw	r4, #:lower16:(_STR_1048617-(1f+8))
+	movt	r4, #:upper16:(_STR_1048617-(1f+8))
+1:	add	r4, pc, r4
+	sub	sp, sp, #16
+	mov	r0, r4
+	vcvt.f64.f32	d7, s18
+	vmov	r2, r3, d7
+	vcvt.f64.f32	d7, s16
+	vstr	d7, [sp, #0]
+	vcvt.f64.f32	d7, s17
+	vstr	d7, [sp, #8]
+	bl	printf
+	add	sp, sp, #16
+	movw	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	vldr	s16, [sp, #4]
+	vldr	s17, [sp, #8]
+	vldr	s18, [sp, #12]
+	vldr	s19, [sp, #16]
+	vldr	s20, [sp, #20]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	tralala, .-tralala
+
+	.align	2
+	.type	goodbye, %function
+goodbye:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #72
+	str	r4, [sp, #0]
+	.cfi_offset	4, -80
+	vstr	s16, [sp, #4]
+	.cfi_offset	80, -76
+	vstr	s17, [sp, #8]
+	.cfi_offset	81, -72
+	vstr	s18, [sp, #12]
+	.cfi_offset	82, -68
+	vstr	s19, [sp, #16]
+	.cfi_offset	83, -64
+	vstr	s20, [sp, #20]
+	.cfi_offset	84, -60
+	vstr	s21, [sp, #24]
+	.cfi_offset	85, -56
+	vstr	s22, [sp, #28]
+	.cfi_offset	86, -52
+	vstr	s23, [sp, #32]
+	.cfi_offset	87, -48
+	vstr	s0, [fp, #-12]
+	vstr	s1, [fp, #-16]
+	vstr	s2, [fp, #-20]
+	vstr	s3, [fp, #-24]
+	vstr	s4, [fp, #-28]
+	vstr	s5, [fp, #-32]
+	vstr	s6, [fp, #-36]
+	vstr	s7, [fp, #-40]
+block1048618:
+	vldr	s23, [fp, #-12]
+	vldr	s22, [fp, #-16]
+	vldr	s21, [fp, #-20]
+	vldr	s20, [fp, #-24]
+	vldr	s19, [fp, #-28]
+	vldr	s18, [fp, #-32]
+	vldr	s16, [fp, #-36]
+	vldr	s17, [fp, #-40]
+	movw	r4, #:lower16:(_STR_1048619-(1f+8))
+	movt	r4, #:upper16:(_STR_1048619-(1f+8))
+1:	add	r4, pc, r4
+	sub	sp, sp, #56
+	mov	r0, r4
+	vcvt.f64.f32	d7, s23
+	vmov	r2, r3, d7
+	vcvt.f64.f32	d7, s22
+	vstr	d7, [sp, #0]
+	vcvt.f64.f32	d7, s21
+	vstr	d7, [sp, #8]
+	vcvt.f64.f32	d7, s20
+	vstr	d7, [sp, #16]
+	vcvt.f64.f32	d7, s19
+	vstr	d7, [sp, #24]
+	vcvt.f64.f32	d7, s18
+	vstr	d7, [sp, #32]
+	vcvt.f64.f32	d7, s16
+	vstr	d7, [sp, #40]
+	vcvt.f64.f32	d7, s17
+	vstr	d7, [sp, #48]
+	bl	printf
+	add	sp, sp, #56
+	movw	r4, #:lower16:(_STR_1048620-(1f+8))
+	movt	r4, #:upper16:(_STR_1048620-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048621-(1f+8))
+	movt	r4, #:upper16:(_STR_1048621-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r4, #1
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vmov.f32	s0, s16
+	bl	tralala
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	vldr	s16, [sp, #4]
+	vldr	s17, [sp, #8]
+	vldr	s18, [sp, #12]
+	vldr	s19, [sp, #16]
+	vldr	s20, [sp, #20]
+	vldr	s21, [sp, #24]
+	vldr	s22, [sp, #28]
+	vldr	s23, [sp, #32]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	goodbye, .-goodbye
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #0
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048622-(1f+8))
+	movt	r0, #:upper16:(_STR_1048622-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	bl	hello
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+w:
+	.word	0x0
+x:
+	.word	0x0
+y:
+	.word	0x0
+z:
+	.word	0x0
+_STR_1048584:
+	.asciz	"Hello,"
+_STR_1048585:
+	.asciz	"world!"
+_STR_1048587:
+	.asciz	"Hello, world!\n"
+_STR_1048588:
+	.asciz	"How"
+_STR_1048589:
+	.asciz	"are"
+_STR_1048590:
+	.asciz	"you?"
+_STR_1048591:
+	.asciz	"How are you?\n"
+_STR_1048595:
+	.asciz	"%d %d %d\n"
+_STR_1048598:
+	.asciz	"x + y :="
+_STR_1048599:
+	.asciz	"x + y := %d\n"
+_STR_1048600:
+	.asciz	"x - y :="
+_STR_1048601:
+	.asciz	"x - y := %d\n"
+_STR_1048602:
+	.asciz	"x * y :="
+_STR_1048603:
+	.asciz	"x * y := %d\n"
+_STR_1048604:
+	.asciz	"x / y :="
+_STR_1048605:
+	.asciz	"x / y := %d\n"
+_STR_1048614:
+	.asciz	"%d\n"
+_STR_1048617:
+	.asciz	"%f %f %f\n"
+_STR_1048619:
+	.asciz	"%f %f %f %f %f %f %f %f\n"
+_STR_1048620:
+	.asciz	"Morna"
+_STR_1048621:
+	.asciz	"Morna\n"
+_STR_1048622:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
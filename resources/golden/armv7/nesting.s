@@ -0,0 +1,227 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"nesting.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	hello, %function
+hello:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r5, [sp, #4]
+	.cfi_offset	5, -20
+block1048578:
+	movw	r4, #:lower16:(_STR_1048579-(1f+8))
+	movt	r4, #:upper16:(_STR_1048579-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048581-(1f+8))
+	movt	r4, #:upper16:(_STR_1048581-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r4, #42
+	mov	r0, r4
+	bl	test_me
+	str	r0, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048583-(1f+8))
+	movt	r4, #:upper16:(_STR_1048583-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-12]
+	movw	r5, #:lower16:(_STR_1048584-(1f+8))
+	movt	r5, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	hello, .-hello
+
+	.align	2
+	.type	test_me, %function
+test_me:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r0, [fp, #-12]
+block1048586:
+	movw	r4, #32
+	str	r4, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048588-(1f+8))
+	movt	r4, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-16]
+	movw	r5, #:lower16:(_STR_1048589-(1f+8))
+	movt	r5, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r4, #64
+	str	r4, [fp, #-24]
+	movw	r4, #27
+	str	r4, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048592-(1f+8))
+	movt	r4, #:upper16:(_STR_1048592-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r5, [fp, #-24]
+	movw	r4, #:lower16:(_STR_1048593-(1f+8))
+	movt	r4, #:upper16:(_STR_1048593-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r6, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048594-(1f+8))
+	movt	r4, #:upper16:(_STR_1048594-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r5
+	mov	r2, r6
+	bl	printf
+	movw	r4, #128
+	str	r4, [fp, #-20]
+	movw	r4, #:lower16:(_STR_1048596-(1f+8))
+	movt	r4, #:upper16:(_STR_1048596-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-20]
+	movw	r5, #:lower16:(_STR_1048597-(1f+8))
+	movt	r5, #:upper16:(_STR_1048597-(1f+8))
+1:	add	r5, pc, r5
+	movw	r5, #:lower16:(_STR_1048598-(1f+8))
+	movt	r5, #:upper16:(_STR_1048598-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r4, #:lower16:(_STR_1048588-(1f+8))
+	movt	r4, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-16]
+	movw	r5, #:lower16:(_STR_1048589-(1f+8))
+	movt	r5, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	ldr	r4, [fp, #-12]
+	movw	r5, #1
+	add	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	test_me, .-test_me
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #0
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048600-(1f+8))
+	movt	r0, #:upper16:(_STR_1048600-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	bl	hello
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048579:
+	.asciz	"Hello, world!"
+_STR_1048581:
+	.asciz	"Hello, world!\n"
+_STR_1048583:
+	.asciz	"x:="
+_STR_1048584:
+	.asciz	"x:= %d\n"
+_STR_1048588:
+	.asciz	"Outer scope has a:="
+_STR_1048589:
+	.asciz	"Outer scope has a:= %d\n"
+_STR_1048592:
+	.asciz	"I have a:="
+_STR_1048593:
+	.asciz	"and b:="
+_STR_1048594:
+	.asciz	"I have a:= %d and b:= %d\n"
+_STR_1048596:
+	.asciz	"B was reassigned to "
+_STR_1048597:
+	.asciz	"in inner"
+_STR_1048598:
+	.asciz	"B was reassigned to  %d in inner\n"
+_STR_1048600:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,280 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"newton.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	newton, %function
+newton:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #24
+	str	r4, [sp, #0]
+	.cfi_offset	4, -32
+	str	r5, [sp, #4]
+	.cfi_offset	5, -28
+	str	r6, [sp, #8]
+	.cfi_offset	6, -24
+	str	r0, [fp, #-12]
+block1048585:
+	ldr	r4, [fp, #-12]
+	movw	r5, #1
+	mov	r0, r4
+	mov	r1, r5
+	bl	improve
+	str	r0, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048587-(1f+8))
+	movt	r4, #:upper16:(_STR_1048587-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r5, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048588-(1f+8))
+	movt	r4, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r6, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048590-(1f+8))
+	movt	r4, #:upper16:(_STR_1048590-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r5
+	mov	r2, r6
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	newton, .-newton
+
+	.align	2
+	.type	improve, %function
+improve:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r8, [sp, #16]
+	.cfi_offset	8, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048592:
+	ldr	r7, [fp, #-16]
+	ldr	r4, [fp, #-16]
+	ldr	r5, [fp, #-16]
+	mul	r6, r4, r5
+	ldr	r4, [fp, #-12]
+	sub	r8, r6, r4
+	movw	r4, #2
+	ldr	r5, [fp, #-16]
+	mul	r6, r4, r5
+	sdiv	r4, r8, r6
+	sub	r5, r7, r4
+	str	r5, [fp, #-20]
+	ldr	r4, [fp, #-20]
+	ldr	r5, [fp, #-16]
+	sub	r6, r4, r5
+	movw	r4, #0
+	sub	r5, r6, r4
+	movw	r4, #0
+	cmp	r5, r4
+	bne	block1048595
+block1048594:
+	ldr	r4, [fp, #-20]
+	movw	r5, #1
+	sub	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	ldr	r8, [sp, #16]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+block1048595:
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-20]
+	mov	r0, r4
+	mov	r1, r5
+	bl	improve
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	ldr	r8, [sp, #16]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	improve, .-improve
+
+	.align	2
+	.type	fourty_two, %function
+fourty_two:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r5, [sp, #4]
+	.cfi_offset	5, -20
+	str	r0, [fp, #-12]
+block1048596:
+	ldr	r4, [fp, #-12]
+	movw	r5, #42
+	add	r0, r4, r5
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	fourty_two, .-fourty_two
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #1
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048598-(1f+8))
+	movt	r0, #:upper16:(_STR_1048598-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #4]	@ Load argv[1]
+	mvn	r1, #19
+	add	r1, fp, r1
+	movw	r4, #1
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-20]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #4]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-20]
+	ldr	r0, [fp, #-20]	@ Load parsed argv[1]
+	bl	newton
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argv_error:
+	movw	r0, #:lower16:(_STR_1048599-(1f+8))
+	movt	r0, #:upper16:(_STR_1048599-(1f+8))
+1:	add	r0, pc, r0
+	mov	r1, r4
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+x:
+	.word	0x0
+y:
+	.word	0x0
+z:
+	.word	0x0
+a:
+	.word	0x0
+b:
+	.word	0x0
+c:
+	.word	0x0
+_STR_1048587:
+	.asciz	"The square root of"
+_STR_1048588:
+	.asciz	"is"
+_STR_1048590:
+	.asciz	"The square root of %d is %d\n"
+_STR_1048598:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048599:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
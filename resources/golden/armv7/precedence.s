@@ -0,0 +1,142 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"precedence.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	precedence, %function
+precedence:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+block1048577:
+	movw	r4, #2
+	str	r4, [fp, #-12]
+	movw	r4, #3
+	str	r4, [fp, #-16]
+	movw	r4, #1
+	str	r4, [fp, #-20]
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	ldr	r6, [fp, #-20]
+	sub	r7, r5, r6
+	mul	r5, r4, r7
+	str	r5, [fp, #-24]
+	movw	r4, #:lower16:(_STR_1048581-(1f+8))
+	movt	r4, #:upper16:(_STR_1048581-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-24]
+	movw	r5, #:lower16:(_STR_1048583-(1f+8))
+	movt	r5, #:upper16:(_STR_1048583-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	mul	r6, r4, r5
+	ldr	r4, [fp, #-20]
+	sub	r5, r6, r4
+	str	r5, [fp, #-24]
+	movw	r4, #:lower16:(_STR_1048584-(1f+8))
+	movt	r4, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r4, [fp, #-24]
+	movw	r5, #:lower16:(_STR_1048585-(1f+8))
+	movt	r5, #:upper16:(_STR_1048585-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	precedence, .-precedence
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #0
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048587-(1f+8))
+	movt	r0, #:upper16:(_STR_1048587-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	bl	precedence
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048581:
+	.asciz	"2*(3-1) := "
+_STR_1048583:
+	.asciz	"2*(3-1) :=  %d\n"
+_STR_1048584:
+	.asciz	"2*3-1 := "
+_STR_1048585:
+	.asciz	"2*3-1 :=  %d\n"
+_STR_1048587:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,186 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"prime.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	mainfunc, %function
+mainfunc:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+block1048578:
+	movw	r0, #0
+	.cfi_remember_state
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	mainfunc, .-mainfunc
+
+	.align	2
+	.type	factor, %function
+factor:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #32
+	str	r4, [sp, #0]
+	.cfi_offset	4, -40
+	str	r5, [sp, #4]
+	.cfi_offset	5, -36
+	str	r6, [sp, #8]
+	.cfi_offset	6, -32
+	str	r7, [sp, #12]
+	.cfi_offset	7, -28
+	str	r8, [sp, #16]
+	.cfi_offset	8, -24
+	str	r0, [fp, #-12]
+block1048580:
+	ldr	r4, [fp, #-12]
+	movw	r5, #2
+	movw	r5, #31
+	lsr	r6, r4, r5
+	add	r5, r4, r6
+	movw	r4, #1
+	asr	r6, r5, r4
+	str	r6, [fp, #-16]
+block1048582:
+	ldr	r4, [fp, #-12]
+	ldr	r5, [fp, #-16]
+	ldr	r6, [fp, #-12]
+	ldr	r7, [fp, #-16]
+	sdiv	r8, r6, r7
+	mul	r6, r5, r8
+	sub	r5, r4, r6
+	movw	r4, #0
+	sub	r6, r5, r4
+	movw	r4, #0
+	cmp	r6, r4
+	ble	block1048584
+block1048583:
+	ldr	r4, [fp, #-16]
+	movw	r5, #1
+	sub	r6, r4, r5
+	str	r6, [fp, #-16]
+	b	block1048582
+block1048584:
+	ldr	r4, [fp, #-16]
+	movw	r5, #1
+	sub	r6, r4, r5
+	movw	r4, #0
+	sub	r5, r6, r4
+	movw	r4, #0
+	cmp	r5, r4
+	ble	block1048587
+block1048586:
+	ldr	r4, [fp, #-16]
+	mov	r0, r4
+	bl	factor
+	str	r0, [fp, #-20]
+	ldr	r4, [fp, #-12]
+	ldr	r6, [fp, #-16]
+	sdiv	r5, r4, r6
+	mov	r0, r5
+	bl	factor
+	str	r0, [fp, #-20]
+	b	block1048588
+block1048587:
+	ldr	r4, [fp, #-12]
+	movw	r5, #:lower16:(_STR_1048589-(1f+8))
+	movt	r5, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r5, pc, r5
+	movw	r5, #:lower16:(_STR_1048591-(1f+8))
+	movt	r5, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+block1048588:
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	ldr	r8, [sp, #16]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	factor, .-factor
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #0
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048593-(1f+8))
+	movt	r0, #:upper16:(_STR_1048593-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	bl	mainfunc
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048589:
+	.asciz	"is a prime factor"
+_STR_1048591:
+	.asciz	"%d is a prime factor\n"
+_STR_1048593:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
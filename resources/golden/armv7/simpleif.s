@@ -0,0 +1,330 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"simpleif.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	dingdong, %function
+dingdong:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #64
+	str	r4, [sp, #0]
+	.cfi_offset	4, -72
+	str	r5, [sp, #4]
+	.cfi_offset	5, -68
+	str	r6, [sp, #8]
+	.cfi_offset	6, -64
+	str	r7, [sp, #12]
+	.cfi_offset	7, -60
+	str	r8, [sp, #16]
+	.cfi_offset	8, -56
+	str	r9, [sp, #20]
+	.cfi_offset	9, -52
+	str	r10, [sp, #24]
+	.cfi_offset	10, -48
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	str	r2, [fp, #-20]
+	str	r3, [fp, #-24]
+	ldr	ip, [fp, #0]
+	str	ip, [fp, #-28]
+	ldr	ip, [fp, #4]
+	str	ip, [fp, #-32]
+	ldr	ip, [fp, #8]
+	str	ip, [fp, #-36]
+block1048577:
+	movw	r4, #42
+	str	r4, [fp, #-40]
+	ldr	r10, [fp, #-12]
+	ldr	r9, [fp, #-16]
+	ldr	r8, [fp, #-20]
+	ldr	r7, [fp, #-24]
+	ldr	r4, [fp, #-28]
+	ldr	r5, [fp, #-32]
+	ldr	r6, [fp, #-36]
+	movw	ip, #:lower16:(_STR_1048580-(1f+8))
+	movt	ip, #:upper16:(_STR_1048580-(1f+8))
+1:	add	ip, pc, ip
+	str	ip, [fp, #-44]
+	sub	sp, sp, #16
+	ldr	r0, [fp, #-44]
+	mov	r1, r10
+	mov	r2, r9
+	mov	r3, r8
+	str	r7, [sp, #0]
+	str	r4, [sp, #4]
+	str	r5, [sp, #8]
+	str	r6, [sp, #12]
+	bl	printf
+	add	sp, sp, #16
+	ldr	r4, [fp, #-40]
+	movw	r5, #42
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	bne	block1048582
+block1048581:
+	movw	r4, #:lower16:(_STR_1048584-(1f+8))
+	movt	r4, #:upper16:(_STR_1048584-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048585-(1f+8))
+	movt	r4, #:upper16:(_STR_1048585-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r4, #43
+	str	r4, [fp, #-40]
+	b	block1048589
+block1048582:
+	movw	r4, #44
+	str	r4, [fp, #-40]
+block1048589:
+	ldr	r4, [fp, #-40]
+	movw	r5, #0
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	ble	block1048591
+block1048590:
+	ldr	r4, [fp, #-40]
+	movw	r5, #:lower16:(_STR_1048592-(1f+8))
+	movt	r5, #:upper16:(_STR_1048592-(1f+8))
+1:	add	r5, pc, r5
+	mov	r0, r5
+	mov	r1, r4
+	bl	printf
+	ldr	r4, [fp, #-40]
+	movw	r5, #1
+	sub	r6, r4, r5
+	str	r6, [fp, #-40]
+	b	block1048589
+block1048591:
+	ldr	r0, [fp, #-40]
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	ldr	r8, [sp, #16]
+	ldr	r9, [sp, #20]
+	ldr	r10, [sp, #24]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	dingdong, .-dingdong
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #40
+	str	r4, [sp, #0]
+	.cfi_offset	4, -48
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #7
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048594-(1f+8))
+	movt	r0, #:upper16:(_STR_1048594-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #4]	@ Load argv[1]
+	mvn	r1, #19
+	add	r1, fp, r1
+	movw	r4, #1
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-20]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #4]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-20]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #8]	@ Load argv[2]
+	mvn	r1, #23
+	add	r1, fp, r1
+	movw	r4, #2
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-24]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #8]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-24]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #12]	@ Load argv[3]
+	mvn	r1, #27
+	add	r1, fp, r1
+	movw	r4, #3
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-28]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #12]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-28]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #16]	@ Load argv[4]
+	mvn	r1, #31
+	add	r1, fp, r1
+	movw	r4, #4
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-32]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #16]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-32]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #20]	@ Load argv[5]
+	mvn	r1, #35
+	add	r1, fp, r1
+	movw	r4, #5
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-36]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #20]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-36]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #24]	@ Load argv[6]
+	mvn	r1, #39
+	add	r1, fp, r1
+	movw	r4, #6
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-40]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #24]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-40]
+	ldr	ip, [fp, #-16]	@ Load argv
+	ldr	r0, [ip, #28]	@ Load argv[7]
+	mvn	r1, #43
+	add	r1, fp, r1
+	movw	r4, #7
+	mov	r2, #10
+	bl	strtol
+	ldr	r2, [fp, #-44]	@ Load end pointer
+	ldr	ip, [fp, #-16]
+	ldr	r3, [ip, #28]
+	cmp	r2, r3
+	beq	_L_argv_error
+	ldrb	r3, [r2]
+	cmp	r3, #0
+	bne	_L_argv_error
+	str	r0, [fp, #-44]
+	sub	sp, sp, #16
+	ldr	r0, [fp, #-20]	@ Load parsed argv[1]
+	ldr	r1, [fp, #-24]	@ Load parsed argv[2]
+	ldr	r2, [fp, #-28]	@ Load parsed argv[3]
+	ldr	r3, [fp, #-32]	@ Load parsed argv[4]
+	ldr	ip, [fp, #-36]
+	str	ip, [sp, #0]
+	ldr	ip, [fp, #-40]
+	str	ip, [sp, #4]
+	ldr	ip, [fp, #-44]
+	str	ip, [sp, #8]
+	bl	dingdong
+	add	sp, sp, #16
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argv_error:
+	movw	r0, #:lower16:(_STR_1048595-(1f+8))
+	movt	r0, #:upper16:(_STR_1048595-(1f+8))
+1:	add	r0, pc, r0
+	mov	r1, r4
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048580:
+	.asciz	"%d %d %d %d %d %d %d\n"
+_STR_1048584:
+	.asciz	"Equal!"
+_STR_1048585:
+	.asciz	"Equal!\n"
+_STR_1048592:
+	.asciz	"%d\n"
+_STR_1048594:
+	.asciz	"Argument error: expected 7 arguments, got %d\n"
+_STR_1048595:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",%progbits
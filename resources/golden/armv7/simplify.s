@@ -0,0 +1,210 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"simplify.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	f, %function
+f:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+block1048579:
+	movw	r0, #0
+	.cfi_remember_state
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	f, .-f
+
+	.align	2
+	.type	g, %function
+g:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #56
+	str	r4, [sp, #0]
+	.cfi_offset	4, -64
+	str	r5, [sp, #4]
+	.cfi_offset	5, -60
+	str	r6, [sp, #8]
+	.cfi_offset	6, -56
+	str	r7, [sp, #12]
+	.cfi_offset	7, -52
+	str	r8, [sp, #16]
+	.cfi_offset	8, -48
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	str	r2, [fp, #-20]
+block1048581:
+	movw	r4, #1
+	str	r4, [fp, #-24]
+	movw	r4, #2
+	str	r4, [fp, #-28]
+	ldr	r4, [fp, #-36]
+	movw	r5, #1
+	add	r6, r4, r5
+	ldr	r5, [fp, #-40]
+	movw	r7, #2
+	add	r4, r5, r7
+	ldr	r7, [fp, #-44]
+	movw	r8, #3
+	add	r5, r7, r8
+	mov	r0, r6
+	mov	r1, r4
+	mov	r2, r5
+	bl	g
+	str	r0, [fp, #-32]
+	ldr	r4, [fp, #-24]
+	ldr	r5, [fp, #-28]
+	ldr	r6, [fp, #-32]
+	movw	r7, #:lower16:(_STR_1048586-(1f+8))
+	movt	r7, #:upper16:(_STR_1048586-(1f+8))
+1:	add	r7, pc, r7
+	mov	r0, r7
+	mov	r1, r4
+	mov	r2, r5
+	mov	r3, r6
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	ldr	r7, [sp, #12]
+	ldr	r8, [sp, #16]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	g, .-g
+
+	.align	2
+	.type	h, %function
+h:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #24
+	str	r4, [sp, #0]
+	.cfi_offset	4, -32
+	vstr	s16, [sp, #4]
+	.cfi_offset	80, -28
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+block1048587:
+	movw	r4, #5
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vstr	s16, [fp, #-20]
+	movw	r4, #1
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vstr	s16, [fp, #-20]
+	movw	r4, #4
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vstr	s16, [fp, #-20]
+	movw	r4, #2
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vstr	s16, [fp, #-20]
+	mvn	r4, #5
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vstr	s16, [fp, #-20]
+	movw	r4, #4
+	vmov	s16, r4
+	vcvt.f32.s32	s16, s16
+	vstr	s16, [fp, #-20]
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	vldr	s16, [sp, #4]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	h, .-h
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #0
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048591-(1f+8))
+	movt	r0, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	bl	f
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048586:
+	.asciz	"%d %d %d\n"
+_STR_1048591:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
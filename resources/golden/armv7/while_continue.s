@@ -0,0 +1,178 @@
+	.arch	armv7-a
+	.arch_extension	idiv
+	.fpu	vfpv3-d16
+	.eabi_attribute	28, 1	@ Tag_ABI_VFP_args: floats are passed in VFP registers
+	.syntax	unified
+	.arm
+	.file	"while_continue.vsl"
+	.text
+	.globl	main
+	.type	main, %function
+
+	.align	2
+	.type	while_continue, %function
+while_continue:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #24
+	str	r4, [sp, #0]
+	.cfi_offset	4, -32
+	str	r5, [sp, #4]
+	.cfi_offset	5, -28
+	str	r6, [sp, #8]
+	.cfi_offset	6, -24
+block1048577:
+	movw	r4, #0
+	str	r4, [fp, #-12]
+block1048579:
+	ldr	r4, [fp, #-12]
+	movw	r5, #3
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	bge	block1048581
+block1048580:
+	ldr	r4, [fp, #-12]
+	movw	r5, #1
+	add	r6, r4, r5
+	str	r6, [fp, #-12]
+	movw	r4, #0
+	str	r4, [fp, #-16]
+	b	block1048584
+block1048581:
+	movw	r4, #:lower16:(_STR_1048597-(1f+8))
+	movt	r4, #:upper16:(_STR_1048597-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048598-(1f+8))
+	movt	r4, #:upper16:(_STR_1048598-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	movw	r0, #0
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	ldr	r5, [sp, #4]
+	ldr	r6, [sp, #8]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+block1048584:
+	ldr	r4, [fp, #-16]
+	movw	r5, #2
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	bge	block1048586
+block1048585:
+	ldr	r4, [fp, #-16]
+	movw	r5, #1
+	add	r6, r4, r5
+	str	r6, [fp, #-16]
+	b	block1048584
+block1048586:
+	movw	r4, #:lower16:(_STR_1048588-(1f+8))
+	movt	r4, #:upper16:(_STR_1048588-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r5, [fp, #-12]
+	movw	r4, #:lower16:(_STR_1048589-(1f+8))
+	movt	r4, #:upper16:(_STR_1048589-(1f+8))
+1:	add	r4, pc, r4
+	ldr	r6, [fp, #-16]
+	movw	r4, #:lower16:(_STR_1048591-(1f+8))
+	movt	r4, #:upper16:(_STR_1048591-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	mov	r1, r5
+	mov	r2, r6
+	bl	printf
+	ldr	r4, [fp, #-12]
+	movw	r5, #5
+	sub	r6, r4, r5
+	movw	r4, #0
+	cmp	r6, r4
+	blt	block1048579
+block1048594:
+	movw	r4, #:lower16:(_STR_1048595-(1f+8))
+	movt	r4, #:upper16:(_STR_1048595-(1f+8))
+1:	add	r4, pc, r4
+	movw	r4, #:lower16:(_STR_1048596-(1f+8))
+	movt	r4, #:upper16:(_STR_1048596-(1f+8))
+1:	add	r4, pc, r4
+	mov	r0, r4
+	bl	printf
+	b	block1048579
+	.cfi_endproc
+	.size	while_continue, .-while_continue
+
+	.align	2
+main:
+	.cfi_startproc
+	push	{fp, lr}
+	.cfi_def_cfa_offset	8
+	.cfi_offset	14, -4
+	.cfi_offset	11, -8
+	add	fp, sp, #8
+	.cfi_def_cfa	11, 0
+	sub	sp, sp, #16
+	str	r4, [sp, #0]
+	.cfi_offset	4, -24
+	str	r0, [fp, #-12]
+	str	r1, [fp, #-16]
+	sub	r1, r0, #1
+	movw	ip, #0
+	cmp	r1, ip
+	beq	_L_argc_ok
+	movw	r0, #:lower16:(_STR_1048599-(1f+8))
+	movt	r0, #:upper16:(_STR_1048599-(1f+8))
+1:	add	r0, pc, r0
+	bl	printf
+	mov	r0, #1
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+_L_argc_ok:
+	bl	while_continue
+	.cfi_remember_state
+	ldr	r4, [sp, #0]
+	sub	sp, fp, #8
+	.cfi_def_cfa	13, 8
+	pop	{fp, lr}
+	.cfi_def_cfa_offset	0
+	bx	lr
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	2
+_STR_1048588:
+	.asciz	"a"
+_STR_1048589:
+	.asciz	"b"
+_STR_1048591:
+	.asciz	"a %d b %d\n"
+_STR_1048595:
+	.asciz	"unreachable"
+_STR_1048596:
+	.asciz	"unreachable\n"
+_STR_1048597:
+	.asciz	"done"
+_STR_1048598:
+	.asciz	"done\n"
+_STR_1048599:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",%progbits
@@ -0,0 +1,281 @@
+	.file	"bitops.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	bitwise_operators, @function
+bitwise_operators:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+block1048577:
+1:	auipc	t0, %pcrel_hi(_STR_1048578)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048579)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -16(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048581)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	lw	t0, -12(s0)
+	li	t1, -1
+	xor	t2, t1, t0
+	sw	t2, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048583)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048584)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -20(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048585)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	or	t2, t0, t1
+	sw	t2, -20(s0)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048586)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -16(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048584)
+	addi	t2, t2, %pcrel_lo(1b)
+	lw	t2, -20(s0)
+1:	auipc	t3, %pcrel_hi(_STR_1048587)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	xor	t2, t0, t1
+	sw	t2, -20(s0)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048588)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -16(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048584)
+	addi	t2, t2, %pcrel_lo(1b)
+	lw	t2, -20(s0)
+1:	auipc	t3, %pcrel_hi(_STR_1048589)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	and	t2, t0, t1
+	sw	t2, -20(s0)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048590)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -16(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048584)
+	addi	t2, t2, %pcrel_lo(1b)
+	lw	t2, -20(s0)
+1:	auipc	t3, %pcrel_hi(_STR_1048591)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	sll	t2, t0, t1
+	sw	t2, -20(s0)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048592)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -16(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048584)
+	addi	t2, t2, %pcrel_lo(1b)
+	lw	t2, -20(s0)
+1:	auipc	t3, %pcrel_hi(_STR_1048593)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	sra	t2, t0, t1
+	sw	t2, -20(s0)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048594)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -16(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048584)
+	addi	t2, t2, %pcrel_lo(1b)
+	lw	t2, -20(s0)
+1:	auipc	t3, %pcrel_hi(_STR_1048595)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	bitwise_operators, .-bitwise_operators
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 2
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048597)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 4(t0)	# Load argv[1]
+	addi	a1, s0, -20
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	lw	t1, -20(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 4(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -20(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 8(t0)	# Load argv[2]
+	addi	a1, s0, -24
+	li	s1, 2
+	li	a2, 10
+	call	strtol
+	lw	t1, -24(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 8(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -24(s0)
+	lw	a0, -20(s0)	# Load parsed argv[1]
+	lw	a1, -24(s0)	# Load parsed argv[2]
+	call	bitwise_operators
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048598)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048578:
+	.asciz	"a is"
+_STR_1048579:
+	.asciz	"and b is"
+_STR_1048581:
+	.asciz	"a is %d and b is %d\n"
+_STR_1048583:
+	.asciz	"~"
+_STR_1048584:
+	.asciz	"="
+_STR_1048585:
+	.asciz	"~ %d = %d\n"
+_STR_1048586:
+	.asciz	"|"
+_STR_1048587:
+	.asciz	"%d | %d = %d\n"
+_STR_1048588:
+	.asciz	"^"
+_STR_1048589:
+	.asciz	"%d ^ %d = %d\n"
+_STR_1048590:
+	.asciz	"&"
+_STR_1048591:
+	.asciz	"%d & %d = %d\n"
+_STR_1048592:
+	.asciz	"<<"
+_STR_1048593:
+	.asciz	"%d << %d = %d\n"
+_STR_1048594:
+	.asciz	">>"
+_STR_1048595:
+	.asciz	"%d >> %d = %d\n"
+_STR_1048597:
+	.asciz	"Argument error: expected 2 arguments, got %d\n"
+_STR_1048598:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
@@ -0,0 +1,268 @@
+	.file	"cast.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	casting, @function
+casting:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	fsw	fa0, -20(s0)
+	fsw	fa1, -24(s0)
+block1048578:
+	lw	t0, -12(s0)
+	flw	ft0, -20(s0)
+	fcvt.s.w	ft1, t0
+	fadd.s	ft2, ft1, ft0
+	fcvt.w.s	t0, ft2, rne
+	sw	t0, -28(s0)
+	lw	t0, -16(s0)
+	flw	ft0, -24(s0)
+	fcvt.s.w	ft1, t0
+	fadd.s	ft2, ft1, ft0
+	fsw	ft2, -32(s0)
+	li	t0, 3
+	sw	t0, -28(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048580)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048581)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -16(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048582)
+	addi	t2, t2, %pcrel_lo(1b)
+	flw	ft0, -20(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048583)
+	addi	t2, t2, %pcrel_lo(1b)
+	flw	ft1, -24(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048585)
+	addi	t2, t2, %pcrel_lo(1b)
+	addi	sp, sp, -16
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	fcvt.d.s	ft11, ft0
+	fsd	ft11, 0(sp)
+	lw	a4, 0(sp)
+	lw	a5, 4(sp)
+	fcvt.d.s	ft11, ft1
+	fsd	ft11, 0(sp)
+	lw	a6, 0(sp)
+	lw	a7, 4(sp)
+	call	printf
+	addi	sp, sp, 16
+	lw	t0, -28(s0)
+1:	auipc	t6, %pcrel_hi(_L_CONST_1048586)
+	flw	ft0, %pcrel_lo(1b)(t6)
+	fcvt.s.w	ft1, t0
+	fmv.s	fa0, ft1
+	fmv.s	fa1, ft0
+	call	foo
+	fsw	fa0, -32(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048587)
+	addi	t0, t0, %pcrel_lo(1b)
+	flw	ft0, -32(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048588)
+	addi	t0, t0, %pcrel_lo(1b)
+	addi	sp, sp, -16
+	mv	a0, t0
+	fcvt.d.s	ft11, ft0
+	fsd	ft11, 0(sp)
+	lw	a2, 0(sp)
+	lw	a3, 4(sp)
+	call	printf
+	addi	sp, sp, 16
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	casting, .-casting
+
+	.align	2
+	.type	foo, @function
+foo:
+	.cfi_startproc
+	addi	sp, sp, -16
+	.cfi_def_cfa_offset	16
+	sw	ra, 12(sp)
+	sw	s0, 8(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 16
+	.cfi_def_cfa	8, 0
+	fsw	fa0, -12(s0)
+	fsw	fa1, -16(s0)
+block1048590:
+	flw	ft0, -12(s0)
+	flw	ft1, -16(s0)
+	fmul.s	fa0, ft0, ft1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 16
+	lw	ra, 12(sp)
+	lw	s0, 8(sp)
+	addi	sp, sp, 16
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	foo, .-foo
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sw	ra, 44(sp)
+	sw	s0, 40(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -48
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 4
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048591)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	lw	ra, 44(sp)
+	lw	s0, 40(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 4(t0)	# Load argv[1]
+	addi	a1, s0, -20
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	lw	t1, -20(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 4(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -20(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 8(t0)	# Load argv[2]
+	addi	a1, s0, -24
+	li	s1, 2
+	li	a2, 10
+	call	strtol
+	lw	t1, -24(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 8(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -24(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 12(t0)	# Load argv[3]
+	addi	a1, s0, -28
+	li	s1, 3
+	call	strtod
+	fcvt.s.d	fa0, fa0
+	lw	t1, -28(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 12(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	fsw	fa0, -28(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 16(t0)	# Load argv[4]
+	addi	a1, s0, -32
+	li	s1, 4
+	call	strtod
+	fcvt.s.d	fa0, fa0
+	lw	t1, -32(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 16(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	fsw	fa0, -32(s0)
+	lw	a0, -20(s0)	# Load parsed argv[1]
+	lw	a1, -24(s0)	# Load parsed argv[2]
+	flw	fa0, -28(s0)	# Load parsed argv[3]
+	flw	fa1, -32(s0)	# Load parsed argv[4]
+	call	casting
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	lw	ra, 44(sp)
+	lw	s0, 40(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048592)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	lw	ra, 44(sp)
+	lw	s0, 40(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_L_CONST_1048586:
+	.word	0x3e99999a	# 0.300000
+_STR_1048580:
+	.asciz	"a is"
+_STR_1048581:
+	.asciz	"b is"
+_STR_1048582:
+	.asciz	"c is"
+_STR_1048583:
+	.asciz	"d is"
+_STR_1048585:
+	.asciz	"a is %d b is %d c is %f d is %f\n"
+_STR_1048587:
+	.asciz	"f is"
+_STR_1048588:
+	.asciz	"f is %f\n"
+_STR_1048591:
+	.asciz	"Argument error: expected 4 arguments, got %d\n"
+_STR_1048592:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
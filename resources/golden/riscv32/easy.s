@@ -0,0 +1,291 @@
+	.file	"easy.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	mainfunc, @function
+mainfunc:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+block1048578:
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048581)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	t0, 15
+	sw	t0, -16(s0)
+	li	t0, 5
+	sw	t0, -24(s0)
+	lw	t0, -16(s0)
+	lw	t1, -24(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	test
+	sw	a0, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048584)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -20(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048585)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	mainfunc, .-mainfunc
+
+	.align	2
+	.type	test, @function
+test:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+block1048587:
+1:	auipc	t0, %pcrel_hi(_STR_1048588)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048589)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -16(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048590)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	add	t2, t0, t1
+	sw	t2, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048591)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -20(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048592)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	sub	t2, t0, t1
+	sw	t2, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048593)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -20(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048594)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	mul	t2, t0, t1
+	sw	t2, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048595)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -20(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048596)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	div	t2, t0, t1
+	sw	t2, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048597)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -20(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048598)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048599)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -20(s0)
+	li	t1, 0
+	sub	t2, t1, t0
+1:	auipc	t0, %pcrel_hi(_STR_1048600)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	mv	a1, t2
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048601)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+	lw	t1, -12(s0)
+	mul	t2, t0, t1
+	lw	t0, -16(s0)
+	lw	t1, -16(s0)
+	mul	t3, t0, t1
+	add	t0, t2, t3
+1:	auipc	t1, %pcrel_hi(_STR_1048602)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	sub	a0, t0, t1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	test, .-test
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 1
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048603)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 4(t0)	# Load argv[1]
+	addi	a1, s0, -20
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	lw	t1, -20(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 4(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -20(s0)
+	lw	a0, -20(s0)	# Load parsed argv[1]
+	call	mainfunc
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048604)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048579:
+	.asciz	"Testing plain call/return and expression evaluation"
+_STR_1048581:
+	.asciz	"Testing plain call/return and expression evaluation\n"
+_STR_1048584:
+	.asciz	"The deftion returned y:="
+_STR_1048585:
+	.asciz	"The deftion returned y:= %d\n"
+_STR_1048588:
+	.asciz	"My parameters are a:="
+_STR_1048589:
+	.asciz	"and b:="
+_STR_1048590:
+	.asciz	"My parameters are a:= %d and b:= %d\n"
+_STR_1048591:
+	.asciz	"Their sum is c:="
+_STR_1048592:
+	.asciz	"Their sum is c:= %d\n"
+_STR_1048593:
+	.asciz	"Their difference is c:="
+_STR_1048594:
+	.asciz	"Their difference is c:= %d\n"
+_STR_1048595:
+	.asciz	"Their product is c:="
+_STR_1048596:
+	.asciz	"Their product is c:= %d\n"
+_STR_1048597:
+	.asciz	"Their ratio is c:="
+_STR_1048598:
+	.asciz	"Their ratio is c:= %d\n"
+_STR_1048599:
+	.asciz	"(-c):="
+_STR_1048600:
+	.asciz	"(-c):= %d\n"
+_STR_1048601:
+	.asciz	"The sum of their squares is "
+_STR_1048602:
+	.asciz	"The sum of their squares is  %d\n"
+_STR_1048603:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048604:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
@@ -0,0 +1,185 @@
+	.file	"fibonacci_iterative.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	fibonacci_iterative, @function
+fibonacci_iterative:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+block1048577:
+	lw	t0, -12(s0)
+	sw	t0, -16(s0)
+	li	t0, 1
+	sw	t0, -20(s0)
+	li	t0, 1
+	sw	t0, -24(s0)
+	li	t0, 1
+	sw	t0, -28(s0)
+	lw	t0, -16(s0)
+	li	t1, 0
+	sub	t2, t0, t1
+	li	t0, 0
+	ble	t2, t0, block1048581
+block1048579:
+	lw	t0, -16(s0)
+	li	t1, 1
+	sub	t2, t0, t1
+	li	t0, 0
+	bgt	t2, t0, block1048582
+	j	block1048591
+block1048581:
+	li	t0, 0
+	sw	t0, -28(s0)
+	j	block1048591
+block1048582:
+	lw	t0, -16(s0)
+	li	t1, 2
+	sub	t2, t0, t1
+	li	t0, 0
+	ble	t2, t0, block1048591
+block1048587:
+	lw	t0, -16(s0)
+	li	t1, 3
+	sub	t2, t0, t1
+	li	t0, 0
+	ble	t2, t0, block1048591
+block1048588:
+	lw	t0, -24(s0)
+	lw	t1, -20(s0)
+	add	t2, t0, t1
+	sw	t2, -28(s0)
+	lw	t0, -24(s0)
+	sw	t0, -20(s0)
+	lw	t0, -28(s0)
+	sw	t0, -24(s0)
+	lw	t0, -16(s0)
+	li	t1, 1
+	sub	t2, t0, t1
+	sw	t2, -16(s0)
+	j	block1048587
+block1048591:
+1:	auipc	t0, %pcrel_hi(_STR_1048592)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048593)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -28(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048595)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	fibonacci_iterative, .-fibonacci_iterative
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 1
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048596)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 4(t0)	# Load argv[1]
+	addi	a1, s0, -20
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	lw	t1, -20(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 4(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -20(s0)
+	lw	a0, -20(s0)	# Load parsed argv[1]
+	call	fibonacci_iterative
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048597)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048592:
+	.asciz	"Fibonacci number #"
+_STR_1048593:
+	.asciz	"is"
+_STR_1048595:
+	.asciz	"Fibonacci number # %d is %d\n"
+_STR_1048596:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048597:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
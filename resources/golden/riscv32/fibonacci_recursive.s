@@ -0,0 +1,191 @@
+	.file	"fibonacci_recursive.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	fibonacci_recursive, @function
+fibonacci_recursive:
+	.cfi_startproc
+	addi	sp, sp, -16
+	.cfi_def_cfa_offset	16
+	sw	ra, 12(sp)
+	sw	s0, 8(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 16
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+block1048578:
+	lw	t0, -12(s0)
+	mv	a0, t0
+	call	fibonacci_number
+	sw	a0, -16(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048580)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -16(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048582)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 16
+	lw	ra, 12(sp)
+	lw	s0, 8(sp)
+	addi	sp, sp, 16
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	fibonacci_recursive, .-fibonacci_recursive
+
+	.align	2
+	.type	fibonacci_number, @function
+fibonacci_number:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+block1048584:
+	li	t0, 0
+	sw	t0, -16(s0)
+	lw	t0, -12(s0)
+	li	t1, 2
+	sub	t2, t0, t1
+	li	t0, 0
+	ble	t2, t0, block1048587
+block1048585:
+	lw	t0, -12(s0)
+	li	t1, 1
+	sub	t2, t0, t1
+	mv	a0, t2
+	call	fibonacci_number
+	sw	a0, -20(s0)
+	lw	t0, -12(s0)
+	li	t1, 2
+	sub	t2, t0, t1
+	mv	a0, t2
+	call	fibonacci_number
+	lw	t0, -20(s0)
+	add	t1, t0, a0
+	sw	t1, -16(s0)
+	j	block1048589
+block1048587:
+	li	t0, 1
+	sw	t0, -16(s0)
+block1048589:
+	lw	a0, -16(s0)
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	fibonacci_number, .-fibonacci_number
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 1
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048590)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 4(t0)	# Load argv[1]
+	addi	a1, s0, -20
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	lw	t1, -20(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 4(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -20(s0)
+	lw	a0, -20(s0)	# Load parsed argv[1]
+	call	fibonacci_recursive
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048591)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048579:
+	.asciz	"Fibonacci number #"
+_STR_1048580:
+	.asciz	"is"
+_STR_1048582:
+	.asciz	"Fibonacci number # %d is %d\n"
+_STR_1048590:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048591:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
@@ -0,0 +1,303 @@
+	.file	"float.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	float_test, @function
+float_test:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	fsw	fa0, -12(s0)
+	fsw	fa1, -16(s0)
+block1048577:
+	flw	ft0, -12(s0)
+	flw	ft1, -16(s0)
+	fadd.s	ft2, ft0, ft1
+	fsw	ft2, -20(s0)
+	flw	ft0, -12(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048578)
+	addi	t0, t0, %pcrel_lo(1b)
+	flw	ft1, -16(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+	flw	ft2, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048581)
+	addi	t0, t0, %pcrel_lo(1b)
+	addi	sp, sp, -16
+	mv	a0, t0
+	fcvt.d.s	ft11, ft0
+	fsd	ft11, 0(sp)
+	lw	a2, 0(sp)
+	lw	a3, 4(sp)
+	fcvt.d.s	ft11, ft1
+	fsd	ft11, 0(sp)
+	lw	a4, 0(sp)
+	lw	a5, 4(sp)
+	fcvt.d.s	ft11, ft2
+	fsd	ft11, 0(sp)
+	lw	a6, 0(sp)
+	lw	a7, 4(sp)
+	call	printf
+	addi	sp, sp, 16
+	flw	ft0, -12(s0)
+	flw	ft1, -16(s0)
+	fsub.s	ft2, ft0, ft1
+	fsw	ft2, -20(s0)
+	flw	ft0, -12(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048582)
+	addi	t0, t0, %pcrel_lo(1b)
+	flw	ft1, -16(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+	flw	ft2, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048583)
+	addi	t0, t0, %pcrel_lo(1b)
+	addi	sp, sp, -16
+	mv	a0, t0
+	fcvt.d.s	ft11, ft0
+	fsd	ft11, 0(sp)
+	lw	a2, 0(sp)
+	lw	a3, 4(sp)
+	fcvt.d.s	ft11, ft1
+	fsd	ft11, 0(sp)
+	lw	a4, 0(sp)
+	lw	a5, 4(sp)
+	fcvt.d.s	ft11, ft2
+	fsd	ft11, 0(sp)
+	lw	a6, 0(sp)
+	lw	a7, 4(sp)
+	call	printf
+	addi	sp, sp, 16
+	flw	ft0, -12(s0)
+	flw	ft1, -16(s0)
+	fmul.s	ft2, ft0, ft1
+	fsw	ft2, -20(s0)
+	flw	ft0, -12(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048584)
+	addi	t0, t0, %pcrel_lo(1b)
+	flw	ft1, -16(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+	flw	ft2, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048585)
+	addi	t0, t0, %pcrel_lo(1b)
+	addi	sp, sp, -16
+	mv	a0, t0
+	fcvt.d.s	ft11, ft0
+	fsd	ft11, 0(sp)
+	lw	a2, 0(sp)
+	lw	a3, 4(sp)
+	fcvt.d.s	ft11, ft1
+	fsd	ft11, 0(sp)
+	lw	a4, 0(sp)
+	lw	a5, 4(sp)
+	fcvt.d.s	ft11, ft2
+	fsd	ft11, 0(sp)
+	lw	a6, 0(sp)
+	lw	a7, 4(sp)
+	call	printf
+	addi	sp, sp, 16
+	flw	ft0, -12(s0)
+	flw	ft1, -16(s0)
+	fdiv.s	ft2, ft0, ft1
+	fsw	ft2, -20(s0)
+	flw	ft0, -12(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048586)
+	addi	t0, t0, %pcrel_lo(1b)
+	flw	ft1, -16(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+	flw	ft2, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048587)
+	addi	t0, t0, %pcrel_lo(1b)
+	addi	sp, sp, -16
+	mv	a0, t0
+	fcvt.d.s	ft11, ft0
+	fsd	ft11, 0(sp)
+	lw	a2, 0(sp)
+	lw	a3, 4(sp)
+	fcvt.d.s	ft11, ft1
+	fsd	ft11, 0(sp)
+	lw	a4, 0(sp)
+	lw	a5, 4(sp)
+	fcvt.d.s	ft11, ft2
+	fsd	ft11, 0(sp)
+	lw	a6, 0(sp)
+	lw	a7, 4(sp)
+	call	printf
+	addi	sp, sp, 16
+1:	auipc	t6, %pcrel_hi(_L_CONST_1048588)
+	flw	ft0, %pcrel_lo(1b)(t6)
+	fsw	ft0, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048589)
+	addi	t0, t0, %pcrel_lo(1b)
+	flw	ft0, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048590)
+	addi	t0, t0, %pcrel_lo(1b)
+	addi	sp, sp, -16
+	mv	a0, t0
+	fcvt.d.s	ft11, ft0
+	fsd	ft11, 0(sp)
+	lw	a2, 0(sp)
+	lw	a3, 4(sp)
+	call	printf
+	addi	sp, sp, 16
+	li	t0, 1
+	fcvt.s.w	ft0, t0
+	fsw	ft0, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048589)
+	addi	t0, t0, %pcrel_lo(1b)
+	flw	ft0, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048590)
+	addi	t0, t0, %pcrel_lo(1b)
+	addi	sp, sp, -16
+	mv	a0, t0
+	fcvt.d.s	ft11, ft0
+	fsd	ft11, 0(sp)
+	lw	a2, 0(sp)
+	lw	a3, 4(sp)
+	call	printf
+	addi	sp, sp, 16
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	float_test, .-float_test
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 2
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048593)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 4(t0)	# Load argv[1]
+	addi	a1, s0, -20
+	li	s1, 1
+	call	strtod
+	fcvt.s.d	fa0, fa0
+	lw	t1, -20(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 4(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	fsw	fa0, -20(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 8(t0)	# Load argv[2]
+	addi	a1, s0, -24
+	li	s1, 2
+	call	strtod
+	fcvt.s.d	fa0, fa0
+	lw	t1, -24(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 8(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	fsw	fa0, -24(s0)
+	flw	fa0, -20(s0)	# Load parsed argv[1]
+	flw	fa1, -24(s0)	# Load parsed argv[2]
+	call	float_test
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048594)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_L_CONST_1048588:
+	.word	0x401ccccd	# 2.450000
+_STR_1048578:
+	.asciz	"+"
+_STR_1048579:
+	.asciz	"="
+_STR_1048581:
+	.asciz	"%f + %f = %f\n"
+_STR_1048582:
+	.asciz	"-"
+_STR_1048583:
+	.asciz	"%f - %f = %f\n"
+_STR_1048584:
+	.asciz	"*"
+_STR_1048585:
+	.asciz	"%f * %f = %f\n"
+_STR_1048586:
+	.asciz	"/"
+_STR_1048587:
+	.asciz	"%f / %f = %f\n"
+_STR_1048589:
+	.asciz	"c is now a constant: "
+_STR_1048590:
+	.asciz	"c is now a constant:  %f\n"
+_STR_1048593:
+	.asciz	"Argument error: expected 2 arguments, got %d\n"
+_STR_1048594:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
@@ -0,0 +1,232 @@
+	.file	"funcall.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	defall, @function
+defall:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+block1048579:
+	li	t0, 5
+	sw	t0, -12(s0)
+	li	t0, 10
+	sw	t0, -16(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048582)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048584)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	my_deftion
+	sw	a0, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048585)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -20(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048586)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	call	my_other_deftion
+	sw	a0, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048587)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -20(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048588)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	defall, .-defall
+
+	.align	2
+	.type	my_deftion, @function
+my_deftion:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+block1048590:
+	lw	t0, -12(s0)
+	lw	t1, -12(s0)
+	mul	t2, t0, t1
+	lw	t0, -16(s0)
+	lw	t1, -16(s0)
+	mul	t3, t0, t1
+	add	t0, t2, t3
+	sw	t0, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048591)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048592)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048593)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -16(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048594)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048595)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -20(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048596)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	lw	a0, -20(s0)
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	my_deftion, .-my_deftion
+
+	.align	2
+	.type	my_other_deftion, @function
+my_other_deftion:
+	.cfi_startproc
+	addi	sp, sp, -16
+	.cfi_def_cfa_offset	16
+	sw	ra, 12(sp)
+	sw	s0, 8(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 16
+	.cfi_def_cfa	8, 0
+block1048597:
+	li	t0, 42
+	sw	t0, -12(s0)
+	lw	a0, -12(s0)
+	.cfi_remember_state
+	.cfi_def_cfa	2, 16
+	lw	ra, 12(sp)
+	lw	s0, 8(sp)
+	addi	sp, sp, 16
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	my_other_deftion, .-my_other_deftion
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048599)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	defall
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048582:
+	.asciz	"Calling my_deftion with parameters"
+_STR_1048584:
+	.asciz	"Calling my_deftion with parameters %d %d\n"
+_STR_1048585:
+	.asciz	"The returned result is"
+_STR_1048586:
+	.asciz	"The returned result is %d\n"
+_STR_1048587:
+	.asciz	"The other returned result is"
+_STR_1048588:
+	.asciz	"The other returned result is %d\n"
+_STR_1048591:
+	.asciz	"Parameter s is"
+_STR_1048592:
+	.asciz	"Parameter s is %d\n"
+_STR_1048593:
+	.asciz	"Parameter t is"
+_STR_1048594:
+	.asciz	"Parameter t is %d\n"
+_STR_1048595:
+	.asciz	"The sum of their squares is"
+_STR_1048596:
+	.asciz	"The sum of their squares is %d\n"
+_STR_1048599:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
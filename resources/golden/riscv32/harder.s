@@ -0,0 +1,228 @@
+	.file	"harder.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	start, @function
+start:
+	.cfi_startproc
+	addi	sp, sp, -16
+	.cfi_def_cfa_offset	16
+	sw	ra, 12(sp)
+	sw	s0, 8(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 16
+	.cfi_def_cfa	8, 0
+block1048578:
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048581)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	t0, 1
+	mv	a0, t0
+	call	test_me
+	sw	a0, -12(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048583)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048584)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 16
+	lw	ra, 12(sp)
+	lw	s0, 8(sp)
+	addi	sp, sp, 16
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	start, .-start
+
+	.align	2
+	.type	test_me, @function
+test_me:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+block1048586:
+1:	auipc	t0, %pcrel_hi(_STR_1048587)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048588)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	t0, 2
+	sw	t0, -16(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048590)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -16(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048591)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	t0, 3
+	sw	t0, -24(s0)
+	li	t0, 4
+	sw	t0, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048594)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048595)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -20(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048596)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	li	t0, 5
+	sw	t0, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048598)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -20(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048599)
+	addi	t1, t1, %pcrel_lo(1b)
+1:	auipc	t1, %pcrel_hi(_STR_1048600)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048601)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -16(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048602)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048603)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048604)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	lw	t0, -12(s0)
+	li	t1, 1
+	sub	a0, t0, t1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	test_me, .-test_me
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048605)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	start
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048579:
+	.asciz	"Nested scopes coming up..."
+_STR_1048581:
+	.asciz	"Nested scopes coming up...\n"
+_STR_1048583:
+	.asciz	"x:="
+_STR_1048584:
+	.asciz	"x:= %d\n"
+_STR_1048587:
+	.asciz	"Parameter a is a:="
+_STR_1048588:
+	.asciz	"Parameter a is a:= %d\n"
+_STR_1048590:
+	.asciz	"Outer scope has a:="
+_STR_1048591:
+	.asciz	"Outer scope has a:= %d\n"
+_STR_1048594:
+	.asciz	"Inner scope has a:="
+_STR_1048595:
+	.asciz	"and b:="
+_STR_1048596:
+	.asciz	"Inner scope has a:= %d and b:= %d\n"
+_STR_1048598:
+	.asciz	"b was updated to "
+_STR_1048599:
+	.asciz	"in inner scope"
+_STR_1048600:
+	.asciz	"b was updated to  %d in inner scope\n"
+_STR_1048601:
+	.asciz	"Outer scope (still) has a:="
+_STR_1048602:
+	.asciz	"Outer scope (still) has a:= %d\n"
+_STR_1048603:
+	.asciz	"Return expression (a-1) using a:="
+_STR_1048604:
+	.asciz	"Return expression (a-1) using a:= %d\n"
+_STR_1048605:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
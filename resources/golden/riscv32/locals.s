@@ -0,0 +1,193 @@
+	.file	"locals.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	nesting_scopes, @function
+nesting_scopes:
+	.cfi_startproc
+	addi	sp, sp, -64
+	.cfi_def_cfa_offset	64
+	sw	ra, 60(sp)
+	sw	s0, 56(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 64
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	sw	a2, -20(s0)
+block1048580:
+	li	t0, 21
+	sw	t0, -24(s0)
+	li	t0, 42
+	sw	t0, -48(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048583)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -48(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048585)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048586)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048587)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048588)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t6, %pcrel_hi(k)
+	lw	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t1, %pcrel_hi(_STR_1048589)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 64
+	lw	ra, 60(sp)
+	lw	s0, 56(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	nesting_scopes, .-nesting_scopes
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 3
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048591)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 4(t0)	# Load argv[1]
+	addi	a1, s0, -20
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	lw	t1, -20(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 4(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -20(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 8(t0)	# Load argv[2]
+	addi	a1, s0, -24
+	li	s1, 2
+	li	a2, 10
+	call	strtol
+	lw	t1, -24(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 8(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -24(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 12(t0)	# Load argv[3]
+	addi	a1, s0, -28
+	li	s1, 3
+	li	a2, 10
+	call	strtol
+	lw	t1, -28(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 12(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -28(s0)
+	lw	a0, -20(s0)	# Load parsed argv[1]
+	lw	a1, -24(s0)	# Load parsed argv[2]
+	lw	a2, -28(s0)	# Load parsed argv[3]
+	call	nesting_scopes
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048592)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+i:
+	.word	0x0
+j:
+	.word	0x0
+k:
+	.word	0x0
+_STR_1048583:
+	.asciz	"Inner a is "
+_STR_1048585:
+	.asciz	"Inner a is  %d\n"
+_STR_1048586:
+	.asciz	"Outer a is "
+_STR_1048587:
+	.asciz	"Outer a is  %d\n"
+_STR_1048588:
+	.asciz	"Global k is "
+_STR_1048589:
+	.asciz	"Global k is  %d\n"
+_STR_1048591:
+	.asciz	"Argument error: expected 3 arguments, got %d\n"
+_STR_1048592:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
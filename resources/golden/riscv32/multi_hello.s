@@ -0,0 +1,416 @@
+	.file	"multi_hello.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	hello, @function
+hello:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	sw	s2, 4(sp)
+	.cfi_offset	18, -28
+	sw	s3, 8(sp)
+	.cfi_offset	19, -24
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+block1048583:
+1:	auipc	t0, %pcrel_hi(_STR_1048584)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048585)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048587)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048588)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048589)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048590)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048591)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	t0, 42
+	li	t1, 43
+	li	t2, 44
+1:	auipc	t3, %pcrel_hi(_STR_1048595)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	li	t0, 30
+1:	auipc	t6, %pcrel_hi(x)
+	sw	t0, %pcrel_lo(1b)(t6)
+	li	t0, -15
+1:	auipc	t6, %pcrel_hi(y)
+	sw	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(x)
+	lw	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(x)
+	lw	t1, %pcrel_lo(1b)(t6)
+	mul	t2, t0, t1
+1:	auipc	t6, %pcrel_hi(y)
+	lw	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(y)
+	lw	t1, %pcrel_lo(1b)(t6)
+	mul	t3, t0, t1
+	add	t0, t2, t3
+1:	auipc	t6, %pcrel_hi(z)
+	sw	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t0, %pcrel_hi(_STR_1048598)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t6, %pcrel_hi(x)
+	lw	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(y)
+	lw	t1, %pcrel_lo(1b)(t6)
+	add	t2, t0, t1
+1:	auipc	t0, %pcrel_hi(_STR_1048599)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	mv	a1, t2
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048600)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t6, %pcrel_hi(x)
+	lw	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(y)
+	lw	t1, %pcrel_lo(1b)(t6)
+	sub	t2, t0, t1
+1:	auipc	t0, %pcrel_hi(_STR_1048601)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	mv	a1, t2
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048602)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t6, %pcrel_hi(x)
+	lw	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(y)
+	lw	t1, %pcrel_lo(1b)(t6)
+	mul	t2, t0, t1
+1:	auipc	t0, %pcrel_hi(_STR_1048603)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	mv	a1, t2
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048604)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t6, %pcrel_hi(x)
+	lw	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(y)
+	lw	t1, %pcrel_lo(1b)(t6)
+	div	t2, t0, t1
+1:	auipc	t0, %pcrel_hi(_STR_1048605)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	mv	a1, t2
+	call	printf
+	li	t0, 1
+	li	t1, 2
+	li	t2, 3
+	li	t3, 4
+	li	t4, 5
+	li	s1, 6
+	li	s2, 7
+	li	s3, 8
+	fcvt.s.w	ft0, t0
+	fcvt.s.w	ft1, t1
+	fcvt.s.w	ft2, t2
+	fcvt.s.w	ft3, t3
+	fcvt.s.w	ft4, t4
+	fcvt.s.w	ft5, s1
+	fcvt.s.w	ft6, s2
+	fcvt.s.w	ft7, s3
+	fmv.s	fa0, ft0
+	fmv.s	fa1, ft1
+	fmv.s	fa2, ft2
+	fmv.s	fa3, ft3
+	fmv.s	fa4, ft4
+	fmv.s	fa5, ft5
+	fmv.s	fa6, ft6
+	fmv.s	fa7, ft7
+	call	goodbye
+1:	auipc	t6, %pcrel_hi(w)
+	sw	a0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(w)
+	lw	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t1, %pcrel_hi(_STR_1048614)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	lw	s2, 4(sp)
+	lw	s3, 8(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	hello, .-hello
+
+	.align	2
+	.type	tralala, @function
+tralala:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	fsw	fa0, -12(s0)
+block1048616:
+	li	t0, 3
+	fcvt.s.w	ft0, t0
+	fsw	ft0, -16(s0)
+	li	t0, 5
+	fcvt.s.w	ft0, t0
+	fsw	ft0, -20(s0)
+	li	t0, 2
+	fcvt.s.w	ft0, t0
+	fsw	ft0, -24(s0)
+	li	t0, 4
+	fcvt.s.w	ft0, t0
+	fsw	ft0, -28(s0)
+	li	t0, 42
+	fcvt.s.w	ft0, t0
+	fsw	ft0, -12(s0)
+	flw	ft0, -12(s0)
+	flw	ft1, -16(s0)
+	flw	ft2, -20(s0)
+	fmul.s	ft3, ft1, ft2
+	flw	ft1, -24(s0)
+	flw	ft2, -28(s0)
+	fmul.s	ft4, ft1, ft2
+1:	auipc	t0, %pcrel_hi(_STR_1048617)
+	addi	t0, t0, %pcrel_lo(1b)
+	addi	sp, sp, -16
+	mv	a0, t0
+	fcvt.d.s	ft11, ft0
+	fsd	ft11, 0(sp)
+	lw	a2, 0(sp)
+	lw	a3, 4(sp)
+	fcvt.d.s	ft11, ft3
+	fsd	ft11, 0(sp)
+	lw	a4, 0(sp)
+	lw	a5, 4(sp)
+	fcvt.d.s	ft11, ft4
+	fsd	ft11, 0(sp)
+	lw	a6, 0(sp)
+	lw	a7, 4(sp)
+	call	printf
+	addi	sp, sp, 16
+	li	a0, 1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	tralala, .-tralala
+
+	.align	2
+	.type	goodbye, @function
+goodbye:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sw	ra, 44(sp)
+	sw	s0, 40(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	fsw	fa0, -12(s0)
+	fsw	fa1, -16(s0)
+	fsw	fa2, -20(s0)
+	fsw	fa3, -24(s0)
+	fsw	fa4, -28(s0)
+	fsw	fa5, -32(s0)
+	fsw	fa6, -36(s0)
+	fsw	fa7, -40(s0)
+block1048618:
+	flw	ft0, -12(s0)
+	flw	ft1, -16(s0)
+	flw	ft2, -20(s0)
+	flw	ft3, -24(s0)
+	flw	ft4, -28(s0)
+	flw	ft5, -32(s0)
+	flw	ft6, -36(s0)
+	flw	ft7, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048619)
+	addi	t0, t0, %pcrel_lo(1b)
+	addi	sp, sp, -48
+	mv	a0, t0
+	fcvt.d.s	ft11, ft0
+	fsd	ft11, 40(sp)
+	lw	a2, 40(sp)
+	lw	a3, 44(sp)
+	fcvt.d.s	ft11, ft1
+	fsd	ft11, 40(sp)
+	lw	a4, 40(sp)
+	lw	a5, 44(sp)
+	fcvt.d.s	ft11, ft2
+	fsd	ft11, 40(sp)
+	lw	a6, 40(sp)
+	lw	a7, 44(sp)
+	fcvt.d.s	ft11, ft3
+	fsd	ft11, 0(sp)
+	fcvt.d.s	ft11, ft4
+	fsd	ft11, 8(sp)
+	fcvt.d.s	ft11, ft5
+	fsd	ft11, 16(sp)
+	fcvt.d.s	ft11, ft6
+	fsd	ft11, 24(sp)
+	fcvt.d.s	ft11, ft7
+	fsd	ft11, 32(sp)
+	call	printf
+	addi	sp, sp, 48
+1:	auipc	t0, %pcrel_hi(_STR_1048620)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048621)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	t0, 1
+	fcvt.s.w	ft0, t0
+	fmv.s	fa0, ft0
+	call	tralala
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	lw	ra, 44(sp)
+	lw	s0, 40(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	goodbye, .-goodbye
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048622)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	hello
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+w:
+	.word	0x0
+x:
+	.word	0x0
+y:
+	.word	0x0
+z:
+	.word	0x0
+_STR_1048584:
+	.asciz	"Hello,"
+_STR_1048585:
+	.asciz	"world!"
+_STR_1048587:
+	.asciz	"Hello, world!\n"
+_STR_1048588:
+	.asciz	"How"
+_STR_1048589:
+	.asciz	"are"
+_STR_1048590:
+	.asciz	"you?"
+_STR_1048591:
+	.asciz	"How are you?\n"
+_STR_1048595:
+	.asciz	"%d %d %d\n"
+_STR_1048598:
+	.asciz	"x + y :="
+_STR_1048599:
+	.asciz	"x + y := %d\n"
+_STR_1048600:
+	.asciz	"x - y :="
+_STR_1048601:
+	.asciz	"x - y := %d\n"
+_STR_1048602:
+	.asciz	"x * y :="
+_STR_1048603:
+	.asciz	"x * y := %d\n"
+_STR_1048604:
+	.asciz	"x / y :="
+_STR_1048605:
+	.asciz	"x / y := %d\n"
+_STR_1048614:
+	.asciz	"%d\n"
+_STR_1048617:
+	.asciz	"%f %f %f\n"
+_STR_1048619:
+	.asciz	"%f %f %f %f %f %f %f %f\n"
+_STR_1048620:
+	.asciz	"Morna"
+_STR_1048621:
+	.asciz	"Morna\n"
+_STR_1048622:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
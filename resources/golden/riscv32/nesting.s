@@ -0,0 +1,200 @@
+	.file	"nesting.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	hello, @function
+hello:
+	.cfi_startproc
+	addi	sp, sp, -16
+	.cfi_def_cfa_offset	16
+	sw	ra, 12(sp)
+	sw	s0, 8(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 16
+	.cfi_def_cfa	8, 0
+block1048578:
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048581)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	t0, 42
+	mv	a0, t0
+	call	test_me
+	sw	a0, -12(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048583)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048584)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 16
+	lw	ra, 12(sp)
+	lw	s0, 8(sp)
+	addi	sp, sp, 16
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	hello, .-hello
+
+	.align	2
+	.type	test_me, @function
+test_me:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+block1048586:
+	li	t0, 32
+	sw	t0, -16(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048588)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -16(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048589)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	t0, 64
+	sw	t0, -24(s0)
+	li	t0, 27
+	sw	t0, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048592)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048593)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -20(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048594)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	li	t0, 128
+	sw	t0, -20(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048596)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -20(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048597)
+	addi	t1, t1, %pcrel_lo(1b)
+1:	auipc	t1, %pcrel_hi(_STR_1048598)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048588)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -16(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048589)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	lw	t0, -12(s0)
+	li	t1, 1
+	add	a0, t0, t1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	test_me, .-test_me
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048600)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	hello
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048579:
+	.asciz	"Hello, world!"
+_STR_1048581:
+	.asciz	"Hello, world!\n"
+_STR_1048583:
+	.asciz	"x:="
+_STR_1048584:
+	.asciz	"x:= %d\n"
+_STR_1048588:
+	.asciz	"Outer scope has a:="
+_STR_1048589:
+	.asciz	"Outer scope has a:= %d\n"
+_STR_1048592:
+	.asciz	"I have a:="
+_STR_1048593:
+	.asciz	"and b:="
+_STR_1048594:
+	.asciz	"I have a:= %d and b:= %d\n"
+_STR_1048596:
+	.asciz	"B was reassigned to "
+_STR_1048597:
+	.asciz	"in inner"
+_STR_1048598:
+	.asciz	"B was reassigned to  %d in inner\n"
+_STR_1048600:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
@@ -0,0 +1,170 @@
+	.file	"prime.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	mainfunc, @function
+mainfunc:
+	.cfi_startproc
+	addi	sp, sp, -16
+	.cfi_def_cfa_offset	16
+	sw	ra, 12(sp)
+	sw	s0, 8(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 16
+	.cfi_def_cfa	8, 0
+block1048578:
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 16
+	lw	ra, 12(sp)
+	lw	s0, 8(sp)
+	addi	sp, sp, 16
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	mainfunc, .-mainfunc
+
+	.align	2
+	.type	factor, @function
+factor:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+block1048580:
+	lw	t0, -12(s0)
+	li	t1, 2
+	li	t1, 31
+	srl	t2, t0, t1
+	add	t1, t0, t2
+	li	t0, 1
+	sra	t2, t1, t0
+	sw	t2, -16(s0)
+block1048582:
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	lw	t2, -12(s0)
+	lw	t3, -16(s0)
+	div	t4, t2, t3
+	mul	t2, t1, t4
+	sub	t1, t0, t2
+	li	t0, 0
+	sub	t2, t1, t0
+	li	t0, 0
+	ble	t2, t0, block1048584
+block1048583:
+	lw	t0, -16(s0)
+	li	t1, 1
+	sub	t2, t0, t1
+	sw	t2, -16(s0)
+	j	block1048582
+block1048584:
+	lw	t0, -16(s0)
+	li	t1, 1
+	sub	t2, t0, t1
+	li	t0, 0
+	sub	t1, t2, t0
+	li	t0, 0
+	ble	t1, t0, block1048587
+block1048586:
+	lw	t0, -16(s0)
+	mv	a0, t0
+	call	factor
+	sw	a0, -20(s0)
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	div	t2, t0, t1
+	mv	a0, t2
+	call	factor
+	sw	a0, -20(s0)
+	j	block1048588
+block1048587:
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048589)
+	addi	t1, t1, %pcrel_lo(1b)
+1:	auipc	t1, %pcrel_hi(_STR_1048591)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+block1048588:
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	factor, .-factor
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048593)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	mainfunc
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048589:
+	.asciz	"is a prime factor"
+_STR_1048591:
+	.asciz	"%d is a prime factor\n"
+_STR_1048593:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
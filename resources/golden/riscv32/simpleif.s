@@ -0,0 +1,280 @@
+	.file	"simpleif.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	dingdong, @function
+dingdong:
+	.cfi_startproc
+	addi	sp, sp, -64
+	.cfi_def_cfa_offset	64
+	sw	ra, 60(sp)
+	sw	s0, 56(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -64
+	sw	s2, 4(sp)
+	.cfi_offset	18, -60
+	sw	s3, 8(sp)
+	.cfi_offset	19, -56
+	addi	s0, sp, 64
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	sw	a2, -20(s0)
+	sw	a3, -24(s0)
+	sw	a4, -28(s0)
+	sw	a5, -32(s0)
+	sw	a6, -36(s0)
+block1048577:
+	li	t0, 42
+	sw	t0, -40(s0)
+	lw	t0, -12(s0)
+	lw	t1, -16(s0)
+	lw	t2, -20(s0)
+	lw	t3, -24(s0)
+	lw	t4, -28(s0)
+	lw	s1, -32(s0)
+	lw	s3, -36(s0)
+1:	auipc	s2, %pcrel_hi(_STR_1048580)
+	addi	s2, s2, %pcrel_lo(1b)
+	mv	a0, s2
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	mv	a4, t3
+	mv	a5, t4
+	mv	a6, s1
+	mv	a7, s3
+	call	printf
+	lw	t0, -40(s0)
+	li	t1, 42
+	sub	t2, t0, t1
+	li	t0, 0
+	bne	t2, t0, block1048582
+block1048581:
+1:	auipc	t0, %pcrel_hi(_STR_1048584)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048585)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	t0, 43
+	sw	t0, -40(s0)
+	j	block1048589
+block1048582:
+	li	t0, 44
+	sw	t0, -40(s0)
+block1048589:
+	lw	t0, -40(s0)
+	li	t1, 0
+	sub	t2, t0, t1
+	li	t0, 0
+	ble	t2, t0, block1048591
+block1048590:
+	lw	t0, -40(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048592)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	lw	t0, -40(s0)
+	li	t1, 1
+	sub	t2, t0, t1
+	sw	t2, -40(s0)
+	j	block1048589
+block1048591:
+	lw	a0, -40(s0)
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	lw	s2, 4(sp)
+	lw	s3, 8(sp)
+	.cfi_def_cfa	2, 64
+	lw	ra, 60(sp)
+	lw	s0, 56(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	dingdong, .-dingdong
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sw	ra, 44(sp)
+	sw	s0, 40(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -48
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 7
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048594)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	lw	ra, 44(sp)
+	lw	s0, 40(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 4(t0)	# Load argv[1]
+	addi	a1, s0, -20
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	lw	t1, -20(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 4(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -20(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 8(t0)	# Load argv[2]
+	addi	a1, s0, -24
+	li	s1, 2
+	li	a2, 10
+	call	strtol
+	lw	t1, -24(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 8(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -24(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 12(t0)	# Load argv[3]
+	addi	a1, s0, -28
+	li	s1, 3
+	li	a2, 10
+	call	strtol
+	lw	t1, -28(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 12(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -28(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 16(t0)	# Load argv[4]
+	addi	a1, s0, -32
+	li	s1, 4
+	li	a2, 10
+	call	strtol
+	lw	t1, -32(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 16(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -32(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 20(t0)	# Load argv[5]
+	addi	a1, s0, -36
+	li	s1, 5
+	li	a2, 10
+	call	strtol
+	lw	t1, -36(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 20(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -36(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 24(t0)	# Load argv[6]
+	addi	a1, s0, -40
+	li	s1, 6
+	li	a2, 10
+	call	strtol
+	lw	t1, -40(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 24(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -40(s0)
+	lw	t0, -16(s0)	# Load argv
+	lw	a0, 28(t0)	# Load argv[7]
+	addi	a1, s0, -44
+	li	s1, 7
+	li	a2, 10
+	call	strtol
+	lw	t1, -44(s0)	# Load end pointer
+	lw	t0, -16(s0)
+	lw	t2, 28(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sw	a0, -44(s0)
+	lw	a0, -20(s0)	# Load parsed argv[1]
+	lw	a1, -24(s0)	# Load parsed argv[2]
+	lw	a2, -28(s0)	# Load parsed argv[3]
+	lw	a3, -32(s0)	# Load parsed argv[4]
+	lw	a4, -36(s0)	# Load parsed argv[5]
+	lw	a5, -40(s0)	# Load parsed argv[6]
+	lw	a6, -44(s0)	# Load parsed argv[7]
+	call	dingdong
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	lw	ra, 44(sp)
+	lw	s0, 40(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048595)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	lw	ra, 44(sp)
+	lw	s0, 40(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048580:
+	.asciz	"%d %d %d %d %d %d %d\n"
+_STR_1048584:
+	.asciz	"Equal!"
+_STR_1048585:
+	.asciz	"Equal!\n"
+_STR_1048592:
+	.asciz	"%d\n"
+_STR_1048594:
+	.asciz	"Argument error: expected 7 arguments, got %d\n"
+_STR_1048595:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
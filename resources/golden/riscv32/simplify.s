@@ -0,0 +1,187 @@
+	.file	"simplify.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	f, @function
+f:
+	.cfi_startproc
+	addi	sp, sp, -16
+	.cfi_def_cfa_offset	16
+	sw	ra, 12(sp)
+	sw	s0, 8(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 16
+	.cfi_def_cfa	8, 0
+block1048579:
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 16
+	lw	ra, 12(sp)
+	lw	s0, 8(sp)
+	addi	sp, sp, 16
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	f, .-f
+
+	.align	2
+	.type	g, @function
+g:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sw	ra, 44(sp)
+	sw	s0, 40(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	sw	a2, -20(s0)
+block1048581:
+	li	t0, 1
+	sw	t0, -24(s0)
+	li	t0, 2
+	sw	t0, -28(s0)
+	lw	t0, -36(s0)
+	li	t1, 1
+	add	t3, t0, t1
+	lw	t0, -40(s0)
+	li	t1, 2
+	add	t2, t0, t1
+	lw	t0, -44(s0)
+	li	t1, 3
+	add	t4, t0, t1
+	mv	a0, t3
+	mv	a1, t2
+	mv	a2, t4
+	call	g
+	sw	a0, -32(s0)
+	lw	t0, -24(s0)
+	lw	t1, -28(s0)
+	lw	t2, -32(s0)
+1:	auipc	t3, %pcrel_hi(_STR_1048586)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	lw	ra, 44(sp)
+	lw	s0, 40(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	g, .-g
+
+	.align	2
+	.type	h, @function
+h:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+block1048587:
+	li	t0, 5
+	fcvt.s.w	ft0, t0
+	fsw	ft0, -20(s0)
+	li	t0, 1
+	fcvt.s.w	ft0, t0
+	fsw	ft0, -20(s0)
+	li	t0, 4
+	fcvt.s.w	ft0, t0
+	fsw	ft0, -20(s0)
+	li	t0, 2
+	fcvt.s.w	ft0, t0
+	fsw	ft0, -20(s0)
+	li	t0, -6
+	fcvt.s.w	ft0, t0
+	fsw	ft0, -20(s0)
+	li	t0, 4
+	fcvt.s.w	ft0, t0
+	fsw	ft0, -20(s0)
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	h, .-h
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048591)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	f
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048586:
+	.asciz	"%d %d %d\n"
+_STR_1048591:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
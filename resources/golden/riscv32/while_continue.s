@@ -0,0 +1,159 @@
+	.file	"while_continue.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	while_continue, @function
+while_continue:
+	.cfi_startproc
+	addi	sp, sp, -16
+	.cfi_def_cfa_offset	16
+	sw	ra, 12(sp)
+	sw	s0, 8(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 16
+	.cfi_def_cfa	8, 0
+block1048577:
+	li	t0, 0
+	sw	t0, -12(s0)
+block1048579:
+	lw	t0, -12(s0)
+	li	t1, 3
+	sub	t2, t0, t1
+	li	t0, 0
+	bge	t2, t0, block1048581
+block1048580:
+	lw	t0, -12(s0)
+	li	t1, 1
+	add	t2, t0, t1
+	sw	t2, -12(s0)
+	li	t0, 0
+	sw	t0, -16(s0)
+	j	block1048584
+block1048581:
+1:	auipc	t0, %pcrel_hi(_STR_1048597)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048598)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 16
+	lw	ra, 12(sp)
+	lw	s0, 8(sp)
+	addi	sp, sp, 16
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+block1048584:
+	lw	t0, -16(s0)
+	li	t1, 2
+	sub	t2, t0, t1
+	li	t0, 0
+	bge	t2, t0, block1048586
+block1048585:
+	lw	t0, -16(s0)
+	li	t1, 1
+	add	t2, t0, t1
+	sw	t2, -16(s0)
+	j	block1048584
+block1048586:
+1:	auipc	t0, %pcrel_hi(_STR_1048588)
+	addi	t0, t0, %pcrel_lo(1b)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048589)
+	addi	t1, t1, %pcrel_lo(1b)
+	lw	t1, -16(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048591)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	lw	t0, -12(s0)
+	li	t1, 5
+	sub	t2, t0, t1
+	li	t0, 0
+	blt	t2, t0, block1048579
+block1048594:
+1:	auipc	t0, %pcrel_hi(_STR_1048595)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048596)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	j	block1048579
+	.cfi_endproc
+	.size	while_continue, .-while_continue
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048599)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	while_continue
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048588:
+	.asciz	"a"
+_STR_1048589:
+	.asciz	"b"
+_STR_1048591:
+	.asciz	"a %d b %d\n"
+_STR_1048595:
+	.asciz	"unreachable"
+_STR_1048596:
+	.asciz	"unreachable\n"
+_STR_1048597:
+	.asciz	"done"
+_STR_1048598:
+	.asciz	"done\n"
+_STR_1048599:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
@@ -0,0 +1,152 @@
+	.file	"while_test.vsl"
+	.attribute	arch, "rv32gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	while_test, @function
+while_test:
+	.cfi_startproc
+	addi	sp, sp, -16
+	.cfi_def_cfa_offset	16
+	sw	ra, 12(sp)
+	sw	s0, 8(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	addi	s0, sp, 16
+	.cfi_def_cfa	8, 0
+block1048577:
+	li	t0, 20
+	sw	t0, -12(s0)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048580)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	lw	t0, -12(s0)
+	li	t1, 0
+	sub	t2, t0, t1
+	li	t0, 0
+	ble	t2, t0, block1048586
+block1048581:
+1:	auipc	t0, %pcrel_hi(_STR_1048584)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048585)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+block1048586:
+	lw	t0, -12(s0)
+	li	t1, 0
+	sub	t2, t0, t1
+	li	t0, 0
+	ble	t2, t0, block1048588
+block1048587:
+	lw	t0, -12(s0)
+	li	t1, 10
+	sub	t2, t0, t1
+	li	t0, 0
+	beq	t2, t0, block1048589
+	j	block1048591
+block1048588:
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 16
+	lw	ra, 12(sp)
+	lw	s0, 8(sp)
+	addi	sp, sp, 16
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+block1048589:
+	lw	t0, -12(s0)
+	li	t1, 1
+	sub	t2, t0, t1
+	sw	t2, -12(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048593)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048594)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	j	block1048586
+block1048591:
+	lw	t0, -12(s0)
+	li	t1, 1
+	sub	t2, t0, t1
+	sw	t2, -12(s0)
+	lw	t0, -12(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048580)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	j	block1048586
+	.cfi_endproc
+	.size	while_test, .-while_test
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sw	ra, 28(sp)
+	sw	s0, 24(sp)
+	.cfi_offset	1, -4
+	.cfi_offset	8, -8
+	sw	s1, 0(sp)
+	.cfi_offset	9, -32
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sw	a0, -12(s0)
+	sw	a1, -16(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048596)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	while_test
+	.cfi_remember_state
+	lw	s1, 0(sp)
+	.cfi_def_cfa	2, 32
+	lw	ra, 28(sp)
+	lw	s0, 24(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048580:
+	.asciz	"%d\n"
+_STR_1048584:
+	.asciz	"foobar"
+_STR_1048585:
+	.asciz	"foobar\n"
+_STR_1048593:
+	.asciz	"Skip..."
+_STR_1048594:
+	.asciz	"Skip...\n"
+_STR_1048596:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
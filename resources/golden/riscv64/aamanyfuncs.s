@@ -0,0 +1,3673 @@
+	.file	"aamanyfuncs.vsl"
+	.attribute	arch, "rv64gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	tester, @function
+tester:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sd	ra, 24(sp)
+	sd	s0, 16(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+block1048588:
+	li	t0, 10
+	sd	t0, -24(s0)
+	li	t0, 5
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	mv	t0, a0
+1:	auipc	t1, %pcrel_hi(_STR_1048592)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	mv	t0, a0
+1:	auipc	t1, %pcrel_hi(_STR_1048592)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	ld	ra, 24(sp)
+	ld	s0, 16(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	tester, .-tester
+
+	.align	2
+	.type	add, @function
+add:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048594:
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	add	a0, t0, t1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	add, .-add
+
+	.align	2
+	.type	sub, @function
+sub:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048596:
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	sub	a0, t0, t1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	sub, .-sub
+
+	.align	2
+	.type	mul, @function
+mul:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048597:
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mul	a0, t0, t1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	mul, .-mul
+
+	.align	2
+	.type	div, @function
+div:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048598:
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	div	a0, t0, t1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	div, .-div
+
+	.align	2
+	.type	rshift, @function
+rshift:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048599:
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	sll	a0, t0, t1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	rshift, .-rshift
+
+	.align	2
+	.type	lshift, @function
+lshift:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048600:
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	sra	a0, t0, t1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	lshift, .-lshift
+
+	.align	2
+	.type	and, @function
+and:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048601:
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	and	a0, t0, t1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	and, .-and
+
+	.align	2
+	.type	or, @function
+or:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048602:
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	or	a0, t0, t1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	or, .-or
+
+	.align	2
+	.type	xor, @function
+xor:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048603:
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	xor	a0, t0, t1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	xor, .-xor
+
+	.align	2
+	.type	foo, @function
+foo:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+block1048604:
+	li	t0, 50
+	sd	t0, -40(s0)
+	li	t0, -2
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048607)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048608)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	foo, .-foo
+
+	.align	2
+	.type	bar, @function
+bar:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+block1048609:
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	sub
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	div
+	sd	a0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -24(s0)
+	ld	t0, -32(s0)
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	sd	t0, -32(s0)
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	add
+	sd	a0, -40(s0)
+	li	t0, 0
+	sd	t0, -40(s0)
+	li	t0, 1
+	sd	t0, -40(s0)
+	ld	t0, -32(s0)
+	ld	t1, -24(s0)
+	add	t2, t0, t1
+	ld	t0, -40(s0)
+	ld	t1, -48(s0)
+	mul	t3, t0, t1
+	sub	t0, t2, t3
+	sd	t0, -40(s0)
+	ld	t0, -40(s0)
+	sd	t0, -48(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048610)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048611)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	bar, .-bar
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -48
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048612)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	tester
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048592:
+	.asciz	"%lld\n"
+_STR_1048607:
+	.asciz	"foo here"
+_STR_1048608:
+	.asciz	"foo here\n"
+_STR_1048610:
+	.asciz	"bar here"
+_STR_1048611:
+	.asciz	"bar here\n"
+_STR_1048612:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
@@ -0,0 +1,281 @@
+	.file	"bitops.vsl"
+	.attribute	arch, "rv64gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	bitwise_operators, @function
+bitwise_operators:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048577:
+1:	auipc	t0, %pcrel_hi(_STR_1048578)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048579)
+	addi	t1, t1, %pcrel_lo(1b)
+	ld	t1, -32(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048581)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	ld	t0, -24(s0)
+	li	t1, -1
+	xor	t2, t1, t0
+	sd	t2, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048583)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048584)
+	addi	t1, t1, %pcrel_lo(1b)
+	ld	t1, -40(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048585)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	or	t2, t0, t1
+	sd	t2, -40(s0)
+	ld	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048586)
+	addi	t1, t1, %pcrel_lo(1b)
+	ld	t1, -32(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048584)
+	addi	t2, t2, %pcrel_lo(1b)
+	ld	t2, -40(s0)
+1:	auipc	t3, %pcrel_hi(_STR_1048587)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	xor	t2, t0, t1
+	sd	t2, -40(s0)
+	ld	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048588)
+	addi	t1, t1, %pcrel_lo(1b)
+	ld	t1, -32(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048584)
+	addi	t2, t2, %pcrel_lo(1b)
+	ld	t2, -40(s0)
+1:	auipc	t3, %pcrel_hi(_STR_1048589)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	and	t2, t0, t1
+	sd	t2, -40(s0)
+	ld	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048590)
+	addi	t1, t1, %pcrel_lo(1b)
+	ld	t1, -32(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048584)
+	addi	t2, t2, %pcrel_lo(1b)
+	ld	t2, -40(s0)
+1:	auipc	t3, %pcrel_hi(_STR_1048591)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	sll	t2, t0, t1
+	sd	t2, -40(s0)
+	ld	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048592)
+	addi	t1, t1, %pcrel_lo(1b)
+	ld	t1, -32(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048584)
+	addi	t2, t2, %pcrel_lo(1b)
+	ld	t2, -40(s0)
+1:	auipc	t3, %pcrel_hi(_STR_1048593)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	sra	t2, t0, t1
+	sd	t2, -40(s0)
+	ld	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048594)
+	addi	t1, t1, %pcrel_lo(1b)
+	ld	t1, -32(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048584)
+	addi	t2, t2, %pcrel_lo(1b)
+	ld	t2, -40(s0)
+1:	auipc	t3, %pcrel_hi(_STR_1048595)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	bitwise_operators, .-bitwise_operators
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -64
+	.cfi_def_cfa_offset	64
+	sd	ra, 56(sp)
+	sd	s0, 48(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -64
+	addi	s0, sp, 64
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	addi	a1, a0, -1
+	li	t0, 2
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048597)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 8(t0)	# Load argv[1]
+	addi	a1, s0, -40
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	ld	t1, -40(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 8(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -40(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 16(t0)	# Load argv[2]
+	addi	a1, s0, -48
+	li	s1, 2
+	li	a2, 10
+	call	strtol
+	ld	t1, -48(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 16(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -48(s0)
+	ld	a0, -40(s0)	# Load parsed argv[1]
+	ld	a1, -48(s0)	# Load parsed argv[2]
+	call	bitwise_operators
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048598)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048578:
+	.asciz	"a is"
+_STR_1048579:
+	.asciz	"and b is"
+_STR_1048581:
+	.asciz	"a is %lld and b is %lld\n"
+_STR_1048583:
+	.asciz	"~"
+_STR_1048584:
+	.asciz	"="
+_STR_1048585:
+	.asciz	"~ %lld = %lld\n"
+_STR_1048586:
+	.asciz	"|"
+_STR_1048587:
+	.asciz	"%lld | %lld = %lld\n"
+_STR_1048588:
+	.asciz	"^"
+_STR_1048589:
+	.asciz	"%lld ^ %lld = %lld\n"
+_STR_1048590:
+	.asciz	"&"
+_STR_1048591:
+	.asciz	"%lld & %lld = %lld\n"
+_STR_1048592:
+	.asciz	"<<"
+_STR_1048593:
+	.asciz	"%lld << %lld = %lld\n"
+_STR_1048594:
+	.asciz	">>"
+_STR_1048595:
+	.asciz	"%lld >> %lld = %lld\n"
+_STR_1048597:
+	.asciz	"Argument error: expected 2 arguments, got %d\n"
+_STR_1048598:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
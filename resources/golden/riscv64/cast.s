@@ -0,0 +1,253 @@
+	.file	"cast.vsl"
+	.attribute	arch, "rv64gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	casting, @function
+casting:
+	.cfi_startproc
+	addi	sp, sp, -64
+	.cfi_def_cfa_offset	64
+	sd	ra, 56(sp)
+	sd	s0, 48(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 64
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	fsd	fa0, -40(s0)
+	fsd	fa1, -48(s0)
+block1048578:
+	ld	t0, -24(s0)
+	fld	ft0, -40(s0)
+	fcvt.d.l	ft1, t0
+	fadd.d	ft2, ft1, ft0
+	fcvt.l.d	t0, ft2, rne
+	sd	t0, -56(s0)
+	ld	t0, -32(s0)
+	fld	ft0, -48(s0)
+	fcvt.d.l	ft1, t0
+	fadd.d	ft2, ft1, ft0
+	fsd	ft2, -64(s0)
+	li	t0, 3
+	sd	t0, -56(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048580)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048581)
+	addi	t1, t1, %pcrel_lo(1b)
+	ld	t1, -32(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048582)
+	addi	t2, t2, %pcrel_lo(1b)
+	fld	ft0, -40(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048583)
+	addi	t2, t2, %pcrel_lo(1b)
+	fld	ft1, -48(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048585)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	fmv.x.d	a3, ft0
+	fmv.x.d	a4, ft1
+	call	printf
+	ld	t0, -56(s0)
+1:	auipc	t6, %pcrel_hi(_L_CONST_1048586)
+	fld	ft0, %pcrel_lo(1b)(t6)
+	fcvt.d.l	ft1, t0
+	fmv.d	fa0, ft1
+	fmv.d	fa1, ft0
+	call	foo
+	fsd	fa0, -64(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048587)
+	addi	t0, t0, %pcrel_lo(1b)
+	fld	ft0, -64(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048588)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	fmv.x.d	a1, ft0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	casting, .-casting
+
+	.align	2
+	.type	foo, @function
+foo:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sd	ra, 24(sp)
+	sd	s0, 16(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	fsd	fa0, -24(s0)
+	fsd	fa1, -32(s0)
+block1048590:
+	fld	ft0, -24(s0)
+	fld	ft1, -32(s0)
+	fmul.d	fa0, ft0, ft1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	ld	ra, 24(sp)
+	ld	s0, 16(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	foo, .-foo
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -80
+	.cfi_def_cfa_offset	80
+	sd	ra, 72(sp)
+	sd	s0, 64(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -80
+	addi	s0, sp, 80
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	addi	a1, a0, -1
+	li	t0, 4
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048591)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 80
+	ld	ra, 72(sp)
+	ld	s0, 64(sp)
+	addi	sp, sp, 80
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 8(t0)	# Load argv[1]
+	addi	a1, s0, -40
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	ld	t1, -40(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 8(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -40(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 16(t0)	# Load argv[2]
+	addi	a1, s0, -48
+	li	s1, 2
+	li	a2, 10
+	call	strtol
+	ld	t1, -48(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 16(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -48(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 24(t0)	# Load argv[3]
+	addi	a1, s0, -56
+	li	s1, 3
+	call	strtod
+	ld	t1, -56(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 24(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	fsd	fa0, -56(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 32(t0)	# Load argv[4]
+	addi	a1, s0, -64
+	li	s1, 4
+	call	strtod
+	ld	t1, -64(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 32(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	fsd	fa0, -64(s0)
+	ld	a0, -40(s0)	# Load parsed argv[1]
+	ld	a1, -48(s0)	# Load parsed argv[2]
+	fld	fa0, -56(s0)	# Load parsed argv[3]
+	fld	fa1, -64(s0)	# Load parsed argv[4]
+	call	casting
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 80
+	ld	ra, 72(sp)
+	ld	s0, 64(sp)
+	addi	sp, sp, 80
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048592)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 80
+	ld	ra, 72(sp)
+	ld	s0, 64(sp)
+	addi	sp, sp, 80
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_L_CONST_1048586:
+	.dword	0x3fd3333340000000	# 0.300000
+_STR_1048580:
+	.asciz	"a is"
+_STR_1048581:
+	.asciz	"b is"
+_STR_1048582:
+	.asciz	"c is"
+_STR_1048583:
+	.asciz	"d is"
+_STR_1048585:
+	.asciz	"a is %lld b is %lld c is %f d is %f\n"
+_STR_1048587:
+	.asciz	"f is"
+_STR_1048588:
+	.asciz	"f is %f\n"
+_STR_1048591:
+	.asciz	"Argument error: expected 4 arguments, got %d\n"
+_STR_1048592:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
@@ -0,0 +1,156 @@
+	.file	"escapecodes.vsl"
+	.attribute	arch, "rv64gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	escapecodes, @function
+escapecodes:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sd	ra, 24(sp)
+	sd	s0, 16(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+block1048577:
+1:	auipc	t0, %pcrel_hi(_STR_1048578)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048581)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	t0, 64
+	sd	t0, -24(s0)
+	li	t0, 42
+	sd	t0, -32(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048584)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048585)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048586)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048587)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048588)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048589)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048590)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048591)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048592)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048593)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048594)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	ld	ra, 24(sp)
+	ld	s0, 16(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	escapecodes, .-escapecodes
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -48
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048596)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	escapecodes
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048578:
+	.asciz	"Testing if printf format codes are left alone."
+_STR_1048579:
+	.asciz	"\\nOutput *should* contain percent characters, but no integers."
+_STR_1048581:
+	.asciz	"Testing if printf format codes are left alone. \\nOutput *should* contain percent characters, but no integers.\n"
+_STR_1048584:
+	.asciz	"\\tHello, world! %d %d"
+_STR_1048585:
+	.asciz	"\\tHello, world! %%d %%d\n"
+_STR_1048586:
+	.asciz	"Adding a splash of ANSI color codes - This will only work in a color terminal"
+_STR_1048587:
+	.asciz	"Adding a splash of ANSI color codes - This will only work in a color terminal\n"
+_STR_1048588:
+	.asciz	"\\t\\033[31mRed"
+_STR_1048589:
+	.asciz	"\\t\\033[31mRed\n"
+_STR_1048590:
+	.asciz	"\\t\\033[32mGreen"
+_STR_1048591:
+	.asciz	"\\t\\033[32mGreen\n"
+_STR_1048592:
+	.asciz	"\\t\\033[34mBlue"
+_STR_1048593:
+	.asciz	"\\033[0m"
+_STR_1048594:
+	.asciz	"\\t\\033[34mBlue \\033[0m\n"
+_STR_1048596:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
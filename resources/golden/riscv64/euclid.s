@@ -0,0 +1,265 @@
+	.file	"euclid.vsl"
+	.attribute	arch, "rv64gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	euclid, @function
+euclid:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048578:
+	ld	t0, -24(s0)
+	li	t1, 0
+	sub	t2, t0, t1
+	li	t0, 0
+	bge	t2, t0, block1048581
+block1048579:
+	ld	t0, -24(s0)
+	li	t1, 0
+	sub	t2, t1, t0
+	sd	t2, -24(s0)
+block1048581:
+	ld	t0, -32(s0)
+	li	t1, 0
+	sub	t2, t0, t1
+	li	t0, 0
+	bge	t2, t0, block1048583
+block1048582:
+	ld	t0, -32(s0)
+	li	t1, 0
+	sub	t2, t1, t0
+	sd	t2, -32(s0)
+block1048583:
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	gcd
+	li	t0, 1
+	sub	t1, a0, t0
+	li	t0, 0
+	ble	t1, t0, block1048586
+block1048584:
+1:	auipc	t0, %pcrel_hi(_STR_1048587)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048588)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -32(s0)
+	sd	t0, -48(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048589)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	gcd
+	mv	t0, a0
+1:	auipc	t1, %pcrel_hi(_STR_1048591)
+	addi	t1, t1, %pcrel_lo(1b)
+	ld	t2, -40(s0)
+	ld	t3, -48(s0)
+	mv	a0, t1
+	mv	a1, t2
+	mv	a2, t3
+	mv	a3, t0
+	call	printf
+	j	block1048592
+block1048586:
+	ld	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048588)
+	addi	t1, t1, %pcrel_lo(1b)
+	ld	t1, -32(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048593)
+	addi	t2, t2, %pcrel_lo(1b)
+1:	auipc	t2, %pcrel_hi(_STR_1048594)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+block1048592:
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	euclid, .-euclid
+
+	.align	2
+	.type	gcd, @function
+gcd:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048595:
+	ld	t0, -32(s0)
+	li	t1, 0
+	sub	t2, t0, t1
+	li	t0, 0
+	ble	t2, t0, block1048597
+block1048596:
+	ld	t4, -32(s0)
+	ld	t0, -24(s0)
+	ld	t1, -24(s0)
+	ld	t2, -32(s0)
+	div	t3, t1, t2
+	ld	t1, -32(s0)
+	mul	t2, t3, t1
+	sub	t1, t0, t2
+	mv	a0, t4
+	mv	a1, t1
+	call	gcd
+	sd	a0, -40(s0)
+	j	block1048598
+block1048597:
+	ld	t0, -24(s0)
+	sd	t0, -40(s0)
+block1048598:
+	ld	a0, -40(s0)
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	gcd, .-gcd
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -64
+	.cfi_def_cfa_offset	64
+	sd	ra, 56(sp)
+	sd	s0, 48(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -64
+	addi	s0, sp, 64
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	addi	a1, a0, -1
+	li	t0, 2
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048599)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 8(t0)	# Load argv[1]
+	addi	a1, s0, -40
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	ld	t1, -40(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 8(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -40(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 16(t0)	# Load argv[2]
+	addi	a1, s0, -48
+	li	s1, 2
+	li	a2, 10
+	call	strtol
+	ld	t1, -48(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 16(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -48(s0)
+	ld	a0, -40(s0)	# Load parsed argv[1]
+	ld	a1, -48(s0)	# Load parsed argv[2]
+	call	euclid
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048600)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048587:
+	.asciz	"Greatest common divisor of"
+_STR_1048588:
+	.asciz	"and"
+_STR_1048589:
+	.asciz	"is"
+_STR_1048591:
+	.asciz	"Greatest common divisor of %lld and %lld is %lld\n"
+_STR_1048593:
+	.asciz	"are relative primes"
+_STR_1048594:
+	.asciz	"%lld and %lld are relative primes\n"
+_STR_1048599:
+	.asciz	"Argument error: expected 2 arguments, got %d\n"
+_STR_1048600:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
@@ -0,0 +1,191 @@
+	.file	"fibonacci_recursive.vsl"
+	.attribute	arch, "rv64gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	fibonacci_recursive, @function
+fibonacci_recursive:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sd	ra, 24(sp)
+	sd	s0, 16(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+block1048578:
+	ld	t0, -24(s0)
+	mv	a0, t0
+	call	fibonacci_number
+	sd	a0, -32(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048580)
+	addi	t1, t1, %pcrel_lo(1b)
+	ld	t1, -32(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048582)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	ld	ra, 24(sp)
+	ld	s0, 16(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	fibonacci_recursive, .-fibonacci_recursive
+
+	.align	2
+	.type	fibonacci_number, @function
+fibonacci_number:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+block1048584:
+	li	t0, 0
+	sd	t0, -32(s0)
+	ld	t0, -24(s0)
+	li	t1, 2
+	sub	t2, t0, t1
+	li	t0, 0
+	ble	t2, t0, block1048587
+block1048585:
+	ld	t0, -24(s0)
+	li	t1, 1
+	sub	t2, t0, t1
+	mv	a0, t2
+	call	fibonacci_number
+	sd	a0, -40(s0)
+	ld	t0, -24(s0)
+	li	t1, 2
+	sub	t2, t0, t1
+	mv	a0, t2
+	call	fibonacci_number
+	ld	t0, -40(s0)
+	add	t1, t0, a0
+	sd	t1, -32(s0)
+	j	block1048589
+block1048587:
+	li	t0, 1
+	sd	t0, -32(s0)
+block1048589:
+	ld	a0, -32(s0)
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	fibonacci_number, .-fibonacci_number
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -48
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	addi	a1, a0, -1
+	li	t0, 1
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048590)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 8(t0)	# Load argv[1]
+	addi	a1, s0, -40
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	ld	t1, -40(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 8(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -40(s0)
+	ld	a0, -40(s0)	# Load parsed argv[1]
+	call	fibonacci_recursive
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048591)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048579:
+	.asciz	"Fibonacci number #"
+_STR_1048580:
+	.asciz	"is"
+_STR_1048582:
+	.asciz	"Fibonacci number # %lld is %lld\n"
+_STR_1048590:
+	.asciz	"Argument error: expected 1 argument, got %d\n"
+_STR_1048591:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
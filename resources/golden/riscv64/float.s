@@ -0,0 +1,247 @@
+	.file	"float.vsl"
+	.attribute	arch, "rv64gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	float_test, @function
+float_test:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	fsd	fa0, -24(s0)
+	fsd	fa1, -32(s0)
+block1048577:
+	fld	ft0, -24(s0)
+	fld	ft1, -32(s0)
+	fadd.d	ft2, ft0, ft1
+	fsd	ft2, -40(s0)
+	fld	ft0, -24(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048578)
+	addi	t0, t0, %pcrel_lo(1b)
+	fld	ft1, -32(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+	fld	ft2, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048581)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	fmv.x.d	a1, ft0
+	fmv.x.d	a2, ft1
+	fmv.x.d	a3, ft2
+	call	printf
+	fld	ft0, -24(s0)
+	fld	ft1, -32(s0)
+	fsub.d	ft2, ft0, ft1
+	fsd	ft2, -40(s0)
+	fld	ft0, -24(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048582)
+	addi	t0, t0, %pcrel_lo(1b)
+	fld	ft1, -32(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+	fld	ft2, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048583)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	fmv.x.d	a1, ft0
+	fmv.x.d	a2, ft1
+	fmv.x.d	a3, ft2
+	call	printf
+	fld	ft0, -24(s0)
+	fld	ft1, -32(s0)
+	fmul.d	ft2, ft0, ft1
+	fsd	ft2, -40(s0)
+	fld	ft0, -24(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048584)
+	addi	t0, t0, %pcrel_lo(1b)
+	fld	ft1, -32(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+	fld	ft2, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048585)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	fmv.x.d	a1, ft0
+	fmv.x.d	a2, ft1
+	fmv.x.d	a3, ft2
+	call	printf
+	fld	ft0, -24(s0)
+	fld	ft1, -32(s0)
+	fdiv.d	ft2, ft0, ft1
+	fsd	ft2, -40(s0)
+	fld	ft0, -24(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048586)
+	addi	t0, t0, %pcrel_lo(1b)
+	fld	ft1, -32(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048579)
+	addi	t0, t0, %pcrel_lo(1b)
+	fld	ft2, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048587)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	fmv.x.d	a1, ft0
+	fmv.x.d	a2, ft1
+	fmv.x.d	a3, ft2
+	call	printf
+1:	auipc	t6, %pcrel_hi(_L_CONST_1048588)
+	fld	ft0, %pcrel_lo(1b)(t6)
+	fsd	ft0, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048589)
+	addi	t0, t0, %pcrel_lo(1b)
+	fld	ft0, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048590)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	fmv.x.d	a1, ft0
+	call	printf
+	li	t0, 1
+	fcvt.d.l	ft0, t0
+	fsd	ft0, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048589)
+	addi	t0, t0, %pcrel_lo(1b)
+	fld	ft0, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048590)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	fmv.x.d	a1, ft0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	float_test, .-float_test
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -64
+	.cfi_def_cfa_offset	64
+	sd	ra, 56(sp)
+	sd	s0, 48(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -64
+	addi	s0, sp, 64
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	addi	a1, a0, -1
+	li	t0, 2
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048593)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 8(t0)	# Load argv[1]
+	addi	a1, s0, -40
+	li	s1, 1
+	call	strtod
+	ld	t1, -40(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 8(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	fsd	fa0, -40(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 16(t0)	# Load argv[2]
+	addi	a1, s0, -48
+	li	s1, 2
+	call	strtod
+	ld	t1, -48(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 16(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	fsd	fa0, -48(s0)
+	fld	fa0, -40(s0)	# Load parsed argv[1]
+	fld	fa1, -48(s0)	# Load parsed argv[2]
+	call	float_test
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048594)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_L_CONST_1048588:
+	.dword	0x40039999a0000000	# 2.450000
+_STR_1048578:
+	.asciz	"+"
+_STR_1048579:
+	.asciz	"="
+_STR_1048581:
+	.asciz	"%f + %f = %f\n"
+_STR_1048582:
+	.asciz	"-"
+_STR_1048583:
+	.asciz	"%f - %f = %f\n"
+_STR_1048584:
+	.asciz	"*"
+_STR_1048585:
+	.asciz	"%f * %f = %f\n"
+_STR_1048586:
+	.asciz	"/"
+_STR_1048587:
+	.asciz	"%f / %f = %f\n"
+_STR_1048589:
+	.asciz	"c is now a constant: "
+_STR_1048590:
+	.asciz	"c is now a constant:  %f\n"
+_STR_1048593:
+	.asciz	"Argument error: expected 2 arguments, got %d\n"
+_STR_1048594:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
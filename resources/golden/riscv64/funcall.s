@@ -0,0 +1,232 @@
+	.file	"funcall.vsl"
+	.attribute	arch, "rv64gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	defall, @function
+defall:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+block1048579:
+	li	t0, 5
+	sd	t0, -24(s0)
+	li	t0, 10
+	sd	t0, -32(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048582)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+1:	auipc	t2, %pcrel_hi(_STR_1048584)
+	addi	t2, t2, %pcrel_lo(1b)
+	mv	a0, t2
+	mv	a1, t0
+	mv	a2, t1
+	call	printf
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	mv	a0, t0
+	mv	a1, t1
+	call	my_deftion
+	sd	a0, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048585)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -40(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048586)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	call	my_other_deftion
+	sd	a0, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048587)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -40(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048588)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	defall, .-defall
+
+	.align	2
+	.type	my_deftion, @function
+my_deftion:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048590:
+	ld	t0, -24(s0)
+	ld	t1, -24(s0)
+	mul	t2, t0, t1
+	ld	t0, -32(s0)
+	ld	t1, -32(s0)
+	mul	t3, t0, t1
+	add	t0, t2, t3
+	sd	t0, -40(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048591)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -24(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048592)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048593)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -32(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048594)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048595)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -40(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048596)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	ld	a0, -40(s0)
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	my_deftion, .-my_deftion
+
+	.align	2
+	.type	my_other_deftion, @function
+my_other_deftion:
+	.cfi_startproc
+	addi	sp, sp, -32
+	.cfi_def_cfa_offset	32
+	sd	ra, 24(sp)
+	sd	s0, 16(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 32
+	.cfi_def_cfa	8, 0
+block1048597:
+	li	t0, 42
+	sd	t0, -24(s0)
+	ld	a0, -24(s0)
+	.cfi_remember_state
+	.cfi_def_cfa	2, 32
+	ld	ra, 24(sp)
+	ld	s0, 16(sp)
+	addi	sp, sp, 32
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	my_other_deftion, .-my_other_deftion
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -48
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048599)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	defall
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048582:
+	.asciz	"Calling my_deftion with parameters"
+_STR_1048584:
+	.asciz	"Calling my_deftion with parameters %lld %lld\n"
+_STR_1048585:
+	.asciz	"The returned result is"
+_STR_1048586:
+	.asciz	"The returned result is %lld\n"
+_STR_1048587:
+	.asciz	"The other returned result is"
+_STR_1048588:
+	.asciz	"The other returned result is %lld\n"
+_STR_1048591:
+	.asciz	"Parameter s is"
+_STR_1048592:
+	.asciz	"Parameter s is %lld\n"
+_STR_1048593:
+	.asciz	"Parameter t is"
+_STR_1048594:
+	.asciz	"Parameter t is %lld\n"
+_STR_1048595:
+	.asciz	"The sum of their squares is"
+_STR_1048596:
+	.asciz	"The sum of their squares is %lld\n"
+_STR_1048599:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
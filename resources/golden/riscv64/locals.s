@@ -0,0 +1,193 @@
+	.file	"locals.vsl"
+	.attribute	arch, "rv64gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	nesting_scopes, @function
+nesting_scopes:
+	.cfi_startproc
+	addi	sp, sp, -112
+	.cfi_def_cfa_offset	112
+	sd	ra, 104(sp)
+	sd	s0, 96(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 112
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	sd	a2, -40(s0)
+block1048580:
+	li	t0, 21
+	sd	t0, -48(s0)
+	li	t0, 42
+	sd	t0, -96(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048583)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -96(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048585)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048586)
+	addi	t0, t0, %pcrel_lo(1b)
+	ld	t0, -48(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048587)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048588)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t6, %pcrel_hi(k)
+	ld	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t1, %pcrel_hi(_STR_1048589)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 112
+	ld	ra, 104(sp)
+	ld	s0, 96(sp)
+	addi	sp, sp, 112
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	nesting_scopes, .-nesting_scopes
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -64
+	.cfi_def_cfa_offset	64
+	sd	ra, 56(sp)
+	sd	s0, 48(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -64
+	addi	s0, sp, 64
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	addi	a1, a0, -1
+	li	t0, 3
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048591)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 8(t0)	# Load argv[1]
+	addi	a1, s0, -40
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	ld	t1, -40(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 8(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -40(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 16(t0)	# Load argv[2]
+	addi	a1, s0, -48
+	li	s1, 2
+	li	a2, 10
+	call	strtol
+	ld	t1, -48(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 16(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -48(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 24(t0)	# Load argv[3]
+	addi	a1, s0, -56
+	li	s1, 3
+	li	a2, 10
+	call	strtol
+	ld	t1, -56(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 24(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -56(s0)
+	ld	a0, -40(s0)	# Load parsed argv[1]
+	ld	a1, -48(s0)	# Load parsed argv[2]
+	ld	a2, -56(s0)	# Load parsed argv[3]
+	call	nesting_scopes
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048592)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+i:
+	.dword	0x0
+j:
+	.dword	0x0
+k:
+	.dword	0x0
+_STR_1048583:
+	.asciz	"Inner a is "
+_STR_1048585:
+	.asciz	"Inner a is  %lld\n"
+_STR_1048586:
+	.asciz	"Outer a is "
+_STR_1048587:
+	.asciz	"Outer a is  %lld\n"
+_STR_1048588:
+	.asciz	"Global k is "
+_STR_1048589:
+	.asciz	"Global k is  %lld\n"
+_STR_1048591:
+	.asciz	"Argument error: expected 3 arguments, got %d\n"
+_STR_1048592:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
@@ -0,0 +1,391 @@
+	.file	"multi_hello.vsl"
+	.attribute	arch, "rv64gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	hello, @function
+hello:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -48
+	sd	s2, 8(sp)
+	.cfi_offset	18, -40
+	sd	s3, 16(sp)
+	.cfi_offset	19, -32
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+block1048583:
+1:	auipc	t0, %pcrel_hi(_STR_1048584)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048585)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048587)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048588)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048589)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048590)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048591)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	t0, 42
+	li	t1, 43
+	li	t2, 44
+1:	auipc	t3, %pcrel_hi(_STR_1048595)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	li	t0, 30
+1:	auipc	t6, %pcrel_hi(x)
+	sd	t0, %pcrel_lo(1b)(t6)
+	li	t0, -15
+1:	auipc	t6, %pcrel_hi(y)
+	sd	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(x)
+	ld	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(x)
+	ld	t1, %pcrel_lo(1b)(t6)
+	mul	t2, t0, t1
+1:	auipc	t6, %pcrel_hi(y)
+	ld	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(y)
+	ld	t1, %pcrel_lo(1b)(t6)
+	mul	t3, t0, t1
+	add	t0, t2, t3
+1:	auipc	t6, %pcrel_hi(z)
+	sd	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t0, %pcrel_hi(_STR_1048598)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t6, %pcrel_hi(x)
+	ld	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(y)
+	ld	t1, %pcrel_lo(1b)(t6)
+	add	t2, t0, t1
+1:	auipc	t0, %pcrel_hi(_STR_1048599)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	mv	a1, t2
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048600)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t6, %pcrel_hi(x)
+	ld	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(y)
+	ld	t1, %pcrel_lo(1b)(t6)
+	sub	t2, t0, t1
+1:	auipc	t0, %pcrel_hi(_STR_1048601)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	mv	a1, t2
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048602)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t6, %pcrel_hi(x)
+	ld	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(y)
+	ld	t1, %pcrel_lo(1b)(t6)
+	mul	t2, t0, t1
+1:	auipc	t0, %pcrel_hi(_STR_1048603)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	mv	a1, t2
+	call	printf
+1:	auipc	t0, %pcrel_hi(_STR_1048604)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t6, %pcrel_hi(x)
+	ld	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(y)
+	ld	t1, %pcrel_lo(1b)(t6)
+	div	t2, t0, t1
+1:	auipc	t0, %pcrel_hi(_STR_1048605)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	mv	a1, t2
+	call	printf
+	li	t0, 1
+	li	t1, 2
+	li	t2, 3
+	li	t3, 4
+	li	t4, 5
+	li	s1, 6
+	li	s2, 7
+	li	s3, 8
+	fcvt.d.l	ft0, t0
+	fcvt.d.l	ft1, t1
+	fcvt.d.l	ft2, t2
+	fcvt.d.l	ft3, t3
+	fcvt.d.l	ft4, t4
+	fcvt.d.l	ft5, s1
+	fcvt.d.l	ft6, s2
+	fcvt.d.l	ft7, s3
+	fmv.d	fa0, ft0
+	fmv.d	fa1, ft1
+	fmv.d	fa2, ft2
+	fmv.d	fa3, ft3
+	fmv.d	fa4, ft4
+	fmv.d	fa5, ft5
+	fmv.d	fa6, ft6
+	fmv.d	fa7, ft7
+	call	goodbye
+1:	auipc	t6, %pcrel_hi(w)
+	sd	a0, %pcrel_lo(1b)(t6)
+1:	auipc	t6, %pcrel_hi(w)
+	ld	t0, %pcrel_lo(1b)(t6)
+1:	auipc	t1, %pcrel_hi(_STR_1048614)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	ld	s2, 8(sp)
+	ld	s3, 16(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	hello, .-hello
+
+	.align	2
+	.type	tralala, @function
+tralala:
+	.cfi_startproc
+	addi	sp, sp, -64
+	.cfi_def_cfa_offset	64
+	sd	ra, 56(sp)
+	sd	s0, 48(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 64
+	.cfi_def_cfa	8, 0
+	fsd	fa0, -24(s0)
+block1048616:
+	li	t0, 3
+	fcvt.d.l	ft0, t0
+	fsd	ft0, -32(s0)
+	li	t0, 5
+	fcvt.d.l	ft0, t0
+	fsd	ft0, -40(s0)
+	li	t0, 2
+	fcvt.d.l	ft0, t0
+	fsd	ft0, -48(s0)
+	li	t0, 4
+	fcvt.d.l	ft0, t0
+	fsd	ft0, -56(s0)
+	li	t0, 42
+	fcvt.d.l	ft0, t0
+	fsd	ft0, -24(s0)
+	fld	ft0, -24(s0)
+	fld	ft1, -32(s0)
+	fld	ft2, -40(s0)
+	fmul.d	ft3, ft1, ft2
+	fld	ft1, -48(s0)
+	fld	ft2, -56(s0)
+	fmul.d	ft4, ft1, ft2
+1:	auipc	t0, %pcrel_hi(_STR_1048617)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	fmv.x.d	a1, ft0
+	fmv.x.d	a2, ft3
+	fmv.x.d	a3, ft4
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	.cfi_def_cfa	2, 64
+	ld	ra, 56(sp)
+	ld	s0, 48(sp)
+	addi	sp, sp, 64
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	tralala, .-tralala
+
+	.align	2
+	.type	goodbye, @function
+goodbye:
+	.cfi_startproc
+	addi	sp, sp, -80
+	.cfi_def_cfa_offset	80
+	sd	ra, 72(sp)
+	sd	s0, 64(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 80
+	.cfi_def_cfa	8, 0
+	fsd	fa0, -24(s0)
+	fsd	fa1, -32(s0)
+	fsd	fa2, -40(s0)
+	fsd	fa3, -48(s0)
+	fsd	fa4, -56(s0)
+	fsd	fa5, -64(s0)
+	fsd	fa6, -72(s0)
+	fsd	fa7, -80(s0)
+block1048618:
+	fld	ft0, -24(s0)
+	fld	ft1, -32(s0)
+	fld	ft2, -40(s0)
+	fld	ft3, -48(s0)
+	fld	ft4, -56(s0)
+	fld	ft5, -64(s0)
+	fld	ft6, -72(s0)
+	fld	ft7, -80(s0)
+1:	auipc	t0, %pcrel_hi(_STR_1048619)
+	addi	t0, t0, %pcrel_lo(1b)
+	addi	sp, sp, -16
+	mv	a0, t0
+	fmv.x.d	a1, ft0
+	fmv.x.d	a2, ft1
+	fmv.x.d	a3, ft2
+	fmv.x.d	a4, ft3
+	fmv.x.d	a5, ft4
+	fmv.x.d	a6, ft5
+	fmv.x.d	a7, ft6
+	fsd	ft7, 0(sp)
+	call	printf
+	addi	sp, sp, 16
+1:	auipc	t0, %pcrel_hi(_STR_1048620)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048621)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	t0, 1
+	fcvt.d.l	ft0, t0
+	fmv.d	fa0, ft0
+	call	tralala
+	.cfi_remember_state
+	.cfi_def_cfa	2, 80
+	ld	ra, 72(sp)
+	ld	s0, 64(sp)
+	addi	sp, sp, 80
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	goodbye, .-goodbye
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -48
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048622)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	hello
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+w:
+	.dword	0x0
+x:
+	.dword	0x0
+y:
+	.dword	0x0
+z:
+	.dword	0x0
+_STR_1048584:
+	.asciz	"Hello,"
+_STR_1048585:
+	.asciz	"world!"
+_STR_1048587:
+	.asciz	"Hello, world!\n"
+_STR_1048588:
+	.asciz	"How"
+_STR_1048589:
+	.asciz	"are"
+_STR_1048590:
+	.asciz	"you?"
+_STR_1048591:
+	.asciz	"How are you?\n"
+_STR_1048595:
+	.asciz	"%lld %lld %lld\n"
+_STR_1048598:
+	.asciz	"x + y :="
+_STR_1048599:
+	.asciz	"x + y := %lld\n"
+_STR_1048600:
+	.asciz	"x - y :="
+_STR_1048601:
+	.asciz	"x - y := %lld\n"
+_STR_1048602:
+	.asciz	"x * y :="
+_STR_1048603:
+	.asciz	"x * y := %lld\n"
+_STR_1048604:
+	.asciz	"x / y :="
+_STR_1048605:
+	.asciz	"x / y := %lld\n"
+_STR_1048614:
+	.asciz	"%lld\n"
+_STR_1048617:
+	.asciz	"%f %f %f\n"
+_STR_1048619:
+	.asciz	"%f %f %f %f %f %f %f %f\n"
+_STR_1048620:
+	.asciz	"Morna"
+_STR_1048621:
+	.asciz	"Morna\n"
+_STR_1048622:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
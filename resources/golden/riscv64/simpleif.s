@@ -0,0 +1,280 @@
+	.file	"simpleif.vsl"
+	.attribute	arch, "rv64gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	dingdong, @function
+dingdong:
+	.cfi_startproc
+	addi	sp, sp, -112
+	.cfi_def_cfa_offset	112
+	sd	ra, 104(sp)
+	sd	s0, 96(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -112
+	sd	s2, 8(sp)
+	.cfi_offset	18, -104
+	sd	s3, 16(sp)
+	.cfi_offset	19, -96
+	addi	s0, sp, 112
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	sd	a2, -40(s0)
+	sd	a3, -48(s0)
+	sd	a4, -56(s0)
+	sd	a5, -64(s0)
+	sd	a6, -72(s0)
+block1048577:
+	li	t0, 42
+	sd	t0, -80(s0)
+	ld	t0, -24(s0)
+	ld	t1, -32(s0)
+	ld	t2, -40(s0)
+	ld	t3, -48(s0)
+	ld	t4, -56(s0)
+	ld	s1, -64(s0)
+	ld	s3, -72(s0)
+1:	auipc	s2, %pcrel_hi(_STR_1048580)
+	addi	s2, s2, %pcrel_lo(1b)
+	mv	a0, s2
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	mv	a4, t3
+	mv	a5, t4
+	mv	a6, s1
+	mv	a7, s3
+	call	printf
+	ld	t0, -80(s0)
+	li	t1, 42
+	sub	t2, t0, t1
+	li	t0, 0
+	bne	t2, t0, block1048582
+block1048581:
+1:	auipc	t0, %pcrel_hi(_STR_1048584)
+	addi	t0, t0, %pcrel_lo(1b)
+1:	auipc	t0, %pcrel_hi(_STR_1048585)
+	addi	t0, t0, %pcrel_lo(1b)
+	mv	a0, t0
+	call	printf
+	li	t0, 43
+	sd	t0, -80(s0)
+	j	block1048589
+block1048582:
+	li	t0, 44
+	sd	t0, -80(s0)
+block1048589:
+	ld	t0, -80(s0)
+	li	t1, 0
+	sub	t2, t0, t1
+	li	t0, 0
+	ble	t2, t0, block1048591
+block1048590:
+	ld	t0, -80(s0)
+1:	auipc	t1, %pcrel_hi(_STR_1048592)
+	addi	t1, t1, %pcrel_lo(1b)
+	mv	a0, t1
+	mv	a1, t0
+	call	printf
+	ld	t0, -80(s0)
+	li	t1, 1
+	sub	t2, t0, t1
+	sd	t2, -80(s0)
+	j	block1048589
+block1048591:
+	ld	a0, -80(s0)
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	ld	s2, 8(sp)
+	ld	s3, 16(sp)
+	.cfi_def_cfa	2, 112
+	ld	ra, 104(sp)
+	ld	s0, 96(sp)
+	addi	sp, sp, 112
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	dingdong, .-dingdong
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -96
+	.cfi_def_cfa_offset	96
+	sd	ra, 88(sp)
+	sd	s0, 80(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -96
+	addi	s0, sp, 96
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	addi	a1, a0, -1
+	li	t0, 7
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048594)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 96
+	ld	ra, 88(sp)
+	ld	s0, 80(sp)
+	addi	sp, sp, 96
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 8(t0)	# Load argv[1]
+	addi	a1, s0, -40
+	li	s1, 1
+	li	a2, 10
+	call	strtol
+	ld	t1, -40(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 8(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -40(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 16(t0)	# Load argv[2]
+	addi	a1, s0, -48
+	li	s1, 2
+	li	a2, 10
+	call	strtol
+	ld	t1, -48(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 16(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -48(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 24(t0)	# Load argv[3]
+	addi	a1, s0, -56
+	li	s1, 3
+	li	a2, 10
+	call	strtol
+	ld	t1, -56(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 24(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -56(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 32(t0)	# Load argv[4]
+	addi	a1, s0, -64
+	li	s1, 4
+	li	a2, 10
+	call	strtol
+	ld	t1, -64(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 32(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -64(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 40(t0)	# Load argv[5]
+	addi	a1, s0, -72
+	li	s1, 5
+	li	a2, 10
+	call	strtol
+	ld	t1, -72(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 40(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -72(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 48(t0)	# Load argv[6]
+	addi	a1, s0, -80
+	li	s1, 6
+	li	a2, 10
+	call	strtol
+	ld	t1, -80(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 48(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -80(s0)
+	ld	t0, -32(s0)	# Load argv
+	ld	a0, 56(t0)	# Load argv[7]
+	addi	a1, s0, -88
+	li	s1, 7
+	li	a2, 10
+	call	strtol
+	ld	t1, -88(s0)	# Load end pointer
+	ld	t0, -32(s0)
+	ld	t2, 56(t0)
+	beq	t1, t2, _L_argv_error
+	lbu	t2, 0(t1)
+	bnez	t2, _L_argv_error
+	sd	a0, -88(s0)
+	ld	a0, -40(s0)	# Load parsed argv[1]
+	ld	a1, -48(s0)	# Load parsed argv[2]
+	ld	a2, -56(s0)	# Load parsed argv[3]
+	ld	a3, -64(s0)	# Load parsed argv[4]
+	ld	a4, -72(s0)	# Load parsed argv[5]
+	ld	a5, -80(s0)	# Load parsed argv[6]
+	ld	a6, -88(s0)	# Load parsed argv[7]
+	call	dingdong
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 96
+	ld	ra, 88(sp)
+	ld	s0, 80(sp)
+	addi	sp, sp, 96
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argv_error:
+1:	auipc	a0, %pcrel_hi(_STR_1048595)
+	addi	a0, a0, %pcrel_lo(1b)
+	mv	a1, s1
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 96
+	ld	ra, 88(sp)
+	ld	s0, 80(sp)
+	addi	sp, sp, 96
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048580:
+	.asciz	"%lld %lld %lld %lld %lld %lld %lld\n"
+_STR_1048584:
+	.asciz	"Equal!"
+_STR_1048585:
+	.asciz	"Equal!\n"
+_STR_1048592:
+	.asciz	"%lld\n"
+_STR_1048594:
+	.asciz	"Argument error: expected 7 arguments, got %d\n"
+_STR_1048595:
+	.asciz	"Argument error: argument %ld is neither int nor float\n"
+
+	.section	.note.GNU-stack,"",@progbits
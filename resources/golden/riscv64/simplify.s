@@ -0,0 +1,187 @@
+	.file	"simplify.vsl"
+	.attribute	arch, "rv64gc"
+	.option	rvc
+	.option	nopic
+	.text
+	.globl	main
+	.type	main, @function
+
+	.align	2
+	.type	f, @function
+f:
+	.cfi_startproc
+	addi	sp, sp, -16
+	.cfi_def_cfa_offset	16
+	sd	ra, 8(sp)
+	sd	s0, 0(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 16
+	.cfi_def_cfa	8, 0
+block1048579:
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 16
+	ld	ra, 8(sp)
+	ld	s0, 0(sp)
+	addi	sp, sp, 16
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	f, .-f
+
+	.align	2
+	.type	g, @function
+g:
+	.cfi_startproc
+	addi	sp, sp, -96
+	.cfi_def_cfa_offset	96
+	sd	ra, 88(sp)
+	sd	s0, 80(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 96
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	sd	a2, -40(s0)
+block1048581:
+	li	t0, 1
+	sd	t0, -48(s0)
+	li	t0, 2
+	sd	t0, -56(s0)
+	ld	t0, -72(s0)
+	li	t1, 1
+	add	t3, t0, t1
+	ld	t0, -80(s0)
+	li	t1, 2
+	add	t2, t0, t1
+	ld	t0, -88(s0)
+	li	t1, 3
+	add	t4, t0, t1
+	mv	a0, t3
+	mv	a1, t2
+	mv	a2, t4
+	call	g
+	sd	a0, -64(s0)
+	ld	t0, -48(s0)
+	ld	t1, -56(s0)
+	ld	t2, -64(s0)
+1:	auipc	t3, %pcrel_hi(_STR_1048586)
+	addi	t3, t3, %pcrel_lo(1b)
+	mv	a0, t3
+	mv	a1, t0
+	mv	a2, t1
+	mv	a3, t2
+	call	printf
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 96
+	ld	ra, 88(sp)
+	ld	s0, 80(sp)
+	addi	sp, sp, 96
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	g, .-g
+
+	.align	2
+	.type	h, @function
+h:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+block1048587:
+	li	t0, 5
+	fcvt.d.l	ft0, t0
+	fsd	ft0, -40(s0)
+	li	t0, 1
+	fcvt.d.l	ft0, t0
+	fsd	ft0, -40(s0)
+	li	t0, 4
+	fcvt.d.l	ft0, t0
+	fsd	ft0, -40(s0)
+	li	t0, 2
+	fcvt.d.l	ft0, t0
+	fsd	ft0, -40(s0)
+	li	t0, -6
+	fcvt.d.l	ft0, t0
+	fsd	ft0, -40(s0)
+	li	t0, 4
+	fcvt.d.l	ft0, t0
+	fsd	ft0, -40(s0)
+	li	a0, 0
+	.cfi_remember_state
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	h, .-h
+
+	.align	2
+main:
+	.cfi_startproc
+	addi	sp, sp, -48
+	.cfi_def_cfa_offset	48
+	sd	ra, 40(sp)
+	sd	s0, 32(sp)
+	.cfi_offset	1, -8
+	.cfi_offset	8, -16
+	sd	s1, 0(sp)
+	.cfi_offset	9, -48
+	addi	s0, sp, 48
+	.cfi_def_cfa	8, 0
+	sd	a0, -24(s0)
+	sd	a1, -32(s0)
+	addi	a1, a0, -1
+	li	t0, 0
+	beq	a1, t0, _L_argc_ok
+1:	auipc	a0, %pcrel_hi(_STR_1048591)
+	addi	a0, a0, %pcrel_lo(1b)
+	call	printf
+	li	a0, 1
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+_L_argc_ok:
+	call	f
+	.cfi_remember_state
+	ld	s1, 0(sp)
+	.cfi_def_cfa	2, 48
+	ld	ra, 40(sp)
+	ld	s0, 32(sp)
+	addi	sp, sp, 48
+	.cfi_def_cfa_offset	0
+	ret
+	.cfi_restore_state
+	.cfi_endproc
+	.size	main, .-main
+
+	.data
+	.align	3
+_STR_1048586:
+	.asciz	"%lld %lld %lld\n"
+_STR_1048591:
+	.asciz	"Argument error: expected 0 arguments, got %d\n"
+
+	.section	.note.GNU-stack,"",@progbits
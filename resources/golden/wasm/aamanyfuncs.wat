@@ -0,0 +1,8759 @@
+;; aamanyfuncs.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "%lld\0a\00")
+	(data (i32.const 6) "foo here\00")
+	(data (i32.const 15) "foo here\0a\00")
+	(data (i32.const 25) "bar here\00")
+	(data (i32.const 34) "bar here\0a\00")
+
+	(func $tester (export "tester") (result i64)
+		(local $a.0 i64)
+		(local $b.1 i64)
+		(local $%6 i64)
+		(local $%7 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%13 i64)
+		(local $%14 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		i64.const 10
+		local.set $a.0
+		i64.const 5
+		local.set $b.1
+		local.get $a.0
+		local.set $%6
+		local.get $b.1
+		local.set $%7
+		local.get $%6
+		local.get $%7
+		call $add
+		local.set $%8
+		local.get $%8
+		local.set $%9
+		i32.const 48
+		local.get $%9
+		i64.store
+		i32.const 0
+		i32.const 48
+		call $printf
+		drop
+		local.get $a.0
+		local.set $%13
+		local.get $b.1
+		local.set $%14
+		local.get $%13
+		local.get $%14
+		call $sub
+		local.set $%15
+		local.get $%15
+		local.set $%16
+		i32.const 48
+		local.get $%16
+		i64.store
+		i32.const 0
+		i32.const 48
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $add (export "add") (param $a i64) (param $b i64) (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%8 i64)
+		(local $%10 i64)
+		(local $%12 i64)
+		(local $%14 i64)
+		(local $%20 i64)
+		(local $%21 i64)
+		(local $%22 i64)
+		(local $%23 i64)
+		(local $%24 i64)
+		(local $%25 i64)
+		(local $%26 i64)
+		(local $%28 i64)
+		(local $%30 i64)
+		(local $%32 i64)
+		(local $%34 i64)
+		(local $%36 i64)
+		(local $%38 i64)
+		(local $%40 i64)
+		(local $%46 i64)
+		(local $%47 i64)
+		(local $%48 i64)
+		(local $%49 i64)
+		(local $%50 i64)
+		(local $%51 i64)
+		(local $%52 i64)
+		(local $%54 i64)
+		(local $%56 i64)
+		(local $%58 i64)
+		(local $%60 i64)
+		(local $%62 i64)
+		(local $%64 i64)
+		(local $%66 i64)
+		(local $%72 i64)
+		(local $%73 i64)
+		(local $%74 i64)
+		(local $%75 i64)
+		(local $%76 i64)
+		(local $%77 i64)
+		(local $%78 i64)
+		(local $%80 i64)
+		(local $%82 i64)
+		(local $%84 i64)
+		(local $%86 i64)
+		(local $%88 i64)
+		(local $%90 i64)
+		(local $%92 i64)
+		(local $%98 i64)
+		(local $%100 i64)
+		(local $%102 i64)
+		(local $%104 i64)
+		(local $%106 i64)
+		(local $%108 i64)
+		(local $%110 i64)
+		(local $%116 i64)
+		(local $%117 i64)
+		(local $%118 i64)
+		(local $%119 i64)
+		(local $%120 i64)
+		(local $%121 i64)
+		(local $%122 i64)
+		(local $%124 i64)
+		(local $%126 i64)
+		(local $%127 i64)
+		(local $%128 i64)
+		local.get $a
+		local.set $%4
+		local.get $%4
+		local.set $x.0
+		local.get $b
+		local.set $%6
+		local.get $%6
+		local.set $y.1
+		local.get $x.0
+		local.set $%8
+		local.get $%8
+		local.set $y.1
+		local.get $x.0
+		local.set $%10
+		local.get $%10
+		local.set $a
+		local.get $b
+		local.set $%12
+		local.get $%12
+		local.set $b
+		local.get $a
+		local.set $%14
+		local.get $%14
+		local.set $b
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%20
+		local.get $a
+		local.set $%21
+		local.get $%20
+		local.get $%21
+		i64.add
+		local.set $%22
+		local.get $x.0
+		local.set $%23
+		local.get $y.1
+		local.set $%24
+		local.get $%23
+		local.get $%24
+		i64.mul
+		local.set $%25
+		local.get $%22
+		local.get $%25
+		i64.sub
+		local.set $%26
+		local.get $%26
+		local.set $x.0
+		local.get $x.0
+		local.set $%28
+		local.get $%28
+		local.set $y.1
+		local.get $a
+		local.set $%30
+		local.get $%30
+		local.set $x.0
+		local.get $b
+		local.set $%32
+		local.get $%32
+		local.set $y.1
+		local.get $x.0
+		local.set $%34
+		local.get $%34
+		local.set $y.1
+		local.get $x.0
+		local.set $%36
+		local.get $%36
+		local.set $a
+		local.get $b
+		local.set $%38
+		local.get $%38
+		local.set $b
+		local.get $a
+		local.set $%40
+		local.get $%40
+		local.set $b
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%46
+		local.get $a
+		local.set $%47
+		local.get $%46
+		local.get $%47
+		i64.add
+		local.set $%48
+		local.get $x.0
+		local.set $%49
+		local.get $y.1
+		local.set $%50
+		local.get $%49
+		local.get $%50
+		i64.mul
+		local.set $%51
+		local.get $%48
+		local.get $%51
+		i64.sub
+		local.set $%52
+		local.get $%52
+		local.set $x.0
+		local.get $x.0
+		local.set $%54
+		local.get $%54
+		local.set $y.1
+		local.get $a
+		local.set $%56
+		local.get $%56
+		local.set $x.0
+		local.get $b
+		local.set $%58
+		local.get $%58
+		local.set $y.1
+		local.get $x.0
+		local.set $%60
+		local.get $%60
+		local.set $y.1
+		local.get $x.0
+		local.set $%62
+		local.get $%62
+		local.set $a
+		local.get $b
+		local.set $%64
+		local.get $%64
+		local.set $b
+		local.get $a
+		local.set $%66
+		local.get $%66
+		local.set $b
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%72
+		local.get $a
+		local.set $%73
+		local.get $%72
+		local.get $%73
+		i64.add
+		local.set $%74
+		local.get $x.0
+		local.set $%75
+		local.get $y.1
+		local.set $%76
+		local.get $%75
+		local.get $%76
+		i64.mul
+		local.set $%77
+		local.get $%74
+		local.get $%77
+		i64.sub
+		local.set $%78
+		local.get $%78
+		local.set $x.0
+		local.get $x.0
+		local.set $%80
+		local.get $%80
+		local.set $y.1
+		local.get $a
+		local.set $%82
+		local.get $%82
+		local.set $x.0
+		local.get $b
+		local.set $%84
+		local.get $%84
+		local.set $y.1
+		local.get $x.0
+		local.set $%86
+		local.get $%86
+		local.set $y.1
+		local.get $x.0
+		local.set $%88
+		local.get $%88
+		local.set $a
+		local.get $b
+		local.set $%90
+		local.get $%90
+		local.set $b
+		local.get $a
+		local.set $%92
+		local.get $%92
+		local.set $b
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $x.0
+		local.set $%98
+		local.get $%98
+		local.set $y.1
+		local.get $a
+		local.set $%100
+		local.get $%100
+		local.set $x.0
+		local.get $b
+		local.set $%102
+		local.get $%102
+		local.set $y.1
+		local.get $x.0
+		local.set $%104
+		local.get $%104
+		local.set $y.1
+		local.get $x.0
+		local.set $%106
+		local.get $%106
+		local.set $a
+		local.get $b
+		local.set $%108
+		local.get $%108
+		local.set $b
+		local.get $a
+		local.set $%110
+		local.get $%110
+		local.set $b
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%116
+		local.get $a
+		local.set $%117
+		local.get $%116
+		local.get $%117
+		i64.add
+		local.set $%118
+		local.get $x.0
+		local.set $%119
+		local.get $y.1
+		local.set $%120
+		local.get $%119
+		local.get $%120
+		i64.mul
+		local.set $%121
+		local.get $%118
+		local.get $%121
+		i64.sub
+		local.set $%122
+		local.get $%122
+		local.set $x.0
+		local.get $x.0
+		local.set $%124
+		local.get $%124
+		local.set $y.1
+		local.get $a
+		local.set $%126
+		local.get $b
+		local.set $%127
+		local.get $%126
+		local.get $%127
+		i64.add
+		local.set $%128
+		local.get $%128
+		return
+		unreachable
+	)
+
+	(func $sub (export "sub") (param $a i64) (param $b i64) (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%8 i64)
+		(local $%10 i64)
+		(local $%12 i64)
+		(local $%14 i64)
+		(local $%20 i64)
+		(local $%21 i64)
+		(local $%22 i64)
+		(local $%23 i64)
+		(local $%24 i64)
+		(local $%25 i64)
+		(local $%26 i64)
+		(local $%28 i64)
+		(local $%30 i64)
+		(local $%32 i64)
+		(local $%34 i64)
+		(local $%36 i64)
+		(local $%38 i64)
+		(local $%40 i64)
+		(local $%46 i64)
+		(local $%47 i64)
+		(local $%48 i64)
+		(local $%49 i64)
+		(local $%50 i64)
+		(local $%51 i64)
+		(local $%52 i64)
+		(local $%54 i64)
+		(local $%56 i64)
+		(local $%58 i64)
+		(local $%60 i64)
+		(local $%62 i64)
+		(local $%64 i64)
+		(local $%66 i64)
+		(local $%72 i64)
+		(local $%73 i64)
+		(local $%74 i64)
+		(local $%75 i64)
+		(local $%76 i64)
+		(local $%77 i64)
+		(local $%78 i64)
+		(local $%80 i64)
+		(local $%82 i64)
+		(local $%84 i64)
+		(local $%86 i64)
+		(local $%88 i64)
+		(local $%90 i64)
+		(local $%92 i64)
+		(local $%98 i64)
+		(local $%99 i64)
+		(local $%100 i64)
+		(local $%101 i64)
+		(local $%102 i64)
+		(local $%103 i64)
+		(local $%104 i64)
+		(local $%106 i64)
+		(local $%108 i64)
+		(local $%110 i64)
+		(local $%112 i64)
+		(local $%114 i64)
+		(local $%116 i64)
+		(local $%118 i64)
+		(local $%124 i64)
+		(local $%125 i64)
+		(local $%126 i64)
+		(local $%127 i64)
+		(local $%128 i64)
+		(local $%129 i64)
+		(local $%130 i64)
+		(local $%132 i64)
+		(local $%134 i64)
+		(local $%136 i64)
+		(local $%138 i64)
+		(local $%140 i64)
+		(local $%142 i64)
+		(local $%144 i64)
+		(local $%150 i64)
+		(local $%151 i64)
+		(local $%152 i64)
+		(local $%153 i64)
+		(local $%154 i64)
+		(local $%155 i64)
+		(local $%156 i64)
+		(local $%158 i64)
+		(local $%160 i64)
+		(local $%161 i64)
+		(local $%162 i64)
+		local.get $a
+		local.set $%4
+		local.get $%4
+		local.set $x.0
+		local.get $b
+		local.set $%6
+		local.get $%6
+		local.set $y.1
+		local.get $x.0
+		local.set $%8
+		local.get $%8
+		local.set $y.1
+		local.get $x.0
+		local.set $%10
+		local.get $%10
+		local.set $a
+		local.get $b
+		local.set $%12
+		local.get $%12
+		local.set $b
+		local.get $a
+		local.set $%14
+		local.get $%14
+		local.set $b
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%20
+		local.get $a
+		local.set $%21
+		local.get $%20
+		local.get $%21
+		i64.add
+		local.set $%22
+		local.get $x.0
+		local.set $%23
+		local.get $y.1
+		local.set $%24
+		local.get $%23
+		local.get $%24
+		i64.mul
+		local.set $%25
+		local.get $%22
+		local.get $%25
+		i64.sub
+		local.set $%26
+		local.get $%26
+		local.set $x.0
+		local.get $x.0
+		local.set $%28
+		local.get $%28
+		local.set $y.1
+		local.get $a
+		local.set $%30
+		local.get $%30
+		local.set $x.0
+		local.get $b
+		local.set $%32
+		local.get $%32
+		local.set $y.1
+		local.get $x.0
+		local.set $%34
+		local.get $%34
+		local.set $y.1
+		local.get $x.0
+		local.set $%36
+		local.get $%36
+		local.set $a
+		local.get $b
+		local.set $%38
+		local.get $%38
+		local.set $b
+		local.get $a
+		local.set $%40
+		local.get $%40
+		local.set $b
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%46
+		local.get $a
+		local.set $%47
+		local.get $%46
+		local.get $%47
+		i64.add
+		local.set $%48
+		local.get $x.0
+		local.set $%49
+		local.get $y.1
+		local.set $%50
+		local.get $%49
+		local.get $%50
+		i64.mul
+		local.set $%51
+		local.get $%48
+		local.get $%51
+		i64.sub
+		local.set $%52
+		local.get $%52
+		local.set $x.0
+		local.get $x.0
+		local.set $%54
+		local.get $%54
+		local.set $y.1
+		local.get $a
+		local.set $%56
+		local.get $%56
+		local.set $x.0
+		local.get $b
+		local.set $%58
+		local.get $%58
+		local.set $y.1
+		local.get $x.0
+		local.set $%60
+		local.get $%60
+		local.set $y.1
+		local.get $x.0
+		local.set $%62
+		local.get $%62
+		local.set $a
+		local.get $b
+		local.set $%64
+		local.get $%64
+		local.set $b
+		local.get $a
+		local.set $%66
+		local.get $%66
+		local.set $b
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%72
+		local.get $a
+		local.set $%73
+		local.get $%72
+		local.get $%73
+		i64.add
+		local.set $%74
+		local.get $x.0
+		local.set $%75
+		local.get $y.1
+		local.set $%76
+		local.get $%75
+		local.get $%76
+		i64.mul
+		local.set $%77
+		local.get $%74
+		local.get $%77
+		i64.sub
+		local.set $%78
+		local.get $%78
+		local.set $x.0
+		local.get $x.0
+		local.set $%80
+		local.get $%80
+		local.set $y.1
+		local.get $a
+		local.set $%82
+		local.get $%82
+		local.set $x.0
+		local.get $b
+		local.set $%84
+		local.get $%84
+		local.set $y.1
+		local.get $x.0
+		local.set $%86
+		local.get $%86
+		local.set $y.1
+		local.get $x.0
+		local.set $%88
+		local.get $%88
+		local.set $a
+		local.get $b
+		local.set $%90
+		local.get $%90
+		local.set $b
+		local.get $a
+		local.set $%92
+		local.get $%92
+		local.set $b
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%98
+		local.get $a
+		local.set $%99
+		local.get $%98
+		local.get $%99
+		i64.add
+		local.set $%100
+		local.get $x.0
+		local.set $%101
+		local.get $y.1
+		local.set $%102
+		local.get $%101
+		local.get $%102
+		i64.mul
+		local.set $%103
+		local.get $%100
+		local.get $%103
+		i64.sub
+		local.set $%104
+		local.get $%104
+		local.set $x.0
+		local.get $x.0
+		local.set $%106
+		local.get $%106
+		local.set $y.1
+		local.get $a
+		local.set $%108
+		local.get $%108
+		local.set $x.0
+		local.get $b
+		local.set $%110
+		local.get $%110
+		local.set $y.1
+		local.get $x.0
+		local.set $%112
+		local.get $%112
+		local.set $y.1
+		local.get $x.0
+		local.set $%114
+		local.get $%114
+		local.set $a
+		local.get $b
+		local.set $%116
+		local.get $%116
+		local.set $b
+		local.get $a
+		local.set $%118
+		local.get $%118
+		local.set $b
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%124
+		local.get $a
+		local.set $%125
+		local.get $%124
+		local.get $%125
+		i64.add
+		local.set $%126
+		local.get $x.0
+		local.set $%127
+		local.get $y.1
+		local.set $%128
+		local.get $%127
+		local.get $%128
+		i64.mul
+		local.set $%129
+		local.get $%126
+		local.get $%129
+		i64.sub
+		local.set $%130
+		local.get $%130
+		local.set $x.0
+		local.get $x.0
+		local.set $%132
+		local.get $%132
+		local.set $y.1
+		local.get $a
+		local.set $%134
+		local.get $%134
+		local.set $x.0
+		local.get $b
+		local.set $%136
+		local.get $%136
+		local.set $y.1
+		local.get $x.0
+		local.set $%138
+		local.get $%138
+		local.set $y.1
+		local.get $x.0
+		local.set $%140
+		local.get $%140
+		local.set $a
+		local.get $b
+		local.set $%142
+		local.get $%142
+		local.set $b
+		local.get $a
+		local.set $%144
+		local.get $%144
+		local.set $b
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%150
+		local.get $a
+		local.set $%151
+		local.get $%150
+		local.get $%151
+		i64.add
+		local.set $%152
+		local.get $x.0
+		local.set $%153
+		local.get $y.1
+		local.set $%154
+		local.get $%153
+		local.get $%154
+		i64.mul
+		local.set $%155
+		local.get $%152
+		local.get $%155
+		i64.sub
+		local.set $%156
+		local.get $%156
+		local.set $x.0
+		local.get $x.0
+		local.set $%158
+		local.get $%158
+		local.set $y.1
+		local.get $a
+		local.set $%160
+		local.get $b
+		local.set $%161
+		local.get $%160
+		local.get $%161
+		i64.sub
+		local.set $%162
+		local.get $%162
+		return
+		unreachable
+	)
+
+	(func $mul (export "mul") (param $a i64) (param $b i64) (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%13 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%17 i64)
+		(local $%18 i64)
+		(local $%20 i64)
+		(local $%22 i64)
+		(local $%24 i64)
+		(local $%26 i64)
+		(local $%27 i64)
+		(local $%28 i64)
+		(local $%29 i64)
+		(local $%35 i64)
+		(local $%36 i64)
+		(local $%37 i64)
+		(local $%38 i64)
+		(local $%39 i64)
+		(local $%40 i64)
+		(local $%41 i64)
+		(local $%43 i64)
+		(local $%45 i64)
+		(local $%47 i64)
+		(local $%49 i64)
+		(local $%50 i64)
+		(local $%51 i64)
+		(local $%52 i64)
+		(local $%54 i64)
+		(local $%56 i64)
+		(local $%57 i64)
+		(local $%58 i64)
+		(local $%59 i64)
+		(local $%61 i64)
+		(local $%63 i64)
+		(local $%65 i64)
+		(local $%67 i64)
+		(local $%68 i64)
+		(local $%69 i64)
+		(local $%70 i64)
+		(local $%76 i64)
+		(local $%77 i64)
+		(local $%78 i64)
+		(local $%79 i64)
+		(local $%80 i64)
+		(local $%81 i64)
+		(local $%82 i64)
+		(local $%84 i64)
+		(local $%86 i64)
+		(local $%88 i64)
+		(local $%90 i64)
+		(local $%91 i64)
+		(local $%92 i64)
+		(local $%93 i64)
+		(local $%95 i64)
+		(local $%97 i64)
+		(local $%98 i64)
+		(local $%99 i64)
+		(local $%100 i64)
+		(local $%102 i64)
+		(local $%104 i64)
+		(local $%106 i64)
+		(local $%108 i64)
+		(local $%109 i64)
+		(local $%110 i64)
+		(local $%111 i64)
+		(local $%117 i64)
+		(local $%118 i64)
+		(local $%119 i64)
+		(local $%120 i64)
+		(local $%121 i64)
+		(local $%122 i64)
+		(local $%123 i64)
+		(local $%125 i64)
+		(local $%127 i64)
+		(local $%129 i64)
+		(local $%131 i64)
+		(local $%132 i64)
+		(local $%133 i64)
+		(local $%134 i64)
+		(local $%136 i64)
+		(local $%138 i64)
+		(local $%139 i64)
+		(local $%140 i64)
+		(local $%141 i64)
+		(local $%143 i64)
+		(local $%145 i64)
+		(local $%147 i64)
+		(local $%149 i64)
+		(local $%150 i64)
+		(local $%151 i64)
+		(local $%152 i64)
+		(local $%158 i64)
+		(local $%159 i64)
+		(local $%160 i64)
+		(local $%161 i64)
+		(local $%162 i64)
+		(local $%163 i64)
+		(local $%164 i64)
+		(local $%166 i64)
+		(local $%168 i64)
+		(local $%170 i64)
+		(local $%172 i64)
+		(local $%173 i64)
+		(local $%174 i64)
+		(local $%175 i64)
+		(local $%177 i64)
+		(local $%179 i64)
+		(local $%180 i64)
+		(local $%181 i64)
+		(local $%182 i64)
+		(local $%184 i64)
+		(local $%186 i64)
+		(local $%188 i64)
+		(local $%190 i64)
+		(local $%191 i64)
+		(local $%192 i64)
+		(local $%193 i64)
+		(local $%199 i64)
+		(local $%200 i64)
+		(local $%201 i64)
+		(local $%202 i64)
+		(local $%203 i64)
+		(local $%204 i64)
+		(local $%205 i64)
+		(local $%207 i64)
+		(local $%209 i64)
+		(local $%211 i64)
+		(local $%213 i64)
+		(local $%214 i64)
+		(local $%215 i64)
+		(local $%216 i64)
+		(local $%218 i64)
+		(local $%220 i64)
+		(local $%221 i64)
+		(local $%222 i64)
+		(local $%223 i64)
+		(local $%225 i64)
+		(local $%227 i64)
+		(local $%229 i64)
+		(local $%231 i64)
+		(local $%232 i64)
+		(local $%233 i64)
+		(local $%234 i64)
+		(local $%240 i64)
+		(local $%241 i64)
+		(local $%242 i64)
+		(local $%243 i64)
+		(local $%244 i64)
+		(local $%245 i64)
+		(local $%246 i64)
+		(local $%248 i64)
+		(local $%250 i64)
+		(local $%252 i64)
+		(local $%254 i64)
+		(local $%255 i64)
+		(local $%256 i64)
+		(local $%257 i64)
+		(local $%259 i64)
+		(local $%261 i64)
+		(local $%262 i64)
+		(local $%263 i64)
+		(local $%264 i64)
+		(local $%266 i64)
+		(local $%268 i64)
+		(local $%270 i64)
+		(local $%272 i64)
+		(local $%273 i64)
+		(local $%274 i64)
+		(local $%275 i64)
+		(local $%281 i64)
+		(local $%282 i64)
+		(local $%283 i64)
+		(local $%284 i64)
+		(local $%285 i64)
+		(local $%286 i64)
+		(local $%287 i64)
+		(local $%289 i64)
+		(local $%291 i64)
+		(local $%293 i64)
+		(local $%295 i64)
+		(local $%296 i64)
+		(local $%297 i64)
+		(local $%298 i64)
+		(local $%300 i64)
+		(local $%302 i64)
+		(local $%303 i64)
+		(local $%304 i64)
+		(local $%305 i64)
+		(local $%307 i64)
+		(local $%309 i64)
+		(local $%311 i64)
+		(local $%313 i64)
+		(local $%314 i64)
+		(local $%315 i64)
+		(local $%316 i64)
+		(local $%322 i64)
+		(local $%323 i64)
+		(local $%324 i64)
+		(local $%325 i64)
+		(local $%326 i64)
+		(local $%327 i64)
+		(local $%328 i64)
+		(local $%330 i64)
+		(local $%332 i64)
+		(local $%333 i64)
+		(local $%334 i64)
+		local.get $a
+		local.set $%4
+		local.get $%4
+		local.set $x.0
+		local.get $b
+		local.set $%6
+		local.get $%6
+		local.set $y.1
+		local.get $a
+		local.set $%8
+		local.get $b
+		local.set $%9
+		local.get $%8
+		local.get $%9
+		call $sub
+		local.set $%10
+		local.get $%10
+		local.set $%11
+		local.get $%11
+		local.set $x.0
+		local.get $x.0
+		local.set $%13
+		local.get $%13
+		local.set $y.1
+		local.get $x.0
+		local.set $%15
+		local.get $y.1
+		local.set $%16
+		local.get $%15
+		local.get $%16
+		call $div
+		local.set $%17
+		local.get $%17
+		local.set $%18
+		local.get $%18
+		local.set $x.0
+		local.get $x.0
+		local.set $%20
+		local.get $%20
+		local.set $a
+		local.get $b
+		local.set $%22
+		local.get $%22
+		local.set $b
+		local.get $a
+		local.set $%24
+		local.get $%24
+		local.set $b
+		local.get $b
+		local.set $%26
+		local.get $b
+		local.set $%27
+		local.get $%26
+		local.get $%27
+		call $add
+		local.set $%28
+		local.get $%28
+		local.set $%29
+		local.get $%29
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%35
+		local.get $a
+		local.set $%36
+		local.get $%35
+		local.get $%36
+		i64.add
+		local.set $%37
+		local.get $x.0
+		local.set $%38
+		local.get $y.1
+		local.set $%39
+		local.get $%38
+		local.get $%39
+		i64.mul
+		local.set $%40
+		local.get $%37
+		local.get $%40
+		i64.sub
+		local.set $%41
+		local.get $%41
+		local.set $x.0
+		local.get $x.0
+		local.set $%43
+		local.get $%43
+		local.set $y.1
+		local.get $a
+		local.set $%45
+		local.get $%45
+		local.set $x.0
+		local.get $b
+		local.set $%47
+		local.get $%47
+		local.set $y.1
+		local.get $a
+		local.set $%49
+		local.get $b
+		local.set $%50
+		local.get $%49
+		local.get $%50
+		call $sub
+		local.set $%51
+		local.get $%51
+		local.set $%52
+		local.get $%52
+		local.set $x.0
+		local.get $x.0
+		local.set $%54
+		local.get $%54
+		local.set $y.1
+		local.get $x.0
+		local.set $%56
+		local.get $y.1
+		local.set $%57
+		local.get $%56
+		local.get $%57
+		call $div
+		local.set $%58
+		local.get $%58
+		local.set $%59
+		local.get $%59
+		local.set $x.0
+		local.get $x.0
+		local.set $%61
+		local.get $%61
+		local.set $a
+		local.get $b
+		local.set $%63
+		local.get $%63
+		local.set $b
+		local.get $a
+		local.set $%65
+		local.get $%65
+		local.set $b
+		local.get $b
+		local.set $%67
+		local.get $b
+		local.set $%68
+		local.get $%67
+		local.get $%68
+		call $add
+		local.set $%69
+		local.get $%69
+		local.set $%70
+		local.get $%70
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%76
+		local.get $a
+		local.set $%77
+		local.get $%76
+		local.get $%77
+		i64.add
+		local.set $%78
+		local.get $x.0
+		local.set $%79
+		local.get $y.1
+		local.set $%80
+		local.get $%79
+		local.get $%80
+		i64.mul
+		local.set $%81
+		local.get $%78
+		local.get $%81
+		i64.sub
+		local.set $%82
+		local.get $%82
+		local.set $x.0
+		local.get $x.0
+		local.set $%84
+		local.get $%84
+		local.set $y.1
+		local.get $a
+		local.set $%86
+		local.get $%86
+		local.set $x.0
+		local.get $b
+		local.set $%88
+		local.get $%88
+		local.set $y.1
+		local.get $a
+		local.set $%90
+		local.get $b
+		local.set $%91
+		local.get $%90
+		local.get $%91
+		call $sub
+		local.set $%92
+		local.get $%92
+		local.set $%93
+		local.get $%93
+		local.set $x.0
+		local.get $x.0
+		local.set $%95
+		local.get $%95
+		local.set $y.1
+		local.get $x.0
+		local.set $%97
+		local.get $y.1
+		local.set $%98
+		local.get $%97
+		local.get $%98
+		call $div
+		local.set $%99
+		local.get $%99
+		local.set $%100
+		local.get $%100
+		local.set $x.0
+		local.get $x.0
+		local.set $%102
+		local.get $%102
+		local.set $a
+		local.get $b
+		local.set $%104
+		local.get $%104
+		local.set $b
+		local.get $a
+		local.set $%106
+		local.get $%106
+		local.set $b
+		local.get $b
+		local.set $%108
+		local.get $b
+		local.set $%109
+		local.get $%108
+		local.get $%109
+		call $add
+		local.set $%110
+		local.get $%110
+		local.set $%111
+		local.get $%111
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%117
+		local.get $a
+		local.set $%118
+		local.get $%117
+		local.get $%118
+		i64.add
+		local.set $%119
+		local.get $x.0
+		local.set $%120
+		local.get $y.1
+		local.set $%121
+		local.get $%120
+		local.get $%121
+		i64.mul
+		local.set $%122
+		local.get $%119
+		local.get $%122
+		i64.sub
+		local.set $%123
+		local.get $%123
+		local.set $x.0
+		local.get $x.0
+		local.set $%125
+		local.get $%125
+		local.set $y.1
+		local.get $a
+		local.set $%127
+		local.get $%127
+		local.set $x.0
+		local.get $b
+		local.set $%129
+		local.get $%129
+		local.set $y.1
+		local.get $a
+		local.set $%131
+		local.get $b
+		local.set $%132
+		local.get $%131
+		local.get $%132
+		call $sub
+		local.set $%133
+		local.get $%133
+		local.set $%134
+		local.get $%134
+		local.set $x.0
+		local.get $x.0
+		local.set $%136
+		local.get $%136
+		local.set $y.1
+		local.get $x.0
+		local.set $%138
+		local.get $y.1
+		local.set $%139
+		local.get $%138
+		local.get $%139
+		call $div
+		local.set $%140
+		local.get $%140
+		local.set $%141
+		local.get $%141
+		local.set $x.0
+		local.get $x.0
+		local.set $%143
+		local.get $%143
+		local.set $a
+		local.get $b
+		local.set $%145
+		local.get $%145
+		local.set $b
+		local.get $a
+		local.set $%147
+		local.get $%147
+		local.set $b
+		local.get $b
+		local.set $%149
+		local.get $b
+		local.set $%150
+		local.get $%149
+		local.get $%150
+		call $add
+		local.set $%151
+		local.get $%151
+		local.set $%152
+		local.get $%152
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%158
+		local.get $a
+		local.set $%159
+		local.get $%158
+		local.get $%159
+		i64.add
+		local.set $%160
+		local.get $x.0
+		local.set $%161
+		local.get $y.1
+		local.set $%162
+		local.get $%161
+		local.get $%162
+		i64.mul
+		local.set $%163
+		local.get $%160
+		local.get $%163
+		i64.sub
+		local.set $%164
+		local.get $%164
+		local.set $x.0
+		local.get $x.0
+		local.set $%166
+		local.get $%166
+		local.set $y.1
+		local.get $a
+		local.set $%168
+		local.get $%168
+		local.set $x.0
+		local.get $b
+		local.set $%170
+		local.get $%170
+		local.set $y.1
+		local.get $a
+		local.set $%172
+		local.get $b
+		local.set $%173
+		local.get $%172
+		local.get $%173
+		call $sub
+		local.set $%174
+		local.get $%174
+		local.set $%175
+		local.get $%175
+		local.set $x.0
+		local.get $x.0
+		local.set $%177
+		local.get $%177
+		local.set $y.1
+		local.get $x.0
+		local.set $%179
+		local.get $y.1
+		local.set $%180
+		local.get $%179
+		local.get $%180
+		call $div
+		local.set $%181
+		local.get $%181
+		local.set $%182
+		local.get $%182
+		local.set $x.0
+		local.get $x.0
+		local.set $%184
+		local.get $%184
+		local.set $a
+		local.get $b
+		local.set $%186
+		local.get $%186
+		local.set $b
+		local.get $a
+		local.set $%188
+		local.get $%188
+		local.set $b
+		local.get $b
+		local.set $%190
+		local.get $b
+		local.set $%191
+		local.get $%190
+		local.get $%191
+		call $add
+		local.set $%192
+		local.get $%192
+		local.set $%193
+		local.get $%193
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%199
+		local.get $a
+		local.set $%200
+		local.get $%199
+		local.get $%200
+		i64.add
+		local.set $%201
+		local.get $x.0
+		local.set $%202
+		local.get $y.1
+		local.set $%203
+		local.get $%202
+		local.get $%203
+		i64.mul
+		local.set $%204
+		local.get $%201
+		local.get $%204
+		i64.sub
+		local.set $%205
+		local.get $%205
+		local.set $x.0
+		local.get $x.0
+		local.set $%207
+		local.get $%207
+		local.set $y.1
+		local.get $a
+		local.set $%209
+		local.get $%209
+		local.set $x.0
+		local.get $b
+		local.set $%211
+		local.get $%211
+		local.set $y.1
+		local.get $a
+		local.set $%213
+		local.get $b
+		local.set $%214
+		local.get $%213
+		local.get $%214
+		call $sub
+		local.set $%215
+		local.get $%215
+		local.set $%216
+		local.get $%216
+		local.set $x.0
+		local.get $x.0
+		local.set $%218
+		local.get $%218
+		local.set $y.1
+		local.get $x.0
+		local.set $%220
+		local.get $y.1
+		local.set $%221
+		local.get $%220
+		local.get $%221
+		call $div
+		local.set $%222
+		local.get $%222
+		local.set $%223
+		local.get $%223
+		local.set $x.0
+		local.get $x.0
+		local.set $%225
+		local.get $%225
+		local.set $a
+		local.get $b
+		local.set $%227
+		local.get $%227
+		local.set $b
+		local.get $a
+		local.set $%229
+		local.get $%229
+		local.set $b
+		local.get $b
+		local.set $%231
+		local.get $b
+		local.set $%232
+		local.get $%231
+		local.get $%232
+		call $add
+		local.set $%233
+		local.get $%233
+		local.set $%234
+		local.get $%234
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%240
+		local.get $a
+		local.set $%241
+		local.get $%240
+		local.get $%241
+		i64.add
+		local.set $%242
+		local.get $x.0
+		local.set $%243
+		local.get $y.1
+		local.set $%244
+		local.get $%243
+		local.get $%244
+		i64.mul
+		local.set $%245
+		local.get $%242
+		local.get $%245
+		i64.sub
+		local.set $%246
+		local.get $%246
+		local.set $x.0
+		local.get $x.0
+		local.set $%248
+		local.get $%248
+		local.set $y.1
+		local.get $a
+		local.set $%250
+		local.get $%250
+		local.set $x.0
+		local.get $b
+		local.set $%252
+		local.get $%252
+		local.set $y.1
+		local.get $a
+		local.set $%254
+		local.get $b
+		local.set $%255
+		local.get $%254
+		local.get $%255
+		call $sub
+		local.set $%256
+		local.get $%256
+		local.set $%257
+		local.get $%257
+		local.set $x.0
+		local.get $x.0
+		local.set $%259
+		local.get $%259
+		local.set $y.1
+		local.get $x.0
+		local.set $%261
+		local.get $y.1
+		local.set $%262
+		local.get $%261
+		local.get $%262
+		call $div
+		local.set $%263
+		local.get $%263
+		local.set $%264
+		local.get $%264
+		local.set $x.0
+		local.get $x.0
+		local.set $%266
+		local.get $%266
+		local.set $a
+		local.get $b
+		local.set $%268
+		local.get $%268
+		local.set $b
+		local.get $a
+		local.set $%270
+		local.get $%270
+		local.set $b
+		local.get $b
+		local.set $%272
+		local.get $b
+		local.set $%273
+		local.get $%272
+		local.get $%273
+		call $add
+		local.set $%274
+		local.get $%274
+		local.set $%275
+		local.get $%275
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%281
+		local.get $a
+		local.set $%282
+		local.get $%281
+		local.get $%282
+		i64.add
+		local.set $%283
+		local.get $x.0
+		local.set $%284
+		local.get $y.1
+		local.set $%285
+		local.get $%284
+		local.get $%285
+		i64.mul
+		local.set $%286
+		local.get $%283
+		local.get $%286
+		i64.sub
+		local.set $%287
+		local.get $%287
+		local.set $x.0
+		local.get $x.0
+		local.set $%289
+		local.get $%289
+		local.set $y.1
+		local.get $a
+		local.set $%291
+		local.get $%291
+		local.set $x.0
+		local.get $b
+		local.set $%293
+		local.get $%293
+		local.set $y.1
+		local.get $a
+		local.set $%295
+		local.get $b
+		local.set $%296
+		local.get $%295
+		local.get $%296
+		call $sub
+		local.set $%297
+		local.get $%297
+		local.set $%298
+		local.get $%298
+		local.set $x.0
+		local.get $x.0
+		local.set $%300
+		local.get $%300
+		local.set $y.1
+		local.get $x.0
+		local.set $%302
+		local.get $y.1
+		local.set $%303
+		local.get $%302
+		local.get $%303
+		call $div
+		local.set $%304
+		local.get $%304
+		local.set $%305
+		local.get $%305
+		local.set $x.0
+		local.get $x.0
+		local.set $%307
+		local.get $%307
+		local.set $a
+		local.get $b
+		local.set $%309
+		local.get $%309
+		local.set $b
+		local.get $a
+		local.set $%311
+		local.get $%311
+		local.set $b
+		local.get $b
+		local.set $%313
+		local.get $b
+		local.set $%314
+		local.get $%313
+		local.get $%314
+		call $add
+		local.set $%315
+		local.get $%315
+		local.set $%316
+		local.get $%316
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%322
+		local.get $a
+		local.set $%323
+		local.get $%322
+		local.get $%323
+		i64.add
+		local.set $%324
+		local.get $x.0
+		local.set $%325
+		local.get $y.1
+		local.set $%326
+		local.get $%325
+		local.get $%326
+		i64.mul
+		local.set $%327
+		local.get $%324
+		local.get $%327
+		i64.sub
+		local.set $%328
+		local.get $%328
+		local.set $x.0
+		local.get $x.0
+		local.set $%330
+		local.get $%330
+		local.set $y.1
+		local.get $a
+		local.set $%332
+		local.get $b
+		local.set $%333
+		local.get $%332
+		local.get $%333
+		i64.mul
+		local.set $%334
+		local.get $%334
+		return
+		unreachable
+	)
+
+	(func $div (export "div") (param $a i64) (param $b i64) (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%13 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%17 i64)
+		(local $%18 i64)
+		(local $%20 i64)
+		(local $%22 i64)
+		(local $%24 i64)
+		(local $%26 i64)
+		(local $%27 i64)
+		(local $%28 i64)
+		(local $%29 i64)
+		(local $%35 i64)
+		(local $%36 i64)
+		(local $%37 i64)
+		(local $%38 i64)
+		(local $%39 i64)
+		(local $%40 i64)
+		(local $%41 i64)
+		(local $%43 i64)
+		(local $%45 i64)
+		(local $%47 i64)
+		(local $%49 i64)
+		(local $%50 i64)
+		(local $%51 i64)
+		(local $%52 i64)
+		(local $%54 i64)
+		(local $%56 i64)
+		(local $%57 i64)
+		(local $%58 i64)
+		(local $%59 i64)
+		(local $%61 i64)
+		(local $%63 i64)
+		(local $%65 i64)
+		(local $%67 i64)
+		(local $%68 i64)
+		(local $%69 i64)
+		(local $%70 i64)
+		(local $%76 i64)
+		(local $%77 i64)
+		(local $%78 i64)
+		(local $%79 i64)
+		(local $%80 i64)
+		(local $%81 i64)
+		(local $%82 i64)
+		(local $%84 i64)
+		(local $%86 i64)
+		(local $%88 i64)
+		(local $%90 i64)
+		(local $%91 i64)
+		(local $%92 i64)
+		(local $%93 i64)
+		(local $%95 i64)
+		(local $%97 i64)
+		(local $%98 i64)
+		(local $%99 i64)
+		(local $%100 i64)
+		(local $%102 i64)
+		(local $%104 i64)
+		(local $%106 i64)
+		(local $%108 i64)
+		(local $%109 i64)
+		(local $%110 i64)
+		(local $%111 i64)
+		(local $%117 i64)
+		(local $%118 i64)
+		(local $%119 i64)
+		(local $%120 i64)
+		(local $%121 i64)
+		(local $%122 i64)
+		(local $%123 i64)
+		(local $%125 i64)
+		(local $%127 i64)
+		(local $%129 i64)
+		(local $%131 i64)
+		(local $%132 i64)
+		(local $%133 i64)
+		(local $%134 i64)
+		(local $%136 i64)
+		(local $%138 i64)
+		(local $%139 i64)
+		(local $%140 i64)
+		(local $%141 i64)
+		(local $%143 i64)
+		(local $%145 i64)
+		(local $%147 i64)
+		(local $%149 i64)
+		(local $%150 i64)
+		(local $%151 i64)
+		(local $%152 i64)
+		(local $%158 i64)
+		(local $%159 i64)
+		(local $%160 i64)
+		(local $%161 i64)
+		(local $%162 i64)
+		(local $%163 i64)
+		(local $%164 i64)
+		(local $%166 i64)
+		(local $%168 i64)
+		(local $%170 i64)
+		(local $%172 i64)
+		(local $%173 i64)
+		(local $%174 i64)
+		(local $%175 i64)
+		(local $%177 i64)
+		(local $%179 i64)
+		(local $%180 i64)
+		(local $%181 i64)
+		(local $%182 i64)
+		(local $%184 i64)
+		(local $%186 i64)
+		(local $%188 i64)
+		(local $%190 i64)
+		(local $%191 i64)
+		(local $%192 i64)
+		(local $%193 i64)
+		(local $%199 i64)
+		(local $%200 i64)
+		(local $%201 i64)
+		(local $%202 i64)
+		(local $%203 i64)
+		(local $%204 i64)
+		(local $%205 i64)
+		(local $%207 i64)
+		(local $%209 i64)
+		(local $%211 i64)
+		(local $%213 i64)
+		(local $%214 i64)
+		(local $%215 i64)
+		(local $%216 i64)
+		(local $%218 i64)
+		(local $%220 i64)
+		(local $%221 i64)
+		(local $%222 i64)
+		(local $%223 i64)
+		(local $%225 i64)
+		(local $%227 i64)
+		(local $%229 i64)
+		(local $%231 i64)
+		(local $%232 i64)
+		(local $%233 i64)
+		(local $%234 i64)
+		(local $%240 i64)
+		(local $%241 i64)
+		(local $%242 i64)
+		(local $%243 i64)
+		(local $%244 i64)
+		(local $%245 i64)
+		(local $%246 i64)
+		(local $%248 i64)
+		(local $%250 i64)
+		(local $%252 i64)
+		(local $%254 i64)
+		(local $%255 i64)
+		(local $%256 i64)
+		(local $%257 i64)
+		(local $%259 i64)
+		(local $%261 i64)
+		(local $%262 i64)
+		(local $%263 i64)
+		(local $%264 i64)
+		(local $%266 i64)
+		(local $%268 i64)
+		(local $%270 i64)
+		(local $%272 i64)
+		(local $%273 i64)
+		(local $%274 i64)
+		(local $%275 i64)
+		(local $%281 i64)
+		(local $%282 i64)
+		(local $%283 i64)
+		(local $%284 i64)
+		(local $%285 i64)
+		(local $%286 i64)
+		(local $%287 i64)
+		(local $%289 i64)
+		(local $%291 i64)
+		(local $%293 i64)
+		(local $%295 i64)
+		(local $%296 i64)
+		(local $%297 i64)
+		(local $%298 i64)
+		(local $%300 i64)
+		(local $%302 i64)
+		(local $%303 i64)
+		(local $%304 i64)
+		(local $%305 i64)
+		(local $%307 i64)
+		(local $%309 i64)
+		(local $%311 i64)
+		(local $%313 i64)
+		(local $%314 i64)
+		(local $%315 i64)
+		(local $%316 i64)
+		(local $%322 i64)
+		(local $%323 i64)
+		(local $%324 i64)
+		(local $%325 i64)
+		(local $%326 i64)
+		(local $%327 i64)
+		(local $%328 i64)
+		(local $%330 i64)
+		(local $%332 i64)
+		(local $%334 i64)
+		(local $%336 i64)
+		(local $%337 i64)
+		(local $%338 i64)
+		(local $%339 i64)
+		(local $%341 i64)
+		(local $%343 i64)
+		(local $%344 i64)
+		(local $%345 i64)
+		(local $%346 i64)
+		(local $%348 i64)
+		(local $%350 i64)
+		(local $%352 i64)
+		(local $%354 i64)
+		(local $%355 i64)
+		(local $%356 i64)
+		(local $%357 i64)
+		(local $%363 i64)
+		(local $%364 i64)
+		(local $%365 i64)
+		(local $%366 i64)
+		(local $%367 i64)
+		(local $%368 i64)
+		(local $%369 i64)
+		(local $%371 i64)
+		(local $%373 i64)
+		(local $%375 i64)
+		(local $%377 i64)
+		(local $%378 i64)
+		(local $%379 i64)
+		(local $%380 i64)
+		(local $%382 i64)
+		(local $%384 i64)
+		(local $%385 i64)
+		(local $%386 i64)
+		(local $%387 i64)
+		(local $%389 i64)
+		(local $%391 i64)
+		(local $%393 i64)
+		(local $%395 i64)
+		(local $%396 i64)
+		(local $%397 i64)
+		(local $%398 i64)
+		(local $%404 i64)
+		(local $%405 i64)
+		(local $%406 i64)
+		(local $%407 i64)
+		(local $%408 i64)
+		(local $%409 i64)
+		(local $%410 i64)
+		(local $%412 i64)
+		(local $%414 i64)
+		(local $%416 i64)
+		(local $%418 i64)
+		(local $%419 i64)
+		(local $%420 i64)
+		(local $%421 i64)
+		(local $%423 i64)
+		(local $%425 i64)
+		(local $%426 i64)
+		(local $%427 i64)
+		(local $%428 i64)
+		(local $%430 i64)
+		(local $%432 i64)
+		(local $%434 i64)
+		(local $%436 i64)
+		(local $%437 i64)
+		(local $%438 i64)
+		(local $%439 i64)
+		(local $%445 i64)
+		(local $%446 i64)
+		(local $%447 i64)
+		(local $%448 i64)
+		(local $%449 i64)
+		(local $%450 i64)
+		(local $%451 i64)
+		(local $%453 i64)
+		(local $%455 i64)
+		(local $%456 i64)
+		(local $%457 i64)
+		local.get $a
+		local.set $%4
+		local.get $%4
+		local.set $x.0
+		local.get $b
+		local.set $%6
+		local.get $%6
+		local.set $y.1
+		local.get $a
+		local.set $%8
+		local.get $b
+		local.set $%9
+		local.get $%8
+		local.get $%9
+		call $sub
+		local.set $%10
+		local.get $%10
+		local.set $%11
+		local.get $%11
+		local.set $x.0
+		local.get $x.0
+		local.set $%13
+		local.get $%13
+		local.set $y.1
+		local.get $x.0
+		local.set $%15
+		local.get $y.1
+		local.set $%16
+		local.get $%15
+		local.get $%16
+		call $div
+		local.set $%17
+		local.get $%17
+		local.set $%18
+		local.get $%18
+		local.set $x.0
+		local.get $x.0
+		local.set $%20
+		local.get $%20
+		local.set $a
+		local.get $b
+		local.set $%22
+		local.get $%22
+		local.set $b
+		local.get $a
+		local.set $%24
+		local.get $%24
+		local.set $b
+		local.get $b
+		local.set $%26
+		local.get $b
+		local.set $%27
+		local.get $%26
+		local.get $%27
+		call $add
+		local.set $%28
+		local.get $%28
+		local.set $%29
+		local.get $%29
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%35
+		local.get $a
+		local.set $%36
+		local.get $%35
+		local.get $%36
+		i64.add
+		local.set $%37
+		local.get $x.0
+		local.set $%38
+		local.get $y.1
+		local.set $%39
+		local.get $%38
+		local.get $%39
+		i64.mul
+		local.set $%40
+		local.get $%37
+		local.get $%40
+		i64.sub
+		local.set $%41
+		local.get $%41
+		local.set $x.0
+		local.get $x.0
+		local.set $%43
+		local.get $%43
+		local.set $y.1
+		local.get $a
+		local.set $%45
+		local.get $%45
+		local.set $x.0
+		local.get $b
+		local.set $%47
+		local.get $%47
+		local.set $y.1
+		local.get $a
+		local.set $%49
+		local.get $b
+		local.set $%50
+		local.get $%49
+		local.get $%50
+		call $sub
+		local.set $%51
+		local.get $%51
+		local.set $%52
+		local.get $%52
+		local.set $x.0
+		local.get $x.0
+		local.set $%54
+		local.get $%54
+		local.set $y.1
+		local.get $x.0
+		local.set $%56
+		local.get $y.1
+		local.set $%57
+		local.get $%56
+		local.get $%57
+		call $div
+		local.set $%58
+		local.get $%58
+		local.set $%59
+		local.get $%59
+		local.set $x.0
+		local.get $x.0
+		local.set $%61
+		local.get $%61
+		local.set $a
+		local.get $b
+		local.set $%63
+		local.get $%63
+		local.set $b
+		local.get $a
+		local.set $%65
+		local.get $%65
+		local.set $b
+		local.get $b
+		local.set $%67
+		local.get $b
+		local.set $%68
+		local.get $%67
+		local.get $%68
+		call $add
+		local.set $%69
+		local.get $%69
+		local.set $%70
+		local.get $%70
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%76
+		local.get $a
+		local.set $%77
+		local.get $%76
+		local.get $%77
+		i64.add
+		local.set $%78
+		local.get $x.0
+		local.set $%79
+		local.get $y.1
+		local.set $%80
+		local.get $%79
+		local.get $%80
+		i64.mul
+		local.set $%81
+		local.get $%78
+		local.get $%81
+		i64.sub
+		local.set $%82
+		local.get $%82
+		local.set $x.0
+		local.get $x.0
+		local.set $%84
+		local.get $%84
+		local.set $y.1
+		local.get $a
+		local.set $%86
+		local.get $%86
+		local.set $x.0
+		local.get $b
+		local.set $%88
+		local.get $%88
+		local.set $y.1
+		local.get $a
+		local.set $%90
+		local.get $b
+		local.set $%91
+		local.get $%90
+		local.get $%91
+		call $sub
+		local.set $%92
+		local.get $%92
+		local.set $%93
+		local.get $%93
+		local.set $x.0
+		local.get $x.0
+		local.set $%95
+		local.get $%95
+		local.set $y.1
+		local.get $x.0
+		local.set $%97
+		local.get $y.1
+		local.set $%98
+		local.get $%97
+		local.get $%98
+		call $div
+		local.set $%99
+		local.get $%99
+		local.set $%100
+		local.get $%100
+		local.set $x.0
+		local.get $x.0
+		local.set $%102
+		local.get $%102
+		local.set $a
+		local.get $b
+		local.set $%104
+		local.get $%104
+		local.set $b
+		local.get $a
+		local.set $%106
+		local.get $%106
+		local.set $b
+		local.get $b
+		local.set $%108
+		local.get $b
+		local.set $%109
+		local.get $%108
+		local.get $%109
+		call $add
+		local.set $%110
+		local.get $%110
+		local.set $%111
+		local.get $%111
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%117
+		local.get $a
+		local.set $%118
+		local.get $%117
+		local.get $%118
+		i64.add
+		local.set $%119
+		local.get $x.0
+		local.set $%120
+		local.get $y.1
+		local.set $%121
+		local.get $%120
+		local.get $%121
+		i64.mul
+		local.set $%122
+		local.get $%119
+		local.get $%122
+		i64.sub
+		local.set $%123
+		local.get $%123
+		local.set $x.0
+		local.get $x.0
+		local.set $%125
+		local.get $%125
+		local.set $y.1
+		local.get $a
+		local.set $%127
+		local.get $%127
+		local.set $x.0
+		local.get $b
+		local.set $%129
+		local.get $%129
+		local.set $y.1
+		local.get $a
+		local.set $%131
+		local.get $b
+		local.set $%132
+		local.get $%131
+		local.get $%132
+		call $sub
+		local.set $%133
+		local.get $%133
+		local.set $%134
+		local.get $%134
+		local.set $x.0
+		local.get $x.0
+		local.set $%136
+		local.get $%136
+		local.set $y.1
+		local.get $x.0
+		local.set $%138
+		local.get $y.1
+		local.set $%139
+		local.get $%138
+		local.get $%139
+		call $div
+		local.set $%140
+		local.get $%140
+		local.set $%141
+		local.get $%141
+		local.set $x.0
+		local.get $x.0
+		local.set $%143
+		local.get $%143
+		local.set $a
+		local.get $b
+		local.set $%145
+		local.get $%145
+		local.set $b
+		local.get $a
+		local.set $%147
+		local.get $%147
+		local.set $b
+		local.get $b
+		local.set $%149
+		local.get $b
+		local.set $%150
+		local.get $%149
+		local.get $%150
+		call $add
+		local.set $%151
+		local.get $%151
+		local.set $%152
+		local.get $%152
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%158
+		local.get $a
+		local.set $%159
+		local.get $%158
+		local.get $%159
+		i64.add
+		local.set $%160
+		local.get $x.0
+		local.set $%161
+		local.get $y.1
+		local.set $%162
+		local.get $%161
+		local.get $%162
+		i64.mul
+		local.set $%163
+		local.get $%160
+		local.get $%163
+		i64.sub
+		local.set $%164
+		local.get $%164
+		local.set $x.0
+		local.get $x.0
+		local.set $%166
+		local.get $%166
+		local.set $y.1
+		local.get $a
+		local.set $%168
+		local.get $%168
+		local.set $x.0
+		local.get $b
+		local.set $%170
+		local.get $%170
+		local.set $y.1
+		local.get $a
+		local.set $%172
+		local.get $b
+		local.set $%173
+		local.get $%172
+		local.get $%173
+		call $sub
+		local.set $%174
+		local.get $%174
+		local.set $%175
+		local.get $%175
+		local.set $x.0
+		local.get $x.0
+		local.set $%177
+		local.get $%177
+		local.set $y.1
+		local.get $x.0
+		local.set $%179
+		local.get $y.1
+		local.set $%180
+		local.get $%179
+		local.get $%180
+		call $div
+		local.set $%181
+		local.get $%181
+		local.set $%182
+		local.get $%182
+		local.set $x.0
+		local.get $x.0
+		local.set $%184
+		local.get $%184
+		local.set $a
+		local.get $b
+		local.set $%186
+		local.get $%186
+		local.set $b
+		local.get $a
+		local.set $%188
+		local.get $%188
+		local.set $b
+		local.get $b
+		local.set $%190
+		local.get $b
+		local.set $%191
+		local.get $%190
+		local.get $%191
+		call $add
+		local.set $%192
+		local.get $%192
+		local.set $%193
+		local.get $%193
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%199
+		local.get $a
+		local.set $%200
+		local.get $%199
+		local.get $%200
+		i64.add
+		local.set $%201
+		local.get $x.0
+		local.set $%202
+		local.get $y.1
+		local.set $%203
+		local.get $%202
+		local.get $%203
+		i64.mul
+		local.set $%204
+		local.get $%201
+		local.get $%204
+		i64.sub
+		local.set $%205
+		local.get $%205
+		local.set $x.0
+		local.get $x.0
+		local.set $%207
+		local.get $%207
+		local.set $y.1
+		local.get $a
+		local.set $%209
+		local.get $%209
+		local.set $x.0
+		local.get $b
+		local.set $%211
+		local.get $%211
+		local.set $y.1
+		local.get $a
+		local.set $%213
+		local.get $b
+		local.set $%214
+		local.get $%213
+		local.get $%214
+		call $sub
+		local.set $%215
+		local.get $%215
+		local.set $%216
+		local.get $%216
+		local.set $x.0
+		local.get $x.0
+		local.set $%218
+		local.get $%218
+		local.set $y.1
+		local.get $x.0
+		local.set $%220
+		local.get $y.1
+		local.set $%221
+		local.get $%220
+		local.get $%221
+		call $div
+		local.set $%222
+		local.get $%222
+		local.set $%223
+		local.get $%223
+		local.set $x.0
+		local.get $x.0
+		local.set $%225
+		local.get $%225
+		local.set $a
+		local.get $b
+		local.set $%227
+		local.get $%227
+		local.set $b
+		local.get $a
+		local.set $%229
+		local.get $%229
+		local.set $b
+		local.get $b
+		local.set $%231
+		local.get $b
+		local.set $%232
+		local.get $%231
+		local.get $%232
+		call $add
+		local.set $%233
+		local.get $%233
+		local.set $%234
+		local.get $%234
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%240
+		local.get $a
+		local.set $%241
+		local.get $%240
+		local.get $%241
+		i64.add
+		local.set $%242
+		local.get $x.0
+		local.set $%243
+		local.get $y.1
+		local.set $%244
+		local.get $%243
+		local.get $%244
+		i64.mul
+		local.set $%245
+		local.get $%242
+		local.get $%245
+		i64.sub
+		local.set $%246
+		local.get $%246
+		local.set $x.0
+		local.get $x.0
+		local.set $%248
+		local.get $%248
+		local.set $y.1
+		local.get $a
+		local.set $%250
+		local.get $%250
+		local.set $x.0
+		local.get $b
+		local.set $%252
+		local.get $%252
+		local.set $y.1
+		local.get $a
+		local.set $%254
+		local.get $b
+		local.set $%255
+		local.get $%254
+		local.get $%255
+		call $sub
+		local.set $%256
+		local.get $%256
+		local.set $%257
+		local.get $%257
+		local.set $x.0
+		local.get $x.0
+		local.set $%259
+		local.get $%259
+		local.set $y.1
+		local.get $x.0
+		local.set $%261
+		local.get $y.1
+		local.set $%262
+		local.get $%261
+		local.get $%262
+		call $div
+		local.set $%263
+		local.get $%263
+		local.set $%264
+		local.get $%264
+		local.set $x.0
+		local.get $x.0
+		local.set $%266
+		local.get $%266
+		local.set $a
+		local.get $b
+		local.set $%268
+		local.get $%268
+		local.set $b
+		local.get $a
+		local.set $%270
+		local.get $%270
+		local.set $b
+		local.get $b
+		local.set $%272
+		local.get $b
+		local.set $%273
+		local.get $%272
+		local.get $%273
+		call $add
+		local.set $%274
+		local.get $%274
+		local.set $%275
+		local.get $%275
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%281
+		local.get $a
+		local.set $%282
+		local.get $%281
+		local.get $%282
+		i64.add
+		local.set $%283
+		local.get $x.0
+		local.set $%284
+		local.get $y.1
+		local.set $%285
+		local.get $%284
+		local.get $%285
+		i64.mul
+		local.set $%286
+		local.get $%283
+		local.get $%286
+		i64.sub
+		local.set $%287
+		local.get $%287
+		local.set $x.0
+		local.get $x.0
+		local.set $%289
+		local.get $%289
+		local.set $y.1
+		local.get $a
+		local.set $%291
+		local.get $%291
+		local.set $x.0
+		local.get $b
+		local.set $%293
+		local.get $%293
+		local.set $y.1
+		local.get $a
+		local.set $%295
+		local.get $b
+		local.set $%296
+		local.get $%295
+		local.get $%296
+		call $sub
+		local.set $%297
+		local.get $%297
+		local.set $%298
+		local.get $%298
+		local.set $x.0
+		local.get $x.0
+		local.set $%300
+		local.get $%300
+		local.set $y.1
+		local.get $x.0
+		local.set $%302
+		local.get $y.1
+		local.set $%303
+		local.get $%302
+		local.get $%303
+		call $div
+		local.set $%304
+		local.get $%304
+		local.set $%305
+		local.get $%305
+		local.set $x.0
+		local.get $x.0
+		local.set $%307
+		local.get $%307
+		local.set $a
+		local.get $b
+		local.set $%309
+		local.get $%309
+		local.set $b
+		local.get $a
+		local.set $%311
+		local.get $%311
+		local.set $b
+		local.get $b
+		local.set $%313
+		local.get $b
+		local.set $%314
+		local.get $%313
+		local.get $%314
+		call $add
+		local.set $%315
+		local.get $%315
+		local.set $%316
+		local.get $%316
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%322
+		local.get $a
+		local.set $%323
+		local.get $%322
+		local.get $%323
+		i64.add
+		local.set $%324
+		local.get $x.0
+		local.set $%325
+		local.get $y.1
+		local.set $%326
+		local.get $%325
+		local.get $%326
+		i64.mul
+		local.set $%327
+		local.get $%324
+		local.get $%327
+		i64.sub
+		local.set $%328
+		local.get $%328
+		local.set $x.0
+		local.get $x.0
+		local.set $%330
+		local.get $%330
+		local.set $y.1
+		local.get $a
+		local.set $%332
+		local.get $%332
+		local.set $x.0
+		local.get $b
+		local.set $%334
+		local.get $%334
+		local.set $y.1
+		local.get $a
+		local.set $%336
+		local.get $b
+		local.set $%337
+		local.get $%336
+		local.get $%337
+		call $sub
+		local.set $%338
+		local.get $%338
+		local.set $%339
+		local.get $%339
+		local.set $x.0
+		local.get $x.0
+		local.set $%341
+		local.get $%341
+		local.set $y.1
+		local.get $x.0
+		local.set $%343
+		local.get $y.1
+		local.set $%344
+		local.get $%343
+		local.get $%344
+		call $div
+		local.set $%345
+		local.get $%345
+		local.set $%346
+		local.get $%346
+		local.set $x.0
+		local.get $x.0
+		local.set $%348
+		local.get $%348
+		local.set $a
+		local.get $b
+		local.set $%350
+		local.get $%350
+		local.set $b
+		local.get $a
+		local.set $%352
+		local.get $%352
+		local.set $b
+		local.get $b
+		local.set $%354
+		local.get $b
+		local.set $%355
+		local.get $%354
+		local.get $%355
+		call $add
+		local.set $%356
+		local.get $%356
+		local.set $%357
+		local.get $%357
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%363
+		local.get $a
+		local.set $%364
+		local.get $%363
+		local.get $%364
+		i64.add
+		local.set $%365
+		local.get $x.0
+		local.set $%366
+		local.get $y.1
+		local.set $%367
+		local.get $%366
+		local.get $%367
+		i64.mul
+		local.set $%368
+		local.get $%365
+		local.get $%368
+		i64.sub
+		local.set $%369
+		local.get $%369
+		local.set $x.0
+		local.get $x.0
+		local.set $%371
+		local.get $%371
+		local.set $y.1
+		local.get $a
+		local.set $%373
+		local.get $%373
+		local.set $x.0
+		local.get $b
+		local.set $%375
+		local.get $%375
+		local.set $y.1
+		local.get $a
+		local.set $%377
+		local.get $b
+		local.set $%378
+		local.get $%377
+		local.get $%378
+		call $sub
+		local.set $%379
+		local.get $%379
+		local.set $%380
+		local.get $%380
+		local.set $x.0
+		local.get $x.0
+		local.set $%382
+		local.get $%382
+		local.set $y.1
+		local.get $x.0
+		local.set $%384
+		local.get $y.1
+		local.set $%385
+		local.get $%384
+		local.get $%385
+		call $div
+		local.set $%386
+		local.get $%386
+		local.set $%387
+		local.get $%387
+		local.set $x.0
+		local.get $x.0
+		local.set $%389
+		local.get $%389
+		local.set $a
+		local.get $b
+		local.set $%391
+		local.get $%391
+		local.set $b
+		local.get $a
+		local.set $%393
+		local.get $%393
+		local.set $b
+		local.get $b
+		local.set $%395
+		local.get $b
+		local.set $%396
+		local.get $%395
+		local.get $%396
+		call $add
+		local.set $%397
+		local.get $%397
+		local.set $%398
+		local.get $%398
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%404
+		local.get $a
+		local.set $%405
+		local.get $%404
+		local.get $%405
+		i64.add
+		local.set $%406
+		local.get $x.0
+		local.set $%407
+		local.get $y.1
+		local.set $%408
+		local.get $%407
+		local.get $%408
+		i64.mul
+		local.set $%409
+		local.get $%406
+		local.get $%409
+		i64.sub
+		local.set $%410
+		local.get $%410
+		local.set $x.0
+		local.get $x.0
+		local.set $%412
+		local.get $%412
+		local.set $y.1
+		local.get $a
+		local.set $%414
+		local.get $%414
+		local.set $x.0
+		local.get $b
+		local.set $%416
+		local.get $%416
+		local.set $y.1
+		local.get $a
+		local.set $%418
+		local.get $b
+		local.set $%419
+		local.get $%418
+		local.get $%419
+		call $sub
+		local.set $%420
+		local.get $%420
+		local.set $%421
+		local.get $%421
+		local.set $x.0
+		local.get $x.0
+		local.set $%423
+		local.get $%423
+		local.set $y.1
+		local.get $x.0
+		local.set $%425
+		local.get $y.1
+		local.set $%426
+		local.get $%425
+		local.get $%426
+		call $div
+		local.set $%427
+		local.get $%427
+		local.set $%428
+		local.get $%428
+		local.set $x.0
+		local.get $x.0
+		local.set $%430
+		local.get $%430
+		local.set $a
+		local.get $b
+		local.set $%432
+		local.get $%432
+		local.set $b
+		local.get $a
+		local.set $%434
+		local.get $%434
+		local.set $b
+		local.get $b
+		local.set $%436
+		local.get $b
+		local.set $%437
+		local.get $%436
+		local.get $%437
+		call $add
+		local.set $%438
+		local.get $%438
+		local.set $%439
+		local.get $%439
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%445
+		local.get $a
+		local.set $%446
+		local.get $%445
+		local.get $%446
+		i64.add
+		local.set $%447
+		local.get $x.0
+		local.set $%448
+		local.get $y.1
+		local.set $%449
+		local.get $%448
+		local.get $%449
+		i64.mul
+		local.set $%450
+		local.get $%447
+		local.get $%450
+		i64.sub
+		local.set $%451
+		local.get $%451
+		local.set $x.0
+		local.get $x.0
+		local.set $%453
+		local.get $%453
+		local.set $y.1
+		local.get $a
+		local.set $%455
+		local.get $b
+		local.set $%456
+		local.get $%455
+		local.get $%456
+		i64.div_s
+		local.set $%457
+		local.get $%457
+		return
+		unreachable
+	)
+
+	(func $rshift (export "rshift") (param $a i64) (param $b i64) (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%13 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%17 i64)
+		(local $%18 i64)
+		(local $%20 i64)
+		(local $%22 i64)
+		(local $%24 i64)
+		(local $%26 i64)
+		(local $%27 i64)
+		(local $%28 i64)
+		(local $%29 i64)
+		(local $%35 i64)
+		(local $%36 i64)
+		(local $%37 i64)
+		(local $%38 i64)
+		(local $%39 i64)
+		(local $%40 i64)
+		(local $%41 i64)
+		(local $%43 i64)
+		(local $%45 i64)
+		(local $%47 i64)
+		(local $%49 i64)
+		(local $%50 i64)
+		(local $%51 i64)
+		(local $%52 i64)
+		(local $%54 i64)
+		(local $%56 i64)
+		(local $%57 i64)
+		(local $%58 i64)
+		(local $%59 i64)
+		(local $%61 i64)
+		(local $%63 i64)
+		(local $%65 i64)
+		(local $%67 i64)
+		(local $%68 i64)
+		(local $%69 i64)
+		(local $%70 i64)
+		(local $%76 i64)
+		(local $%77 i64)
+		(local $%78 i64)
+		(local $%79 i64)
+		(local $%80 i64)
+		(local $%81 i64)
+		(local $%82 i64)
+		(local $%84 i64)
+		(local $%86 i64)
+		(local $%88 i64)
+		(local $%90 i64)
+		(local $%91 i64)
+		(local $%92 i64)
+		(local $%93 i64)
+		(local $%95 i64)
+		(local $%97 i64)
+		(local $%98 i64)
+		(local $%99 i64)
+		(local $%100 i64)
+		(local $%102 i64)
+		(local $%104 i64)
+		(local $%106 i64)
+		(local $%108 i64)
+		(local $%109 i64)
+		(local $%110 i64)
+		(local $%111 i64)
+		(local $%117 i64)
+		(local $%118 i64)
+		(local $%119 i64)
+		(local $%120 i64)
+		(local $%121 i64)
+		(local $%122 i64)
+		(local $%123 i64)
+		(local $%125 i64)
+		(local $%127 i64)
+		(local $%129 i64)
+		(local $%131 i64)
+		(local $%132 i64)
+		(local $%133 i64)
+		(local $%134 i64)
+		(local $%136 i64)
+		(local $%138 i64)
+		(local $%139 i64)
+		(local $%140 i64)
+		(local $%141 i64)
+		(local $%143 i64)
+		(local $%145 i64)
+		(local $%147 i64)
+		(local $%149 i64)
+		(local $%150 i64)
+		(local $%151 i64)
+		(local $%152 i64)
+		(local $%158 i64)
+		(local $%159 i64)
+		(local $%160 i64)
+		(local $%161 i64)
+		(local $%162 i64)
+		(local $%163 i64)
+		(local $%164 i64)
+		(local $%166 i64)
+		(local $%168 i64)
+		(local $%170 i64)
+		(local $%172 i64)
+		(local $%173 i64)
+		(local $%174 i64)
+		(local $%175 i64)
+		(local $%177 i64)
+		(local $%179 i64)
+		(local $%180 i64)
+		(local $%181 i64)
+		(local $%182 i64)
+		(local $%184 i64)
+		(local $%186 i64)
+		(local $%188 i64)
+		(local $%190 i64)
+		(local $%191 i64)
+		(local $%192 i64)
+		(local $%193 i64)
+		(local $%199 i64)
+		(local $%200 i64)
+		(local $%201 i64)
+		(local $%202 i64)
+		(local $%203 i64)
+		(local $%204 i64)
+		(local $%205 i64)
+		(local $%207 i64)
+		(local $%209 i64)
+		(local $%211 i64)
+		(local $%213 i64)
+		(local $%214 i64)
+		(local $%215 i64)
+		(local $%216 i64)
+		(local $%218 i64)
+		(local $%220 i64)
+		(local $%221 i64)
+		(local $%222 i64)
+		(local $%223 i64)
+		(local $%225 i64)
+		(local $%227 i64)
+		(local $%229 i64)
+		(local $%231 i64)
+		(local $%232 i64)
+		(local $%233 i64)
+		(local $%234 i64)
+		(local $%240 i64)
+		(local $%241 i64)
+		(local $%242 i64)
+		(local $%243 i64)
+		(local $%244 i64)
+		(local $%245 i64)
+		(local $%246 i64)
+		(local $%248 i64)
+		(local $%250 i64)
+		(local $%252 i64)
+		(local $%254 i64)
+		(local $%255 i64)
+		(local $%256 i64)
+		(local $%257 i64)
+		(local $%259 i64)
+		(local $%261 i64)
+		(local $%262 i64)
+		(local $%263 i64)
+		(local $%264 i64)
+		(local $%266 i64)
+		(local $%268 i64)
+		(local $%270 i64)
+		(local $%272 i64)
+		(local $%273 i64)
+		(local $%274 i64)
+		(local $%275 i64)
+		(local $%281 i64)
+		(local $%282 i64)
+		(local $%283 i64)
+		(local $%284 i64)
+		(local $%285 i64)
+		(local $%286 i64)
+		(local $%287 i64)
+		(local $%289 i64)
+		(local $%291 i64)
+		(local $%293 i64)
+		(local $%295 i64)
+		(local $%296 i64)
+		(local $%297 i64)
+		(local $%298 i64)
+		(local $%300 i64)
+		(local $%302 i64)
+		(local $%303 i64)
+		(local $%304 i64)
+		(local $%305 i64)
+		(local $%307 i64)
+		(local $%309 i64)
+		(local $%311 i64)
+		(local $%313 i64)
+		(local $%314 i64)
+		(local $%315 i64)
+		(local $%316 i64)
+		(local $%322 i64)
+		(local $%323 i64)
+		(local $%324 i64)
+		(local $%325 i64)
+		(local $%326 i64)
+		(local $%327 i64)
+		(local $%328 i64)
+		(local $%330 i64)
+		(local $%332 i64)
+		(local $%334 i64)
+		(local $%336 i64)
+		(local $%337 i64)
+		(local $%338 i64)
+		(local $%339 i64)
+		(local $%341 i64)
+		(local $%343 i64)
+		(local $%344 i64)
+		(local $%345 i64)
+		(local $%346 i64)
+		(local $%348 i64)
+		(local $%350 i64)
+		(local $%352 i64)
+		(local $%354 i64)
+		(local $%355 i64)
+		(local $%356 i64)
+		(local $%357 i64)
+		(local $%363 i64)
+		(local $%364 i64)
+		(local $%365 i64)
+		(local $%366 i64)
+		(local $%367 i64)
+		(local $%368 i64)
+		(local $%369 i64)
+		(local $%371 i64)
+		(local $%373 i64)
+		(local $%375 i64)
+		(local $%377 i64)
+		(local $%378 i64)
+		(local $%379 i64)
+		(local $%380 i64)
+		(local $%382 i64)
+		(local $%384 i64)
+		(local $%385 i64)
+		(local $%386 i64)
+		(local $%387 i64)
+		(local $%389 i64)
+		(local $%391 i64)
+		(local $%393 i64)
+		(local $%395 i64)
+		(local $%396 i64)
+		(local $%397 i64)
+		(local $%398 i64)
+		(local $%404 i64)
+		(local $%405 i64)
+		(local $%406 i64)
+		(local $%407 i64)
+		(local $%408 i64)
+		(local $%409 i64)
+		(local $%410 i64)
+		(local $%412 i64)
+		(local $%414 i64)
+		(local $%416 i64)
+		(local $%418 i64)
+		(local $%419 i64)
+		(local $%420 i64)
+		(local $%421 i64)
+		(local $%423 i64)
+		(local $%425 i64)
+		(local $%426 i64)
+		(local $%427 i64)
+		(local $%428 i64)
+		(local $%430 i64)
+		(local $%432 i64)
+		(local $%434 i64)
+		(local $%436 i64)
+		(local $%437 i64)
+		(local $%438 i64)
+		(local $%439 i64)
+		(local $%445 i64)
+		(local $%446 i64)
+		(local $%447 i64)
+		(local $%448 i64)
+		(local $%449 i64)
+		(local $%450 i64)
+		(local $%451 i64)
+		(local $%453 i64)
+		(local $%455 i64)
+		(local $%456 i64)
+		(local $%457 i64)
+		local.get $a
+		local.set $%4
+		local.get $%4
+		local.set $x.0
+		local.get $b
+		local.set $%6
+		local.get $%6
+		local.set $y.1
+		local.get $a
+		local.set $%8
+		local.get $b
+		local.set $%9
+		local.get $%8
+		local.get $%9
+		call $sub
+		local.set $%10
+		local.get $%10
+		local.set $%11
+		local.get $%11
+		local.set $x.0
+		local.get $x.0
+		local.set $%13
+		local.get $%13
+		local.set $y.1
+		local.get $x.0
+		local.set $%15
+		local.get $y.1
+		local.set $%16
+		local.get $%15
+		local.get $%16
+		call $div
+		local.set $%17
+		local.get $%17
+		local.set $%18
+		local.get $%18
+		local.set $x.0
+		local.get $x.0
+		local.set $%20
+		local.get $%20
+		local.set $a
+		local.get $b
+		local.set $%22
+		local.get $%22
+		local.set $b
+		local.get $a
+		local.set $%24
+		local.get $%24
+		local.set $b
+		local.get $b
+		local.set $%26
+		local.get $b
+		local.set $%27
+		local.get $%26
+		local.get $%27
+		call $add
+		local.set $%28
+		local.get $%28
+		local.set $%29
+		local.get $%29
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%35
+		local.get $a
+		local.set $%36
+		local.get $%35
+		local.get $%36
+		i64.add
+		local.set $%37
+		local.get $x.0
+		local.set $%38
+		local.get $y.1
+		local.set $%39
+		local.get $%38
+		local.get $%39
+		i64.mul
+		local.set $%40
+		local.get $%37
+		local.get $%40
+		i64.sub
+		local.set $%41
+		local.get $%41
+		local.set $x.0
+		local.get $x.0
+		local.set $%43
+		local.get $%43
+		local.set $y.1
+		local.get $a
+		local.set $%45
+		local.get $%45
+		local.set $x.0
+		local.get $b
+		local.set $%47
+		local.get $%47
+		local.set $y.1
+		local.get $a
+		local.set $%49
+		local.get $b
+		local.set $%50
+		local.get $%49
+		local.get $%50
+		call $sub
+		local.set $%51
+		local.get $%51
+		local.set $%52
+		local.get $%52
+		local.set $x.0
+		local.get $x.0
+		local.set $%54
+		local.get $%54
+		local.set $y.1
+		local.get $x.0
+		local.set $%56
+		local.get $y.1
+		local.set $%57
+		local.get $%56
+		local.get $%57
+		call $div
+		local.set $%58
+		local.get $%58
+		local.set $%59
+		local.get $%59
+		local.set $x.0
+		local.get $x.0
+		local.set $%61
+		local.get $%61
+		local.set $a
+		local.get $b
+		local.set $%63
+		local.get $%63
+		local.set $b
+		local.get $a
+		local.set $%65
+		local.get $%65
+		local.set $b
+		local.get $b
+		local.set $%67
+		local.get $b
+		local.set $%68
+		local.get $%67
+		local.get $%68
+		call $add
+		local.set $%69
+		local.get $%69
+		local.set $%70
+		local.get $%70
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%76
+		local.get $a
+		local.set $%77
+		local.get $%76
+		local.get $%77
+		i64.add
+		local.set $%78
+		local.get $x.0
+		local.set $%79
+		local.get $y.1
+		local.set $%80
+		local.get $%79
+		local.get $%80
+		i64.mul
+		local.set $%81
+		local.get $%78
+		local.get $%81
+		i64.sub
+		local.set $%82
+		local.get $%82
+		local.set $x.0
+		local.get $x.0
+		local.set $%84
+		local.get $%84
+		local.set $y.1
+		local.get $a
+		local.set $%86
+		local.get $%86
+		local.set $x.0
+		local.get $b
+		local.set $%88
+		local.get $%88
+		local.set $y.1
+		local.get $a
+		local.set $%90
+		local.get $b
+		local.set $%91
+		local.get $%90
+		local.get $%91
+		call $sub
+		local.set $%92
+		local.get $%92
+		local.set $%93
+		local.get $%93
+		local.set $x.0
+		local.get $x.0
+		local.set $%95
+		local.get $%95
+		local.set $y.1
+		local.get $x.0
+		local.set $%97
+		local.get $y.1
+		local.set $%98
+		local.get $%97
+		local.get $%98
+		call $div
+		local.set $%99
+		local.get $%99
+		local.set $%100
+		local.get $%100
+		local.set $x.0
+		local.get $x.0
+		local.set $%102
+		local.get $%102
+		local.set $a
+		local.get $b
+		local.set $%104
+		local.get $%104
+		local.set $b
+		local.get $a
+		local.set $%106
+		local.get $%106
+		local.set $b
+		local.get $b
+		local.set $%108
+		local.get $b
+		local.set $%109
+		local.get $%108
+		local.get $%109
+		call $add
+		local.set $%110
+		local.get $%110
+		local.set $%111
+		local.get $%111
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%117
+		local.get $a
+		local.set $%118
+		local.get $%117
+		local.get $%118
+		i64.add
+		local.set $%119
+		local.get $x.0
+		local.set $%120
+		local.get $y.1
+		local.set $%121
+		local.get $%120
+		local.get $%121
+		i64.mul
+		local.set $%122
+		local.get $%119
+		local.get $%122
+		i64.sub
+		local.set $%123
+		local.get $%123
+		local.set $x.0
+		local.get $x.0
+		local.set $%125
+		local.get $%125
+		local.set $y.1
+		local.get $a
+		local.set $%127
+		local.get $%127
+		local.set $x.0
+		local.get $b
+		local.set $%129
+		local.get $%129
+		local.set $y.1
+		local.get $a
+		local.set $%131
+		local.get $b
+		local.set $%132
+		local.get $%131
+		local.get $%132
+		call $sub
+		local.set $%133
+		local.get $%133
+		local.set $%134
+		local.get $%134
+		local.set $x.0
+		local.get $x.0
+		local.set $%136
+		local.get $%136
+		local.set $y.1
+		local.get $x.0
+		local.set $%138
+		local.get $y.1
+		local.set $%139
+		local.get $%138
+		local.get $%139
+		call $div
+		local.set $%140
+		local.get $%140
+		local.set $%141
+		local.get $%141
+		local.set $x.0
+		local.get $x.0
+		local.set $%143
+		local.get $%143
+		local.set $a
+		local.get $b
+		local.set $%145
+		local.get $%145
+		local.set $b
+		local.get $a
+		local.set $%147
+		local.get $%147
+		local.set $b
+		local.get $b
+		local.set $%149
+		local.get $b
+		local.set $%150
+		local.get $%149
+		local.get $%150
+		call $add
+		local.set $%151
+		local.get $%151
+		local.set $%152
+		local.get $%152
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%158
+		local.get $a
+		local.set $%159
+		local.get $%158
+		local.get $%159
+		i64.add
+		local.set $%160
+		local.get $x.0
+		local.set $%161
+		local.get $y.1
+		local.set $%162
+		local.get $%161
+		local.get $%162
+		i64.mul
+		local.set $%163
+		local.get $%160
+		local.get $%163
+		i64.sub
+		local.set $%164
+		local.get $%164
+		local.set $x.0
+		local.get $x.0
+		local.set $%166
+		local.get $%166
+		local.set $y.1
+		local.get $a
+		local.set $%168
+		local.get $%168
+		local.set $x.0
+		local.get $b
+		local.set $%170
+		local.get $%170
+		local.set $y.1
+		local.get $a
+		local.set $%172
+		local.get $b
+		local.set $%173
+		local.get $%172
+		local.get $%173
+		call $sub
+		local.set $%174
+		local.get $%174
+		local.set $%175
+		local.get $%175
+		local.set $x.0
+		local.get $x.0
+		local.set $%177
+		local.get $%177
+		local.set $y.1
+		local.get $x.0
+		local.set $%179
+		local.get $y.1
+		local.set $%180
+		local.get $%179
+		local.get $%180
+		call $div
+		local.set $%181
+		local.get $%181
+		local.set $%182
+		local.get $%182
+		local.set $x.0
+		local.get $x.0
+		local.set $%184
+		local.get $%184
+		local.set $a
+		local.get $b
+		local.set $%186
+		local.get $%186
+		local.set $b
+		local.get $a
+		local.set $%188
+		local.get $%188
+		local.set $b
+		local.get $b
+		local.set $%190
+		local.get $b
+		local.set $%191
+		local.get $%190
+		local.get $%191
+		call $add
+		local.set $%192
+		local.get $%192
+		local.set $%193
+		local.get $%193
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%199
+		local.get $a
+		local.set $%200
+		local.get $%199
+		local.get $%200
+		i64.add
+		local.set $%201
+		local.get $x.0
+		local.set $%202
+		local.get $y.1
+		local.set $%203
+		local.get $%202
+		local.get $%203
+		i64.mul
+		local.set $%204
+		local.get $%201
+		local.get $%204
+		i64.sub
+		local.set $%205
+		local.get $%205
+		local.set $x.0
+		local.get $x.0
+		local.set $%207
+		local.get $%207
+		local.set $y.1
+		local.get $a
+		local.set $%209
+		local.get $%209
+		local.set $x.0
+		local.get $b
+		local.set $%211
+		local.get $%211
+		local.set $y.1
+		local.get $a
+		local.set $%213
+		local.get $b
+		local.set $%214
+		local.get $%213
+		local.get $%214
+		call $sub
+		local.set $%215
+		local.get $%215
+		local.set $%216
+		local.get $%216
+		local.set $x.0
+		local.get $x.0
+		local.set $%218
+		local.get $%218
+		local.set $y.1
+		local.get $x.0
+		local.set $%220
+		local.get $y.1
+		local.set $%221
+		local.get $%220
+		local.get $%221
+		call $div
+		local.set $%222
+		local.get $%222
+		local.set $%223
+		local.get $%223
+		local.set $x.0
+		local.get $x.0
+		local.set $%225
+		local.get $%225
+		local.set $a
+		local.get $b
+		local.set $%227
+		local.get $%227
+		local.set $b
+		local.get $a
+		local.set $%229
+		local.get $%229
+		local.set $b
+		local.get $b
+		local.set $%231
+		local.get $b
+		local.set $%232
+		local.get $%231
+		local.get $%232
+		call $add
+		local.set $%233
+		local.get $%233
+		local.set $%234
+		local.get $%234
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%240
+		local.get $a
+		local.set $%241
+		local.get $%240
+		local.get $%241
+		i64.add
+		local.set $%242
+		local.get $x.0
+		local.set $%243
+		local.get $y.1
+		local.set $%244
+		local.get $%243
+		local.get $%244
+		i64.mul
+		local.set $%245
+		local.get $%242
+		local.get $%245
+		i64.sub
+		local.set $%246
+		local.get $%246
+		local.set $x.0
+		local.get $x.0
+		local.set $%248
+		local.get $%248
+		local.set $y.1
+		local.get $a
+		local.set $%250
+		local.get $%250
+		local.set $x.0
+		local.get $b
+		local.set $%252
+		local.get $%252
+		local.set $y.1
+		local.get $a
+		local.set $%254
+		local.get $b
+		local.set $%255
+		local.get $%254
+		local.get $%255
+		call $sub
+		local.set $%256
+		local.get $%256
+		local.set $%257
+		local.get $%257
+		local.set $x.0
+		local.get $x.0
+		local.set $%259
+		local.get $%259
+		local.set $y.1
+		local.get $x.0
+		local.set $%261
+		local.get $y.1
+		local.set $%262
+		local.get $%261
+		local.get $%262
+		call $div
+		local.set $%263
+		local.get $%263
+		local.set $%264
+		local.get $%264
+		local.set $x.0
+		local.get $x.0
+		local.set $%266
+		local.get $%266
+		local.set $a
+		local.get $b
+		local.set $%268
+		local.get $%268
+		local.set $b
+		local.get $a
+		local.set $%270
+		local.get $%270
+		local.set $b
+		local.get $b
+		local.set $%272
+		local.get $b
+		local.set $%273
+		local.get $%272
+		local.get $%273
+		call $add
+		local.set $%274
+		local.get $%274
+		local.set $%275
+		local.get $%275
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%281
+		local.get $a
+		local.set $%282
+		local.get $%281
+		local.get $%282
+		i64.add
+		local.set $%283
+		local.get $x.0
+		local.set $%284
+		local.get $y.1
+		local.set $%285
+		local.get $%284
+		local.get $%285
+		i64.mul
+		local.set $%286
+		local.get $%283
+		local.get $%286
+		i64.sub
+		local.set $%287
+		local.get $%287
+		local.set $x.0
+		local.get $x.0
+		local.set $%289
+		local.get $%289
+		local.set $y.1
+		local.get $a
+		local.set $%291
+		local.get $%291
+		local.set $x.0
+		local.get $b
+		local.set $%293
+		local.get $%293
+		local.set $y.1
+		local.get $a
+		local.set $%295
+		local.get $b
+		local.set $%296
+		local.get $%295
+		local.get $%296
+		call $sub
+		local.set $%297
+		local.get $%297
+		local.set $%298
+		local.get $%298
+		local.set $x.0
+		local.get $x.0
+		local.set $%300
+		local.get $%300
+		local.set $y.1
+		local.get $x.0
+		local.set $%302
+		local.get $y.1
+		local.set $%303
+		local.get $%302
+		local.get $%303
+		call $div
+		local.set $%304
+		local.get $%304
+		local.set $%305
+		local.get $%305
+		local.set $x.0
+		local.get $x.0
+		local.set $%307
+		local.get $%307
+		local.set $a
+		local.get $b
+		local.set $%309
+		local.get $%309
+		local.set $b
+		local.get $a
+		local.set $%311
+		local.get $%311
+		local.set $b
+		local.get $b
+		local.set $%313
+		local.get $b
+		local.set $%314
+		local.get $%313
+		local.get $%314
+		call $add
+		local.set $%315
+		local.get $%315
+		local.set $%316
+		local.get $%316
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%322
+		local.get $a
+		local.set $%323
+		local.get $%322
+		local.get $%323
+		i64.add
+		local.set $%324
+		local.get $x.0
+		local.set $%325
+		local.get $y.1
+		local.set $%326
+		local.get $%325
+		local.get $%326
+		i64.mul
+		local.set $%327
+		local.get $%324
+		local.get $%327
+		i64.sub
+		local.set $%328
+		local.get $%328
+		local.set $x.0
+		local.get $x.0
+		local.set $%330
+		local.get $%330
+		local.set $y.1
+		local.get $a
+		local.set $%332
+		local.get $%332
+		local.set $x.0
+		local.get $b
+		local.set $%334
+		local.get $%334
+		local.set $y.1
+		local.get $a
+		local.set $%336
+		local.get $b
+		local.set $%337
+		local.get $%336
+		local.get $%337
+		call $sub
+		local.set $%338
+		local.get $%338
+		local.set $%339
+		local.get $%339
+		local.set $x.0
+		local.get $x.0
+		local.set $%341
+		local.get $%341
+		local.set $y.1
+		local.get $x.0
+		local.set $%343
+		local.get $y.1
+		local.set $%344
+		local.get $%343
+		local.get $%344
+		call $div
+		local.set $%345
+		local.get $%345
+		local.set $%346
+		local.get $%346
+		local.set $x.0
+		local.get $x.0
+		local.set $%348
+		local.get $%348
+		local.set $a
+		local.get $b
+		local.set $%350
+		local.get $%350
+		local.set $b
+		local.get $a
+		local.set $%352
+		local.get $%352
+		local.set $b
+		local.get $b
+		local.set $%354
+		local.get $b
+		local.set $%355
+		local.get $%354
+		local.get $%355
+		call $add
+		local.set $%356
+		local.get $%356
+		local.set $%357
+		local.get $%357
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%363
+		local.get $a
+		local.set $%364
+		local.get $%363
+		local.get $%364
+		i64.add
+		local.set $%365
+		local.get $x.0
+		local.set $%366
+		local.get $y.1
+		local.set $%367
+		local.get $%366
+		local.get $%367
+		i64.mul
+		local.set $%368
+		local.get $%365
+		local.get $%368
+		i64.sub
+		local.set $%369
+		local.get $%369
+		local.set $x.0
+		local.get $x.0
+		local.set $%371
+		local.get $%371
+		local.set $y.1
+		local.get $a
+		local.set $%373
+		local.get $%373
+		local.set $x.0
+		local.get $b
+		local.set $%375
+		local.get $%375
+		local.set $y.1
+		local.get $a
+		local.set $%377
+		local.get $b
+		local.set $%378
+		local.get $%377
+		local.get $%378
+		call $sub
+		local.set $%379
+		local.get $%379
+		local.set $%380
+		local.get $%380
+		local.set $x.0
+		local.get $x.0
+		local.set $%382
+		local.get $%382
+		local.set $y.1
+		local.get $x.0
+		local.set $%384
+		local.get $y.1
+		local.set $%385
+		local.get $%384
+		local.get $%385
+		call $div
+		local.set $%386
+		local.get $%386
+		local.set $%387
+		local.get $%387
+		local.set $x.0
+		local.get $x.0
+		local.set $%389
+		local.get $%389
+		local.set $a
+		local.get $b
+		local.set $%391
+		local.get $%391
+		local.set $b
+		local.get $a
+		local.set $%393
+		local.get $%393
+		local.set $b
+		local.get $b
+		local.set $%395
+		local.get $b
+		local.set $%396
+		local.get $%395
+		local.get $%396
+		call $add
+		local.set $%397
+		local.get $%397
+		local.set $%398
+		local.get $%398
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%404
+		local.get $a
+		local.set $%405
+		local.get $%404
+		local.get $%405
+		i64.add
+		local.set $%406
+		local.get $x.0
+		local.set $%407
+		local.get $y.1
+		local.set $%408
+		local.get $%407
+		local.get $%408
+		i64.mul
+		local.set $%409
+		local.get $%406
+		local.get $%409
+		i64.sub
+		local.set $%410
+		local.get $%410
+		local.set $x.0
+		local.get $x.0
+		local.set $%412
+		local.get $%412
+		local.set $y.1
+		local.get $a
+		local.set $%414
+		local.get $%414
+		local.set $x.0
+		local.get $b
+		local.set $%416
+		local.get $%416
+		local.set $y.1
+		local.get $a
+		local.set $%418
+		local.get $b
+		local.set $%419
+		local.get $%418
+		local.get $%419
+		call $sub
+		local.set $%420
+		local.get $%420
+		local.set $%421
+		local.get $%421
+		local.set $x.0
+		local.get $x.0
+		local.set $%423
+		local.get $%423
+		local.set $y.1
+		local.get $x.0
+		local.set $%425
+		local.get $y.1
+		local.set $%426
+		local.get $%425
+		local.get $%426
+		call $div
+		local.set $%427
+		local.get $%427
+		local.set $%428
+		local.get $%428
+		local.set $x.0
+		local.get $x.0
+		local.set $%430
+		local.get $%430
+		local.set $a
+		local.get $b
+		local.set $%432
+		local.get $%432
+		local.set $b
+		local.get $a
+		local.set $%434
+		local.get $%434
+		local.set $b
+		local.get $b
+		local.set $%436
+		local.get $b
+		local.set $%437
+		local.get $%436
+		local.get $%437
+		call $add
+		local.set $%438
+		local.get $%438
+		local.set $%439
+		local.get $%439
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%445
+		local.get $a
+		local.set $%446
+		local.get $%445
+		local.get $%446
+		i64.add
+		local.set $%447
+		local.get $x.0
+		local.set $%448
+		local.get $y.1
+		local.set $%449
+		local.get $%448
+		local.get $%449
+		i64.mul
+		local.set $%450
+		local.get $%447
+		local.get $%450
+		i64.sub
+		local.set $%451
+		local.get $%451
+		local.set $x.0
+		local.get $x.0
+		local.set $%453
+		local.get $%453
+		local.set $y.1
+		local.get $a
+		local.set $%455
+		local.get $b
+		local.set $%456
+		local.get $%455
+		local.get $%456
+		i64.shl
+		local.set $%457
+		local.get $%457
+		return
+		unreachable
+	)
+
+	(func $lshift (export "lshift") (param $a i64) (param $b i64) (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%13 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%17 i64)
+		(local $%18 i64)
+		(local $%20 i64)
+		(local $%22 i64)
+		(local $%24 i64)
+		(local $%26 i64)
+		(local $%27 i64)
+		(local $%28 i64)
+		(local $%29 i64)
+		(local $%35 i64)
+		(local $%36 i64)
+		(local $%37 i64)
+		(local $%38 i64)
+		(local $%39 i64)
+		(local $%40 i64)
+		(local $%41 i64)
+		(local $%43 i64)
+		(local $%45 i64)
+		(local $%46 i64)
+		(local $%47 i64)
+		local.get $a
+		local.set $%4
+		local.get $%4
+		local.set $x.0
+		local.get $b
+		local.set $%6
+		local.get $%6
+		local.set $y.1
+		local.get $a
+		local.set $%8
+		local.get $b
+		local.set $%9
+		local.get $%8
+		local.get $%9
+		call $sub
+		local.set $%10
+		local.get $%10
+		local.set $%11
+		local.get $%11
+		local.set $x.0
+		local.get $x.0
+		local.set $%13
+		local.get $%13
+		local.set $y.1
+		local.get $x.0
+		local.set $%15
+		local.get $y.1
+		local.set $%16
+		local.get $%15
+		local.get $%16
+		call $div
+		local.set $%17
+		local.get $%17
+		local.set $%18
+		local.get $%18
+		local.set $x.0
+		local.get $x.0
+		local.set $%20
+		local.get $%20
+		local.set $a
+		local.get $b
+		local.set $%22
+		local.get $%22
+		local.set $b
+		local.get $a
+		local.set $%24
+		local.get $%24
+		local.set $b
+		local.get $b
+		local.set $%26
+		local.get $b
+		local.set $%27
+		local.get $%26
+		local.get $%27
+		call $add
+		local.set $%28
+		local.get $%28
+		local.set $%29
+		local.get $%29
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%35
+		local.get $a
+		local.set $%36
+		local.get $%35
+		local.get $%36
+		i64.add
+		local.set $%37
+		local.get $x.0
+		local.set $%38
+		local.get $y.1
+		local.set $%39
+		local.get $%38
+		local.get $%39
+		i64.mul
+		local.set $%40
+		local.get $%37
+		local.get $%40
+		i64.sub
+		local.set $%41
+		local.get $%41
+		local.set $x.0
+		local.get $x.0
+		local.set $%43
+		local.get $%43
+		local.set $y.1
+		local.get $a
+		local.set $%45
+		local.get $b
+		local.set $%46
+		local.get $%45
+		local.get $%46
+		i64.shr_s
+		local.set $%47
+		local.get $%47
+		return
+		unreachable
+	)
+
+	(func $and (export "and") (param $a i64) (param $b i64) (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%13 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%17 i64)
+		(local $%18 i64)
+		(local $%20 i64)
+		(local $%22 i64)
+		(local $%24 i64)
+		(local $%26 i64)
+		(local $%27 i64)
+		(local $%28 i64)
+		(local $%29 i64)
+		(local $%35 i64)
+		(local $%36 i64)
+		(local $%37 i64)
+		(local $%38 i64)
+		(local $%39 i64)
+		(local $%40 i64)
+		(local $%41 i64)
+		(local $%43 i64)
+		(local $%45 i64)
+		(local $%47 i64)
+		(local $%49 i64)
+		(local $%50 i64)
+		(local $%51 i64)
+		(local $%52 i64)
+		(local $%54 i64)
+		(local $%56 i64)
+		(local $%57 i64)
+		(local $%58 i64)
+		(local $%59 i64)
+		(local $%61 i64)
+		(local $%63 i64)
+		(local $%65 i64)
+		(local $%67 i64)
+		(local $%68 i64)
+		(local $%69 i64)
+		(local $%70 i64)
+		(local $%76 i64)
+		(local $%77 i64)
+		(local $%78 i64)
+		(local $%79 i64)
+		(local $%80 i64)
+		(local $%81 i64)
+		(local $%82 i64)
+		(local $%84 i64)
+		(local $%86 i64)
+		(local $%88 i64)
+		(local $%90 i64)
+		(local $%91 i64)
+		(local $%92 i64)
+		(local $%93 i64)
+		(local $%95 i64)
+		(local $%97 i64)
+		(local $%98 i64)
+		(local $%99 i64)
+		(local $%100 i64)
+		(local $%102 i64)
+		(local $%104 i64)
+		(local $%106 i64)
+		(local $%108 i64)
+		(local $%109 i64)
+		(local $%110 i64)
+		(local $%111 i64)
+		(local $%117 i64)
+		(local $%118 i64)
+		(local $%119 i64)
+		(local $%120 i64)
+		(local $%121 i64)
+		(local $%122 i64)
+		(local $%123 i64)
+		(local $%125 i64)
+		(local $%127 i64)
+		(local $%129 i64)
+		(local $%131 i64)
+		(local $%132 i64)
+		(local $%133 i64)
+		(local $%134 i64)
+		(local $%136 i64)
+		(local $%138 i64)
+		(local $%139 i64)
+		(local $%140 i64)
+		(local $%141 i64)
+		(local $%143 i64)
+		(local $%145 i64)
+		(local $%147 i64)
+		(local $%149 i64)
+		(local $%150 i64)
+		(local $%151 i64)
+		(local $%152 i64)
+		(local $%158 i64)
+		(local $%159 i64)
+		(local $%160 i64)
+		(local $%161 i64)
+		(local $%162 i64)
+		(local $%163 i64)
+		(local $%164 i64)
+		(local $%166 i64)
+		(local $%168 i64)
+		(local $%170 i64)
+		(local $%172 i64)
+		(local $%173 i64)
+		(local $%174 i64)
+		(local $%175 i64)
+		(local $%177 i64)
+		(local $%179 i64)
+		(local $%180 i64)
+		(local $%181 i64)
+		(local $%182 i64)
+		(local $%184 i64)
+		(local $%186 i64)
+		(local $%188 i64)
+		(local $%190 i64)
+		(local $%191 i64)
+		(local $%192 i64)
+		(local $%193 i64)
+		(local $%199 i64)
+		(local $%200 i64)
+		(local $%201 i64)
+		(local $%202 i64)
+		(local $%203 i64)
+		(local $%204 i64)
+		(local $%205 i64)
+		(local $%207 i64)
+		(local $%209 i64)
+		(local $%211 i64)
+		(local $%213 i64)
+		(local $%214 i64)
+		(local $%215 i64)
+		(local $%216 i64)
+		(local $%218 i64)
+		(local $%220 i64)
+		(local $%221 i64)
+		(local $%222 i64)
+		(local $%223 i64)
+		(local $%225 i64)
+		(local $%227 i64)
+		(local $%229 i64)
+		(local $%231 i64)
+		(local $%232 i64)
+		(local $%233 i64)
+		(local $%234 i64)
+		(local $%240 i64)
+		(local $%241 i64)
+		(local $%242 i64)
+		(local $%243 i64)
+		(local $%244 i64)
+		(local $%245 i64)
+		(local $%246 i64)
+		(local $%248 i64)
+		(local $%250 i64)
+		(local $%252 i64)
+		(local $%254 i64)
+		(local $%255 i64)
+		(local $%256 i64)
+		(local $%257 i64)
+		(local $%259 i64)
+		(local $%261 i64)
+		(local $%262 i64)
+		(local $%263 i64)
+		(local $%264 i64)
+		(local $%266 i64)
+		(local $%268 i64)
+		(local $%270 i64)
+		(local $%272 i64)
+		(local $%273 i64)
+		(local $%274 i64)
+		(local $%275 i64)
+		(local $%281 i64)
+		(local $%282 i64)
+		(local $%283 i64)
+		(local $%284 i64)
+		(local $%285 i64)
+		(local $%286 i64)
+		(local $%287 i64)
+		(local $%289 i64)
+		(local $%291 i64)
+		(local $%293 i64)
+		(local $%295 i64)
+		(local $%296 i64)
+		(local $%297 i64)
+		(local $%298 i64)
+		(local $%300 i64)
+		(local $%302 i64)
+		(local $%303 i64)
+		(local $%304 i64)
+		(local $%305 i64)
+		(local $%307 i64)
+		(local $%309 i64)
+		(local $%311 i64)
+		(local $%313 i64)
+		(local $%314 i64)
+		(local $%315 i64)
+		(local $%316 i64)
+		(local $%322 i64)
+		(local $%323 i64)
+		(local $%324 i64)
+		(local $%325 i64)
+		(local $%326 i64)
+		(local $%327 i64)
+		(local $%328 i64)
+		(local $%330 i64)
+		(local $%332 i64)
+		(local $%334 i64)
+		(local $%336 i64)
+		(local $%337 i64)
+		(local $%338 i64)
+		(local $%339 i64)
+		(local $%341 i64)
+		(local $%343 i64)
+		(local $%344 i64)
+		(local $%345 i64)
+		(local $%346 i64)
+		(local $%348 i64)
+		(local $%350 i64)
+		(local $%352 i64)
+		(local $%354 i64)
+		(local $%355 i64)
+		(local $%356 i64)
+		(local $%357 i64)
+		(local $%363 i64)
+		(local $%364 i64)
+		(local $%365 i64)
+		(local $%366 i64)
+		(local $%367 i64)
+		(local $%368 i64)
+		(local $%369 i64)
+		(local $%371 i64)
+		(local $%373 i64)
+		(local $%375 i64)
+		(local $%377 i64)
+		(local $%378 i64)
+		(local $%379 i64)
+		(local $%380 i64)
+		(local $%382 i64)
+		(local $%384 i64)
+		(local $%385 i64)
+		(local $%386 i64)
+		(local $%387 i64)
+		(local $%389 i64)
+		(local $%391 i64)
+		(local $%393 i64)
+		(local $%395 i64)
+		(local $%396 i64)
+		(local $%397 i64)
+		(local $%398 i64)
+		(local $%404 i64)
+		(local $%405 i64)
+		(local $%406 i64)
+		(local $%407 i64)
+		(local $%408 i64)
+		(local $%409 i64)
+		(local $%410 i64)
+		(local $%412 i64)
+		(local $%414 i64)
+		(local $%415 i64)
+		(local $%416 i64)
+		local.get $a
+		local.set $%4
+		local.get $%4
+		local.set $x.0
+		local.get $b
+		local.set $%6
+		local.get $%6
+		local.set $y.1
+		local.get $a
+		local.set $%8
+		local.get $b
+		local.set $%9
+		local.get $%8
+		local.get $%9
+		call $sub
+		local.set $%10
+		local.get $%10
+		local.set $%11
+		local.get $%11
+		local.set $x.0
+		local.get $x.0
+		local.set $%13
+		local.get $%13
+		local.set $y.1
+		local.get $x.0
+		local.set $%15
+		local.get $y.1
+		local.set $%16
+		local.get $%15
+		local.get $%16
+		call $div
+		local.set $%17
+		local.get $%17
+		local.set $%18
+		local.get $%18
+		local.set $x.0
+		local.get $x.0
+		local.set $%20
+		local.get $%20
+		local.set $a
+		local.get $b
+		local.set $%22
+		local.get $%22
+		local.set $b
+		local.get $a
+		local.set $%24
+		local.get $%24
+		local.set $b
+		local.get $b
+		local.set $%26
+		local.get $b
+		local.set $%27
+		local.get $%26
+		local.get $%27
+		call $add
+		local.set $%28
+		local.get $%28
+		local.set $%29
+		local.get $%29
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%35
+		local.get $a
+		local.set $%36
+		local.get $%35
+		local.get $%36
+		i64.add
+		local.set $%37
+		local.get $x.0
+		local.set $%38
+		local.get $y.1
+		local.set $%39
+		local.get $%38
+		local.get $%39
+		i64.mul
+		local.set $%40
+		local.get $%37
+		local.get $%40
+		i64.sub
+		local.set $%41
+		local.get $%41
+		local.set $x.0
+		local.get $x.0
+		local.set $%43
+		local.get $%43
+		local.set $y.1
+		local.get $a
+		local.set $%45
+		local.get $%45
+		local.set $x.0
+		local.get $b
+		local.set $%47
+		local.get $%47
+		local.set $y.1
+		local.get $a
+		local.set $%49
+		local.get $b
+		local.set $%50
+		local.get $%49
+		local.get $%50
+		call $sub
+		local.set $%51
+		local.get $%51
+		local.set $%52
+		local.get $%52
+		local.set $x.0
+		local.get $x.0
+		local.set $%54
+		local.get $%54
+		local.set $y.1
+		local.get $x.0
+		local.set $%56
+		local.get $y.1
+		local.set $%57
+		local.get $%56
+		local.get $%57
+		call $div
+		local.set $%58
+		local.get $%58
+		local.set $%59
+		local.get $%59
+		local.set $x.0
+		local.get $x.0
+		local.set $%61
+		local.get $%61
+		local.set $a
+		local.get $b
+		local.set $%63
+		local.get $%63
+		local.set $b
+		local.get $a
+		local.set $%65
+		local.get $%65
+		local.set $b
+		local.get $b
+		local.set $%67
+		local.get $b
+		local.set $%68
+		local.get $%67
+		local.get $%68
+		call $add
+		local.set $%69
+		local.get $%69
+		local.set $%70
+		local.get $%70
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%76
+		local.get $a
+		local.set $%77
+		local.get $%76
+		local.get $%77
+		i64.add
+		local.set $%78
+		local.get $x.0
+		local.set $%79
+		local.get $y.1
+		local.set $%80
+		local.get $%79
+		local.get $%80
+		i64.mul
+		local.set $%81
+		local.get $%78
+		local.get $%81
+		i64.sub
+		local.set $%82
+		local.get $%82
+		local.set $x.0
+		local.get $x.0
+		local.set $%84
+		local.get $%84
+		local.set $y.1
+		local.get $a
+		local.set $%86
+		local.get $%86
+		local.set $x.0
+		local.get $b
+		local.set $%88
+		local.get $%88
+		local.set $y.1
+		local.get $a
+		local.set $%90
+		local.get $b
+		local.set $%91
+		local.get $%90
+		local.get $%91
+		call $sub
+		local.set $%92
+		local.get $%92
+		local.set $%93
+		local.get $%93
+		local.set $x.0
+		local.get $x.0
+		local.set $%95
+		local.get $%95
+		local.set $y.1
+		local.get $x.0
+		local.set $%97
+		local.get $y.1
+		local.set $%98
+		local.get $%97
+		local.get $%98
+		call $div
+		local.set $%99
+		local.get $%99
+		local.set $%100
+		local.get $%100
+		local.set $x.0
+		local.get $x.0
+		local.set $%102
+		local.get $%102
+		local.set $a
+		local.get $b
+		local.set $%104
+		local.get $%104
+		local.set $b
+		local.get $a
+		local.set $%106
+		local.get $%106
+		local.set $b
+		local.get $b
+		local.set $%108
+		local.get $b
+		local.set $%109
+		local.get $%108
+		local.get $%109
+		call $add
+		local.set $%110
+		local.get $%110
+		local.set $%111
+		local.get $%111
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%117
+		local.get $a
+		local.set $%118
+		local.get $%117
+		local.get $%118
+		i64.add
+		local.set $%119
+		local.get $x.0
+		local.set $%120
+		local.get $y.1
+		local.set $%121
+		local.get $%120
+		local.get $%121
+		i64.mul
+		local.set $%122
+		local.get $%119
+		local.get $%122
+		i64.sub
+		local.set $%123
+		local.get $%123
+		local.set $x.0
+		local.get $x.0
+		local.set $%125
+		local.get $%125
+		local.set $y.1
+		local.get $a
+		local.set $%127
+		local.get $%127
+		local.set $x.0
+		local.get $b
+		local.set $%129
+		local.get $%129
+		local.set $y.1
+		local.get $a
+		local.set $%131
+		local.get $b
+		local.set $%132
+		local.get $%131
+		local.get $%132
+		call $sub
+		local.set $%133
+		local.get $%133
+		local.set $%134
+		local.get $%134
+		local.set $x.0
+		local.get $x.0
+		local.set $%136
+		local.get $%136
+		local.set $y.1
+		local.get $x.0
+		local.set $%138
+		local.get $y.1
+		local.set $%139
+		local.get $%138
+		local.get $%139
+		call $div
+		local.set $%140
+		local.get $%140
+		local.set $%141
+		local.get $%141
+		local.set $x.0
+		local.get $x.0
+		local.set $%143
+		local.get $%143
+		local.set $a
+		local.get $b
+		local.set $%145
+		local.get $%145
+		local.set $b
+		local.get $a
+		local.set $%147
+		local.get $%147
+		local.set $b
+		local.get $b
+		local.set $%149
+		local.get $b
+		local.set $%150
+		local.get $%149
+		local.get $%150
+		call $add
+		local.set $%151
+		local.get $%151
+		local.set $%152
+		local.get $%152
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%158
+		local.get $a
+		local.set $%159
+		local.get $%158
+		local.get $%159
+		i64.add
+		local.set $%160
+		local.get $x.0
+		local.set $%161
+		local.get $y.1
+		local.set $%162
+		local.get $%161
+		local.get $%162
+		i64.mul
+		local.set $%163
+		local.get $%160
+		local.get $%163
+		i64.sub
+		local.set $%164
+		local.get $%164
+		local.set $x.0
+		local.get $x.0
+		local.set $%166
+		local.get $%166
+		local.set $y.1
+		local.get $a
+		local.set $%168
+		local.get $%168
+		local.set $x.0
+		local.get $b
+		local.set $%170
+		local.get $%170
+		local.set $y.1
+		local.get $a
+		local.set $%172
+		local.get $b
+		local.set $%173
+		local.get $%172
+		local.get $%173
+		call $sub
+		local.set $%174
+		local.get $%174
+		local.set $%175
+		local.get $%175
+		local.set $x.0
+		local.get $x.0
+		local.set $%177
+		local.get $%177
+		local.set $y.1
+		local.get $x.0
+		local.set $%179
+		local.get $y.1
+		local.set $%180
+		local.get $%179
+		local.get $%180
+		call $div
+		local.set $%181
+		local.get $%181
+		local.set $%182
+		local.get $%182
+		local.set $x.0
+		local.get $x.0
+		local.set $%184
+		local.get $%184
+		local.set $a
+		local.get $b
+		local.set $%186
+		local.get $%186
+		local.set $b
+		local.get $a
+		local.set $%188
+		local.get $%188
+		local.set $b
+		local.get $b
+		local.set $%190
+		local.get $b
+		local.set $%191
+		local.get $%190
+		local.get $%191
+		call $add
+		local.set $%192
+		local.get $%192
+		local.set $%193
+		local.get $%193
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%199
+		local.get $a
+		local.set $%200
+		local.get $%199
+		local.get $%200
+		i64.add
+		local.set $%201
+		local.get $x.0
+		local.set $%202
+		local.get $y.1
+		local.set $%203
+		local.get $%202
+		local.get $%203
+		i64.mul
+		local.set $%204
+		local.get $%201
+		local.get $%204
+		i64.sub
+		local.set $%205
+		local.get $%205
+		local.set $x.0
+		local.get $x.0
+		local.set $%207
+		local.get $%207
+		local.set $y.1
+		local.get $a
+		local.set $%209
+		local.get $%209
+		local.set $x.0
+		local.get $b
+		local.set $%211
+		local.get $%211
+		local.set $y.1
+		local.get $a
+		local.set $%213
+		local.get $b
+		local.set $%214
+		local.get $%213
+		local.get $%214
+		call $sub
+		local.set $%215
+		local.get $%215
+		local.set $%216
+		local.get $%216
+		local.set $x.0
+		local.get $x.0
+		local.set $%218
+		local.get $%218
+		local.set $y.1
+		local.get $x.0
+		local.set $%220
+		local.get $y.1
+		local.set $%221
+		local.get $%220
+		local.get $%221
+		call $div
+		local.set $%222
+		local.get $%222
+		local.set $%223
+		local.get $%223
+		local.set $x.0
+		local.get $x.0
+		local.set $%225
+		local.get $%225
+		local.set $a
+		local.get $b
+		local.set $%227
+		local.get $%227
+		local.set $b
+		local.get $a
+		local.set $%229
+		local.get $%229
+		local.set $b
+		local.get $b
+		local.set $%231
+		local.get $b
+		local.set $%232
+		local.get $%231
+		local.get $%232
+		call $add
+		local.set $%233
+		local.get $%233
+		local.set $%234
+		local.get $%234
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%240
+		local.get $a
+		local.set $%241
+		local.get $%240
+		local.get $%241
+		i64.add
+		local.set $%242
+		local.get $x.0
+		local.set $%243
+		local.get $y.1
+		local.set $%244
+		local.get $%243
+		local.get $%244
+		i64.mul
+		local.set $%245
+		local.get $%242
+		local.get $%245
+		i64.sub
+		local.set $%246
+		local.get $%246
+		local.set $x.0
+		local.get $x.0
+		local.set $%248
+		local.get $%248
+		local.set $y.1
+		local.get $a
+		local.set $%250
+		local.get $%250
+		local.set $x.0
+		local.get $b
+		local.set $%252
+		local.get $%252
+		local.set $y.1
+		local.get $a
+		local.set $%254
+		local.get $b
+		local.set $%255
+		local.get $%254
+		local.get $%255
+		call $sub
+		local.set $%256
+		local.get $%256
+		local.set $%257
+		local.get $%257
+		local.set $x.0
+		local.get $x.0
+		local.set $%259
+		local.get $%259
+		local.set $y.1
+		local.get $x.0
+		local.set $%261
+		local.get $y.1
+		local.set $%262
+		local.get $%261
+		local.get $%262
+		call $div
+		local.set $%263
+		local.get $%263
+		local.set $%264
+		local.get $%264
+		local.set $x.0
+		local.get $x.0
+		local.set $%266
+		local.get $%266
+		local.set $a
+		local.get $b
+		local.set $%268
+		local.get $%268
+		local.set $b
+		local.get $a
+		local.set $%270
+		local.get $%270
+		local.set $b
+		local.get $b
+		local.set $%272
+		local.get $b
+		local.set $%273
+		local.get $%272
+		local.get $%273
+		call $add
+		local.set $%274
+		local.get $%274
+		local.set $%275
+		local.get $%275
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%281
+		local.get $a
+		local.set $%282
+		local.get $%281
+		local.get $%282
+		i64.add
+		local.set $%283
+		local.get $x.0
+		local.set $%284
+		local.get $y.1
+		local.set $%285
+		local.get $%284
+		local.get $%285
+		i64.mul
+		local.set $%286
+		local.get $%283
+		local.get $%286
+		i64.sub
+		local.set $%287
+		local.get $%287
+		local.set $x.0
+		local.get $x.0
+		local.set $%289
+		local.get $%289
+		local.set $y.1
+		local.get $a
+		local.set $%291
+		local.get $%291
+		local.set $x.0
+		local.get $b
+		local.set $%293
+		local.get $%293
+		local.set $y.1
+		local.get $a
+		local.set $%295
+		local.get $b
+		local.set $%296
+		local.get $%295
+		local.get $%296
+		call $sub
+		local.set $%297
+		local.get $%297
+		local.set $%298
+		local.get $%298
+		local.set $x.0
+		local.get $x.0
+		local.set $%300
+		local.get $%300
+		local.set $y.1
+		local.get $x.0
+		local.set $%302
+		local.get $y.1
+		local.set $%303
+		local.get $%302
+		local.get $%303
+		call $div
+		local.set $%304
+		local.get $%304
+		local.set $%305
+		local.get $%305
+		local.set $x.0
+		local.get $x.0
+		local.set $%307
+		local.get $%307
+		local.set $a
+		local.get $b
+		local.set $%309
+		local.get $%309
+		local.set $b
+		local.get $a
+		local.set $%311
+		local.get $%311
+		local.set $b
+		local.get $b
+		local.set $%313
+		local.get $b
+		local.set $%314
+		local.get $%313
+		local.get $%314
+		call $add
+		local.set $%315
+		local.get $%315
+		local.set $%316
+		local.get $%316
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%322
+		local.get $a
+		local.set $%323
+		local.get $%322
+		local.get $%323
+		i64.add
+		local.set $%324
+		local.get $x.0
+		local.set $%325
+		local.get $y.1
+		local.set $%326
+		local.get $%325
+		local.get $%326
+		i64.mul
+		local.set $%327
+		local.get $%324
+		local.get $%327
+		i64.sub
+		local.set $%328
+		local.get $%328
+		local.set $x.0
+		local.get $x.0
+		local.set $%330
+		local.get $%330
+		local.set $y.1
+		local.get $a
+		local.set $%332
+		local.get $%332
+		local.set $x.0
+		local.get $b
+		local.set $%334
+		local.get $%334
+		local.set $y.1
+		local.get $a
+		local.set $%336
+		local.get $b
+		local.set $%337
+		local.get $%336
+		local.get $%337
+		call $sub
+		local.set $%338
+		local.get $%338
+		local.set $%339
+		local.get $%339
+		local.set $x.0
+		local.get $x.0
+		local.set $%341
+		local.get $%341
+		local.set $y.1
+		local.get $x.0
+		local.set $%343
+		local.get $y.1
+		local.set $%344
+		local.get $%343
+		local.get $%344
+		call $div
+		local.set $%345
+		local.get $%345
+		local.set $%346
+		local.get $%346
+		local.set $x.0
+		local.get $x.0
+		local.set $%348
+		local.get $%348
+		local.set $a
+		local.get $b
+		local.set $%350
+		local.get $%350
+		local.set $b
+		local.get $a
+		local.set $%352
+		local.get $%352
+		local.set $b
+		local.get $b
+		local.set $%354
+		local.get $b
+		local.set $%355
+		local.get $%354
+		local.get $%355
+		call $add
+		local.set $%356
+		local.get $%356
+		local.set $%357
+		local.get $%357
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%363
+		local.get $a
+		local.set $%364
+		local.get $%363
+		local.get $%364
+		i64.add
+		local.set $%365
+		local.get $x.0
+		local.set $%366
+		local.get $y.1
+		local.set $%367
+		local.get $%366
+		local.get $%367
+		i64.mul
+		local.set $%368
+		local.get $%365
+		local.get $%368
+		i64.sub
+		local.set $%369
+		local.get $%369
+		local.set $x.0
+		local.get $x.0
+		local.set $%371
+		local.get $%371
+		local.set $y.1
+		local.get $a
+		local.set $%373
+		local.get $%373
+		local.set $x.0
+		local.get $b
+		local.set $%375
+		local.get $%375
+		local.set $y.1
+		local.get $a
+		local.set $%377
+		local.get $b
+		local.set $%378
+		local.get $%377
+		local.get $%378
+		call $sub
+		local.set $%379
+		local.get $%379
+		local.set $%380
+		local.get $%380
+		local.set $x.0
+		local.get $x.0
+		local.set $%382
+		local.get $%382
+		local.set $y.1
+		local.get $x.0
+		local.set $%384
+		local.get $y.1
+		local.set $%385
+		local.get $%384
+		local.get $%385
+		call $div
+		local.set $%386
+		local.get $%386
+		local.set $%387
+		local.get $%387
+		local.set $x.0
+		local.get $x.0
+		local.set $%389
+		local.get $%389
+		local.set $a
+		local.get $b
+		local.set $%391
+		local.get $%391
+		local.set $b
+		local.get $a
+		local.set $%393
+		local.get $%393
+		local.set $b
+		local.get $b
+		local.set $%395
+		local.get $b
+		local.set $%396
+		local.get $%395
+		local.get $%396
+		call $add
+		local.set $%397
+		local.get $%397
+		local.set $%398
+		local.get $%398
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%404
+		local.get $a
+		local.set $%405
+		local.get $%404
+		local.get $%405
+		i64.add
+		local.set $%406
+		local.get $x.0
+		local.set $%407
+		local.get $y.1
+		local.set $%408
+		local.get $%407
+		local.get $%408
+		i64.mul
+		local.set $%409
+		local.get $%406
+		local.get $%409
+		i64.sub
+		local.set $%410
+		local.get $%410
+		local.set $x.0
+		local.get $x.0
+		local.set $%412
+		local.get $%412
+		local.set $y.1
+		local.get $a
+		local.set $%414
+		local.get $b
+		local.set $%415
+		local.get $%414
+		local.get $%415
+		i64.and
+		local.set $%416
+		local.get $%416
+		return
+		unreachable
+	)
+
+	(func $or (export "or") (param $a i64) (param $b i64) (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%13 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%17 i64)
+		(local $%18 i64)
+		(local $%20 i64)
+		(local $%22 i64)
+		(local $%24 i64)
+		(local $%26 i64)
+		(local $%27 i64)
+		(local $%28 i64)
+		(local $%29 i64)
+		(local $%35 i64)
+		(local $%36 i64)
+		(local $%37 i64)
+		(local $%38 i64)
+		(local $%39 i64)
+		(local $%40 i64)
+		(local $%41 i64)
+		(local $%43 i64)
+		(local $%45 i64)
+		(local $%47 i64)
+		(local $%49 i64)
+		(local $%50 i64)
+		(local $%51 i64)
+		(local $%52 i64)
+		(local $%54 i64)
+		(local $%56 i64)
+		(local $%57 i64)
+		(local $%58 i64)
+		(local $%59 i64)
+		(local $%61 i64)
+		(local $%63 i64)
+		(local $%65 i64)
+		(local $%67 i64)
+		(local $%68 i64)
+		(local $%69 i64)
+		(local $%70 i64)
+		(local $%76 i64)
+		(local $%77 i64)
+		(local $%78 i64)
+		(local $%79 i64)
+		(local $%80 i64)
+		(local $%81 i64)
+		(local $%82 i64)
+		(local $%84 i64)
+		(local $%86 i64)
+		(local $%88 i64)
+		(local $%90 i64)
+		(local $%91 i64)
+		(local $%92 i64)
+		(local $%93 i64)
+		(local $%95 i64)
+		(local $%97 i64)
+		(local $%98 i64)
+		(local $%99 i64)
+		(local $%100 i64)
+		(local $%102 i64)
+		(local $%104 i64)
+		(local $%106 i64)
+		(local $%108 i64)
+		(local $%109 i64)
+		(local $%110 i64)
+		(local $%111 i64)
+		(local $%117 i64)
+		(local $%118 i64)
+		(local $%119 i64)
+		(local $%120 i64)
+		(local $%121 i64)
+		(local $%122 i64)
+		(local $%123 i64)
+		(local $%125 i64)
+		(local $%127 i64)
+		(local $%129 i64)
+		(local $%131 i64)
+		(local $%132 i64)
+		(local $%133 i64)
+		(local $%134 i64)
+		(local $%136 i64)
+		(local $%138 i64)
+		(local $%139 i64)
+		(local $%140 i64)
+		(local $%141 i64)
+		(local $%143 i64)
+		(local $%145 i64)
+		(local $%147 i64)
+		(local $%149 i64)
+		(local $%150 i64)
+		(local $%151 i64)
+		(local $%152 i64)
+		(local $%158 i64)
+		(local $%159 i64)
+		(local $%160 i64)
+		(local $%161 i64)
+		(local $%162 i64)
+		(local $%163 i64)
+		(local $%164 i64)
+		(local $%166 i64)
+		(local $%168 i64)
+		(local $%170 i64)
+		(local $%172 i64)
+		(local $%173 i64)
+		(local $%174 i64)
+		(local $%175 i64)
+		(local $%177 i64)
+		(local $%179 i64)
+		(local $%180 i64)
+		(local $%181 i64)
+		(local $%182 i64)
+		(local $%184 i64)
+		(local $%186 i64)
+		(local $%188 i64)
+		(local $%190 i64)
+		(local $%191 i64)
+		(local $%192 i64)
+		(local $%193 i64)
+		(local $%199 i64)
+		(local $%200 i64)
+		(local $%201 i64)
+		(local $%202 i64)
+		(local $%203 i64)
+		(local $%204 i64)
+		(local $%205 i64)
+		(local $%207 i64)
+		(local $%209 i64)
+		(local $%211 i64)
+		(local $%213 i64)
+		(local $%214 i64)
+		(local $%215 i64)
+		(local $%216 i64)
+		(local $%218 i64)
+		(local $%220 i64)
+		(local $%221 i64)
+		(local $%222 i64)
+		(local $%223 i64)
+		(local $%225 i64)
+		(local $%227 i64)
+		(local $%229 i64)
+		(local $%231 i64)
+		(local $%232 i64)
+		(local $%233 i64)
+		(local $%234 i64)
+		(local $%240 i64)
+		(local $%241 i64)
+		(local $%242 i64)
+		(local $%243 i64)
+		(local $%244 i64)
+		(local $%245 i64)
+		(local $%246 i64)
+		(local $%248 i64)
+		(local $%250 i64)
+		(local $%252 i64)
+		(local $%254 i64)
+		(local $%255 i64)
+		(local $%256 i64)
+		(local $%257 i64)
+		(local $%259 i64)
+		(local $%261 i64)
+		(local $%262 i64)
+		(local $%263 i64)
+		(local $%264 i64)
+		(local $%266 i64)
+		(local $%268 i64)
+		(local $%270 i64)
+		(local $%272 i64)
+		(local $%273 i64)
+		(local $%274 i64)
+		(local $%275 i64)
+		(local $%281 i64)
+		(local $%282 i64)
+		(local $%283 i64)
+		(local $%284 i64)
+		(local $%285 i64)
+		(local $%286 i64)
+		(local $%287 i64)
+		(local $%289 i64)
+		(local $%291 i64)
+		(local $%293 i64)
+		(local $%295 i64)
+		(local $%296 i64)
+		(local $%297 i64)
+		(local $%298 i64)
+		(local $%300 i64)
+		(local $%302 i64)
+		(local $%303 i64)
+		(local $%304 i64)
+		(local $%305 i64)
+		(local $%307 i64)
+		(local $%309 i64)
+		(local $%311 i64)
+		(local $%313 i64)
+		(local $%314 i64)
+		(local $%315 i64)
+		(local $%316 i64)
+		(local $%322 i64)
+		(local $%323 i64)
+		(local $%324 i64)
+		(local $%325 i64)
+		(local $%326 i64)
+		(local $%327 i64)
+		(local $%328 i64)
+		(local $%330 i64)
+		(local $%332 i64)
+		(local $%334 i64)
+		(local $%336 i64)
+		(local $%337 i64)
+		(local $%338 i64)
+		(local $%339 i64)
+		(local $%341 i64)
+		(local $%343 i64)
+		(local $%344 i64)
+		(local $%345 i64)
+		(local $%346 i64)
+		(local $%348 i64)
+		(local $%350 i64)
+		(local $%352 i64)
+		(local $%354 i64)
+		(local $%355 i64)
+		(local $%356 i64)
+		(local $%357 i64)
+		(local $%363 i64)
+		(local $%364 i64)
+		(local $%365 i64)
+		(local $%366 i64)
+		(local $%367 i64)
+		(local $%368 i64)
+		(local $%369 i64)
+		(local $%371 i64)
+		(local $%373 i64)
+		(local $%375 i64)
+		(local $%377 i64)
+		(local $%378 i64)
+		(local $%379 i64)
+		(local $%380 i64)
+		(local $%382 i64)
+		(local $%384 i64)
+		(local $%385 i64)
+		(local $%386 i64)
+		(local $%387 i64)
+		(local $%389 i64)
+		(local $%391 i64)
+		(local $%393 i64)
+		(local $%395 i64)
+		(local $%396 i64)
+		(local $%397 i64)
+		(local $%398 i64)
+		(local $%404 i64)
+		(local $%405 i64)
+		(local $%406 i64)
+		(local $%407 i64)
+		(local $%408 i64)
+		(local $%409 i64)
+		(local $%410 i64)
+		(local $%412 i64)
+		(local $%414 i64)
+		(local $%416 i64)
+		(local $%418 i64)
+		(local $%419 i64)
+		(local $%420 i64)
+		(local $%421 i64)
+		(local $%423 i64)
+		(local $%425 i64)
+		(local $%426 i64)
+		(local $%427 i64)
+		(local $%428 i64)
+		(local $%430 i64)
+		(local $%432 i64)
+		(local $%434 i64)
+		(local $%436 i64)
+		(local $%437 i64)
+		(local $%438 i64)
+		(local $%439 i64)
+		(local $%445 i64)
+		(local $%446 i64)
+		(local $%447 i64)
+		(local $%448 i64)
+		(local $%449 i64)
+		(local $%450 i64)
+		(local $%451 i64)
+		(local $%453 i64)
+		(local $%455 i64)
+		(local $%456 i64)
+		(local $%457 i64)
+		local.get $a
+		local.set $%4
+		local.get $%4
+		local.set $x.0
+		local.get $b
+		local.set $%6
+		local.get $%6
+		local.set $y.1
+		local.get $a
+		local.set $%8
+		local.get $b
+		local.set $%9
+		local.get $%8
+		local.get $%9
+		call $sub
+		local.set $%10
+		local.get $%10
+		local.set $%11
+		local.get $%11
+		local.set $x.0
+		local.get $x.0
+		local.set $%13
+		local.get $%13
+		local.set $y.1
+		local.get $x.0
+		local.set $%15
+		local.get $y.1
+		local.set $%16
+		local.get $%15
+		local.get $%16
+		call $div
+		local.set $%17
+		local.get $%17
+		local.set $%18
+		local.get $%18
+		local.set $x.0
+		local.get $x.0
+		local.set $%20
+		local.get $%20
+		local.set $a
+		local.get $b
+		local.set $%22
+		local.get $%22
+		local.set $b
+		local.get $a
+		local.set $%24
+		local.get $%24
+		local.set $b
+		local.get $b
+		local.set $%26
+		local.get $b
+		local.set $%27
+		local.get $%26
+		local.get $%27
+		call $add
+		local.set $%28
+		local.get $%28
+		local.set $%29
+		local.get $%29
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%35
+		local.get $a
+		local.set $%36
+		local.get $%35
+		local.get $%36
+		i64.add
+		local.set $%37
+		local.get $x.0
+		local.set $%38
+		local.get $y.1
+		local.set $%39
+		local.get $%38
+		local.get $%39
+		i64.mul
+		local.set $%40
+		local.get $%37
+		local.get $%40
+		i64.sub
+		local.set $%41
+		local.get $%41
+		local.set $x.0
+		local.get $x.0
+		local.set $%43
+		local.get $%43
+		local.set $y.1
+		local.get $a
+		local.set $%45
+		local.get $%45
+		local.set $x.0
+		local.get $b
+		local.set $%47
+		local.get $%47
+		local.set $y.1
+		local.get $a
+		local.set $%49
+		local.get $b
+		local.set $%50
+		local.get $%49
+		local.get $%50
+		call $sub
+		local.set $%51
+		local.get $%51
+		local.set $%52
+		local.get $%52
+		local.set $x.0
+		local.get $x.0
+		local.set $%54
+		local.get $%54
+		local.set $y.1
+		local.get $x.0
+		local.set $%56
+		local.get $y.1
+		local.set $%57
+		local.get $%56
+		local.get $%57
+		call $div
+		local.set $%58
+		local.get $%58
+		local.set $%59
+		local.get $%59
+		local.set $x.0
+		local.get $x.0
+		local.set $%61
+		local.get $%61
+		local.set $a
+		local.get $b
+		local.set $%63
+		local.get $%63
+		local.set $b
+		local.get $a
+		local.set $%65
+		local.get $%65
+		local.set $b
+		local.get $b
+		local.set $%67
+		local.get $b
+		local.set $%68
+		local.get $%67
+		local.get $%68
+		call $add
+		local.set $%69
+		local.get $%69
+		local.set $%70
+		local.get $%70
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%76
+		local.get $a
+		local.set $%77
+		local.get $%76
+		local.get $%77
+		i64.add
+		local.set $%78
+		local.get $x.0
+		local.set $%79
+		local.get $y.1
+		local.set $%80
+		local.get $%79
+		local.get $%80
+		i64.mul
+		local.set $%81
+		local.get $%78
+		local.get $%81
+		i64.sub
+		local.set $%82
+		local.get $%82
+		local.set $x.0
+		local.get $x.0
+		local.set $%84
+		local.get $%84
+		local.set $y.1
+		local.get $a
+		local.set $%86
+		local.get $%86
+		local.set $x.0
+		local.get $b
+		local.set $%88
+		local.get $%88
+		local.set $y.1
+		local.get $a
+		local.set $%90
+		local.get $b
+		local.set $%91
+		local.get $%90
+		local.get $%91
+		call $sub
+		local.set $%92
+		local.get $%92
+		local.set $%93
+		local.get $%93
+		local.set $x.0
+		local.get $x.0
+		local.set $%95
+		local.get $%95
+		local.set $y.1
+		local.get $x.0
+		local.set $%97
+		local.get $y.1
+		local.set $%98
+		local.get $%97
+		local.get $%98
+		call $div
+		local.set $%99
+		local.get $%99
+		local.set $%100
+		local.get $%100
+		local.set $x.0
+		local.get $x.0
+		local.set $%102
+		local.get $%102
+		local.set $a
+		local.get $b
+		local.set $%104
+		local.get $%104
+		local.set $b
+		local.get $a
+		local.set $%106
+		local.get $%106
+		local.set $b
+		local.get $b
+		local.set $%108
+		local.get $b
+		local.set $%109
+		local.get $%108
+		local.get $%109
+		call $add
+		local.set $%110
+		local.get $%110
+		local.set $%111
+		local.get $%111
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%117
+		local.get $a
+		local.set $%118
+		local.get $%117
+		local.get $%118
+		i64.add
+		local.set $%119
+		local.get $x.0
+		local.set $%120
+		local.get $y.1
+		local.set $%121
+		local.get $%120
+		local.get $%121
+		i64.mul
+		local.set $%122
+		local.get $%119
+		local.get $%122
+		i64.sub
+		local.set $%123
+		local.get $%123
+		local.set $x.0
+		local.get $x.0
+		local.set $%125
+		local.get $%125
+		local.set $y.1
+		local.get $a
+		local.set $%127
+		local.get $%127
+		local.set $x.0
+		local.get $b
+		local.set $%129
+		local.get $%129
+		local.set $y.1
+		local.get $a
+		local.set $%131
+		local.get $b
+		local.set $%132
+		local.get $%131
+		local.get $%132
+		call $sub
+		local.set $%133
+		local.get $%133
+		local.set $%134
+		local.get $%134
+		local.set $x.0
+		local.get $x.0
+		local.set $%136
+		local.get $%136
+		local.set $y.1
+		local.get $x.0
+		local.set $%138
+		local.get $y.1
+		local.set $%139
+		local.get $%138
+		local.get $%139
+		call $div
+		local.set $%140
+		local.get $%140
+		local.set $%141
+		local.get $%141
+		local.set $x.0
+		local.get $x.0
+		local.set $%143
+		local.get $%143
+		local.set $a
+		local.get $b
+		local.set $%145
+		local.get $%145
+		local.set $b
+		local.get $a
+		local.set $%147
+		local.get $%147
+		local.set $b
+		local.get $b
+		local.set $%149
+		local.get $b
+		local.set $%150
+		local.get $%149
+		local.get $%150
+		call $add
+		local.set $%151
+		local.get $%151
+		local.set $%152
+		local.get $%152
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%158
+		local.get $a
+		local.set $%159
+		local.get $%158
+		local.get $%159
+		i64.add
+		local.set $%160
+		local.get $x.0
+		local.set $%161
+		local.get $y.1
+		local.set $%162
+		local.get $%161
+		local.get $%162
+		i64.mul
+		local.set $%163
+		local.get $%160
+		local.get $%163
+		i64.sub
+		local.set $%164
+		local.get $%164
+		local.set $x.0
+		local.get $x.0
+		local.set $%166
+		local.get $%166
+		local.set $y.1
+		local.get $a
+		local.set $%168
+		local.get $%168
+		local.set $x.0
+		local.get $b
+		local.set $%170
+		local.get $%170
+		local.set $y.1
+		local.get $a
+		local.set $%172
+		local.get $b
+		local.set $%173
+		local.get $%172
+		local.get $%173
+		call $sub
+		local.set $%174
+		local.get $%174
+		local.set $%175
+		local.get $%175
+		local.set $x.0
+		local.get $x.0
+		local.set $%177
+		local.get $%177
+		local.set $y.1
+		local.get $x.0
+		local.set $%179
+		local.get $y.1
+		local.set $%180
+		local.get $%179
+		local.get $%180
+		call $div
+		local.set $%181
+		local.get $%181
+		local.set $%182
+		local.get $%182
+		local.set $x.0
+		local.get $x.0
+		local.set $%184
+		local.get $%184
+		local.set $a
+		local.get $b
+		local.set $%186
+		local.get $%186
+		local.set $b
+		local.get $a
+		local.set $%188
+		local.get $%188
+		local.set $b
+		local.get $b
+		local.set $%190
+		local.get $b
+		local.set $%191
+		local.get $%190
+		local.get $%191
+		call $add
+		local.set $%192
+		local.get $%192
+		local.set $%193
+		local.get $%193
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%199
+		local.get $a
+		local.set $%200
+		local.get $%199
+		local.get $%200
+		i64.add
+		local.set $%201
+		local.get $x.0
+		local.set $%202
+		local.get $y.1
+		local.set $%203
+		local.get $%202
+		local.get $%203
+		i64.mul
+		local.set $%204
+		local.get $%201
+		local.get $%204
+		i64.sub
+		local.set $%205
+		local.get $%205
+		local.set $x.0
+		local.get $x.0
+		local.set $%207
+		local.get $%207
+		local.set $y.1
+		local.get $a
+		local.set $%209
+		local.get $%209
+		local.set $x.0
+		local.get $b
+		local.set $%211
+		local.get $%211
+		local.set $y.1
+		local.get $a
+		local.set $%213
+		local.get $b
+		local.set $%214
+		local.get $%213
+		local.get $%214
+		call $sub
+		local.set $%215
+		local.get $%215
+		local.set $%216
+		local.get $%216
+		local.set $x.0
+		local.get $x.0
+		local.set $%218
+		local.get $%218
+		local.set $y.1
+		local.get $x.0
+		local.set $%220
+		local.get $y.1
+		local.set $%221
+		local.get $%220
+		local.get $%221
+		call $div
+		local.set $%222
+		local.get $%222
+		local.set $%223
+		local.get $%223
+		local.set $x.0
+		local.get $x.0
+		local.set $%225
+		local.get $%225
+		local.set $a
+		local.get $b
+		local.set $%227
+		local.get $%227
+		local.set $b
+		local.get $a
+		local.set $%229
+		local.get $%229
+		local.set $b
+		local.get $b
+		local.set $%231
+		local.get $b
+		local.set $%232
+		local.get $%231
+		local.get $%232
+		call $add
+		local.set $%233
+		local.get $%233
+		local.set $%234
+		local.get $%234
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%240
+		local.get $a
+		local.set $%241
+		local.get $%240
+		local.get $%241
+		i64.add
+		local.set $%242
+		local.get $x.0
+		local.set $%243
+		local.get $y.1
+		local.set $%244
+		local.get $%243
+		local.get $%244
+		i64.mul
+		local.set $%245
+		local.get $%242
+		local.get $%245
+		i64.sub
+		local.set $%246
+		local.get $%246
+		local.set $x.0
+		local.get $x.0
+		local.set $%248
+		local.get $%248
+		local.set $y.1
+		local.get $a
+		local.set $%250
+		local.get $%250
+		local.set $x.0
+		local.get $b
+		local.set $%252
+		local.get $%252
+		local.set $y.1
+		local.get $a
+		local.set $%254
+		local.get $b
+		local.set $%255
+		local.get $%254
+		local.get $%255
+		call $sub
+		local.set $%256
+		local.get $%256
+		local.set $%257
+		local.get $%257
+		local.set $x.0
+		local.get $x.0
+		local.set $%259
+		local.get $%259
+		local.set $y.1
+		local.get $x.0
+		local.set $%261
+		local.get $y.1
+		local.set $%262
+		local.get $%261
+		local.get $%262
+		call $div
+		local.set $%263
+		local.get $%263
+		local.set $%264
+		local.get $%264
+		local.set $x.0
+		local.get $x.0
+		local.set $%266
+		local.get $%266
+		local.set $a
+		local.get $b
+		local.set $%268
+		local.get $%268
+		local.set $b
+		local.get $a
+		local.set $%270
+		local.get $%270
+		local.set $b
+		local.get $b
+		local.set $%272
+		local.get $b
+		local.set $%273
+		local.get $%272
+		local.get $%273
+		call $add
+		local.set $%274
+		local.get $%274
+		local.set $%275
+		local.get $%275
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%281
+		local.get $a
+		local.set $%282
+		local.get $%281
+		local.get $%282
+		i64.add
+		local.set $%283
+		local.get $x.0
+		local.set $%284
+		local.get $y.1
+		local.set $%285
+		local.get $%284
+		local.get $%285
+		i64.mul
+		local.set $%286
+		local.get $%283
+		local.get $%286
+		i64.sub
+		local.set $%287
+		local.get $%287
+		local.set $x.0
+		local.get $x.0
+		local.set $%289
+		local.get $%289
+		local.set $y.1
+		local.get $a
+		local.set $%291
+		local.get $%291
+		local.set $x.0
+		local.get $b
+		local.set $%293
+		local.get $%293
+		local.set $y.1
+		local.get $a
+		local.set $%295
+		local.get $b
+		local.set $%296
+		local.get $%295
+		local.get $%296
+		call $sub
+		local.set $%297
+		local.get $%297
+		local.set $%298
+		local.get $%298
+		local.set $x.0
+		local.get $x.0
+		local.set $%300
+		local.get $%300
+		local.set $y.1
+		local.get $x.0
+		local.set $%302
+		local.get $y.1
+		local.set $%303
+		local.get $%302
+		local.get $%303
+		call $div
+		local.set $%304
+		local.get $%304
+		local.set $%305
+		local.get $%305
+		local.set $x.0
+		local.get $x.0
+		local.set $%307
+		local.get $%307
+		local.set $a
+		local.get $b
+		local.set $%309
+		local.get $%309
+		local.set $b
+		local.get $a
+		local.set $%311
+		local.get $%311
+		local.set $b
+		local.get $b
+		local.set $%313
+		local.get $b
+		local.set $%314
+		local.get $%313
+		local.get $%314
+		call $add
+		local.set $%315
+		local.get $%315
+		local.set $%316
+		local.get $%316
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%322
+		local.get $a
+		local.set $%323
+		local.get $%322
+		local.get $%323
+		i64.add
+		local.set $%324
+		local.get $x.0
+		local.set $%325
+		local.get $y.1
+		local.set $%326
+		local.get $%325
+		local.get $%326
+		i64.mul
+		local.set $%327
+		local.get $%324
+		local.get $%327
+		i64.sub
+		local.set $%328
+		local.get $%328
+		local.set $x.0
+		local.get $x.0
+		local.set $%330
+		local.get $%330
+		local.set $y.1
+		local.get $a
+		local.set $%332
+		local.get $%332
+		local.set $x.0
+		local.get $b
+		local.set $%334
+		local.get $%334
+		local.set $y.1
+		local.get $a
+		local.set $%336
+		local.get $b
+		local.set $%337
+		local.get $%336
+		local.get $%337
+		call $sub
+		local.set $%338
+		local.get $%338
+		local.set $%339
+		local.get $%339
+		local.set $x.0
+		local.get $x.0
+		local.set $%341
+		local.get $%341
+		local.set $y.1
+		local.get $x.0
+		local.set $%343
+		local.get $y.1
+		local.set $%344
+		local.get $%343
+		local.get $%344
+		call $div
+		local.set $%345
+		local.get $%345
+		local.set $%346
+		local.get $%346
+		local.set $x.0
+		local.get $x.0
+		local.set $%348
+		local.get $%348
+		local.set $a
+		local.get $b
+		local.set $%350
+		local.get $%350
+		local.set $b
+		local.get $a
+		local.set $%352
+		local.get $%352
+		local.set $b
+		local.get $b
+		local.set $%354
+		local.get $b
+		local.set $%355
+		local.get $%354
+		local.get $%355
+		call $add
+		local.set $%356
+		local.get $%356
+		local.set $%357
+		local.get $%357
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%363
+		local.get $a
+		local.set $%364
+		local.get $%363
+		local.get $%364
+		i64.add
+		local.set $%365
+		local.get $x.0
+		local.set $%366
+		local.get $y.1
+		local.set $%367
+		local.get $%366
+		local.get $%367
+		i64.mul
+		local.set $%368
+		local.get $%365
+		local.get $%368
+		i64.sub
+		local.set $%369
+		local.get $%369
+		local.set $x.0
+		local.get $x.0
+		local.set $%371
+		local.get $%371
+		local.set $y.1
+		local.get $a
+		local.set $%373
+		local.get $%373
+		local.set $x.0
+		local.get $b
+		local.set $%375
+		local.get $%375
+		local.set $y.1
+		local.get $a
+		local.set $%377
+		local.get $b
+		local.set $%378
+		local.get $%377
+		local.get $%378
+		call $sub
+		local.set $%379
+		local.get $%379
+		local.set $%380
+		local.get $%380
+		local.set $x.0
+		local.get $x.0
+		local.set $%382
+		local.get $%382
+		local.set $y.1
+		local.get $x.0
+		local.set $%384
+		local.get $y.1
+		local.set $%385
+		local.get $%384
+		local.get $%385
+		call $div
+		local.set $%386
+		local.get $%386
+		local.set $%387
+		local.get $%387
+		local.set $x.0
+		local.get $x.0
+		local.set $%389
+		local.get $%389
+		local.set $a
+		local.get $b
+		local.set $%391
+		local.get $%391
+		local.set $b
+		local.get $a
+		local.set $%393
+		local.get $%393
+		local.set $b
+		local.get $b
+		local.set $%395
+		local.get $b
+		local.set $%396
+		local.get $%395
+		local.get $%396
+		call $add
+		local.set $%397
+		local.get $%397
+		local.set $%398
+		local.get $%398
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%404
+		local.get $a
+		local.set $%405
+		local.get $%404
+		local.get $%405
+		i64.add
+		local.set $%406
+		local.get $x.0
+		local.set $%407
+		local.get $y.1
+		local.set $%408
+		local.get $%407
+		local.get $%408
+		i64.mul
+		local.set $%409
+		local.get $%406
+		local.get $%409
+		i64.sub
+		local.set $%410
+		local.get $%410
+		local.set $x.0
+		local.get $x.0
+		local.set $%412
+		local.get $%412
+		local.set $y.1
+		local.get $a
+		local.set $%414
+		local.get $%414
+		local.set $x.0
+		local.get $b
+		local.set $%416
+		local.get $%416
+		local.set $y.1
+		local.get $a
+		local.set $%418
+		local.get $b
+		local.set $%419
+		local.get $%418
+		local.get $%419
+		call $sub
+		local.set $%420
+		local.get $%420
+		local.set $%421
+		local.get $%421
+		local.set $x.0
+		local.get $x.0
+		local.set $%423
+		local.get $%423
+		local.set $y.1
+		local.get $x.0
+		local.set $%425
+		local.get $y.1
+		local.set $%426
+		local.get $%425
+		local.get $%426
+		call $div
+		local.set $%427
+		local.get $%427
+		local.set $%428
+		local.get $%428
+		local.set $x.0
+		local.get $x.0
+		local.set $%430
+		local.get $%430
+		local.set $a
+		local.get $b
+		local.set $%432
+		local.get $%432
+		local.set $b
+		local.get $a
+		local.set $%434
+		local.get $%434
+		local.set $b
+		local.get $b
+		local.set $%436
+		local.get $b
+		local.set $%437
+		local.get $%436
+		local.get $%437
+		call $add
+		local.set $%438
+		local.get $%438
+		local.set $%439
+		local.get $%439
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%445
+		local.get $a
+		local.set $%446
+		local.get $%445
+		local.get $%446
+		i64.add
+		local.set $%447
+		local.get $x.0
+		local.set $%448
+		local.get $y.1
+		local.set $%449
+		local.get $%448
+		local.get $%449
+		i64.mul
+		local.set $%450
+		local.get $%447
+		local.get $%450
+		i64.sub
+		local.set $%451
+		local.get $%451
+		local.set $x.0
+		local.get $x.0
+		local.set $%453
+		local.get $%453
+		local.set $y.1
+		local.get $a
+		local.set $%455
+		local.get $b
+		local.set $%456
+		local.get $%455
+		local.get $%456
+		i64.or
+		local.set $%457
+		local.get $%457
+		return
+		unreachable
+	)
+
+	(func $xor (export "xor") (param $a i64) (param $b i64) (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%13 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%17 i64)
+		(local $%18 i64)
+		(local $%20 i64)
+		(local $%22 i64)
+		(local $%24 i64)
+		(local $%26 i64)
+		(local $%27 i64)
+		(local $%28 i64)
+		(local $%29 i64)
+		(local $%35 i64)
+		(local $%36 i64)
+		(local $%37 i64)
+		(local $%38 i64)
+		(local $%39 i64)
+		(local $%40 i64)
+		(local $%41 i64)
+		(local $%43 i64)
+		(local $%45 i64)
+		(local $%47 i64)
+		(local $%49 i64)
+		(local $%50 i64)
+		(local $%51 i64)
+		(local $%52 i64)
+		(local $%54 i64)
+		(local $%56 i64)
+		(local $%57 i64)
+		(local $%58 i64)
+		(local $%59 i64)
+		(local $%61 i64)
+		(local $%63 i64)
+		(local $%65 i64)
+		(local $%67 i64)
+		(local $%68 i64)
+		(local $%69 i64)
+		(local $%70 i64)
+		(local $%76 i64)
+		(local $%77 i64)
+		(local $%78 i64)
+		(local $%79 i64)
+		(local $%80 i64)
+		(local $%81 i64)
+		(local $%82 i64)
+		(local $%84 i64)
+		(local $%86 i64)
+		(local $%88 i64)
+		(local $%90 i64)
+		(local $%91 i64)
+		(local $%92 i64)
+		(local $%93 i64)
+		(local $%95 i64)
+		(local $%97 i64)
+		(local $%98 i64)
+		(local $%99 i64)
+		(local $%100 i64)
+		(local $%102 i64)
+		(local $%104 i64)
+		(local $%106 i64)
+		(local $%108 i64)
+		(local $%109 i64)
+		(local $%110 i64)
+		(local $%111 i64)
+		(local $%117 i64)
+		(local $%118 i64)
+		(local $%119 i64)
+		(local $%120 i64)
+		(local $%121 i64)
+		(local $%122 i64)
+		(local $%123 i64)
+		(local $%125 i64)
+		(local $%127 i64)
+		(local $%129 i64)
+		(local $%131 i64)
+		(local $%132 i64)
+		(local $%133 i64)
+		(local $%134 i64)
+		(local $%136 i64)
+		(local $%138 i64)
+		(local $%139 i64)
+		(local $%140 i64)
+		(local $%141 i64)
+		(local $%143 i64)
+		(local $%145 i64)
+		(local $%147 i64)
+		(local $%149 i64)
+		(local $%150 i64)
+		(local $%151 i64)
+		(local $%152 i64)
+		(local $%158 i64)
+		(local $%159 i64)
+		(local $%160 i64)
+		(local $%161 i64)
+		(local $%162 i64)
+		(local $%163 i64)
+		(local $%164 i64)
+		(local $%166 i64)
+		(local $%168 i64)
+		(local $%169 i64)
+		(local $%170 i64)
+		local.get $a
+		local.set $%4
+		local.get $%4
+		local.set $x.0
+		local.get $b
+		local.set $%6
+		local.get $%6
+		local.set $y.1
+		local.get $a
+		local.set $%8
+		local.get $b
+		local.set $%9
+		local.get $%8
+		local.get $%9
+		call $sub
+		local.set $%10
+		local.get $%10
+		local.set $%11
+		local.get $%11
+		local.set $x.0
+		local.get $x.0
+		local.set $%13
+		local.get $%13
+		local.set $y.1
+		local.get $x.0
+		local.set $%15
+		local.get $y.1
+		local.set $%16
+		local.get $%15
+		local.get $%16
+		call $div
+		local.set $%17
+		local.get $%17
+		local.set $%18
+		local.get $%18
+		local.set $x.0
+		local.get $x.0
+		local.set $%20
+		local.get $%20
+		local.set $a
+		local.get $b
+		local.set $%22
+		local.get $%22
+		local.set $b
+		local.get $a
+		local.set $%24
+		local.get $%24
+		local.set $b
+		local.get $b
+		local.set $%26
+		local.get $b
+		local.set $%27
+		local.get $%26
+		local.get $%27
+		call $add
+		local.set $%28
+		local.get $%28
+		local.set $%29
+		local.get $%29
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%35
+		local.get $a
+		local.set $%36
+		local.get $%35
+		local.get $%36
+		i64.add
+		local.set $%37
+		local.get $x.0
+		local.set $%38
+		local.get $y.1
+		local.set $%39
+		local.get $%38
+		local.get $%39
+		i64.mul
+		local.set $%40
+		local.get $%37
+		local.get $%40
+		i64.sub
+		local.set $%41
+		local.get $%41
+		local.set $x.0
+		local.get $x.0
+		local.set $%43
+		local.get $%43
+		local.set $y.1
+		local.get $a
+		local.set $%45
+		local.get $%45
+		local.set $x.0
+		local.get $b
+		local.set $%47
+		local.get $%47
+		local.set $y.1
+		local.get $a
+		local.set $%49
+		local.get $b
+		local.set $%50
+		local.get $%49
+		local.get $%50
+		call $sub
+		local.set $%51
+		local.get $%51
+		local.set $%52
+		local.get $%52
+		local.set $x.0
+		local.get $x.0
+		local.set $%54
+		local.get $%54
+		local.set $y.1
+		local.get $x.0
+		local.set $%56
+		local.get $y.1
+		local.set $%57
+		local.get $%56
+		local.get $%57
+		call $div
+		local.set $%58
+		local.get $%58
+		local.set $%59
+		local.get $%59
+		local.set $x.0
+		local.get $x.0
+		local.set $%61
+		local.get $%61
+		local.set $a
+		local.get $b
+		local.set $%63
+		local.get $%63
+		local.set $b
+		local.get $a
+		local.set $%65
+		local.get $%65
+		local.set $b
+		local.get $b
+		local.set $%67
+		local.get $b
+		local.set $%68
+		local.get $%67
+		local.get $%68
+		call $add
+		local.set $%69
+		local.get $%69
+		local.set $%70
+		local.get $%70
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%76
+		local.get $a
+		local.set $%77
+		local.get $%76
+		local.get $%77
+		i64.add
+		local.set $%78
+		local.get $x.0
+		local.set $%79
+		local.get $y.1
+		local.set $%80
+		local.get $%79
+		local.get $%80
+		i64.mul
+		local.set $%81
+		local.get $%78
+		local.get $%81
+		i64.sub
+		local.set $%82
+		local.get $%82
+		local.set $x.0
+		local.get $x.0
+		local.set $%84
+		local.get $%84
+		local.set $y.1
+		local.get $a
+		local.set $%86
+		local.get $%86
+		local.set $x.0
+		local.get $b
+		local.set $%88
+		local.get $%88
+		local.set $y.1
+		local.get $a
+		local.set $%90
+		local.get $b
+		local.set $%91
+		local.get $%90
+		local.get $%91
+		call $sub
+		local.set $%92
+		local.get $%92
+		local.set $%93
+		local.get $%93
+		local.set $x.0
+		local.get $x.0
+		local.set $%95
+		local.get $%95
+		local.set $y.1
+		local.get $x.0
+		local.set $%97
+		local.get $y.1
+		local.set $%98
+		local.get $%97
+		local.get $%98
+		call $div
+		local.set $%99
+		local.get $%99
+		local.set $%100
+		local.get $%100
+		local.set $x.0
+		local.get $x.0
+		local.set $%102
+		local.get $%102
+		local.set $a
+		local.get $b
+		local.set $%104
+		local.get $%104
+		local.set $b
+		local.get $a
+		local.set $%106
+		local.get $%106
+		local.set $b
+		local.get $b
+		local.set $%108
+		local.get $b
+		local.set $%109
+		local.get $%108
+		local.get $%109
+		call $add
+		local.set $%110
+		local.get $%110
+		local.set $%111
+		local.get $%111
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%117
+		local.get $a
+		local.set $%118
+		local.get $%117
+		local.get $%118
+		i64.add
+		local.set $%119
+		local.get $x.0
+		local.set $%120
+		local.get $y.1
+		local.set $%121
+		local.get $%120
+		local.get $%121
+		i64.mul
+		local.set $%122
+		local.get $%119
+		local.get $%122
+		i64.sub
+		local.set $%123
+		local.get $%123
+		local.set $x.0
+		local.get $x.0
+		local.set $%125
+		local.get $%125
+		local.set $y.1
+		local.get $a
+		local.set $%127
+		local.get $%127
+		local.set $x.0
+		local.get $b
+		local.set $%129
+		local.get $%129
+		local.set $y.1
+		local.get $a
+		local.set $%131
+		local.get $b
+		local.set $%132
+		local.get $%131
+		local.get $%132
+		call $sub
+		local.set $%133
+		local.get $%133
+		local.set $%134
+		local.get $%134
+		local.set $x.0
+		local.get $x.0
+		local.set $%136
+		local.get $%136
+		local.set $y.1
+		local.get $x.0
+		local.set $%138
+		local.get $y.1
+		local.set $%139
+		local.get $%138
+		local.get $%139
+		call $div
+		local.set $%140
+		local.get $%140
+		local.set $%141
+		local.get $%141
+		local.set $x.0
+		local.get $x.0
+		local.set $%143
+		local.get $%143
+		local.set $a
+		local.get $b
+		local.set $%145
+		local.get $%145
+		local.set $b
+		local.get $a
+		local.set $%147
+		local.get $%147
+		local.set $b
+		local.get $b
+		local.set $%149
+		local.get $b
+		local.set $%150
+		local.get $%149
+		local.get $%150
+		call $add
+		local.set $%151
+		local.get $%151
+		local.set $%152
+		local.get $%152
+		local.set $x.0
+		i64.const 0
+		local.set $x.0
+		i64.const 1
+		local.set $x.0
+		local.get $b
+		local.set $%158
+		local.get $a
+		local.set $%159
+		local.get $%158
+		local.get $%159
+		i64.add
+		local.set $%160
+		local.get $x.0
+		local.set $%161
+		local.get $y.1
+		local.set $%162
+		local.get $%161
+		local.get $%162
+		i64.mul
+		local.set $%163
+		local.get $%160
+		local.get $%163
+		i64.sub
+		local.set $%164
+		local.get $%164
+		local.set $x.0
+		local.get $x.0
+		local.set $%166
+		local.get $%166
+		local.set $y.1
+		local.get $a
+		local.set $%168
+		local.get $b
+		local.set $%169
+		local.get $%168
+		local.get $%169
+		i64.xor
+		local.set $%170
+		local.get $%170
+		return
+		unreachable
+	)
+
+	(func $foo (export "foo") (result i64)
+		(local $a.0 i64)
+		(local $b.1 i64)
+		(local $x.2 i64)
+		(local $y.3 i64)
+		(local $%8 i64)
+		(local $%10 i64)
+		(local $%12 i64)
+		(local $%13 i64)
+		(local $%14 i64)
+		(local $%15 i64)
+		(local $%17 i64)
+		(local $%19 i64)
+		(local $%20 i64)
+		(local $%21 i64)
+		(local $%22 i64)
+		(local $%24 i64)
+		(local $%26 i64)
+		(local $%28 i64)
+		(local $%30 i64)
+		(local $%31 i64)
+		(local $%32 i64)
+		(local $%33 i64)
+		(local $%39 i64)
+		(local $%40 i64)
+		(local $%41 i64)
+		(local $%42 i64)
+		(local $%43 i64)
+		(local $%44 i64)
+		(local $%45 i64)
+		(local $%47 i64)
+		(local $%49 i64)
+		(local $%51 i64)
+		(local $%53 i64)
+		(local $%54 i64)
+		(local $%55 i64)
+		(local $%56 i64)
+		(local $%58 i64)
+		(local $%60 i64)
+		(local $%61 i64)
+		(local $%62 i64)
+		(local $%63 i64)
+		(local $%65 i64)
+		(local $%67 i64)
+		(local $%69 i64)
+		(local $%71 i64)
+		(local $%72 i64)
+		(local $%73 i64)
+		(local $%74 i64)
+		(local $%80 i64)
+		(local $%81 i64)
+		(local $%82 i64)
+		(local $%83 i64)
+		(local $%84 i64)
+		(local $%85 i64)
+		(local $%86 i64)
+		(local $%88 i64)
+		(local $%90 i64)
+		(local $%92 i64)
+		(local $%94 i64)
+		(local $%95 i64)
+		(local $%96 i64)
+		(local $%97 i64)
+		(local $%99 i64)
+		(local $%101 i64)
+		(local $%102 i64)
+		(local $%103 i64)
+		(local $%104 i64)
+		(local $%106 i64)
+		(local $%108 i64)
+		(local $%110 i64)
+		(local $%112 i64)
+		(local $%113 i64)
+		(local $%114 i64)
+		(local $%115 i64)
+		(local $%121 i64)
+		(local $%122 i64)
+		(local $%123 i64)
+		(local $%124 i64)
+		(local $%125 i64)
+		(local $%126 i64)
+		(local $%127 i64)
+		(local $%129 i64)
+		(local $%131 i64)
+		(local $%133 i64)
+		(local $%135 i64)
+		(local $%136 i64)
+		(local $%137 i64)
+		(local $%138 i64)
+		(local $%140 i64)
+		(local $%142 i64)
+		(local $%143 i64)
+		(local $%144 i64)
+		(local $%145 i64)
+		(local $%147 i64)
+		(local $%149 i64)
+		(local $%151 i64)
+		(local $%153 i64)
+		(local $%154 i64)
+		(local $%155 i64)
+		(local $%156 i64)
+		(local $%162 i64)
+		(local $%163 i64)
+		(local $%164 i64)
+		(local $%165 i64)
+		(local $%166 i64)
+		(local $%167 i64)
+		(local $%168 i64)
+		(local $%170 i64)
+		(local $%172 i64)
+		(local $%174 i64)
+		(local $%176 i64)
+		(local $%177 i64)
+		(local $%178 i64)
+		(local $%179 i64)
+		(local $%181 i64)
+		(local $%183 i64)
+		(local $%184 i64)
+		(local $%185 i64)
+		(local $%186 i64)
+		(local $%188 i64)
+		(local $%190 i64)
+		(local $%192 i64)
+		(local $%194 i64)
+		(local $%195 i64)
+		(local $%196 i64)
+		(local $%197 i64)
+		(local $%203 i64)
+		(local $%204 i64)
+		(local $%205 i64)
+		(local $%206 i64)
+		(local $%207 i64)
+		(local $%208 i64)
+		(local $%209 i64)
+		(local $%211 i64)
+		i64.const 50
+		local.set $x.2
+		i64.const -2
+		local.set $y.3
+		local.get $a.0
+		local.set $%8
+		local.get $%8
+		local.set $x.2
+		local.get $b.1
+		local.set $%10
+		local.get $%10
+		local.set $y.3
+		local.get $a.0
+		local.set $%12
+		local.get $b.1
+		local.set $%13
+		local.get $%12
+		local.get $%13
+		call $sub
+		local.set $%14
+		local.get $%14
+		local.set $%15
+		local.get $%15
+		local.set $x.2
+		local.get $x.2
+		local.set $%17
+		local.get $%17
+		local.set $y.3
+		local.get $x.2
+		local.set $%19
+		local.get $y.3
+		local.set $%20
+		local.get $%19
+		local.get $%20
+		call $div
+		local.set $%21
+		local.get $%21
+		local.set $%22
+		local.get $%22
+		local.set $x.2
+		local.get $x.2
+		local.set $%24
+		local.get $%24
+		local.set $a.0
+		local.get $b.1
+		local.set $%26
+		local.get $%26
+		local.set $b.1
+		local.get $a.0
+		local.set $%28
+		local.get $%28
+		local.set $b.1
+		local.get $b.1
+		local.set $%30
+		local.get $b.1
+		local.set $%31
+		local.get $%30
+		local.get $%31
+		call $add
+		local.set $%32
+		local.get $%32
+		local.set $%33
+		local.get $%33
+		local.set $x.2
+		i64.const 0
+		local.set $x.2
+		i64.const 1
+		local.set $x.2
+		local.get $b.1
+		local.set $%39
+		local.get $a.0
+		local.set $%40
+		local.get $%39
+		local.get $%40
+		i64.add
+		local.set $%41
+		local.get $x.2
+		local.set $%42
+		local.get $y.3
+		local.set $%43
+		local.get $%42
+		local.get $%43
+		i64.mul
+		local.set $%44
+		local.get $%41
+		local.get $%44
+		i64.sub
+		local.set $%45
+		local.get $%45
+		local.set $x.2
+		local.get $x.2
+		local.set $%47
+		local.get $%47
+		local.set $y.3
+		local.get $a.0
+		local.set $%49
+		local.get $%49
+		local.set $x.2
+		local.get $b.1
+		local.set $%51
+		local.get $%51
+		local.set $y.3
+		local.get $a.0
+		local.set $%53
+		local.get $b.1
+		local.set $%54
+		local.get $%53
+		local.get $%54
+		call $sub
+		local.set $%55
+		local.get $%55
+		local.set $%56
+		local.get $%56
+		local.set $x.2
+		local.get $x.2
+		local.set $%58
+		local.get $%58
+		local.set $y.3
+		local.get $x.2
+		local.set $%60
+		local.get $y.3
+		local.set $%61
+		local.get $%60
+		local.get $%61
+		call $div
+		local.set $%62
+		local.get $%62
+		local.set $%63
+		local.get $%63
+		local.set $x.2
+		local.get $x.2
+		local.set $%65
+		local.get $%65
+		local.set $a.0
+		local.get $b.1
+		local.set $%67
+		local.get $%67
+		local.set $b.1
+		local.get $a.0
+		local.set $%69
+		local.get $%69
+		local.set $b.1
+		local.get $b.1
+		local.set $%71
+		local.get $b.1
+		local.set $%72
+		local.get $%71
+		local.get $%72
+		call $add
+		local.set $%73
+		local.get $%73
+		local.set $%74
+		local.get $%74
+		local.set $x.2
+		i64.const 0
+		local.set $x.2
+		i64.const 1
+		local.set $x.2
+		local.get $b.1
+		local.set $%80
+		local.get $a.0
+		local.set $%81
+		local.get $%80
+		local.get $%81
+		i64.add
+		local.set $%82
+		local.get $x.2
+		local.set $%83
+		local.get $y.3
+		local.set $%84
+		local.get $%83
+		local.get $%84
+		i64.mul
+		local.set $%85
+		local.get $%82
+		local.get $%85
+		i64.sub
+		local.set $%86
+		local.get $%86
+		local.set $x.2
+		local.get $x.2
+		local.set $%88
+		local.get $%88
+		local.set $y.3
+		local.get $a.0
+		local.set $%90
+		local.get $%90
+		local.set $x.2
+		local.get $b.1
+		local.set $%92
+		local.get $%92
+		local.set $y.3
+		local.get $a.0
+		local.set $%94
+		local.get $b.1
+		local.set $%95
+		local.get $%94
+		local.get $%95
+		call $sub
+		local.set $%96
+		local.get $%96
+		local.set $%97
+		local.get $%97
+		local.set $x.2
+		local.get $x.2
+		local.set $%99
+		local.get $%99
+		local.set $y.3
+		local.get $x.2
+		local.set $%101
+		local.get $y.3
+		local.set $%102
+		local.get $%101
+		local.get $%102
+		call $div
+		local.set $%103
+		local.get $%103
+		local.set $%104
+		local.get $%104
+		local.set $x.2
+		local.get $x.2
+		local.set $%106
+		local.get $%106
+		local.set $a.0
+		local.get $b.1
+		local.set $%108
+		local.get $%108
+		local.set $b.1
+		local.get $a.0
+		local.set $%110
+		local.get $%110
+		local.set $b.1
+		local.get $b.1
+		local.set $%112
+		local.get $b.1
+		local.set $%113
+		local.get $%112
+		local.get $%113
+		call $add
+		local.set $%114
+		local.get $%114
+		local.set $%115
+		local.get $%115
+		local.set $x.2
+		i64.const 0
+		local.set $x.2
+		i64.const 1
+		local.set $x.2
+		local.get $b.1
+		local.set $%121
+		local.get $a.0
+		local.set $%122
+		local.get $%121
+		local.get $%122
+		i64.add
+		local.set $%123
+		local.get $x.2
+		local.set $%124
+		local.get $y.3
+		local.set $%125
+		local.get $%124
+		local.get $%125
+		i64.mul
+		local.set $%126
+		local.get $%123
+		local.get $%126
+		i64.sub
+		local.set $%127
+		local.get $%127
+		local.set $x.2
+		local.get $x.2
+		local.set $%129
+		local.get $%129
+		local.set $y.3
+		local.get $a.0
+		local.set $%131
+		local.get $%131
+		local.set $x.2
+		local.get $b.1
+		local.set $%133
+		local.get $%133
+		local.set $y.3
+		local.get $a.0
+		local.set $%135
+		local.get $b.1
+		local.set $%136
+		local.get $%135
+		local.get $%136
+		call $sub
+		local.set $%137
+		local.get $%137
+		local.set $%138
+		local.get $%138
+		local.set $x.2
+		local.get $x.2
+		local.set $%140
+		local.get $%140
+		local.set $y.3
+		local.get $x.2
+		local.set $%142
+		local.get $y.3
+		local.set $%143
+		local.get $%142
+		local.get $%143
+		call $div
+		local.set $%144
+		local.get $%144
+		local.set $%145
+		local.get $%145
+		local.set $x.2
+		local.get $x.2
+		local.set $%147
+		local.get $%147
+		local.set $a.0
+		local.get $b.1
+		local.set $%149
+		local.get $%149
+		local.set $b.1
+		local.get $a.0
+		local.set $%151
+		local.get $%151
+		local.set $b.1
+		local.get $b.1
+		local.set $%153
+		local.get $b.1
+		local.set $%154
+		local.get $%153
+		local.get $%154
+		call $add
+		local.set $%155
+		local.get $%155
+		local.set $%156
+		local.get $%156
+		local.set $x.2
+		i64.const 0
+		local.set $x.2
+		i64.const 1
+		local.set $x.2
+		local.get $b.1
+		local.set $%162
+		local.get $a.0
+		local.set $%163
+		local.get $%162
+		local.get $%163
+		i64.add
+		local.set $%164
+		local.get $x.2
+		local.set $%165
+		local.get $y.3
+		local.set $%166
+		local.get $%165
+		local.get $%166
+		i64.mul
+		local.set $%167
+		local.get $%164
+		local.get $%167
+		i64.sub
+		local.set $%168
+		local.get $%168
+		local.set $x.2
+		local.get $x.2
+		local.set $%170
+		local.get $%170
+		local.set $y.3
+		local.get $a.0
+		local.set $%172
+		local.get $%172
+		local.set $x.2
+		local.get $b.1
+		local.set $%174
+		local.get $%174
+		local.set $y.3
+		local.get $a.0
+		local.set $%176
+		local.get $b.1
+		local.set $%177
+		local.get $%176
+		local.get $%177
+		call $sub
+		local.set $%178
+		local.get $%178
+		local.set $%179
+		local.get $%179
+		local.set $x.2
+		local.get $x.2
+		local.set $%181
+		local.get $%181
+		local.set $y.3
+		local.get $x.2
+		local.set $%183
+		local.get $y.3
+		local.set $%184
+		local.get $%183
+		local.get $%184
+		call $div
+		local.set $%185
+		local.get $%185
+		local.set $%186
+		local.get $%186
+		local.set $x.2
+		local.get $x.2
+		local.set $%188
+		local.get $%188
+		local.set $a.0
+		local.get $b.1
+		local.set $%190
+		local.get $%190
+		local.set $b.1
+		local.get $a.0
+		local.set $%192
+		local.get $%192
+		local.set $b.1
+		local.get $b.1
+		local.set $%194
+		local.get $b.1
+		local.set $%195
+		local.get $%194
+		local.get $%195
+		call $add
+		local.set $%196
+		local.get $%196
+		local.set $%197
+		local.get $%197
+		local.set $x.2
+		i64.const 0
+		local.set $x.2
+		i64.const 1
+		local.set $x.2
+		local.get $b.1
+		local.set $%203
+		local.get $a.0
+		local.set $%204
+		local.get $%203
+		local.get $%204
+		i64.add
+		local.set $%205
+		local.get $x.2
+		local.set $%206
+		local.get $y.3
+		local.set $%207
+		local.get $%206
+		local.get $%207
+		i64.mul
+		local.set $%208
+		local.get $%205
+		local.get $%208
+		i64.sub
+		local.set $%209
+		local.get $%209
+		local.set $x.2
+		local.get $x.2
+		local.set $%211
+		local.get $%211
+		local.set $y.3
+		i32.const 15
+		i32.const 48
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $bar (export "bar") (result i64)
+		(local $a.0 i64)
+		(local $b.1 i64)
+		(local $x.2 i64)
+		(local $y.3 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%13 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%17 i64)
+		(local $%18 i64)
+		(local $%20 i64)
+		(local $%22 i64)
+		(local $%24 i64)
+		(local $%26 i64)
+		(local $%27 i64)
+		(local $%28 i64)
+		(local $%29 i64)
+		(local $%35 i64)
+		(local $%36 i64)
+		(local $%37 i64)
+		(local $%38 i64)
+		(local $%39 i64)
+		(local $%40 i64)
+		(local $%41 i64)
+		(local $%43 i64)
+		(local $%45 i64)
+		(local $%47 i64)
+		(local $%49 i64)
+		(local $%50 i64)
+		(local $%51 i64)
+		(local $%52 i64)
+		(local $%54 i64)
+		(local $%56 i64)
+		(local $%57 i64)
+		(local $%58 i64)
+		(local $%59 i64)
+		(local $%61 i64)
+		(local $%63 i64)
+		(local $%65 i64)
+		(local $%67 i64)
+		(local $%68 i64)
+		(local $%69 i64)
+		(local $%70 i64)
+		(local $%76 i64)
+		(local $%77 i64)
+		(local $%78 i64)
+		(local $%79 i64)
+		(local $%80 i64)
+		(local $%81 i64)
+		(local $%82 i64)
+		(local $%84 i64)
+		(local $%86 i64)
+		(local $%88 i64)
+		(local $%90 i64)
+		(local $%91 i64)
+		(local $%92 i64)
+		(local $%93 i64)
+		(local $%95 i64)
+		(local $%97 i64)
+		(local $%98 i64)
+		(local $%99 i64)
+		(local $%100 i64)
+		(local $%102 i64)
+		(local $%104 i64)
+		(local $%106 i64)
+		(local $%108 i64)
+		(local $%109 i64)
+		(local $%110 i64)
+		(local $%111 i64)
+		(local $%117 i64)
+		(local $%118 i64)
+		(local $%119 i64)
+		(local $%120 i64)
+		(local $%121 i64)
+		(local $%122 i64)
+		(local $%123 i64)
+		(local $%125 i64)
+		(local $%127 i64)
+		(local $%129 i64)
+		(local $%131 i64)
+		(local $%132 i64)
+		(local $%133 i64)
+		(local $%134 i64)
+		(local $%136 i64)
+		(local $%138 i64)
+		(local $%139 i64)
+		(local $%140 i64)
+		(local $%141 i64)
+		(local $%143 i64)
+		(local $%145 i64)
+		(local $%147 i64)
+		(local $%149 i64)
+		(local $%150 i64)
+		(local $%151 i64)
+		(local $%152 i64)
+		(local $%158 i64)
+		(local $%159 i64)
+		(local $%160 i64)
+		(local $%161 i64)
+		(local $%162 i64)
+		(local $%163 i64)
+		(local $%164 i64)
+		(local $%166 i64)
+		(local $%168 i64)
+		(local $%170 i64)
+		(local $%172 i64)
+		(local $%173 i64)
+		(local $%174 i64)
+		(local $%175 i64)
+		(local $%177 i64)
+		(local $%179 i64)
+		(local $%180 i64)
+		(local $%181 i64)
+		(local $%182 i64)
+		(local $%184 i64)
+		(local $%186 i64)
+		(local $%188 i64)
+		(local $%190 i64)
+		(local $%191 i64)
+		(local $%192 i64)
+		(local $%193 i64)
+		(local $%199 i64)
+		(local $%200 i64)
+		(local $%201 i64)
+		(local $%202 i64)
+		(local $%203 i64)
+		(local $%204 i64)
+		(local $%205 i64)
+		(local $%207 i64)
+		(local $%209 i64)
+		(local $%211 i64)
+		(local $%213 i64)
+		(local $%214 i64)
+		(local $%215 i64)
+		(local $%216 i64)
+		(local $%218 i64)
+		(local $%220 i64)
+		(local $%221 i64)
+		(local $%222 i64)
+		(local $%223 i64)
+		(local $%225 i64)
+		(local $%227 i64)
+		(local $%229 i64)
+		(local $%231 i64)
+		(local $%232 i64)
+		(local $%233 i64)
+		(local $%234 i64)
+		(local $%240 i64)
+		(local $%241 i64)
+		(local $%242 i64)
+		(local $%243 i64)
+		(local $%244 i64)
+		(local $%245 i64)
+		(local $%246 i64)
+		(local $%248 i64)
+		local.get $a.0
+		local.set $%4
+		local.get $%4
+		local.set $x.2
+		local.get $b.1
+		local.set $%6
+		local.get $%6
+		local.set $y.3
+		local.get $a.0
+		local.set $%8
+		local.get $b.1
+		local.set $%9
+		local.get $%8
+		local.get $%9
+		call $sub
+		local.set $%10
+		local.get $%10
+		local.set $%11
+		local.get $%11
+		local.set $x.2
+		local.get $x.2
+		local.set $%13
+		local.get $%13
+		local.set $y.3
+		local.get $x.2
+		local.set $%15
+		local.get $y.3
+		local.set $%16
+		local.get $%15
+		local.get $%16
+		call $div
+		local.set $%17
+		local.get $%17
+		local.set $%18
+		local.get $%18
+		local.set $x.2
+		local.get $x.2
+		local.set $%20
+		local.get $%20
+		local.set $a.0
+		local.get $b.1
+		local.set $%22
+		local.get $%22
+		local.set $b.1
+		local.get $a.0
+		local.set $%24
+		local.get $%24
+		local.set $b.1
+		local.get $b.1
+		local.set $%26
+		local.get $b.1
+		local.set $%27
+		local.get $%26
+		local.get $%27
+		call $add
+		local.set $%28
+		local.get $%28
+		local.set $%29
+		local.get $%29
+		local.set $x.2
+		i64.const 0
+		local.set $x.2
+		i64.const 1
+		local.set $x.2
+		local.get $b.1
+		local.set $%35
+		local.get $a.0
+		local.set $%36
+		local.get $%35
+		local.get $%36
+		i64.add
+		local.set $%37
+		local.get $x.2
+		local.set $%38
+		local.get $y.3
+		local.set $%39
+		local.get $%38
+		local.get $%39
+		i64.mul
+		local.set $%40
+		local.get $%37
+		local.get $%40
+		i64.sub
+		local.set $%41
+		local.get $%41
+		local.set $x.2
+		local.get $x.2
+		local.set $%43
+		local.get $%43
+		local.set $y.3
+		local.get $a.0
+		local.set $%45
+		local.get $%45
+		local.set $x.2
+		local.get $b.1
+		local.set $%47
+		local.get $%47
+		local.set $y.3
+		local.get $a.0
+		local.set $%49
+		local.get $b.1
+		local.set $%50
+		local.get $%49
+		local.get $%50
+		call $sub
+		local.set $%51
+		local.get $%51
+		local.set $%52
+		local.get $%52
+		local.set $x.2
+		local.get $x.2
+		local.set $%54
+		local.get $%54
+		local.set $y.3
+		local.get $x.2
+		local.set $%56
+		local.get $y.3
+		local.set $%57
+		local.get $%56
+		local.get $%57
+		call $div
+		local.set $%58
+		local.get $%58
+		local.set $%59
+		local.get $%59
+		local.set $x.2
+		local.get $x.2
+		local.set $%61
+		local.get $%61
+		local.set $a.0
+		local.get $b.1
+		local.set $%63
+		local.get $%63
+		local.set $b.1
+		local.get $a.0
+		local.set $%65
+		local.get $%65
+		local.set $b.1
+		local.get $b.1
+		local.set $%67
+		local.get $b.1
+		local.set $%68
+		local.get $%67
+		local.get $%68
+		call $add
+		local.set $%69
+		local.get $%69
+		local.set $%70
+		local.get $%70
+		local.set $x.2
+		i64.const 0
+		local.set $x.2
+		i64.const 1
+		local.set $x.2
+		local.get $b.1
+		local.set $%76
+		local.get $a.0
+		local.set $%77
+		local.get $%76
+		local.get $%77
+		i64.add
+		local.set $%78
+		local.get $x.2
+		local.set $%79
+		local.get $y.3
+		local.set $%80
+		local.get $%79
+		local.get $%80
+		i64.mul
+		local.set $%81
+		local.get $%78
+		local.get $%81
+		i64.sub
+		local.set $%82
+		local.get $%82
+		local.set $x.2
+		local.get $x.2
+		local.set $%84
+		local.get $%84
+		local.set $y.3
+		local.get $a.0
+		local.set $%86
+		local.get $%86
+		local.set $x.2
+		local.get $b.1
+		local.set $%88
+		local.get $%88
+		local.set $y.3
+		local.get $a.0
+		local.set $%90
+		local.get $b.1
+		local.set $%91
+		local.get $%90
+		local.get $%91
+		call $sub
+		local.set $%92
+		local.get $%92
+		local.set $%93
+		local.get $%93
+		local.set $x.2
+		local.get $x.2
+		local.set $%95
+		local.get $%95
+		local.set $y.3
+		local.get $x.2
+		local.set $%97
+		local.get $y.3
+		local.set $%98
+		local.get $%97
+		local.get $%98
+		call $div
+		local.set $%99
+		local.get $%99
+		local.set $%100
+		local.get $%100
+		local.set $x.2
+		local.get $x.2
+		local.set $%102
+		local.get $%102
+		local.set $a.0
+		local.get $b.1
+		local.set $%104
+		local.get $%104
+		local.set $b.1
+		local.get $a.0
+		local.set $%106
+		local.get $%106
+		local.set $b.1
+		local.get $b.1
+		local.set $%108
+		local.get $b.1
+		local.set $%109
+		local.get $%108
+		local.get $%109
+		call $add
+		local.set $%110
+		local.get $%110
+		local.set $%111
+		local.get $%111
+		local.set $x.2
+		i64.const 0
+		local.set $x.2
+		i64.const 1
+		local.set $x.2
+		local.get $b.1
+		local.set $%117
+		local.get $a.0
+		local.set $%118
+		local.get $%117
+		local.get $%118
+		i64.add
+		local.set $%119
+		local.get $x.2
+		local.set $%120
+		local.get $y.3
+		local.set $%121
+		local.get $%120
+		local.get $%121
+		i64.mul
+		local.set $%122
+		local.get $%119
+		local.get $%122
+		i64.sub
+		local.set $%123
+		local.get $%123
+		local.set $x.2
+		local.get $x.2
+		local.set $%125
+		local.get $%125
+		local.set $y.3
+		local.get $a.0
+		local.set $%127
+		local.get $%127
+		local.set $x.2
+		local.get $b.1
+		local.set $%129
+		local.get $%129
+		local.set $y.3
+		local.get $a.0
+		local.set $%131
+		local.get $b.1
+		local.set $%132
+		local.get $%131
+		local.get $%132
+		call $sub
+		local.set $%133
+		local.get $%133
+		local.set $%134
+		local.get $%134
+		local.set $x.2
+		local.get $x.2
+		local.set $%136
+		local.get $%136
+		local.set $y.3
+		local.get $x.2
+		local.set $%138
+		local.get $y.3
+		local.set $%139
+		local.get $%138
+		local.get $%139
+		call $div
+		local.set $%140
+		local.get $%140
+		local.set $%141
+		local.get $%141
+		local.set $x.2
+		local.get $x.2
+		local.set $%143
+		local.get $%143
+		local.set $a.0
+		local.get $b.1
+		local.set $%145
+		local.get $%145
+		local.set $b.1
+		local.get $a.0
+		local.set $%147
+		local.get $%147
+		local.set $b.1
+		local.get $b.1
+		local.set $%149
+		local.get $b.1
+		local.set $%150
+		local.get $%149
+		local.get $%150
+		call $add
+		local.set $%151
+		local.get $%151
+		local.set $%152
+		local.get $%152
+		local.set $x.2
+		i64.const 0
+		local.set $x.2
+		i64.const 1
+		local.set $x.2
+		local.get $b.1
+		local.set $%158
+		local.get $a.0
+		local.set $%159
+		local.get $%158
+		local.get $%159
+		i64.add
+		local.set $%160
+		local.get $x.2
+		local.set $%161
+		local.get $y.3
+		local.set $%162
+		local.get $%161
+		local.get $%162
+		i64.mul
+		local.set $%163
+		local.get $%160
+		local.get $%163
+		i64.sub
+		local.set $%164
+		local.get $%164
+		local.set $x.2
+		local.get $x.2
+		local.set $%166
+		local.get $%166
+		local.set $y.3
+		local.get $a.0
+		local.set $%168
+		local.get $%168
+		local.set $x.2
+		local.get $b.1
+		local.set $%170
+		local.get $%170
+		local.set $y.3
+		local.get $a.0
+		local.set $%172
+		local.get $b.1
+		local.set $%173
+		local.get $%172
+		local.get $%173
+		call $sub
+		local.set $%174
+		local.get $%174
+		local.set $%175
+		local.get $%175
+		local.set $x.2
+		local.get $x.2
+		local.set $%177
+		local.get $%177
+		local.set $y.3
+		local.get $x.2
+		local.set $%179
+		local.get $y.3
+		local.set $%180
+		local.get $%179
+		local.get $%180
+		call $div
+		local.set $%181
+		local.get $%181
+		local.set $%182
+		local.get $%182
+		local.set $x.2
+		local.get $x.2
+		local.set $%184
+		local.get $%184
+		local.set $a.0
+		local.get $b.1
+		local.set $%186
+		local.get $%186
+		local.set $b.1
+		local.get $a.0
+		local.set $%188
+		local.get $%188
+		local.set $b.1
+		local.get $b.1
+		local.set $%190
+		local.get $b.1
+		local.set $%191
+		local.get $%190
+		local.get $%191
+		call $add
+		local.set $%192
+		local.get $%192
+		local.set $%193
+		local.get $%193
+		local.set $x.2
+		i64.const 0
+		local.set $x.2
+		i64.const 1
+		local.set $x.2
+		local.get $b.1
+		local.set $%199
+		local.get $a.0
+		local.set $%200
+		local.get $%199
+		local.get $%200
+		i64.add
+		local.set $%201
+		local.get $x.2
+		local.set $%202
+		local.get $y.3
+		local.set $%203
+		local.get $%202
+		local.get $%203
+		i64.mul
+		local.set $%204
+		local.get $%201
+		local.get $%204
+		i64.sub
+		local.set $%205
+		local.get $%205
+		local.set $x.2
+		local.get $x.2
+		local.set $%207
+		local.get $%207
+		local.set $y.3
+		local.get $a.0
+		local.set $%209
+		local.get $%209
+		local.set $x.2
+		local.get $b.1
+		local.set $%211
+		local.get $%211
+		local.set $y.3
+		local.get $a.0
+		local.set $%213
+		local.get $b.1
+		local.set $%214
+		local.get $%213
+		local.get $%214
+		call $sub
+		local.set $%215
+		local.get $%215
+		local.set $%216
+		local.get $%216
+		local.set $x.2
+		local.get $x.2
+		local.set $%218
+		local.get $%218
+		local.set $y.3
+		local.get $x.2
+		local.set $%220
+		local.get $y.3
+		local.set $%221
+		local.get $%220
+		local.get $%221
+		call $div
+		local.set $%222
+		local.get $%222
+		local.set $%223
+		local.get $%223
+		local.set $x.2
+		local.get $x.2
+		local.set $%225
+		local.get $%225
+		local.set $a.0
+		local.get $b.1
+		local.set $%227
+		local.get $%227
+		local.set $b.1
+		local.get $a.0
+		local.set $%229
+		local.get $%229
+		local.set $b.1
+		local.get $b.1
+		local.set $%231
+		local.get $b.1
+		local.set $%232
+		local.get $%231
+		local.get $%232
+		call $add
+		local.set $%233
+		local.get $%233
+		local.set $%234
+		local.get $%234
+		local.set $x.2
+		i64.const 0
+		local.set $x.2
+		i64.const 1
+		local.set $x.2
+		local.get $b.1
+		local.set $%240
+		local.get $a.0
+		local.set $%241
+		local.get $%240
+		local.get $%241
+		i64.add
+		local.set $%242
+		local.get $x.2
+		local.set $%243
+		local.get $y.3
+		local.set $%244
+		local.get $%243
+		local.get $%244
+		i64.mul
+		local.set $%245
+		local.get $%242
+		local.get $%245
+		i64.sub
+		local.set $%246
+		local.get $%246
+		local.set $x.2
+		local.get $x.2
+		local.set $%248
+		local.get $%248
+		local.set $y.3
+		i32.const 34
+		i32.const 48
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $tester))
+)
@@ -0,0 +1,246 @@
+;; bitops.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "a is\00")
+	(data (i32.const 5) "and b is\00")
+	(data (i32.const 14) "a is %lld and b is %lld\0a\00")
+	(data (i32.const 39) "~\00")
+	(data (i32.const 41) "=\00")
+	(data (i32.const 43) "~ %lld = %lld\0a\00")
+	(data (i32.const 58) "|\00")
+	(data (i32.const 60) "%lld | %lld = %lld\0a\00")
+	(data (i32.const 80) "^\00")
+	(data (i32.const 82) "%lld ^ %lld = %lld\0a\00")
+	(data (i32.const 102) "&\00")
+	(data (i32.const 104) "%lld & %lld = %lld\0a\00")
+	(data (i32.const 124) "<<\00")
+	(data (i32.const 127) "%lld << %lld = %lld\0a\00")
+	(data (i32.const 148) ">>\00")
+	(data (i32.const 151) "%lld >> %lld = %lld\0a\00")
+
+	(func $bitwise_operators (export "bitwise_operators") (param $a i64) (param $b i64) (result i64)
+		(local $c.0 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%10 i64)
+		(local $%12 i64)
+		(local $%15 i64)
+		(local $%17 i64)
+		(local $%21 i64)
+		(local $%22 i64)
+		(local $%23 i64)
+		(local $%25 i64)
+		(local $%27 i64)
+		(local $%29 i64)
+		(local $%33 i64)
+		(local $%34 i64)
+		(local $%35 i64)
+		(local $%37 i64)
+		(local $%39 i64)
+		(local $%41 i64)
+		(local $%45 i64)
+		(local $%46 i64)
+		(local $%47 i64)
+		(local $%49 i64)
+		(local $%51 i64)
+		(local $%53 i64)
+		(local $%57 i64)
+		(local $%58 i64)
+		(local $%59 i64)
+		(local $%61 i64)
+		(local $%63 i64)
+		(local $%65 i64)
+		(local $%69 i64)
+		(local $%70 i64)
+		(local $%71 i64)
+		(local $%73 i64)
+		(local $%75 i64)
+		(local $%77 i64)
+		local.get $a
+		local.set $%4
+		local.get $b
+		local.set $%6
+		i32.const 176
+		local.get $%4
+		i64.store
+		i32.const 184
+		local.get $%6
+		i64.store
+		i32.const 14
+		i32.const 176
+		call $printf
+		drop
+		local.get $a
+		local.set $%10
+		i64.const -1
+		local.get $%10
+		i64.xor
+		local.set $%12
+		local.get $%12
+		local.set $c.0
+		local.get $a
+		local.set $%15
+		local.get $c.0
+		local.set $%17
+		i32.const 176
+		local.get $%15
+		i64.store
+		i32.const 184
+		local.get $%17
+		i64.store
+		i32.const 43
+		i32.const 176
+		call $printf
+		drop
+		local.get $a
+		local.set $%21
+		local.get $b
+		local.set $%22
+		local.get $%21
+		local.get $%22
+		i64.or
+		local.set $%23
+		local.get $%23
+		local.set $c.0
+		local.get $a
+		local.set $%25
+		local.get $b
+		local.set $%27
+		local.get $c.0
+		local.set $%29
+		i32.const 176
+		local.get $%25
+		i64.store
+		i32.const 184
+		local.get $%27
+		i64.store
+		i32.const 192
+		local.get $%29
+		i64.store
+		i32.const 60
+		i32.const 176
+		call $printf
+		drop
+		local.get $a
+		local.set $%33
+		local.get $b
+		local.set $%34
+		local.get $%33
+		local.get $%34
+		i64.xor
+		local.set $%35
+		local.get $%35
+		local.set $c.0
+		local.get $a
+		local.set $%37
+		local.get $b
+		local.set $%39
+		local.get $c.0
+		local.set $%41
+		i32.const 176
+		local.get $%37
+		i64.store
+		i32.const 184
+		local.get $%39
+		i64.store
+		i32.const 192
+		local.get $%41
+		i64.store
+		i32.const 82
+		i32.const 176
+		call $printf
+		drop
+		local.get $a
+		local.set $%45
+		local.get $b
+		local.set $%46
+		local.get $%45
+		local.get $%46
+		i64.and
+		local.set $%47
+		local.get $%47
+		local.set $c.0
+		local.get $a
+		local.set $%49
+		local.get $b
+		local.set $%51
+		local.get $c.0
+		local.set $%53
+		i32.const 176
+		local.get $%49
+		i64.store
+		i32.const 184
+		local.get $%51
+		i64.store
+		i32.const 192
+		local.get $%53
+		i64.store
+		i32.const 104
+		i32.const 176
+		call $printf
+		drop
+		local.get $a
+		local.set $%57
+		local.get $b
+		local.set $%58
+		local.get $%57
+		local.get $%58
+		i64.shl
+		local.set $%59
+		local.get $%59
+		local.set $c.0
+		local.get $a
+		local.set $%61
+		local.get $b
+		local.set $%63
+		local.get $c.0
+		local.set $%65
+		i32.const 176
+		local.get $%61
+		i64.store
+		i32.const 184
+		local.get $%63
+		i64.store
+		i32.const 192
+		local.get $%65
+		i64.store
+		i32.const 127
+		i32.const 176
+		call $printf
+		drop
+		local.get $a
+		local.set $%69
+		local.get $b
+		local.set $%70
+		local.get $%69
+		local.get $%70
+		i64.shr_s
+		local.set $%71
+		local.get $%71
+		local.set $c.0
+		local.get $a
+		local.set $%73
+		local.get $b
+		local.set $%75
+		local.get $c.0
+		local.set $%77
+		i32.const 176
+		local.get $%73
+		i64.store
+		i32.const 184
+		local.get $%75
+		i64.store
+		i32.const 192
+		local.get $%77
+		i64.store
+		i32.const 151
+		i32.const 176
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $bitwise_operators))
+)
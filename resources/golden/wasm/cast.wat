@@ -0,0 +1,133 @@
+;; cast.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "a is\00")
+	(data (i32.const 5) "b is\00")
+	(data (i32.const 10) "c is\00")
+	(data (i32.const 15) "d is\00")
+	(data (i32.const 20) "a is %lld b is %lld c is %f d is %f\0a\00")
+	(data (i32.const 57) "f is\00")
+	(data (i32.const 62) "f is %f\0a\00")
+
+	(func $casting (export "casting") (param $a i64) (param $b i64) (param $c f64) (param $d f64) (result i64)
+		(local $e.0 i64)
+		(local $f.1 f64)
+		(local $%6 i64)
+		(local $%7 f64)
+		(local $%8 f64)
+		(local $%9 f64)
+		(local $%10 i64)
+		(local $%12 i64)
+		(local $%13 f64)
+		(local $%14 f64)
+		(local $%15 f64)
+		(local $%20 i64)
+		(local $%22 i64)
+		(local $%24 f64)
+		(local $%26 f64)
+		(local $%30 i64)
+		(local $%32 f64)
+		(local $%33 f64)
+		(local $%34 f64)
+		(local $%37 f64)
+		local.get $a
+		local.set $%6
+		local.get $c
+		local.set $%7
+		local.get $%6
+		f64.convert_i64_s
+		local.set $%8
+		local.get $%8
+		local.get $%7
+		f64.add
+		local.set $%9
+		local.get $%9
+		i64.trunc_sat_f64_s
+		local.set $%10
+		local.get $%10
+		local.set $e.0
+		local.get $b
+		local.set $%12
+		local.get $d
+		local.set $%13
+		local.get $%12
+		f64.convert_i64_s
+		local.set $%14
+		local.get $%14
+		local.get $%13
+		f64.add
+		local.set $%15
+		local.get $%15
+		local.set $f.1
+		i64.const 3
+		local.set $e.0
+		local.get $a
+		local.set $%20
+		local.get $b
+		local.set $%22
+		local.get $c
+		local.set $%24
+		local.get $d
+		local.set $%26
+		i32.const 72
+		local.get $%20
+		i64.store
+		i32.const 80
+		local.get $%22
+		i64.store
+		i32.const 88
+		local.get $%24
+		f64.store
+		i32.const 96
+		local.get $%26
+		f64.store
+		i32.const 20
+		i32.const 72
+		call $printf
+		drop
+		local.get $e.0
+		local.set $%30
+		local.get $%30
+		f64.convert_i64_s
+		local.set $%32
+		local.get $%32
+		f64.const 0.30000001192092896
+		call $foo
+		local.set $%33
+		local.get $%33
+		local.set $%34
+		local.get $%34
+		local.set $f.1
+		local.get $f.1
+		local.set $%37
+		i32.const 72
+		local.get $%37
+		f64.store
+		i32.const 62
+		i32.const 72
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $foo (export "foo") (param $x f64) (param $y f64) (result f64)
+		(local $%2 f64)
+		(local $%3 f64)
+		(local $%4 f64)
+		local.get $x
+		local.set $%2
+		local.get $y
+		local.set $%3
+		local.get $%2
+		local.get $%3
+		f64.mul
+		local.set $%4
+		local.get $%4
+		return
+		unreachable
+	)
+	(export "main" (func $casting))
+)
@@ -0,0 +1,243 @@
+;; easy.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "Testing plain call/return and expression evaluation\00")
+	(data (i32.const 52) "Testing plain call/return and expression evaluation\0a\00")
+	(data (i32.const 105) "The deftion returned y:=\00")
+	(data (i32.const 130) "The deftion returned y:= %lld\0a\00")
+	(data (i32.const 161) "My parameters are a:=\00")
+	(data (i32.const 183) "and b:=\00")
+	(data (i32.const 191) "My parameters are a:= %lld and b:= %lld\0a\00")
+	(data (i32.const 232) "Their sum is c:=\00")
+	(data (i32.const 249) "Their sum is c:= %lld\0a\00")
+	(data (i32.const 272) "Their difference is c:=\00")
+	(data (i32.const 296) "Their difference is c:= %lld\0a\00")
+	(data (i32.const 326) "Their product is c:=\00")
+	(data (i32.const 347) "Their product is c:= %lld\0a\00")
+	(data (i32.const 374) "Their ratio is c:=\00")
+	(data (i32.const 393) "Their ratio is c:= %lld\0a\00")
+	(data (i32.const 418) "(-c):=\00")
+	(data (i32.const 425) "(-c):= %lld\0a\00")
+	(data (i32.const 438) "The sum of their squares is \00")
+	(data (i32.const 467) "The sum of their squares is  %lld\0a\00")
+
+	(func $mainfunc (export "mainfunc") (param $a i64) (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $z.2 i64)
+		(local $%12 i64)
+		(local $%13 i64)
+		(local $%14 i64)
+		(local $%15 i64)
+		(local $%18 i64)
+		i32.const 52
+		i32.const 504
+		call $printf
+		drop
+		i64.const 15
+		local.set $x.0
+		i64.const 5
+		local.set $z.2
+		local.get $x.0
+		local.set $%12
+		local.get $z.2
+		local.set $%13
+		local.get $%12
+		local.get $%13
+		call $test
+		local.set $%14
+		local.get $%14
+		local.set $%15
+		local.get $%15
+		local.set $y.1
+		local.get $y.1
+		local.set $%18
+		i32.const 504
+		local.get $%18
+		i64.store
+		i32.const 130
+		i32.const 504
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $test (export "test") (param $a i64) (param $b i64) (result i64)
+		(local $c.0 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%12 i64)
+		(local $%15 i64)
+		(local $%19 i64)
+		(local $%20 i64)
+		(local $%21 i64)
+		(local $%24 i64)
+		(local $%28 i64)
+		(local $%29 i64)
+		(local $%30 i64)
+		(local $%33 i64)
+		(local $%37 i64)
+		(local $%38 i64)
+		(local $%39 i64)
+		(local $%42 i64)
+		(local $%47 i64)
+		(local $%49 i64)
+		(local $%54 i64)
+		(local $%55 i64)
+		(local $%56 i64)
+		(local $%57 i64)
+		(local $%58 i64)
+		(local $%59 i64)
+		(local $%60 i64)
+		(local $%64 i64)
+		(local $%65 i64)
+		(local $%66 i64)
+		local.get $a
+		local.set $%4
+		local.get $b
+		local.set $%6
+		i32.const 504
+		local.get $%4
+		i64.store
+		i32.const 512
+		local.get $%6
+		i64.store
+		i32.const 191
+		i32.const 504
+		call $printf
+		drop
+		local.get $a
+		local.set $%10
+		local.get $b
+		local.set $%11
+		local.get $%10
+		local.get $%11
+		i64.add
+		local.set $%12
+		local.get $%12
+		local.set $c.0
+		local.get $c.0
+		local.set $%15
+		i32.const 504
+		local.get $%15
+		i64.store
+		i32.const 249
+		i32.const 504
+		call $printf
+		drop
+		local.get $a
+		local.set $%19
+		local.get $b
+		local.set $%20
+		local.get $%19
+		local.get $%20
+		i64.sub
+		local.set $%21
+		local.get $%21
+		local.set $c.0
+		local.get $c.0
+		local.set $%24
+		i32.const 504
+		local.get $%24
+		i64.store
+		i32.const 296
+		i32.const 504
+		call $printf
+		drop
+		local.get $a
+		local.set $%28
+		local.get $b
+		local.set $%29
+		local.get $%28
+		local.get $%29
+		i64.mul
+		local.set $%30
+		local.get $%30
+		local.set $c.0
+		local.get $c.0
+		local.set $%33
+		i32.const 504
+		local.get $%33
+		i64.store
+		i32.const 347
+		i32.const 504
+		call $printf
+		drop
+		local.get $a
+		local.set $%37
+		local.get $b
+		local.set $%38
+		local.get $%37
+		local.get $%38
+		i64.div_s
+		local.set $%39
+		local.get $%39
+		local.set $c.0
+		local.get $c.0
+		local.set $%42
+		i32.const 504
+		local.get $%42
+		i64.store
+		i32.const 393
+		i32.const 504
+		call $printf
+		drop
+		local.get $c.0
+		local.set $%47
+		i64.const 0
+		local.get $%47
+		i64.sub
+		local.set $%49
+		i32.const 504
+		local.get $%49
+		i64.store
+		i32.const 425
+		i32.const 504
+		call $printf
+		drop
+		local.get $a
+		local.set $%54
+		local.get $a
+		local.set $%55
+		local.get $%54
+		local.get $%55
+		i64.mul
+		local.set $%56
+		local.get $b
+		local.set $%57
+		local.get $b
+		local.set $%58
+		local.get $%57
+		local.get $%58
+		i64.mul
+		local.set $%59
+		local.get $%56
+		local.get $%59
+		i64.add
+		local.set $%60
+		i32.const 504
+		local.get $%60
+		i64.store
+		i32.const 467
+		i32.const 504
+		call $printf
+		drop
+		local.get $a
+		local.set $%64
+		local.get $b
+		local.set $%65
+		local.get $%64
+		local.get $%65
+		i64.sub
+		local.set $%66
+		local.get $%66
+		return
+		unreachable
+	)
+	(export "main" (func $mainfunc))
+)
@@ -0,0 +1,56 @@
+;; escapecodes.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "Testing if printf format codes are left alone.\00")
+	(data (i32.const 47) "\5cnOutput *should* contain percent characters, but no integers.\00")
+	(data (i32.const 110) "Testing if printf format codes are left alone. \5cnOutput *should* contain percent characters, but no integers.\0a\00")
+	(data (i32.const 221) "\5ctHello, world! %d %d\00")
+	(data (i32.const 243) "\5ctHello, world! %%d %%d\0a\00")
+	(data (i32.const 268) "Adding a splash of ANSI color codes - This will only work in a color terminal\00")
+	(data (i32.const 346) "Adding a splash of ANSI color codes - This will only work in a color terminal\0a\00")
+	(data (i32.const 425) "\5ct\5c033[31mRed\00")
+	(data (i32.const 439) "\5ct\5c033[31mRed\0a\00")
+	(data (i32.const 454) "\5ct\5c033[32mGreen\00")
+	(data (i32.const 470) "\5ct\5c033[32mGreen\0a\00")
+	(data (i32.const 487) "\5ct\5c033[34mBlue\00")
+	(data (i32.const 502) "\5c033[0m\00")
+	(data (i32.const 510) "\5ct\5c033[34mBlue \5c033[0m\0a\00")
+
+	(func $escapecodes (export "escapecodes") (result i64)
+		(local $a.0 i64)
+		(local $b.1 i64)
+		i32.const 110
+		i32.const 536
+		call $printf
+		drop
+		i64.const 64
+		local.set $a.0
+		i64.const 42
+		local.set $b.1
+		i32.const 243
+		i32.const 536
+		call $printf
+		drop
+		i32.const 346
+		i32.const 536
+		call $printf
+		drop
+		i32.const 439
+		i32.const 536
+		call $printf
+		drop
+		i32.const 470
+		i32.const 536
+		call $printf
+		drop
+		i32.const 510
+		i32.const 536
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $escapecodes))
+)
@@ -0,0 +1,225 @@
+;; euclid.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "Greatest common divisor of\00")
+	(data (i32.const 27) "and\00")
+	(data (i32.const 31) "is\00")
+	(data (i32.const 34) "Greatest common divisor of %lld and %lld is %lld\0a\00")
+	(data (i32.const 84) "are relative primes\00")
+	(data (i32.const 104) "%lld and %lld are relative primes\0a\00")
+
+	(func $euclid (export "euclid") (param $a i64) (param $b i64) (result i64)
+		(local $%2 i64)
+		(local $%4 i64)
+		(local $%7 i64)
+		(local $%9 i64)
+		(local $%12 i64)
+		(local $%14 i64)
+		(local $%17 i64)
+		(local $%19 i64)
+		(local $%22 i64)
+		(local $%23 i64)
+		(local $%24 i64)
+		(local $%25 i64)
+		(local $%27 i64)
+		(local $%31 i64)
+		(local $%33 i64)
+		(local $%35 i64)
+		(local $%36 i64)
+		(local $%37 i64)
+		(local $%38 i64)
+		(local $%43 i64)
+		(local $%45 i64)
+		block $block1048581
+			local.get $a
+			local.set $%2
+			local.get $%2
+			i64.const 0
+			i64.sub
+			local.set $%4
+			local.get $%4
+			i64.const 0
+			i64.lt_s
+			if
+				local.get $a
+				local.set $%7
+				i64.const 0
+				local.get $%7
+				i64.sub
+				local.set $%9
+				local.get $%9
+				local.set $a
+				br $block1048581
+			else
+				br $block1048581
+			end
+		end
+		block $block1048583
+			local.get $b
+			local.set $%12
+			local.get $%12
+			i64.const 0
+			i64.sub
+			local.set $%14
+			local.get $%14
+			i64.const 0
+			i64.lt_s
+			if
+				local.get $b
+				local.set $%17
+				i64.const 0
+				local.get $%17
+				i64.sub
+				local.set $%19
+				local.get $%19
+				local.set $b
+				br $block1048583
+			else
+				br $block1048583
+			end
+		end
+		block $block1048592
+			local.get $a
+			local.set $%22
+			local.get $b
+			local.set $%23
+			local.get $%22
+			local.get $%23
+			call $gcd
+			local.set $%24
+			local.get $%24
+			local.set $%25
+			local.get $%25
+			i64.const 1
+			i64.sub
+			local.set $%27
+			local.get $%27
+			i64.const 0
+			i64.gt_s
+			if
+				local.get $a
+				local.set $%31
+				local.get $b
+				local.set $%33
+				local.get $a
+				local.set $%35
+				local.get $b
+				local.set $%36
+				local.get $%35
+				local.get $%36
+				call $gcd
+				local.set $%37
+				local.get $%37
+				local.set $%38
+				i32.const 144
+				local.get $%31
+				i64.store
+				i32.const 152
+				local.get $%33
+				i64.store
+				i32.const 160
+				local.get $%38
+				i64.store
+				i32.const 34
+				i32.const 144
+				call $printf
+				drop
+				br $block1048592
+			else
+				local.get $a
+				local.set $%43
+				local.get $b
+				local.set $%45
+				i32.const 144
+				local.get $%43
+				i64.store
+				i32.const 152
+				local.get $%45
+				i64.store
+				i32.const 104
+				i32.const 144
+				call $printf
+				drop
+				br $block1048592
+			end
+		end
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $gcd (export "gcd") (param $a i64) (param $b i64) (result i64)
+		(local $g.0 i64)
+		(local $%3 i64)
+		(local $%5 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%12 i64)
+		(local $%13 i64)
+		(local $%14 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%17 i64)
+		(local $%20 i64)
+		(local $%23 i64)
+		block $block1048598
+			local.get $b
+			local.set $%3
+			local.get $%3
+			i64.const 0
+			i64.sub
+			local.set $%5
+			local.get $%5
+			i64.const 0
+			i64.gt_s
+			if
+				local.get $b
+				local.set $%8
+				local.get $a
+				local.set $%9
+				local.get $a
+				local.set $%10
+				local.get $b
+				local.set $%11
+				local.get $%10
+				local.get $%11
+				i64.div_s
+				local.set $%12
+				local.get $b
+				local.set $%13
+				local.get $%12
+				local.get $%13
+				i64.mul
+				local.set $%14
+				local.get $%9
+				local.get $%14
+				i64.sub
+				local.set $%15
+				local.get $%8
+				local.get $%15
+				call $gcd
+				local.set $%16
+				local.get $%16
+				local.set $%17
+				local.get $%17
+				local.set $g.0
+				br $block1048598
+			else
+				local.get $a
+				local.set $%20
+				local.get $%20
+				local.set $g.0
+				br $block1048598
+			end
+		end
+		local.get $g.0
+		local.set $%23
+		local.get $%23
+		return
+		unreachable
+	)
+	(export "main" (func $euclid))
+)
@@ -0,0 +1,146 @@
+;; fibonacci_iterative.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "Fibonacci number #\00")
+	(data (i32.const 19) "is\00")
+	(data (i32.const 22) "Fibonacci number # %lld is %lld\0a\00")
+
+	(func $fibonacci_iterative (export "fibonacci_iterative") (param $n i64) (result i64)
+		(local $w.0 i64)
+		(local $x.1 i64)
+		(local $y.2 i64)
+		(local $f.3 i64)
+		(local $%5 i64)
+		(local $%13 i64)
+		(local $%15 i64)
+		(local $%18 i64)
+		(local $%20 i64)
+		(local $%23 i64)
+		(local $%25 i64)
+		(local $%29 i64)
+		(local $%31 i64)
+		(local $%34 i64)
+		(local $%35 i64)
+		(local $%36 i64)
+		(local $%38 i64)
+		(local $%40 i64)
+		(local $%42 i64)
+		(local $%44 i64)
+		(local $%54 i64)
+		(local $%56 i64)
+		block $block1048591
+			local.get $n
+			local.set $%5
+			local.get $%5
+			local.set $w.0
+			i64.const 1
+			local.set $x.1
+			i64.const 1
+			local.set $y.2
+			i64.const 1
+			local.set $f.3
+			local.get $w.0
+			local.set $%13
+			local.get $%13
+			i64.const 0
+			i64.sub
+			local.set $%15
+			local.get $%15
+			i64.const 0
+			i64.gt_s
+			if
+				local.get $w.0
+				local.set $%18
+				local.get $%18
+				i64.const 1
+				i64.sub
+				local.set $%20
+				local.get $%20
+				i64.const 0
+				i64.gt_s
+				if
+					local.get $w.0
+					local.set $%23
+					local.get $%23
+					i64.const 2
+					i64.sub
+					local.set $%25
+					local.get $%25
+					i64.const 0
+					i64.gt_s
+					if
+						loop $block1048587.loop
+							local.get $w.0
+							local.set $%29
+							local.get $%29
+							i64.const 3
+							i64.sub
+							local.set $%31
+							local.get $%31
+							i64.const 0
+							i64.gt_s
+							if
+								local.get $y.2
+								local.set $%34
+								local.get $x.1
+								local.set $%35
+								local.get $%34
+								local.get $%35
+								i64.add
+								local.set $%36
+								local.get $%36
+								local.set $f.3
+								local.get $y.2
+								local.set $%38
+								local.get $%38
+								local.set $x.1
+								local.get $f.3
+								local.set $%40
+								local.get $%40
+								local.set $y.2
+								local.get $w.0
+								local.set $%42
+								local.get $%42
+								i64.const 1
+								i64.sub
+								local.set $%44
+								local.get $%44
+								local.set $w.0
+								br $block1048587.loop
+							else
+								br $block1048591
+							end
+						end
+					else
+						br $block1048591
+					end
+				else
+					br $block1048591
+				end
+			else
+				i64.const 0
+				local.set $f.3
+				br $block1048591
+			end
+		end
+		local.get $n
+		local.set $%54
+		local.get $f.3
+		local.set $%56
+		i32.const 56
+		local.get $%54
+		i64.store
+		i32.const 64
+		local.get $%56
+		i64.store
+		i32.const 22
+		i32.const 56
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $fibonacci_iterative))
+)
@@ -0,0 +1,113 @@
+;; fibonacci_recursive.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "Fibonacci number #\00")
+	(data (i32.const 19) "is\00")
+	(data (i32.const 22) "Fibonacci number # %lld is %lld\0a\00")
+
+	(func $fibonacci_recursive (export "fibonacci_recursive") (param $n i64) (result i64)
+		(local $f.0 i64)
+		(local $%2 i64)
+		(local $%3 i64)
+		(local $%4 i64)
+		(local $%7 i64)
+		(local $%9 i64)
+		local.get $n
+		local.set $%2
+		local.get $%2
+		call $fibonacci_number
+		local.set $%3
+		local.get $%3
+		local.set $%4
+		local.get $%4
+		local.set $f.0
+		local.get $n
+		local.set $%7
+		local.get $f.0
+		local.set $%9
+		i32.const 56
+		local.get $%7
+		i64.store
+		i32.const 64
+		local.get $%9
+		i64.store
+		i32.const 22
+		i32.const 56
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $fibonacci_number (export "fibonacci_number") (param $n i64) (result i64)
+		(local $y.0 i64)
+		(local $%4 i64)
+		(local $%6 i64)
+		(local $%9 i64)
+		(local $%11 i64)
+		(local $%12 i64)
+		(local $%13 i64)
+		(local $%14 i64)
+		(local $%16 i64)
+		(local $%17 i64)
+		(local $%18 i64)
+		(local $%19 i64)
+		(local $%25 i64)
+		block $block1048589
+			i64.const 0
+			local.set $y.0
+			local.get $n
+			local.set $%4
+			local.get $%4
+			i64.const 2
+			i64.sub
+			local.set $%6
+			local.get $%6
+			i64.const 0
+			i64.gt_s
+			if
+				local.get $n
+				local.set $%9
+				local.get $%9
+				i64.const 1
+				i64.sub
+				local.set $%11
+				local.get $%11
+				call $fibonacci_number
+				local.set $%12
+				local.get $%12
+				local.set $%13
+				local.get $n
+				local.set $%14
+				local.get $%14
+				i64.const 2
+				i64.sub
+				local.set $%16
+				local.get $%16
+				call $fibonacci_number
+				local.set $%17
+				local.get $%17
+				local.set $%18
+				local.get $%13
+				local.get $%18
+				i64.add
+				local.set $%19
+				local.get $%19
+				local.set $y.0
+				br $block1048589
+			else
+				i64.const 1
+				local.set $y.0
+				br $block1048589
+			end
+		end
+		local.get $y.0
+		local.set $%25
+		local.get $%25
+		return
+		unreachable
+	)
+	(export "main" (func $fibonacci_recursive))
+)
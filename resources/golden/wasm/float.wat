@@ -0,0 +1,192 @@
+;; float.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "+\00")
+	(data (i32.const 2) "=\00")
+	(data (i32.const 4) "%f + %f = %f\0a\00")
+	(data (i32.const 18) "-\00")
+	(data (i32.const 20) "%f - %f = %f\0a\00")
+	(data (i32.const 34) "*\00")
+	(data (i32.const 36) "%f * %f = %f\0a\00")
+	(data (i32.const 50) "/\00")
+	(data (i32.const 52) "%f / %f = %f\0a\00")
+	(data (i32.const 66) "c is now a constant: \00")
+	(data (i32.const 88) "c is now a constant:  %f\0a\00")
+
+	(func $float_test (export "float_test") (param $a f64) (param $b f64) (result i64)
+		(local $c.0 f64)
+		(local $%3 f64)
+		(local $%4 f64)
+		(local $%5 f64)
+		(local $%7 f64)
+		(local $%9 f64)
+		(local $%11 f64)
+		(local $%15 f64)
+		(local $%16 f64)
+		(local $%17 f64)
+		(local $%19 f64)
+		(local $%21 f64)
+		(local $%23 f64)
+		(local $%27 f64)
+		(local $%28 f64)
+		(local $%29 f64)
+		(local $%31 f64)
+		(local $%33 f64)
+		(local $%35 f64)
+		(local $%39 f64)
+		(local $%40 f64)
+		(local $%41 f64)
+		(local $%43 f64)
+		(local $%45 f64)
+		(local $%47 f64)
+		(local $%54 f64)
+		(local $%59 f64)
+		(local $%62 f64)
+		local.get $a
+		local.set $%3
+		local.get $b
+		local.set $%4
+		local.get $%3
+		local.get $%4
+		f64.add
+		local.set $%5
+		local.get $%5
+		local.set $c.0
+		local.get $a
+		local.set $%7
+		local.get $b
+		local.set $%9
+		local.get $c.0
+		local.set $%11
+		i32.const 120
+		local.get $%7
+		f64.store
+		i32.const 128
+		local.get $%9
+		f64.store
+		i32.const 136
+		local.get $%11
+		f64.store
+		i32.const 4
+		i32.const 120
+		call $printf
+		drop
+		local.get $a
+		local.set $%15
+		local.get $b
+		local.set $%16
+		local.get $%15
+		local.get $%16
+		f64.sub
+		local.set $%17
+		local.get $%17
+		local.set $c.0
+		local.get $a
+		local.set $%19
+		local.get $b
+		local.set $%21
+		local.get $c.0
+		local.set $%23
+		i32.const 120
+		local.get $%19
+		f64.store
+		i32.const 128
+		local.get $%21
+		f64.store
+		i32.const 136
+		local.get $%23
+		f64.store
+		i32.const 20
+		i32.const 120
+		call $printf
+		drop
+		local.get $a
+		local.set $%27
+		local.get $b
+		local.set $%28
+		local.get $%27
+		local.get $%28
+		f64.mul
+		local.set $%29
+		local.get $%29
+		local.set $c.0
+		local.get $a
+		local.set $%31
+		local.get $b
+		local.set $%33
+		local.get $c.0
+		local.set $%35
+		i32.const 120
+		local.get $%31
+		f64.store
+		i32.const 128
+		local.get $%33
+		f64.store
+		i32.const 136
+		local.get $%35
+		f64.store
+		i32.const 36
+		i32.const 120
+		call $printf
+		drop
+		local.get $a
+		local.set $%39
+		local.get $b
+		local.set $%40
+		local.get $%39
+		local.get $%40
+		f64.div
+		local.set $%41
+		local.get $%41
+		local.set $c.0
+		local.get $a
+		local.set $%43
+		local.get $b
+		local.set $%45
+		local.get $c.0
+		local.set $%47
+		i32.const 120
+		local.get $%43
+		f64.store
+		i32.const 128
+		local.get $%45
+		f64.store
+		i32.const 136
+		local.get $%47
+		f64.store
+		i32.const 52
+		i32.const 120
+		call $printf
+		drop
+		f64.const 2.450000047683716
+		local.set $c.0
+		local.get $c.0
+		local.set $%54
+		i32.const 120
+		local.get $%54
+		f64.store
+		i32.const 88
+		i32.const 120
+		call $printf
+		drop
+		i64.const 1
+		f64.convert_i64_s
+		local.set $%59
+		local.get $%59
+		local.set $c.0
+		local.get $c.0
+		local.set $%62
+		i32.const 120
+		local.get $%62
+		f64.store
+		i32.const 88
+		i32.const 120
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $float_test))
+)
@@ -0,0 +1,172 @@
+;; funcall.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "Calling my_deftion with parameters\00")
+	(data (i32.const 35) "Calling my_deftion with parameters %lld %lld\0a\00")
+	(data (i32.const 81) "The returned result is\00")
+	(data (i32.const 104) "The returned result is %lld\0a\00")
+	(data (i32.const 133) "The other returned result is\00")
+	(data (i32.const 162) "The other returned result is %lld\0a\00")
+	(data (i32.const 197) "Parameter s is\00")
+	(data (i32.const 212) "Parameter s is %lld\0a\00")
+	(data (i32.const 233) "Parameter t is\00")
+	(data (i32.const 248) "Parameter t is %lld\0a\00")
+	(data (i32.const 269) "The sum of their squares is\00")
+	(data (i32.const 297) "The sum of their squares is %lld\0a\00")
+
+	(func $defall (export "defall") (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $z.2 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%13 i64)
+		(local $%14 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%19 i64)
+		(local $%23 i64)
+		(local $%24 i64)
+		(local $%27 i64)
+		i64.const 5
+		local.set $x.0
+		i64.const 10
+		local.set $y.1
+		local.get $x.0
+		local.set $%8
+		local.get $y.1
+		local.set $%9
+		i32.const 336
+		local.get $%8
+		i64.store
+		i32.const 344
+		local.get $%9
+		i64.store
+		i32.const 35
+		i32.const 336
+		call $printf
+		drop
+		local.get $x.0
+		local.set $%13
+		local.get $y.1
+		local.set $%14
+		local.get $%13
+		local.get $%14
+		call $my_deftion
+		local.set $%15
+		local.get $%15
+		local.set $%16
+		local.get $%16
+		local.set $z.2
+		local.get $z.2
+		local.set $%19
+		i32.const 336
+		local.get $%19
+		i64.store
+		i32.const 104
+		i32.const 336
+		call $printf
+		drop
+		call $my_other_deftion
+		local.set $%23
+		local.get $%23
+		local.set $%24
+		local.get $%24
+		local.set $z.2
+		local.get $z.2
+		local.set $%27
+		i32.const 336
+		local.get $%27
+		i64.store
+		i32.const 162
+		i32.const 336
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $my_deftion (export "my_deftion") (param $s i64) (param $t i64) (result i64)
+		(local $u.0 i64)
+		(local $%3 i64)
+		(local $%4 i64)
+		(local $%5 i64)
+		(local $%6 i64)
+		(local $%7 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%12 i64)
+		(local $%17 i64)
+		(local $%22 i64)
+		(local $%26 i64)
+		local.get $s
+		local.set $%3
+		local.get $s
+		local.set $%4
+		local.get $%3
+		local.get $%4
+		i64.mul
+		local.set $%5
+		local.get $t
+		local.set $%6
+		local.get $t
+		local.set $%7
+		local.get $%6
+		local.get $%7
+		i64.mul
+		local.set $%8
+		local.get $%5
+		local.get $%8
+		i64.add
+		local.set $%9
+		local.get $%9
+		local.set $u.0
+		local.get $s
+		local.set $%12
+		i32.const 336
+		local.get $%12
+		i64.store
+		i32.const 212
+		i32.const 336
+		call $printf
+		drop
+		local.get $t
+		local.set $%17
+		i32.const 336
+		local.get $%17
+		i64.store
+		i32.const 248
+		i32.const 336
+		call $printf
+		drop
+		local.get $u.0
+		local.set $%22
+		i32.const 336
+		local.get $%22
+		i64.store
+		i32.const 297
+		i32.const 336
+		call $printf
+		drop
+		local.get $u.0
+		local.set $%26
+		local.get $%26
+		return
+		unreachable
+	)
+
+	(func $my_other_deftion (export "my_other_deftion") (result i64)
+		(local $x.0 i64)
+		(local $%3 i64)
+		i64.const 42
+		local.set $x.0
+		local.get $x.0
+		local.set $%3
+		local.get $%3
+		return
+		unreachable
+	)
+	(export "main" (func $defall))
+)
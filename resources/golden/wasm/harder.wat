@@ -0,0 +1,145 @@
+;; harder.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "Nested scopes coming up...\00")
+	(data (i32.const 27) "Nested scopes coming up...\0a\00")
+	(data (i32.const 55) "x:=\00")
+	(data (i32.const 59) "x:= %lld\0a\00")
+	(data (i32.const 69) "Parameter a is a:=\00")
+	(data (i32.const 88) "Parameter a is a:= %lld\0a\00")
+	(data (i32.const 113) "Outer scope has a:=\00")
+	(data (i32.const 133) "Outer scope has a:= %lld\0a\00")
+	(data (i32.const 159) "Inner scope has a:=\00")
+	(data (i32.const 179) "and b:=\00")
+	(data (i32.const 187) "Inner scope has a:= %lld and b:= %lld\0a\00")
+	(data (i32.const 226) "b was updated to \00")
+	(data (i32.const 244) "in inner scope\00")
+	(data (i32.const 259) "b was updated to  %lld in inner scope\0a\00")
+	(data (i32.const 298) "Outer scope (still) has a:=\00")
+	(data (i32.const 326) "Outer scope (still) has a:= %lld\0a\00")
+	(data (i32.const 360) "Return expression (a-1) using a:=\00")
+	(data (i32.const 394) "Return expression (a-1) using a:= %lld\0a\00")
+
+	(func $start (export "start") (result i64)
+		(local $x.0 i64)
+		(local $%6 i64)
+		(local $%7 i64)
+		(local $%10 i64)
+		i32.const 27
+		i32.const 440
+		call $printf
+		drop
+		i64.const 1
+		call $test_me
+		local.set $%6
+		local.get $%6
+		local.set $%7
+		local.get $%7
+		local.set $x.0
+		local.get $x.0
+		local.set $%10
+		i32.const 440
+		local.get $%10
+		i64.store
+		i32.const 59
+		i32.const 440
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $test_me (export "test_me") (param $a i64) (result i64)
+		(local $a.0 i64)
+		(local $b.1 i64)
+		(local $a.2 i64)
+		(local $%2 i64)
+		(local $%10 i64)
+		(local $%21 i64)
+		(local $%23 i64)
+		(local $%30 i64)
+		(local $%36 i64)
+		(local $%41 i64)
+		(local $%45 i64)
+		(local $%47 i64)
+		local.get $a
+		local.set $%2
+		i32.const 440
+		local.get $%2
+		i64.store
+		i32.const 88
+		i32.const 440
+		call $printf
+		drop
+		i64.const 2
+		local.set $a.0
+		local.get $a.0
+		local.set $%10
+		i32.const 440
+		local.get $%10
+		i64.store
+		i32.const 133
+		i32.const 440
+		call $printf
+		drop
+		i64.const 3
+		local.set $a.2
+		i64.const 4
+		local.set $b.1
+		local.get $a.2
+		local.set $%21
+		local.get $b.1
+		local.set $%23
+		i32.const 440
+		local.get $%21
+		i64.store
+		i32.const 448
+		local.get $%23
+		i64.store
+		i32.const 187
+		i32.const 440
+		call $printf
+		drop
+		i64.const 5
+		local.set $b.1
+		local.get $b.1
+		local.set $%30
+		i32.const 440
+		local.get $%30
+		i64.store
+		i32.const 259
+		i32.const 440
+		call $printf
+		drop
+		local.get $a.0
+		local.set $%36
+		i32.const 440
+		local.get $%36
+		i64.store
+		i32.const 326
+		i32.const 440
+		call $printf
+		drop
+		local.get $a
+		local.set $%41
+		i32.const 440
+		local.get $%41
+		i64.store
+		i32.const 394
+		i32.const 440
+		call $printf
+		drop
+		local.get $a
+		local.set $%45
+		local.get $%45
+		i64.const 1
+		i64.sub
+		local.set $%47
+		local.get $%47
+		return
+		unreachable
+	)
+	(export "main" (func $start))
+)
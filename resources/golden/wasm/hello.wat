@@ -0,0 +1,18 @@
+;; hello.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "Hello, world!\00")
+	(data (i32.const 14) "Hello, world!\0a\00")
+
+	(func $hello (export "hello") (result i64)
+		i32.const 14
+		i32.const 32
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $hello))
+)
@@ -0,0 +1,95 @@
+;; if2.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "%lld\0a\00")
+	(data (i32.const 6) "Bigger\00")
+	(data (i32.const 13) "Bigger\0a\00")
+	(data (i32.const 21) "Smaller\00")
+	(data (i32.const 29) "Smaller\0a\00")
+	(data (i32.const 38) "Equal\00")
+	(data (i32.const 44) "Equal\0a\00")
+
+	(func $test (export "test") (param $a i64) (result i64)
+		(local $%1 i64)
+		(local $%5 i64)
+		(local $%7 i64)
+		(local $%15 i64)
+		(local $%17 i64)
+		(local $%25 i64)
+		(local $%27 i64)
+		block $block1048582
+			local.get $a
+			local.set $%1
+			i32.const 56
+			local.get $%1
+			i64.store
+			i32.const 0
+			i32.const 56
+			call $printf
+			drop
+			local.get $a
+			local.set $%5
+			local.get $%5
+			i64.const 10
+			i64.sub
+			local.set $%7
+			local.get $%7
+			i64.const 0
+			i64.gt_s
+			if
+				i32.const 13
+				i32.const 56
+				call $printf
+				drop
+				br $block1048582
+			else
+				br $block1048582
+			end
+		end
+		block $block1048587
+			local.get $a
+			local.set $%15
+			local.get $%15
+			i64.const 10
+			i64.sub
+			local.set $%17
+			local.get $%17
+			i64.const 0
+			i64.lt_s
+			if
+				i32.const 29
+				i32.const 56
+				call $printf
+				drop
+				br $block1048587
+			else
+				br $block1048587
+			end
+		end
+		block $block1048591
+			local.get $a
+			local.set $%25
+			local.get $%25
+			i64.const 10
+			i64.sub
+			local.set $%27
+			local.get $%27
+			i64.const 0
+			i64.eq
+			if
+				i32.const 44
+				i32.const 56
+				call $printf
+				drop
+				br $block1048591
+			else
+				br $block1048591
+			end
+		end
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $test))
+)
@@ -0,0 +1,98 @@
+;; if_test.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "%lld\0a\00")
+	(data (i32.const 6) "A equals 10\00")
+	(data (i32.const 18) "A equals 10\0a\00")
+	(data (i32.const 31) "B is greater than -15\00")
+	(data (i32.const 53) "B is greater than -15\0a\00")
+	(data (i32.const 76) "B is smaller than or equal to -15\00")
+	(data (i32.const 110) "B is smaller than or equal to -15\0a\00")
+
+	(func $if_test (export "if_test") (result i64)
+		(local $a.0 i64)
+		(local $b.1 i64)
+		(local $%6 i64)
+		(local $%10 i64)
+		(local $%12 i64)
+		(local $%20 i64)
+		(local $%22 i64)
+		(local $%25 i64)
+		(local $%27 i64)
+		block $block1048583
+			i64.const 10
+			local.set $a.0
+			i64.const -15
+			local.set $b.1
+			local.get $a.0
+			local.set $%6
+			i32.const 152
+			local.get $%6
+			i64.store
+			i32.const 0
+			i32.const 152
+			call $printf
+			drop
+			local.get $a.0
+			local.set $%10
+			local.get $%10
+			i64.const 10
+			i64.sub
+			local.set $%12
+			local.get $%12
+			i64.const 0
+			i64.eq
+			if
+				i32.const 18
+				i32.const 152
+				call $printf
+				drop
+				br $block1048583
+			else
+				br $block1048583
+			end
+		end
+		block $block1048588
+			local.get $a.0
+			local.set $%20
+			local.get $%20
+			i64.const 0
+			i64.sub
+			local.set $%22
+			local.get $%22
+			i64.const 0
+			i64.gt_s
+			if
+				local.get $b.1
+				local.set $%25
+				local.get $%25
+				i64.const -15
+				i64.sub
+				local.set $%27
+				local.get $%27
+				i64.const 0
+				i64.gt_s
+				if
+					i32.const 53
+					i32.const 152
+					call $printf
+					drop
+					br $block1048588
+				else
+					i32.const 110
+					i32.const 152
+					call $printf
+					drop
+					br $block1048588
+				end
+			else
+				br $block1048588
+			end
+		end
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $if_test))
+)
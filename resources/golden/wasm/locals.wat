@@ -0,0 +1,63 @@
+;; locals.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(global $i (mut i64) (i64.const 0))
+	(global $j (mut i64) (i64.const 0))
+	(global $k (mut i64) (i64.const 0))
+	(data (i32.const 0) "Inner a is \00")
+	(data (i32.const 12) "Inner a is  %lld\0a\00")
+	(data (i32.const 30) "Outer a is \00")
+	(data (i32.const 42) "Outer a is  %lld\0a\00")
+	(data (i32.const 60) "Global k is \00")
+	(data (i32.const 73) "Global k is  %lld\0a\00")
+
+	(func $nesting_scopes (export "nesting_scopes") (param $x i64) (param $y i64) (param $z i64) (result i64)
+		(local $a.0 i64)
+		(local $b.1 i64)
+		(local $c.2 i64)
+		(local $d.3 i64)
+		(local $e.4 i64)
+		(local $f.5 i64)
+		(local $a.6 i64)
+		(local $b.7 i64)
+		(local $%16 i64)
+		(local $%21 i64)
+		(local $%26 i64)
+		i64.const 21
+		local.set $a.0
+		i64.const 42
+		local.set $a.6
+		local.get $a.6
+		local.set $%16
+		i32.const 96
+		local.get $%16
+		i64.store
+		i32.const 12
+		i32.const 96
+		call $printf
+		drop
+		local.get $a.0
+		local.set $%21
+		i32.const 96
+		local.get $%21
+		i64.store
+		i32.const 42
+		i32.const 96
+		call $printf
+		drop
+		global.get $k
+		local.set $%26
+		i32.const 96
+		local.get $%26
+		i64.store
+		i32.const 73
+		i32.const 96
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $nesting_scopes))
+)
@@ -0,0 +1,372 @@
+;; multi_hello.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(global $w (mut i64) (i64.const 0))
+	(global $x (mut i64) (i64.const 0))
+	(global $y (mut i64) (i64.const 0))
+	(global $z (mut i64) (i64.const 0))
+	(data (i32.const 0) "Hello,\00")
+	(data (i32.const 7) "world!\00")
+	(data (i32.const 14) "Hello, world!\0a\00")
+	(data (i32.const 29) "How\00")
+	(data (i32.const 33) "are\00")
+	(data (i32.const 37) "you?\00")
+	(data (i32.const 42) "How are you?\0a\00")
+	(data (i32.const 56) "%lld %lld %lld\0a\00")
+	(data (i32.const 72) "x + y :=\00")
+	(data (i32.const 81) "x + y := %lld\0a\00")
+	(data (i32.const 96) "x - y :=\00")
+	(data (i32.const 105) "x - y := %lld\0a\00")
+	(data (i32.const 120) "x * y :=\00")
+	(data (i32.const 129) "x * y := %lld\0a\00")
+	(data (i32.const 144) "x / y :=\00")
+	(data (i32.const 153) "x / y := %lld\0a\00")
+	(data (i32.const 168) "%lld\0a\00")
+	(data (i32.const 174) "%f %f %f\0a\00")
+	(data (i32.const 184) "%f %f %f %f %f %f %f %f\0a\00")
+	(data (i32.const 209) "Morna\00")
+	(data (i32.const 215) "Morna\0a\00")
+
+	(func $hello (export "hello") (result i64)
+		(local $%21 i64)
+		(local $%22 i64)
+		(local $%23 i64)
+		(local $%24 i64)
+		(local $%25 i64)
+		(local $%26 i64)
+		(local $%27 i64)
+		(local $%30 i64)
+		(local $%31 i64)
+		(local $%32 i64)
+		(local $%37 i64)
+		(local $%38 i64)
+		(local $%39 i64)
+		(local $%44 i64)
+		(local $%45 i64)
+		(local $%46 i64)
+		(local $%51 i64)
+		(local $%52 i64)
+		(local $%53 i64)
+		(local $%65 f64)
+		(local $%66 f64)
+		(local $%67 f64)
+		(local $%68 f64)
+		(local $%69 f64)
+		(local $%70 f64)
+		(local $%71 f64)
+		(local $%72 f64)
+		(local $%73 i64)
+		(local $%74 i64)
+		(local $%76 i64)
+		i32.const 14
+		i32.const 224
+		call $printf
+		drop
+		i32.const 42
+		i32.const 224
+		call $printf
+		drop
+		i32.const 224
+		i64.const 42
+		i64.store
+		i32.const 232
+		i64.const 43
+		i64.store
+		i32.const 240
+		i64.const 44
+		i64.store
+		i32.const 56
+		i32.const 224
+		call $printf
+		drop
+		i64.const 30
+		global.set $x
+		i64.const -15
+		global.set $y
+		global.get $x
+		local.set $%21
+		global.get $x
+		local.set $%22
+		local.get $%21
+		local.get $%22
+		i64.mul
+		local.set $%23
+		global.get $y
+		local.set $%24
+		global.get $y
+		local.set $%25
+		local.get $%24
+		local.get $%25
+		i64.mul
+		local.set $%26
+		local.get $%23
+		local.get $%26
+		i64.add
+		local.set $%27
+		local.get $%27
+		global.set $z
+		global.get $x
+		local.set $%30
+		global.get $y
+		local.set $%31
+		local.get $%30
+		local.get $%31
+		i64.add
+		local.set $%32
+		i32.const 224
+		local.get $%32
+		i64.store
+		i32.const 81
+		i32.const 224
+		call $printf
+		drop
+		global.get $x
+		local.set $%37
+		global.get $y
+		local.set $%38
+		local.get $%37
+		local.get $%38
+		i64.sub
+		local.set $%39
+		i32.const 224
+		local.get $%39
+		i64.store
+		i32.const 105
+		i32.const 224
+		call $printf
+		drop
+		global.get $x
+		local.set $%44
+		global.get $y
+		local.set $%45
+		local.get $%44
+		local.get $%45
+		i64.mul
+		local.set $%46
+		i32.const 224
+		local.get $%46
+		i64.store
+		i32.const 129
+		i32.const 224
+		call $printf
+		drop
+		global.get $x
+		local.set $%51
+		global.get $y
+		local.set $%52
+		local.get $%51
+		local.get $%52
+		i64.div_s
+		local.set $%53
+		i32.const 224
+		local.get $%53
+		i64.store
+		i32.const 153
+		i32.const 224
+		call $printf
+		drop
+		i64.const 1
+		f64.convert_i64_s
+		local.set $%65
+		i64.const 2
+		f64.convert_i64_s
+		local.set $%66
+		i64.const 3
+		f64.convert_i64_s
+		local.set $%67
+		i64.const 4
+		f64.convert_i64_s
+		local.set $%68
+		i64.const 5
+		f64.convert_i64_s
+		local.set $%69
+		i64.const 6
+		f64.convert_i64_s
+		local.set $%70
+		i64.const 7
+		f64.convert_i64_s
+		local.set $%71
+		i64.const 8
+		f64.convert_i64_s
+		local.set $%72
+		local.get $%65
+		local.get $%66
+		local.get $%67
+		local.get $%68
+		local.get $%69
+		local.get $%70
+		local.get $%71
+		local.get $%72
+		call $goodbye
+		local.set $%73
+		local.get $%73
+		local.set $%74
+		local.get $%74
+		global.set $w
+		global.get $w
+		local.set $%76
+		i32.const 224
+		local.get $%76
+		i64.store
+		i32.const 168
+		i32.const 224
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $tralala (export "tralala") (param $wang f64) (result i64)
+		(local $x.0 f64)
+		(local $y.1 f64)
+		(local $z.2 f64)
+		(local $w.3 f64)
+		(local $%6 f64)
+		(local $%9 f64)
+		(local $%12 f64)
+		(local $%15 f64)
+		(local $%18 f64)
+		(local $%20 f64)
+		(local $%21 f64)
+		(local $%22 f64)
+		(local $%23 f64)
+		(local $%24 f64)
+		(local $%25 f64)
+		(local $%26 f64)
+		i64.const 3
+		f64.convert_i64_s
+		local.set $%6
+		local.get $%6
+		local.set $x.0
+		i64.const 5
+		f64.convert_i64_s
+		local.set $%9
+		local.get $%9
+		local.set $y.1
+		i64.const 2
+		f64.convert_i64_s
+		local.set $%12
+		local.get $%12
+		local.set $z.2
+		i64.const 4
+		f64.convert_i64_s
+		local.set $%15
+		local.get $%15
+		local.set $w.3
+		i64.const 42
+		f64.convert_i64_s
+		local.set $%18
+		local.get $%18
+		local.set $wang
+		local.get $wang
+		local.set $%20
+		local.get $x.0
+		local.set $%21
+		local.get $y.1
+		local.set $%22
+		local.get $%21
+		local.get $%22
+		f64.mul
+		local.set $%23
+		local.get $z.2
+		local.set $%24
+		local.get $w.3
+		local.set $%25
+		local.get $%24
+		local.get $%25
+		f64.mul
+		local.set $%26
+		i32.const 224
+		local.get $%20
+		f64.store
+		i32.const 232
+		local.get $%23
+		f64.store
+		i32.const 240
+		local.get $%26
+		f64.store
+		i32.const 174
+		i32.const 224
+		call $printf
+		drop
+		i64.const 1
+		return
+		unreachable
+	)
+
+	(func $goodbye (export "goodbye") (param $a f64) (param $b f64) (param $c f64) (param $d f64) (param $e f64) (param $f f64) (param $g f64) (param $h f64) (result i64)
+		(local $%8 f64)
+		(local $%9 f64)
+		(local $%10 f64)
+		(local $%11 f64)
+		(local $%12 f64)
+		(local $%13 f64)
+		(local $%14 f64)
+		(local $%15 f64)
+		(local $%24 f64)
+		(local $%25 i64)
+		(local $%26 i64)
+		local.get $a
+		local.set $%8
+		local.get $b
+		local.set $%9
+		local.get $c
+		local.set $%10
+		local.get $d
+		local.set $%11
+		local.get $e
+		local.set $%12
+		local.get $f
+		local.set $%13
+		local.get $g
+		local.set $%14
+		local.get $h
+		local.set $%15
+		i32.const 224
+		local.get $%8
+		f64.store
+		i32.const 232
+		local.get $%9
+		f64.store
+		i32.const 240
+		local.get $%10
+		f64.store
+		i32.const 248
+		local.get $%11
+		f64.store
+		i32.const 256
+		local.get $%12
+		f64.store
+		i32.const 264
+		local.get $%13
+		f64.store
+		i32.const 272
+		local.get $%14
+		f64.store
+		i32.const 280
+		local.get $%15
+		f64.store
+		i32.const 184
+		i32.const 224
+		call $printf
+		drop
+		i32.const 215
+		i32.const 224
+		call $printf
+		drop
+		i64.const 1
+		f64.convert_i64_s
+		local.set $%24
+		local.get $%24
+		call $tralala
+		local.set $%25
+		local.get $%25
+		local.set $%26
+		local.get $%26
+		return
+		unreachable
+	)
+	(export "main" (func $hello))
+)
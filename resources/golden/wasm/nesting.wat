@@ -0,0 +1,119 @@
+;; nesting.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "Hello, world!\00")
+	(data (i32.const 14) "Hello, world!\0a\00")
+	(data (i32.const 29) "x:=\00")
+	(data (i32.const 33) "x:= %lld\0a\00")
+	(data (i32.const 43) "Outer scope has a:=\00")
+	(data (i32.const 63) "Outer scope has a:= %lld\0a\00")
+	(data (i32.const 89) "I have a:=\00")
+	(data (i32.const 100) "and b:=\00")
+	(data (i32.const 108) "I have a:= %lld and b:= %lld\0a\00")
+	(data (i32.const 138) "B was reassigned to \00")
+	(data (i32.const 159) "in inner\00")
+	(data (i32.const 168) "B was reassigned to  %lld in inner\0a\00")
+
+	(func $hello (export "hello") (result i64)
+		(local $x.0 i64)
+		(local $%6 i64)
+		(local $%7 i64)
+		(local $%10 i64)
+		i32.const 14
+		i32.const 208
+		call $printf
+		drop
+		i64.const 42
+		call $test_me
+		local.set $%6
+		local.get $%6
+		local.set $%7
+		local.get $%7
+		local.set $x.0
+		local.get $x.0
+		local.set $%10
+		i32.const 208
+		local.get $%10
+		i64.store
+		i32.const 33
+		i32.const 208
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $test_me (export "test_me") (param $a i64) (result i64)
+		(local $a.0 i64)
+		(local $b.1 i64)
+		(local $a.2 i64)
+		(local $%5 i64)
+		(local $%16 i64)
+		(local $%18 i64)
+		(local $%25 i64)
+		(local $%31 i64)
+		(local $%35 i64)
+		(local $%37 i64)
+		i64.const 32
+		local.set $a.0
+		local.get $a.0
+		local.set $%5
+		i32.const 208
+		local.get $%5
+		i64.store
+		i32.const 63
+		i32.const 208
+		call $printf
+		drop
+		i64.const 64
+		local.set $a.2
+		i64.const 27
+		local.set $b.1
+		local.get $a.2
+		local.set $%16
+		local.get $b.1
+		local.set $%18
+		i32.const 208
+		local.get $%16
+		i64.store
+		i32.const 216
+		local.get $%18
+		i64.store
+		i32.const 108
+		i32.const 208
+		call $printf
+		drop
+		i64.const 128
+		local.set $b.1
+		local.get $b.1
+		local.set $%25
+		i32.const 208
+		local.get $%25
+		i64.store
+		i32.const 168
+		i32.const 208
+		call $printf
+		drop
+		local.get $a.0
+		local.set $%31
+		i32.const 208
+		local.get $%31
+		i64.store
+		i32.const 63
+		i32.const 208
+		call $printf
+		drop
+		local.get $a
+		local.set $%35
+		local.get $%35
+		i64.const 1
+		i64.add
+		local.set $%37
+		local.get $%37
+		return
+		unreachable
+	)
+	(export "main" (func $hello))
+)
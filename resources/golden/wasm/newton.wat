@@ -0,0 +1,160 @@
+;; newton.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(global $x (mut i64) (i64.const 0))
+	(global $y (mut i64) (i64.const 0))
+	(global $z (mut i64) (i64.const 0))
+	(global $a (mut i64) (i64.const 0))
+	(global $b (mut i64) (i64.const 0))
+	(global $c (mut i64) (i64.const 0))
+	(data (i32.const 0) "The square root of\00")
+	(data (i32.const 19) "is\00")
+	(data (i32.const 22) "The square root of %lld is %lld\0a\00")
+
+	(func $newton (export "newton") (param $n i64) (result i64)
+		(local $square_root.0 i64)
+		(local $%2 i64)
+		(local $%4 i64)
+		(local $%5 i64)
+		(local $%8 i64)
+		(local $%10 i64)
+		local.get $n
+		local.set $%2
+		local.get $%2
+		i64.const 1
+		call $improve
+		local.set $%4
+		local.get $%4
+		local.set $%5
+		local.get $%5
+		local.set $square_root.0
+		local.get $n
+		local.set $%8
+		local.get $square_root.0
+		local.set $%10
+		i32.const 56
+		local.get $%8
+		i64.store
+		i32.const 64
+		local.get $%10
+		i64.store
+		i32.const 22
+		i32.const 56
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $improve (export "improve") (param $n i64) (param $estimate i64) (result i64)
+		(local $next.0 i64)
+		(local $%3 i64)
+		(local $%4 i64)
+		(local $%5 i64)
+		(local $%6 i64)
+		(local $%7 i64)
+		(local $%8 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%12 i64)
+		(local $%13 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%17 i64)
+		(local $%19 i64)
+		(local $%22 i64)
+		(local $%24 i64)
+		(local $%26 i64)
+		(local $%27 i64)
+		(local $%28 i64)
+		(local $%29 i64)
+		local.get $estimate
+		local.set $%3
+		local.get $estimate
+		local.set $%4
+		local.get $estimate
+		local.set $%5
+		local.get $%4
+		local.get $%5
+		i64.mul
+		local.set $%6
+		local.get $n
+		local.set $%7
+		local.get $%6
+		local.get $%7
+		i64.sub
+		local.set $%8
+		local.get $estimate
+		local.set $%10
+		i64.const 2
+		local.get $%10
+		i64.mul
+		local.set $%11
+		local.get $%8
+		local.get $%11
+		i64.div_s
+		local.set $%12
+		local.get $%3
+		local.get $%12
+		i64.sub
+		local.set $%13
+		local.get $%13
+		local.set $next.0
+		local.get $next.0
+		local.set $%15
+		local.get $estimate
+		local.set $%16
+		local.get $%15
+		local.get $%16
+		i64.sub
+		local.set $%17
+		local.get $%17
+		i64.const 0
+		i64.sub
+		local.set $%19
+		local.get $%19
+		i64.const 0
+		i64.eq
+		if
+			local.get $next.0
+			local.set $%22
+			local.get $%22
+			i64.const 1
+			i64.sub
+			local.set $%24
+			local.get $%24
+			return
+		else
+			local.get $n
+			local.set $%26
+			local.get $next.0
+			local.set $%27
+			local.get $%26
+			local.get $%27
+			call $improve
+			local.set $%28
+			local.get $%28
+			local.set $%29
+			local.get $%29
+			return
+		end
+		unreachable
+	)
+
+	(func $fourty_two (export "fourty_two") (param $x i64) (result i64)
+		(local $%1 i64)
+		(local $%3 i64)
+		local.get $x
+		local.set $%1
+		local.get $%1
+		i64.const 42
+		i64.add
+		local.set $%3
+		local.get $%3
+		return
+		unreachable
+	)
+	(export "main" (func $newton))
+)
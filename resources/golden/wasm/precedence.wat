@@ -0,0 +1,88 @@
+;; precedence.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "2*(3-1) := \00")
+	(data (i32.const 12) "2*(3-1) :=  %lld\0a\00")
+	(data (i32.const 30) "2*3-1 := \00")
+	(data (i32.const 40) "2*3-1 :=  %lld\0a\00")
+
+	(func $precedence (export "precedence") (result i64)
+		(local $a.0 i64)
+		(local $b.1 i64)
+		(local $c.2 i64)
+		(local $d.3 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%12 i64)
+		(local $%13 i64)
+		(local $%14 i64)
+		(local $%17 i64)
+		(local $%21 i64)
+		(local $%22 i64)
+		(local $%23 i64)
+		(local $%24 i64)
+		(local $%25 i64)
+		(local $%28 i64)
+		i64.const 2
+		local.set $a.0
+		i64.const 3
+		local.set $b.1
+		i64.const 1
+		local.set $c.2
+		local.get $a.0
+		local.set $%10
+		local.get $b.1
+		local.set $%11
+		local.get $c.2
+		local.set $%12
+		local.get $%11
+		local.get $%12
+		i64.sub
+		local.set $%13
+		local.get $%10
+		local.get $%13
+		i64.mul
+		local.set $%14
+		local.get $%14
+		local.set $d.3
+		local.get $d.3
+		local.set $%17
+		i32.const 56
+		local.get $%17
+		i64.store
+		i32.const 12
+		i32.const 56
+		call $printf
+		drop
+		local.get $a.0
+		local.set $%21
+		local.get $b.1
+		local.set $%22
+		local.get $%21
+		local.get $%22
+		i64.mul
+		local.set $%23
+		local.get $c.2
+		local.set $%24
+		local.get $%23
+		local.get $%24
+		i64.sub
+		local.set $%25
+		local.get $%25
+		local.set $d.3
+		local.get $d.3
+		local.set $%28
+		i32.const 56
+		local.get $%28
+		i64.store
+		i32.const 40
+		i32.const 56
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $precedence))
+)
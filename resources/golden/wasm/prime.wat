@@ -0,0 +1,148 @@
+;; prime.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "is a prime factor\00")
+	(data (i32.const 18) "%lld is a prime factor\0a\00")
+
+	(func $mainfunc (export "mainfunc") (result i64)
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $factor (export "factor") (param $n i64) (result i64)
+		(local $f.0 i64)
+		(local $r.1 i64)
+		(local $%2 i64)
+		(local $%4 i64)
+		(local $%7 i64)
+		(local $%8 i64)
+		(local $%9 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%12 i64)
+		(local $%13 i64)
+		(local $%15 i64)
+		(local $%18 i64)
+		(local $%20 i64)
+		(local $%23 i64)
+		(local $%25 i64)
+		(local $%27 i64)
+		(local $%31 i64)
+		(local $%32 i64)
+		(local $%33 i64)
+		(local $%35 i64)
+		(local $%36 i64)
+		(local $%37 i64)
+		(local $%38 i64)
+		(local $%39 i64)
+		(local $%42 i64)
+		local.get $n
+		local.set $%2
+		local.get $%2
+		i64.const 2
+		i64.div_s
+		local.set $%4
+		local.get $%4
+		local.set $f.0
+		loop $block1048582.loop
+			local.get $n
+			local.set $%7
+			local.get $f.0
+			local.set $%8
+			local.get $n
+			local.set $%9
+			local.get $f.0
+			local.set $%10
+			local.get $%9
+			local.get $%10
+			i64.div_s
+			local.set $%11
+			local.get $%8
+			local.get $%11
+			i64.mul
+			local.set $%12
+			local.get $%7
+			local.get $%12
+			i64.sub
+			local.set $%13
+			local.get $%13
+			i64.const 0
+			i64.sub
+			local.set $%15
+			local.get $%15
+			i64.const 0
+			i64.gt_s
+			if
+				local.get $f.0
+				local.set $%18
+				local.get $%18
+				i64.const 1
+				i64.sub
+				local.set $%20
+				local.get $%20
+				local.set $f.0
+				br $block1048582.loop
+			else
+				block $block1048588
+					local.get $f.0
+					local.set $%23
+					local.get $%23
+					i64.const 1
+					i64.sub
+					local.set $%25
+					local.get $%25
+					i64.const 0
+					i64.sub
+					local.set $%27
+					local.get $%27
+					i64.const 0
+					i64.gt_s
+					if
+						local.get $f.0
+						local.set $%31
+						local.get $%31
+						call $factor
+						local.set $%32
+						local.get $%32
+						local.set $%33
+						local.get $%33
+						local.set $r.1
+						local.get $n
+						local.set $%35
+						local.get $f.0
+						local.set $%36
+						local.get $%35
+						local.get $%36
+						i64.div_s
+						local.set $%37
+						local.get $%37
+						call $factor
+						local.set $%38
+						local.get $%38
+						local.set $%39
+						local.get $%39
+						local.set $r.1
+						br $block1048588
+					else
+						local.get $n
+						local.set $%42
+						i32.const 48
+						local.get $%42
+						i64.store
+						i32.const 18
+						i32.const 48
+						call $printf
+						drop
+						br $block1048588
+					end
+				end
+				i64.const 0
+				return
+			end
+		end
+		unreachable
+	)
+	(export "main" (func $mainfunc))
+)
@@ -0,0 +1,54 @@
+;; return_nested.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "t is\00")
+	(data (i32.const 5) "t is %lld\0a\00")
+
+	(func $hello (export "hello") (result i64)
+		(local $%2 i64)
+		(local $%3 i64)
+		i64.const 64
+		call $test
+		local.set $%2
+		local.get $%2
+		local.set $%3
+		i32.const 16
+		local.get $%3
+		i64.store
+		i32.const 5
+		i32.const 16
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $test (export "test") (param $a i64) (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $x.2 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%12 i64)
+		i64.const 32
+		local.set $x.0
+		i64.const 20
+		local.set $y.1
+		i64.const 64
+		local.set $x.2
+		local.get $x.2
+		local.set $%10
+		local.get $a
+		local.set $%11
+		local.get $%10
+		local.get $%11
+		i64.add
+		local.set $%12
+		local.get $%12
+		return
+		unreachable
+	)
+	(export "main" (func $hello))
+)
@@ -0,0 +1,48 @@
+;; simplefun.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "Parameter s is\00")
+	(data (i32.const 15) "t is \00")
+	(data (i32.const 21) "Parameter s is %lld t is  %lld\0a\00")
+
+	(func $defall (export "defall") (result i64)
+		(local $x.0 i64)
+		(local $%3 i64)
+		(local $%4 i64)
+		i64.const 5
+		i64.const 10
+		call $my_deftion
+		local.set $%3
+		local.get $%3
+		local.set $%4
+		local.get $%4
+		local.set $x.0
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $my_deftion (export "my_deftion") (param $s i64) (param $t i64) (result i64)
+		(local $%3 i64)
+		(local $%5 i64)
+		local.get $s
+		local.set $%3
+		local.get $t
+		local.set $%5
+		i32.const 56
+		local.get $%3
+		i64.store
+		i32.const 64
+		local.get $%5
+		i64.store
+		i32.const 21
+		i32.const 56
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $defall))
+)
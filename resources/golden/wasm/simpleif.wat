@@ -0,0 +1,131 @@
+;; simpleif.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "%lld %lld %lld %lld %lld %lld %lld\0a\00")
+	(data (i32.const 36) "Equal!\00")
+	(data (i32.const 43) "Equal!\0a\00")
+	(data (i32.const 51) "%lld\0a\00")
+
+	(func $dingdong (export "dingdong") (param $a i64) (param $b i64) (param $c i64) (param $d i64) (param $e i64) (param $f i64) (param $g i64) (result i64)
+		(local $x.0 i64)
+		(local $%10 i64)
+		(local $%11 i64)
+		(local $%12 i64)
+		(local $%13 i64)
+		(local $%14 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%20 i64)
+		(local $%22 i64)
+		(local $%36 i64)
+		(local $%38 i64)
+		(local $%41 i64)
+		(local $%45 i64)
+		(local $%47 i64)
+		(local $%50 i64)
+		block $block1048589
+			i64.const 42
+			local.set $x.0
+			local.get $a
+			local.set $%10
+			local.get $b
+			local.set $%11
+			local.get $c
+			local.set $%12
+			local.get $d
+			local.set $%13
+			local.get $e
+			local.set $%14
+			local.get $f
+			local.set $%15
+			local.get $g
+			local.set $%16
+			i32.const 64
+			local.get $%10
+			i64.store
+			i32.const 72
+			local.get $%11
+			i64.store
+			i32.const 80
+			local.get $%12
+			i64.store
+			i32.const 88
+			local.get $%13
+			i64.store
+			i32.const 96
+			local.get $%14
+			i64.store
+			i32.const 104
+			local.get $%15
+			i64.store
+			i32.const 112
+			local.get $%16
+			i64.store
+			i32.const 0
+			i32.const 64
+			call $printf
+			drop
+			local.get $x.0
+			local.set $%20
+			local.get $%20
+			i64.const 42
+			i64.sub
+			local.set $%22
+			local.get $%22
+			i64.const 0
+			i64.eq
+			if
+				i32.const 43
+				i32.const 64
+				call $printf
+				drop
+				i64.const 43
+				local.set $x.0
+				br $block1048589
+			else
+				i64.const 44
+				local.set $x.0
+				br $block1048589
+			end
+		end
+		loop $block1048589.loop
+			local.get $x.0
+			local.set $%36
+			local.get $%36
+			i64.const 0
+			i64.sub
+			local.set $%38
+			local.get $%38
+			i64.const 0
+			i64.gt_s
+			if
+				local.get $x.0
+				local.set $%41
+				i32.const 64
+				local.get $%41
+				i64.store
+				i32.const 51
+				i32.const 64
+				call $printf
+				drop
+				local.get $x.0
+				local.set $%45
+				local.get $%45
+				i64.const 1
+				i64.sub
+				local.set $%47
+				local.get $%47
+				local.set $x.0
+				br $block1048589.loop
+			else
+				local.get $x.0
+				local.set $%50
+				local.get $%50
+				return
+			end
+		end
+		unreachable
+	)
+	(export "main" (func $dingdong))
+)
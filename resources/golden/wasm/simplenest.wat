@@ -0,0 +1,109 @@
+;; simplenest.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "Outer x is\00")
+	(data (i32.const 11) "y is\00")
+	(data (i32.const 16) "parm is\00")
+	(data (i32.const 24) "Outer x is %lld y is %lld parm is %lld\0a\00")
+	(data (i32.const 64) "Inner x is\00")
+	(data (i32.const 75) "Inner x is %lld y is %lld parm is %lld\0a\00")
+
+	(func $hello (export "hello") (result i64)
+		(local $t.0 i64)
+		(local $%2 i64)
+		(local $%3 i64)
+		i64.const 42
+		call $test
+		local.set $%2
+		local.get $%2
+		local.set $%3
+		local.get $%3
+		local.set $t.0
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $test (export "test") (param $a i64) (result i64)
+		(local $x.0 i64)
+		(local $y.1 i64)
+		(local $x.2 i64)
+		(local $%8 i64)
+		(local $%10 i64)
+		(local $%12 i64)
+		(local $%20 i64)
+		(local $%22 i64)
+		(local $%24 i64)
+		(local $%29 i64)
+		(local $%31 i64)
+		(local $%33 i64)
+		i64.const 32
+		local.set $x.0
+		i64.const 20
+		local.set $y.1
+		local.get $x.0
+		local.set $%8
+		local.get $y.1
+		local.set $%10
+		local.get $a
+		local.set $%12
+		i32.const 120
+		local.get $%8
+		i64.store
+		i32.const 128
+		local.get $%10
+		i64.store
+		i32.const 136
+		local.get $%12
+		i64.store
+		i32.const 24
+		i32.const 120
+		call $printf
+		drop
+		i64.const 64
+		local.set $x.2
+		local.get $x.2
+		local.set $%20
+		local.get $y.1
+		local.set $%22
+		local.get $a
+		local.set $%24
+		i32.const 120
+		local.get $%20
+		i64.store
+		i32.const 128
+		local.get $%22
+		i64.store
+		i32.const 136
+		local.get $%24
+		i64.store
+		i32.const 75
+		i32.const 120
+		call $printf
+		drop
+		local.get $x.0
+		local.set $%29
+		local.get $y.1
+		local.set $%31
+		local.get $a
+		local.set $%33
+		i32.const 120
+		local.get $%29
+		i64.store
+		i32.const 128
+		local.get $%31
+		i64.store
+		i32.const 136
+		local.get $%33
+		i64.store
+		i32.const 24
+		i32.const 120
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $hello))
+)
@@ -0,0 +1,129 @@
+;; simplify.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "%lld %lld %lld\0a\00")
+
+	(func $f (export "f") (result i64)
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $g (export "g") (param $a i64) (param $b i64) (param $c i64) (result i64)
+		(local $u.0 i64)
+		(local $v.1 i64)
+		(local $w.2 i64)
+		(local $x.3 i64)
+		(local $y.4 i64)
+		(local $z.5 i64)
+		(local $%13 i64)
+		(local $%15 i64)
+		(local $%16 i64)
+		(local $%18 i64)
+		(local $%19 i64)
+		(local $%21 i64)
+		(local $%22 i64)
+		(local $%23 i64)
+		(local $%25 i64)
+		(local $%26 i64)
+		(local $%27 i64)
+		i64.const 1
+		local.set $u.0
+		i64.const 2
+		local.set $v.1
+		local.get $x.3
+		local.set $%13
+		local.get $%13
+		i64.const 1
+		i64.add
+		local.set $%15
+		local.get $y.4
+		local.set $%16
+		local.get $%16
+		i64.const 2
+		i64.add
+		local.set $%18
+		local.get $z.5
+		local.set $%19
+		local.get $%19
+		i64.const 3
+		i64.add
+		local.set $%21
+		local.get $%15
+		local.get $%18
+		local.get $%21
+		call $g
+		local.set $%22
+		local.get $%22
+		local.set $%23
+		local.get $%23
+		local.set $w.2
+		local.get $u.0
+		local.set $%25
+		local.get $v.1
+		local.set $%26
+		local.get $w.2
+		local.set $%27
+		i32.const 16
+		local.get $%25
+		i64.store
+		i32.const 24
+		local.get $%26
+		i64.store
+		i32.const 32
+		local.get $%27
+		i64.store
+		i32.const 0
+		i32.const 16
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+
+	(func $h (export "h") (param $a i64) (param $b i64) (result i64)
+		(local $x.0 f64)
+		(local $%4 f64)
+		(local $%7 f64)
+		(local $%10 f64)
+		(local $%13 f64)
+		(local $%16 f64)
+		(local $%19 f64)
+		i64.const 5
+		f64.convert_i64_s
+		local.set $%4
+		local.get $%4
+		local.set $x.0
+		i64.const 1
+		f64.convert_i64_s
+		local.set $%7
+		local.get $%7
+		local.set $x.0
+		i64.const 4
+		f64.convert_i64_s
+		local.set $%10
+		local.get $%10
+		local.set $x.0
+		i64.const 2
+		f64.convert_i64_s
+		local.set $%13
+		local.get $%13
+		local.set $x.0
+		i64.const -6
+		f64.convert_i64_s
+		local.set $%16
+		local.get $%16
+		local.set $x.0
+		i64.const 4
+		f64.convert_i64_s
+		local.set $%19
+		local.get $%19
+		local.set $x.0
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $f))
+)
@@ -0,0 +1,18 @@
+;; trouble.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "wang\00")
+	(data (i32.const 5) "wang\0a\00")
+
+	(func $hello (export "hello") (param $wang i64) (result i64)
+		i32.const 5
+		i32.const 16
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $hello))
+)
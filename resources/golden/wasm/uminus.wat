@@ -0,0 +1,71 @@
+;; uminus.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "a is\00")
+	(data (i32.const 5) "and b is\00")
+	(data (i32.const 14) "a is %lld and b is %lld\0a\00")
+	(data (i32.const 39) "a/(-b) is\00")
+	(data (i32.const 49) "a/(-b) is %lld\0a\00")
+	(data (i32.const 65) "10/(-2) is\00")
+	(data (i32.const 76) "10/(-2) is %lld\0a\00")
+
+	(func $negatives (export "negatives") (result i64)
+		(local $a.0 i64)
+		(local $b.1 i64)
+		(local $%7 i64)
+		(local $%9 i64)
+		(local $%14 i64)
+		(local $%15 i64)
+		(local $%17 i64)
+		(local $%18 i64)
+		i64.const 100
+		local.set $a.0
+		i64.const 20
+		local.set $b.1
+		local.get $a.0
+		local.set $%7
+		local.get $b.1
+		local.set $%9
+		i32.const 96
+		local.get $%7
+		i64.store
+		i32.const 104
+		local.get $%9
+		i64.store
+		i32.const 14
+		i32.const 96
+		call $printf
+		drop
+		local.get $a.0
+		local.set $%14
+		local.get $b.1
+		local.set $%15
+		i64.const 0
+		local.get $%15
+		i64.sub
+		local.set $%17
+		local.get $%14
+		local.get $%17
+		i64.div_s
+		local.set $%18
+		i32.const 96
+		local.get $%18
+		i64.store
+		i32.const 49
+		i32.const 96
+		call $printf
+		drop
+		i32.const 96
+		i64.const -5
+		i64.store
+		i32.const 76
+		i32.const 96
+		call $printf
+		drop
+		i64.const 0
+		return
+		unreachable
+	)
+	(export "main" (func $negatives))
+)
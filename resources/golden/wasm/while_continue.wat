@@ -0,0 +1,118 @@
+;; while_continue.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "a\00")
+	(data (i32.const 2) "b\00")
+	(data (i32.const 4) "a %lld b %lld\0a\00")
+	(data (i32.const 19) "unreachable\00")
+	(data (i32.const 31) "unreachable\0a\00")
+	(data (i32.const 44) "done\00")
+	(data (i32.const 49) "done\0a\00")
+
+	(func $while_continue (export "while_continue") (result i64)
+		(local $a.0 i64)
+		(local $b.1 i64)
+		(local $%5 i64)
+		(local $%7 i64)
+		(local $%10 i64)
+		(local $%12 i64)
+		(local $%17 i64)
+		(local $%19 i64)
+		(local $%22 i64)
+		(local $%24 i64)
+		(local $%28 i64)
+		(local $%30 i64)
+		(local $%34 i64)
+		(local $%36 i64)
+		i64.const 0
+		local.set $a.0
+		loop $block1048579.loop
+			local.get $a.0
+			local.set $%5
+			local.get $%5
+			i64.const 3
+			i64.sub
+			local.set $%7
+			local.get $%7
+			i64.const 0
+			i64.lt_s
+			if
+				local.get $a.0
+				local.set $%10
+				local.get $%10
+				i64.const 1
+				i64.add
+				local.set $%12
+				local.get $%12
+				local.set $a.0
+				i64.const 0
+				local.set $b.1
+				loop $block1048584.loop
+					local.get $b.1
+					local.set $%17
+					local.get $%17
+					i64.const 2
+					i64.sub
+					local.set $%19
+					local.get $%19
+					i64.const 0
+					i64.lt_s
+					if
+						local.get $b.1
+						local.set $%22
+						local.get $%22
+						i64.const 1
+						i64.add
+						local.set $%24
+						local.get $%24
+						local.set $b.1
+						br $block1048584.loop
+					else
+						local.get $a.0
+						local.set $%28
+						local.get $b.1
+						local.set $%30
+						i32.const 56
+						local.get $%28
+						i64.store
+						i32.const 64
+						local.get $%30
+						i64.store
+						i32.const 4
+						i32.const 56
+						call $printf
+						drop
+						local.get $a.0
+						local.set $%34
+						local.get $%34
+						i64.const 5
+						i64.sub
+						local.set $%36
+						local.get $%36
+						i64.const 0
+						i64.lt_s
+						if
+							br $block1048579.loop
+						else
+							i32.const 31
+							i32.const 56
+							call $printf
+							drop
+							br $block1048579.loop
+						end
+					end
+				end
+			else
+				i32.const 49
+				i32.const 56
+				call $printf
+				drop
+				i64.const 0
+				return
+			end
+		end
+		unreachable
+	)
+	(export "main" (func $while_continue))
+)
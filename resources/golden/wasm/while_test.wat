@@ -0,0 +1,118 @@
+;; while_test.vsl
+(module
+	(import "env" "printf" (func $printf (param i32 i32) (result i32)))
+	(memory (export "memory") 1)
+	(data (i32.const 0) "%lld\0a\00")
+	(data (i32.const 6) "foobar\00")
+	(data (i32.const 13) "foobar\0a\00")
+	(data (i32.const 21) "Skip...\00")
+	(data (i32.const 29) "Skip...\0a\00")
+
+	(func $while_test (export "while_test") (result i64)
+		(local $a.0 i64)
+		(local $%3 i64)
+		(local $%7 i64)
+		(local $%9 i64)
+		(local $%18 i64)
+		(local $%20 i64)
+		(local $%23 i64)
+		(local $%25 i64)
+		(local $%28 i64)
+		(local $%30 i64)
+		(local $%37 i64)
+		(local $%39 i64)
+		(local $%42 i64)
+		block $block1048586
+			i64.const 20
+			local.set $a.0
+			local.get $a.0
+			local.set $%3
+			i32.const 40
+			local.get $%3
+			i64.store
+			i32.const 0
+			i32.const 40
+			call $printf
+			drop
+			local.get $a.0
+			local.set $%7
+			local.get $%7
+			i64.const 0
+			i64.sub
+			local.set $%9
+			local.get $%9
+			i64.const 0
+			i64.gt_s
+			if
+				i32.const 13
+				i32.const 40
+				call $printf
+				drop
+				br $block1048586
+			else
+				br $block1048586
+			end
+		end
+		loop $block1048586.loop
+			local.get $a.0
+			local.set $%18
+			local.get $%18
+			i64.const 0
+			i64.sub
+			local.set $%20
+			local.get $%20
+			i64.const 0
+			i64.gt_s
+			if
+				local.get $a.0
+				local.set $%23
+				local.get $%23
+				i64.const 10
+				i64.sub
+				local.set $%25
+				local.get $%25
+				i64.const 0
+				i64.eq
+				if
+					local.get $a.0
+					local.set $%28
+					local.get $%28
+					i64.const 1
+					i64.sub
+					local.set $%30
+					local.get $%30
+					local.set $a.0
+					i32.const 29
+					i32.const 40
+					call $printf
+					drop
+					br $block1048586.loop
+				else
+					local.get $a.0
+					local.set $%37
+					local.get $%37
+					i64.const 1
+					i64.sub
+					local.set $%39
+					local.get $%39
+					local.set $a.0
+					local.get $a.0
+					local.set $%42
+					i32.const 40
+					local.get $%42
+					i64.store
+					i32.const 0
+					i32.const 40
+					call $printf
+					drop
+					br $block1048586.loop
+				end
+			else
+				i64.const 0
+				return
+			end
+		end
+		unreachable
+	)
+	(export "main" (func $while_test))
+)
@@ -0,0 +1,86 @@
+package backend
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Annotator writes the VSL source lines and the LIR instructions that assembler is generated from as comments above
+// the assembler. A nil Annotator writes nothing. Functions that are generated in parallel must use their own copy of
+// the Annotator, returned by Function.
+type Annotator struct {
+	lines   []string // lines holds the source code lines, or nil if the source code isn't available.
+	comment string   // comment starts a line comment of the target assembler, such as // or #.
+	line    int      // line is the source line that was annotated last.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// NewAnnotator returns an Annotator for assembler whose line comments start with comment, or nil if opt.Annotate
+// isn't set. Source lines are read from the source file opt.Src. Only line numbers are written if the source code was
+// read from stdin, or from several source files whose lines can't be told apart.
+func NewAnnotator(opt util.Options, comment string) *Annotator {
+	if !opt.Annotate {
+		return nil
+	}
+	a := &Annotator{comment: comment}
+	if len(opt.Src) > 0 && opt.Src != util.Stdin && len(opt.Srcs) < 2 {
+		if b, err := ioutil.ReadFile(opt.Src); err == nil {
+			a.lines = strings.Split(string(b), "\n")
+		}
+	}
+	return a
+}
+
+// Function returns a copy of the Annotator a, which annotates the first instruction of a new function with its
+// source line.
+func (a *Annotator) Function() *Annotator {
+	if a == nil {
+		return nil
+	}
+	res := *a
+	res.line = 0
+	return &res
+}
+
+// Annotate writes the comment lines returned by Lines to wr, indented by a tab.
+func (a *Annotator) Annotate(wr *util.Writer, v lir.Value) {
+	for _, e1 := range a.Lines(v) {
+		wr.Write("\t%s\n", e1)
+	}
+}
+
+// Lines returns the comment lines that annotate the LIR instruction v: its source line, if it's another line than
+// the previously annotated line, followed by v.
+func (a *Annotator) Lines(v lir.Value) []string {
+	if a == nil {
+		return nil
+	}
+	var res []string
+	if loc := v.Location(); loc.IsKnown() && loc.Line != a.line {
+		a.line = loc.Line
+		if loc.Line <= len(a.lines) {
+			res = append(res, fmt.Sprintf("%s %d: %s", a.comment, loc.Line, strings.TrimSpace(a.lines[loc.Line-1])))
+		} else {
+			res = append(res, fmt.Sprintf("%s %d:", a.comment, loc.Line))
+		}
+	}
+	return append(res, fmt.Sprintf("%s\t%s", a.comment, v.String()))
+}
@@ -6,11 +6,12 @@ import (
 	"fmt"
 	"math"
 	"path/filepath"
-	"sync"
 )
 
 import (
+	"vslc/src/backend"
 	"vslc/src/backend/regfile"
+	"vslc/src/backend/vslrt"
 	"vslc/src/ir"
 	"vslc/src/ir/lir"
 	"vslc/src/ir/lir/types"
@@ -29,6 +30,20 @@ type register struct {
 	use  int // Counter of when this register was used. Lower value means a longer time has passed since last this register was used.
 }
 
+// generator holds the configuration of the aarch64 assembler generated for one compilation, which is derived from its
+// util.Options.
+type generator struct {
+	darwin         bool               // Set to true if Apple's Mach-O assembler syntax is generated instead of ELF.
+	pic            bool               // Set to true if global data is addressed through the global offset table.
+	omitFP         bool               // Set to true if leaf functions without locals and spill slots don't save FP and LR.
+	optSize        bool               // Set to true if smaller code is preferred over faster code.
+	stackProtector bool               // Set to true if non-leaf functions check a canary below FP and LR before returning.
+	runtime        bool               // Set to true if main parses the command line arguments with the VSL runtime.
+	trapv          bool               // Set to true if checked integer operations branch to lir.LabelOverflow.
+	intBits        int                // Number of bits of integers. 32-bit integers are kept sign extended in registers.
+	annotator      *backend.Annotator // Writes the source lines and LIR instructions of functions as comments, if set.
+}
+
 // RegisterFile defines a virtual register file during compilation time. It holds 32 integer and 32 floating point
 // registers per aarch64 ABI.
 type RegisterFile struct {
@@ -43,6 +58,18 @@ type RegisterFile struct {
 const labelMain = "main"          // String literal of name of main function as defined in the output assembler.
 const labelConstant = "_L_CONST_" // String literal for all constants.
 
+const labelArgCount = "vsl.argc"  // Label of the word that holds argc for the builtin functions argc and arg.
+const labelArgVector = "vsl.argv" // Label of the word that holds argv for the builtin functions argc and arg.
+const labelArgEmpty = "vsl.empty" // Label of the empty string returned for program arguments out of range.
+
+// labelOverflowMsg is the label of the message written by the integer overflow handler lir.LabelOverflow.
+const labelOverflowMsg = "vsl.overflow.msg"
+
+const (
+	labelGuard     = "__stack_chk_guard" // labelGuard is the C library's stack protector canary.
+	labelGuardFail = "__stack_chk_fail"  // labelGuardFail is the C library's stack protector failure handler.
+)
+
 const (
 	i = types.Int   // i indicates integer type.
 	f = types.Float // f indicates floating point type.
@@ -55,6 +82,14 @@ const (
 	wordSize32 = 4  // Word size in bytes for 32-bit architecture.
 )
 
+// The aarch64 backend only generates code for the LP64 ABI, hence pointers, registers and stack slots are 64 bits wide
+// whatever the width of VSL integers.
+const (
+	wordSize  = wordSize64 // wordSize defines the word size of the aarch64 architecture in bytes.
+	bitSize   = bitSize64  // bitSize defines the bit size of the aarch64 architecture.
+	wordLabel = "xword"    // wordLabel defines the assembler directive of a word of the aarch64 architecture.
+)
+
 // stackAlign defines the stack alignment of the aarch64 stack. If the stack grows or shrinks, it must do so in
 // multiples of the stackAlign value.
 const stackAlign = 16 // Per chapter 5.2.2.1 of https://documentation-service.arm.com/static/5fa43415b1a7c5445f292563?token=
@@ -65,6 +100,14 @@ const paramReg = 8
 const minImm = -2048 // minImm defines the minimum 12-bit signed immediate value.
 const maxImm = 2047  // maxImm defines the maximum 12-bit signed immediate value.
 
+const maxAddImm = 4095 // maxAddImm defines the maximum 12-bit unsigned immediate value of add and sub.
+const addImmShift = 12 // addImmShift defines the optional left shift of the immediate value of add and sub.
+
+const (
+	halfwordSize = 16     // halfwordSize defines the number of bits moved by movz, movn and movk.
+	halfwordMask = 0xffff // halfwordMask defines the mask of the bits moved by movz, movn and movk.
+)
+
 // Integer general purpose registers.
 const (
 	r0 = iota
@@ -174,6 +217,13 @@ const (
 	store = "str"
 )
 
+// Scratch registers that hold spilled values while an instruction executes. The intra-procedure call registers
+// r16 and r17, and v30 and v31 are never allocated to virtual registers.
+var (
+	scratchi = [...]int{r16, r17}
+	scratchf = [...]int{v30, v31}
+)
+
 // -------------------
 // ----- Globals -----
 // -------------------
@@ -249,15 +299,6 @@ var regf = [...]string{
 	"d30",
 }
 
-// wordSize defines the word size of the aarch64 architecture to generate.
-var wordSize = wordSize64 // Default to 64-bit architecture.
-
-// bitSize defines the bit size of the aarch64 architecture to generate.
-var bitSize = bitSize64 // default to 64-bit architecture.
-
-// wordLabel defines the size of the architecture word. xword for 64-bit, word for 32-bit.
-var wordLabel = "xword"
-
 // ---------------------
 // ----- functions -----
 // ---------------------
@@ -265,63 +306,49 @@ var wordLabel = "xword"
 // GenArm recursively generates ARM v8 (aarch64) assembler code from the intermediate representation.
 func GenArm(opt util.Options, m *lir.Module, root *ir.Node) error {
 	// Generate .text section.
-	wr := util.NewWriter()
+	wr := opt.NewWriter()
 	defer wr.Close()
-	wr.Write("\t.arch\tarmv8-a\n")
-	wr.Write("\t.file\t%q\n", filepath.Base(opt.Src))
-	wr.Write("\t.text\n")
+	g := newGenerator(opt)
+	if g.darwin {
+		// Mach-O has no symbol types and no architecture directive.
+		wr.Write("\t.file\t%q\n", filepath.Base(opt.Src))
+		wr.Write("\t.text\n")
+		wr.Write("\t.globl\t%s\n", g.symbol(labelMain))
+	} else {
+		wr.Write("\t.arch\tarmv8-a\n")
+		wr.Write("\t.file\t%q\n", filepath.Base(opt.Src))
+		wr.Write("\t.text\n")
 
-	wr.Write("\t.global\t%s\n", labelMain)
-	wr.Write("\t.type\t%s, %%function\n", labelMain)
-	wr.Flush() // Write to top of output.
+		wr.Write("\t.global\t%s\n", labelMain)
+		wr.Write("\t.type\t%s, %%function\n", labelMain)
+	}
 
-	// Generate functions.
+	// Generate functions, and apply peephole optimisations to each of them.
+	ws := wr.Split(len(m.Functions()))
 	if opt.Threads > 1 {
-		// Parallel.
-		t := opt.Threads
-		l := len(m.Functions())
-		if t > l {
-			t = l
-		}
-		n := l / t   // Jobs per worker go routine.
-		res := l % t // Residual jobs.
-
-		start := 0
-		end := n
-
-		wg := sync.WaitGroup{}
-		wg.Add(t)
-		cerr := make(chan error)
-
-		for i1 := 0; i1 < t; i1++ {
-			// Launch t go routines.
-			if i1 < res {
-				// Worker should do one extra residual job.
-				end++
+		// Parallel. Every function is generated to its own Writer, which are written in the order of the functions.
+		pool := opt.NewPool("codegen")
+		if err := pool.Run(opt.Context(), len(m.Functions()), func(w *util.Worker, i int) error {
+			e1 := m.Functions()[i]
+			if len(e1.Blocks()) < 1 {
+				return nil // External functions are only declared, and generate no code.
 			}
-
-			// Spawn worker go routine.
-			go func(start, end int, wg *sync.WaitGroup, cerr chan error) {
-				w := util.NewWriter()
-				defer wg.Done()
-				defer w.Close()
-
-				for _, e1 := range m.Functions()[start:end] {
-					if err := genFunction(e1, &w); err != nil {
-						cerr <- err
-					}
-				}
-			}(start, end, &wg, cerr)
-			start = end
-			end += n
+			w.Log.Infof("generating function %s", e1.Name())
+			if err := g.genFunction(e1, &ws[i]); err != nil {
+				return err
+			}
+			ws[i].Transform(peephole)
+			return nil
+		}); err != nil {
+			return err
 		}
-		wg.Wait()
 	} else {
 		// Sequential.
-		for _, e1 := range m.Functions() {
-			if err := genFunction(e1, &wr); err != nil {
+		for i1, e1 := range m.Functions() {
+			if err := g.genFunction(e1, &ws[i1]); err != nil {
 				return err
 			}
+			ws[i1].Transform(peephole)
 		}
 	}
 
@@ -330,7 +357,7 @@ func GenArm(opt util.Options, m *lir.Module, root *ir.Node) error {
 	var callee *lir.Function
 	for _, e1 := range root.Children {
 		if e1.Typ == ir.FUNCTION {
-			if callee = m.GetFunction(e1.Children[0].Data.(string)); callee == nil {
+			if callee = m.GetFunction(e1.Children[0].Data.Str); callee == nil {
 				return errors.New("no functions defined for module")
 			}
 			break
@@ -338,19 +365,36 @@ func GenArm(opt util.Options, m *lir.Module, root *ir.Node) error {
 	}
 	rf := CreateRegisterFile()
 
-	// Generate implicit main function for program entry.
-	if err := genMain(rf, callee, &wr); err != nil {
+	// Generate implicit main function for program entry, and the runtime routines of the builtin functions.
+	builtins := m.UsesArgs()
+	if err := g.genMain(rf, callee, builtins, &wr); err != nil {
 		return err
 	}
-	wr.Flush()
+	wr.Transform(peephole)
+	if builtins {
+		g.genArgs(rf, &wr)
+	}
+	if g.trapv {
+		g.genOverflow(rf, &wr)
+	}
 
 	// Generate global data.
 	wr.Write("\n\t.data\n")
+	if g.darwin {
+		// Align words to 8 bytes.
+		wr.Write("\t.p2align\t3\n")
+	}
 	for _, e1 := range m.Globals() {
 		wr.Label(e1.Name())
 		// Write globals with initial values 0. VSL doesn't support variable initialisation on declaration.
 		wr.Write("\t.%s\t0x0\n", wordLabel)
 	}
+	if builtins {
+		wr.Label(labelArgCount)
+		wr.Write("\t.%s\t0x0\n", wordLabel)
+		wr.Label(labelArgVector)
+		wr.Write("\t.%s\t0x0\n", wordLabel)
+	}
 
 	// Generate constant data.
 	for _, e1 := range m.Constants() {
@@ -358,10 +402,10 @@ func GenArm(opt util.Options, m *lir.Module, root *ir.Node) error {
 		if e1.Used() {
 			wr.Label(fmt.Sprintf("%s%d", labelConstant, e1.GlobalSeq()))
 			if e1.DataType() == types.Int {
-				wr.Write("\t.%s\t0x%x\t// %d\n", wordLabel, e1.Value().(int), e1.Value().(int))
+				wr.Write("\t.%s\t0x%x\t%s %d\n", wordLabel, e1.Value().(int), g.comment(), e1.Value().(int))
 			} else {
 				fl := math.Float64bits(e1.Value().(float64))
-				wr.Write("\t.%s\t0x%x\t// %f\n", wordLabel, fl, e1.Value().(float64))
+				wr.Write("\t.%s\t0x%x\t%s %f\n", wordLabel, fl, g.comment(), e1.Value().(float64))
 			}
 		}
 	}
@@ -371,15 +415,148 @@ func GenArm(opt util.Options, m *lir.Module, root *ir.Node) error {
 		wr.Label(e1.Name())
 		wr.Write("\t.asciz\t%q\n", e1.Value())
 	}
+	if builtins {
+		wr.Label(labelArgEmpty)
+		wr.Write("\t.asciz\t\"\"\n")
+	}
+	if g.trapv {
+		wr.Label(labelOverflowMsg)
+		wr.Write("\t.asciz\t%q\n", lir.OverflowMsg)
+	}
+
+	if !g.darwin {
+		// Mark the stack as non-executable.
+		wr.Write("\n\t.section\t.note.GNU-stack,\"\",%%progbits\n")
+	}
 	return nil
 }
 
+// newGenerator returns the generator of the aarch64 assembler of the compilation configured by opt.
+func newGenerator(opt util.Options) *generator {
+	return &generator{
+		darwin:         opt.TargetOS == util.MAC,
+		pic:            opt.PIC,
+		omitFP:         opt.OmitFP,
+		optSize:        opt.OptSize,
+		stackProtector: opt.SSP,
+		runtime:        opt.Runtime,
+		trapv:          opt.Trapv,
+		intBits:        opt.IntBits(),
+		annotator:      backend.NewAnnotator(opt, "//"),
+	}
+}
+
+// symbol returns the assembler symbol of the function or C library function name. Mach-O symbols are prefixed by an
+// underscore.
+func (g *generator) symbol(name string) string {
+	if g.darwin {
+		return "_" + name
+	}
+	return name
+}
+
+// page returns the operand of adrp that loads the address of the 4KB page holding label.
+func (g *generator) page(label string) string {
+	if g.darwin {
+		return label + "@PAGE"
+	}
+	return label
+}
+
+// pageOff returns the operand that adds the offset of label within its 4KB page.
+func (g *generator) pageOff(label string) string {
+	if g.darwin {
+		return label + "@PAGEOFF"
+	}
+	return ":lo12:" + label
+}
+
+// gotPage returns the operand of adrp that loads the address of the 4KB page holding the global offset table entry of
+// label.
+func (g *generator) gotPage(label string) string {
+	if g.darwin {
+		return label + "@GOTPAGE"
+	}
+	return ":got:" + label
+}
+
+// gotPageOff returns the operand that adds the offset of the global offset table entry of label within its 4KB page.
+func (g *generator) gotPageOff(label string) string {
+	if g.darwin {
+		return label + "@GOTPAGEOFF"
+	}
+	return ":got_lo12:" + label
+}
+
+// genAddress generates the instructions that put the address of label in register dst. Position-independent code
+// loads the address from the global offset table.
+func (g *generator) genAddress(dst regfile.Register, label string, wr *util.Writer) {
+	wr.Write("\tadrp\t%s, %s\n", dst.String(), g.pageOf(label))
+	if g.pic {
+		wr.Write("\tldr\t%s, [%s, %s]\n", dst.String(), dst.String(), g.gotPageOff(label))
+	} else {
+		wr.Write("\tadd\t%s, %s, %s\n", dst.String(), dst.String(), g.pageOff(label))
+	}
+}
+
+// genAccess generates the load or store op of register r from or to the word at label, using x28 as temporary
+// register for the address. The comment note is appended to the first instruction, unless it's empty.
+func (g *generator) genAccess(op string, r regfile.Register, label, note string, rf RegisterFile, wr *util.Writer) {
+	if note != "" {
+		note = fmt.Sprintf("\t\t%s%s", g.comment(), note)
+	}
+	tmp := rf.GetI(r28)
+	wr.Write("\tadrp\t%s, %s%s\n", tmp.String(), g.pageOf(label), note)
+	if g.pic {
+		wr.Write("\tldr\t%s, [%s, %s]\n", tmp.String(), tmp.String(), g.gotPageOff(label))
+		wr.Write("\t%s\t%s, [%s]\n", op, r.String(), tmp.String())
+	} else {
+		wr.Write("\t%s\t%s, [%s, %s]\n", op, r.String(), tmp.String(), g.pageOff(label))
+	}
+}
+
+// pageOf returns the operand of adrp for label, which is the page of its global offset table entry for
+// position-independent code.
+func (g *generator) pageOf(label string) string {
+	if g.pic {
+		return g.gotPage(label)
+	}
+	return g.page(label)
+}
+
+// comment returns the token that starts an assembler comment.
+func (g *generator) comment() string {
+	if g.darwin {
+		return ";"
+	}
+	return "//"
+}
+
+// genPrintf generates a call to printf with the format string in x0 and a single integer argument in x1. Apple
+// platforms pass the variadic argument on the stack.
+func (g *generator) genPrintf(rf RegisterFile, wr *util.Writer) {
+	if !g.darwin {
+		wr.Write("\tbl\t%s\n", g.symbol("printf"))
+		return
+	}
+	wr.Write("\tstr\t%s, [%s, #-%d]!\n", rf.GetI(r1).String(), rf.SP().String(), stackAlign)
+	wr.Write("\tbl\t%s\n", g.symbol("printf"))
+	wr.Write("\tadd\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), stackAlign)
+}
+
 // genMain generates an implicit main function that checks input command-line arguments and calls the function callee.
 // After the function callee returns the main function exits the program with the return value of the call to callee.
-// If the return value of callee is a floating point value, the value is cast to integer.
-func genMain(rf RegisterFile, callee *lir.Function, wr *util.Writer) error {
+// If the return value of callee is a floating point value, the value is cast to integer. If builtins is set, argc and
+// argv are stored for the builtin functions argc and arg, and a callee without parameters accepts any number of
+// arguments.
+func (g *generator) genMain(rf RegisterFile, callee *lir.Function, builtins bool, wr *util.Writer) error {
 	wr.Write("\n")
-	wr.Label(labelMain)
+	if g.darwin {
+		// Instructions must be aligned to 4 bytes.
+		wr.Write("\t.p2align\t2\n")
+	}
+	wr.Label(g.symbol(labelMain))
+	wr.Write("\t.cfi_startproc\n")
 
 	nf, ni := 0, 0 // Number of floating point and integer parameters respectively.
 	for _, e1 := range callee.Params() {
@@ -390,8 +567,18 @@ func genMain(rf RegisterFile, callee *lir.Function, wr *util.Writer) error {
 		}
 	}
 
+	// The argv index of the argument being parsed is kept in x19 for error reporting, and x20 is the temporary of
+	// arguments passed on the stack. Both are callee-saved, and are saved at the bottom of the stack frame.
+	var saved []regfile.Register
+	if len(callee.Params()) > 0 && !g.runtime {
+		saved = append(saved, rf.GetI(r19))
+	}
+	if ni > paramReg {
+		saved = append(saved, rf.GetI(r20))
+	}
+
 	// Set up stack.
-	sa := 4 + ni + nf // FP, LR, argc and argv plus all arguments required by callee.
+	sa := 4 + ni + nf + len(saved) // FP, LR, argc and argv plus all arguments required by callee and saved registers.
 
 	spill := 0 // Needed for adjusting where to start storing arguments, such that last argument hits FP of callee.
 	sa *= wordSize
@@ -416,172 +603,202 @@ func genMain(rf RegisterFile, callee *lir.Function, wr *util.Writer) error {
 	// argv[3]
 	// ...
 	// argv[argc-1]
+	// [x19, x20]
 	// <--- SP
 	//
 	// BOTTOM
 
 	fpOffsetArgc := wordSize * 3 // Offset of argc on stack from FP.
 	fpOffsetArgv := wordSize << 2
-	wr.Write("\tsub\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), sa) // Adjust SP.
-	wr.Write("\tstp\t%s, %s, [%s, #%d]\n",
-		rf.FP().String(), rf.LR().String(), rf.SP().String(), sa-(wordSize<<1)) // Store FP and LR on top of stack.
-	wr.Write("\tadd\t%s, %s, #%d\n", rf.FP().String(), rf.SP().String(), sa)                  // Set new FP to old SP.
+	slot := func(i1 int) int {
+		// Offset of parsed argument i1 on stack from FP. The runtime library stores arguments in ascending order.
+		if g.runtime {
+			return -fpOffsetArgv - spill - wordSize*(len(callee.Params())-i1)
+		}
+		return -fpOffsetArgv - spill - wordSize*(i1+1)
+	}
+	fr := frame{size: sa, saved: saved}
+	g.genPrologue(fr, rf, wr)                                                                 // Store FP and LR on top of stack, set new FP to old SP.
 	wr.Write("\tstr\t%s, [%s, #%d]\n", rf.regi[r0].String(), rf.FP().String(), -fpOffsetArgc) // argc.
 	wr.Write("\tstr\t%s, [%s, #%d]\n", rf.regi[r1].String(), rf.FP().String(), -fpOffsetArgv) // argv.
+	if builtins {
+		g.genAddress(rf.GetI(r9), labelArgCount, wr)
+		wr.Write("\tstr\tw0, [%s]\n", rf.GetI(r9).String())
+		g.genAddress(rf.GetI(r9), labelArgVector, wr)
+		wr.Write("\tstr\t%s, [%s]\n", rf.GetI(r1).String(), rf.GetI(r9).String())
+	}
 
 	// Jump labels for error checking.
 	largcok := "_L_argc_ok"     // Jump to label if argc matches parameter count of callee.
 	largverr := "_L_argv_error" // Jump to label if parameter is not integer or float.
 	lcall := "_L_call"          // Jump to label when all parameters are ok.
 
-	// Check parameter count and argc.
-	wr.Write("\tldr\t%s, [%s, #%d]\n", rf.GetI(r1).String(), rf.FP().String(), -fpOffsetArgc) // This is bloated, but it's idiomatic to load argc from the stack.
-	wr.Write("\tsub\t%s, %s, #%d\n", rf.GetI(r1).String(), rf.GetI(r1).String(), 1)
-	wr.Write("\tcmp\t%s, #%d\n", rf.GetI(r1).String(), len(callee.Params())) // First argument is application path.
-	wr.Write("\tb.eq\t%s\n", largcok)
-
-	// argc is not ok.
-	var errstr *lir.String
-	if len(callee.Params()) == 1 {
-		errstr = callee.CreateGlobalString("Argument error: expected 1 argument, got %d\n")
+	if builtins && len(callee.Params()) == 0 {
+		// Any number of arguments is accepted, which are read by the builtin functions.
+		wr.Write("\tb\t%s\n", lcall)
+	} else if g.runtime {
+		// Parse and store arguments on stack using the runtime library, which exits on errors. The arguments argc and
+		// argv are still in x0 and x1. The types string and the arguments are null if callee has no parameters.
+		if len(callee.Params()) > 0 {
+			g.genAddress(rf.GetI(r2), callee.CreateGlobalString(vslrt.Types(callee)).Name(), wr)
+			wr.Write("\tsub\t%s, %s, #%d\n", rf.GetI(r3).String(), rf.FP().String(), -slot(0))
+		} else {
+			wr.Write("\tmov\t%s, xzr\n", rf.GetI(r2).String())
+			wr.Write("\tmov\t%s, xzr\n", rf.GetI(r3).String())
+		}
+		wr.Write("\tbl\t%s\n", g.symbol(vslrt.LabelArgs))
 	} else {
-		errstr = callee.CreateGlobalString(fmt.Sprintf("Argument error: expected %d arguments, got %%d\n", len(callee.Params())))
-	}
-
-	// Load format string and call printf.
-	wr.Write("\tadrp\t%s, %s\n", rf.GetI(r0).String(), errstr.Name())
-	wr.Write("\tadd\t%s, %s, :lo12:%s\n", rf.GetI(r0).String(), rf.GetI(r0).String(), errstr.Name())
-	wr.Write("\tbl\tprintf\n")
-
-	// Set return code and return.
-	wr.Write("\tmov\t%s, #%d\n", rf.GetI(r0).String(), 1)
-	wr.Write("\tldp\t%s, %s, [%s, #%d]\n",
-		rf.FP().String(), rf.LR().String(), rf.SP().String(), sa-(wordSize<<1)) // Restore FP and LR before returning.
-	wr.Write("\tadd\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), sa)
-	wr.Write("\tret\n")
-
-	// argc is ok.
-	wr.Label(largcok)
-
-	if len(callee.Params()) > 0 {
-		ii := 0 // Number of integer arguments provided.
-		fi := 0 // Number of floating point arguments provided.
-
-		// Generate arguments. Parse and store on stack to avoid overwriting during atoi/atof calls.
-		// Retrieve when calling VSL callee function.
-		for i1, e1 := range callee.Params() {
-			// Move argv pointer into register x8.
-			wr.Write("\tldr\t%s, [%s, #%d]\t// Load argv\n",
-				rf.GetI(r8).String(), rf.FP().String(), -fpOffsetArgv)
-
-			// Put the i'th element of argv into x0 for atoi and/or atof.
-			wr.Write("\tldr\t%s, [%s, #%d]\t// Load argv[%d]\n",
-				rf.GetI(r0).String(), rf.GetI(r8).String(), wordSize*(i1+1), i1+1)
-
-			// Save current argv index in x19 for error reporting.
-			wr.Write("\tmov\t%s, #%d\n", rf.GetI(r19).String(), i1+1)
-
-			if e1.DataType() == types.Int {
-				// Parse argv[i1+1] as int using atoi.
-				wr.Write("\tbl\tatoi\n")
-
-				// Verify that argument was an integer != 0.
-				wr.Write("\tcbz\tw0, %s\n", largverr) // atoi returns 32-bit int in w0.
+		// Check parameter count and argc.
+		wr.Write("\tldr\t%s, [%s, #%d]\n", rf.GetI(r1).String(), rf.FP().String(), -fpOffsetArgc) // This is bloated, but it's idiomatic to load argc from the stack.
+		wr.Write("\tsub\t%s, %s, #%d\n", rf.GetI(r1).String(), rf.GetI(r1).String(), 1)
+		wr.Write("\tcmp\t%s, #%d\n", rf.GetI(r1).String(), len(callee.Params())) // First argument is application path.
+		wr.Write("\tb.eq\t%s\n", largcok)
+
+		// argc is not ok.
+		var errstr *lir.String
+		if len(callee.Params()) == 1 {
+			errstr = callee.CreateGlobalString("Argument error: expected 1 argument, got %d\n")
+		} else {
+			errstr = callee.CreateGlobalString(fmt.Sprintf("Argument error: expected %d arguments, got %%d\n", len(callee.Params())))
+		}
 
-				// Store on stack for later.
-				wr.Write("\tstr\t%s, [%s, #%d]\n",
-					rf.GetI(r0), rf.FP().String(), -fpOffsetArgv-spill-wordSize*(1+i1)) // Adjust for spill.
-				ii++
-			} else {
-				// Parse argv[i1+1] as float using atof.
+		// Load format string and call printf.
+		g.genAddress(rf.GetI(r0), errstr.Name(), wr)
+		g.genPrintf(rf, wr)
 
-				// Call atof.
-				wr.Write("\tbl\tatof\n")
+		// Set return code and return.
+		wr.Write("\tmov\t%s, #%d\n", rf.GetI(r0).String(), 1)
+		g.genEpilogue(fr, rf, wr) // Restore FP and LR before returning.
+
+		// argc is ok.
+		wr.Label(largcok)
+
+		if len(callee.Params()) > 0 {
+			ii := 0 // Number of integer arguments provided.
+			fi := 0 // Number of floating point arguments provided.
+
+			// Generate arguments. Parse and store on stack to avoid overwriting during strtol/strtod calls.
+			// Retrieve when calling VSL callee function. The end pointer of the parse is stored in the stack slot of
+			// the argument until the argument is verified.
+			for i1, e1 := range callee.Params() {
+				// Move argv pointer into register x8.
+				wr.Write("\tldr\t%s, [%s, #%d]\t%s Load argv\n",
+					rf.GetI(r8).String(), rf.FP().String(), -fpOffsetArgv, g.comment())
+
+				// Put the i'th element of argv into x0 and the address of the end pointer into x1 for strtol and/or
+				// strtod.
+				wr.Write("\tldr\t%s, [%s, #%d]\t%s Load argv[%d]\n",
+					rf.GetI(r0).String(), rf.GetI(r8).String(), wordSize*(i1+1), g.comment(), i1+1)
+				wr.Write("\tsub\t%s, %s, #%d\n", rf.GetI(r1).String(), rf.FP().String(), -slot(i1))
+
+				// Save current argv index in x19 for error reporting.
+				wr.Write("\tmov\t%s, #%d\n", rf.GetI(r19).String(), i1+1)
+
+				if e1.DataType() == types.Int {
+					// Parse argv[i1+1] as decimal int using strtol.
+					wr.Write("\tmov\t%s, #%d\n", rf.GetI(r2).String(), 10)
+					wr.Write("\tbl\t%s\n", g.symbol("strtol"))
+					g.genExtend(rf.GetI(r0), wr)
+					ii++
+				} else {
+					// Parse argv[i1+1] as float using strtod.
+					wr.Write("\tbl\t%s\n", g.symbol("strtod"))
+					fi++
+				}
 
-				// Verify that argument was a float != 0.0.
-				wr.Write("\tfcmp\t%s, #0.0\n", rf.GetF(v0).String())
+				// Verify that argument was non-empty and parsed to its end.
+				wr.Write("\tldr\t%s, [%s, #%d]\t%s Load end pointer\n",
+					rf.GetI(r9).String(), rf.FP().String(), slot(i1), g.comment())
+				wr.Write("\tldr\t%s, [%s, #%d]\n", rf.GetI(r8).String(), rf.FP().String(), -fpOffsetArgv)
+				wr.Write("\tldr\t%s, [%s, #%d]\n", rf.GetI(r10).String(), rf.GetI(r8).String(), wordSize*(i1+1))
+				wr.Write("\tcmp\t%s, %s\n", rf.GetI(r9).String(), rf.GetI(r10).String())
 				wr.Write("\tb.eq\t%s\n", largverr)
+				wr.Write("\tldrb\tw10, [%s]\n", rf.GetI(r9).String())
+				wr.Write("\tcbnz\tw10, %s\n", largverr)
 
 				// Store on stack for later.
-				wr.Write("\tstr\t%s, [%s, #%d]\n",
-					rf.GetF(v0), rf.FP().String(), -fpOffsetArgv-spill-wordSize*(1+i1)) // Adsjust for spill.
-				fi++
+				if e1.DataType() == types.Int {
+					wr.Write("\tstr\t%s, [%s, #%d]\n", rf.GetI(r0), rf.FP().String(), slot(i1))
+				} else {
+					wr.Write("\tstr\t%s, [%s, #%d]\n", rf.GetF(v0), rf.FP().String(), slot(i1))
+				}
 			}
+
+			// Generate arguments from back to front, such that the first argument can be put into x0/d0 without collision.
+			//for i1 := len(callee.Params()) - 1; i1 >= 0; i1-- {
+			//	// Move argv pointer into register x8.
+			//	wr.Write("\tldr\t%s, [%s, #%d]\n",
+			//		rf.GetI(r8).String(), rf.FP().String(), -fpOffsetArgv)
+			//
+			//	wr.Write("\tmov\t%s, #%d\n", rf.GetI(r19).String(), len(callee.Params())-ii-fi) // Save current argv index in x19 for error reporting.
+			//	e1 := callee.Params()[i1]
+			//
+			//	if e1.DataType() == types.Int {
+			//		// Parameter is integer. Used atoi to parse argument.
+			//
+			//		// Put the i'th element of argv into x0 for atoi and/or atof.
+			//		wr.Write("\tldr\t%s, [%s, #%d]\t// Load argv[%d]\n",
+			//			rf.GetI(r0).String(), rf.GetI(r8).String(), wordSize*(i1+1), len(callee.Params())-ii-fi)
+			//
+			//		// Call atoi.
+			//		wr.Write("\tbl\tatoi\n")
+			//
+			//		// Verify that argument was an integer != 0.
+			//		wr.Write("\tcbz\tw0, %s\n", largverr) // atoi returns 32-bit int in w0.
+			//
+			//		// Argument is good: move result integer to correct register or pass on stack.
+			//		if ii < paramReg {
+			//			// Pass in register.
+			//			dst := rf.GetI(ni%paramReg - ii - 1)
+			//			//if dst.Id() != r0 {
+			//			// Argument is already in correct register.
+			//			wr.Write("\tmov\t%s, %s\n", dst.String(), rf.GetI(r0).String())
+			//			//}
+			//		} else {
+			//			// Pass on stack.
+			//			wr.Write("\tstr\t%s, [%s, #%d]\n", rf.GetI(r0).String(), rf.SP().String(), (ii-paramReg)*wordSize)
+			//		}
+			//		ii++
+			//	} else {
+			//		// Parameter is floating point type. Parse argument as float using atof.
+			//
+			//		// Put the i'th element of argv into x0 for atof.
+			//		wr.Write("\tldr\t%s, [%s, #%d]\n", rf.GetI(r0).String(), rf.GetI(r8).String(), wordSize*i1)
+			//
+			//		// Call atof.
+			//		wr.Write("\tbl\tatof\n")
+			//
+			//		// Verify that argument was a float != 0.0.
+			//		wr.Write("\tfcmp\t%s, #0.0\n", rf.GetF(v0).String(), largverr)
+			//		wr.Write("\tb.eq\t%s\n", largverr)
+			//
+			//		// Pass floating point argument either in register or on stack.
+			//		if fi < paramReg {
+			//			// Pass in register.
+			//			dst := rf.GetF(fi%paramReg - fi - 1)
+			//			//if dst.Id() != v0 {
+			//			// Argument is already in correct register.
+			//			wr.Write("\tmov\t%s, %s\n", dst.String(), rf.GetF(v0).String())
+			//			//}
+			//		} else {
+			//			// Pass on stack.
+			//			wr.Write("\tstr\t%s, [%s, #%d]\n", rf.GetI(v0).String(), rf.SP().String(), (fi-paramReg)*wordSize)
+			//		}
+			//		fi++
+			//	}
+			//}
 		}
 
-		// Generate arguments from back to front, such that the first argument can be put into x0/d0 without collision.
-		//for i1 := len(callee.Params()) - 1; i1 >= 0; i1-- {
-		//	// Move argv pointer into register x8.
-		//	wr.Write("\tldr\t%s, [%s, #%d]\n",
-		//		rf.GetI(r8).String(), rf.FP().String(), -fpOffsetArgv)
-		//
-		//	wr.Write("\tmov\t%s, #%d\n", rf.GetI(r19).String(), len(callee.Params())-ii-fi) // Save current argv index in x19 for error reporting.
-		//	e1 := callee.Params()[i1]
-		//
-		//	if e1.DataType() == types.Int {
-		//		// Parameter is integer. Used atoi to parse argument.
-		//
-		//		// Put the i'th element of argv into x0 for atoi and/or atof.
-		//		wr.Write("\tldr\t%s, [%s, #%d]\t// Load argv[%d]\n",
-		//			rf.GetI(r0).String(), rf.GetI(r8).String(), wordSize*(i1+1), len(callee.Params())-ii-fi)
-		//
-		//		// Call atoi.
-		//		wr.Write("\tbl\tatoi\n")
-		//
-		//		// Verify that argument was an integer != 0.
-		//		wr.Write("\tcbz\tw0, %s\n", largverr) // atoi returns 32-bit int in w0.
-		//
-		//		// Argument is good: move result integer to correct register or pass on stack.
-		//		if ii < paramReg {
-		//			// Pass in register.
-		//			dst := rf.GetI(ni%paramReg - ii - 1)
-		//			//if dst.Id() != r0 {
-		//			// Argument is already in correct register.
-		//			wr.Write("\tmov\t%s, %s\n", dst.String(), rf.GetI(r0).String())
-		//			//}
-		//		} else {
-		//			// Pass on stack.
-		//			wr.Write("\tstr\t%s, [%s, #%d]\n", rf.GetI(r0).String(), rf.SP().String(), (ii-paramReg)*wordSize)
-		//		}
-		//		ii++
-		//	} else {
-		//		// Parameter is floating point type. Parse argument as float using atof.
-		//
-		//		// Put the i'th element of argv into x0 for atof.
-		//		wr.Write("\tldr\t%s, [%s, #%d]\n", rf.GetI(r0).String(), rf.GetI(r8).String(), wordSize*i1)
-		//
-		//		// Call atof.
-		//		wr.Write("\tbl\tatof\n")
-		//
-		//		// Verify that argument was a float != 0.0.
-		//		wr.Write("\tfcmp\t%s, #0.0\n", rf.GetF(v0).String(), largverr)
-		//		wr.Write("\tb.eq\t%s\n", largverr)
-		//
-		//		// Pass floating point argument either in register or on stack.
-		//		if fi < paramReg {
-		//			// Pass in register.
-		//			dst := rf.GetF(fi%paramReg - fi - 1)
-		//			//if dst.Id() != v0 {
-		//			// Argument is already in correct register.
-		//			wr.Write("\tmov\t%s, %s\n", dst.String(), rf.GetF(v0).String())
-		//			//}
-		//		} else {
-		//			// Pass on stack.
-		//			wr.Write("\tstr\t%s, [%s, #%d]\n", rf.GetI(v0).String(), rf.SP().String(), (fi-paramReg)*wordSize)
-		//		}
-		//		fi++
-		//	}
-		//}
-	}
-
-	// When done with parameters, cause program to jump to call function under the argv error handling logic.
-	wr.Write("\tb\t%s\n", lcall)
+		// When done with parameters, cause program to jump to call function under the argv error handling logic.
+		wr.Write("\tb\t%s\n", lcall)
+	}
 
 	// Go here when ready to call callee.
 	wr.Label(lcall)
 
 	idx := 0 // Number of int arguments moved.
 	fdx := 0 // Number of float arguments moved.
+	sdx := 0 // Number of arguments passed on stack.
 
 	// Check if we need to pass arguments over stack.
 	argsa := sa // Argument passing stack.
@@ -599,7 +816,6 @@ func genMain(rf RegisterFile, callee *lir.Function, wr *util.Writer) error {
 	// Need to adjust stack.
 	if argsa > sa {
 		wr.Write("\tsub\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), argsa-sa)
-		sa = argsa
 
 		// Stack from top to bottom when passing arguments over stack.
 		//
@@ -615,6 +831,7 @@ func genMain(rf RegisterFile, callee *lir.Function, wr *util.Writer) error {
 		// argv[3]
 		// ...
 		// argv[argc-1]
+		// [x19, x20]
 		// ------------- only needed if more arguments than argument registers --------------
 		// [spill]
 		// parsed argument 0
@@ -632,69 +849,151 @@ func genMain(rf RegisterFile, callee *lir.Function, wr *util.Writer) error {
 	for i1, e1 := range callee.Params() {
 		if e1.DataType() == types.Int {
 			if idx < paramReg {
-				wr.Write("\tldr\t%s, [%s, #%d]\t// Load parsed argv[%d] into register %s\n",
-					rf.GetI(idx).String(), rf.FP().String(), -fpOffsetArgv-spill-wordSize*(i1+1), i1+1, rf.GetI(idx).String())
+				wr.Write("\tldr\t%s, [%s, #%d]\t%s Load parsed argv[%d] into register %s\n",
+					rf.GetI(idx).String(), rf.FP().String(), slot(i1), g.comment(), i1+1, rf.GetI(idx).String())
 			} else {
 				// Store to stack.
-				sdx := 1 + i1 - paramReg
 				tmp := rf.GetI(r20) // Used r20 as temporary register.
 				wr.Write("\tldr\t%s, [%s, #%d]\n",
-					tmp.String(), rf.FP().String(), -fpOffsetArgv-spill-wordSize*(idx+1))
+					tmp.String(), rf.FP().String(), slot(i1))
 				wr.Write("\tstr\t%s, [%s, #%d]\n", tmp.String(), rf.SP().String(), wordSize*sdx)
+				sdx++
 			}
 			idx++
 		} else {
 			if fdx < paramReg {
-				wr.Write("\tldr\t%s, [%s, #%d]\t// Load parsed argv[%d] into register %s\n",
-					rf.GetF(fdx).String(), rf.FP().String(), -fpOffsetArgv-spill-wordSize*(i1+1), i1+1, rf.GetF(fdx).String())
+				wr.Write("\tldr\t%s, [%s, #%d]\t%s Load parsed argv[%d] into register %s\n",
+					rf.GetF(fdx).String(), rf.FP().String(), slot(i1), g.comment(), i1+1, rf.GetF(fdx).String())
 			} else {
 				// Store to stack.
-				sdx := 1 + i1 - paramReg
-				tmp := rf.GetF(v20) // Used r20 as temporary register.
+				tmp := rf.GetF(v20) // Used v20 as temporary register.
 				wr.Write("\tldr\t%s, [%s, #%d]\n",
-					tmp.String(), rf.FP().String(), -fpOffsetArgv-spill-wordSize*(idx+1))
+					tmp.String(), rf.FP().String(), slot(i1))
 				wr.Write("\tstr\t%s, [%s, #%d]\n", tmp.String(), rf.SP().String(), wordSize*sdx)
+				sdx++
 			}
 			fdx++
 		}
 	}
 
 	// Call VSL callee function.
-	wr.Write("\tbl\t%s\n", callee.Name())
+	wr.Write("\tbl\t%s\n", g.symbol(callee.Name()))
 
 	// Move float result from v0 to r0 if necessary.
 	if callee.DataType() == f {
 		wr.Write("\tfcvtns\t%s, %s\n", rf.regf[v0].String(), rf.regi[r0].String()) // Round to nearest.
 	}
 
+	// Pop the arguments passed on the stack, such that the saved registers are restored from the bottom of the frame.
+	if argsa > sa {
+		wr.Write("\tadd\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), argsa-sa)
+	}
+
 	// De-allocate stack and return, result from callee is already in r0.
-	wr.Write("\tldp\t%s, %s, [%s, #%d]\n",
-		rf.FP().String(), rf.LR().String(), rf.SP().String(), sa-(wordSize<<1)) // Restore FP and LR before returning.
-	wr.Write("\tadd\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), sa)
-	wr.Write("\tret\n")
+	g.genEpilogue(fr, rf, wr) // Restore FP and LR before returning.
 
-	if len(callee.Params()) > 0 {
+	if len(callee.Params()) > 0 && !g.runtime {
 
 		// argv errors jump here.
 		wr.Label(largverr)
-		errstr = callee.CreateGlobalString("Argument error: argument %ld is neither int nor float\n")
+		errstr := callee.CreateGlobalString("Argument error: argument %ld is neither int nor float\n")
 
 		// Load format string and call printf.
-		wr.Write("\tadrp\t%s, %s\n", rf.regi[r0].String(), errstr.Name())
-		wr.Write("\tadd\t%s, %s, :lo12:%s\n", rf.regi[r0].String(), rf.regi[r0].String(), errstr.Name())
+		g.genAddress(rf.regi[r0], errstr.Name(), wr)
 		wr.Write("\tmov\t%s, %s\n", rf.GetI(r1).String(), rf.GetI(r19).String()) // Move saved argument index into x1.
-		wr.Write("\tbl\tprintf\n")
+		g.genPrintf(rf, wr)
 
 		// Set return code and return.
 		wr.Write("\tmov\t%s, #%d\n", rf.GetI(r0).String(), 1)
-		wr.Write("\tldp\t%s, %s, [%s, #%d]\n",
-			rf.FP().String(), rf.LR().String(), rf.SP().String(), sa-(wordSize<<1)) // Restore FP and LR before returning.
-		wr.Write("\tadd\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), sa)
-		wr.Write("\tret\n")
+		g.genEpilogue(fr, rf, wr) // Restore FP and LR before returning.
 	}
+	g.genProcEnd(labelMain, wr)
 	return nil
 }
 
+// genArgs generates the runtime routines of the builtin functions argc and arg, which read argc and argv as stored by
+// the implicit main function. Program arguments out of range are the empty string, which strtol and strtod parse as
+// zero.
+func (g *generator) genArgs(rf RegisterFile, wr *util.Writer) {
+	x0, x9, x10 := rf.GetI(r0).String(), rf.GetI(r9).String(), rf.GetI(r10).String()
+	start := func(name string) {
+		wr.Write("\n")
+		if g.darwin {
+			wr.Write("\t.p2align\t2\n")
+		} else {
+			wr.Write("\t.type\t%s, %%function\n", name)
+		}
+		wr.Label(g.symbol(name))
+		wr.Write("\t.cfi_startproc\n")
+	}
+
+	// argc returns the number of arguments, which excludes the program name.
+	start(lir.LabelArgc)
+	g.genAddress(rf.GetI(r9), labelArgCount, wr)
+	wr.Write("\tldrsw\t%s, [%s]\n", x0, x9)
+	wr.Write("\tsub\t%s, %s, #1\n", x0, x0)
+	wr.Write("\tret\n")
+	g.genProcEnd(lir.LabelArgc, wr)
+
+	// The string of argument x0 is argv[x0], or the empty string if x0 is out of range.
+	start(lir.LabelArgString)
+	g.genAddress(rf.GetI(r9), labelArgCount, wr)
+	wr.Write("\tldrsw\t%s, [%s]\n", x10, x9)
+	wr.Write("\tcmp\t%s, #1\n", x0)
+	wr.Write("\tb.lt\t1f\n")
+	wr.Write("\tcmp\t%s, %s\n", x0, x10)
+	wr.Write("\tb.ge\t1f\n")
+	g.genAddress(rf.GetI(r9), labelArgVector, wr)
+	wr.Write("\tldr\t%s, [%s]\n", x9, x9)
+	wr.Write("\tldr\t%s, [%s, %s, lsl #3]\n", x0, x9, x0)
+	wr.Write("\tret\n")
+	wr.Write("1:\n")
+	g.genAddress(rf.GetI(r0), labelArgEmpty, wr)
+	wr.Write("\tret\n")
+	g.genProcEnd(lir.LabelArgString, wr)
+
+	// Integer and float arguments parse the string of the argument.
+	for _, e1 := range []string{lir.LabelArgInt, lir.LabelArgFloat} {
+		start(e1)
+		wr.Write("\tstp\t%s, %s, [%s, #-%d]!\n", rf.FP(), rf.LR(), rf.SP(), stackAlign)
+		wr.Write("\t.cfi_def_cfa_offset\t%d\n", stackAlign)
+		wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(rf.FP()), -stackAlign)
+		wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(rf.LR()), -wordSize)
+		wr.Write("\tmov\t%s, sp\n", rf.FP())
+		wr.Write("\tbl\t%s\n", g.symbol(lir.LabelArgString))
+		wr.Write("\tmov\t%s, xzr\n", rf.GetI(r1).String())
+		if e1 == lir.LabelArgInt {
+			wr.Write("\tmov\t%s, #10\n", rf.GetI(r2).String())
+			wr.Write("\tbl\t%s\n", g.symbol("strtol"))
+			g.genExtend(rf.GetI(r0), wr)
+		} else {
+			wr.Write("\tbl\t%s\n", g.symbol("strtod"))
+		}
+		wr.Write("\tldp\t%s, %s, [%s], #%d\n", rf.FP(), rf.LR(), rf.SP(), stackAlign)
+		wr.Write("\t.cfi_def_cfa_offset\t0\n")
+		wr.Write("\tret\n")
+		g.genProcEnd(e1, wr)
+	}
+}
+
+// genOverflow generates the handler of integer overflows, which writes the overflow message to stderr by dprintf and
+// aborts the program. Overflowing expressions branch to it without linking, since it doesn't return.
+func (g *generator) genOverflow(rf RegisterFile, wr *util.Writer) {
+	wr.Write("\n")
+	if g.darwin {
+		wr.Write("\t.p2align\t2\n")
+	} else {
+		wr.Write("\t.type\t%s, %%function\n", lir.LabelOverflow)
+	}
+	wr.Label(lir.LabelOverflow)
+	wr.Write("\t.cfi_startproc\n")
+	wr.Write("\tmov\t%s, #2\n", rf.GetI(r0).String())
+	g.genAddress(rf.GetI(r1), labelOverflowMsg, wr)
+	wr.Write("\tbl\t%s\n", g.symbol("dprintf"))
+	wr.Write("\tbl\t%s\n", g.symbol("abort"))
+	g.genProcEnd(lir.LabelOverflow, wr)
+}
+
 func CreateRegisterFile() RegisterFile {
 	rf := RegisterFile{
 		regi: make([]regfile.Register, 32),
@@ -774,6 +1073,22 @@ func (rf RegisterFile) GetF(i int) regfile.Register {
 	return rf.regf[i]
 }
 
+// ArgI returns the integer argument register with index i, where x0 is the return value register.
+func (rf RegisterFile) ArgI(i int) regfile.Register {
+	if i < 0 || i >= paramReg {
+		return nil
+	}
+	return rf.regi[a0+i]
+}
+
+// ArgF returns the floating point argument register with index i, where d0 is the return value register.
+func (rf RegisterFile) ArgF(i int) regfile.Register {
+	if i < 0 || i >= paramReg {
+		return nil
+	}
+	return rf.regf[v0+i]
+}
+
 // GetNextTempI returns the next available integer register that hasn't been allocated yet.
 // If no registers are vacant, <nil> is returned.
 func (rf RegisterFile) GetNextTempI() regfile.Register {
@@ -805,7 +1120,12 @@ func (rf RegisterFile) GetNextTempF() regfile.Register {
 func (rf RegisterFile) GetNextTempIExclude(exc []regfile.Register) regfile.Register {
 	// Used r8-28. Registers r19-28 are callee-saved.
 	// Exclude r28, because it may be used for register spilling or other temporaries.
+	// Exclude r16 and r17, because they hold spilled values.
+	// Exclude r18, because it's the platform register, which is reserved on Apple platforms.
 	for i1, e1 := range rf.regi[r8:r28] {
+		if i1+r8 == r16 || i1+r8 == r17 || i1+r8 == r18 {
+			continue
+		}
 		for _, e2 := range exc {
 			if e2.Id() == e1.(*register).Id() && e2.Type() == ir.DataInteger {
 				// Register already in use by neighbour.
@@ -868,9 +1188,17 @@ func (rf RegisterFile) LR() regfile.Register {
 	return rf.regi[lr]
 }
 
+// CallerSaved returns the registers that are clobbered by function calls: r0-r18, v0-v7 and v16-v31.
+func (rf RegisterFile) CallerSaved() []regfile.Register {
+	res := make([]regfile.Register, 0, r19-r0+v8-v0+len(rf.regf[v16:]))
+	res = append(res, rf.regi[r0:r19]...)
+	res = append(res, rf.regf[v0:v8]...)
+	return append(res, rf.regf[v16:]...)
+}
+
 // Ki returns the number of usable temporary integer registers.
 func (rf RegisterFile) Ki() int {
-	return len(rf.regi[r8:r29])
+	return len(rf.regi[r8:r28]) - len(scratchi) - 1 // Exclude r18.
 }
 
 // Kf returns the number of usable temporary floating point registers.
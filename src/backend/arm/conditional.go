@@ -24,11 +24,15 @@ import (
 // ----- Function -----
 // --------------------
 
-// genBranch generates aarch64 assembler of an LIR branch instruction. An error is returned if something went wrong.
-func genBranch(v *lir.BranchInstruction, rf regfile.RegisterFile, wr *util.Writer, ls *util.Stack) error {
+// genBranch generates aarch64 assembler of an LIR branch instruction. The Block next is the Block that follows the
+// branch in the generated code, or nil if the branch is in the last Block of the function. Jumps to next are omitted.
+// An error is returned if something went wrong.
+func genBranch(v *lir.BranchInstruction, next *lir.Block, rf regfile.RegisterFile, wr *util.Writer) error {
 	if v.Else() == nil {
 		// Unconditional branch.
-		wr.Write("\tb\t%s\n", v.Then().Name())
+		if v.Then() != next {
+			wr.Write("\tb\t%s\n", v.Then().Name())
+		}
 		return nil
 	}
 
@@ -38,16 +42,37 @@ func genBranch(v *lir.BranchInstruction, rf regfile.RegisterFile, wr *util.Write
 	if op1.DataType() == types.Int {
 		// Int compare.
 		wr.Write("\tcmp\t%s, %s\n",
-			op1.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String(),
-			op2.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String())
+			op1.GetHW().Reg.String(),
+			op2.GetHW().Reg.String())
 	} else {
 		// Float compare.
 		wr.Write("\tfcmp\t%s, %s\n",
-			op1.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String(),
-			op2.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String())
+			op1.GetHW().Reg.String(),
+			op2.GetHW().Reg.String())
 	}
 
-	// Generate jump to ELSE block if condition is false. THEN block follows jump instruction sequentially.
+	if v.Else() == next {
+		// ELSE block follows sequentially: jump to THEN block if condition is true.
+		switch v.Operator() {
+		case types.Eq:
+			wr.Write("\tb.eq\t%s\n", v.Then().Name())
+		case types.Neq:
+			wr.Write("\tb.ne\t%s\n", v.Then().Name())
+		case types.LessThan:
+			wr.Write("\tb.lt\t%s\n", v.Then().Name())
+		case types.LessThanOrEqual:
+			wr.Write("\tb.le\t%s\n", v.Then().Name())
+		case types.GreaterThan:
+			wr.Write("\tb.gt\t%s\n", v.Then().Name())
+		case types.GreaterThanOrEqual:
+			wr.Write("\tb.ge\t%s\n", v.Then().Name())
+		default:
+			return fmt.Errorf("unexpected logical operation: %d", v.Operator())
+		}
+		return nil
+	}
+
+	// Generate jump to ELSE block if condition is false.
 	switch v.Operator() {
 	case types.Eq:
 		// Jump if op1 != op2.
@@ -70,5 +95,79 @@ func genBranch(v *lir.BranchInstruction, rf regfile.RegisterFile, wr *util.Write
 	default:
 		return fmt.Errorf("unexpected logical operation: %d", v.Operator())
 	}
+
+	// Jump to THEN block unless it follows sequentially.
+	if v.Then() != next {
+		wr.Write("\tb\t%s\n", v.Then().Name())
+	}
 	return nil
 }
+
+// genSelect generates aarch64 assembler of an LIR select instruction as a compare followed by a conditional select.
+// A select has more operands than there are scratch registers, so spilled operands are loaded by genSelect itself:
+// the compared values are loaded before the compare and the selected values after it.
+func (g *generator) genSelect(v *lir.SelectInstruction, fun *lir.Function, rf RegisterFile, wr *util.Writer) error {
+	var cond string
+	switch v.Operator() {
+	case types.Eq:
+		cond = "eq"
+	case types.Neq:
+		cond = "ne"
+	case types.LessThan:
+		cond = "lt"
+	case types.LessThanOrEqual:
+		cond = "le"
+	case types.GreaterThan:
+		cond = "gt"
+	case types.GreaterThanOrEqual:
+		cond = "ge"
+	default:
+		return fmt.Errorf("unexpected logical operation: %d", v.Operator())
+	}
+
+	// Generate test.
+	op1 := g.genOperand(v.Operand1(), 0, fun, rf, wr)
+	op2 := g.genOperand(v.Operand2(), 1, fun, rf, wr)
+	if v.Operand1().DataType() == types.Int {
+		wr.Write("\tcmp\t%s, %s\n", op1.String(), op2.String())
+	} else {
+		wr.Write("\tfcmp\t%s, %s\n", op1.String(), op2.String())
+	}
+
+	// Select value. The result may overwrite a scratch register, because the selected values are read first.
+	tval := g.genOperand(v.True(), 0, fun, rf, wr)
+	fval := g.genOperand(v.False(), 1, fun, rf, wr)
+	n := v.GetHW()
+	sel := "csel"
+	dst := rf.GetI(scratchi[0])
+	if v.DataType() == types.Float {
+		sel = "fcsel"
+		dst = rf.GetF(scratchf[0])
+	}
+	if !n.Spill {
+		dst = n.Reg
+	}
+	wr.Write("\t%s\t%s, %s, %s, %s\n", sel, dst.String(), tval.String(), fval.String(), cond)
+	if n.Spill {
+		wr.Write("\t%s\t%s, [%s, #%d]\n", store, dst.String(), rf.FP(), g.spillOffset(fun, n))
+	}
+	return nil
+}
+
+// genOperand returns the register holding the operand v. If v is spilled, it's loaded into the scratch register with
+// index idx of its type.
+func (g *generator) genOperand(v lir.Value, idx int, fun *lir.Function, rf RegisterFile,
+	wr *util.Writer) regfile.Register {
+	n := v.GetHW()
+	if !n.Spill {
+		return n.Reg
+	}
+	var r regfile.Register
+	if typ := v.DataType(); typ == types.Int || typ == types.String {
+		r = rf.GetI(scratchi[idx])
+	} else {
+		r = rf.GetF(scratchf[idx])
+	}
+	wr.Write("\t%s\t%s, [%s, #%d]\n", load, r.String(), rf.FP(), g.spillOffset(fun, n))
+	return r
+}
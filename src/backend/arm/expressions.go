@@ -2,6 +2,8 @@ package arm
 
 import (
 	"fmt"
+	"math"
+	"math/bits"
 	"vslc/src/backend/regfile"
 	"vslc/src/ir/lir"
 	"vslc/src/ir/lir/types"
@@ -27,55 +29,88 @@ import (
 // ----- Function -----
 // --------------------
 
-// genExpression generates aarch64 assembler for arithmetic expressions. An error is returned if something went wrong.
-func genExpression(v *lir.DataInstruction, wr *util.Writer) error {
+// genExpression generates aarch64 assembler for arithmetic expressions. If -ftrapv is set, integer additions and
+// subtractions set the condition flags and branch to the overflow handler on signed overflow, and multiplications
+// are checked by genMultiplyOverflow. An error is returned if something went wrong.
+func (g *generator) genExpression(v *lir.DataInstruction, rf RegisterFile, wr *util.Writer) error {
 	op1 := v.Operand1()
 	op2 := v.Operand2()
-	dst := v.GetHW().(*lir.LiveNode).Reg.(regfile.Register)
-	reg1 := op1.GetHW().(*lir.LiveNode).Reg.(regfile.Register)
+	dst := v.GetHW().Reg
+
+	if c := g.immediateOperand(v); c != nil && c.Immediate() {
+		// Binary expression with immediate operand.
+		src := op1
+		if c == op1 {
+			src = op2
+		}
+		return g.genImmediateExpression(v, src.GetHW().Reg, c.Value().(int), dst, wr)
+	}
+	if g.trapv && v.Traps() && v.Operator() == types.Mul {
+		g.genMultiplyOverflow(dst, op1.GetHW().Reg, op2.GetHW().Reg, rf, wr)
+		return nil
+	}
+	reg1 := op1.GetHW().Reg
 
 	if op2 != nil {
 		// Binary expression.
-		reg2 := op2.GetHW().(*lir.LiveNode).Reg.(regfile.Register)
+		reg2 := op2.GetHW().Reg
 
 		// Choose instruction from operator.
 		if dst.Type() == int(types.Int) {
-			// Integer operations.
+			// Integer operations. Arithmetic of 32-bit integers is computed by the 32-bit views of the registers, and
+			// sign extended. Bitwise operations of sign extended integers are sign extended.
+			d, r1, r2 := g.intReg(dst), g.intReg(reg1), g.intReg(reg2)
 			switch v.Operator() {
 			case types.Add:
-				wr.Write("\tadd\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
+				wr.Write("\t%s\t%s, %s, %s\n", g.flags("add"), d, r1, r2)
+				g.genOverflowBranch(wr)
 			case types.Sub:
-				wr.Write("\tsub\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
+				wr.Write("\t%s\t%s, %s, %s\n", g.flags("sub"), d, r1, r2)
+				g.genOverflowBranch(wr)
 			case types.Mul:
-				wr.Write("\tmul\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
+				wr.Write("\tmul\t%s, %s, %s\n", d, r1, r2)
 			case types.Div:
 				// Signed division. Division by zero caught in validate.
-				wr.Write("\tsdiv\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
+				wr.Write("\tsdiv\t%s, %s, %s\n", d, r1, r2)
 			case types.Rem:
 				// From: https://stackoverflow.com/questions/35351470/obtaining-remainder-using-single-aarch64-instruction
 				// Also division by zero is caught in validate.
-				wr.Write("\tudiv\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
-				wr.Write("\tmsub\t%s, %s, %s, %s\n", dst.String(), dst.String(), reg2.String(), reg1.String())
+				wr.Write("\tsdiv\t%s, %s, %s\n", d, r1, r2)
+				wr.Write("\tmsub\t%s, %s, %s, %s\n", d, d, r2, r1)
 			case types.And:
 				wr.Write("\tand\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
+				return nil
 			case types.Xor:
 				wr.Write("\teor\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
+				return nil
 			case types.Or:
 				wr.Write("\torr\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
+				return nil
+			case types.MulHigh:
+				if g.intBits == bitSize32 {
+					// The upper word of the 64-bit product.
+					wr.Write("\tsmull\t%s, %s, %s\n", dst.String(), r1, r2)
+					wr.Write("\tasr\t%s, %s, #%d\n", dst.String(), dst.String(), bitSize32)
+					return nil
+				}
+				wr.Write("\tsmulh\t%s, %s, %s\n", d, r1, r2)
 			case types.RShift:
-				wr.Write("\tlsr\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
+				wr.Write("\tlsr\t%s, %s, %s\n", d, r1, r2)
+			case types.ARShift:
+				wr.Write("\tasr\t%s, %s, %s\n", d, r1, r2)
 			case types.LShift:
-				wr.Write("\tlsl\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
+				wr.Write("\tlsl\t%s, %s, %s\n", d, r1, r2)
 			default:
 				return fmt.Errorf("unexpected binary operator %q", v.Operator().String())
 			}
+			g.genExtend(dst, wr)
 		} else {
 			switch v.Operator() {
 			case types.Add:
 				wr.Write("\tfadd\t%s, %s, %s\n",
-					v.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String(),
-					op1.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String(),
-					op2.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String())
+					v.GetHW().Reg.String(),
+					op1.GetHW().Reg.String(),
+					op2.GetHW().Reg.String())
 			case types.Sub:
 				wr.Write("\tfsub\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
 			case types.Mul:
@@ -90,7 +125,8 @@ func genExpression(v *lir.DataInstruction, wr *util.Writer) error {
 		// Unary expression.
 		switch v.Operator() {
 		case types.Sub:
-			wr.Write("\tneg\t%s, %s\n", dst.String(), reg1.String())
+			wr.Write("\tneg\t%s, %s\n", g.intReg(dst), g.intReg(reg1))
+			g.genExtend(dst, wr)
 		case types.Not:
 			wr.Write("\tmvn\t%s, %s\n", dst.String(), reg1.String())
 		default:
@@ -100,17 +136,351 @@ func genExpression(v *lir.DataInstruction, wr *util.Writer) error {
 	return nil
 }
 
+// genImmediateExpression generates aarch64 assembler for the integer binary expression v of register src and the
+// immediate value imm. An error is returned if something went wrong.
+func (g *generator) genImmediateExpression(v *lir.DataInstruction, src regfile.Register, imm int, dst regfile.Register,
+	wr *util.Writer) error {
+	switch v.Operator() {
+	case types.Add, types.Sub:
+		op, neg := "add", "sub"
+		if v.Operator() == types.Sub {
+			op, neg = neg, op
+		}
+		if imm < 0 {
+			op = neg
+			imm = -imm
+		}
+		if imm > maxAddImm {
+			wr.Write("\t%s\t%s, %s, #%d, lsl #%d\n", g.flags(op), g.intReg(dst), g.intReg(src), imm>>addImmShift,
+				addImmShift)
+		} else {
+			wr.Write("\t%s\t%s, %s, #%d\n", g.flags(op), g.intReg(dst), g.intReg(src), imm)
+		}
+		g.genOverflowBranch(wr)
+		g.genExtend(dst, wr)
+	case types.And:
+		wr.Write("\tand\t%s, %s, #0x%x\n", dst.String(), src.String(), uint64(imm)&wordMask())
+	case types.Or:
+		wr.Write("\torr\t%s, %s, #0x%x\n", dst.String(), src.String(), uint64(imm)&wordMask())
+	case types.Xor:
+		wr.Write("\teor\t%s, %s, #0x%x\n", dst.String(), src.String(), uint64(imm)&wordMask())
+	case types.RShift:
+		wr.Write("\tlsr\t%s, %s, #%d\n", g.intReg(dst), g.intReg(src), imm)
+		g.genExtend(dst, wr)
+	case types.ARShift:
+		wr.Write("\tasr\t%s, %s, #%d\n", g.intReg(dst), g.intReg(src), imm)
+		g.genExtend(dst, wr)
+	case types.LShift:
+		wr.Write("\tlsl\t%s, %s, #%d\n", g.intReg(dst), g.intReg(src), imm)
+		g.genExtend(dst, wr)
+	default:
+		return fmt.Errorf("unexpected binary operator %q with immediate operand", v.Operator().String())
+	}
+	return nil
+}
+
+// flags returns the addition or subtraction op, which sets the condition flags if -ftrapv is set.
+func (g *generator) flags(op string) string {
+	if g.trapv {
+		return op + "s"
+	}
+	return op
+}
+
+// genOverflowBranch generates the branch to the overflow handler if the preceding integer addition or subtraction
+// set the overflow flag, if -ftrapv is set.
+func (g *generator) genOverflowBranch(wr *util.Writer) {
+	if g.trapv {
+		wr.Write("\tb.vs\t%s\n", lir.LabelOverflow)
+	}
+}
+
+// genMultiplyOverflow generates aarch64 assembler for the integer multiplication dst = reg1 * reg2, which branches to
+// the overflow handler if the product doesn't fit in a word. That's the case if the upper half of the 128-bit product,
+// computed by smulh, isn't the sign extension of the lower half. The upper half is held by a scratch register that
+// isn't an operand or the result. If both scratch registers hold spilled operands, LR is used, which is saved by the
+// function, because leaf frames have no spill slots. The 64-bit product of 32-bit integers, computed by smull,
+// overflows if it isn't the sign extension of its lower half.
+func (g *generator) genMultiplyOverflow(dst, reg1, reg2 regfile.Register, rf RegisterFile, wr *util.Writer) {
+	if g.intBits == bitSize32 {
+		wr.Write("\tsmull\t%s, %s, %s\n", dst.String(), word(reg1), word(reg2))
+		wr.Write("\tcmp\t%s, %s, sxtw\n", dst.String(), word(dst))
+		wr.Write("\tb.ne\t%s\n", lir.LabelOverflow)
+		return
+	}
+	tmp := rf.LR()
+	for _, e1 := range scratchi {
+		if r := rf.GetI(e1); r.Id() != dst.Id() && r.Id() != reg1.Id() && r.Id() != reg2.Id() {
+			tmp = r
+			break
+		}
+	}
+	wr.Write("\tsmulh\t%s, %s, %s\n", tmp.String(), reg1.String(), reg2.String())
+	wr.Write("\tmul\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
+	wr.Write("\tcmp\t%s, %s, asr #%d\n", tmp.String(), dst.String(), bitSize-1)
+	wr.Write("\tb.ne\t%s\n", lir.LabelOverflow)
+}
+
+// fusedMultiply returns the multiplication prev if it's fused with the addition or subtraction v into a single
+// madd, msub, fmadd or fmsub instruction, and nil otherwise. The multiplication must immediately precede v and be used
+// by v only, such that the registers of its operands are still intact when v executes. Subtractions can only fuse
+// the subtrahend. None of the operands may be spilled or encoded as immediate operand. Integer operations aren't fused
+// if -ftrapv is set, because they're checked for overflow one by one.
+func (g *generator) fusedMultiply(v, prev lir.Value) *lir.DataInstruction {
+	add, ok := v.(*lir.DataInstruction)
+	if !ok || add.Operand2() == nil || (add.Operator() != types.Add && add.Operator() != types.Sub) ||
+		(g.trapv && add.Traps()) {
+		return nil
+	}
+	mul, ok := prev.(*lir.DataInstruction)
+	if !ok || mul.Operand2() == nil || mul.Operator() != types.Mul || mul.DataType() != add.DataType() ||
+		len(mul.Users()) != 1 {
+		return nil
+	}
+	other := add.Operand1()
+	if add.Operand2() != lir.Value(mul) {
+		if add.Operator() != types.Add || add.Operand1() != lir.Value(mul) {
+			return nil
+		}
+		other = add.Operand2()
+	}
+	for _, e1 := range []lir.Value{mul, mul.Operand1(), mul.Operand2(), other} {
+		if n := e1.GetHW(); n == nil || n.Spill || n.Reg == nil {
+			return nil
+		}
+	}
+	return mul
+}
+
+// genMultiplyAdd generates aarch64 assembler for the addition or subtraction v with the fused multiplication mul
+// returned by fusedMultiply.
+func (g *generator) genMultiplyAdd(v, mul *lir.DataInstruction, wr *util.Writer) {
+	other := v.Operand1()
+	if other == lir.Value(mul) {
+		other = v.Operand2()
+	}
+	op := "madd"
+	if v.Operator() == types.Sub {
+		op = "msub"
+	}
+	if v.DataType() == types.Float {
+		op = "f" + op
+		wr.Write("\t%s\t%s, %s, %s, %s\n", op,
+			v.GetHW().Reg.String(),
+			mul.Operand1().GetHW().Reg.String(),
+			mul.Operand2().GetHW().Reg.String(),
+			other.GetHW().Reg.String())
+		return
+	}
+	wr.Write("\t%s\t%s, %s, %s, %s\n", op,
+		g.intReg(v.GetHW().Reg),
+		g.intReg(mul.Operand1().GetHW().Reg),
+		g.intReg(mul.Operand2().GetHW().Reg),
+		g.intReg(other.GetHW().Reg))
+	g.genExtend(v.GetHW().Reg, wr)
+}
+
+// immediate returns true if every user of the Constant c encodes c as an immediate operand, such that c doesn't need
+// a register.
+func (g *generator) immediate(c *lir.Constant) bool {
+	if c.DataType() != types.Int || len(c.Users()) < 1 {
+		return false
+	}
+	for _, e1 := range c.Users() {
+		if v, ok := e1.(*lir.DataInstruction); !ok || g.immediateOperand(v) != c {
+			return false
+		}
+	}
+	return true
+}
+
+// immediateOperand returns the Constant operand of the integer binary expression v that fits in the immediate field
+// of the instruction generated for v, or nil if there is none. The second operand is preferred. The first operand is
+// only returned for commutative operators if the second operand is not a Constant.
+func (g *generator) immediateOperand(v *lir.DataInstruction) *lir.Constant {
+	op1, op2 := v.Operand1(), v.Operand2()
+	if v.DataType() != types.Int || op2 == nil || op1 == op2 {
+		return nil
+	}
+	if c, ok := op2.(*lir.Constant); ok {
+		if g.fitsImmediate(v.Operator(), c.Value().(int)) {
+			return c
+		}
+		return nil
+	}
+	switch v.Operator() {
+	case types.Add, types.And, types.Or, types.Xor:
+		if c, ok := op1.(*lir.Constant); ok && g.fitsImmediate(v.Operator(), c.Value().(int)) {
+			return c
+		}
+	}
+	return nil
+}
+
+// fitsImmediate returns true if the value imm can be encoded as the immediate operand of the instruction generated for
+// the arithmetic operation op.
+func (g *generator) fitsImmediate(op types.ArithmeticOperation, imm int) bool {
+	switch op {
+	case types.Add, types.Sub:
+		if imm < 0 {
+			imm = -imm
+		}
+		return imm <= maxAddImm || (imm&maxAddImm == 0 && imm>>addImmShift <= maxAddImm)
+	case types.And, types.Or, types.Xor:
+		return logicalImmediate(uint64(imm))
+	case types.RShift, types.ARShift, types.LShift:
+		return 0 <= imm && imm < g.intBits
+	}
+	return false
+}
+
+// logicalImmediate returns true if the value imm can be encoded as the bitmask immediate of the logical instructions
+// and, orr and eor. A bitmask immediate is a repeating element of 2, 4, 8, 16, 32 or 64 bits, where each element is a
+// rotated run of ones.
+func logicalImmediate(imm uint64) bool {
+	if bitSize == bitSize32 {
+		if int64(imm) != int64(int32(imm)) {
+			// Doesn't fit in a 32-bit register.
+			return false
+		}
+		imm &= wordMask()
+		imm |= imm << bitSize32
+	}
+	if imm == 0 || imm == math.MaxUint64 {
+		return false
+	}
+
+	// Find the smallest repeating element.
+	size := bitSize64
+	for size > 2 {
+		half := size >> 1
+		mask := uint64(1)<<uint(half) - 1
+		if imm&mask != (imm>>uint(half))&mask {
+			break
+		}
+		size = half
+	}
+	mask := uint64(math.MaxUint64) >> uint(bitSize64-size)
+	e := imm & mask
+
+	// A rotated run of ones has exactly two transitions between zeros and ones.
+	rot := (e>>1 | e<<uint(size-1)) & mask
+	return bits.OnesCount64(e^rot) == 2
+}
+
+// movImmediate returns the instructions that put the integer val in the register named r, as a movz or movn
+// instruction followed by a movk instruction for every remaining halfword that differs. Starting with movn needs fewer
+// instructions if more halfwords are all ones than all zeros.
+func movImmediate(r string, val int) []string {
+	imm := uint64(val) & wordMask()
+	skip := uint64(0) // Halfwords that are set by the first instruction.
+	zeros, ones := 0, 0
+	for i1 := 0; i1 < bitSize; i1 += halfwordSize {
+		switch (imm >> uint(i1)) & halfwordMask {
+		case 0:
+			zeros++
+		case halfwordMask:
+			ones++
+		}
+	}
+	op := "movz"
+	if ones > zeros {
+		op = "movn"
+		skip = halfwordMask
+	}
+
+	res := make([]string, 0, bitSize/halfwordSize)
+	for i1 := 0; i1 < bitSize; i1 += halfwordSize {
+		h := (imm >> uint(i1)) & halfwordMask
+		if h == skip {
+			continue
+		}
+		if len(res) > 0 {
+			res = append(res, fmt.Sprintf("\tmovk\t%s, #%#x, lsl #%d", r, h, i1))
+		} else if op == "movn" {
+			res = append(res, fmt.Sprintf("\tmovn\t%s, #%#x, lsl #%d", r, ^h&halfwordMask, i1))
+		} else {
+			res = append(res, fmt.Sprintf("\tmovz\t%s, #%#x, lsl #%d", r, h, i1))
+		}
+	}
+	if len(res) == 0 {
+		// Every halfword is either all zeros or all ones.
+		res = append(res, fmt.Sprintf("\t%s\t%s, #0", op, r))
+	}
+	return res
+}
+
+// fmovImmediate returns true if the floating point value val can be encoded as the 8-bit immediate of fmov, that is
+// val = ±n/16 * 2^e where 16 <= n <= 31 and -3 <= e <= 4.
+func fmovImmediate(val float64) bool {
+	a := math.Abs(val)
+	for e1 := -3; e1 <= 4; e1++ {
+		n := a / math.Ldexp(1, e1) * 16
+		if 16 <= n && n <= 31 && n == math.Trunc(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// synthesise returns true if an integer constant that is put in a register by n movz, movn and movk instructions
+// should be synthesised rather than loaded from the literal pool. Synthesised constants avoid a memory access, so
+// they're always preferred, unless optimising for size and the instructions are larger than the literal pool load.
+func (g *generator) synthesise(n int) bool {
+	if !g.optSize {
+		return true
+	}
+	pool := 2 // adrp and ldr.
+	if g.pic {
+		pool++ // Load from the global offset table.
+	}
+	return n <= pool
+}
+
+// word returns the name of the 32-bit view of the general purpose integer register r.
+func word(r regfile.Register) string {
+	return fmt.Sprintf("w%d", r.Id())
+}
+
+// intReg returns the name of the view of the integer register r that computes integers, which is the 32-bit view if
+// integers are 32 bits wide.
+func (g *generator) intReg(r regfile.Register) string {
+	if g.intBits == bitSize32 {
+		return word(r)
+	}
+	return r.String()
+}
+
+// genExtend sign extends the 32-bit view of the integer register r to the full register, if integers are 32 bits
+// wide.
+func (g *generator) genExtend(r regfile.Register, wr *util.Writer) {
+	if g.intBits == bitSize32 {
+		wr.Write("\tsxtw\t%s, %s\n", r.String(), word(r))
+	}
+}
+
+// wordMask returns the mask of the bits of a register of the target architecture.
+func wordMask() uint64 {
+	return uint64(math.MaxUint64) >> uint(bitSize64-bitSize)
+}
+
 // genFunctionCall generates aarch64 assembler for a function call. An error is returned if something went wrong. The
 // result of the function call is put in register a0 for integers or v0 for floating point functions.
-func genFunctionCall(v *lir.FunctionCallInstruction, rf regfile.RegisterFile, wr *util.Writer) error {
-	// Check if we need to pass arguments on stack.
-	nargs := 0 // Total number of arguments.
-	ni := 0    // Number of integer arguments.
-	nf := 0    // Number of float arguments.
+func (g *generator) genFunctionCall(v *lir.FunctionCallInstruction, fun *lir.Function, rf regfile.RegisterFile,
+	wr *util.Writer) error {
+	// Check if we need to pass arguments on stack. Integer and float arguments are assigned the argument registers
+	// of their own register class, so each class overflows to the stack independently.
+	ni := 0 // Number of integer arguments.
+	nf := 0 // Number of float arguments.
+	nv := 0 // Number of variadic arguments.
 
-	for _, e1 := range v.Arguments() {
+	for i1, e1 := range v.Arguments() {
 		if e1.DataType() == types.VaList {
-			nargs += len(e1.(*lir.VaList).Values())
+			nv += len(e1.(*lir.VaList).Values())
+			if g.darwin {
+				// Apple platforms pass variadic arguments on the stack.
+				continue
+			}
 			for _, e2 := range e1.(*lir.VaList).Values() {
 				if e2.DataType() == types.String || e2.DataType() == types.Int {
 					ni++
@@ -118,106 +488,116 @@ func genFunctionCall(v *lir.FunctionCallInstruction, rf regfile.RegisterFile, wr
 					nf++
 				}
 			}
+		} else if typ := v.Target().Params()[i1].DataType(); typ == types.Int || typ == types.String {
+			ni++
 		} else {
-			nargs++
-			if e1.DataType() == types.Int || e1.DataType() == types.String {
-				ni++
-			} else {
-				nf++
-			}
+			nf++
 		}
 	}
-	stack := 0
+	stack := 0 // Number of stack slots.
 	if ni > paramReg {
-		stack += paramReg - ni
+		stack += ni - paramReg
 	}
 	if nf > paramReg {
-		stack += paramReg - nf
+		stack += nf - paramReg
 	}
-	if stack > 0 {
-		stack *= wordSize
-		res := stack % stackAlign
-		if res != 0 {
-			stack += stackAlign - res
-		}
-		wr.Write("\tsub\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), stack)
+
+	// Apple platforms pass variadic arguments on the stack following the named arguments, every argument occupying
+	// one word.
+	vi := stack // Index of first variadic stack slot.
+	if g.darwin {
+		stack += nv
+	}
+	size := stack * wordSize
+	if res := size % stackAlign; res != 0 {
+		size += stackAlign - res
+	}
+	if size > 0 {
+		wr.Write("\tsub\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), size)
 	}
 
-	if len(v.Arguments()) > 0 {
-		ii := 0 // Index of current or last integer argument.
-		fi := 0 // Index of current or last float argument.
+	ii := 0 // Index of next integer argument register.
+	fi := 0 // Index of next float argument register.
+	si := 0 // Index of next stack slot.
 
-		// Generate argument passing.
-		for i1, e1 := range v.Arguments() {
-			arg := e1
-			param := v.Target().Params()[i1]
+	// pass moves the argument arg to the next argument register of its class, or to the next stack slot if the
+	// argument registers of its class are used up.
+	pass := func(arg lir.Value, isInt bool) {
+		if isInt && ii < paramReg {
+			g.genArgument(rf.GetI(ii), arg, fun, rf, wr)
+		} else if !isInt && fi < paramReg {
+			g.genArgument(rf.GetF(fi), arg, fun, rf, wr)
+		} else {
+			wr.Write("\t%s\t%s, [%s, #%d]\n",
+				store, g.argument(arg, fun, rf, wr).String(), rf.SP().String(), wordSize*si)
+			si++
+		}
+		if isInt {
+			ii++
+		} else {
+			fi++
+		}
+	}
 
-			if param.DataType() == types.Int || param.DataType() == types.String {
-				if ii < paramReg {
-					// Used integer registers.
-					wr.Write("\tmov\t%s, %s\n",
-						rf.GetI(ii), arg.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String())
-				} else {
-					// Put on stack.
-					wr.Write("\tstr\t%s, [%s, #%d]\n",
-						arg.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String(), rf.SP().String(), wordSize*(nargs-1))
-				}
-				ii++
-				nargs--
-			} else if arg.DataType() == types.Float {
-				if fi < paramReg {
-					// Used float registers.
-					wr.Write("\tfmov\t%s, %s\n",
-						rf.GetF(fi), arg.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String())
-				} else {
-					// Put on stack.
-					wr.Write("\tstr\t%s, [%s, #%d]\n",
-						arg.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String(), rf.SP().String(), wordSize*(nargs-1))
-				}
-				fi++
-				nargs--
-			} else if arg.DataType() == types.VaList {
-				// VaList is used exclusively by calls to printf.
-				for _, e2 := range arg.(*lir.VaList).Values() {
-					varg := e2.GetHW().(*lir.LiveNode).Reg.(regfile.Register)
-					if e2.DataType() == types.Int || e2.DataType() == types.String {
-						// Int or strings.
-						if fi < paramReg {
-							// Move to register.
-							wr.Write("\tmov\t%s, %s\n", rf.GetI(ii).String(), varg.String())
-						} else {
-							// Pass on stack.
-							wr.Write("\tstr\t%s, [%s, #%d]\n", varg.String(), rf.SP().String(), wordSize*(nargs-1))
-						}
-						ii++
-						nargs--
-					} else {
-						// Float.
-						if fi < paramReg {
-							// Move to register.
-							wr.Write("\tfmov\t%s, %s\n", rf.GetF(fi).String(), varg.String())
-						} else {
-							// Pass on stack.
-							wr.Write("\tstr\t%s, [%s, #%d]\n", varg.String(), rf.SP().String(), wordSize*(nargs-1))
-						}
-						fi++
-						nargs--
-					}
-				}
-			} else {
-				return fmt.Errorf("cannot create function call assembler: unexpected data type: %s",
-					arg.DataType().String())
+	// Generate argument passing.
+	for i1, e1 := range v.Arguments() {
+		if e1.DataType() != types.VaList {
+			typ := v.Target().Params()[i1].DataType()
+			pass(e1, typ == types.Int || typ == types.String)
+		} else if g.darwin {
+			// VaList is used exclusively by calls to printf.
+			for i2, e2 := range e1.(*lir.VaList).Values() {
+				wr.Write("\t%s\t%s, [%s, #%d]\n",
+					store, g.argument(e2, fun, rf, wr).String(), rf.SP().String(), wordSize*(vi+i2))
+			}
+		} else {
+			// VaList is used exclusively by calls to printf. Variadic floats are passed in the float argument
+			// registers, like named arguments.
+			for _, e2 := range e1.(*lir.VaList).Values() {
+				pass(e2, e2.DataType() == types.Int || e2.DataType() == types.String)
 			}
-
 		}
 	}
 
 	// Call function.
-	wr.Write("\tbl\t%s\n", v.Target().Name())
+	wr.Write("\tbl\t%s\n", g.symbol(v.Target().Name()))
 
 	// De-allocate stack for arguments, if any.
-	if stack > 0 {
-		wr.Write("\tadd\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), stack)
+	if size > 0 {
+		wr.Write("\tadd\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), size)
 	}
 	return nil
 }
+
+// genArgument moves the function call argument arg to the argument register dst. Spilled arguments are loaded from
+// their spill slot directly.
+func (g *generator) genArgument(dst regfile.Register, arg lir.Value, fun *lir.Function, rf regfile.RegisterFile,
+	wr *util.Writer) {
+	if n := spilled(arg); n != nil {
+		wr.Write("\t%s\t%s, [%s, #%d]\n", load, dst.String(), rf.FP(), g.spillOffset(fun, n))
+		return
+	}
+	if dst.Type() == int(i) {
+		wr.Write("\tmov\t%s, %s\n", dst.String(), arg.GetHW().Reg.String())
+	} else {
+		wr.Write("\tfmov\t%s, %s\n", dst.String(), arg.GetHW().Reg.String())
+	}
+}
+
+// argument returns the register holding the function call argument arg. Spilled arguments are loaded from their
+// spill slot into a scratch register.
+func (g *generator) argument(arg lir.Value, fun *lir.Function, rf regfile.RegisterFile,
+	wr *util.Writer) regfile.Register {
+	n := spilled(arg)
+	if n == nil {
+		return arg.GetHW().Reg
+	}
+	var r regfile.Register
+	if typ := arg.DataType(); typ == types.Int || typ == types.String {
+		r = rf.GetI(scratchi[0])
+	} else {
+		r = rf.GetF(scratchf[0])
+	}
+	wr.Write("\t%s\t%s, [%s, #%d]\n", load, r.String(), rf.FP(), g.spillOffset(fun, n))
+	return r
+}
@@ -0,0 +1,89 @@
+package arm
+
+import (
+	"testing"
+)
+
+// TestLogicalImmediate verifies that values are accepted as bitmask immediates of the logical instructions if, and
+// only if, they are a repeating element of rotated runs of ones.
+func TestLogicalImmediate(t *testing.T) {
+	tests := []struct {
+		imm uint64
+		ok  bool
+	}{
+		{0, false},
+		{0xffffffffffffffff, false},
+		{1, true},
+		{0xff, true},
+		{0xff00, true},
+		{0x5555555555555555, true},
+		{0x00ff00ff00ff00ff, true},
+		{0x8000000000000001, true},
+		{0xfffffffffffffffe, true},
+		{0x5555, false},
+		{0x101, false},
+		{0x1234, false},
+	}
+	for _, e1 := range tests {
+		if res := logicalImmediate(e1.imm); res != e1.ok {
+			t.Errorf("expected %t for 0x%x, got %t", e1.ok, e1.imm, res)
+		}
+	}
+}
+
+// TestMovImmediate verifies that integers are synthesised from the fewest halfwords, starting with movn when most
+// halfwords are all ones.
+func TestMovImmediate(t *testing.T) {
+	tests := []struct {
+		val int
+		res []string
+	}{
+		{0, []string{"\tmovz\tx0, #0"}},
+		{-1, []string{"\tmovn\tx0, #0"}},
+		{0x12345, []string{"\tmovz\tx0, #0x2345, lsl #0", "\tmovk\tx0, #0x1, lsl #16"}},
+		{0x10000, []string{"\tmovz\tx0, #0x1, lsl #16"}},
+		{-0x12345, []string{"\tmovn\tx0, #0x2344, lsl #0", "\tmovk\tx0, #0xfffe, lsl #16"}},
+		{0x123456789abc, []string{"\tmovz\tx0, #0x9abc, lsl #0", "\tmovk\tx0, #0x5678, lsl #16",
+			"\tmovk\tx0, #0x1234, lsl #32"}},
+	}
+	for _, e1 := range tests {
+		res := movImmediate("x0", e1.val)
+		if len(res) != len(e1.res) {
+			t.Errorf("expected %q for %d, got %q", e1.res, e1.val, res)
+			continue
+		}
+		for i1 := range res {
+			if res[i1] != e1.res[i1] {
+				t.Errorf("expected %q for %d, got %q", e1.res, e1.val, res)
+				break
+			}
+		}
+	}
+}
+
+// TestFmovImmediate verifies that floating point values are accepted as fmov immediates if, and only if, they have a
+// four bit fraction and an exponent in the range -3 to 4.
+func TestFmovImmediate(t *testing.T) {
+	tests := []struct {
+		val float64
+		ok  bool
+	}{
+		{0, false},
+		{1, true},
+		{-1, true},
+		{0.5, true},
+		{2, true},
+		{0.125, true},
+		{31, true},
+		{1.9375, true},
+		{0.1, false},
+		{32, false},
+		{0.0625, false},
+		{1.03125, false},
+	}
+	for _, e1 := range tests {
+		if res := fmovImmediate(e1.val); res != e1.ok {
+			t.Errorf("expected %t for %g, got %t", e1.ok, e1.val, res)
+		}
+	}
+}
@@ -2,6 +2,9 @@ package arm
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"vslc/src/backend/regfile"
 	"vslc/src/ir/lir"
 	"vslc/src/ir/lir/types"
@@ -12,6 +15,14 @@ import (
 // ----- Type definitions -----
 // ----------------------------
 
+// frame defines the stack frame layout of a function.
+type frame struct {
+	size   int                // Size of the stack frame in bytes, aligned with the stack alignment.
+	saved  []regfile.Register // Callee-saved registers stored at the bottom of the stack frame.
+	leaf   bool               // Set to true if FP and LR are not saved and the stack frame is addressed relative to SP.
+	canary bool               // Set to true if a stack protector canary is stored below FP and LR.
+}
+
 // ---------------------
 // ----- Constants -----
 // ---------------------
@@ -28,214 +39,250 @@ import (
 //
 // General steps:
 //
-// - Grow stack with 8 * arguments + sp and lr. Align with stack alignment.
+// - Grow stack with 8 * (arguments + locals + spill slots) + sp and lr + used callee-saved registers. Align with stack
+//   alignment. Leaf functions without locals and spill slots don't save FP and LR if -fomit-frame-pointer is set.
+// - Save used callee-saved registers at the bottom of the stack frame.
+// - Store a canary directly below FP and LR of non-leaf functions if -fstack-protector is set, and check it before
+//   returning.
 // - Store all arguments on stack to maximise available registers.
 // - Used register file LRU to assign registers.
 // - Generate function body.
 // - De-allocate stack.
 // - Return x0 for integer functions, use v0 for floating point functions.
-func genFunction(fun *lir.Function, wr *util.Writer) error {
+func (g *generator) genFunction(fun *lir.Function, wr *util.Writer) error {
 	if len(fun.Blocks()) < 1 {
 		return nil
 	}
 	rf := CreateRegisterFile()
+	ann := g.annotator.Function()
 
 	// Write function name label.
 	wr.Write("\n")
-	wr.Label(fun.Name())
-
-	// Calculate new stack size.
-	sa := wordSize * (len(fun.Params()) + len(fun.Locals()) + 2) // Stack adjust. Accommodate all local variables, params and FP + LR.
-	spill := sa % stackAlign
-	if spill != 0 {
-		sa += stackAlign - spill
+	if g.darwin {
+		// Instructions must be aligned to 4 bytes.
+		wr.Write("\t.p2align\t2\n")
+	} else {
+		wr.Write("\t.type\t%s, %%function\n", fun.Name())
 	}
+	wr.Label(g.symbol(fun.Name()))
+	wr.Write("\t.cfi_startproc\n")
 
-	// Adjust stack and set stack frame pointer.
-	wr.Write("\tsub\t%s, %s, #%d\n", rf.SP(), rf.SP(), sa)
-
-	// Save old frame pointer and link register.
-	wr.Write("\tstp\t%s, %s, [%s, #%d]\n", rf.FP(), rf.LR(), rf.SP(), sa-(wordSize<<1))
+	// Calculate new stack size.
+	fr := g.newFrame(fun, rf)
 
-	// Set frame pointer to old stack  pointer.
-	wr.Write("\tadd\t%s, %s, #%d\n", rf.FP(), rf.SP(), sa)
+	// Allocate stack frame and save FP, LR and used callee-saved registers.
+	g.genPrologue(fr, rf, wr)
 
 	ii := 0 // Number of integer parameters.
 	fi := 0 // Number of float parameters.
+	si := 0 // Number of parameters passed on stack.
 
 	// Put arguments on stack.
-	offset := -(wordSize * 3) // Offset by 3: 2 for skipping old SP and LR, one to align with current word.
 	for i1, e1 := range fun.Params() {
+		offset := fr.param(i1)
 		if e1.DataType() == i {
 			// Integer parameter.
-			if ii > paramReg {
+			if ii >= paramReg {
 				// Load from stack, store on stack. Reuse x0, because argument passed in x0 is stored on stack by this point.
-				wr.Write("\tldr\t%s, [%s, #%d]\n", regi[r0], rf.FP(), wordSize*i1)
-				wr.Write("\tstr\t%s, [%s, #%d]\n", regi[r0], rf.FP(), offset)
+				wr.Write("\tldr\t%s, %s\n", regi[r0], fr.addr(rf, wordSize*si))
+				wr.Write("\tstr\t%s, %s\n", regi[r0], fr.addr(rf, offset))
+				si++
 			} else {
 				// Store directly on stack from register.
-				wr.Write("\tstr\t%s, [%s, #%d]\n", regi[r0+ii], rf.FP(), offset)
+				wr.Write("\tstr\t%s, %s\n", regi[r0+ii], fr.addr(rf, offset))
 			}
 			ii++
 		} else {
 			// Float parameter.
-			if fi > paramReg {
+			if fi >= paramReg {
 				// Load from stack, store on stack. Reuse v0, because argument passed in v0 is stored on stack by this point.
-				wr.Write("\tldr\t%s, [%s, #%d]\n", rf.GetF(v0), rf.FP(), wordSize*i1)
-				wr.Write("\tstr\t%s, [%s, #%d]\n", rf.GetF(v0), rf.FP(), offset)
+				wr.Write("\tldr\t%s, %s\n", rf.GetF(v0), fr.addr(rf, wordSize*si))
+				wr.Write("\tstr\t%s, %s\n", rf.GetF(v0), fr.addr(rf, offset))
+				si++
 			} else {
 				// Store directly on stack from register.
-				wr.Write("\tstr\t%s, [%s, #%d]\n", rf.GetF(v0+fi), rf.FP(), offset)
+				wr.Write("\tstr\t%s, %s\n", rf.GetF(v0+fi), fr.addr(rf, offset))
 			}
 			fi++
 		}
-		offset -= wordSize
 	}
 
-	ls := util.Stack{}
-
 	// Generate function body.
-	for _, e1 := range fun.Blocks() {
+	blocks := fun.Blocks()
+	for i1, e1 := range blocks {
+		var next *lir.Block
+		if i1+1 < len(blocks) {
+			next = blocks[i1+1]
+		}
+
 		// Write label for basic block.
 		wr.Label(e1.Name())
-		for _, e2 := range e1.Instructions() {
+		insts := e1.Instructions()
+		for i2, e2 := range insts {
+			ann.Annotate(wr, e2)
+
+			// Load spilled operands into scratch registers.
+			reloaded := g.genReload(e2, fun, rf, wr)
+
 			switch e2.Type() {
 			case types.DataInstruction:
 				if e2.DataType() == types.VaList {
 					// VaList is handled already by genExpression.
 					break
 				}
-				if err := genExpression(e2.(*lir.DataInstruction), wr); err != nil {
-					return err
+				if i2+1 < len(insts) && g.fusedMultiply(insts[i2+1], e2) != nil {
+					// Multiplication is generated by the following addition or subtraction.
+					break
+				}
+				if i2 > 0 {
+					if m := g.fusedMultiply(e2, insts[i2-1]); m != nil {
+						g.genMultiplyAdd(e2.(*lir.DataInstruction), m, wr)
+						break
+					}
+				}
+				if err := g.genExpression(e2.(*lir.DataInstruction), rf, wr); err != nil {
+					return locate(e2, err)
 				}
 			case types.LoadInstruction:
-				dst := e2.GetHW().(*lir.LiveNode).Reg.(regfile.Register)
+				dst := e2.GetHW().Reg
 				if e2.DataType() == types.String {
-					wr.Write("\tadrp\t%s, %s\n",
-						dst.String(), e2.Operand1().Name())
-					wr.Write("\tadd\t%s, %s, :lo12:%s\n", dst.String(), dst.String(), e2.Operand1().Name())
+					g.genAddress(dst, e2.Operand1().Name(), wr)
 					break
 				}
 				switch e2.Operand1().Type() {
 				case types.DeclareInstruction:
-					// Add 3 to offset: 1 to align for bottom-down, 2 for skipping stack saved SP and LR.
 					src := e2.Operand1().(*lir.DeclareInstruction)
-					wr.Write("\t%s\t%s, [%s, #%d]\n",
-						load, dst.String(),
-						rf.FP(), -wordSize*(src.Seq()+3+len(fun.Params()))) // Locals are stored after parameters.
+					wr.Write("\t%s\t%s, %s\n", load, dst.String(), fr.addr(rf, fr.local(fun, src.Seq())))
 				case types.Param:
-					// Add 3 to offset: 1 to align for bottom-down, 2 for skipping stack saved SP and LR.
 					src := e2.Operand1().(*lir.Param)
-					wr.Write("\t%s\t%s, [%s, #%d]\n",
-						load, dst.String(),
-						rf.FP(), -wordSize*(src.Id()+3)) // Params go first on stack.
+					wr.Write("\t%s\t%s, %s\n", load, dst.String(), fr.addr(rf, fr.param(src.Id())))
 				case types.Global:
 					src := e2.Operand1().(*lir.Global)
 
-					// Used x0 for storing the temporary value that is &GLOBAL_VARIABLE. Load cannot happen after return.
-					wr.Write("\tadrp\t%s, %s\n", rf.GetI(r0).String(), src.Name())
-					wr.Write("\t%s\t%s, [%s, :lo12:%s]\n",
-						load, dst.String(), rf.GetI(r0).String(), src.Name())
+					// Used x28 for storing the temporary value that is &GLOBAL_VARIABLE. Register x0 may hold a live value.
+					g.genAccess(load, dst, src.Name(), "", rf, wr)
 				default:
 					panic(fmt.Sprintf("compiler error: unexpected load source type %s", e2.Operand1().Type().String()))
 				}
 			case types.StoreInstruction:
-				src := e2.Operand1().GetHW().(*lir.LiveNode).Reg.(regfile.Register)
+				src := e2.Operand1().GetHW().Reg
 				switch e2.Operand2().Type() {
 				case types.DeclareInstruction:
-					// Add 3 to offset: 1 to align for bottom-down, 2 for skipping stack saved SP and LR.
 					dst := e2.Operand2().(*lir.DeclareInstruction)
-					wr.Write("\t%s\t%s, [%s, #%d]\n",
-						store, src.String(),
-						rf.FP(), -wordSize*(dst.Seq()+3+len(fun.Params()))) // Locals are stored after parameters.
+					wr.Write("\t%s\t%s, %s\n", store, src.String(), fr.addr(rf, fr.local(fun, dst.Seq())))
 				case types.Param:
-					// Add 3 to offset: 1 to align for bottom-down, 2 for skipping stack saved SP and LR.
 					dst := e2.Operand2().(*lir.Param)
-					wr.Write("\t%s\t%s, [%s, #%d]\n",
-						store, src.String(),
-						rf.FP(), -wordSize*(dst.Id()+3)) // Params go first on stack.
+					wr.Write("\t%s\t%s, %s\n", store, src.String(), fr.addr(rf, fr.param(dst.Id())))
 				case types.Global:
 					dst := e2.Operand2().(*lir.Global)
 
 					// Used x28 for storing the temporary value that is &GLOBAL_VARIABLE. Load cannot happen after return.
-					wr.Write("\tadrp\t%s, %s\n", rf.GetI(r28).String(), dst.Name())
-					wr.Write("\t%s\t%s, [%s, :lo12:%s]\n",
-						store, src.String(), rf.GetI(r28).String(), dst.Name())
+					g.genAccess(store, src, dst.Name(), "", rf, wr)
 				default:
 					panic(fmt.Sprintf("compiler error: unexpected store destination type %d", e2.Operand2().Type()))
 				}
 			case types.Constant:
-				r := e2.GetHW().(*lir.LiveNode).Reg.(regfile.Register) // Assigned hardware register.
+				if e2.(*lir.Constant).Immediate() {
+					// Encoded as immediate operand by its users.
+					break
+				}
+				r := e2.GetHW().Reg // Assigned hardware register.
 				if e2.DataType() == types.Int {
 					val := e2.(*lir.Constant).Value().(int)
 					if minImm <= val && val <= maxImm {
 						// Used immediate instruction.
 						wr.Write("\tmov\t%s, #%d\n", r.String(), val)
+					} else if !g.pooled(e2.(*lir.Constant)) {
+						// Synthesise from halfwords.
+						for _, e3 := range movImmediate(r.String(), val) {
+							wr.Write("%s\n", e3)
+						}
 					} else {
 						// Load hex string representation of integer and load. Use x28 as temporary register.
 						cnst := e2.(*lir.Constant)
 						istr := fmt.Sprintf("%s%d", labelConstant, cnst.GlobalSeq())
-						wr.Write("\tadrp\t%s, %s\t\t//Load constant %d\n",
-							rf.GetI(r28).String(), istr, cnst.Value().(int))
-						wr.Write("\tldr\t%s, [%s, :lo12:%s]\n", r.String(), rf.GetI(r28).String(), istr)
+						g.genAccess(load, r, istr, fmt.Sprintf("Load constant %d", cnst.Value().(int)), rf, wr)
 						cnst.Use()
 					}
+				} else if val := e2.(*lir.Constant).Value().(float64); val == 0 && !math.Signbit(val) {
+					// Copy positive zero from the zero register.
+					wr.Write("\tfmov\t%s, xzr\n", r.String())
+				} else if fmovImmediate(val) {
+					// Encode as immediate. The assembler requires a decimal point to parse it as a float.
+					fstr := strconv.FormatFloat(val, 'f', -1, 64)
+					if !strings.Contains(fstr, ".") {
+						fstr += ".0"
+					}
+					wr.Write("\tfmov\t%s, #%s\n", r.String(), fstr)
 				} else {
 					// Load hex string representation of float into destination register. Use x28 as temporary register.
 					cnst := e2.(*lir.Constant)
 					fstr := fmt.Sprintf("%s%d", labelConstant, cnst.GlobalSeq())
-					wr.Write("\tadrp\t%s, %s\t\t//Load constant %f\n",
-						rf.GetI(r28).String(), fstr, cnst.Value().(float64))
-					wr.Write("\tldr\t%s, [%s, :lo12:%s]\n", r.String(), rf.GetI(r28).String(), fstr)
+					g.genAccess(load, r, fstr, fmt.Sprintf("Load constant %f", cnst.Value().(float64)), rf, wr)
 					cnst.Use()
 				}
 			case types.CastInstruction:
 				if e2.DataType() == types.Int {
 					// Cast float to int.
 					wr.Write("\tfcvtns\t%s, %s\n",
-						e2.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String(),
-						e2.Operand1().GetHW().(*lir.LiveNode).Reg.(regfile.Register).String()) // Convert to nearest.
+						g.intReg(e2.GetHW().Reg),
+						e2.Operand1().GetHW().Reg.String()) // Convert to nearest.
+					g.genExtend(e2.GetHW().Reg, wr)
 				} else {
 					// Cast int to float.
 					wr.Write("\tscvtf\t%s, %s\n",
-						e2.GetHW().(*lir.LiveNode).Reg.(regfile.Register).String(),
-						e2.Operand1().GetHW().(*lir.LiveNode).Reg.(regfile.Register).String())
+						e2.GetHW().Reg.String(),
+						e2.Operand1().GetHW().Reg.String())
 				}
 			case types.BranchInstruction:
-				if err := genBranch(e2.(*lir.BranchInstruction), rf, wr, &ls); err != nil {
-					return err
+				if err := genBranch(e2.(*lir.BranchInstruction), next, rf, wr); err != nil {
+					return locate(e2, err)
 				}
 			case types.ReturnInstruction:
-				if err := genReturn(e2.(*lir.ReturnInstruction), fun, &rf, wr); err != nil {
-					return err
+				if err := g.genReturn(e2.(*lir.ReturnInstruction), fun, fr, &rf, wr); err != nil {
+					return locate(e2, err)
 				}
 			case types.FunctionCallInstruction:
-				if err := genFunctionCall(e2.(*lir.FunctionCallInstruction), rf, wr); err != nil {
-					return err
+				if err := g.genFunctionCall(e2.(*lir.FunctionCallInstruction), fun, rf, wr); err != nil {
+					return locate(e2, err)
 				}
 			case types.PreserveInstruction:
 				// Preserves x0 or d0 from function calls.
-				dst := e2.GetHW().(*lir.LiveNode).Reg.(regfile.Register)
-				src := e2.Operand1().GetHW().(*lir.LiveNode).Reg.(regfile.Register)
-				if e2.DataType() == types.Int {
+				dst := e2.GetHW().Reg
+				src := e2.Operand1().GetHW().Reg
+				if dst.Id() == src.Id() {
+					// Coalesced by the register allocator.
+				} else if e2.DataType() != types.Float {
 					wr.Write("\tmov\t%s, %s\n", dst.String(), src.String())
 				}else{
 					wr.Write("\tfmov\t%s, %s\n", dst.String(), src.String())
 				}
+			case types.SelectInstruction:
+				// Loads and stores its spilled operands and result by itself.
+				if err := g.genSelect(e2.(*lir.SelectInstruction), fun, rf, wr); err != nil {
+					return locate(e2, err)
+				}
+				continue
 			case types.PrintInstruction, types.Global, types.Param, types.DeclareInstruction:
 				// Ignore, because they've been handled during LIR construction.
 				continue
 			default:
-				return fmt.Errorf("unexpected LIR instruction type %d", e2.Type())
+				return locate(e2, fmt.Errorf("unexpected LIR instruction type %d", e2.Type()))
 			}
+
+			// Store spilled result to its spill slot.
+			g.genSpill(e2, reloaded, fun, rf, wr)
 		}
 	}
+	g.genProcEnd(fun.Name(), wr)
 	return nil
 }
 
-// genReturn generates a function return statement. An error is returned if something went wrong.
-func genReturn(v *lir.ReturnInstruction, fun *lir.Function, rf *RegisterFile, wr *util.Writer) error {
-	r := v.Operand1().GetHW().(*lir.LiveNode).Reg.(regfile.Register)
+// genReturn generates a function return statement that tears down the stack frame fr. An error is returned if
+// something went wrong.
+func (g *generator) genReturn(v *lir.ReturnInstruction, fun *lir.Function, fr frame, rf *RegisterFile,
+	wr *util.Writer) error {
+	r := v.Operand1().GetHW().Reg
 
 	// Check if correct register index was assigned.
 	if r.Id() != r0 {
@@ -253,22 +300,381 @@ func genReturn(v *lir.ReturnInstruction, fun *lir.Function, rf *RegisterFile, wr
 			wr.Write("\tscvtf\t%s, %s\n", rf.GetF(v0).String(), r.String())
 		} else {
 			// Cast float to integer.
-			wr.Write("\tfcvtns\t%s, %s\n", rf.GetI(r0).String(), r.String()) // Convert to nearest.
+			wr.Write("\tfcvtns\t%s, %s\n", g.intReg(rf.GetI(r0)), r.String()) // Convert to nearest.
+			g.genExtend(rf.GetI(r0), wr)
 		}
 	}
 
-	// Calculate allocated stack size.
-	sa := wordSize * (len(fun.Params()) + len(fun.Locals()) + 2) // Stack adjust.
-	spill := sa % stackAlign
-	if spill != 0 {
-		sa += stackAlign - spill
+	// Restore callee-saved registers, FP and LR, and de-allocate stack.
+	g.genEpilogue(fr, *rf, wr)
+	return nil
+}
+
+// genPrologue generates the allocation of the stack frame fr, which stores FP and LR at its top and the callee-saved
+// registers at its bottom, and sets FP to the old SP. Leaf frames neither save nor set FP and LR. Call frame
+// information directives are generated after each instruction that changes the canonical frame address or saves a
+// register.
+func (g *generator) genPrologue(fr frame, rf RegisterFile, wr *util.Writer) {
+	sa, saved := fr.size, fr.saved
+	if fr.leaf {
+		if sa > 0 {
+			wr.Write("\tsub\t%s, %s, #%d\n", rf.SP(), rf.SP(), sa)
+			wr.Write("\t.cfi_def_cfa_offset\t%d\n", sa)
+		}
+		genSaveRestore(saved, false, rf, wr)
+		for i1, e1 := range saved {
+			wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(e1), wordSize*i1-sa)
+		}
+		return
+	}
+
+	// Adjust stack.
+	wr.Write("\tsub\t%s, %s, #%d\n", rf.SP(), rf.SP(), sa)
+	wr.Write("\t.cfi_def_cfa_offset\t%d\n", sa)
+
+	// Save old frame pointer and link register.
+	wr.Write("\tstp\t%s, %s, [%s, #%d]\n", rf.FP(), rf.LR(), rf.SP(), sa-(wordSize<<1))
+	wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(rf.FP()), -(wordSize << 1))
+	wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(rf.LR()), -wordSize)
+
+	// Save callee-saved registers that are used by the function body.
+	genSaveRestore(saved, false, rf, wr)
+	for i1, e1 := range saved {
+		wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(e1), wordSize*i1-sa)
+	}
+
+	// Set frame pointer to old stack pointer. The canonical frame address follows FP from here on.
+	wr.Write("\tadd\t%s, %s, #%d\n", rf.FP(), rf.SP(), sa)
+	wr.Write("\t.cfi_def_cfa\t%d, 0\n", dwarfReg(rf.FP()))
+
+	// Store the stack protector canary directly below FP and LR.
+	if fr.canary {
+		tmp := rf.GetI(scratchi[0])
+		g.genGuard(tmp, wr)
+		wr.Write("\t%s\t%s, [%s, #%d]\n", store, tmp.String(), rf.FP(), -wordSize*3)
+	}
+}
+
+// genEpilogue generates the restoring of the callee-saved registers, FP and LR, de-allocates the stack frame fr set up
+// by genPrologue, and returns. The call frame information state is remembered before and restored after the
+// epilogue, because code following the return is still inside the stack frame.
+func (g *generator) genEpilogue(fr frame, rf RegisterFile, wr *util.Writer) {
+	sa, saved := fr.size, fr.saved
+	wr.Write("\t.cfi_remember_state\n")
+
+	// Check that the stack protector canary is intact. The failure handler doesn't return.
+	if fr.canary {
+		r1, r2 := rf.GetI(scratchi[0]), rf.GetI(scratchi[1])
+		wr.Write("\t%s\t%s, [%s, #%d]\n", load, r1.String(), rf.FP(), -wordSize*3)
+		g.genGuard(r2, wr)
+		wr.Write("\tcmp\t%s, %s\n", r1.String(), r2.String())
+		wr.Write("\tb.eq\t1f\n")
+		wr.Write("\tbl\t%s\n", g.symbol(labelGuardFail))
+		wr.Write("1:\n")
 	}
 
+	// Restore callee-saved registers.
+	genSaveRestore(saved, true, rf, wr)
+
+	if fr.leaf {
+		if sa > 0 {
+			wr.Write("\tadd\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), sa)
+			wr.Write("\t.cfi_def_cfa_offset\t0\n")
+		}
+		wr.Write("\tret\n")
+		wr.Write("\t.cfi_restore_state\n")
+		return
+	}
+
+	// SP still equals FP-sa, so the canonical frame address can be described by SP before FP is restored.
+	wr.Write("\t.cfi_def_cfa\t%d, %d\n", dwarfReg(rf.SP()), sa)
+
 	// Restore FP and LR.
 	wr.Write("\tldp\t%s, %s, [%s, #%d]\n", rf.FP().String(), rf.LR().String(), rf.SP().String(), sa-(wordSize<<1))
 
 	// De-allocate stack.
 	wr.Write("\tadd\t%s, %s, #%d\n", rf.SP().String(), rf.SP().String(), sa)
+	wr.Write("\t.cfi_def_cfa_offset\t0\n")
 	wr.Write("\tret\n")
+	wr.Write("\t.cfi_restore_state\n")
+}
+
+// genProcEnd generates the end of the call frame information and, for ELF output, the size of the function name.
+func (g *generator) genProcEnd(name string, wr *util.Writer) {
+	wr.Write("\t.cfi_endproc\n")
+	if !g.darwin {
+		wr.Write("\t.size\t%s, .-%s\n", name, name)
+	}
+}
+
+// dwarfReg returns the DWARF register number of register r, which is used by the call frame information directives.
+// Integer registers are numbered 0-31 and floating point registers 64-95.
+func dwarfReg(r regfile.Register) int {
+	if r.Type() == int(f) {
+		return 64 + r.Id()
+	}
+	return r.Id()
+}
+
+// calleeSaved returns the callee-saved registers r19-r28 and v8-v15 that are written by the body of Function fun,
+// ordered by type and index. Register x28 is included if it's used as a temporary register.
+func (g *generator) calleeSaved(fun *lir.Function, rf RegisterFile) []regfile.Register {
+	usedi := make([]bool, len(rf.regi))
+	usedf := make([]bool, len(rf.regf))
+	for _, e1 := range fun.Blocks() {
+		for _, e2 := range e1.Instructions() {
+			if g.usesScratch(e2) {
+				usedi[r28] = true
+			}
+			n := e2.GetHW()
+			if n == nil || e2.DataType() == types.VaList {
+				// No code is generated for writing variable argument lists.
+				continue
+			}
+			if r := n.Reg; r != nil {
+				if r.Type() == int(i) {
+					usedi[r.Id()] = true
+				} else {
+					usedf[r.Id()] = true
+				}
+			}
+		}
+	}
+
+	res := make([]regfile.Register, 0, r28-r19+v15-v8+2)
+	for i1 := r19; i1 <= r28; i1++ {
+		if usedi[i1] {
+			res = append(res, rf.GetI(i1))
+		}
+	}
+	for i1 := v8; i1 <= v15; i1++ {
+		if usedf[i1] {
+			res = append(res, rf.GetF(i1))
+		}
+	}
+	return res
+}
+
+// usesScratch returns true if the generated code of the LIR instruction v uses x28 as a temporary register.
+func (g *generator) usesScratch(v lir.Value) bool {
+	switch v.Type() {
+	case types.StoreInstruction:
+		return v.Operand2().Type() == types.Global
+	case types.LoadInstruction:
+		return v.Operand1().Type() == types.Global
+	case types.Constant:
+		return g.pooled(v.(*lir.Constant))
+	}
+	return false
+}
+
+// pooled returns true if the Constant c is loaded from the literal pool. Floating point constants are pooled unless
+// they're positive zero or fit in the immediate of fmov, and integer constants are pooled if they don't fit in the
+// immediate of mov and aren't synthesised.
+func (g *generator) pooled(c *lir.Constant) bool {
+	if c.DataType() != types.Int {
+		val := c.Value().(float64)
+		return !(val == 0 && !math.Signbit(val)) && !fmovImmediate(val)
+	}
+	val := c.Value().(int)
+	if minImm <= val && val <= maxImm {
+		return false
+	}
+	return !g.synthesise(len(movImmediate("", val)))
+}
+
+// newFrame returns the stack frame of Function fun, which holds its parameters, local variables, spill slots, FP and
+// LR and the callee-saved registers written by the function body. FP and LR are omitted for leaf functions without
+// local variables and spill slots if -fomit-frame-pointer is set. The size is aligned with the stack alignment.
+func (g *generator) newFrame(fun *lir.Function, rf RegisterFile) frame {
+	fr := frame{
+		saved: g.calleeSaved(fun, rf),
+	}
+	fr.leaf = g.omitFP && len(fun.Locals()) == 0 && spillSlots(fun) == 0 && isLeaf(fun)
+	fr.canary = g.stackProtector && !fr.leaf
+	n := len(fun.Params()) + len(fun.Locals()) + spillSlots(fun) + len(fr.saved)
+	if !fr.leaf {
+		n += 2 // FP and LR.
+	}
+	if fr.canary {
+		n++
+	}
+	fr.size = wordSize * n
+	if spill := fr.size % stackAlign; spill != 0 {
+		fr.size += stackAlign - spill
+	}
+	return fr
+}
+
+// isLeaf returns true if Function fun doesn't call any functions, including printf.
+func isLeaf(fun *lir.Function) bool {
+	for _, e1 := range fun.Blocks() {
+		for _, e2 := range e1.Instructions() {
+			if e2.Type() == types.FunctionCallInstruction {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// param returns the frame pointer relative offset of the stack slot of parameter number id. Parameters go first on
+// the stack.
+func (fr frame) param(id int) int {
+	if fr.leaf {
+		// Add 1 to offset to align for bottom-down.
+		return -wordSize * (id + 1)
+	}
+	// Add 3 to offset: 1 to align for bottom-down, 2 for skipping stack saved SP and LR, and 1 for the canary.
+	if fr.canary {
+		return -wordSize * (id + 4)
+	}
+	return -wordSize * (id + 3)
+}
+
+// local returns the frame pointer relative offset of the stack slot of local variable number seq of Function fun.
+// Locals are stored after parameters.
+func (fr frame) local(fun *lir.Function, seq int) int {
+	return fr.param(seq + len(fun.Params()))
+}
+
+// addr returns the memory operand addressing the frame pointer relative offset. The operand is relative to SP if
+// the frame pointer is omitted.
+func (fr frame) addr(rf RegisterFile, offset int) string {
+	if fr.leaf {
+		return fmt.Sprintf("[%s, #%d]", rf.SP().String(), offset+fr.size)
+	}
+	return fmt.Sprintf("[%s, #%d]", rf.FP().String(), offset)
+}
+
+// spillSlots returns the number of spill slots assigned to the values of Function fun by the register allocator.
+func spillSlots(fun *lir.Function) int {
+	res := 0
+	for _, e1 := range fun.Blocks() {
+		for _, e2 := range e1.Instructions() {
+			if n := e2.GetHW(); n != nil && n.Spill && n.Slot >= res {
+				res = n.Slot + 1
+			}
+		}
+	}
+	return res
+}
+
+// spillOffset returns the frame pointer relative offset of the spill slot of the spilled LiveNode n of Function fun.
+// Spill slots are stored after the parameters and local variables.
+func (g *generator) spillOffset(fun *lir.Function, n *lir.LiveNode) int {
+	// Add 3 to offset: 1 to align for bottom-down, 2 for skipping stack saved SP and LR.
+	return -wordSize * (n.Slot + 3 + g.canarySlots() + len(fun.Params()) + len(fun.Locals()))
+}
+
+// canarySlots returns the number of stack slots between FP and LR and the parameters of non-leaf functions, which
+// hold the stack protector canary if -fstack-protector is set.
+func (g *generator) canarySlots() int {
+	if g.stackProtector {
+		return 1
+	}
+	return 0
+}
+
+// genGuard generates the load of the stack protector canary into register dst. The canary is defined by the C
+// library, so it's always addressed through the global offset table.
+func (g *generator) genGuard(dst regfile.Register, wr *util.Writer) {
+	label := g.symbol(labelGuard)
+	wr.Write("\tadrp\t%s, %s\n", dst.String(), g.gotPage(label))
+	wr.Write("\tldr\t%s, [%s, %s]\n", dst.String(), dst.String(), g.gotPageOff(label))
+	wr.Write("\t%s\t%s, [%s]\n", load, dst.String(), dst.String())
+}
+
+// spilled returns the LiveNode of LIR value v if v is spilled to a spill slot, and nil otherwise.
+func spilled(v lir.Value) *lir.LiveNode {
+	if v == nil {
+		return nil
+	}
+	if n := v.GetHW(); n != nil && n.Spill {
+		return n
+	}
 	return nil
 }
+
+// genReload loads the spilled operands of the LIR instruction v from their spill slots into the scratch registers,
+// and assigns a scratch register to the result of v if it's spilled. Function calls load spilled arguments by
+// themselves. Returns the LiveNodes that were assigned a scratch register, which are released by genSpill.
+func (g *generator) genReload(v lir.Value, fun *lir.Function, rf RegisterFile, wr *util.Writer) []*lir.LiveNode {
+	switch v.Type() {
+	case types.DataInstruction, types.LoadInstruction, types.StoreInstruction, types.Constant,
+		types.CastInstruction, types.PreserveInstruction, types.BranchInstruction, types.ReturnInstruction:
+		if v.DataType() == types.VaList {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	res := make([]*lir.LiveNode, 0, 3)
+	ii := 0
+	fi := 0
+	for _, e1 := range []lir.Value{v.Operand1(), v.Operand2()} {
+		n := spilled(e1)
+		if n == nil || n.Reg != nil {
+			// Not spilled or already loaded, because both operands are the same value.
+			continue
+		}
+		if typ := e1.DataType(); typ == types.Int || typ == types.String {
+			n.Reg = rf.GetI(scratchi[ii])
+			ii++
+		} else {
+			n.Reg = rf.GetF(scratchf[fi])
+			fi++
+		}
+		wr.Write("\t%s\t%s, [%s, #%d]\n", load, n.Reg.String(), rf.FP(), g.spillOffset(fun, n))
+		res = append(res, n)
+	}
+
+	// The result may overwrite an operand's scratch register, because the operands are read first.
+	if n := spilled(v); n != nil {
+		if typ := v.DataType(); typ == types.Int || typ == types.String {
+			n.Reg = rf.GetI(scratchi[0])
+		} else {
+			n.Reg = rf.GetF(scratchf[0])
+		}
+		res = append(res, n)
+	}
+	return res
+}
+
+// genSpill stores the result of the LIR instruction v to its spill slot, if it's spilled, and releases the scratch
+// registers of the LiveNodes reloaded returned by genReload.
+func (g *generator) genSpill(v lir.Value, reloaded []*lir.LiveNode, fun *lir.Function, rf RegisterFile,
+	wr *util.Writer) {
+	if n := spilled(v); n != nil && n.Reg != nil {
+		wr.Write("\t%s\t%s, [%s, #%d]\n", store, n.Reg.String(), rf.FP(), g.spillOffset(fun, n))
+	}
+	for _, e1 := range reloaded {
+		e1.Reg = nil
+	}
+}
+
+// genSaveRestore generates the stores of the callee-saved registers saved to the bottom of the stack frame, or the
+// loads if restore is true. Consecutive registers of the same type are stored and loaded in pairs.
+func genSaveRestore(saved []regfile.Register, restore bool, rf RegisterFile, wr *util.Writer) {
+	pair, single := "stp", store
+	if restore {
+		pair, single = "ldp", load
+	}
+	for i1 := 0; i1 < len(saved); {
+		if i1+1 < len(saved) && saved[i1].Type() == saved[i1+1].Type() {
+			wr.Write("\t%s\t%s, %s, [%s, #%d]\n", pair, saved[i1], saved[i1+1], rf.SP(), wordSize*i1)
+			i1 += 2
+		} else {
+			wr.Write("\t%s\t%s, [%s, #%d]\n", single, saved[i1], rf.SP(), wordSize*i1)
+			i1++
+		}
+	}
+}
+
+// locate returns err as a util.CodegenError at the source location of the LIR instruction v, which prefixes the message of
+// err if the location is known.
+func locate(v lir.Value, err error) error {
+	loc := v.Location()
+	return &util.CodegenError{Position: util.Position{Line: loc.Line, Pos: loc.Pos}, Err: err}
+}
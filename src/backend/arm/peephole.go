@@ -0,0 +1,149 @@
+package arm
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// memOp defines a single register load or store with an immediate offset, such as ldr x0, [fp, #-24].
+type memOp struct {
+	op     string // Either ldr or str.
+	reg    string // Register loaded or stored.
+	base   string // Base address register.
+	offset int    // Immediate offset from base address register.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+const (
+	minPairOffset = -64 // minPairOffset defines the minimum scaled 7-bit signed offset of ldp and stp.
+	maxPairOffset = 63  // maxPairOffset defines the maximum scaled 7-bit signed offset of ldp and stp.
+)
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// reMov matches register to register moves without comments.
+var reMov = regexp.MustCompile(`^\t(mov|fmov)\t([xd][0-9]+|fp|lr), ([xd][0-9]+|fp|lr)$`)
+
+// reMem matches loads and stores of a single register with an immediate offset, without comments.
+var reMem = regexp.MustCompile(`^\t(ldr|str)\t([xwds][0-9]+), \[(x[0-9]+|fp|sp), #(-?[0-9]+)]$`)
+
+// reBranch matches unconditional and conditional branches to a label, without comments.
+var reBranch = regexp.MustCompile(`^\tb(\.[a-z]+)?\t(\S+)$`)
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// peephole optimises the aarch64 assembler text asm by looking at adjacent instructions, and returns the optimised
+// assembler text. The following optimisations are done:
+//
+//   - Moves of a 64-bit register to itself are removed.
+//   - Adjacent loads or stores of neighbouring words relative to the same base register are fused into ldp or stp.
+//   - Branches to the label immediately following the branch are removed.
+//
+// Comment lines, written by -fverbose-asm, are skipped, such that annotated assembler is optimised in the same way.
+func peephole(asm string) string {
+	lines := strings.Split(asm, "\n")
+	res := make([]string, 0, len(lines))
+	last := -1 // Index of the last line of res that isn't a comment.
+	for _, e1 := range lines {
+		if strings.HasPrefix(e1, "\t//") {
+			res = append(res, e1)
+			continue
+		}
+
+		// Remove moves of a register to itself.
+		if m := reMov.FindStringSubmatch(e1); m != nil && m[2] == m[3] {
+			continue
+		}
+
+		if last >= 0 {
+			prev := res[last]
+
+			// Remove branch to the next label.
+			if strings.HasSuffix(e1, ":") {
+				if m := reBranch.FindStringSubmatch(prev); m != nil && m[2]+":" == e1 {
+					res = append(res[:last], res[last+1:]...)
+				}
+			}
+
+			// Fuse loads and stores into pairs.
+			if s, ok := pair(prev, e1); ok {
+				res[last] = s
+				continue
+			}
+		}
+		last = len(res)
+		res = append(res, e1)
+	}
+	return strings.Join(res, "\n")
+}
+
+// pair returns the ldp or stp instruction that does the same as the two adjacent instructions first and second, if
+// both are loads or both are stores of registers of the same size to neighbouring words of the same base address
+// register. Returns false if the instructions can't be fused.
+func pair(first, second string) (string, bool) {
+	a, ok := parseMemOp(first)
+	if !ok {
+		return "", false
+	}
+	b, ok := parseMemOp(second)
+	if !ok {
+		return "", false
+	}
+	if a.op != b.op || a.base != b.base || a.reg[0] != b.reg[0] || a.reg == b.reg {
+		return "", false
+	}
+	if a.op == load && a.reg == a.base {
+		// The second load uses the base address register overwritten by the first load.
+		return "", false
+	}
+
+	size := wordSize64
+	if a.reg[0] == 'w' || a.reg[0] == 's' {
+		size = wordSize32
+	}
+	if b.offset < a.offset {
+		a, b = b, a
+	}
+	if b.offset-a.offset != size || a.offset%size != 0 ||
+		a.offset/size < minPairOffset || a.offset/size > maxPairOffset {
+		return "", false
+	}
+
+	op := "stp"
+	if a.op == load {
+		op = "ldp"
+	}
+	return fmt.Sprintf("\t%s\t%s, %s, [%s, #%d]", op, a.reg, b.reg, a.base, a.offset), true
+}
+
+// parseMemOp returns the memOp of the assembler line s, and false if s isn't a single register load or store with an
+// immediate offset.
+func parseMemOp(s string) (memOp, bool) {
+	m := reMem.FindStringSubmatch(s)
+	if m == nil {
+		return memOp{}, false
+	}
+	offset, err := strconv.Atoi(m[4])
+	if err != nil {
+		return memOp{}, false
+	}
+	return memOp{
+		op:     m[1],
+		reg:    m[2],
+		base:   m[3],
+		offset: offset,
+	}, true
+}
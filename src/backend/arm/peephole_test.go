@@ -0,0 +1,40 @@
+package arm
+
+import (
+	"testing"
+)
+
+// TestPeephole verifies that the peephole optimiser removes redundant moves and branches, and fuses adjacent loads
+// and stores only when it's safe to do so.
+func TestPeephole(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		out  string
+	}{
+		{"self move", "\tmov\tx8, x8\n\tmov\tx8, x9", "\tmov\tx8, x9"},
+		{"self fmov", "\tfmov\td1, d1", ""},
+		{"32-bit self move", "\tmov\tw8, w8", "\tmov\tw8, w8"},
+		{"branch to next", "\tb\tblock1\nblock1:", "block1:"},
+		{"conditional branch to next", "\tb.ge\tblock1\nblock1:", "block1:"},
+		{"branch elsewhere", "\tb\tblock2\nblock1:", "\tb\tblock2\nblock1:"},
+		{"ascending loads", "\tldr\tx8, [fp, #-32]\n\tldr\tx9, [fp, #-24]", "\tldp\tx8, x9, [fp, #-32]"},
+		{"descending stores", "\tstr\tx0, [fp, #-24]\n\tstr\tx1, [fp, #-32]", "\tstp\tx1, x0, [fp, #-32]"},
+		{"float loads", "\tldr\td0, [sp, #8]\n\tldr\td1, [sp, #16]", "\tldp\td0, d1, [sp, #8]"},
+		{"mixed types", "\tldr\tx0, [sp, #8]\n\tldr\td1, [sp, #16]", "\tldr\tx0, [sp, #8]\n\tldr\td1, [sp, #16]"},
+		{"same register", "\tldr\tx0, [sp, #8]\n\tldr\tx0, [sp, #16]", "\tldr\tx0, [sp, #8]\n\tldr\tx0, [sp, #16]"},
+		{"overwritten base", "\tldr\tx9, [x9, #0]\n\tldr\tx10, [x9, #8]", "\tldr\tx9, [x9, #0]\n\tldr\tx10, [x9, #8]"},
+		{"gap", "\tstr\tx0, [fp, #-24]\n\tstr\tx1, [fp, #-40]", "\tstr\tx0, [fp, #-24]\n\tstr\tx1, [fp, #-40]"},
+		{"out of range", "\tstr\tx0, [sp, #512]\n\tstr\tx1, [sp, #520]", "\tstr\tx0, [sp, #512]\n\tstr\tx1, [sp, #520]"},
+		{"three loads", "\tldr\tx0, [sp, #0]\n\tldr\tx1, [sp, #8]\n\tldr\tx2, [sp, #16]",
+			"\tldp\tx0, x1, [sp, #0]\n\tldr\tx2, [sp, #16]"},
+		{"annotated loads", "\tldr\tx8, [fp, #-32]\n\t//\t%3 = load b\n\tldr\tx9, [fp, #-24]",
+			"\tldp\tx8, x9, [fp, #-32]\n\t//\t%3 = load b"},
+		{"annotated branch", "\tb\tblock1\n\t// 4: a := b\nblock1:", "\t// 4: a := b\nblock1:"},
+	}
+	for _, e1 := range tests {
+		if res := peephole(e1.in); res != e1.out {
+			t.Errorf("%s: expected %q, got %q", e1.name, e1.out, res)
+		}
+	}
+}
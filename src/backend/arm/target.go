@@ -0,0 +1,80 @@
+package arm
+
+import (
+	"errors"
+	"vslc/src/backend"
+	"vslc/src/backend/regfile"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// target implements backend.Target for ARMv8 (aarch64).
+type target struct{}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+func init() {
+	backend.Register(util.Aarch64, target{})
+}
+
+// Name returns the name of the target architecture.
+func (target) Name() string {
+	return "aarch64"
+}
+
+// WordSize returns the width of integers in bits.
+func (target) WordSize() int {
+	return 64
+}
+
+// CreateRegisterFile returns a new register file.
+func (target) CreateRegisterFile(_ util.Options) regfile.RegisterFile {
+	return CreateRegisterFile()
+}
+
+// Validate returns an error for freestanding output, which isn't supported.
+func (target) Validate(opt util.Options) error {
+	if opt.Freestanding {
+		return errors.New("freestanding output is only supported for RISC-V")
+	}
+	return nil
+}
+
+// Generate generates assembler of LIR Module m.
+func (target) Generate(opt util.Options, m *lir.Module, root *ir.Node) error {
+	return GenArm(opt, m, root)
+}
+
+// Immediate returns true if Constant c is encoded as an immediate operand by all of its users, which depends on the
+// width of integers of opt.
+func (target) Immediate(opt util.Options, c *lir.Constant) bool {
+	return (&generator{intBits: opt.IntBits()}).immediate(c)
+}
+
+// Select returns true, because the csel and fcsel instructions select between two registers.
+func (target) Select() bool {
+	return true
+}
+
+// Toolchain returns the target triple of the C compiler driver that assembles and links the output.
+func (target) Toolchain(opt util.Options) (string, []string, error) {
+	if opt.TargetOS == util.MAC {
+		return "aarch64-apple-darwin", nil, nil
+	}
+	return "aarch64-linux-gnu", nil, nil
+}
@@ -0,0 +1,766 @@
+// Package armv7 provides means to generate 32-bit ARMv7-A assembly code from the lightweight intermediate
+// representation, for the hard-float EABI of armhf Linux systems.
+package armv7
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+)
+
+import (
+	"vslc/src/backend"
+	"vslc/src/backend/regfile"
+	"vslc/src/backend/vslrt"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// register defines a physical register, of either type integer or floating point, and an index (r0-r15 or s0-s31).
+type register struct {
+	typ int // Type of register (integer or floating point).
+	idx int // Index of register (0 = r0, 11 = fp, 4 = s4 etc.).
+	use int // Set to 1 if the register is allocated by GetNextTempI or GetNextTempF.
+}
+
+// generator holds the configuration of the ARMv7-A assembler generated for one compilation, which is derived from its
+// util.Options.
+type generator struct {
+	runtime   bool               // Set to true if main parses the command line arguments with the VSL runtime.
+	annotator *backend.Annotator // Writes the source lines and LIR instructions of functions as comments, if set.
+}
+
+// RegisterFile defines a virtual register file during compilation time. It holds 16 integer registers and the 32
+// single precision registers of the VFP register bank, per the procedure call standard for the ARM architecture.
+type RegisterFile struct {
+	regi []regfile.Register
+	regf []regfile.Register
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+const labelMain = "main"          // String literal of name of main function as defined in the output assembler.
+const labelConstant = "_L_CONST_" // String literal for all constants.
+
+const labelArgCount = "vsl.argc"  // Label of the word that holds argc for the builtin functions argc and arg.
+const labelArgVector = "vsl.argv" // Label of the word that holds argv for the builtin functions argc and arg.
+const labelArgEmpty = "vsl.empty" // Label of the empty string returned for program arguments out of range.
+
+const wordSize = 4 // Word size in bytes.
+
+// stackAlign defines the stack alignment at public interfaces. If the stack grows or shrinks, it must do so in
+// multiples of the stackAlign value.
+const stackAlign = 8 // Per chapter 5.2.1.2 of the procedure call standard for the ARM architecture.
+
+// paramReg defines the maximum number of integer arguments that can go in registers.
+const paramReg = 4
+
+// paramRegF defines the maximum number of floating point arguments that can go in registers. The procedure call
+// standard passes floats in s0-s15, but s14 and s15 are scratch registers, hence the 15th and 16th float parameters of
+// VSL functions are passed on stack. Only calls between VSL functions pass that many floats.
+const paramRegF = 14
+
+// maxOffset defines the largest offset of vldr and vstr, which limits the size of stack frames.
+const maxOffset = 1020
+
+// dwarfFloat defines the DWARF register number of s0. Integer registers are numbered 0-15.
+const dwarfFloat = 64
+
+// Integer registers.
+const (
+	r0  = iota // Argument register 0 and return value register.
+	r1         // Argument register 1.
+	r2         // Argument register 2.
+	r3         // Argument register 3.
+	r4         // Saved register 4.
+	r5         // Saved register 5.
+	r6         // Saved register 6.
+	r7         // Saved register 7.
+	r8         // Saved register 8.
+	r9         // Saved register 9, the platform register, which is a saved register on Linux.
+	r10        // Saved register 10.
+	fp         // Frame pointer.
+	ip         // Intra-procedure call scratch register.
+	sp         // Stack pointer.
+	lr         // Link register.
+	pc         // Program counter.
+)
+
+// Floating point registers s0-s31. Registers s0-s15 are argument and temporary registers, and s16-s31 are saved.
+const (
+	s0  = 0  // Argument register 0 and return value register.
+	s14 = 14 // Temporary register 14.
+	s15 = 15 // Temporary register 15.
+	s16 = 16 // Saved register 16.
+	s31 = 31 // Saved register 31.
+)
+
+// d7 is the double precision register that overlaps s14 and s15, which converts variadic floats to double.
+const d7 = "d7"
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// regi defines print friendly ABI names of the integer registers.
+var regi = [...]string{
+	"r0", "r1", "r2", "r3", "r4", "r5", "r6", "r7",
+	"r8", "r9", "r10", "fp", "ip", "sp", "lr", "pc",
+}
+
+// tempi defines the integer registers that are handed out to virtual registers. The argument registers and the
+// scratch registers are excluded.
+var tempi = [...]int{r4, r5, r6, r7, r8, r9, r10}
+
+// tempf defines the floating point registers that are handed out to virtual registers. The argument registers and the
+// scratch registers are excluded.
+var tempf = [...]int{16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31}
+
+// Scratch registers that hold spilled values while an instruction executes. Register lr also holds the address of
+// global data. The link register is saved by every function, so it's free to use in the function body. They're never
+// allocated to virtual registers.
+var (
+	scratchi = [...]int{ip, lr}
+	scratchf = [...]int{s14, s15}
+)
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// newGenerator returns the generator of the ARMv7-A assembler of the compilation configured by opt.
+func newGenerator(opt util.Options) *generator {
+	return &generator{runtime: opt.Runtime, annotator: backend.NewAnnotator(opt, "@")}
+}
+
+// GenArmv7 generates ARMv7-A assembler code from the LIR Module m, whose registers have been allocated. The first
+// function of the syntax tree root is called from an implicit main function. Print statements and the parsing of
+// command line arguments call printf, strtol and strtod of the C standard library, hence only Linux targets are
+// supported. Floats are computed in single precision with VFPv3, and integer division requires the integer divide
+// extension, which every ARMv7-A core with virtualisation support has, such as Cortex-A7 and Cortex-A53.
+func GenArmv7(opt util.Options, m *lir.Module, root *ir.Node) error {
+	g := newGenerator(opt)
+
+	// Generate .text section. Data is addressed relative to PC, hence the code is position-independent.
+	wr := opt.NewWriter()
+	defer wr.Close()
+	wr.Write("\t.arch\tarmv7-a\n")
+	wr.Write("\t.arch_extension\tidiv\n")
+	wr.Write("\t.fpu\tvfpv3-d16\n")
+	wr.Write("\t.eabi_attribute\t28, 1\t@ Tag_ABI_VFP_args: floats are passed in VFP registers\n")
+	wr.Write("\t.syntax\tunified\n")
+	wr.Write("\t.arm\n")
+	wr.Write("\t.file\t%q\n", filepath.Base(opt.Src))
+	wr.Write("\t.text\n")
+	wr.Write("\t.globl\t%s\n", labelMain)
+	wr.Write("\t.type\t%s, %%function\n", labelMain)
+
+	// Generate functions. The register file is only read, so it's shared by all worker go routines.
+	rf := CreateRegisterFile()
+	ws := wr.Split(len(m.Functions()))
+	if opt.Threads > 1 {
+		// Parallel. Every function is generated to its own Writer, which are written in the order of the functions.
+		pool := opt.NewPool("codegen")
+		if err := pool.Run(opt.Context(), len(m.Functions()), func(w *util.Worker, i int) error {
+			e1 := m.Functions()[i]
+			if len(e1.Blocks()) < 1 {
+				return nil // External functions are only declared, and generate no code.
+			}
+			w.Log.Infof("generating function %s", e1.Name())
+			return g.genFunction(e1, rf, &ws[i])
+		}); err != nil {
+			return err
+		}
+	} else {
+		// Sequential.
+		for i1, e1 := range m.Functions() {
+			if err := g.genFunction(e1, rf, &ws[i1]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Find first defined function, which will be called implicitly from main.
+	var callee *lir.Function
+	for _, e1 := range root.Children {
+		if e1.Typ == ir.FUNCTION {
+			if callee = m.GetFunction(e1.Children[0].Data.Str); callee == nil {
+				return errors.New("no functions defined for module")
+			}
+			break
+		}
+	}
+
+	// Generate implicit main function for program entry, and the runtime routines of the builtin functions.
+	builtins := m.UsesArgs()
+	if err := g.genMain(rf, callee, builtins, &wr); err != nil {
+		return err
+	}
+	if builtins {
+		genArgs(rf, &wr)
+	}
+
+	// Generate global data.
+	wr.Write("\n\t.data\n")
+	wr.Write("\t.align\t2\n")
+	for _, e1 := range m.Globals() {
+		wr.Label(e1.Name())
+		// Write globals with initial values 0. VSL doesn't support variable initialisation on declaration.
+		wr.Write("\t.word\t0x0\n")
+	}
+	if builtins {
+		wr.Label(labelArgCount)
+		wr.Write("\t.word\t0x0\n")
+		wr.Label(labelArgVector)
+		wr.Write("\t.word\t0x0\n")
+	}
+
+	// Generate constant data.
+	for _, e1 := range m.Constants() {
+		// Only write constants that have been used. Integer constants are always synthesised by movw and movt.
+		if e1.Used() {
+			wr.Label(fmt.Sprintf("%s%d", labelConstant, e1.GlobalSeq()))
+			fl := math.Float32bits(float32(e1.Value().(float64)))
+			wr.Write("\t.word\t0x%x\t@ %f\n", fl, e1.Value().(float64))
+		}
+	}
+
+	// Generate string data.
+	for _, e1 := range m.Strings() {
+		wr.Label(e1.Name())
+		wr.Write("\t.asciz\t%q\n", e1.Value())
+	}
+	if builtins {
+		wr.Label(labelArgEmpty)
+		wr.Write("\t.asciz\t\"\"\n")
+	}
+
+	// Mark the stack as non-executable.
+	wr.Write("\n\t.section\t.note.GNU-stack,\"\",%%progbits\n")
+	return nil
+}
+
+// genMain generates an implicit main function that checks input command-line arguments and calls the function callee.
+// After the function callee returns the main function exits the program with the return value of the call to callee.
+// If the return value of callee is a floating point value, the value is cast to integer. If builtins is set, argc and
+// argv are stored for the builtin functions argc and arg, and a callee without parameters accepts any number of
+// arguments.
+func (g *generator) genMain(rf RegisterFile, callee *lir.Function, builtins bool, wr *util.Writer) error {
+	if callee == nil {
+		return errors.New("no functions defined for module")
+	}
+	wr.Write("\n\t.align\t2\n")
+	wr.Label(labelMain)
+	wr.Write("\t.cfi_startproc\n")
+
+	// Stack from top to bottom. Register r4 holds the index of the argument being parsed, for error reporting.
+	//
+	// TOP
+	// <--- FP
+	// LR
+	// FP
+	// argc
+	// **argv
+	// parsed argument 0
+	// parsed argument 1
+	// ...
+	// parsed argument n
+	// r4
+	// <--- SP
+	//
+	// BOTTOM
+	fr := frame{saved: []regfile.Register{rf.GetI(r4)}, offsets: []int{0}}
+	fr.size = wordSize * (5 + len(callee.Params()))
+	if res := fr.size % stackAlign; res != 0 {
+		fr.size += stackAlign - res
+	}
+	if fr.size > maxOffset {
+		return fmt.Errorf("function %s has too many parameters", callee.Name())
+	}
+	fpOffsetArgc := -wordSize * 3 // Offset of argc on stack from FP.
+	fpOffsetArgv := -wordSize * 4 // Offset of argv on stack from FP.
+	arg := func(i1 int) int {
+		// Offset of parsed argument i1 on stack from FP. The runtime library stores arguments in ascending order.
+		if g.runtime {
+			return fpOffsetArgv - wordSize*(len(callee.Params())-i1)
+		}
+		return fpOffsetArgv - wordSize*(i1+1)
+	}
+
+	genPrologue(fr, rf, wr)
+	fp := rf.FP().String()
+	tmp := rf.GetI(scratchi[0]).String()
+	wr.Write("\tstr\t%s, [%s, #%d]\n", rf.GetI(r0).String(), fp, fpOffsetArgc)
+	wr.Write("\tstr\t%s, [%s, #%d]\n", rf.GetI(r1).String(), fp, fpOffsetArgv)
+	if builtins {
+		genAccess("str", rf.GetI(r0), rf.GetI(r2), labelArgCount, wr)
+		genAccess("str", rf.GetI(r1), rf.GetI(r2), labelArgVector, wr)
+	}
+
+	// Jump labels for error checking.
+	largcok := "_L_argc_ok"     // Jump to label if argc matches parameter count of callee.
+	largverr := "_L_argv_error" // Jump to label if parameter is not integer or float.
+
+	// Any number of arguments is accepted by a callee without parameters, if they're read by the builtin functions.
+	check := !builtins || len(callee.Params()) > 0
+
+	if g.runtime && check {
+		// Parse and store arguments on stack using the runtime library, which exits on errors. The arguments argc and
+		// argv are still in r0 and r1. The types string and the arguments are null if callee has no parameters.
+		if len(callee.Params()) > 0 {
+			genAddress(rf.GetI(r2), callee.CreateGlobalString(vslrt.Types(callee)).Name(), wr)
+			genInt(rf.GetI(r3), arg(0), wr)
+			wr.Write("\tadd\t%s, %s, %s\n", rf.GetI(r3).String(), fp, rf.GetI(r3).String())
+		} else {
+			wr.Write("\tmov\t%s, #0\n", rf.GetI(r2).String())
+			wr.Write("\tmov\t%s, #0\n", rf.GetI(r3).String())
+		}
+		wr.Write("\tbl\t%s\n", vslrt.LabelArgs)
+	} else if check {
+		// Check parameter count and argc. First argument is application path.
+		wr.Write("\tsub\t%s, %s, #1\n", rf.GetI(r1).String(), rf.GetI(r0).String())
+		genInt(rf.GetI(scratchi[0]), len(callee.Params()), wr)
+		wr.Write("\tcmp\t%s, %s\n", rf.GetI(r1).String(), tmp)
+		wr.Write("\tbeq\t%s\n", largcok)
+
+		// argc is not ok.
+		var errstr *lir.String
+		if len(callee.Params()) == 1 {
+			errstr = callee.CreateGlobalString("Argument error: expected 1 argument, got %d\n")
+		} else {
+			errstr = callee.CreateGlobalString(fmt.Sprintf("Argument error: expected %d arguments, got %%d\n", len(callee.Params())))
+		}
+
+		// Load format string and call printf.
+		genAddress(rf.GetI(r0), errstr.Name(), wr)
+		wr.Write("\tbl\tprintf\n")
+
+		// Set return code and return.
+		wr.Write("\tmov\t%s, #1\n", rf.GetI(r0).String())
+		genEpilogue(fr, rf, wr)
+
+		// argc is ok.
+		wr.Label(largcok)
+
+		// Parse and store on stack to avoid overwriting during strtol/strtod calls. The end pointer of the parse is
+		// stored in the stack slot of the argument until the argument is verified.
+		for i1, e1 := range callee.Params() {
+			// Put the i'th element of argv into r0 and the address of the end pointer into r1 for strtol or strtod.
+			wr.Write("\tldr\t%s, [%s, #%d]\t@ Load argv\n", tmp, fp, fpOffsetArgv)
+			wr.Write("\tldr\t%s, [%s, #%d]\t@ Load argv[%d]\n", rf.GetI(r0).String(), tmp, wordSize*(i1+1), i1+1)
+			genInt(rf.GetI(r1), arg(i1), wr)
+			wr.Write("\tadd\t%s, %s, %s\n", rf.GetI(r1).String(), fp, rf.GetI(r1).String())
+
+			// Save current argv index in r4 for error reporting.
+			genInt(rf.GetI(r4), i1+1, wr)
+
+			if e1.DataType() == types.Int {
+				// Parse argv[i1+1] as decimal int using strtol.
+				wr.Write("\tmov\t%s, #10\n", rf.GetI(r2).String())
+				wr.Write("\tbl\tstrtol\n")
+			} else {
+				// Parse argv[i1+1] as float using strtod, which returns a double in d0.
+				wr.Write("\tbl\tstrtod\n")
+				wr.Write("\tvcvt.f32.f64\t%s, d0\n", rf.GetF(s0).String())
+			}
+
+			// Verify that argument was non-empty and parsed to its end.
+			wr.Write("\tldr\t%s, [%s, #%d]\t@ Load end pointer\n", rf.GetI(r2).String(), fp, arg(i1))
+			wr.Write("\tldr\t%s, [%s, #%d]\n", tmp, fp, fpOffsetArgv)
+			wr.Write("\tldr\t%s, [%s, #%d]\n", rf.GetI(r3).String(), tmp, wordSize*(i1+1))
+			wr.Write("\tcmp\t%s, %s\n", rf.GetI(r2).String(), rf.GetI(r3).String())
+			wr.Write("\tbeq\t%s\n", largverr)
+			wr.Write("\tldrb\t%s, [%s]\n", rf.GetI(r3).String(), rf.GetI(r2).String())
+			wr.Write("\tcmp\t%s, #0\n", rf.GetI(r3).String())
+			wr.Write("\tbne\t%s\n", largverr)
+			if e1.DataType() == types.Int {
+				wr.Write("\tstr\t%s, [%s, #%d]\n", rf.GetI(r0).String(), fp, arg(i1))
+			} else {
+				wr.Write("\tvstr\t%s, [%s, #%d]\n", rf.GetF(s0).String(), fp, arg(i1))
+			}
+		}
+	}
+
+	// Count arguments passed on stack.
+	ii, fi, stack := 0, 0, 0
+	for _, e1 := range callee.Params() {
+		if nextArgument(rf, e1.DataType() == types.Float, &ii, &fi) == nil {
+			stack++
+		}
+	}
+	size := stack * wordSize
+	if res := size % stackAlign; res != 0 {
+		size += stackAlign - res
+	}
+	if size > 0 {
+		genSP("sub", size, rf, wr)
+	}
+
+	// Load arguments from stack into registers or pass on stack.
+	ii, fi = 0, 0
+	si := 0 // Index of next stack slot.
+	for i1, e1 := range callee.Params() {
+		isFloat := e1.DataType() == types.Float
+		if r := nextArgument(rf, isFloat, &ii, &fi); r != nil {
+			wr.Write("\t%s\t%s, [%s, #%d]\t@ Load parsed argv[%d]\n", load(r), r.String(), fp, arg(i1), i1+1)
+		} else {
+			wr.Write("\tldr\t%s, [%s, #%d]\n", tmp, fp, arg(i1))
+			wr.Write("\tstr\t%s, [%s, #%d]\n", tmp, rf.SP().String(), wordSize*si)
+			si++
+		}
+	}
+
+	// Call VSL callee function.
+	wr.Write("\tbl\t%s\n", callee.Name())
+	if size > 0 {
+		genSP("add", size, rf, wr)
+	}
+
+	// Convert float result from s0 to r0 if necessary. Round to nearest.
+	if callee.DataType() == types.Float {
+		wr.Write("\tvcvtr.s32.f32\t%s, %s\n", rf.GetF(s0).String(), rf.GetF(s0).String())
+		wr.Write("\tvmov\t%s, %s\n", rf.GetI(r0).String(), rf.GetF(s0).String())
+	}
+
+	// De-allocate stack and return, result from callee is already in r0.
+	genEpilogue(fr, rf, wr)
+
+	if len(callee.Params()) > 0 && !g.runtime {
+		// argv errors jump here.
+		wr.Label(largverr)
+		errstr := callee.CreateGlobalString("Argument error: argument %ld is neither int nor float\n")
+
+		// Load format string and call printf.
+		genAddress(rf.GetI(r0), errstr.Name(), wr)
+		wr.Write("\tmov\t%s, %s\n", rf.GetI(r1).String(), rf.GetI(r4).String()) // Move saved argument index into r1.
+		wr.Write("\tbl\tprintf\n")
+
+		// Set return code and return.
+		wr.Write("\tmov\t%s, #1\n", rf.GetI(r0).String())
+		genEpilogue(fr, rf, wr)
+	}
+	genProcEnd(labelMain, wr)
+	return nil
+}
+
+// CreateRegisterFile returns a new ARMv7 RegisterFile.
+// genArgs generates the runtime routines of the builtin functions argc and arg, which read argc and argv as stored by
+// the implicit main function. Program arguments out of range are the empty string, which strtol and strtod parse as
+// zero. Floats are converted from the double returned by strtod.
+func genArgs(rf RegisterFile, wr *util.Writer) {
+	r0s, r2s := rf.GetI(r0).String(), rf.GetI(r2).String()
+	start := func(name string) {
+		wr.Write("\n\t.align\t2\n")
+		wr.Write("\t.type\t%s, %%function\n", name)
+		wr.Label(name)
+		wr.Write("\t.cfi_startproc\n")
+	}
+
+	// argc returns the number of arguments, which excludes the program name.
+	start(lir.LabelArgc)
+	genAccess("ldr", rf.GetI(r0), rf.GetI(ip), labelArgCount, wr)
+	wr.Write("\tsub\t%s, %s, #1\n", r0s, r0s)
+	wr.Write("\tbx\tlr\n")
+	genProcEnd(lir.LabelArgc, wr)
+
+	// The string of argument r0 is argv[r0], or the empty string if r0 is out of range. Local label 1 is taken by
+	// genAddress.
+	start(lir.LabelArgString)
+	genAccess("ldr", rf.GetI(r2), rf.GetI(ip), labelArgCount, wr)
+	wr.Write("\tcmp\t%s, #1\n", r0s)
+	wr.Write("\tblt\t2f\n")
+	wr.Write("\tcmp\t%s, %s\n", r0s, r2s)
+	wr.Write("\tbge\t2f\n")
+	genAccess("ldr", rf.GetI(r2), rf.GetI(ip), labelArgVector, wr)
+	wr.Write("\tldr\t%s, [%s, %s, lsl #2]\n", r0s, r2s, r0s)
+	wr.Write("\tbx\tlr\n")
+	wr.Write("2:\n")
+	genAddress(rf.GetI(r0), labelArgEmpty, wr)
+	wr.Write("\tbx\tlr\n")
+	genProcEnd(lir.LabelArgString, wr)
+
+	// Integer and float arguments parse the string of the argument.
+	for _, e1 := range []string{lir.LabelArgInt, lir.LabelArgFloat} {
+		start(e1)
+		wr.Write("\tpush\t{fp, lr}\n")
+		wr.Write("\t.cfi_def_cfa_offset\t%d\n", stackAlign)
+		wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(rf.FP()), -stackAlign)
+		wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(rf.LR()), -wordSize)
+		wr.Write("\tbl\t%s\n", lir.LabelArgString)
+		wr.Write("\tmov\t%s, #0\n", rf.GetI(r1).String())
+		if e1 == lir.LabelArgInt {
+			wr.Write("\tmov\t%s, #10\n", r2s)
+			wr.Write("\tbl\tstrtol\n")
+		} else {
+			wr.Write("\tbl\tstrtod\n")
+			wr.Write("\tvcvt.f32.f64\t%s, d0\n", rf.GetF(s0).String())
+		}
+		wr.Write("\tpop\t{fp, pc}\n")
+		genProcEnd(e1, wr)
+	}
+}
+
+func CreateRegisterFile() RegisterFile {
+	rf := RegisterFile{
+		regi: make([]regfile.Register, len(regi)),
+		regf: make([]regfile.Register, 32),
+	}
+
+	// Initiate registers.
+	for i1 := range rf.regi {
+		rf.regi[i1] = &register{
+			typ: int(types.Int),
+			idx: i1,
+		}
+	}
+	for i1 := range rf.regf {
+		rf.regf[i1] = &register{
+			typ: int(types.Float),
+			idx: i1,
+		}
+	}
+	return rf
+}
+
+// genAddress generates the instructions that put the address of the label of module data in integer register dst.
+// The offset of the label from PC is synthesised by movw and movt, and the numbered local label marks the instruction
+// that reads PC, which is 8 bytes ahead in ARM state. This is position-independent.
+func genAddress(dst regfile.Register, label string, wr *util.Writer) {
+	wr.Write("\tmovw\t%s, #:lower16:(%s-(1f+8))\n", dst.String(), label)
+	wr.Write("\tmovt\t%s, #:upper16:(%s-(1f+8))\n", dst.String(), label)
+	wr.Write("1:\tadd\t%s, pc, %s\n", dst.String(), dst.String())
+}
+
+// genAccess generates the load or store op of register r from or to the word at the label of module data, using
+// integer register tmp to hold the pc-relative address, like genAddress.
+func genAccess(op string, r, tmp regfile.Register, label string, wr *util.Writer) {
+	genAddress(tmp, label, wr)
+	wr.Write("\t%s\t%s, [%s]\n", op, r.String(), tmp.String())
+}
+
+// genInt generates the instructions that put the 32-bit integer v in integer register dst: mvn for small negative
+// integers, and movw followed by movt if the upper half isn't zero otherwise.
+func genInt(dst regfile.Register, v int, wr *util.Writer) {
+	u := uint32(v)
+	if ^u < 256 {
+		wr.Write("\tmvn\t%s, #%d\n", dst.String(), ^u)
+		return
+	}
+	wr.Write("\tmovw\t%s, #%d\n", dst.String(), u&0xffff)
+	if u>>16 != 0 {
+		wr.Write("\tmovt\t%s, #%d\n", dst.String(), u>>16)
+	}
+}
+
+// genSP generates the instruction op, add or sub, that adjusts the stack pointer by n bytes. The scratch register ip
+// holds n if it can't be encoded as an immediate operand.
+func genSP(op string, n int, rf RegisterFile, wr *util.Writer) {
+	sp := rf.SP().String()
+	if immediate(uint32(n)) {
+		wr.Write("\t%s\t%s, %s, #%d\n", op, sp, sp, n)
+		return
+	}
+	tmp := rf.GetI(scratchi[0])
+	genInt(tmp, n, wr)
+	wr.Write("\t%s\t%s, %s, %s\n", op, sp, sp, tmp.String())
+}
+
+// immediate returns true if v can be encoded as the immediate operand of a data-processing instruction, which is an
+// 8-bit value rotated right by an even number of bits.
+func immediate(v uint32) bool {
+	for i1 := uint(0); i1 < 32; i1 += 2 {
+		if (v<<i1|v>>(32-i1))&^0xff == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// load returns the instruction that loads a value into register r.
+func load(r regfile.Register) string {
+	if r.Type() == int(types.Float) {
+		return "vldr"
+	}
+	return "ldr"
+}
+
+// store returns the instruction that stores a value from register r.
+func store(r regfile.Register) string {
+	if r.Type() == int(types.Float) {
+		return "vstr"
+	}
+	return "str"
+}
+
+// ----------------------------
+// ----- Register methods -----
+// ----------------------------
+
+// String returns the assembler string of the register.
+func (r register) String() string {
+	if r.typ == int(types.Int) {
+		return regi[r.idx]
+	}
+	return fmt.Sprintf("s%d", r.idx)
+}
+
+// Id returns the index of the register r.
+func (r register) Id() int {
+	return r.idx
+}
+
+// Type returns the register type, 0 = integer and 1 = floating point.
+func (r register) Type() int {
+	return r.typ
+}
+
+// Used returns true if the register has been allocated (is in use).
+func (r register) Used() bool {
+	return r.use == 1
+}
+
+// ---------------------------------
+// ----- Register file methods -----
+// ---------------------------------
+
+// GetI returns integer register with index i.
+func (rf RegisterFile) GetI(i int) regfile.Register {
+	if i < 0 || i >= len(rf.regi) {
+		return nil
+	}
+	return rf.regi[i]
+}
+
+// GetF returns floating point register with index i.
+func (rf RegisterFile) GetF(i int) regfile.Register {
+	if i < 0 || i >= len(rf.regf) {
+		return nil
+	}
+	return rf.regf[i]
+}
+
+// ArgI returns the integer argument register with index i, where r0 is the return value register.
+func (rf RegisterFile) ArgI(i int) regfile.Register {
+	if i < 0 || i >= paramReg {
+		return nil
+	}
+	return rf.regi[r0+i]
+}
+
+// ArgF returns the floating point argument register with index i, where s0 is the return value register.
+func (rf RegisterFile) ArgF(i int) regfile.Register {
+	if i < 0 || i >= paramRegF {
+		return nil
+	}
+	return rf.regf[s0+i]
+}
+
+// GetNextTempI returns the next available integer register that hasn't been allocated yet.
+// If no registers are vacant, <nil> is returned.
+func (rf RegisterFile) GetNextTempI() regfile.Register {
+	return nextTemp(rf.regi, tempi[:], nil)
+}
+
+// GetNextTempF returns the next available floating point register that hasn't been allocated yet.
+// If no registers are vacant, <nil> is returned.
+func (rf RegisterFile) GetNextTempF() regfile.Register {
+	return nextTemp(rf.regf, tempf[:], nil)
+}
+
+// GetNextTempIExclude returns the next available integer register that hasn't been allocated yet and is
+// not in the exclusion list. If no registers are vacant, <nil> is returned.
+func (rf RegisterFile) GetNextTempIExclude(exc []regfile.Register) regfile.Register {
+	return nextTemp(rf.regi, tempi[:], exc)
+}
+
+// GetNextTempFExclude returns the next available floating point register that hasn't been allocated yet and is
+// not in the exclusion list. If no registers are vacant, <nil> is returned.
+func (rf RegisterFile) GetNextTempFExclude(exc []regfile.Register) regfile.Register {
+	return nextTemp(rf.regf, tempf[:], exc)
+}
+
+// nextTemp returns the first register of regs, indexed by the temporary registers temps, that is not in the exclusion
+// list exc. If exc is nil, the register must not have been allocated, and is marked as allocated. If no registers are
+// vacant, <nil> is returned.
+func nextTemp(regs []regfile.Register, temps []int, exc []regfile.Register) regfile.Register {
+	for _, e1 := range temps {
+		r := regs[e1].(*register)
+		if exc == nil {
+			if r.use == 0 {
+				r.use = 1
+				return r
+			}
+			continue
+		}
+		excluded := false
+		for _, e2 := range exc {
+			if e2.Id() == r.idx && e2.Type() == r.typ {
+				// Register already in use by neighbour.
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			return r
+		}
+	}
+	return nil
+}
+
+// FreeI frees integer register with index i.
+func (rf RegisterFile) FreeI(i int) {
+	if i < 0 || i >= len(rf.regi) {
+		return
+	}
+	rf.regi[i].(*register).use = 0
+}
+
+// FreeF frees floating point register with index i.
+func (rf RegisterFile) FreeF(i int) {
+	if i < 0 || i >= len(rf.regf) {
+		return
+	}
+	rf.regf[i].(*register).use = 0
+}
+
+// SP returns a pointer to the register file's stack pointer.
+func (rf RegisterFile) SP() regfile.Register {
+	return rf.regi[sp]
+}
+
+// FP returns a pointer to the register file's frame pointer.
+func (rf RegisterFile) FP() regfile.Register {
+	return rf.regi[fp]
+}
+
+// LR returns a pointer to the register file's link register.
+func (rf RegisterFile) LR() regfile.Register {
+	return rf.regi[lr]
+}
+
+// CallerSaved returns the registers that are clobbered by function calls: r0-r3, ip, lr and s0-s15.
+func (rf RegisterFile) CallerSaved() []regfile.Register {
+	res := make([]regfile.Register, 0, 6+16)
+	res = append(res, rf.regi[r0:r3+1]...)
+	res = append(res, rf.regi[ip], rf.regi[lr])
+	return append(res, rf.regf[s0:s15+1]...)
+}
+
+// Ki returns the number of usable temporary integer registers.
+func (rf RegisterFile) Ki() int {
+	return len(tempi)
+}
+
+// Kf returns the number of usable temporary floating point registers.
+func (rf RegisterFile) Kf() int {
+	return len(tempf)
+}
@@ -0,0 +1,30 @@
+package armv7
+
+import (
+	"testing"
+)
+
+// TestImmediate verifies that immediate operands are 8-bit values rotated right by an even number of bits.
+func TestImmediate(t *testing.T) {
+	tests := []struct {
+		v  uint32
+		ok bool
+	}{
+		{0, true},
+		{255, true},
+		{256, true},
+		{1016, true},
+		{1020, true},
+		{0xff000000, true},
+		{0xf000000f, true},
+		{257, false},
+		{0x102, false},
+		{0x101, false},
+		{4100, false},
+	}
+	for _, e1 := range tests {
+		if res := immediate(e1.v); res != e1.ok {
+			t.Errorf("expected immediate(%#x) to be %t, got %t", e1.v, e1.ok, res)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package armv7
+
+import (
+	"fmt"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// -----------------------------
+// ----- Type definitions ------
+// -----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// --------------------
+// ----- Function -----
+// --------------------
+
+// genBranch generates ARMv7 assembler of an LIR branch instruction. The Block next is the Block that follows the
+// branch in the generated code, or nil if the branch is in the last Block of the function. Jumps to next are omitted.
+// An error is returned if something went wrong.
+func genBranch(v *lir.BranchInstruction, next *lir.Block, wr *util.Writer) error {
+	if v.Else() == nil {
+		// Unconditional branch.
+		if v.Then() != next {
+			wr.Write("\tb\t%s\n", v.Then().Name())
+		}
+		return nil
+	}
+
+	// Generate test and jump to THEN block if condition is true, or to ELSE block if condition is false and the THEN
+	// block follows sequentially.
+	cond := true
+	target := v.Then()
+	if v.Then() == next {
+		cond = false
+		target = v.Else()
+	}
+	op1 := v.Operand1().GetHW().Reg
+	op2 := v.Operand2().GetHW().Reg
+	var b string
+	if v.Operand1().DataType() == types.Int {
+		// Int compare and branch.
+		switch v.Operator() {
+		case types.Eq:
+			b = choose(cond, "beq", "bne")
+		case types.Neq:
+			b = choose(cond, "bne", "beq")
+		case types.LessThan:
+			b = choose(cond, "blt", "bge")
+		case types.LessThanOrEqual:
+			b = choose(cond, "ble", "bgt")
+		case types.GreaterThan:
+			b = choose(cond, "bgt", "ble")
+		case types.GreaterThanOrEqual:
+			b = choose(cond, "bge", "blt")
+		default:
+			return fmt.Errorf("unexpected logical operation: %d", v.Operator())
+		}
+		wr.Write("\tcmp\t%s, %s\n", op1.String(), op2.String())
+	} else {
+		// Float compare, whose flags are copied from the FPSCR. The conditions are chosen such that comparisons with
+		// NaN are false, and their inverses true.
+		switch v.Operator() {
+		case types.Eq:
+			b = choose(cond, "beq", "bne")
+		case types.Neq:
+			b = choose(cond, "bne", "beq")
+		case types.LessThan:
+			b = choose(cond, "bmi", "bpl")
+		case types.LessThanOrEqual:
+			b = choose(cond, "bls", "bhi")
+		case types.GreaterThan:
+			b = choose(cond, "bgt", "ble")
+		case types.GreaterThanOrEqual:
+			b = choose(cond, "bge", "blt")
+		default:
+			return fmt.Errorf("unexpected logical operation: %d", v.Operator())
+		}
+		wr.Write("\tvcmp.f32\t%s, %s\n", op1.String(), op2.String())
+		wr.Write("\tvmrs\tAPSR_nzcv, fpscr\n")
+	}
+	wr.Write("\t%s\t%s\n", b, target.Name())
+
+	// Jump to ELSE block unless it follows sequentially.
+	if target == v.Then() && v.Else() != next {
+		wr.Write("\tb\t%s\n", v.Else().Name())
+	}
+	return nil
+}
+
+// choose returns the string a if cond is true, and b otherwise.
+func choose(cond bool, a, b string) string {
+	if cond {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,288 @@
+package armv7
+
+import (
+	"fmt"
+	"vslc/src/backend/regfile"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// -----------------------------
+// ----- Type definitions ------
+// -----------------------------
+
+// location defines where an argument of a function call is passed.
+type location struct {
+	reg    regfile.Register // Argument register, or nil if the argument is passed on stack.
+	hi     regfile.Register // Argument register of the upper half of a variadic double passed in a register pair.
+	offset int              // SP relative offset of the stack slot of an argument passed on stack.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// --------------------
+// ----- Function -----
+// --------------------
+
+// genExpression generates ARMv7 assembler for arithmetic expressions. An error is returned if something went wrong.
+func genExpression(v *lir.DataInstruction, rf RegisterFile, wr *util.Writer) error {
+	dst := v.GetHW().Reg
+	reg1 := v.Operand1().GetHW().Reg
+
+	if v.Operand2() == nil {
+		// Unary expression.
+		switch v.Operator() {
+		case types.Sub:
+			if dst.Type() == int(types.Float) {
+				wr.Write("\tvneg.f32\t%s, %s\n", dst.String(), reg1.String())
+			} else {
+				wr.Write("\trsb\t%s, %s, #0\n", dst.String(), reg1.String())
+			}
+		case types.Not:
+			wr.Write("\tmvn\t%s, %s\n", dst.String(), reg1.String())
+		default:
+			return fmt.Errorf("unexpected unary operator %q", v.Operator().String())
+		}
+		return nil
+	}
+
+	// Binary expression. Choose instruction from operator.
+	reg2 := v.Operand2().GetHW().Reg
+	var op string
+	if dst.Type() == int(types.Int) {
+		// Integer operations. Division by zero caught in validate.
+		switch v.Operator() {
+		case types.Add:
+			op = "add"
+		case types.Sub:
+			op = "sub"
+		case types.Mul:
+			op = "mul"
+		case types.Div:
+			op = "sdiv"
+		case types.Rem:
+			genRemainder(dst, reg1, reg2, rf, wr)
+			return nil
+		case types.And:
+			op = "and"
+		case types.Xor:
+			op = "eor"
+		case types.Or:
+			op = "orr"
+		case types.MulHigh:
+			// The lower half of the product is discarded in a scratch register that isn't the destination.
+			lo := rf.GetI(scratchi[0])
+			if lo.Id() == dst.Id() {
+				lo = rf.GetI(scratchi[1])
+			}
+			wr.Write("\tsmull\t%s, %s, %s, %s\n", lo.String(), dst.String(), reg1.String(), reg2.String())
+			return nil
+		case types.RShift:
+			op = "lsr"
+		case types.ARShift:
+			op = "asr"
+		case types.LShift:
+			op = "lsl"
+		}
+	} else {
+		switch v.Operator() {
+		case types.Add:
+			op = "vadd.f32"
+		case types.Sub:
+			op = "vsub.f32"
+		case types.Mul:
+			op = "vmul.f32"
+		case types.Div:
+			op = "vdiv.f32"
+		}
+	}
+	if op == "" {
+		return fmt.Errorf("unexpected binary operator %q", v.Operator().String())
+	}
+	wr.Write("\t%s\t%s, %s, %s\n", op, dst.String(), reg1.String(), reg2.String())
+	return nil
+}
+
+// genRemainder generates the remainder dst of the integer division of reg1 by reg2, which is reg1 minus the quotient
+// times reg2. The quotient is held in the first of dst and the scratch registers that is neither operand. If both
+// scratch registers hold operands, r0 is borrowed, which is safe because neither operand resides in it.
+func genRemainder(dst, reg1, reg2 regfile.Register, rf RegisterFile, wr *util.Writer) {
+	var quot regfile.Register
+	for _, e1 := range []regfile.Register{dst, rf.GetI(scratchi[0]), rf.GetI(scratchi[1])} {
+		if e1.Id() != reg1.Id() && e1.Id() != reg2.Id() {
+			quot = e1
+			break
+		}
+	}
+	borrow := quot == nil
+	if borrow {
+		quot = rf.GetI(r0)
+		wr.Write("\tpush\t{%s}\n", quot.String())
+	}
+	wr.Write("\tsdiv\t%s, %s, %s\n", quot.String(), reg1.String(), reg2.String())
+	wr.Write("\tmls\t%s, %s, %s, %s\n", dst.String(), quot.String(), reg2.String(), reg1.String())
+	if borrow {
+		wr.Write("\tpop\t{%s}\n", quot.String())
+	}
+}
+
+// nextArgument returns the argument register of the next argument of a function call, given the pointers ii and fi to
+// the number of integer and floating point argument registers already used, and increments the counter of the
+// register class it's taken from. Returns nil if the argument is passed on the stack.
+func nextArgument(rf RegisterFile, isFloat bool, ii, fi *int) regfile.Register {
+	if isFloat {
+		if *fi < paramRegF {
+			*fi++
+			return rf.ArgF(*fi - 1)
+		}
+		return nil
+	}
+	if *ii < paramReg {
+		*ii++
+		return rf.ArgI(*ii - 1)
+	}
+	return nil
+}
+
+// nextPair returns the even numbered pair of integer argument registers of a variadic double, given the pointer ii to
+// the number of integer argument registers already used. Returns nil if the double is passed on the stack, in which
+// case any remaining integer argument register is skipped.
+func nextPair(rf RegisterFile, ii *int) (regfile.Register, regfile.Register) {
+	*ii += *ii & 1
+	if *ii+1 < paramReg {
+		*ii += 2
+		return rf.ArgI(*ii - 2), rf.ArgI(*ii - 1)
+	}
+	*ii = paramReg
+	return nil, nil
+}
+
+// genFunctionCall generates ARMv7 assembler for a function call. An error is returned if something went wrong. The
+// result of the function call is put in register r0 for integers or s0 for floating point functions. Variadic
+// arguments are passed in integer registers and on stack, per the base procedure call standard, where floats are
+// promoted to double.
+func genFunctionCall(v *lir.FunctionCallInstruction, fun *lir.Function, rf RegisterFile, wr *util.Writer) error {
+	// Flatten the arguments. VaList is used exclusively by calls to printf.
+	args := make([]lir.Value, 0, len(v.Arguments()))
+	variadic := make([]bool, 0, len(v.Arguments()))
+	for _, e1 := range v.Arguments() {
+		if e1.DataType() == types.VaList {
+			for _, e2 := range e1.(*lir.VaList).Values() {
+				args = append(args, e2)
+				variadic = append(variadic, true)
+			}
+		} else {
+			args = append(args, e1)
+			variadic = append(variadic, false)
+		}
+	}
+
+	// Assign argument registers, and stack slots to the arguments that are passed on stack. Variadic doubles are
+	// passed in an even numbered register pair or an aligned stack slot.
+	ii := 0 // Number of integer argument registers used.
+	fi := 0 // Number of float argument registers used.
+	locs := make([]location, len(args))
+	size := 0
+	for i1, e1 := range args {
+		isFloat := e1.DataType() == types.Float
+		if isFloat && variadic[i1] {
+			if locs[i1].reg, locs[i1].hi = nextPair(rf, &ii); locs[i1].reg == nil {
+				size = align(size, wordSize<<1)
+				locs[i1].offset = size
+				size += wordSize << 1
+			}
+			continue
+		}
+		if locs[i1].reg = nextArgument(rf, isFloat, &ii, &fi); locs[i1].reg == nil {
+			locs[i1].offset = size
+			size += wordSize
+		}
+	}
+	size = align(size, stackAlign)
+	sp := rf.SP().String()
+	if size > 0 {
+		genSP("sub", size, rf, wr)
+	}
+
+	// Generate argument passing.
+	for i1, e1 := range args {
+		loc := locs[i1]
+		switch {
+		case e1.DataType() == types.Float && variadic[i1]:
+			// Convert to double in the scratch registers s14 and s15, and move it to the register pair, if any.
+			src := argument(e1, fun, rf, wr)
+			wr.Write("\tvcvt.f64.f32\t%s, %s\n", d7, src.String())
+			if loc.reg == nil {
+				wr.Write("\tvstr\t%s, [%s, #%d]\n", d7, sp, loc.offset)
+				break
+			}
+			wr.Write("\tvmov\t%s, %s, %s\n", loc.reg.String(), loc.hi.String(), d7)
+		case loc.reg != nil:
+			genArgument(loc.reg, e1, fun, rf, wr)
+		default:
+			src := argument(e1, fun, rf, wr)
+			wr.Write("\t%s\t%s, [%s, #%d]\n", store(src), src.String(), sp, loc.offset)
+		}
+	}
+
+	// Call function.
+	wr.Write("\tbl\t%s\n", v.Target().Name())
+
+	// De-allocate stack for arguments, if any.
+	if size > 0 {
+		genSP("add", size, rf, wr)
+	}
+	return nil
+}
+
+// genArgument moves the function call argument arg to the argument register dst. Spilled arguments are loaded from
+// their spill slot directly.
+func genArgument(dst regfile.Register, arg lir.Value, fun *lir.Function, rf RegisterFile, wr *util.Writer) {
+	if n := spilled(arg); n != nil {
+		wr.Write("\t%s\t%s, [%s, #%d]\n", load(dst), dst.String(), rf.FP().String(), spillOffset(fun, n))
+		return
+	}
+	src := arg.GetHW().Reg
+	if dst.Type() == int(types.Float) {
+		wr.Write("\tvmov.f32\t%s, %s\n", dst.String(), src.String())
+	} else {
+		wr.Write("\tmov\t%s, %s\n", dst.String(), src.String())
+	}
+}
+
+// argument returns the register holding the function call argument arg. Spilled arguments are loaded from their
+// spill slot into a scratch register.
+func argument(arg lir.Value, fun *lir.Function, rf RegisterFile, wr *util.Writer) regfile.Register {
+	n := spilled(arg)
+	if n == nil {
+		return arg.GetHW().Reg
+	}
+	var r regfile.Register
+	if isInt(arg.DataType()) {
+		r = rf.GetI(scratchi[0])
+	} else {
+		r = rf.GetF(scratchf[0])
+	}
+	wr.Write("\t%s\t%s, [%s, #%d]\n", load(r), r.String(), rf.FP().String(), spillOffset(fun, n))
+	return r
+}
+
+// isInt returns true if values of type typ are held in integer registers. Strings are addresses.
+func isInt(typ types.DataType) bool {
+	return typ == types.Int || typ == types.String
+}
+
+// align returns n rounded up to the nearest multiple of a.
+func align(n, a int) int {
+	if res := n % a; res != 0 {
+		n += a - res
+	}
+	return n
+}
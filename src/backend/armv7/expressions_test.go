@@ -0,0 +1,57 @@
+package armv7
+
+import (
+	"testing"
+)
+
+// TestNextArgument verifies that integers and floats are passed in their own class of argument registers, and on the
+// stack when their class is used up.
+func TestNextArgument(t *testing.T) {
+	rf := CreateRegisterFile()
+	ii, fi := 0, 0
+	var res []string
+	for i1 := 0; i1 < paramReg; i1++ {
+		res = append(res, nextArgument(rf, false, &ii, &fi).String())
+		res = append(res, nextArgument(rf, true, &ii, &fi).String())
+	}
+	if r := nextArgument(rf, false, &ii, &fi); r != nil {
+		t.Errorf("expected integer to be passed on stack, got %s", r.String())
+	}
+	for fi < paramRegF {
+		nextArgument(rf, true, &ii, &fi)
+	}
+	if r := nextArgument(rf, true, &ii, &fi); r != nil {
+		t.Errorf("expected float to be passed on stack, got %s", r.String())
+	}
+
+	exp := []string{"r0", "s0", "r1", "s1", "r2", "s2", "r3", "s3"}
+	for i1, e1 := range exp {
+		if res[i1] != e1 {
+			t.Errorf("expected argument %d in %s, got %s", i1, e1, res[i1])
+		}
+	}
+}
+
+// TestNextPair verifies that variadic doubles are passed in even numbered pairs of integer argument registers, and on
+// the stack when no pair is left.
+func TestNextPair(t *testing.T) {
+	rf := CreateRegisterFile()
+	ii, fi := 0, 0
+	var res []string
+	nextArgument(rf, false, &ii, &fi)
+	lo, hi := nextPair(rf, &ii)
+	res = append(res, lo.String(), hi.String())
+	if lo, hi := nextPair(rf, &ii); lo != nil || hi != nil {
+		t.Errorf("expected double to be passed on stack, got %v and %v", lo, hi)
+	}
+	if ii != paramReg {
+		t.Errorf("expected all integer argument registers to be used, got %d", ii)
+	}
+
+	exp := []string{"r2", "r3"}
+	for i1, e1 := range exp {
+		if res[i1] != e1 {
+			t.Errorf("expected register %d to be %s, got %s", i1, e1, res[i1])
+		}
+	}
+}
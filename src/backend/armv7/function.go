@@ -0,0 +1,465 @@
+package armv7
+
+import (
+	"fmt"
+	"math"
+	"vslc/src/backend/regfile"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// frame defines the stack frame layout of a function.
+type frame struct {
+	size    int                // Size of the stack frame in bytes, aligned with the stack alignment.
+	saved   []regfile.Register // Callee-saved registers stored at the bottom of the stack frame.
+	offsets []int              // SP relative offsets of the stack slots of the callee-saved registers.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// genFunction generates ARMv7 assembler code for an integer or floating point return type function.
+//
+// General steps:
+//
+// - Push FP and LR, and grow stack with word size * (arguments + locals + spill slots) + used callee-saved
+//   registers. Align with stack alignment.
+// - Save used callee-saved registers at the bottom of the stack frame.
+// - Store all arguments on stack to maximise available registers.
+// - Generate function body.
+// - De-allocate stack.
+// - Return r0 for integer functions, use s0 for floating point functions.
+func (g *generator) genFunction(fun *lir.Function, rf RegisterFile, wr *util.Writer) error {
+	if len(fun.Blocks()) < 1 {
+		return nil
+	}
+
+	ann := g.annotator.Function()
+
+	// Calculate new stack size. Stack slots are addressed by the 8-bit word offsets of vldr and vstr.
+	fr := newFrame(fun, rf)
+	if fr.size > maxOffset {
+		return fmt.Errorf("stack frame of function %s exceeds %d bytes", fun.Name(), maxOffset)
+	}
+
+	// Write function name label.
+	wr.Write("\n\t.align\t2\n")
+	wr.Write("\t.type\t%s, %%function\n", fun.Name())
+	wr.Label(fun.Name())
+	wr.Write("\t.cfi_startproc\n")
+
+	// Allocate stack frame and save FP, LR and used callee-saved registers.
+	genPrologue(fr, rf, wr)
+
+	ii := 0 // Number of integer argument registers used.
+	fi := 0 // Number of float argument registers used.
+	si := 0 // Number of parameters passed on stack.
+
+	// Put arguments on stack.
+	fp := rf.FP().String()
+	for i1, e1 := range fun.Params() {
+		offset := fr.param(i1)
+		r := nextArgument(rf, e1.DataType() == types.Float, &ii, &fi)
+		if r == nil {
+			// Load from stack, store on stack. Reuse the scratch register, because no value is spilled yet. Floats
+			// are copied bit by bit.
+			r = rf.GetI(scratchi[0])
+			wr.Write("\tldr\t%s, [%s, #%d]\n", r.String(), fp, wordSize*si)
+			si++
+		}
+
+		// Store directly on stack from register.
+		wr.Write("\t%s\t%s, [%s, #%d]\n", store(r), r.String(), fp, offset)
+	}
+
+	// Generate function body.
+	blocks := fun.Blocks()
+	for i1, e1 := range blocks {
+		var next *lir.Block
+		if i1+1 < len(blocks) {
+			next = blocks[i1+1]
+		}
+
+		// Write label for basic block.
+		wr.Label(e1.Name())
+		for _, e2 := range e1.Instructions() {
+			ann.Annotate(wr, e2)
+
+			// Load spilled operands into scratch registers.
+			reloaded := genReload(e2, fun, rf, wr)
+
+			switch e2.Type() {
+			case types.DataInstruction:
+				if e2.DataType() == types.VaList {
+					// VaList is handled by genFunctionCall.
+					break
+				}
+				if err := genExpression(e2.(*lir.DataInstruction), rf, wr); err != nil {
+					return locate(e2, err)
+				}
+			case types.LoadInstruction:
+				dst := e2.GetHW().Reg
+				if e2.DataType() == types.String {
+					genAddress(dst, e2.Operand1().Name(), wr)
+					break
+				}
+				switch e2.Operand1().Type() {
+				case types.DeclareInstruction:
+					src := e2.Operand1().(*lir.DeclareInstruction)
+					wr.Write("\t%s\t%s, [%s, #%d]\n", load(dst), dst.String(), fp, fr.local(fun, src.Seq()))
+				case types.Param:
+					src := e2.Operand1().(*lir.Param)
+					wr.Write("\t%s\t%s, [%s, #%d]\n", load(dst), dst.String(), fp, fr.param(src.Id()))
+				case types.Global:
+					// Used lr for storing the temporary value that is &GLOBAL_VARIABLE.
+					genAccess(load(dst), dst, rf.GetI(scratchi[1]), e2.Operand1().Name(), wr)
+				default:
+					panic(fmt.Sprintf("compiler error: unexpected load source type %s", e2.Operand1().Type().String()))
+				}
+			case types.StoreInstruction:
+				src := e2.Operand1().GetHW().Reg
+				switch e2.Operand2().Type() {
+				case types.DeclareInstruction:
+					dst := e2.Operand2().(*lir.DeclareInstruction)
+					wr.Write("\t%s\t%s, [%s, #%d]\n", store(src), src.String(), fp, fr.local(fun, dst.Seq()))
+				case types.Param:
+					dst := e2.Operand2().(*lir.Param)
+					wr.Write("\t%s\t%s, [%s, #%d]\n", store(src), src.String(), fp, fr.param(dst.Id()))
+				case types.Global:
+					// Used lr for storing the temporary value that is &GLOBAL_VARIABLE.
+					genAccess(store(src), src, rf.GetI(scratchi[1]), e2.Operand2().Name(), wr)
+				default:
+					panic(fmt.Sprintf("compiler error: unexpected store destination type %d", e2.Operand2().Type()))
+				}
+			case types.Constant:
+				r := e2.GetHW().Reg // Assigned hardware register.
+				cnst := e2.(*lir.Constant)
+				if e2.DataType() == types.Int {
+					genInt(r, cnst.Value().(int), wr)
+				} else if val := cnst.Value().(float64); val == 0 && !math.Signbit(val) {
+					// Copy positive zero from the scratch register.
+					tmp := rf.GetI(scratchi[0]).String()
+					wr.Write("\tmov\t%s, #0\n", tmp)
+					wr.Write("\tvmov\t%s, %s\n", r.String(), tmp)
+				} else {
+					// Load float from the literal pool. Use lr as temporary register.
+					fstr := fmt.Sprintf("%s%d", labelConstant, cnst.GlobalSeq())
+					genAccess(load(r), r, rf.GetI(scratchi[1]), fstr, wr)
+					cnst.Use()
+				}
+			case types.CastInstruction:
+				dst := e2.GetHW().Reg
+				src := e2.Operand1().GetHW().Reg
+				genCast(dst, src, rf, wr)
+			case types.BranchInstruction:
+				if err := genBranch(e2.(*lir.BranchInstruction), next, wr); err != nil {
+					return locate(e2, err)
+				}
+			case types.ReturnInstruction:
+				genReturn(e2.(*lir.ReturnInstruction), fun, fr, rf, wr)
+			case types.FunctionCallInstruction:
+				if err := genFunctionCall(e2.(*lir.FunctionCallInstruction), fun, rf, wr); err != nil {
+					return locate(e2, err)
+				}
+			case types.PreserveInstruction:
+				// Preserves r0 or s0 from function calls.
+				dst := e2.GetHW().Reg
+				src := e2.Operand1().GetHW().Reg
+				if dst.Id() == src.Id() {
+					// Coalesced by the register allocator.
+				} else if dst.Type() == int(types.Int) {
+					wr.Write("\tmov\t%s, %s\n", dst.String(), src.String())
+				} else {
+					wr.Write("\tvmov.f32\t%s, %s\n", dst.String(), src.String())
+				}
+			case types.PrintInstruction, types.Global, types.Param, types.DeclareInstruction:
+				// Ignore, because they've been handled during LIR construction.
+				continue
+			default:
+				return locate(e2, fmt.Errorf("unexpected LIR instruction type %d", e2.Type()))
+			}
+
+			// Store spilled result to its spill slot.
+			genSpill(e2, reloaded, fun, rf, wr)
+		}
+	}
+	genProcEnd(fun.Name(), wr)
+	return nil
+}
+
+// genCast generates the conversion of the value in register src to the type of register dst. Integers are moved to a
+// floating point register before the conversion, and floats are rounded to nearest in the scratch register s14 before
+// they're moved to an integer register.
+func genCast(dst, src regfile.Register, rf RegisterFile, wr *util.Writer) {
+	if dst.Type() == int(types.Int) {
+		// Cast float to int. Round to nearest.
+		tmp := rf.GetF(scratchf[0]).String()
+		wr.Write("\tvcvtr.s32.f32\t%s, %s\n", tmp, src.String())
+		wr.Write("\tvmov\t%s, %s\n", dst.String(), tmp)
+	} else {
+		// Cast int to float.
+		wr.Write("\tvmov\t%s, %s\n", dst.String(), src.String())
+		wr.Write("\tvcvt.f32.s32\t%s, %s\n", dst.String(), dst.String())
+	}
+}
+
+// genReturn generates a function return statement that tears down the stack frame fr.
+func genReturn(v *lir.ReturnInstruction, fun *lir.Function, fr frame, rf RegisterFile, wr *util.Writer) {
+	r := v.Operand1().GetHW().Reg
+	switch {
+	case v.Operand1().DataType() != fun.DataType() && fun.DataType() == types.Float:
+		genCast(rf.GetF(s0), r, rf, wr)
+	case v.Operand1().DataType() != fun.DataType():
+		genCast(rf.GetI(r0), r, rf, wr)
+	case r.Type() == int(types.Int) && r.Id() != r0:
+		wr.Write("\tmov\t%s, %s\n", rf.GetI(r0).String(), r.String())
+	case r.Type() == int(types.Float) && r.Id() != s0:
+		wr.Write("\tvmov.f32\t%s, %s\n", rf.GetF(s0).String(), r.String())
+	}
+
+	// Restore callee-saved registers, FP and LR, and de-allocate stack.
+	genEpilogue(fr, rf, wr)
+}
+
+// genPrologue generates the allocation of the stack frame fr, which stores FP and LR at its top and the callee-saved
+// registers at its bottom, and sets FP to the old SP. Call frame information directives are generated after each
+// instruction that changes the canonical frame address or saves a register.
+func genPrologue(fr frame, rf RegisterFile, wr *util.Writer) {
+	sp, fp := rf.SP().String(), rf.FP().String()
+
+	// Save old frame pointer and link register.
+	wr.Write("\tpush\t{%s, %s}\n", fp, rf.LR().String())
+	wr.Write("\t.cfi_def_cfa_offset\t%d\n", wordSize<<1)
+	wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(rf.LR()), -wordSize)
+	wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(rf.FP()), -(wordSize << 1))
+
+	// Set frame pointer to old stack pointer. The canonical frame address follows FP from here on.
+	wr.Write("\tadd\t%s, %s, #%d\n", fp, sp, wordSize<<1)
+	wr.Write("\t.cfi_def_cfa\t%d, 0\n", dwarfReg(rf.FP()))
+
+	// Adjust stack.
+	if n := fr.size - wordSize<<1; n > 0 {
+		genSP("sub", n, rf, wr)
+	}
+
+	// Save callee-saved registers that are used by the function body.
+	for i1, e1 := range fr.saved {
+		wr.Write("\t%s\t%s, [%s, #%d]\n", store(e1), e1.String(), sp, fr.offsets[i1])
+		wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(e1), fr.offsets[i1]-fr.size)
+	}
+}
+
+// genEpilogue generates the restoring of the callee-saved registers, FP and LR, de-allocates the stack frame fr set up
+// by genPrologue, and returns. The call frame information state is remembered before and restored after the
+// epilogue, because code following the return is still inside the stack frame.
+func genEpilogue(fr frame, rf RegisterFile, wr *util.Writer) {
+	sp, fp := rf.SP().String(), rf.FP().String()
+	wr.Write("\t.cfi_remember_state\n")
+
+	// Restore callee-saved registers.
+	for i1, e1 := range fr.saved {
+		wr.Write("\t%s\t%s, [%s, #%d]\n", load(e1), e1.String(), sp, fr.offsets[i1])
+	}
+
+	// De-allocate stack down to the saved FP and LR.
+	wr.Write("\tsub\t%s, %s, #%d\n", sp, fp, wordSize<<1)
+	wr.Write("\t.cfi_def_cfa\t%d, %d\n", dwarfReg(rf.SP()), wordSize<<1)
+
+	// Restore FP and LR, and return.
+	wr.Write("\tpop\t{%s, %s}\n", fp, rf.LR().String())
+	wr.Write("\t.cfi_def_cfa_offset\t0\n")
+	wr.Write("\tbx\t%s\n", rf.LR().String())
+	wr.Write("\t.cfi_restore_state\n")
+}
+
+// genProcEnd generates the end of the call frame information and the size of the function name.
+func genProcEnd(name string, wr *util.Writer) {
+	wr.Write("\t.cfi_endproc\n")
+	wr.Write("\t.size\t%s, .-%s\n", name, name)
+}
+
+// dwarfReg returns the DWARF register number of register r, which is used by the call frame information directives.
+func dwarfReg(r regfile.Register) int {
+	if r.Type() == int(types.Float) {
+		return dwarfFloat + r.Id()
+	}
+	return r.Id()
+}
+
+// calleeSaved returns the callee-saved registers r4-r10 and s16-s31 that are written by the body of Function fun,
+// ordered by type and index. The frame pointer and link register are always saved.
+func calleeSaved(fun *lir.Function, rf RegisterFile) []regfile.Register {
+	usedi := make([]bool, len(rf.regi))
+	usedf := make([]bool, len(rf.regf))
+	for _, e1 := range fun.Blocks() {
+		for _, e2 := range e1.Instructions() {
+			n := e2.GetHW()
+			if n == nil || e2.DataType() == types.VaList {
+				// No code is generated for writing variable argument lists.
+				continue
+			}
+			if r := n.Reg; r != nil {
+				if r.Type() == int(types.Int) {
+					usedi[r.Id()] = true
+				} else {
+					usedf[r.Id()] = true
+				}
+			}
+		}
+	}
+
+	res := make([]regfile.Register, 0, r10-r4+s31-s16+2)
+	for i1, e1 := range usedi {
+		if e1 && r4 <= i1 && i1 <= r10 {
+			res = append(res, rf.GetI(i1))
+		}
+	}
+	for i1, e1 := range usedf {
+		if e1 && s16 <= i1 && i1 <= s31 {
+			res = append(res, rf.GetF(i1))
+		}
+	}
+	return res
+}
+
+// newFrame returns the stack frame of Function fun, which holds its parameters, local variables, spill slots, FP and
+// LR and the callee-saved registers written by the function body. The size is aligned with the stack alignment.
+func newFrame(fun *lir.Function, rf RegisterFile) frame {
+	fr := frame{saved: calleeSaved(fun, rf)}
+	fr.offsets = make([]int, len(fr.saved))
+
+	// Single precision floating point registers are saved in word sized slots, like integer registers.
+	for i1 := range fr.saved {
+		fr.offsets[i1] = wordSize * i1
+	}
+	n := len(fun.Params()) + len(fun.Locals()) + spillSlots(fun) + len(fr.saved) + 2 // FP and LR.
+	fr.size = wordSize * n
+	if res := fr.size % stackAlign; res != 0 {
+		fr.size += stackAlign - res
+	}
+	return fr
+}
+
+// param returns the frame pointer relative offset of the stack slot of parameter number id. Parameters go first on
+// the stack.
+func (fr frame) param(id int) int {
+	// Add 3 to offset: 1 to align for bottom-down, 2 for skipping stack saved LR and FP.
+	return -wordSize * (id + 3)
+}
+
+// local returns the frame pointer relative offset of the stack slot of local variable number seq of Function fun.
+// Locals are stored after parameters.
+func (fr frame) local(fun *lir.Function, seq int) int {
+	return fr.param(seq + len(fun.Params()))
+}
+
+// spillSlots returns the number of spill slots assigned to the values of Function fun by the register allocator.
+func spillSlots(fun *lir.Function) int {
+	res := 0
+	for _, e1 := range fun.Blocks() {
+		for _, e2 := range e1.Instructions() {
+			if n := e2.GetHW(); n != nil && n.Spill && n.Slot >= res {
+				res = n.Slot + 1
+			}
+		}
+	}
+	return res
+}
+
+// spillOffset returns the frame pointer relative offset of the spill slot of the spilled LiveNode n of Function fun.
+// Spill slots are stored after the parameters and local variables.
+func spillOffset(fun *lir.Function, n *lir.LiveNode) int {
+	// Add 3 to offset: 1 to align for bottom-down, 2 for skipping stack saved LR and FP.
+	return -wordSize * (n.Slot + 3 + len(fun.Params()) + len(fun.Locals()))
+}
+
+// spilled returns the LiveNode of LIR value v if v is spilled to a spill slot, and nil otherwise.
+func spilled(v lir.Value) *lir.LiveNode {
+	if v == nil {
+		return nil
+	}
+	if n := v.GetHW(); n != nil && n.Spill {
+		return n
+	}
+	return nil
+}
+
+// genReload loads the spilled operands of the LIR instruction v from their spill slots into the scratch registers,
+// and assigns a scratch register to the result of v if it's spilled. Function calls load spilled arguments by
+// themselves. Returns the LiveNodes that were assigned a scratch register, which are released by genSpill.
+func genReload(v lir.Value, fun *lir.Function, rf RegisterFile, wr *util.Writer) []*lir.LiveNode {
+	switch v.Type() {
+	case types.DataInstruction, types.LoadInstruction, types.StoreInstruction, types.Constant,
+		types.CastInstruction, types.PreserveInstruction, types.BranchInstruction, types.ReturnInstruction:
+		if v.DataType() == types.VaList {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	res := make([]*lir.LiveNode, 0, 3)
+	ii := 0
+	fi := 0
+	for _, e1 := range []lir.Value{v.Operand1(), v.Operand2()} {
+		n := spilled(e1)
+		if n == nil || n.Reg != nil {
+			// Not spilled or already loaded, because both operands are the same value.
+			continue
+		}
+		if isInt(e1.DataType()) {
+			n.Reg = rf.GetI(scratchi[ii])
+			ii++
+		} else {
+			n.Reg = rf.GetF(scratchf[fi])
+			fi++
+		}
+		r := n.Reg
+		wr.Write("\t%s\t%s, [%s, #%d]\n", load(r), r.String(), rf.FP().String(), spillOffset(fun, n))
+		res = append(res, n)
+	}
+
+	// The result may overwrite an operand's scratch register, because the operands are read first.
+	if n := spilled(v); n != nil {
+		if isInt(v.DataType()) {
+			n.Reg = rf.GetI(scratchi[0])
+		} else {
+			n.Reg = rf.GetF(scratchf[0])
+		}
+		res = append(res, n)
+	}
+	return res
+}
+
+// genSpill stores the result of the LIR instruction v to its spill slot, if it's spilled, and releases the scratch
+// registers of the LiveNodes reloaded returned by genReload.
+func genSpill(v lir.Value, reloaded []*lir.LiveNode, fun *lir.Function, rf RegisterFile, wr *util.Writer) {
+	if n := spilled(v); n != nil && n.Reg != nil {
+		r := n.Reg
+		wr.Write("\t%s\t%s, [%s, #%d]\n", store(r), r.String(), rf.FP().String(), spillOffset(fun, n))
+	}
+	for _, e1 := range reloaded {
+		e1.Reg = nil
+	}
+}
+
+// locate returns err as a util.CodegenError at the source location of the LIR instruction v, which prefixes the message of
+// err if the location is known.
+func locate(v lir.Value, err error) error {
+	loc := v.Location()
+	return &util.CodegenError{Position: util.Position{Line: loc.Line, Pos: loc.Pos}, Err: err}
+}
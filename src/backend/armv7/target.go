@@ -0,0 +1,87 @@
+package armv7
+
+import (
+	"errors"
+	"vslc/src/backend"
+	"vslc/src/backend/regfile"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// target implements backend.Target for ARMv7.
+type target struct{}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+func init() {
+	backend.Register(util.Armv7, target{})
+}
+
+// Name returns the name of the target architecture.
+func (target) Name() string {
+	return "armv7"
+}
+
+// WordSize returns the width of integers in bits.
+func (target) WordSize() int {
+	return 32
+}
+
+// CreateRegisterFile returns a new register file.
+func (target) CreateRegisterFile(_ util.Options) regfile.RegisterFile {
+	return CreateRegisterFile()
+}
+
+// Validate returns an error for targets other than linux-gnueabihf, and for freestanding output, stack protection,
+// integer overflow trapping and 64-bit integers, which aren't supported.
+func (target) Validate(opt util.Options) error {
+	switch {
+	case opt.Freestanding:
+		return errors.New("freestanding output is only supported for RISC-V")
+	case opt.TargetOS == util.Windows || opt.TargetOS == util.MAC:
+		return errors.New("ARMv7 code generation requires a linux-gnueabihf target")
+	case opt.SSP:
+		return errors.New("stack protector is not supported for ARMv7")
+	case opt.Trapv:
+		return errors.New("integer overflow trapping is not supported for ARMv7")
+	case opt.IntBits() == 64:
+		return errors.New("64-bit integers are not supported for ARMv7")
+	}
+	return nil
+}
+
+// Generate generates assembler of LIR Module m.
+func (target) Generate(opt util.Options, m *lir.Module, root *ir.Node) error {
+	return GenArmv7(opt, m, root)
+}
+
+// Immediate returns false, because constants are always loaded into a register.
+func (target) Immediate(_ util.Options, _ *lir.Constant) bool {
+	return false
+}
+
+// Select returns false, because simple conditionals are generated as branches.
+func (target) Select() bool {
+	return false
+}
+
+// Toolchain returns the target triple of the C compiler driver that assembles and links the output, and the flags
+// that select the hard-float ABI.
+func (target) Toolchain(_ util.Options) (string, []string, error) {
+	return "arm-linux-gnueabihf", []string{"-march=armv7-a", "-mfloat-abi=hard"}, nil
+}
@@ -1,8 +1,6 @@
 package backend
 
 import (
-	"errors"
-	"vslc/src/backend/arm"
 	"vslc/src/ir"
 	"vslc/src/ir/lir"
 	"vslc/src/util"
@@ -25,17 +23,11 @@ import (
 // ---------------------
 
 // GenerateAssembler takes the syntax tree and generates output assembler code
-// based on architecture defined by opt.
+// based on architecture defined by opt, using the Target registered for it.
 func GenerateAssembler(opt util.Options, m *lir.Module, root *ir.Node) error {
-	switch opt.TargetArch {
-	case util.Aarch64:
-		return arm.GenArm(opt, m, root)
-	case util.Riscv64:
-		//return riscv.GenRiscv(opt)
-		return errors.New("RISC-V 64-bit not supported yet")
-	case util.Riscv32:
-		return errors.New("RISC-V 32-bit not supported")
-	default:
-		return errors.New("unsupported output architecture")
+	t, err := Lookup(opt.TargetArch)
+	if err != nil {
+		return err
 	}
+	return t.Generate(opt, m, root)
 }
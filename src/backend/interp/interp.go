@@ -0,0 +1,540 @@
+// Package interp executes LIR Modules directly on the host, without generating native code.
+package interp
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// interpreter holds the global state of an executing LIR Module.
+type interpreter struct {
+	globals map[lir.Value]interface{} // globals holds the values of the Module's global variables.
+	w       *bufio.Writer             // w receives the output of printf.
+	ew      io.Writer                 // ew receives the output of dprintf to standard error, which isn't buffered.
+	args    []string                  // args holds the program arguments, which are read by argc and arg.
+	trapv   bool                      // trapv is set if integer overflows of checked operations are errors.
+	bits    int                       // bits is the number of bits of integers, which wrap around on overflow.
+	depth   int                       // depth is the current call depth.
+	ctx     context.Context           // ctx cancels execution, such as on Ctrl-C or timeout.
+}
+
+// frame holds the state of a single Function invocation.
+type frame struct {
+	regs map[lir.Value]interface{} // regs holds the values of the Function's virtual registers.
+	mem  map[lir.Value]interface{} // mem holds the values of the Function's parameters and local variables.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// maxDepth is the maximum call depth before execution is aborted with a stack overflow.
+const maxDepth = 1 << 16
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Run executes Module m, starting at the first function declared in the syntax tree root, in the same way as the
+// implicit main function of the native backends: the program arguments args are parsed as integers or floating point
+// values according to the entry function's parameters, and the entry function's return value is returned as the
+// program's exit code. An entry function without parameters accepts any number of arguments if the program reads them
+// by the builtin functions argc and arg. Output from print statements is written to w, and output redirected to
+// standard error to ew. Output redirected to other file descriptors is discarded, as they aren't open.
+//
+// Integers have the width of the integers of m, and wrap around on overflow. Errors are returned for conditions that
+// would crash a native program, such as division by zero or unbounded recursion, and integer overflows of additions,
+// subtractions and multiplications if trapv is set. Argument errors
+// are reported on w with exit code 1, like the native implicit main function. Execution is aborted with the error of
+// ctx if ctx is cancelled.
+func Run(ctx context.Context, m *lir.Module, root *ir.Node, args []string, trapv bool, w, ew io.Writer) (int, error) {
+	var entry *lir.Function
+	for _, e1 := range root.Children {
+		if e1.Typ == ir.FUNCTION {
+			if entry = m.GetFunction(e1.Children[0].Data.Str); entry == nil {
+				return 1, errors.New("no functions defined for module")
+			}
+			break
+		}
+	}
+	if entry == nil {
+		return 1, errors.New("no functions defined for module")
+	}
+
+	it := &interpreter{
+		globals: make(map[lir.Value]interface{}, len(m.Globals())),
+		w:       bufio.NewWriter(w),
+		ew:      ew,
+		args:    args,
+		trapv:   trapv,
+		bits:    m.IntBits(),
+		ctx:     ctx,
+	}
+	defer it.w.Flush()
+	for _, e1 := range m.Globals() {
+		it.globals[e1] = zero(e1.DataType())
+	}
+
+	// Check argument count.
+	params := entry.Params()
+	if len(args) != len(params) && (len(params) > 0 || !m.UsesArgs()) {
+		if len(params) == 1 {
+			_, _ = fmt.Fprintf(it.w, "Argument error: expected 1 argument, got %d\n", len(args))
+		} else {
+			_, _ = fmt.Fprintf(it.w, "Argument error: expected %d arguments, got %d\n", len(params), len(args))
+		}
+		return 1, nil
+	}
+
+	// Parse arguments. Arguments that aren't numbers as a whole are rejected, like the native implicit main function
+	// does.
+	vals := make([]interface{}, len(args))
+	for i1, e1 := range params {
+		ok := false
+		if e1.DataType() == types.Int {
+			var i int
+			i, ok = strtol(args[i1])
+			vals[i1] = wrap(i, it.bits)
+		} else {
+			vals[i1], ok = strtod(args[i1])
+		}
+		if !ok {
+			_, _ = fmt.Fprintf(it.w, "Argument error: argument %d is neither int nor float\n", i1+1)
+			return 1, nil
+		}
+	}
+
+	res, err := it.call(entry, vals)
+	if err != nil {
+		return 1, err
+	}
+	switch v := res.(type) {
+	case int:
+		return v, nil
+	case float64:
+		return ftoi(v, it.bits), nil
+	}
+	return 0, nil
+}
+
+// call executes Function f with the arguments args and returns the Function's return value.
+func (it *interpreter) call(f *lir.Function, args []interface{}) (interface{}, error) {
+	if len(f.Blocks()) < 1 {
+		return it.builtin(f, args)
+	}
+	if it.depth >= maxDepth {
+		return nil, fmt.Errorf("stack overflow: call depth of function %s exceeds %d", f.Name(), maxDepth)
+	}
+	it.depth++
+	defer func() {
+		it.depth--
+	}()
+
+	fr := frame{
+		regs: make(map[lir.Value]interface{}),
+		mem:  make(map[lir.Value]interface{}, len(f.Params())+len(f.Locals())),
+	}
+	for i1, e1 := range f.Params() {
+		fr.mem[e1] = args[i1]
+	}
+	for _, e1 := range f.Locals() {
+		fr.mem[e1] = zero(e1.DataType())
+	}
+
+	var prev *lir.Block
+	b := f.Blocks()[0]
+	for {
+		instructions := b.Instructions()
+
+		// Phi instructions select their values simultaneously, based on the predecessor Block.
+		i1 := 0
+		phis := make([]interface{}, 0)
+		for ; i1 < len(instructions); i1++ {
+			p, ok := instructions[i1].(*lir.PhiInstruction)
+			if !ok {
+				break
+			}
+			v := p.IncomingFrom(prev)
+			if v == nil {
+				return nil, fmt.Errorf("%s: %s has no value for predecessor block", f.Name(), p.Name())
+			}
+			phis = append(phis, fr.get(v))
+		}
+		for i2, e2 := range phis {
+			fr.regs[instructions[i2]] = e2
+		}
+
+		var next *lir.Block
+		for ; i1 < len(instructions); i1++ {
+			switch v := instructions[i1].(type) {
+			case *lir.BranchInstruction:
+				if v.Else() == nil {
+					next = v.Then()
+				} else if t, err := compare(v.Operator(), fr.get(v.Operand1()), fr.get(v.Operand2())); err != nil {
+					return nil, fmt.Errorf("%s: %s", where(f, v), err)
+				} else if t {
+					next = v.Then()
+				} else {
+					next = v.Else()
+				}
+			case *lir.ReturnInstruction:
+				return fr.get(v.Operand1()), nil
+			default:
+				if err := it.exec(&fr, v); err != nil {
+					if v.Type() == types.FunctionCallInstruction {
+						// Already reported by the callee.
+						return nil, err
+					}
+					return nil, fmt.Errorf("%s: %s", where(f, v), err)
+				}
+			}
+		}
+		if next == nil {
+			return nil, fmt.Errorf("%s: %s is not terminated", f.Name(), b.Name())
+		}
+
+		// Check for cancellation on every branch, such that infinite loops can be aborted.
+		select {
+		case <-it.ctx.Done():
+			return nil, it.ctx.Err()
+		default:
+		}
+		prev, b = b, next
+	}
+}
+
+// where returns the name of Function f, followed by the source location of instruction v if it is known.
+func where(f *lir.Function, v lir.Value) string {
+	if loc := v.Location(); loc.IsKnown() {
+		return fmt.Sprintf("%s: %s", f.Name(), loc.String())
+	}
+	return f.Name()
+}
+
+// exec executes the non-terminating instruction v in frame fr.
+func (it *interpreter) exec(fr *frame, v lir.Value) error {
+	switch inst := v.(type) {
+	case *lir.Constant:
+		fr.regs[inst] = inst.Value()
+	case *lir.LoadInstruction:
+		switch src := inst.Operand1().(type) {
+		case *lir.String:
+			fr.regs[inst] = src.Value()
+		case *lir.Global:
+			fr.regs[inst] = it.globals[src]
+		default:
+			fr.regs[inst] = fr.mem[src]
+		}
+	case *lir.StoreInstruction:
+		if dst, ok := inst.Operand2().(*lir.Global); ok {
+			it.globals[dst] = fr.get(inst.Operand1())
+		} else {
+			fr.mem[inst.Operand2()] = fr.get(inst.Operand1())
+		}
+	case *lir.DeclareInstruction:
+		// Local variables are allocated on function entry.
+	case *lir.VaList:
+		vals := make([]interface{}, len(inst.Values()))
+		for i1, e1 := range inst.Values() {
+			vals[i1] = fr.get(e1)
+		}
+		fr.regs[inst] = vals
+	case *lir.DataInstruction:
+		res, err := arithmetic(inst.Operator(), fr.get(inst.Operand1()), fr.get(inst.Operand2()), it.bits)
+		if err != nil {
+			return err
+		}
+		if it.trapv && inst.Traps() &&
+			overflows(inst.Operator(), fr.get(inst.Operand1()), fr.get(inst.Operand2()), res, it.bits) {
+			return errors.New("integer overflow")
+		}
+		fr.regs[inst] = res
+	case *lir.CastInstruction:
+		switch src := fr.get(inst.Operand1()).(type) {
+		case int:
+			fr.regs[inst] = float64(src)
+		case float64:
+			fr.regs[inst] = ftoi(src, it.bits)
+		}
+	case *lir.PreserveInstruction:
+		fr.regs[inst] = fr.get(inst.Operand1())
+	case *lir.SelectInstruction:
+		t, err := compare(inst.Operator(), fr.get(inst.Operand1()), fr.get(inst.Operand2()))
+		if err != nil {
+			return err
+		}
+		if t {
+			fr.regs[inst] = fr.get(inst.True())
+		} else {
+			fr.regs[inst] = fr.get(inst.False())
+		}
+	case *lir.FunctionCallInstruction:
+		args := make([]interface{}, len(inst.Arguments()))
+		for i1, e1 := range inst.Arguments() {
+			args[i1] = fr.get(e1)
+		}
+		res, err := it.call(inst.Target(), args)
+		if err != nil {
+			return err
+		}
+		fr.regs[inst] = res
+	default:
+		return fmt.Errorf("cannot execute instruction %s", v.String())
+	}
+	return nil
+}
+
+// get returns the value of the virtual register v. Constants evaluate to their value wherever they are defined.
+func (fr *frame) get(v lir.Value) interface{} {
+	if c, ok := v.(*lir.Constant); ok {
+		return c.Value()
+	}
+	return fr.regs[v]
+}
+
+// builtin executes the external Function f, which has no body.
+func (it *interpreter) builtin(f *lir.Function, args []interface{}) (interface{}, error) {
+	switch f.Name() {
+	case "printf":
+		if len(args) < 1 {
+			return nil, errors.New("printf: missing format string")
+		}
+		format, ok := args[0].(string)
+		if !ok {
+			return nil, errors.New("printf: format is not a string")
+		}
+		var vals []interface{}
+		if len(args) > 1 {
+			vals, _ = args[1].([]interface{})
+		}
+		n, _ := it.w.WriteString(sprintf(format, vals))
+		return n, nil
+	case "dprintf":
+		if len(args) < 3 {
+			return nil, errors.New("dprintf: missing file descriptor, format string or arguments")
+		}
+		fd, _ := args[0].(int)
+		format, ok := args[1].(string)
+		if !ok {
+			return nil, errors.New("dprintf: format is not a string")
+		}
+		vals, _ := args[2].([]interface{})
+		switch fd {
+		case ir.Stdout:
+			n, _ := it.w.WriteString(sprintf(format, vals))
+			return n, nil
+		case ir.Stderr:
+			n, _ := io.WriteString(it.ew, sprintf(format, vals))
+			return n, nil
+		}
+		return -1, nil // Bad file descriptor.
+	case lir.LabelArgc:
+		return len(it.args), nil
+	case lir.LabelArgInt, lir.LabelArgFloat, lir.LabelArgString:
+		if len(args) < 1 {
+			return nil, fmt.Errorf("%s: missing argument index", f.Name())
+		}
+		i, _ := args[0].(int)
+		s := ""
+		if i >= 1 && i <= len(it.args) {
+			s = it.args[i-1]
+		}
+		switch f.Name() {
+		case lir.LabelArgInt:
+			return wrap(argInt(s), it.bits), nil
+		case lir.LabelArgFloat:
+			return argFloat(s), nil
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("cannot call external function %s", f.Name())
+}
+
+// arithmetic returns the result of the arithmetic operation op on op1 and op2, where integers have the given number
+// of bits. The operand op2 is ignored for unary operations.
+func arithmetic(op types.ArithmeticOperation, op1, op2 interface{}, n int) (interface{}, error) {
+	switch a := op1.(type) {
+	case int:
+		b, _ := op2.(int)
+		mask := uint64(n - 1) // Shift amounts are taken modulo the integer width, like the native backends.
+		switch op {
+		case types.Add:
+			return wrap(a+b, n), nil
+		case types.Sub:
+			return wrap(a-b, n), nil
+		case types.Mul:
+			return wrap(a*b, n), nil
+		case types.Div:
+			if b == 0 {
+				return nil, errors.New("integer division by zero")
+			}
+			return wrap(a/b, n), nil
+		case types.Rem:
+			if b == 0 {
+				return nil, errors.New("integer division by zero")
+			}
+			return a % b, nil
+		case types.LShift:
+			return wrap(int(uint64(a)<<(uint64(b)&mask)), n), nil
+		case types.RShift:
+			// Logical shift, like the native backends.
+			return wrap(int(uint64(a)&(^uint64(0)>>uint(64-n))>>(uint64(b)&mask)), n), nil
+		case types.And:
+			return a & b, nil
+		case types.Xor:
+			return a ^ b, nil
+		case types.Or:
+			return a | b, nil
+		case types.MulHigh:
+			if n < 64 {
+				// The product of narrower integers is exact in 64 bits.
+				return a * b >> uint(n), nil
+			}
+			hi, _ := bits.Mul64(uint64(a), uint64(b))
+			if a < 0 {
+				hi -= uint64(b)
+			}
+			if b < 0 {
+				hi -= uint64(a)
+			}
+			return int(hi), nil
+		case types.ARShift:
+			return a >> (uint64(b) & mask), nil
+		case types.Neg:
+			return wrap(-a, n), nil
+		case types.Not:
+			return ^a, nil
+		}
+	case float64:
+		b, _ := op2.(float64)
+		switch op {
+		case types.Add:
+			return a + b, nil
+		case types.Sub:
+			return a - b, nil
+		case types.Mul:
+			return a * b, nil
+		case types.Div:
+			return a / b, nil
+		case types.Neg:
+			return -a, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected operator %s on operands %v and %v", op.String(), op1, op2)
+}
+
+// overflows returns true if res, which is the result of the integer addition, subtraction or multiplication op on op1
+// and op2 wrapped to n bits, overflowed. The results of integers narrower than 64 bits overflowed if they differ from
+// the exact result. Otherwise, a sum overflows if its sign differs from the sign of both operands, and a difference if
+// its sign differs from the first operand, whose sign differs from the second. A product overflows unless it's divided
+// back into its operand.
+func overflows(op types.ArithmeticOperation, op1, op2, res interface{}, n int) bool {
+	a, b, r := op1.(int), op2.(int), res.(int)
+	if n < 64 {
+		switch op {
+		case types.Add:
+			return a+b != r
+		case types.Sub:
+			return a-b != r
+		case types.Mul:
+			return a*b != r
+		}
+		return false
+	}
+	switch op {
+	case types.Add:
+		return (a^r)&(b^r) < 0
+	case types.Sub:
+		return (a^b)&(a^r) < 0
+	case types.Mul:
+		return a != 0 && (r/a != b || (a == -1 && b == math.MinInt64))
+	}
+	return false
+}
+
+// compare returns the result of the relational operation op on op1 and op2.
+func compare(op types.RelationalOperation, op1, op2 interface{}) (bool, error) {
+	var c int
+	switch a := op1.(type) {
+	case int:
+		b, _ := op2.(int)
+		if a < b {
+			c = -1
+		} else if a > b {
+			c = 1
+		}
+	case float64:
+		b, _ := op2.(float64)
+		if math.IsNaN(a) || math.IsNaN(b) {
+			// Unordered comparisons are only true for inequality.
+			return op == types.Neq, nil
+		}
+		if a < b {
+			c = -1
+		} else if a > b {
+			c = 1
+		}
+	default:
+		return false, fmt.Errorf("cannot compare operands %v and %v", op1, op2)
+	}
+	switch op {
+	case types.Eq:
+		return c == 0, nil
+	case types.Neq:
+		return c != 0, nil
+	case types.LessThan:
+		return c < 0, nil
+	case types.LessThanOrEqual:
+		return c <= 0, nil
+	case types.GreaterThan:
+		return c > 0, nil
+	case types.GreaterThanOrEqual:
+		return c >= 0, nil
+	}
+	return false, fmt.Errorf("unexpected relational operator %d", op)
+}
+
+// zero returns the zero value of data type typ.
+func zero(typ types.DataType) interface{} {
+	if typ == types.Float {
+		return 0.0
+	}
+	return 0
+}
+
+// ftoi converts the floating point value f to an integer of n bits, rounding towards zero and saturating on overflow
+// like the aarch64 fcvtzs instruction.
+func ftoi(f float64, n int) int {
+	min, max := -1<<uint(n-1), 1<<uint(n-1)-1
+	switch {
+	case math.IsNaN(f):
+		return 0
+	case f >= float64(max):
+		return max
+	case f <= float64(min):
+		return min
+	}
+	return int(f)
+}
+
+// wrap returns the integer i wrapped around to n bits, that is its lower n bits sign extended.
+func wrap(i, n int) int {
+	s := uint(64 - n)
+	return int(int64(i<<s) >> s)
+}
@@ -0,0 +1,410 @@
+package interp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+	"vslc/src/frontend"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// srcPath defines the relative path to the typed VSL source files.
+const srcPath = "../../../resources/vsl_typed/"
+
+// interpret compiles the VSL source file src to LIR, optionally promoting local variables to SSA form, and runs it
+// with the program arguments given in the first line comment of the source file.
+func interpret(t *testing.T, src string, ssa bool) (string, int) {
+	opt := util.Options{Src: src, Threads: 1}
+	s, err := util.ReadSource(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	line, _ := bufio.NewReader(strings.NewReader(s)).ReadString('\n')
+	args := strings.Fields(strings.TrimPrefix(strings.TrimSpace(line), "//"))
+
+	root, err := frontend.Parse(s)
+	if err != nil {
+		t.Fatalf("%s: %s", src, err)
+	}
+	if err := ir.Optimise(opt, root); err != nil {
+		t.Fatalf("%s: %s", src, err)
+	}
+	m, err := lir.GenLIR(opt, root)
+	if err != nil {
+		t.Fatalf("%s: %s", src, err)
+	}
+	if ssa {
+		lir.Mem2Reg(opt, m)
+	}
+	out := bytes.Buffer{}
+	code, err := Run(context.Background(), m, root, args, false, &out, ioutil.Discard)
+	if err != nil {
+		t.Fatalf("%s: %s", src, err)
+	}
+	return out.String(), code
+}
+
+// TestRun verifies the output of selected bundled programs.
+func TestRun(t *testing.T) {
+	exp := []struct {
+		src string
+		out string
+	}{
+		{src: "hello.vsl", out: "Hello, world!\n"},
+		{src: "euclid.vsl", out: "45 and 2 are relative primes\n"},
+		{src: "fibonacci_recursive.vsl", out: "Fibonacci number # 7 is 13\n"},
+		{src: "newton.vsl", out: "The square root of 45 is 6\n"},
+		{src: "float.vsl", out: "3.140000 + 3.830000 = 6.970000\n"},
+	}
+	for _, e1 := range exp {
+		out, code := interpret(t, filepath.Join(srcPath, e1.src), false)
+		if !strings.HasPrefix(out, e1.out) {
+			t.Errorf("%s: expected output %q, got %q", e1.src, e1.out, out)
+		}
+		if code != 0 {
+			t.Errorf("%s: expected exit code 0, got %d", e1.src, code)
+		}
+	}
+}
+
+// TestRunSSA verifies that all bundled programs behave identically with and without SSA promotion.
+func TestRunSSA(t *testing.T) {
+	files, err := filepath.Glob(srcPath + "*.vsl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e1 := range files {
+		out1, code1 := interpret(t, e1, false)
+		out2, code2 := interpret(t, e1, true)
+		if out1 != out2 || code1 != code2 {
+			t.Errorf("%s: SSA output differs:\nexpected (%d):\n%s\ngot (%d):\n%s", e1, code1, out1, code2, out2)
+		}
+	}
+}
+
+// TestSprintf verifies the C printf emulation.
+func TestSprintf(t *testing.T) {
+	exp := []struct {
+		format string
+		args   []interface{}
+		out    string
+	}{
+		{format: "%d and %d\n", args: []interface{}{4, -2}, out: "4 and -2\n"},
+		{format: "%f %5.2f|%-8.3e|", args: []interface{}{1.5, 3.14159, 2.0}, out: "1.500000  3.14|2.000e+00|"},
+		{format: "%g %g", args: []interface{}{0.0001, 1234567.0}, out: "0.0001 1.23457e+06"},
+		{format: "100%% %s", args: []interface{}{"done"}, out: "100% done"},
+		{format: "%d %d", args: nil, out: "%d %d"},
+		{format: "%ld %x", args: []interface{}{7, 255}, out: "7 ff"},
+	}
+	for _, e1 := range exp {
+		if out := sprintf(e1.format, e1.args); out != e1.out {
+			t.Errorf("sprintf(%q): expected %q, got %q", e1.format, e1.out, out)
+		}
+	}
+}
+
+// TestRunArgs verifies that program arguments are accepted if they're numbers as a whole, including zero.
+func TestRunArgs(t *testing.T) {
+	root, err := frontend.Parse("def f(a int, b float) int\nbegin\n\tprint a, b\n\treturn a\nend\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt := util.Options{Threads: 1}
+	if err := ir.Optimise(opt, root); err != nil {
+		t.Fatal(err)
+	}
+	m, err := lir.GenLIR(opt, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct {
+		args []string
+		out  string
+		code int
+	}{
+		{args: []string{"0", "0.0"}, out: "0 0.000000\n", code: 0},
+		{args: []string{" -3", "1e1"}, out: "-3 10.000000\n", code: -3},
+		{args: []string{"1.5", "2"}, out: "Argument error: argument 1 is neither int nor float\n", code: 1},
+		{args: []string{"1", "x"}, out: "Argument error: argument 2 is neither int nor float\n", code: 1},
+		{args: []string{"", "1"}, out: "Argument error: argument 1 is neither int nor float\n", code: 1},
+	}
+	for _, e1 := range exp {
+		out := bytes.Buffer{}
+		code, err := Run(context.Background(), m, root, e1.args, false, &out, ioutil.Discard)
+		if err != nil {
+			t.Fatalf("%q: %s", e1.args, err)
+		}
+		if out.String() != e1.out || code != e1.code {
+			t.Errorf("%q: expected %q and exit code %d, got %q and %d", e1.args, e1.out, e1.code, out.String(), code)
+		}
+	}
+}
+
+// TestRunBuiltinArgs verifies that an entry function without parameters accepts any number of arguments if it reads
+// them by the builtin functions argc and arg, which parse a prefix of the argument like strtol and strtod.
+func TestRunBuiltinArgs(t *testing.T) {
+	src := "def f() int\nbegin\n\tvar x float\n\tx := arg(2)\n\tprint argc(), arg(1), x\n" +
+		"\tprintf \"%d %f\", arg(1) + arg(3), arg(2)\n\treturn arg(1)\nend\n"
+	root, err := frontend.Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt := util.Options{Threads: 1}
+	if err := ir.Optimise(opt, root); err != nil {
+		t.Fatal(err)
+	}
+	m, err := lir.GenLIR(opt, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct {
+		args []string
+		out  string
+		code int
+	}{
+		{args: []string{"5", "2.5", "7x"}, out: "3 5 2.500000\n12 2.500000\n", code: 5},
+		{args: []string{"-2"}, out: "1 -2 0.000000\n-2 0.000000\n", code: -2},
+		{args: nil, out: "0  0.000000\n0 0.000000\n", code: 0},
+	}
+	for _, e1 := range exp {
+		out := bytes.Buffer{}
+		code, err := Run(context.Background(), m, root, e1.args, false, &out, ioutil.Discard)
+		if err != nil {
+			t.Fatalf("%q: %s", e1.args, err)
+		}
+		if out.String() != e1.out || code != e1.code {
+			t.Errorf("%q: expected %q and exit code %d, got %q and %d", e1.args, e1.out, e1.code, out.String(), code)
+		}
+	}
+}
+
+// TestRunStderr verifies that print statements redirected to standard error are written to their own writer, and that
+// output to other file descriptors is discarded.
+func TestRunStderr(t *testing.T) {
+	src := "def f(a int) int\nbegin\n\tprint \"out\", a\n\teprint \"err\", a\n\tprintf > 2 \"a = %d\", a\n" +
+		"\tprint > 1 \"out\"\n\tprint > 7 \"lost\"\n\treturn 0\nend\n"
+	root, err := frontend.Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt := util.Options{Threads: 1}
+	if err := ir.Optimise(opt, root); err != nil {
+		t.Fatal(err)
+	}
+	m, err := lir.GenLIR(opt, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, errs := bytes.Buffer{}, bytes.Buffer{}
+	if _, err := Run(context.Background(), m, root, []string{"3"}, false, &out, &errs); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "out 3\nout\n" || errs.String() != "err 3\na = 3\n" {
+		t.Errorf("expected output %q and %q, got %q and %q", "out 3\nout\n", "err 3\na = 3\n", out.String(),
+			errs.String())
+	}
+}
+
+// TestRunTrapv verifies that integer additions, subtractions, negations and multiplications that overflow are errors
+// if trapv is set, and wrap around otherwise.
+func TestRunTrapv(t *testing.T) {
+	src := "def f(a, b int) int\nbegin\n\tvar c int\n\tc := a + b\n\tc := a - b\n\tc := -a\n\tc := a * b\n" +
+		"\treturn 0\nend\n"
+	root, err := frontend.Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt := util.Options{Threads: 1}
+	if err := ir.Optimise(opt, root); err != nil {
+		t.Fatal(err)
+	}
+	m, err := lir.GenLIR(opt, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct {
+		args     []string
+		overflow bool
+	}{
+		{args: []string{"3", "-4"}, overflow: false},
+		{args: []string{"-9223372036854775808", "-1"}, overflow: true},
+		{args: []string{"9223372036854775807", "1"}, overflow: true},
+		{args: []string{"-9223372036854775807", "1"}, overflow: false},
+		{args: []string{"-9223372036854775807", "2"}, overflow: true},
+		{args: []string{"4294967296", "2147483648"}, overflow: true},
+		{args: []string{"4294967296", "-2147483648"}, overflow: false},
+	}
+	for _, e1 := range exp {
+		if _, err := Run(context.Background(), m, root, e1.args, false, ioutil.Discard, ioutil.Discard); err != nil {
+			t.Errorf("%q: expected no error without trapv, got %s", e1.args, err)
+		}
+		_, err := Run(context.Background(), m, root, e1.args, true, ioutil.Discard, ioutil.Discard)
+		if (err != nil) != e1.overflow {
+			t.Errorf("%q: expected overflow %t, got %v", e1.args, e1.overflow, err)
+		}
+	}
+}
+
+// TestRunShift verifies that >> shifts in the sign bit and >>> shifts in zeros, whether the shift is computed at run
+// time or folded by the optimiser.
+func TestRunShift(t *testing.T) {
+	src := "def f(a, b int) int\nbegin\n\tprint a >> b, a >>> b, -8 >> 1, -8 >>> 1, 8 >>> 1\n\treturn 0\nend\n"
+	exp := "-4 9223372036854775804 -4 9223372036854775804 4\n"
+	root, err := frontend.Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt := util.Options{Threads: 1}
+	if err := ir.Optimise(opt, root); err != nil {
+		t.Fatal(err)
+	}
+	m, err := lir.GenLIR(opt, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := bytes.Buffer{}
+	if _, err := Run(context.Background(), m, root, []string{"-8", "1"}, false, &out, ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != exp {
+		t.Errorf("expected %q, got %q", exp, out.String())
+	}
+}
+
+// TestRunIntBits verifies that integers wrap around to the integer width of the Module, including the program
+// arguments, that logical right shifts shift in zeros at the sign bit of the width, and that trapv checks for overflow
+// of the width.
+func TestRunIntBits(t *testing.T) {
+	src := "def f(a, b, c int) int\nbegin\n\tprint a + b, a * b, a / b, a >>> b, c\n\treturn 0\nend\n"
+	exp := []struct {
+		bits int
+		out  string
+	}{
+		{bits: 64, out: "-2147483649 2147483648 2147483648 1 4294967297\n"},
+		{bits: 32, out: "2147483647 -2147483648 -2147483648 1 1\n"},
+	}
+	for _, e1 := range exp {
+		root, err := frontend.Parse(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		opt := util.Options{Threads: 1, IntWidth: e1.bits}
+		if err := ir.Optimise(opt, root); err != nil {
+			t.Fatal(err)
+		}
+		m, err := lir.GenLIR(opt, root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := bytes.Buffer{}
+		args := []string{"-2147483648", "-1", "4294967297"}
+		if _, err := Run(context.Background(), m, root, args, false, &out, ioutil.Discard); err != nil {
+			t.Fatalf("%d bits: %s", e1.bits, err)
+		}
+		if out.String() != e1.out {
+			t.Errorf("%d bits: expected %q, got %q", e1.bits, e1.out, out.String())
+		}
+		_, err = Run(context.Background(), m, root, args, true, ioutil.Discard, ioutil.Discard)
+		if (err != nil) != (e1.bits == 32) {
+			t.Errorf("%d bits: expected overflow %t, got %v", e1.bits, e1.bits == 32, err)
+		}
+	}
+}
+
+// TestRunStrengthReduction verifies multiplication and division by constant powers of 2, and powers of 2 plus one,
+// that the optimiser and LIR reduce to shifts, for positive and negative odd dividends.
+func TestRunStrengthReduction(t *testing.T) {
+	src := "def f(a int) int\nbegin\n\tprint a * 8, a * 9, a * 3, a / 8, a / 2, a / -8\n\treturn 0\nend\n"
+	exp := []struct {
+		arg string
+		out string
+	}{
+		{arg: "64", out: "512 576 192 8 32 -8\n"},
+		{arg: "-7", out: "-56 -63 -21 0 -3 0\n"},
+		{arg: "-65", out: "-520 -585 -195 -8 -32 8\n"},
+	}
+	root, err := frontend.Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt := util.Options{Threads: 1}
+	if err := ir.Optimise(opt, root); err != nil {
+		t.Fatal(err)
+	}
+	m, err := lir.GenLIR(opt, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lir.LowerDivision(opt, m)
+	for _, e1 := range exp {
+		out := bytes.Buffer{}
+		if _, err := Run(context.Background(), m, root, []string{e1.arg}, false, &out, ioutil.Discard); err != nil {
+			t.Fatal(err)
+		}
+		if out.String() != e1.out {
+			t.Errorf("%s: expected %q, got %q", e1.arg, e1.out, out.String())
+		}
+	}
+}
+
+// TestRunLowerDivision verifies that division by constants, lowered to shift sequences for powers of two and to
+// multiply high sequences otherwise, rounds towards zero like signed division, for signed dividends of both integer
+// widths.
+func TestRunLowerDivision(t *testing.T) {
+	divisors := []int{2, 3, 5, 6, 7, 8, 10, 16, 25, 64, 100, 641, 1000, 1024, 12345, 1<<30 - 1, 1 << 30, -2, -3,
+		-7, -8, -10, -1000, -1024, -(1 << 30)}
+	src := strings.Builder{}
+	src.WriteString("def f(a int) int\nbegin\n\tprint a")
+	for _, d := range divisors {
+		fmt.Fprintf(&src, ", a / %d", d)
+	}
+	src.WriteString("\n\treturn 0\nend\n")
+
+	for _, bits := range []int{32, 64} {
+		min, max := math.MinInt32, math.MaxInt32
+		if bits == 64 {
+			min, max = math.MinInt64, math.MaxInt64
+		}
+		root, err := frontend.Parse(src.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		opt := util.Options{Threads: 1, IntWidth: bits}
+		if err := ir.Optimise(opt, root); err != nil {
+			t.Fatal(err)
+		}
+		m, err := lir.GenLIR(opt, root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lir.LowerDivision(opt, m)
+		if strings.Contains(m.String(), " div ") {
+			t.Fatalf("%d bits: division by constant not lowered:\n%s", bits, m.String())
+		}
+		for _, n := range []int{0, 1, -1, 2, -2, 7, -7, 63, -63, 64, -64, 65, -65, 99, -99, 1000, -1001, 123456789,
+			-123456789, min, min + 1, max, max - 1} {
+			exp := strings.Builder{}
+			fmt.Fprint(&exp, n)
+			for _, d := range divisors {
+				fmt.Fprintf(&exp, " %d", n/d)
+			}
+			exp.WriteString("\n")
+			out := bytes.Buffer{}
+			arg := []string{fmt.Sprint(n)}
+			if _, err := Run(context.Background(), m, root, arg, false, &out, ioutil.Discard); err != nil {
+				t.Fatalf("%d bits: %d: %s", bits, n, err)
+			}
+			if out.String() != exp.String() {
+				t.Errorf("%d bits: %d: expected %q, got %q", bits, n, exp.String(), out.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package interp
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// printfFlags holds the flag characters of C printf conversion specifications.
+const printfFlags = "-+ #0"
+
+// printfLength holds the length modifier characters of C printf conversion specifications.
+const printfLength = "hlLqjzt"
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// sprintf formats args according to the C printf format string format. Conversion specifications without a matching
+// argument are written unchanged.
+func sprintf(format string, args []interface{}) string {
+	sb := strings.Builder{}
+	ai := 0
+	for i1 := 0; i1 < len(format); i1++ {
+		if format[i1] != '%' {
+			sb.WriteByte(format[i1])
+			continue
+		}
+
+		// Parse flags, width and precision. Length modifiers are ignored, all integers are 64-bit.
+		i2 := i1 + 1
+		for i2 < len(format) && strings.IndexByte(printfFlags, format[i2]) >= 0 {
+			i2++
+		}
+		for i2 < len(format) && isDigit(format[i2]) {
+			i2++
+		}
+		prec := false
+		if i2 < len(format) && format[i2] == '.' {
+			prec = true
+			i2++
+			for i2 < len(format) && isDigit(format[i2]) {
+				i2++
+			}
+		}
+		spec := format[i1+1 : i2]
+		for i2 < len(format) && strings.IndexByte(printfLength, format[i2]) >= 0 {
+			i2++
+		}
+		if i2 >= len(format) {
+			sb.WriteString(format[i1:])
+			break
+		}
+
+		conv := format[i2]
+		if conv == '%' {
+			sb.WriteByte('%')
+			i1 = i2
+			continue
+		}
+		if strings.IndexByte("diuxXocsfFeEgG", conv) < 0 || ai >= len(args) {
+			sb.WriteString(format[i1 : i2+1])
+			i1 = i2
+			continue
+		}
+		arg := args[ai]
+		ai++
+
+		switch conv {
+		case 'd', 'i':
+			sb.WriteString(fmt.Sprintf("%"+spec+"d", toInt(arg)))
+		case 'u':
+			sb.WriteString(fmt.Sprintf("%"+spec+"d", uint64(toInt(arg))))
+		case 'x', 'X', 'o':
+			sb.WriteString(fmt.Sprintf("%"+spec+string(conv), uint64(toInt(arg))))
+		case 'c':
+			sb.WriteString(fmt.Sprintf("%"+spec+"c", rune(byte(toInt(arg)))))
+		case 's':
+			s, ok := arg.(string)
+			if !ok {
+				s = "(null)"
+			}
+			sb.WriteString(fmt.Sprintf("%"+spec+"s", s))
+		default:
+			f := toFloat(arg)
+			if math.IsInf(f, 0) || math.IsNaN(f) {
+				sb.WriteString(nonFinite(f, spec, conv))
+				break
+			}
+			if (conv == 'g' || conv == 'G') && !prec {
+				// C defaults to 6 significant digits, Go to the shortest representation.
+				spec += ".6"
+			}
+			sb.WriteString(fmt.Sprintf("%"+spec+string(conv), f))
+		}
+		i1 = i2
+	}
+	return sb.String()
+}
+
+// nonFinite formats an infinite or NaN value f the way C printf does.
+func nonFinite(f float64, spec string, conv byte) string {
+	var s string
+	switch {
+	case math.IsNaN(f):
+		s = "nan"
+	case f < 0:
+		s = "-inf"
+	case strings.IndexByte(spec, '+') >= 0:
+		s = "+inf"
+	default:
+		s = "inf"
+	}
+	if conv == 'F' || conv == 'E' || conv == 'G' {
+		s = strings.ToUpper(s)
+	}
+
+	// Only the width and left adjustment apply.
+	width := strings.TrimLeft(spec, printfFlags)
+	if i1 := strings.IndexByte(width, '.'); i1 >= 0 {
+		width = width[:i1]
+	}
+	if strings.IndexByte(spec, '-') >= 0 {
+		return fmt.Sprintf("%-"+width+"s", s)
+	}
+	return fmt.Sprintf("%"+width+"s", s)
+}
+
+// toInt returns the integer value of the printf argument arg.
+func toInt(arg interface{}) int {
+	switch v := arg.(type) {
+	case int:
+		return v
+	case float64:
+		return int(math.Float64bits(v))
+	}
+	return 0
+}
+
+// toFloat returns the floating point value of the printf argument arg.
+func toFloat(arg interface{}) float64 {
+	switch v := arg.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	}
+	return 0
+}
+
+// strtol parses s as a decimal integer the way C strtol does, and returns false unless s holds an integer as a whole,
+// like the implicit main function checks the end pointer of strtol. Leading white space and a sign are accepted, and
+// integers out of range saturate.
+func strtol(s string) (int, bool) {
+	v, err := strconv.ParseInt(strings.TrimLeft(s, " \t\n\v\f\r"), 10, 64)
+	if err != nil {
+		if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+			return int(v), true
+		}
+		return 0, false
+	}
+	return int(v), true
+}
+
+// strtod parses s as a floating point number the way C strtod does, and returns false unless s holds a number as a
+// whole. Leading white space is accepted, and numbers out of range saturate.
+func strtod(s string) (float64, bool) {
+	v, err := strconv.ParseFloat(strings.TrimLeft(s, " \t\n\v\f\r"), 64)
+	if err != nil {
+		if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+			return v, true
+		}
+		return 0, false
+	}
+	return v, true
+}
+
+// argInt parses the longest prefix of s that is an integer, like C strtol does without checking the end of the
+// number. Zero is returned if s doesn't start with a number.
+func argInt(s string) int {
+	for i1 := len(s); i1 > 0; i1-- {
+		if v, ok := strtol(s[:i1]); ok {
+			return v
+		}
+	}
+	return 0
+}
+
+// argFloat parses the longest prefix of s that is a floating point number, like C strtod does without checking the
+// end of the number. Zero is returned if s doesn't start with a number.
+func argFloat(s string) float64 {
+	for i1 := len(s); i1 > 0; i1-- {
+		if v, ok := strtod(s[:i1]); ok {
+			return v
+		}
+	}
+	return 0
+}
+
+// isDigit returns true if c is a decimal digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,153 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"vslc/src/backend/vslrt"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Linker is implemented by Targets whose output is assembled and linked into an executable by a C compiler driver,
+// such as gcc or clang.
+type Linker interface {
+	// Toolchain returns the target triple of the C compiler driver for the target configured by opt, such as
+	// aarch64-linux-gnu, and the flags that select the target's ISA and ABI. Cross-compilers are named by the triple,
+	// such as aarch64-linux-gnu-gcc.
+	Toolchain(opt util.Options) (string, []string, error)
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// DefaultExecutable is the name of the executable written by Link to the output directory if no output path is given.
+const DefaultExecutable = "app.out"
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// hostArch maps the Go architecture of the host to the first component of the target triples it runs natively.
+var hostArch = map[string]string{
+	"amd64":   "x86_64",
+	"arm64":   "aarch64",
+	"arm":     "arm",
+	"riscv64": "riscv64",
+}
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// Link assembles the assembler source file src and links it into the executable out, using the C compiler driver of
+// the Target defined by opt. The driver is taken from the CC environment variable if it's set. Otherwise the
+// cross-compiler named by the target triple is used, the host's cc if the target is the host, or clang. The VSL runtime
+// library is compiled and linked with the program if opt.Runtime is set.
+func Link(opt util.Options, src, out string) error {
+	var args []string
+	if opt.Freestanding {
+		args = append(args, "-nostdlib", "-static")
+		if len(opt.LinkerScript) > 0 {
+			args = append(args, "-T", opt.LinkerScript)
+		}
+	} else if !opt.PIC && opt.TargetOS != util.MAC {
+		// Absolute addresses of global data can't be relocated at load time.
+		args = append(args, "-no-pie")
+	}
+	if len(out) == 0 {
+		out = filepath.Join(opt.OutDir, DefaultExecutable)
+	}
+	args = append(args, "-o", out, src)
+	if opt.Runtime {
+		// Compile the runtime library along with the program.
+		rt, err := vslrt.WriteSource()
+		if err != nil {
+			return err
+		}
+		defer os.Remove(rt)
+		args = append(args, rt)
+	}
+	return drive(opt, args)
+}
+
+// Assemble assembles the assembler source file src into the object file out, using the C compiler driver of the
+// Target defined by opt, in the same way as Link.
+func Assemble(opt util.Options, src, out string) error {
+	return drive(opt, []string{"-c", "-o", out, src})
+}
+
+// Driver returns the command line of the C compiler driver of the Target defined by opt, including the target's
+// flags, and the target triple that selects it. An error is returned if the Target can't be assembled and linked, or
+// if no C compiler is found. See Link.
+func Driver(opt util.Options) ([]string, string, error) {
+	t, err := Lookup(opt.TargetArch)
+	if err != nil {
+		return nil, "", err
+	}
+	l, ok := t.(Linker)
+	if !ok {
+		return nil, "", fmt.Errorf("assembling and linking is not supported for target %s", t.Name())
+	}
+	triple, flags, err := l.Toolchain(opt)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(opt.Triple) > 0 {
+		// The target triple given on the command line selects the C compiler driver, such as for musl or freebsd.
+		triple = opt.Triple
+	}
+	cc, err := compiler(triple)
+	if err != nil {
+		return nil, "", err
+	}
+	if cc[0] == "clang" {
+		cc = append(cc, "--target="+triple)
+	}
+	return append(cc, flags...), triple, nil
+}
+
+// drive runs the C compiler driver of the Target defined by opt with the target's flags, followed by args.
+func drive(opt util.Options, args []string) error {
+	cc, _, err := Driver(opt)
+	if err != nil {
+		return err
+	}
+	args = append(cc[1:], args...)
+
+	opt.Log.Infof("%s %s", cc[0], strings.Join(args, " "))
+	cmd := exec.CommandContext(opt.Context(), cc[0], args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s failed: %s", cc[0], err)
+	}
+	return nil
+}
+
+// compiler returns the command and leading arguments of the C compiler driver for target triple.
+func compiler(triple string) ([]string, error) {
+	if cc := strings.Fields(os.Getenv("CC")); len(cc) > 0 {
+		return cc, nil
+	}
+	if path, err := exec.LookPath(triple + "-gcc"); err == nil {
+		return []string{path}, nil
+	}
+	if strings.HasPrefix(triple, hostArch[runtime.GOARCH]+"-") && strings.Contains(triple, runtime.GOOS) {
+		if path, err := exec.LookPath("cc"); err == nil {
+			return []string{path}, nil
+		}
+	}
+	if _, err := exec.LookPath("clang"); err == nil {
+		return []string{"clang"}, nil
+	}
+	return nil, errors.New("no C compiler found for " + triple + ", set the CC environment variable")
+}
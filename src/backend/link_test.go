@@ -0,0 +1,36 @@
+package backend
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"vslc/src/util"
+)
+
+// TestCompiler verifies that the C compiler driver is taken from the CC environment variable, including its
+// arguments.
+func TestCompiler(t *testing.T) {
+	cc := os.Getenv("CC")
+	defer func() {
+		_ = os.Setenv("CC", cc)
+	}()
+	if err := os.Setenv("CC", "ccache  gcc"); err != nil {
+		t.Fatal(err)
+	}
+	res, err := compiler("aarch64-linux-gnu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := []string{"ccache", "gcc"}; !reflect.DeepEqual(res, exp) {
+		t.Errorf("expected %v, got %v", exp, res)
+	}
+}
+
+// TestLink verifies that targets that don't implement Linker are rejected.
+func TestLink(t *testing.T) {
+	const arch = -2
+	Register(arch, fake{})
+	if err := Link(util.Options{TargetArch: arch}, "app.s", "app"); err == nil {
+		t.Errorf("expected error linking target without toolchain")
+	}
+}
@@ -0,0 +1,520 @@
+package lir
+
+import (
+	"math"
+	"vslc/src/backend/regfile"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// nodeState identifies the work list or set that a register interference graph node belongs to.
+type nodeState int
+
+// moveState identifies the work list or set that a move belongs to.
+type moveState int
+
+// move is a copy of virtual register src to virtual register dst. The copy is eliminated if both are assigned the
+// same physical register.
+type move struct {
+	dst   *lir.LiveNode // Destination of the copy.
+	src   *lir.LiveNode // Source of the copy.
+	state moveState     // Work list or set that the move belongs to.
+}
+
+// colorer assigns physical registers to the register interference graph of a single function using iterated
+// register coalescing, as described by George and Appel. Nodes with fewer than k neighbours are simplified, moves are
+// coalesced when the Briggs or George test guarantees that colourability is preserved, move related nodes are frozen
+// when nothing else can be done and high degree nodes are selected as potential spills. Potential spills are coloured
+// optimistically, so an actual spill is only required if no register is left when the node is popped off the stack.
+type colorer struct {
+	rf       regfile.RegisterFile
+	nodes    []*lir.LiveNode                   // Every node taking part in colouring, in a deterministic order.
+	state    map[*lir.LiveNode]nodeState       // Work list or set of every node.
+	adjSet   map[[2]*lir.LiveNode]bool         // Set of interfering node pairs, stored in both directions.
+	adjList  map[*lir.LiveNode][]*lir.LiveNode // Interfering nodes of every non-precoloured node.
+	degree   map[*lir.LiveNode]int             // Number of interfering nodes of every node.
+	moveList map[*lir.LiveNode][]*move         // Moves that every node is related to.
+	alias    map[*lir.LiveNode]*lir.LiveNode   // Node that a coalesced node was merged into.
+	moves    []*move                           // Work list of moves. Entries no longer in the work list are skipped.
+	simplify []*lir.LiveNode                   // Work list of low degree nodes that are not move related.
+	freeze   []*lir.LiveNode                   // Work list of low degree nodes that are move related.
+	spill    []*lir.LiveNode                   // Work list of high degree nodes.
+	stack    []*lir.LiveNode                   // Nodes removed from the graph, in order of removal.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+const (
+	initial nodeState = iota
+	precolored
+	simplifyList
+	freezeList
+	spillList
+	spilled
+	coalesced
+	colored
+	selectStack
+)
+
+const (
+	worklistMove moveState = iota
+	activeMove
+	coalescedMove
+	constrainedMove
+	frozenMove
+)
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// colorFunction assigns physical registers of the register file rf to the virtual registers of the register
+// interference graph rig of Function f. Nodes of rig with a register assigned already are treated as precoloured.
+// Nodes that cannot be coloured are spilled to stack slots.
+func colorFunction(rf regfile.RegisterFile, f *lir.Function, rig []*lir.LiveNode) {
+	c := &colorer{
+		rf:       rf,
+		state:    map[*lir.LiveNode]nodeState{},
+		adjSet:   map[[2]*lir.LiveNode]bool{},
+		adjList:  map[*lir.LiveNode][]*lir.LiveNode{},
+		degree:   map[*lir.LiveNode]int{},
+		moveList: map[*lir.LiveNode][]*move{},
+		alias:    map[*lir.LiveNode]*lir.LiveNode{},
+	}
+	c.build(f, rig)
+	c.makeWorklist()
+	for {
+		if n := c.pop(&c.simplify, simplifyList); n != nil {
+			c.simplifyNode(n)
+		} else if m := c.nextMove(); m != nil {
+			c.coalesce(m)
+		} else if n := c.pop(&c.freeze, freezeList); n != nil {
+			c.freezeNode(n)
+		} else if n := c.selectSpill(); n != nil {
+			c.push(&c.simplify, n, simplifyList)
+			c.freezeMoves(n)
+		} else {
+			break
+		}
+	}
+	c.assignColors()
+}
+
+// colorable returns true if LiveNode n wraps a value that is assigned a register by the register allocator.
+func colorable(n *lir.LiveNode) bool {
+	switch n.Val.Type() {
+	case types.DataInstruction, types.LoadInstruction, types.PreserveInstruction, types.CastInstruction,
+		types.SelectInstruction:
+		return true
+	case types.Constant:
+		return !n.Val.(*lir.Constant).Immediate()
+	}
+	return false
+}
+
+// isInt returns true if LiveNode n is assigned an integer register, and false if it's assigned a floating point
+// register.
+func (c *colorer) isInt(n *lir.LiveNode) bool {
+	if r := n.Reg; r != nil {
+		return r.Type() == int(types.Int)
+	}
+	return intClass(c.rf, n.Val.DataType())
+}
+
+// k returns the number of registers available for colouring LiveNode n.
+func (c *colorer) k(n *lir.LiveNode) int {
+	if c.isInt(n) {
+		return c.rf.Ki()
+	}
+	return c.rf.Kf()
+}
+
+// build creates the interference graph and the list of moves from the LiveNode dependencies of rig. The copy of a
+// move does not make its source and destination interfere, unless the source is live after the copy.
+func (c *colorer) build(f *lir.Function, rig []*lir.LiveNode) {
+	add := func(n *lir.LiveNode) {
+		if _, ok := c.state[n]; ok {
+			return
+		}
+		if n.Reg != nil {
+			c.state[n] = precolored
+			c.degree[n] = math.MaxInt32
+		} else if colorable(n) {
+			c.state[n] = initial
+		} else {
+			return
+		}
+		c.nodes = append(c.nodes, n)
+	}
+	for _, e1 := range rig {
+		add(e1)
+		for _, e2 := range e1.Dep {
+			add(e2)
+		}
+	}
+
+	// Find copies of virtual registers.
+	lv := f.Liveness()
+	copies := map[[2]*lir.LiveNode]bool{}
+	for _, e1 := range rig {
+		if e1.Val.Type() != types.PreserveInstruction && e1.Val.Type() != types.ReturnInstruction {
+			continue
+		}
+		op := e1.Val.Operand1()
+		if op == nil {
+			continue
+		}
+		src := op.GetHW()
+		if src == nil {
+			continue
+		}
+		if _, ok := c.state[src]; !ok {
+			continue
+		}
+		if _, ok := c.state[e1]; !ok || c.isInt(src) != c.isInt(e1) {
+			continue
+		}
+		m := &move{dst: e1, src: src}
+		c.moves = append(c.moves, m)
+		c.moveList[e1] = append(c.moveList[e1], m)
+		c.moveList[src] = append(c.moveList[src], m)
+
+		live := false
+		for _, e2 := range lv.LiveOut(e1.Val) {
+			if e2 == op {
+				live = true
+				break
+			}
+		}
+		if !live {
+			copies[[2]*lir.LiveNode{e1, src}] = true
+			copies[[2]*lir.LiveNode{src, e1}] = true
+		}
+	}
+
+	// Create interference edges between nodes of the same register class.
+	for _, e1 := range c.nodes {
+		for _, e2 := range e1.Dep {
+			if _, ok := c.state[e2]; !ok || e1 == e2 || c.isInt(e1) != c.isInt(e2) || copies[[2]*lir.LiveNode{e1, e2}] {
+				continue
+			}
+			c.addEdge(e1, e2)
+		}
+	}
+}
+
+// addEdge makes nodes u and v interfere.
+func (c *colorer) addEdge(u, v *lir.LiveNode) {
+	if u == v || c.adjSet[[2]*lir.LiveNode{u, v}] {
+		return
+	}
+	c.adjSet[[2]*lir.LiveNode{u, v}] = true
+	c.adjSet[[2]*lir.LiveNode{v, u}] = true
+	if c.state[u] != precolored {
+		c.adjList[u] = append(c.adjList[u], v)
+		c.degree[u]++
+	}
+	if c.state[v] != precolored {
+		c.adjList[v] = append(c.adjList[v], u)
+		c.degree[v]++
+	}
+}
+
+// makeWorklist puts every non-precoloured node in the work list matching its degree and move relation.
+func (c *colorer) makeWorklist() {
+	for _, e1 := range c.nodes {
+		if c.state[e1] != initial {
+			continue
+		}
+		if c.degree[e1] >= c.k(e1) {
+			c.push(&c.spill, e1, spillList)
+		} else if c.moveRelated(e1) {
+			c.push(&c.freeze, e1, freezeList)
+		} else {
+			c.push(&c.simplify, e1, simplifyList)
+		}
+	}
+}
+
+// push appends node n to work list wl and marks it as belonging to the work list identified by s.
+func (c *colorer) push(wl *[]*lir.LiveNode, n *lir.LiveNode, s nodeState) {
+	c.state[n] = s
+	*wl = append(*wl, n)
+}
+
+// pop removes and returns the last node of work list wl that still belongs to the work list identified by s. Nodes
+// that have moved to another work list or set are discarded. Returns nil if the work list is empty.
+func (c *colorer) pop(wl *[]*lir.LiveNode, s nodeState) *lir.LiveNode {
+	for len(*wl) > 0 {
+		n := (*wl)[len(*wl)-1]
+		*wl = (*wl)[:len(*wl)-1]
+		if c.state[n] == s {
+			return n
+		}
+	}
+	return nil
+}
+
+// nextMove removes and returns the next move of the move work list. Returns nil if the work list is empty.
+func (c *colorer) nextMove() *move {
+	for len(c.moves) > 0 {
+		m := c.moves[len(c.moves)-1]
+		c.moves = c.moves[:len(c.moves)-1]
+		if m.state == worklistMove {
+			return m
+		}
+	}
+	return nil
+}
+
+// adjacent returns the neighbours of node n that are still present in the graph.
+func (c *colorer) adjacent(n *lir.LiveNode) []*lir.LiveNode {
+	res := make([]*lir.LiveNode, 0, len(c.adjList[n]))
+	for _, e1 := range c.adjList[n] {
+		if s := c.state[e1]; s != selectStack && s != coalesced {
+			res = append(res, e1)
+		}
+	}
+	return res
+}
+
+// nodeMoves returns the moves of node n that may still be coalesced.
+func (c *colorer) nodeMoves(n *lir.LiveNode) []*move {
+	res := make([]*move, 0, len(c.moveList[n]))
+	for _, e1 := range c.moveList[n] {
+		if e1.state == activeMove || e1.state == worklistMove {
+			res = append(res, e1)
+		}
+	}
+	return res
+}
+
+// moveRelated returns true if node n is the source or destination of a move that may still be coalesced.
+func (c *colorer) moveRelated(n *lir.LiveNode) bool {
+	return len(c.nodeMoves(n)) > 0
+}
+
+// simplifyNode removes node n from the graph and pushes it on the select stack.
+func (c *colorer) simplifyNode(n *lir.LiveNode) {
+	c.state[n] = selectStack
+	c.stack = append(c.stack, n)
+	for _, e1 := range c.adjacent(n) {
+		c.decrementDegree(e1)
+	}
+}
+
+// decrementDegree decrements the degree of node n. If n becomes a low degree node, it's moved to the simplify or
+// freeze work list and the moves of n and its neighbours are enabled for coalescing.
+func (c *colorer) decrementDegree(n *lir.LiveNode) {
+	if c.state[n] == precolored {
+		return
+	}
+	d := c.degree[n]
+	c.degree[n]--
+	if d != c.k(n) {
+		return
+	}
+	c.enableMoves(append(c.adjacent(n), n))
+	if c.state[n] != spillList {
+		return
+	}
+	if c.moveRelated(n) {
+		c.push(&c.freeze, n, freezeList)
+	} else {
+		c.push(&c.simplify, n, simplifyList)
+	}
+}
+
+// enableMoves puts the active moves of the nodes ns back in the move work list.
+func (c *colorer) enableMoves(ns []*lir.LiveNode) {
+	for _, e1 := range ns {
+		for _, e2 := range c.nodeMoves(e1) {
+			if e2.state == activeMove {
+				e2.state = worklistMove
+				c.moves = append(c.moves, e2)
+			}
+		}
+	}
+}
+
+// getAlias returns the node that node n was coalesced into, or n itself if it's not coalesced.
+func (c *colorer) getAlias(n *lir.LiveNode) *lir.LiveNode {
+	for c.state[n] == coalesced {
+		n = c.alias[n]
+	}
+	return n
+}
+
+// addWorklist moves node u from the freeze work list to the simplify work list if it's no longer move related and
+// of low degree.
+func (c *colorer) addWorklist(u *lir.LiveNode) {
+	if c.state[u] != precolored && !c.moveRelated(u) && c.degree[u] < c.k(u) {
+		c.push(&c.simplify, u, simplifyList)
+	}
+}
+
+// ok implements the George test for neighbour t of a node to be coalesced with the precoloured node r. Node t must
+// be of low degree, already interfere with r or be precoloured with another register than r.
+func (c *colorer) ok(t, r *lir.LiveNode) bool {
+	if c.state[t] == precolored {
+		return t.Reg != r.Reg
+	}
+	return c.degree[t] < c.k(t) || c.adjSet[[2]*lir.LiveNode{t, r}]
+}
+
+// conservative implements the Briggs test, which is true if fewer than k of the nodes ns are of high degree.
+func (c *colorer) conservative(ns []*lir.LiveNode) bool {
+	seen := map[*lir.LiveNode]bool{}
+	n := 0
+	for _, e1 := range ns {
+		if seen[e1] {
+			continue
+		}
+		seen[e1] = true
+		if c.degree[e1] >= c.k(e1) {
+			n++
+		}
+	}
+	return len(ns) == 0 || n < c.k(ns[0])
+}
+
+// coalesce merges the source and destination of move m if this does not make the graph uncolourable.
+func (c *colorer) coalesce(m *move) {
+	u := c.getAlias(m.src)
+	v := c.getAlias(m.dst)
+	if c.state[v] == precolored {
+		u, v = v, u
+	}
+
+	if u == v {
+		m.state = coalescedMove
+		c.addWorklist(u)
+	} else if c.state[v] == precolored || c.adjSet[[2]*lir.LiveNode{u, v}] {
+		m.state = constrainedMove
+		c.addWorklist(u)
+		c.addWorklist(v)
+	} else if c.canCoalesce(u, v) {
+		m.state = coalescedMove
+		c.combine(u, v)
+		c.addWorklist(u)
+	} else {
+		m.state = activeMove
+	}
+}
+
+// canCoalesce returns true if node v can be merged into node u. The George test is used if u is precoloured, and
+// the Briggs test otherwise.
+func (c *colorer) canCoalesce(u, v *lir.LiveNode) bool {
+	if c.state[u] == precolored {
+		for _, e1 := range c.adjacent(v) {
+			if !c.ok(e1, u) {
+				return false
+			}
+		}
+		return true
+	}
+	return c.conservative(append(c.adjacent(u), c.adjacent(v)...))
+}
+
+// combine merges node v into node u.
+func (c *colorer) combine(u, v *lir.LiveNode) {
+	c.state[v] = coalesced
+	c.alias[v] = u
+	c.moveList[u] = append(c.moveList[u], c.moveList[v]...)
+	c.enableMoves([]*lir.LiveNode{v})
+	for _, e1 := range c.adjacent(v) {
+		c.addEdge(e1, u)
+		c.decrementDegree(e1)
+	}
+	if c.degree[u] >= c.k(u) && c.state[u] == freezeList {
+		c.push(&c.spill, u, spillList)
+	}
+}
+
+// freezeNode gives up coalescing the moves of node u, making it a candidate for simplification.
+func (c *colorer) freezeNode(u *lir.LiveNode) {
+	c.push(&c.simplify, u, simplifyList)
+	c.freezeMoves(u)
+}
+
+// freezeMoves gives up coalescing the moves of node u.
+func (c *colorer) freezeMoves(u *lir.LiveNode) {
+	for _, e1 := range c.nodeMoves(u) {
+		v := c.getAlias(e1.src)
+		if v == c.getAlias(u) {
+			v = c.getAlias(e1.dst)
+		}
+		e1.state = frozenMove
+		if c.state[v] == freezeList && !c.moveRelated(v) && c.degree[v] < c.k(v) {
+			c.push(&c.simplify, v, simplifyList)
+		}
+	}
+}
+
+// selectSpill removes and returns the node of highest degree from the spill work list. Returns nil if the work
+// list is empty.
+func (c *colorer) selectSpill() *lir.LiveNode {
+	var res *lir.LiveNode
+	i := -1
+	for i1, e1 := range c.spill {
+		if c.state[e1] == spillList && (res == nil || c.degree[e1] > c.degree[res]) {
+			res = e1
+			i = i1
+		}
+	}
+	if res != nil {
+		c.spill = append(c.spill[:i], c.spill[i+1:]...)
+	}
+	return res
+}
+
+// assignColors pops the nodes of the select stack and assigns them a register that is not used by any of their
+// coloured neighbours. Nodes for which no register is left are spilled to a stack slot of their own. Coalesced nodes
+// get the register or stack slot of the node they were merged into.
+func (c *colorer) assignColors() {
+	slots := 0
+	for i1 := len(c.stack) - 1; i1 >= 0; i1-- {
+		n := c.stack[i1]
+		excl := make([]regfile.Register, 0, len(c.adjList[n]))
+		for _, e1 := range c.adjList[n] {
+			if a := c.getAlias(e1); c.state[a] == colored || c.state[a] == precolored {
+				excl = append(excl, a.Reg)
+			}
+		}
+
+		var r regfile.Register
+		if c.isInt(n) {
+			// Strings are addresses stored in register.
+			r = c.rf.GetNextTempIExclude(excl)
+		} else {
+			r = c.rf.GetNextTempFExclude(excl)
+		}
+		if r == nil {
+			c.state[n] = spilled
+			n.Spill = true
+			n.Slot = slots
+			slots++
+		} else {
+			c.state[n] = colored
+			n.Reg = r
+		}
+	}
+	for _, e1 := range c.nodes {
+		if c.state[e1] == coalesced {
+			a := c.getAlias(e1)
+			e1.Reg = a.Reg
+			e1.Spill = a.Spill
+			e1.Slot = a.Slot
+		}
+	}
+}
@@ -1,12 +1,11 @@
-// Package lir provides functions for transforming the lightweight intermediate representation (LIR) into
-// either ARMv8 or RISC-V assembly.
+// Package lir provides functions for allocating the registers of the backend.Target that the lightweight intermediate
+// representation (LIR) is compiled to.
 package lir
 
 import (
-	"errors"
-	"fmt"
+	"os"
 	"sync"
-	"vslc/src/backend/arm"
+	"vslc/src/backend"
 	"vslc/src/backend/regfile"
 	"vslc/src/ir/lir"
 	"vslc/src/ir/lir/types"
@@ -17,6 +16,18 @@ import (
 // ----- Type definitions -----
 // ----------------------------
 
+// Allocator allocates the registers of the Functions of a Module one at a time, like AllocateRegisters allocates
+// those of the Module, such that the registers of a Function can be allocated while other Functions are still being
+// generated. Its methods may be called by concurrent go routines.
+type Allocator struct {
+	opt    util.Options
+	target backend.Target
+	rf     regfile.RegisterFile              // rf is the virtual register file, or nil if the target has no registers.
+	m      *lir.Module                       // m is the Module whose Functions are allocated.
+	rigs   map[*lir.Function][]*lir.LiveNode // rigs maps the allocated Functions to their interference graphs.
+	sync.Mutex
+}
+
 // node represents a register interference graph node element.
 //type node struct {
 //	val        *lir.Value // LIR Value being wrapped.
@@ -29,9 +40,6 @@ import (
 // ----- Constants -----
 // ---------------------
 
-// Number of times register allocation will retry finding a node with fewer than k neighbours before failing.
-const retry = 128
-
 // -------------------
 // ----- Globals -----
 // -------------------
@@ -45,18 +53,22 @@ const retry = 128
 func AllocateRegisters(opt util.Options, m *lir.Module) error {
 	// Procedure from: http://web.cecs.pdx.edu/~mperkows/temp/register-allocation.pdf
 
-	// Create virtual register file.
-	var rf regfile.RegisterFile
-	if opt.TargetArch == util.Aarch64 {
-		rf = arm.CreateRegisterFile()
-	} else if opt.TargetArch == util.Riscv32 || opt.TargetArch == util.Riscv64 {
-		//rf = riscv.CreateRegisterFile(opt)
-		return errors.New("risc-v target not implemented yet") // TODO: Implement.
-	} else {
-		return errors.New("unsupported target architecture")
+	// Create virtual register file. Targets without registers keep their values in the LIR form.
+	target, err := backend.Lookup(opt.TargetArch)
+	if err != nil {
+		return err
 	}
+	rf := target.CreateRegisterFile(opt)
 	if rf == nil {
-		return errors.New("failed to initiate target virtual register file")
+		return nil
+	}
+
+	// Lower phi instructions and values live across function calls to stack slots.
+	lir.DestructSSA(opt, m)
+
+	// Constants that are encoded as immediate operands by all of their users don't need a register.
+	for _, e1 := range m.Functions() {
+		markImmediates(opt, target, e1)
 	}
 
 	// Find temporaries' dependencies using live variable analysis on virtual registers.
@@ -65,180 +77,209 @@ func AllocateRegisters(opt util.Options, m *lir.Module) error {
 	// Allocate hardware registers to the lir.LiveNodes wrapping the lir.Value.
 	if opt.Threads > 1 {
 		// Parallel.
-		t := opt.Threads
-		l := len(rigs)
-		if t > l {
-			t = l
-		}
-		n := l / t
-		res := l % t
-
-		start := 0
-		end := n
-
-		// Create error listener.
-		perr := util.NewPerror(t)
-
-		// Create wait group for main go routine to wait for worker go routines.
-		wg := sync.WaitGroup{}
-		wg.Add(t)
-
-		// Spawn t worker go routines.
-		for i1 := 0; i1 < t; i1++ {
-			if i1 < res {
-				end++
-			}
-
-			// Spawn worker go routine.
-			go func(start, end int, wg *sync.WaitGroup) {
-				defer wg.Done()
-				for i2, e2 := range rigs[start:end] {
-					// Pass register file rf by value, not pointer, such that every go routine gets its very own copy.
-					if err := allocateRegisterFunc(opt, m.Functions()[start:end][i2], rf, e2); err != nil {
-						perr.Append(err)
-					}
-				}
-			}(start, end, &wg)
-
-			start = end
-			end += n
+		pool := opt.NewPool("regalloc")
+		if err := pool.Run(opt.Context(), len(rigs), func(w *util.Worker, i int) error {
+			w.Log.Infof("allocating registers of function %s", m.Functions()[i].Name())
+			// Pass register file rf by value, not pointer, such that every go routine gets its very own copy.
+			return allocateRegisterFunc(opt, m.Functions()[i], rf, rigs[i])
+		}); err != nil {
+			return err
 		}
-
-		// Wait for worker go routines to finish register allocation.
-		wg.Wait()
-
-		// Check for errors from worker go routines.
-		if perr.Len() > 1 {
-			for e1 := range perr.Errors() {
-				fmt.Println(e1)
-			}
-			return fmt.Errorf("%d error(s) during parallel register allocation", perr.Len())
-		}
-		return nil
 	} else {
 		// Sequential.
 		for i1, e1 := range rigs {
 			if err := allocateRegisterFunc(opt, m.Functions()[i1], rf, e1); err != nil {
-				return nil
+				return err
 			}
 		}
 	}
+
+	// Catch allocation errors here, rather than as invalid assembler or failures in the backends.
+	if err := verifyAllocation(m); err != nil {
+		return err
+	}
+
+	// Print register allocation statistics and interference graphs.
+	if opt.DumpRegAlloc {
+		return dumpRegisterAllocation(os.Stdout, m, rigs)
+	}
 	return nil
 }
 
-// allocateRegisterFunc allocates physical registers to an lir.Function's virtual registers. An error is returned
-// if something wen't wrong.
-func allocateRegisterFunc(opt util.Options, f *lir.Function, rf regfile.RegisterFile, rig []*lir.LiveNode) error {
-	// Assign physical registers to virtual registers using the virtual register file.
+// NewAllocator returns an Allocator of the registers of the Functions of Module m, for the target of opt.
+func NewAllocator(opt util.Options, m *lir.Module) (*Allocator, error) {
+	target, err := backend.Lookup(opt.TargetArch)
+	if err != nil {
+		return nil, err
+	}
+	return &Allocator{
+		opt:    opt,
+		target: target,
+		rf:     target.CreateRegisterFile(opt),
+		m:      m,
+		rigs:   make(map[*lir.Function][]*lir.LiveNode),
+	}, nil
+}
 
-	if opt.TargetArch != util.Riscv32 && opt.TargetArch != util.Riscv64 && opt.TargetArch != util.Aarch64 {
-		return fmt.Errorf("register allocation for target architecture %d not supported", opt.TargetArch)
+// Allocate allocates the registers of Function f, whose LIR must be complete, except for the global variables and
+// strings, which are bound by Finish.
+func (a *Allocator) Allocate(f *lir.Function) error {
+	if a.rf == nil {
+		return nil
 	}
+	f.DestructSSA()
+	markImmediates(a.opt, a.target, f)
+	rig := lir.CalcLivenessFunction(f)
+	a.Lock()
+	a.rigs[f] = rig
+	a.Unlock()
+	return allocateRegisterFunc(a.opt, f, a.rf, rig)
+}
 
-	// "Remove" nodes from RIG and put them on stack.
-	stack := util.Stack{}
-	rt := retry // Retry removing nodes this many times before reporting failure.
-	for stack.Size() < len(rig) && rt > 0 {
-		// Keep removing nodes until all nodes are removed.
-		// Bottom-up to preserve result from live variable analysis.
-		for i2 := len(rig) - 1; i2 >= 0; i2-- {
-			e2 := rig[i2]
-			if e2.Enabled {
-				var k int
-				if e2.Val.DataType() == types.Int {
-					// Integer data.
-					k = rf.Ki()
-				} else {
-					// Floating point data.
-					k = rf.Kf()
-				}
-
-				// If the below check fails, we'll hope to catch it in some later retry iteration.
-				// That's why we have the outer loop that checks rt against the constant retry.
-				if len(e2.GetEnabledNeighbours()) < k {
-					e2.Enabled = false // "Remove" val from RIG.
-					stack.Push(e2)     // Push val on stack.
-				}
+// Finish allocates the registers of the Functions of the Module that weren't allocated by Allocate, such as the
+// runtime routines declared while lowering, and binds its global variables and strings. It verifies the allocation of
+// the Module like AllocateRegisters, and must be called once every Function has been generated.
+func (a *Allocator) Finish() error {
+	if a.rf == nil {
+		return nil
+	}
+	lir.CalcLivenessGlobals(a.m)
+	rigs := make([][]*lir.LiveNode, len(a.m.Functions()))
+	for i1, e1 := range a.m.Functions() {
+		if _, ok := a.rigs[e1]; !ok {
+			if err := a.Allocate(e1); err != nil {
+				return err
 			}
 		}
-		rt--
+		rigs[i1] = a.rigs[e1]
 	}
-
-	// Check for RIG node removal failure.
-	if rt < 1 {
-		return fmt.Errorf("could not untangle register interference graph within %d retries", retry)
+	if err := verifyAllocation(a.m); err != nil {
+		return err
 	}
+	if a.opt.DumpRegAlloc {
+		return dumpRegisterAllocation(os.Stdout, a.m, rigs)
+	}
+	return nil
+}
 
-	// Pop nodes from stack and assign registers.
-	for n := stack.Pop(); n != nil; n = stack.Pop() {
-		n.(*lir.LiveNode).Enabled = true
-
-		// Exclusively assign d0 or x0 to return statement and function calls.
-		if n.(*lir.LiveNode).Val.Type() == types.ReturnInstruction || n.(*lir.LiveNode).Val.Type() == types.FunctionCallInstruction{
-			typ := n.(*lir.LiveNode).Val.DataType()
-			if typ == types.Int || typ == types.String {
-				// Strings are addresses stored in register.
-				n.(*lir.LiveNode).Val.GetHW().(*lir.LiveNode).Reg = rf.GetI(0)
-			} else {
-				n.(*lir.LiveNode).Val.GetHW().(*lir.LiveNode).Reg = rf.GetF(0)
+// markImmediates marks the integer Constants of Function f that target encodes as immediate operands of all of their
+// users for the configuration opt, such that they are left out of register allocation.
+func markImmediates(opt util.Options, target backend.Target, f *lir.Function) {
+	for _, e1 := range f.Blocks() {
+		for _, e2 := range e1.Instructions() {
+			if c, ok := e2.(*lir.Constant); ok {
+				c.SetImmediate(target.Immediate(opt, c))
 			}
-			continue
 		}
+	}
+}
 
-		// Check for datatype of Value. No need to assign physical register to branch instructions etc.
-		if n.(*lir.LiveNode).Val.Type() != types.DataInstruction &&
-			n.(*lir.LiveNode).Val.Type() != types.LoadInstruction &&
-			n.(*lir.LiveNode).Val.Type() != types.Constant &&
-			n.(*lir.LiveNode).Val.Type() != types.PreserveInstruction &&
-			n.(*lir.LiveNode).Val.Type() != types.CastInstruction {
-			continue
+// precolor assigns the registers dictated by the calling convention of the register file rf to the nodes of the
+// register interference graph rig of Function f: return statements and function calls are assigned the return value
+// register and parameters are assigned their argument registers. The registers that are clobbered by a function call
+// are modelled as precolored nodes that interfere with every virtual register that is live across the call, and the
+// argument registers are modelled as precolored nodes that interfere with the call's arguments.
+func precolor(rf regfile.RegisterFile, f *lir.Function, rig []*lir.LiveNode) {
+	// Assign registers for function's parameters.
+	ii := 0
+	fi := 0
+	for _, e1 := range f.Params() {
+		var r regfile.Register
+		if intClass(rf, e1.DataType()) {
+			r = rf.ArgI(ii)
+			ii++
+		} else {
+			r = rf.ArgF(fi)
+			fi++
 		}
-
-		var r regfile.Register // Physical register to be allocated to val n's Value.
-
-		// Check neighbours for allocated registers.
-		en := n.(*lir.LiveNode).GetEnabledNeighbours() // Enabled neighbours.
-		excl := make([]regfile.Register, len(en))      // Exclusion slice.
-		for i1, e1 := range en {
-			excl[i1] = e1.Val.GetHW().(*lir.LiveNode).Reg.(regfile.Register)
+		if r != nil {
+			e1.GetHW().Reg = r
 		}
+	}
 
-		typ := n.(*lir.LiveNode).Val.DataType()
-		if typ == types.Int || typ == types.String {
-			// Strings are addresses stored in register.
-			r = rf.GetNextTempIExclude(excl)
+	clobbers := rf.CallerSaved()
+	lv := f.Liveness()
+	for _, e1 := range rig {
+		if e1.Val.Type() != types.ReturnInstruction && e1.Val.Type() != types.FunctionCallInstruction {
+			continue
+		}
+		if intClass(rf, e1.Val.DataType()) {
+			e1.Reg = rf.ArgI(0)
 		} else {
-			r = rf.GetNextTempFExclude(excl)
+			e1.Reg = rf.ArgF(0)
+		}
+		if e1.Val.Type() != types.FunctionCallInstruction {
+			continue
 		}
 
-		// Check for registering spilling.
-		if r == nil {
-			// TODO: Implement register spilling.
-			n.(*lir.LiveNode).Spill = true
-			return errors.New("register spilling not implemented yet")
-		} else {
-			// Allocate physical register to virtual register.
-			n.(*lir.LiveNode).Val.GetHW().(*lir.LiveNode).Reg = r
+		// Virtual registers that are live after the call, except for the call's result, are live across the call.
+		clobberedi := make([]*lir.LiveNode, 0, len(clobbers))
+		clobberedf := make([]*lir.LiveNode, 0, len(clobbers))
+		for _, e2 := range clobbers {
+			if e2.Type() == int(types.Int) {
+				clobberedi = append(clobberedi, &lir.LiveNode{Val: e1.Val, Enabled: true, Reg: e2})
+			} else {
+				clobberedf = append(clobberedf, &lir.LiveNode{Val: e1.Val, Enabled: true, Reg: e2})
+			}
+		}
+		for _, e2 := range lv.LiveOut(e1.Val) {
+			if e2 == e1.Val {
+				continue
+			}
+			ln := e2.GetHW()
+			if intClass(rf, e2.DataType()) {
+				ln.Dep = append(ln.Dep, clobberedi...)
+			} else {
+				ln.Dep = append(ln.Dep, clobberedf...)
+			}
 		}
-	}
 
-	// Assign registers for function's parameters.
-	l := len(f.Params())
-	if l > 0 {
-		if l > 8 {
-			l = 8
+		// Arguments are moved to the argument registers one by one, so no argument may reside in one.
+		argi := make([]*lir.LiveNode, 0)
+		argf := make([]*lir.LiveNode, 0)
+		for i2 := 0; rf.ArgI(i2) != nil; i2++ {
+			argi = append(argi, &lir.LiveNode{Val: e1.Val, Enabled: true, Reg: rf.ArgI(i2)})
+		}
+		for i2 := 0; rf.ArgF(i2) != nil; i2++ {
+			argf = append(argf, &lir.LiveNode{Val: e1.Val, Enabled: true, Reg: rf.ArgF(i2)})
 		}
-		ii := 0
-		fi := 0
-		for _, e1 := range f.Params()[:l] {
-			if e1.DataType() == types.Int {
-				e1.GetHW().(*lir.LiveNode).Reg = rf.GetI(ii)
-				ii++
+		args := make([]lir.Value, 0, len(e1.Val.(*lir.FunctionCallInstruction).Arguments()))
+		for _, e2 := range e1.Val.(*lir.FunctionCallInstruction).Arguments() {
+			if e2.DataType() == types.VaList {
+				args = append(args, e2.(*lir.VaList).Values()...)
 			} else {
-				e1.GetHW().(*lir.LiveNode).Reg = rf.GetF(fi)
-				fi++
+				args = append(args, e2)
+			}
+		}
+		for _, e2 := range args {
+			ln := e2.GetHW()
+			if ln == nil || ln.Reg != nil {
+				continue
+			}
+			if intClass(rf, e2.DataType()) {
+				ln.Dep = append(ln.Dep, argi...)
+			} else {
+				ln.Dep = append(ln.Dep, argf...)
 			}
 		}
 	}
+}
+
+// intClass returns true if values of type typ are assigned integer registers of the register file rf. Strings are
+// addresses stored in register, and floats are stored in integer registers if rf has no floating point registers.
+func intClass(rf regfile.RegisterFile, typ types.DataType) bool {
+	return typ == types.Int || typ == types.String || rf.Kf() == 0
+}
+
+// allocateRegisterFunc allocates physical registers to an lir.Function's virtual registers. An error is returned
+// if something wen't wrong.
+func allocateRegisterFunc(opt util.Options, f *lir.Function, rf regfile.RegisterFile, rig []*lir.LiveNode) error {
+	// Assign physical registers to virtual registers using the virtual register file.
+
+	// Precolor the nodes that are constrained by the calling convention.
+	precolor(rf, f, rig)
+
+	// Colour the remaining nodes, coalescing copies where possible.
+	colorFunction(rf, f, rig)
 	return nil
 }
@@ -0,0 +1,201 @@
+package lir
+
+import (
+	"testing"
+	"vslc/src/backend/arm"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// TestPrecolor verifies that function calls are assigned the return value register and that virtual registers live
+// across a function call are not assigned a register that is clobbered by the call.
+func TestPrecolor(t *testing.T) {
+	opt := util.Options{Threads: 1, TargetArch: util.Aarch64}
+	m := lir.CreateModule("test")
+	g := m.CreateFunction("g", types.Int)
+	g.CreateBlock().CreateReturn(g.Blocks()[0].CreateConstantInt(1))
+	f := m.CreateFunction("f", types.Int)
+	b := f.CreateBlock()
+	c := b.CreateConstantInt(5)
+	res := b.CreateFunctionCall(g, []lir.Value{})
+	b.CreateReturn(b.CreateAdd(res, c))
+
+	rf := arm.CreateRegisterFile()
+	rig := lir.CalcLiveness(opt, m)[1]
+	if err := allocateRegisterFunc(opt, f, rf, rig); err != nil {
+		t.Fatal(err)
+	}
+
+	call := res.Operand1().GetHW().Reg
+	if call != rf.ArgI(0) {
+		t.Errorf("expected call result in %s, got %s", rf.ArgI(0).String(), call.String())
+	}
+	r := c.GetHW().Reg
+	for _, e1 := range rf.CallerSaved() {
+		if e1 == r {
+			t.Errorf("%s is live across the call but was assigned clobbered register %s", c.Name(), r.String())
+		}
+	}
+}
+
+// TestCoalesce verifies that the copy of a returned value to the return value register is coalesced, unless the
+// value is live across a function call that clobbers the return value register.
+func TestCoalesce(t *testing.T) {
+	opt := util.Options{Threads: 1, TargetArch: util.Aarch64}
+	m := lir.CreateModule("test")
+	g := m.CreateFunction("g", types.Int)
+	g.CreateBlock().CreateReturn(g.Blocks()[0].CreateConstantInt(1))
+	f := m.CreateFunction("f", types.Int)
+	b := f.CreateBlock()
+	c := b.CreateConstantInt(5)
+	res := b.CreateFunctionCall(g, []lir.Value{})
+	b.CreateReturn(b.CreateAdd(res, c))
+
+	rf := arm.CreateRegisterFile()
+	rigs := lir.CalcLiveness(opt, m)
+	for i1, e1 := range m.Functions() {
+		if err := allocateRegisterFunc(opt, e1, rf, rigs[i1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if r := g.Blocks()[0].Instructions()[0].GetHW().Reg; r != rf.ArgI(0) {
+		t.Errorf("expected returned constant in %s, got %s", rf.ArgI(0).String(), r.String())
+	}
+	if r := c.GetHW().Reg; r == rf.ArgI(0) {
+		t.Errorf("%s is live across the call but was coalesced into %s", c.Name(), r.String())
+	}
+}
+
+// TestVerifyAllocation verifies that values without a register and values sharing a register while live at the same
+// time are reported.
+func TestVerifyAllocation(t *testing.T) {
+	opt := util.Options{Threads: 1, TargetArch: util.Aarch64}
+	m := lir.CreateModule("test")
+	f := m.CreateFunction("f", types.Int)
+	b := f.CreateBlock()
+	c1 := b.CreateConstantInt(1)
+	c2 := b.CreateConstantInt(2)
+	b.CreateReturn(b.CreateMul(c1, c2))
+	if err := AllocateRegisters(opt, m); err != nil {
+		t.Fatal(err)
+	}
+
+	n1 := c1.GetHW()
+	n2 := c2.GetHW()
+	r := n2.Reg
+	n2.Reg = n1.Reg
+	if err := verifyAllocation(m); err == nil {
+		t.Errorf("expected error for %s and %s sharing a register", c1.Name(), c2.Name())
+	}
+	n2.Reg = nil
+	if err := verifyAllocation(m); err == nil {
+		t.Errorf("expected error for %s without a register", c2.Name())
+	}
+	n2.Reg = r
+	if err := verifyAllocation(m); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestSpill verifies that values that cannot be assigned a register are spilled to distinct stack slots.
+func TestSpill(t *testing.T) {
+	opt := util.Options{Threads: 1, TargetArch: util.Aarch64}
+	m := lir.CreateModule("test")
+	f := m.CreateFunction("f", types.Int)
+	b := f.CreateBlock()
+
+	// Keep more values live than there are registers.
+	rf := arm.CreateRegisterFile()
+	vals := make([]lir.Value, rf.Ki()+4)
+	for i1 := range vals {
+		vals[i1] = b.CreateConstantInt(i1)
+	}
+	sum := vals[len(vals)-1]
+	for i1 := len(vals) - 2; i1 >= 0; i1-- {
+		sum = b.CreateMul(vals[i1], sum)
+	}
+	b.CreateReturn(sum)
+	if err := AllocateRegisters(opt, m); err != nil {
+		t.Fatal(err)
+	}
+
+	slots := map[int]bool{}
+	for _, e1 := range vals {
+		n := e1.GetHW()
+		if !n.Spill {
+			continue
+		}
+		if slots[n.Slot] {
+			t.Errorf("spill slot %d assigned twice", n.Slot)
+		}
+		slots[n.Slot] = true
+	}
+	if len(slots) == 0 {
+		t.Error("expected spilled values")
+	}
+}
+
+// TestImmediate verifies that constants that are encoded as immediate operands by all of their users are not
+// assigned a register, and that the remaining constants are.
+func TestImmediate(t *testing.T) {
+	opt := util.Options{Threads: 1, TargetArch: util.Aarch64}
+	m := lir.CreateModule("test")
+	f := m.CreateFunction("f", types.Int)
+	b := f.CreateBlock()
+	c1 := b.CreateConstantInt(5)
+	c2 := b.CreateConstantInt(7)
+	c3 := b.CreateConstantInt(0x5555)
+	c4 := b.CreateConstantInt(3)
+	sum := b.CreateAdd(c1, c2)
+	sum = b.CreateAdd(sum, c2)
+	sum = b.CreateAnd(sum, c3)
+	sum = b.CreateMul(sum, c4)
+	b.CreateReturn(b.CreateAdd(sum, c1))
+	if err := AllocateRegisters(opt, m); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		c   *lir.Constant
+		imm bool
+	}{
+		{c1, false}, // First operand of an addition with a constant.
+		{c2, true},
+		{c3, false}, // Not a bitmask immediate.
+		{c4, false}, // Used by multiplication.
+	}
+	for _, e1 := range tests {
+		if e1.c.Immediate() != e1.imm {
+			t.Errorf("expected %s immediate to be %t", e1.c.Name(), e1.imm)
+		}
+		if r := e1.c.GetHW().Reg; (r == nil) != e1.imm {
+			t.Errorf("expected %s register to be assigned: %t", e1.c.Name(), !e1.imm)
+		}
+	}
+}
+
+// TestImmediateShift verifies that shift amounts are only encoded as immediate operands if they're less than the width
+// of integers of the compilation.
+func TestImmediateShift(t *testing.T) {
+	for _, e1 := range []int{32, 64} {
+		opt := util.Options{Threads: 1, TargetArch: util.Aarch64, IntWidth: e1}
+		m := lir.CreateModule("test")
+		f := m.CreateFunction("f", types.Int)
+		b := f.CreateBlock()
+		x := f.CreateParam("x", types.Int)
+		c1 := b.CreateConstantInt(31)
+		c2 := b.CreateConstantInt(40)
+		b.CreateReturn(b.CreateLShift(b.CreateLShift(b.CreateLoad(x), c1), c2))
+		if err := AllocateRegisters(opt, m); err != nil {
+			t.Fatal(err)
+		}
+		if !c1.Immediate() {
+			t.Errorf("%d-bit: expected shift by 31 to be immediate", e1)
+		}
+		if c2.Immediate() != (e1 == 64) {
+			t.Errorf("%d-bit: expected shift by 40 immediate to be %t", e1, e1 == 64)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package lir
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Stats defines the register allocation statistics of a single lir.Function.
+type Stats struct {
+	Function  string // Function is the name of the lir.Function.
+	Virtual   int    // Virtual is the number of virtual registers, which are the nodes of the interference graph.
+	Edges     int    // Edges is the number of interference edges between virtual registers.
+	Colors    int    // Colors is the number of distinct physical registers assigned to virtual registers.
+	Spills    int    // Spills is the number of virtual registers that were spilled to memory.
+	Coalesced int    // Coalesced is the number of register copies whose source and destination share a register.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// CalcStats calculates the register allocation statistics of Function f from its register interference graph rig.
+// The registers must have been allocated.
+func CalcStats(f *lir.Function, rig []*lir.LiveNode) Stats {
+	s := Stats{Function: f.Name()}
+	colors := make(map[[2]int]bool)
+	for _, e1 := range rig {
+		if !isVirtual(e1) {
+			continue
+		}
+		s.Virtual++
+		for _, e2 := range e1.Dep {
+			if isVirtual(e2) && e1.Val.Id() < e2.Val.Id() {
+				s.Edges++
+			}
+		}
+		if e1.Spill {
+			s.Spills++
+		}
+		if r := e1.Reg; r != nil {
+			colors[[2]int{r.Type(), r.Id()}] = true
+		}
+	}
+	s.Colors = len(colors)
+
+	// Copies into and out of the return value register are coalesced if both Values share the register.
+	for _, e1 := range rig {
+		if e1.Val.Type() != types.PreserveInstruction && e1.Val.Type() != types.ReturnInstruction {
+			continue
+		}
+		src := e1.Val.Operand1().GetHW()
+		if src == nil {
+			continue
+		}
+		if dst := e1.Reg; dst != nil {
+			if r := src.Reg; r != nil && r.Type() == dst.Type() && r.Id() == dst.Id() {
+				s.Coalesced++
+			}
+		}
+	}
+	return s
+}
+
+// String returns a print friendly representation of the register allocation statistics s.
+func (s Stats) String() string {
+	return fmt.Sprintf("%s: %d virtual registers, %d interference edges, %d colors, %d spills, %d moves coalesced",
+		s.Function, s.Virtual, s.Edges, s.Colors, s.Spills, s.Coalesced)
+}
+
+// WriteDot writes the register interference graph rig of Function f to w in the Graphviz dot format. Every node is
+// labeled with its virtual register and the assigned physical register.
+func WriteDot(w io.Writer, f *lir.Function, rig []*lir.LiveNode) error {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("graph %q {\n", f.Name()))
+	for _, e1 := range rig {
+		if !isVirtual(e1) {
+			continue
+		}
+		reg := "-"
+		if r := e1.Reg; r != nil {
+			reg = r.String()
+		}
+		sb.WriteString(fmt.Sprintf("\tn%d [label=\"%s\\n%s\"];\n", e1.Val.Id(), e1.Val.Name(), reg))
+	}
+	for _, e1 := range rig {
+		if !isVirtual(e1) {
+			continue
+		}
+		for _, e2 := range e1.Dep {
+			if isVirtual(e2) && e1.Val.Id() < e2.Val.Id() {
+				sb.WriteString(fmt.Sprintf("\tn%d -- n%d;\n", e1.Val.Id(), e2.Val.Id()))
+			}
+		}
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// dumpRegisterAllocation writes the register allocation statistics and interference graph of every Function of
+// Module m to w. The slice rigs holds the register interference graph of every Function.
+func dumpRegisterAllocation(w io.Writer, m *lir.Module, rigs [][]*lir.LiveNode) error {
+	for i1, e1 := range m.Functions() {
+		if len(e1.Blocks()) < 1 {
+			continue
+		}
+		if _, err := fmt.Fprintln(w, CalcStats(e1, rigs[i1]).String()); err != nil {
+			return err
+		}
+		if err := WriteDot(w, e1, rigs[i1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isVirtual returns true if the LiveNode n wraps a virtual register. Precolored nodes that model the registers
+// clobbered by function calls are not virtual registers.
+func isVirtual(n *lir.LiveNode) bool {
+	if n.Val.GetHW() != n {
+		return false
+	}
+	switch n.Val.Type() {
+	case types.DataInstruction, types.LoadInstruction, types.FunctionCallInstruction, types.CastInstruction,
+		types.PreserveInstruction, types.PhiInstruction, types.SelectInstruction:
+		return true
+	case types.Constant:
+		return !n.Val.(*lir.Constant).Immediate()
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package lir
+
+import (
+	"fmt"
+	"vslc/src/backend/regfile"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// verifyAllocation checks the register allocation of every Function of Module m. Every value consumed or produced
+// by the backends must have a register or spill slot assigned, and no two values that are live at the same time may
+// share a register or spill slot. An error is returned describing the first violation found.
+func verifyAllocation(m *lir.Module) error {
+	for _, e1 := range m.Functions() {
+		if err := verifyFunction(e1); err != nil {
+			return fmt.Errorf("register allocation of function %s: %s", e1.Name(), err)
+		}
+	}
+	return nil
+}
+
+// verifyFunction checks the register allocation of Function f.
+func verifyFunction(f *lir.Function) error {
+	lv := f.Liveness()
+	for _, e1 := range f.Blocks() {
+		for _, e2 := range e1.Instructions() {
+			// Every value consumed by the instruction must reside in a register.
+			for _, e3 := range operands(e2) {
+				if needsRegister(e3) && register(e3) == nil && slot(e3) < 0 {
+					return where(e2, fmt.Errorf("operand %s of %s has no register assigned", e3.Name(), e2.Name()))
+				}
+			}
+			if needsRegister(e2) && register(e2) == nil && slot(e2) < 0 {
+				return where(e2, fmt.Errorf("%s has no register assigned", e2.Name()))
+			}
+
+			// Values live before the instruction must reside in distinct registers.
+			if err := distinct(lv.LiveIn(e2)); err != nil {
+				return where(e2, err)
+			}
+
+			// The instruction's result must not overwrite a value that is live after it.
+			if r := register(e2); r != nil {
+				for _, e3 := range lv.LiveOut(e2) {
+					if e3 != e2 && sameRegister(r, register(e3)) {
+						return where(e2, fmt.Errorf("%s overwrites %s, which is live in %s", e2.Name(), e3.Name(),
+							r.String()))
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// operands returns the values consumed by Value v, including the arguments of function calls and the values of
+// variadic argument lists.
+func operands(v lir.Value) []lir.Value {
+	res := make([]lir.Value, 0, 2)
+	if v.Type() == types.FunctionCallInstruction {
+		for _, e1 := range v.(*lir.FunctionCallInstruction).Arguments() {
+			if e1.DataType() == types.VaList {
+				res = append(res, e1.(*lir.VaList).Values()...)
+			} else {
+				res = append(res, e1)
+			}
+		}
+		return res
+	}
+	if v.DataType() == types.VaList {
+		return append(res, v.(*lir.VaList).Values()...)
+	}
+	if s, ok := v.(*lir.SelectInstruction); ok {
+		return append(res, s.Operand1(), s.Operand2(), s.True(), s.False())
+	}
+	if op := v.Operand1(); op != nil {
+		res = append(res, op)
+	}
+	if op := v.Operand2(); op != nil {
+		res = append(res, op)
+	}
+	return res
+}
+
+// needsRegister returns true if Value v is kept in a register by the backends.
+func needsRegister(v lir.Value) bool {
+	switch v.Type() {
+	case types.DataInstruction:
+		// Variadic argument lists are passed by moving their values to argument registers.
+		return v.DataType() != types.VaList
+	case types.LoadInstruction, types.PreserveInstruction, types.CastInstruction, types.FunctionCallInstruction,
+		types.SelectInstruction:
+		return true
+	case types.Constant:
+		// Immediate operands are encoded in the instructions that use them.
+		return !v.(*lir.Constant).Immediate()
+	}
+	return false
+}
+
+// register returns the register assigned to Value v, or nil if Value v has no register assigned.
+func register(v lir.Value) regfile.Register {
+	if n := v.GetHW(); n != nil {
+		return n.Reg
+	}
+	return nil
+}
+
+// slot returns the spill slot assigned to Value v, or -1 if Value v is not spilled.
+func slot(v lir.Value) int {
+	n := v.GetHW()
+	if n == nil || !n.Spill {
+		return -1
+	}
+	return n.Slot
+}
+
+// sameRegister returns true if r1 and r2 are the same physical register.
+func sameRegister(r1, r2 regfile.Register) bool {
+	return r1 != nil && r2 != nil && r1.Id() == r2.Id() && r1.Type() == r2.Type()
+}
+
+// distinct returns an error if any two of the values vals share a register or spill slot.
+func distinct(vals []lir.Value) error {
+	for i1, e1 := range vals {
+		r := register(e1)
+		s := slot(e1)
+		for _, e2 := range vals[i1+1:] {
+			if sameRegister(r, register(e2)) {
+				return fmt.Errorf("%s and %s are live at the same time, but share %s", e1.Name(), e2.Name(),
+					r.String())
+			}
+			if s >= 0 && s == slot(e2) {
+				return fmt.Errorf("%s and %s are live at the same time, but share spill slot %d", e1.Name(),
+					e2.Name(), s)
+			}
+		}
+	}
+	return nil
+}
+
+// where returns err as a util.CodegenError at the source location of Value v, if known.
+func where(v lir.Value, err error) error {
+	loc := v.Location()
+	return &util.CodegenError{Position: util.Position{Line: loc.Line, Pos: loc.Pos}, Err: err}
+}
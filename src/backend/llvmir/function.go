@@ -0,0 +1,396 @@
+package llvmir
+
+import (
+	"fmt"
+	"strings"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// function holds the state of the generation of a single function body.
+type function struct {
+	f   *lir.Function
+	t   target
+	wr  *util.Writer
+	tmp int // tmp is the number of temporary values created for conversions.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// genFunction generates the LLVM IR definition of the LIR Function fun. Functions without body are declared by the
+// module header, and generate nothing.
+//
+// Parameters and local variables are allocated on the stack by an unnamed entry block, which branches to the first
+// Block of fun, such that opt's mem2reg pass can promote them. Every other LIR value is an LLVM value named by its id.
+func genFunction(fun *lir.Function, t target, wr *util.Writer) error {
+	if len(fun.Blocks()) < 1 {
+		return nil
+	}
+	fn := &function{f: fun, t: t, wr: wr}
+
+	// Write signature and entry block.
+	sb := strings.Builder{}
+	for i1, e1 := range fun.Params() {
+		if i1 > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%s %%%s", t.typ(e1.DataType()), e1.Name()))
+	}
+	wr.Write("\ndefine %s @%s(%s) {\n", t.typ(fun.DataType()), fun.Name(), sb.String())
+	for _, e1 := range fun.Params() {
+		wr.Write("\t%s = alloca %s\n", address(e1), t.typ(e1.DataType()))
+		wr.Write("\tstore %s %%%s, ptr %s\n", t.typ(e1.DataType()), e1.Name(), address(e1))
+	}
+	for _, e1 := range fun.Locals() {
+		wr.Write("\t%s = alloca %s\n", address(e1), t.typ(e1.DataType()))
+	}
+	wr.Write("\tbr label %%%s\n", fun.Blocks()[0].Name())
+
+	// Generate function body.
+	for _, e1 := range fun.Blocks() {
+		wr.Write("\n%s:\n", e1.Name())
+		terminated := false
+		for _, e2 := range e1.Instructions() {
+			if err := fn.genInstruction(e2); err != nil {
+				return locate(e2, err)
+			}
+			if e2.Type() == types.BranchInstruction || e2.Type() == types.ReturnInstruction {
+				terminated = true
+				break
+			}
+		}
+		if !terminated {
+			wr.Write("\tunreachable\n")
+		}
+	}
+	wr.Write("}\n")
+	return nil
+}
+
+// genInstruction generates LLVM IR of the LIR instruction v. An error is returned if something went wrong.
+func (fn *function) genInstruction(v lir.Value) error {
+	t := fn.t
+	switch v := v.(type) {
+	case *lir.Constant, *lir.DeclareInstruction, *lir.VaList:
+		// Constants are used as immediate operands, local variables are allocated by the entry block and variable
+		// argument lists are passed by their function call.
+	case *lir.LoadInstruction:
+		if v.DataType() == types.String {
+			// Strings are used by their address.
+			return nil
+		}
+		fn.line("%s = load %s, ptr %s", local(v), t.typ(v.DataType()), address(v.Operand1()))
+	case *lir.StoreInstruction:
+		typ := v.Operand2().DataType()
+		fn.line("store %s %s, ptr %s", t.typ(typ), fn.convert(v.Operand1(), typ), address(v.Operand2()))
+	case *lir.DataInstruction:
+		return fn.genExpression(v)
+	case *lir.CastInstruction:
+		if v.DataType() == types.Float {
+			fn.line("%s = sitofp %s %s to %s", local(v), t.i, fn.operand(v.Operand1()), t.f)
+		} else {
+			fn.line("%s = call %s @%s(%s %s)", local(v), t.i, saturate(t.i, t.f), t.f, fn.operand(v.Operand1()))
+		}
+	case *lir.PreserveInstruction:
+		typ := t.typ(v.DataType())
+		fn.line("%s = bitcast %s %s to %s", local(v), typ, fn.operand(v.Operand1()), typ)
+	case *lir.PhiInstruction:
+		vals, blocks := v.Incoming()
+		in := make([]string, len(vals))
+		for i1, e1 := range vals {
+			in[i1] = fmt.Sprintf("[ %s, %%%s ]", fn.operand(e1), blocks[i1].Name())
+		}
+		fn.line("%s = phi %s %s", local(v), t.typ(v.DataType()), strings.Join(in, ", "))
+	case *lir.SelectInstruction:
+		cond := fn.compare(v.Operator(), v.Operand1(), v.Operand2())
+		typ := t.typ(v.DataType())
+		fn.line("%s = select i1 %s, %s %s, %s %s", local(v), cond, typ, fn.operand(v.True()), typ,
+			fn.operand(v.False()))
+	case *lir.FunctionCallInstruction:
+		return fn.genFunctionCall(v)
+	case *lir.BranchInstruction:
+		if v.Else() == nil {
+			fn.line("br label %%%s", v.Then().Name())
+		} else {
+			cond := fn.compare(v.Operator(), v.Operand1(), v.Operand2())
+			fn.line("br i1 %s, label %%%s, label %%%s", cond, v.Then().Name(), v.Else().Name())
+		}
+	case *lir.ReturnInstruction:
+		typ := fn.f.DataType()
+		fn.line("ret %s %s", t.typ(typ), fn.convert(v.Operand1(), typ))
+	default:
+		return fmt.Errorf("unexpected instruction %s", v.String())
+	}
+	return nil
+}
+
+// genExpression generates LLVM IR of the arithmetic LIR instruction v. Integer additions, subtractions and
+// multiplications pass the overflow bit of the intrinsics that compute them to the overflow handler if -ftrapv is set.
+func (fn *function) genExpression(v *lir.DataInstruction) error {
+	typ := fn.t.typ(v.DataType())
+	if fn.t.trapv && v.Traps() {
+		op := "sadd"
+		switch v.Operator() {
+		case types.Sub:
+			op = "ssub"
+		case types.Mul:
+			op = "smul"
+		}
+		res := fn.temporary()
+		fn.line("%s = call {%s, i1} @%s(%s %s, %s %s)", res, typ, overflow(op, typ), typ, fn.operand(v.Operand1()),
+			typ, fn.operand(v.Operand2()))
+		fn.line("%s = extractvalue {%s, i1} %s, 0", local(v), typ, res)
+		bit := fn.temporary()
+		fn.line("%s = extractvalue {%s, i1} %s, 1", bit, typ, res)
+		fn.line("call void @%s(i1 %s)", lir.LabelOverflow, bit)
+		return nil
+	}
+	isFloat := v.DataType() == types.Float
+	if v.Operand2() == nil {
+		// Unary expression.
+		switch v.Operator() {
+		case types.Sub, types.Neg:
+			if isFloat {
+				fn.line("%s = fneg %s %s", local(v), typ, fn.operand(v.Operand1()))
+			} else {
+				fn.line("%s = sub %s 0, %s", local(v), typ, fn.operand(v.Operand1()))
+			}
+		case types.Not:
+			fn.line("%s = xor %s %s, -1", local(v), typ, fn.operand(v.Operand1()))
+		default:
+			return fmt.Errorf("unexpected unary operator %q", v.Operator().String())
+		}
+		return nil
+	}
+
+	// Binary expression. Choose instruction from operator.
+	var op string
+	if !isFloat {
+		switch v.Operator() {
+		case types.Add:
+			op = "add"
+		case types.Sub:
+			op = "sub"
+		case types.Mul:
+			op = "mul"
+		case types.Div:
+			op = "sdiv"
+		case types.Rem:
+			op = "srem"
+		case types.And:
+			op = "and"
+		case types.Xor:
+			op = "xor"
+		case types.Or:
+			op = "or"
+		case types.RShift:
+			op = "lshr"
+		case types.ARShift:
+			op = "ashr"
+		case types.LShift:
+			op = "shl"
+		}
+	} else {
+		switch v.Operator() {
+		case types.Add:
+			op = "fadd"
+		case types.Sub:
+			op = "fsub"
+		case types.Mul:
+			op = "fmul"
+		case types.Div:
+			op = "fdiv"
+		}
+	}
+	if op == "" {
+		return fmt.Errorf("unexpected binary operator %q", v.Operator().String())
+	}
+	fn.line("%s = %s %s %s, %s", local(v), op, typ, fn.operand(v.Operand1()), fn.operand(v.Operand2()))
+	return nil
+}
+
+// genFunctionCall generates LLVM IR of the function call v. Variadic float arguments of printf and dprintf are promoted
+// to double, and their result is sign extended to the integer type if used. Arguments of VSL functions and the
+// runtime routines of the builtin functions are converted to the type of their parameter.
+func (fn *function) genFunctionCall(v *lir.FunctionCallInstruction) error {
+	t := fn.t
+	args := v.Arguments()
+	if name := v.Target().Name(); name == labelPrintf || name == labelDprintf {
+		// Call to printf or dprintf, where dprintf is preceded by a file descriptor.
+		var ops []string
+		sig, name := "ptr, ...", labelPrintf
+		if v.Target().Name() == labelDprintf {
+			if len(args) != 3 {
+				return fmt.Errorf("expected 3 arguments to %s, got %d", v.Target().Name(), len(args))
+			}
+			fd := fn.operand(args[0])
+			if t.i != "i32" {
+				fd = fn.temporary()
+				fn.line("%s = trunc %s %s to i32", fd, t.i, fn.operand(args[0]))
+			}
+			ops = append(ops, "i32 "+fd)
+			sig, name = "i32, ptr, ...", labelDprintf
+			args = args[1:]
+		}
+		if len(args) != 2 {
+			return fmt.Errorf("expected 2 arguments to %s, got %d", v.Target().Name(), len(args))
+		}
+		l, ok := args[1].(*lir.VaList)
+		if !ok {
+			return fmt.Errorf("expected variable argument list to %s, got %s", v.Target().Name(), args[1].Name())
+		}
+		ops = append(ops, "ptr "+fn.operand(args[0]))
+		for _, e1 := range l.Values() {
+			switch {
+			case e1.DataType() == types.Float && t.f != "double":
+				tmp := fn.temporary()
+				fn.line("%s = fpext %s %s to double", tmp, t.f, fn.operand(e1))
+				ops = append(ops, "double "+tmp)
+			default:
+				ops = append(ops, fmt.Sprintf("%s %s", t.typ(e1.DataType()), fn.operand(e1)))
+			}
+		}
+		call := fmt.Sprintf("call i32 (%s) @%s(%s)", sig, name, strings.Join(ops, ", "))
+		if len(v.Users()) == 0 {
+			fn.line("%s", call)
+		} else if t.i == "i32" {
+			fn.line("%s = %s", local(v), call)
+		} else {
+			tmp := fn.temporary()
+			fn.line("%s = %s", tmp, call)
+			fn.line("%s = sext i32 %s to %s", local(v), tmp, t.i)
+		}
+		return nil
+	}
+
+	params := v.Target().Params()
+	if len(args) != len(params) {
+		return fmt.Errorf("expected %d arguments to %s, got %d", len(params), v.Target().Name(), len(args))
+	}
+	ops := make([]string, len(args))
+	for i1, e1 := range args {
+		typ := params[i1].DataType()
+		ops[i1] = fmt.Sprintf("%s %s", t.typ(typ), fn.convert(e1, typ))
+	}
+	fn.line("%s = call %s @%s(%s)", local(v), t.typ(v.Target().DataType()), v.Target().Name(),
+		strings.Join(ops, ", "))
+	return nil
+}
+
+// compare generates a comparison of the LIR values op1 and op2 using the relational operator op, and returns the name
+// of its i1 result. Floats compare ordered, except for inequality, such that comparisons with NaN are false and
+// their inverses true.
+func (fn *function) compare(op types.RelationalOperation, op1, op2 lir.Value) string {
+	var pred [2]string // Predicates of integer and float comparisons.
+	switch op {
+	case types.Eq:
+		pred = [2]string{"eq", "oeq"}
+	case types.Neq:
+		pred = [2]string{"ne", "une"}
+	case types.LessThan:
+		pred = [2]string{"slt", "olt"}
+	case types.LessThanOrEqual:
+		pred = [2]string{"sle", "ole"}
+	case types.GreaterThan:
+		pred = [2]string{"sgt", "ogt"}
+	case types.GreaterThanOrEqual:
+		pred = [2]string{"sge", "oge"}
+	}
+	tmp := fn.temporary()
+	if op1.DataType() == types.Float {
+		fn.line("%s = fcmp %s %s %s, %s", tmp, pred[1], fn.t.f, fn.operand(op1), fn.operand(op2))
+	} else {
+		fn.line("%s = icmp %s %s %s, %s", tmp, pred[0], fn.t.i, fn.operand(op1), fn.operand(op2))
+	}
+	return tmp
+}
+
+// convert returns the operand of LIR value v converted to data type typ, generating the conversion if their types
+// differ. Floats are converted to integers rounding towards zero and saturating on overflow.
+func (fn *function) convert(v lir.Value, typ types.DataType) string {
+	t := fn.t
+	switch {
+	case v.DataType() == typ:
+		return fn.operand(v)
+	case typ == types.Float:
+		tmp := fn.temporary()
+		fn.line("%s = sitofp %s %s to %s", tmp, t.i, fn.operand(v), t.f)
+		return tmp
+	default:
+		tmp := fn.temporary()
+		fn.line("%s = call %s @%s(%s %s)", tmp, t.i, saturate(t.i, t.f), t.f, fn.operand(v))
+		return tmp
+	}
+}
+
+// operand returns the LLVM operand of the LIR value v. Constants are immediate operands and strings are used by their
+// address.
+func (fn *function) operand(v lir.Value) string {
+	switch v := v.(type) {
+	case *lir.Constant:
+		if f, ok := v.Value().(float64); ok {
+			return float(f, fn.t.f)
+		}
+		return fmt.Sprintf("%d", v.Value())
+	case *lir.LoadInstruction:
+		if s, ok := v.Operand1().(*lir.String); ok {
+			return "@" + s.Name()
+		}
+	}
+	return local(v)
+}
+
+// temporary returns the name of a new temporary value, which cannot clash with the names of LIR values.
+func (fn *function) temporary() string {
+	fn.tmp++
+	return fmt.Sprintf("%%.t%d", fn.tmp)
+}
+
+// line writes an instruction of the function body.
+func (fn *function) line(format string, args ...interface{}) {
+	fn.wr.Write("\t%s\n", fmt.Sprintf(format, args...))
+}
+
+// local returns the name of the LLVM value of the LIR value v. Names start with a period, which VSL identifiers
+// cannot, such that they never clash with parameters.
+func local(v lir.Value) string {
+	return fmt.Sprintf("%%.%d", v.Id())
+}
+
+// address returns the address of the memory allocated LIR variable v, which is a global variable, a parameter or a
+// local variable. Local variables are suffixed by their sequence number, because nested scopes may declare the same
+// name.
+func address(v lir.Value) string {
+	switch v := v.(type) {
+	case *lir.Global:
+		return "@" + v.Name()
+	case *lir.Param:
+		return fmt.Sprintf("%%%s.addr", v.Name())
+	case *lir.DeclareInstruction:
+		return fmt.Sprintf("%%%s.%d", v.Name(), v.Seq())
+	default:
+		return local(v)
+	}
+}
+
+// locate returns err as a util.CodegenError at the source location of the LIR instruction v, which prefixes the message of
+// err if the location is known.
+func locate(v lir.Value, err error) error {
+	loc := v.Location()
+	return &util.CodegenError{Position: util.Position{Line: loc.Line, Pos: loc.Pos}, Err: err}
+}
@@ -0,0 +1,78 @@
+package llvmir
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"vslc/src/frontend"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// srcPath defines the relative path to the typed VSL source files.
+const srcPath = "../../../resources/vsl_typed/"
+
+// TestGenFunction verifies that every LLVM value of the generated functions is defined once, and that every basic
+// block ends with a terminator.
+func TestGenFunction(t *testing.T) {
+	for _, src := range []string{"euclid.vsl", "while_test.vsl", "float.vsl", "nesting.vsl"} {
+		for _, ssa := range []bool{false, true} {
+			opt := util.Options{Src: filepath.Join(srcPath, src), Threads: 1, TargetArch: util.Aarch64}
+			s, err := util.ReadSource(opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			root, err := frontend.Parse(s)
+			if err != nil {
+				t.Fatalf("%s: %s", src, err)
+			}
+			if err := ir.Optimise(opt, root); err != nil {
+				t.Fatalf("%s: %s", src, err)
+			}
+			m, err := lir.GenLIR(opt, root)
+			if err != nil {
+				t.Fatalf("%s: %s", src, err)
+			}
+			lir.SimplifyCFG(opt, m)
+			if ssa {
+				lir.Mem2Reg(opt, m)
+			}
+
+			for _, e1 := range m.Functions() {
+				var res string
+				wr := util.Writer{}
+				if err := genFunction(e1, target{i: "i64", f: "double"}, &wr); err != nil {
+					t.Fatalf("%s: %s", src, err)
+				}
+				wr.Transform(func(s string) string {
+					res = s
+					return ""
+				})
+
+				defs := make(map[string]bool)
+				last := ""
+				for _, e2 := range strings.Split(res, "\n") {
+					f := strings.Fields(e2)
+					if len(f) < 1 {
+						continue
+					}
+					if strings.HasSuffix(f[0], ":") || f[0] == "}" {
+						if last != "" && last != "br" && last != "ret" && last != "unreachable" {
+							t.Errorf("%s: %s: block ends with %s", src, e1.Name(), last)
+						}
+						last = ""
+						continue
+					}
+					last = f[0]
+					if len(f) > 1 && f[1] == "=" {
+						if defs[f[0]] {
+							t.Errorf("%s: %s: %s defined twice", src, e1.Name(), f[0])
+						}
+						defs[f[0]] = true
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,487 @@
+// Package llvmir provides means to generate textual LLVM IR (.ll) from the lightweight intermediate representation,
+// without linking the LLVM libraries. The output can be compiled, optimised or run by clang, opt, llc and lli.
+//
+// Pointers are opaque, as required by LLVM 17 and later. Older versions of LLVM read the output if opaque pointers are
+// enabled, such as by passing -opaque-pointers to opt, llc and lli of LLVM 14.
+package llvmir
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+)
+
+import (
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// target defines the LLVM types of VSL's data types on the target architecture, and how checked integer operations
+// are generated.
+type target struct {
+	i     string // i is the LLVM type of integers.
+	f     string // f is the LLVM type of floats.
+	trapv bool   // trapv is set if integer additions, subtractions and multiplications check for overflow.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+const labelMain = "main"       // String literal of the name of the generated implicit main function.
+const labelPrintf = "printf"   // String literal of the name of the C library function printf.
+const labelDprintf = "dprintf" // String literal of the name of the C library function dprintf.
+const labelStrtol = "strtoll"  // String literal of the name of the C library function strtoll.
+const labelStrtod = "strtod"   // String literal of the name of the C library function strtod.
+const labelAbort = "abort"     // String literal of the name of the C library function abort.
+
+const labelArgc = ".argc" // Name of the error message of the implicit main function on wrong argument count.
+const labelArgv = ".argv" // Name of the error message of the implicit main function on unparsable arguments.
+
+const labelArgCount = "vsl.argc"  // Name of the global that holds argc for the builtin functions argc and arg.
+const labelArgVector = "vsl.argv" // Name of the global that holds argv for the builtin functions argc and arg.
+const labelArgEmpty = "vsl.empty" // Name of the empty string returned for program arguments out of range.
+
+// labelOverflowMsg is the name of the message written by the integer overflow handler lir.LabelOverflow.
+const labelOverflowMsg = "vsl.overflow.msg"
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// reservedFunctionNames defines the function names that are declared or defined by the generated module, and cannot
+// be assigned to VSL functions.
+var reservedFunctionNames = []string{
+	labelMain,
+	labelPrintf,
+	labelDprintf,
+	labelStrtol,
+	labelStrtod,
+	labelAbort,
+}
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// GenLLVMIR generates textual LLVM IR from the LIR Module m, including an implicit main function that parses the
+// program arguments and calls the first function of the syntax tree root, like the native backends.
+func GenLLVMIR(opt util.Options, m *lir.Module, root *ir.Node) error {
+	if opt.TargetArch == util.Wasm {
+		return errors.New("LLVM IR is not supported for WebAssembly, which has its own backend")
+	}
+
+	// Find first defined function, which is called by main.
+	var entry *lir.Function
+	for _, e1 := range root.Children {
+		if e1.Typ == ir.FUNCTION {
+			if entry = m.GetFunction(e1.Children[0].Data.Str); entry == nil {
+				return errors.New("no functions defined for module")
+			}
+			break
+		}
+	}
+	if entry == nil {
+		return errors.New("no functions defined for module")
+	}
+	for _, e1 := range m.Functions() {
+		if len(e1.Blocks()) < 1 {
+			continue
+		}
+		for _, e2 := range reservedFunctionNames {
+			if e1.Name() == e2 {
+				return fmt.Errorf("function name %s is reserved", e1.Name())
+			}
+		}
+	}
+
+	t := target{i: fmt.Sprintf("i%d", m.IntBits()), f: "double", trapv: opt.Trapv}
+	if opt.TargetArch == util.Riscv32 || opt.TargetArch == util.Armv7 {
+		t.f = "float"
+	}
+
+	// Generate module header, strings, globals and declarations.
+	wr := opt.NewWriter()
+	defer wr.Close()
+	wr.Write("; ModuleID = %s\n", quote(filepath.Base(opt.Src)))
+	wr.Write("source_filename = %s\n", quote(filepath.Base(opt.Src)))
+	if opt.TargetArch != util.UnknownArch {
+		tt, err := triple(opt)
+		if err != nil {
+			return err
+		}
+		wr.Write("target triple = %s\n", quote(tt))
+	}
+	wr.Write("\n")
+	for _, e1 := range m.Strings() {
+		wr.Write("@%s = private unnamed_addr constant [%d x i8] %s\n",
+			e1.Name(), len(e1.Value())+1, "c"+quote(e1.Value()+"\x00"))
+	}
+	args := "Argument error: expected %d arguments, got %d\n"
+	if len(entry.Params()) == 1 {
+		args = "Argument error: expected %d argument, got %d\n"
+	}
+	wr.Write("@%s = private unnamed_addr constant [%d x i8] %s\n", labelArgc, len(args)+1, "c"+quote(args+"\x00"))
+	args = "Argument error: argument %d is neither int nor float\n"
+	wr.Write("@%s = private unnamed_addr constant [%d x i8] %s\n", labelArgv, len(args)+1, "c"+quote(args+"\x00"))
+	if len(m.Globals()) > 0 {
+		wr.Write("\n")
+	}
+	for _, e1 := range m.Globals() {
+		// VSL doesn't support variable initialisation on declaration.
+		wr.Write("@%s = global %s %s\n", e1.Name(), t.typ(e1.DataType()), choose(e1.DataType() == types.Float,
+			"0.0", "0"))
+	}
+	wr.Write("\n")
+	wr.Write("declare i32 @%s(ptr, ...)\n", labelPrintf)
+	wr.Write("declare i32 @%s(i32, ptr, ...)\n", labelDprintf)
+	wr.Write("declare i64 @%s(ptr, ptr, i32)\n", labelStrtol)
+	wr.Write("declare double @%s(ptr, ptr)\n", labelStrtod)
+	wr.Write("declare %s @%s(%s)\n", t.i, saturate(t.i, t.f), t.f)
+	if t.i != "i32" {
+		wr.Write("declare i32 @%s(%s)\n", saturate("i32", t.f), t.f)
+	}
+	if t.trapv {
+		for _, e1 := range []string{"sadd", "ssub", "smul"} {
+			wr.Write("declare {%s, i1} @%s(%s, %s)\n", t.i, overflow(e1, t.i), t.i, t.i)
+		}
+		wr.Write("declare void @%s()\n", labelAbort)
+	}
+
+	// Generate functions.
+	ws := wr.Split(len(m.Functions()))
+	if opt.Threads > 1 {
+		// Parallel. Every function is generated to its own Writer, which are written in the order of the functions.
+		pool := opt.NewPool("llvm-ir")
+		if err := pool.Run(opt.Context(), len(m.Functions()), func(w *util.Worker, i int) error {
+			e1 := m.Functions()[i]
+			if len(e1.Blocks()) < 1 {
+				return nil // External functions are only declared, and generate no code.
+			}
+			w.Log.Infof("generating function %s", e1.Name())
+			return genFunction(e1, t, &ws[i])
+		}); err != nil {
+			return err
+		}
+	} else {
+		// Sequential.
+		for i1, e1 := range m.Functions() {
+			if err := genFunction(e1, t, &ws[i1]); err != nil {
+				return err
+			}
+		}
+	}
+	builtins := m.UsesArgs()
+	if builtins {
+		genArgs(t, &wr)
+	}
+	genMain(entry, t, builtins, &wr)
+	if t.trapv {
+		genOverflow(&wr)
+	}
+	return nil
+}
+
+// overflow returns the name of the LLVM intrinsic of the arithmetic operation op, such as sadd, on the integer type
+// typ, which returns the result and whether it overflowed.
+func overflow(op, typ string) string {
+	return fmt.Sprintf("llvm.%s.with.overflow.%s", op, typ)
+}
+
+// genOverflow generates the handler of integer overflows, which is called with the overflow bit of every checked
+// integer operation, and writes the overflow message to stderr by dprintf and aborts the program if it's set. LLVM
+// inlines the handler when optimising.
+func genOverflow(wr *util.Writer) {
+	wr.Write("\n@%s = private unnamed_addr constant [%d x i8] %s\n", labelOverflowMsg, len(lir.OverflowMsg)+1,
+		"c"+quote(lir.OverflowMsg+"\x00"))
+	wr.Write("\ndefine internal void @%s(i1 %%overflow) {\n", lir.LabelOverflow)
+	wr.Write("entry:\n")
+	wr.Write("\tbr i1 %%overflow, label %%abort, label %%ok\n")
+	wr.Write("\nok:\n")
+	wr.Write("\tret void\n")
+	wr.Write("\nabort:\n")
+	wr.Write("\tcall i32 (i32, ptr, ...) @%s(i32 2, ptr @%s)\n", labelDprintf, labelOverflowMsg)
+	wr.Write("\tcall void @%s()\n", labelAbort)
+	wr.Write("\tunreachable\n")
+	wr.Write("}\n")
+}
+
+// genArgs generates the globals that hold argc and argv, and the definitions of the runtime routines of the builtin
+// functions argc and arg, which read them. Program arguments out of range are the empty string, which strtoll and
+// strtod parse as zero.
+func genArgs(t target, wr *util.Writer) {
+	wr.Write("\n@%s = internal global i32 0\n", labelArgCount)
+	wr.Write("@%s = internal global ptr null\n", labelArgVector)
+	wr.Write("@%s = private unnamed_addr constant [1 x i8] zeroinitializer\n", labelArgEmpty)
+
+	wr.Write("\ndefine %s @%s() {\n", t.i, lir.LabelArgc)
+	wr.Write("\t%%argc = load i32, ptr @%s\n", labelArgCount)
+	wr.Write("\t%%n = sub i32 %%argc, 1\n")
+	if t.i == "i32" {
+		wr.Write("\tret i32 %%n\n")
+	} else {
+		wr.Write("\t%%res = sext i32 %%n to %s\n", t.i)
+		wr.Write("\tret %s %%res\n", t.i)
+	}
+	wr.Write("}\n")
+
+	wr.Write("\ndefine ptr @%s(%s %%i) {\n", lir.LabelArgString, t.i)
+	wr.Write("entry:\n")
+	wr.Write("\t%%argc = load i32, ptr @%s\n", labelArgCount)
+	n := "%argc"
+	if t.i != "i32" {
+		wr.Write("\t%%n = sext i32 %%argc to %s\n", t.i)
+		n = "%n"
+	}
+	wr.Write("\t%%low = icmp slt %s %%i, 1\n", t.i)
+	wr.Write("\t%%high = icmp sge %s %%i, %s\n", t.i, n)
+	wr.Write("\t%%out = or i1 %%low, %%high\n")
+	wr.Write("\tbr i1 %%out, label %%empty, label %%load\n")
+	wr.Write("\nload:\n")
+	wr.Write("\t%%argv = load ptr, ptr @%s\n", labelArgVector)
+	wr.Write("\t%%ptr = getelementptr ptr, ptr %%argv, %s %%i\n", t.i)
+	wr.Write("\t%%str = load ptr, ptr %%ptr\n")
+	wr.Write("\tret ptr %%str\n")
+	wr.Write("\nempty:\n")
+	wr.Write("\tret ptr @%s\n", labelArgEmpty)
+	wr.Write("}\n")
+
+	wr.Write("\ndefine %s @%s(%s %%i) {\n", t.i, lir.LabelArgInt, t.i)
+	wr.Write("\t%%str = call ptr @%s(%s %%i)\n", lir.LabelArgString, t.i)
+	wr.Write("\t%%val = call i64 @%s(ptr %%str, ptr null, i32 10)\n", labelStrtol)
+	if t.i == "i64" {
+		wr.Write("\tret i64 %%val\n")
+	} else {
+		wr.Write("\t%%res = trunc i64 %%val to %s\n", t.i)
+		wr.Write("\tret %s %%res\n", t.i)
+	}
+	wr.Write("}\n")
+
+	wr.Write("\ndefine %s @%s(%s %%i) {\n", t.f, lir.LabelArgFloat, t.i)
+	wr.Write("\t%%str = call ptr @%s(%s %%i)\n", lir.LabelArgString, t.i)
+	wr.Write("\t%%val = call double @%s(ptr %%str, ptr null)\n", labelStrtod)
+	if t.f == "double" {
+		wr.Write("\tret double %%val\n")
+	} else {
+		wr.Write("\t%%res = fptrunc double %%val to %s\n", t.f)
+		wr.Write("\tret %s %%res\n", t.f)
+	}
+	wr.Write("}\n")
+}
+
+// genMain generates the implicit main function, which parses the program arguments as the parameters of the Function
+// entry using strtoll and strtod, and returns the result of entry as the exit code. Arguments are rejected unless
+// they parse as a whole, hence zero is a valid argument. If builtins is set, argc and argv are stored for the builtin
+// functions argc and arg, and an entry without parameters accepts any number of arguments.
+func genMain(entry *lir.Function, t target, builtins bool, wr *util.Writer) {
+	params := entry.Params()
+	wr.Write("\ndefine i32 @%s(i32 %%argc, ptr %%argv) {\n", labelMain)
+	wr.Write("entry:\n")
+	wr.Write("\t%%end = alloca ptr\n")
+	if builtins {
+		wr.Write("\tstore i32 %%argc, ptr @%s\n", labelArgCount)
+		wr.Write("\tstore ptr %%argv, ptr @%s\n", labelArgVector)
+	}
+	wr.Write("\t%%n = sub i32 %%argc, 1\n")
+	if builtins && len(params) == 0 {
+		wr.Write("\tbr label %%parse1\n")
+	} else {
+		wr.Write("\t%%argc.ok = icmp eq i32 %%n, %d\n", len(params))
+		wr.Write("\tbr i1 %%argc.ok, label %%parse1, label %%argc.error\n")
+	}
+	wr.Write("\nargc.error:\n")
+	wr.Write("\tcall i32 (ptr, ...) @%s(ptr @%s, i32 %d, i32 %%n)\n", labelPrintf, labelArgc, len(params))
+	wr.Write("\tret i32 1\n")
+
+	// Parse arguments, branching to an error block per argument on failure.
+	args := make([]string, len(params))
+	for i1, e1 := range params {
+		a := fmt.Sprintf("%%arg%d", i1+1)
+		wr.Write("\nparse%d:\n", i1+1)
+		wr.Write("\t%s.ptr = getelementptr ptr, ptr %%argv, i32 %d\n", a, i1+1)
+		wr.Write("\t%s.str = load ptr, ptr %s.ptr\n", a, a)
+		if e1.DataType() == types.Int {
+			wr.Write("\t%s.val = call i64 @%s(ptr %s.str, ptr %%end, i32 10)\n", a, labelStrtol, a)
+			if t.i == "i64" {
+				args[i1] = a + ".val"
+			} else {
+				wr.Write("\t%s = trunc i64 %s.val to %s\n", a, a, t.i)
+				args[i1] = a
+			}
+		} else {
+			wr.Write("\t%s.val = call double @%s(ptr %s.str, ptr %%end)\n", a, labelStrtod, a)
+			if t.f == "double" {
+				args[i1] = a + ".val"
+			} else {
+				wr.Write("\t%s = fptrunc double %s.val to %s\n", a, a, t.f)
+				args[i1] = a
+			}
+		}
+
+		// The argument is bad unless it's non-empty and parsed to its end.
+		wr.Write("\t%s.end = load ptr, ptr %%end\n", a)
+		wr.Write("\t%s.empty = icmp eq ptr %s.end, %s.str\n", a, a, a)
+		wr.Write("\t%s.last = load i8, ptr %s.end\n", a, a)
+		wr.Write("\t%s.rest = icmp ne i8 %s.last, 0\n", a, a)
+		wr.Write("\t%s.bad = or i1 %s.empty, %s.rest\n", a, a, a)
+		next := "call"
+		if i1+1 < len(params) {
+			next = fmt.Sprintf("parse%d", i1+2)
+		}
+		wr.Write("\tbr i1 %s.bad, label %s.error, label %%%s\n", a, a, next)
+		wr.Write("\n%s.error:\n", a[1:])
+		wr.Write("\tcall i32 (ptr, ...) @%s(ptr @%s, i32 %d)\n", labelPrintf, labelArgv, i1+1)
+		wr.Write("\tret i32 1\n")
+	}
+	if len(params) == 0 {
+		// Let the argument count check branch to the call.
+		wr.Write("\nparse1:\n")
+		wr.Write("\tbr label %%call\n")
+	}
+
+	// Call entry and return its result as exit code.
+	sb := strings.Builder{}
+	for i1, e1 := range params {
+		if i1 > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%s %s", t.typ(e1.DataType()), args[i1]))
+	}
+	typ := t.typ(entry.DataType())
+	wr.Write("\ncall:\n")
+	wr.Write("\t%%ret = call %s @%s(%s)\n", typ, entry.Name(), sb.String())
+	switch {
+	case entry.DataType() == types.Float:
+		wr.Write("\t%%code = call i32 @%s(%s %%ret)\n", saturate("i32", t.f), t.f)
+		wr.Write("\tret i32 %%code\n")
+	case typ == "i32":
+		wr.Write("\tret i32 %%ret\n")
+	default:
+		wr.Write("\t%%code = trunc %s %%ret to i32\n", typ)
+		wr.Write("\tret i32 %%code\n")
+	}
+	wr.Write("}\n")
+}
+
+// triple returns the LLVM target triple of the target defined by opt, in the same way as the LLVM framework backend.
+func triple(opt util.Options) (string, error) {
+	if len(opt.Triple) > 0 {
+		return opt.Triple, nil
+	}
+	sb := strings.Builder{}
+	switch opt.TargetArch {
+	case util.Aarch64:
+		sb.WriteString("aarch64")
+	case util.Riscv64:
+		sb.WriteString("riscv64")
+	case util.Riscv32:
+		sb.WriteString("riscv32")
+	case util.Armv7:
+		sb.WriteString("armv7")
+	case util.X86_64:
+		sb.WriteString("x86_64")
+	case util.X86_32:
+		sb.WriteString("x86")
+	default:
+		return "", fmt.Errorf("unsupported target architecture identifier %d", opt.TargetArch)
+	}
+	sb.WriteRune('-')
+
+	// Target vendor. Defaults to PC.
+	switch opt.TargetVendor {
+	case util.PC, util.UnknownVendor:
+		sb.WriteString("pc")
+	case util.Apple:
+		sb.WriteString("apple")
+	case util.IBM:
+		sb.WriteString("ibm")
+	default:
+		return "", fmt.Errorf("unsupported target vendor identifier %d", opt.TargetVendor)
+	}
+	sb.WriteRune('-')
+
+	// Target operating system.
+	switch opt.TargetOS {
+	case util.UnknownOS:
+		sb.WriteString("none")
+	case util.Linux:
+		sb.WriteString("linux")
+	case util.Windows:
+		sb.WriteString("win32")
+	case util.MAC:
+		sb.WriteString("darwin")
+	default:
+		return "", fmt.Errorf("unsupported target operating system identifier %d", opt.TargetOS)
+	}
+
+	// Target abi/environment.
+	sb.WriteRune('-')
+	if opt.TargetArch == util.Armv7 {
+		// Hard-float EABI, which passes floats in VFP registers.
+		sb.WriteString("gnueabihf")
+	} else {
+		sb.WriteString("gnu")
+	}
+	return sb.String(), nil
+}
+
+// typ returns the LLVM type of the LIR data type dt. Strings are pointers.
+func (t target) typ(dt types.DataType) string {
+	switch dt {
+	case types.Int:
+		return t.i
+	case types.Float:
+		return t.f
+	default:
+		return "ptr"
+	}
+}
+
+// saturate returns the name of the LLVM intrinsic that converts floats of type f to integers of type i, rounding
+// towards zero and saturating on overflow, like the native backends.
+func saturate(i, f string) string {
+	return fmt.Sprintf("llvm.fptosi.sat.%s.%s", i, map[string]string{"float": "f32", "double": "f64"}[f])
+}
+
+// quote returns the LLVM string literal of s. Printable ASCII characters are written as is, except for quotes and
+// backslashes, and all other bytes are written as hexadecimal escapes.
+func quote(s string) string {
+	sb := strings.Builder{}
+	sb.Grow(len(s) + 2)
+	sb.WriteByte('"')
+	for i1 := 0; i1 < len(s); i1++ {
+		c := s[i1]
+		if c < 0x20 || c >= 0x7f || c == '"' || c == '\\' {
+			sb.WriteString(fmt.Sprintf("\\%02X", c))
+		} else {
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// float returns the LLVM literal of the float f of type typ. The literal is the hexadecimal bit pattern of f as a
+// double, which LLVM reads exactly for both float and double, after rounding f to single precision for float.
+func float(f float64, typ string) string {
+	if typ == "float" {
+		f = float64(float32(f))
+	}
+	return fmt.Sprintf("0x%016X", math.Float64bits(f))
+}
+
+// choose returns the string a if cond is true, and b otherwise.
+func choose(cond bool, a, b string) string {
+	if cond {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,72 @@
+package llvmir
+
+import (
+	"math"
+	"testing"
+	"vslc/src/util"
+)
+
+// TestQuote verifies that string literals escape quotes, backslashes and non-printable bytes.
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp string
+	}{
+		{"", `""`},
+		{"Hello, world!", `"Hello, world!"`},
+		{"%d\n\x00", `"%d\0A\00"`},
+		{`a "b" \c`, `"a \22b\22 \5Cc"`},
+		{"\xc3\xb8", `"\C3\B8"`},
+	}
+	for _, e1 := range tests {
+		if res := quote(e1.s); res != e1.exp {
+			t.Errorf("expected quote(%q) to be %s, got %s", e1.s, e1.exp, res)
+		}
+	}
+}
+
+// TestFloat verifies that float literals are the bit patterns of doubles, rounded to single precision for float.
+func TestFloat(t *testing.T) {
+	tests := []struct {
+		f   float64
+		typ string
+		exp string
+	}{
+		{0, "double", "0x0000000000000000"},
+		{1, "double", "0x3FF0000000000000"},
+		{-2.5, "float", "0xC004000000000000"},
+		{0.1, "double", "0x3FB999999999999A"},
+		{0.1, "float", "0x3FB99999A0000000"},
+		{math.Inf(1), "double", "0x7FF0000000000000"},
+	}
+	for _, e1 := range tests {
+		if res := float(e1.f, e1.typ); res != e1.exp {
+			t.Errorf("expected float(%g, %s) to be %s, got %s", e1.f, e1.typ, e1.exp, res)
+		}
+	}
+}
+
+// TestTriple verifies the target triples of the supported target architectures and operating systems.
+func TestTriple(t *testing.T) {
+	tests := []struct {
+		opt util.Options
+		exp string
+	}{
+		{util.Options{TargetArch: util.Aarch64, TargetOS: util.Linux}, "aarch64-pc-linux-gnu"},
+		{util.Options{TargetArch: util.Riscv64}, "riscv64-pc-none-gnu"},
+		{util.Options{TargetArch: util.Armv7, TargetOS: util.Linux}, "armv7-pc-linux-gnueabihf"},
+		{util.Options{TargetArch: util.X86_64, TargetOS: util.MAC, TargetVendor: util.Apple}, "x86_64-apple-darwin-gnu"},
+	}
+	for _, e1 := range tests {
+		res, err := triple(e1.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res != e1.exp {
+			t.Errorf("expected triple %s, got %s", e1.exp, res)
+		}
+	}
+	if _, err := triple(util.Options{TargetArch: util.Wasm}); err == nil {
+		t.Errorf("expected error for WebAssembly target")
+	}
+}
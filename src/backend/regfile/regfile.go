@@ -22,12 +22,15 @@ type RegisterFile interface {
 	FP() Register                                // Returns the frame pointer register.
 	GetI(i int) Register                         // Return the i'th integer register.
 	GetF(i int) Register                         // Returns the i'th floating point register.
+	ArgI(i int) Register                         // Returns the i'th integer argument register. The 0'th holds return values.
+	ArgF(i int) Register                         // Returns the i'th floating point argument register. The 0'th holds return values.
 	FreeI(i int)                                 // Free/de-allocate integer register with index i.
 	FreeF(i int)                                 // Free/de-allocate floating register with index i.
 	GetNextTempI() Register                      // Returns the next available temporary integer register.
 	GetNextTempF() Register                      // Returns the next available temporary floating point register.
 	GetNextTempIExclude(exc []Register) Register // Returns the next available temporary integer register with exclusion indices.
 	GetNextTempFExclude(exc []Register) Register // Returns the next available temporary floating point register with exclusion indices.
+	CallerSaved() []Register                     // CallerSaved returns the registers that are clobbered by function calls.
 	Ki() int                                     // Ki returns the number of usable temporary integer registers; allocated and un-allocated.
 	Kf() int                                     // Kf returns the number of usable temporary floating point registers; allocated and un-allocated.
 }
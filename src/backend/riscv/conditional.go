@@ -0,0 +1,109 @@
+package riscv
+
+import (
+	"fmt"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// -----------------------------
+// ----- Type definitions ------
+// -----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// --------------------
+// ----- Function -----
+// --------------------
+
+// genBranch generates RISC-V assembler of an LIR branch instruction. The Block next is the Block that follows the
+// branch in the generated code, or nil if the branch is in the last Block of the function. Jumps to next are omitted.
+// An error is returned if something went wrong.
+func (g *generator) genBranch(v *lir.BranchInstruction, next *lir.Block, rf RegisterFile, wr *util.Writer) error {
+	if v.Else() == nil {
+		// Unconditional branch.
+		if v.Then() != next {
+			wr.Write("\tj\t%s\n", v.Then().Name())
+		}
+		return nil
+	}
+
+	// Generate test and jump to THEN block if condition is true, or to ELSE block if condition is false and the THEN
+	// block follows sequentially.
+	cond := true
+	target := v.Then()
+	if v.Then() == next {
+		cond = false
+		target = v.Else()
+	}
+	op1 := v.Operand1().GetHW().Reg
+	op2 := v.Operand2().GetHW().Reg
+	if v.Operand1().DataType() == types.Int {
+		// Int compare and branch.
+		var b string
+		switch v.Operator() {
+		case types.Eq:
+			b = choose(cond, "beq", "bne")
+		case types.Neq:
+			b = choose(cond, "bne", "beq")
+		case types.LessThan:
+			b = choose(cond, "blt", "bge")
+		case types.LessThanOrEqual:
+			b = choose(cond, "ble", "bgt")
+		case types.GreaterThan:
+			b = choose(cond, "bgt", "ble")
+		case types.GreaterThanOrEqual:
+			b = choose(cond, "bge", "blt")
+		default:
+			return fmt.Errorf("unexpected logical operation: %d", v.Operator())
+		}
+		wr.Write("\t%s\t%s, %s, %s\n", b, op1.String(), op2.String(), target.Name())
+	} else {
+		// Float compare into scratch register, which holds 1 if the comparison is true. Greater than is less than
+		// with swapped operands.
+		tmp := rf.GetI(scratchi[0])
+		var cmp string
+		switch v.Operator() {
+		case types.Eq, types.Neq:
+			cmp = g.fop("feq")
+		case types.LessThan:
+			cmp = g.fop("flt")
+		case types.LessThanOrEqual:
+			cmp = g.fop("fle")
+		case types.GreaterThan:
+			cmp = g.fop("flt")
+			op1, op2 = op2, op1
+		case types.GreaterThanOrEqual:
+			cmp = g.fop("fle")
+			op1, op2 = op2, op1
+		default:
+			return fmt.Errorf("unexpected logical operation: %d", v.Operator())
+		}
+		wr.Write("\t%s\t%s, %s, %s\n", cmp, tmp.String(), op1.String(), op2.String())
+		if v.Operator() == types.Neq {
+			cond = !cond
+		}
+		wr.Write("\t%s\t%s, %s\n", choose(cond, "bnez", "beqz"), tmp.String(), target.Name())
+	}
+
+	// Jump to ELSE block unless it follows sequentially.
+	if target == v.Then() && v.Else() != next {
+		wr.Write("\tj\t%s\n", v.Else().Name())
+	}
+	return nil
+}
+
+// choose returns the string a if cond is true, and b otherwise.
+func choose(cond bool, a, b string) string {
+	if cond {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,436 @@
+package riscv
+
+import (
+	"fmt"
+	"vslc/src/backend/regfile"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// -----------------------------
+// ----- Type definitions ------
+// -----------------------------
+
+// location defines where an argument of a function call is passed.
+type location struct {
+	reg    regfile.Register // Argument register, or nil if the argument is passed on stack.
+	hi     regfile.Register // Argument register of the upper half of a double passed in a register pair on RV32.
+	offset int              // SP relative offset of the stack slot of an argument passed on stack.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// --------------------
+// ----- Function -----
+// --------------------
+
+// genExpression generates RISC-V assembler for arithmetic expressions. Integer additions, subtractions and
+// multiplications are generated by genOverflowExpression if -ftrapv is set. An error is returned if something went
+// wrong.
+func (g *generator) genExpression(v *lir.DataInstruction, rf RegisterFile, wr *util.Writer) error {
+	if g.trapv && v.Traps() {
+		g.genOverflowExpression(v, rf, wr)
+		return nil
+	}
+	dst := v.GetHW().Reg
+	reg1 := v.Operand1().GetHW().Reg
+
+	if v.Operand2() == nil {
+		// Unary expression.
+		switch v.Operator() {
+		case types.Sub:
+			if dst.Type() == int(types.Float) {
+				wr.Write("\t%s\t%s, %s\n", g.fop("fneg"), dst.String(), reg1.String())
+			} else {
+				wr.Write("\tneg%s\t%s, %s\n", g.wext, dst.String(), reg1.String())
+			}
+		case types.Not:
+			wr.Write("\tnot\t%s, %s\n", dst.String(), reg1.String())
+		default:
+			return fmt.Errorf("unexpected unary operator %q", v.Operator().String())
+		}
+		return nil
+	}
+
+	// Binary expression. Choose instruction from operator.
+	reg2 := v.Operand2().GetHW().Reg
+	var op string
+	if dst.Type() == int(types.Int) {
+		// Integer operations. Division by zero caught in validate. Bitwise operations of sign extended 32-bit integers
+		// are sign extended, hence only the arithmetic has w-suffixed variants.
+		switch v.Operator() {
+		case types.Add:
+			op = "add" + g.wext
+		case types.Sub:
+			op = "sub" + g.wext
+		case types.Mul:
+			op = "mul" + g.wext
+		case types.Div:
+			op = "div" + g.wext
+		case types.Rem:
+			op = "rem" + g.wext
+		case types.And:
+			op = "and"
+		case types.Xor:
+			op = "xor"
+		case types.Or:
+			op = "or"
+		case types.MulHigh:
+			if g.wext == "w" {
+				// The upper word of the 64-bit product of the sign extended operands.
+				wr.Write("\tmul\t%s, %s, %s\n", dst.String(), reg1.String(), reg2.String())
+				wr.Write("\tsrai\t%s, %s, 32\n", dst.String(), dst.String())
+				return nil
+			}
+			op = "mulh"
+		case types.RShift:
+			op = "srl" + g.wext
+		case types.ARShift:
+			op = "sra" + g.wext
+		case types.LShift:
+			op = "sll" + g.wext
+		}
+	} else {
+		switch v.Operator() {
+		case types.Add:
+			op = g.fop("fadd")
+		case types.Sub:
+			op = g.fop("fsub")
+		case types.Mul:
+			op = g.fop("fmul")
+		case types.Div:
+			op = g.fop("fdiv")
+		}
+	}
+	if op == "" {
+		return fmt.Errorf("unexpected binary operator %q", v.Operator().String())
+	}
+	wr.Write("\t%s\t%s, %s, %s\n", op, dst.String(), reg1.String(), reg2.String())
+	return nil
+}
+
+// genOverflowExpression generates RISC-V assembler for the integer addition, subtraction or multiplication v, which
+// calls the overflow handler if the result overflows. RISC-V has no overflow flag, hence:
+//
+//   - A sum is less than its first operand if, and only if, the second operand is negative, unless the sum overflows.
+//     A difference is less than its first operand if, and only if, the second operand is positive.
+//   - A product overflows if the upper word of the double word product, computed by mulh, isn't the sign extension of
+//     the lower word.
+//
+// The result is computed in a scratch register that isn't the first operand, which is compared with the result, and
+// moved to the destination register once it's checked. The second operand of a sum or difference is tested before it
+// may be overwritten. The upper word of a product is held by the other scratch register, or by the destination
+// register if both scratch registers hold spilled operands, or RA if the result is spilled too. RA is saved by every
+// stack frame, and the overflow handler doesn't return.
+//
+// 32-bit integers on RV64 overflow if the exact result, computed in 64 bits, differs from the sign extended result of
+// the w-suffixed instruction. The exact result is held like the upper word of a product.
+func (g *generator) genOverflowExpression(v *lir.DataInstruction, rf RegisterFile, wr *util.Writer) {
+	dst := v.GetHW().Reg
+	reg1, reg2 := v.Operand1().GetHW().Reg, v.Operand2().GetHW().Reg
+	res := rf.GetI(scratchi[0])
+	if res.Id() == reg1.Id() {
+		res = rf.GetI(scratchi[1])
+	}
+	hi := rf.LR()
+	for _, e1 := range []regfile.Register{rf.GetI(scratchi[0]), rf.GetI(scratchi[1]), dst} {
+		if e1.Id() != reg1.Id() && e1.Id() != reg2.Id() {
+			hi = e1
+			break
+		}
+	}
+
+	switch {
+	case g.wext == "w":
+		op := "add"
+		if v.Operator() == types.Sub {
+			op = "sub"
+		} else if v.Operator() == types.Mul {
+			op = "mul"
+		}
+		if res.Id() == hi.Id() {
+			res = rf.GetI(scratchi[0])
+			if res.Id() == hi.Id() {
+				res = rf.GetI(scratchi[1])
+			}
+		}
+		wr.Write("\t%s\t%s, %s, %s\n", op, hi.String(), reg1.String(), reg2.String())
+		wr.Write("\t%sw\t%s, %s, %s\n", op, res.String(), reg1.String(), reg2.String())
+		wr.Write("\tbeq\t%s, %s, 3f\n", hi.String(), res.String())
+	case v.Operator() == types.Add, v.Operator() == types.Sub:
+		op, neg := "add", "bltz"
+		if v.Operator() == types.Sub {
+			op, neg = "sub", "bgtz"
+		}
+		check := func(cond string) {
+			wr.Write("\t%s\t%s, %s, %s\n", op, res.String(), reg1.String(), reg2.String())
+			wr.Write("\t%s\t%s, %s, 3f\n", cond, res.String(), reg1.String())
+		}
+		if c, ok := v.Operand2().(*lir.Constant); ok {
+			// The sign of the second operand is known.
+			val := c.Value().(int)
+			if (v.Operator() == types.Add && val < 0) || (v.Operator() == types.Sub && val > 0) {
+				check("blt")
+			} else {
+				check("bge")
+			}
+			break
+		}
+		wr.Write("\t%s\t%s, 2f\n", neg, reg2.String())
+		check("bge")
+		wr.Write("\tj\t4f\n")
+		wr.Write("2:\n")
+		check("blt")
+	case v.Operator() == types.Mul:
+		if res.Id() == hi.Id() {
+			res = rf.GetI(scratchi[0])
+			if res.Id() == hi.Id() {
+				res = rf.GetI(scratchi[1])
+			}
+		}
+		wr.Write("\tmulh\t%s, %s, %s\n", hi.String(), reg1.String(), reg2.String())
+		wr.Write("\tmul\t%s, %s, %s\n", res.String(), reg1.String(), reg2.String())
+		wr.Write("\tbltz\t%s, 2f\n", res.String())
+		wr.Write("\tbeqz\t%s, 3f\n", hi.String())
+		wr.Write("\tj\t4f\n")
+		wr.Write("2:\n")
+		wr.Write("\taddi\t%s, %s, 1\n", hi.String(), hi.String())
+		wr.Write("\tbeqz\t%s, 3f\n", hi.String())
+	}
+	wr.Write("4:\n")
+	wr.Write("\tcall\t%s\n", lir.LabelOverflow)
+	wr.Write("3:\n")
+	if res.Id() != dst.Id() {
+		wr.Write("\tmv\t%s, %s\n", dst.String(), res.String())
+	}
+}
+
+// nextArgument returns the argument register of the next argument of a function call, given the pointers ii and fi to
+// the number of integer and floating point argument registers already used, and increments the counter of the
+// register class it's taken from. Floats are passed in integer argument registers if they're variadic, if the
+// floating point argument registers are used up, or if soft-float is used. Returns nil if the argument is passed on the
+// stack.
+func nextArgument(rf RegisterFile, isFloat, variadic bool, ii, fi *int) regfile.Register {
+	if isFloat && !variadic && !rf.soft && *fi < paramReg {
+		*fi++
+		return rf.ArgF(*fi - 1)
+	}
+	if *ii < paramReg {
+		*ii++
+		return rf.ArgI(*ii - 1)
+	}
+	return nil
+}
+
+// nextPair returns the aligned pair of integer argument registers of a variadic double on RV32, given the pointer ii to
+// the number of integer argument registers already used. Returns nil if the double is passed on the stack, in which
+// case any remaining integer argument register is skipped.
+func nextPair(rf RegisterFile, ii *int) (regfile.Register, regfile.Register) {
+	*ii += *ii & 1
+	if *ii+1 < paramReg {
+		*ii += 2
+		return rf.ArgI(*ii - 2), rf.ArgI(*ii - 1)
+	}
+	*ii = paramReg
+	return nil, nil
+}
+
+// genFunctionCall generates RISC-V assembler for a function call. An error is returned if something went wrong. The
+// result of the function call is put in register a0 for integers or fa0 for floating point functions.
+func (g *generator) genFunctionCall(v *lir.FunctionCallInstruction, fun *lir.Function, rf RegisterFile,
+	wr *util.Writer) error {
+	// Flatten the arguments. VaList is used exclusively by calls to printf.
+	args := make([]lir.Value, 0, len(v.Arguments()))
+	variadic := make([]bool, 0, len(v.Arguments()))
+	for _, e1 := range v.Arguments() {
+		if e1.DataType() == types.VaList {
+			for _, e2 := range e1.(*lir.VaList).Values() {
+				args = append(args, e2)
+				variadic = append(variadic, true)
+			}
+		} else {
+			args = append(args, e1)
+			variadic = append(variadic, false)
+		}
+	}
+
+	// Assign argument registers, and stack slots to the arguments that are passed on stack. Variadic floats are
+	// promoted to double, which is passed in an aligned register pair or stack slot on RV32.
+	ii := 0 // Number of integer argument registers used.
+	fi := 0 // Number of float argument registers used.
+	locs := make([]location, len(args))
+	size := 0
+	pairs := false // Set to true if a double is passed in a register pair.
+	widen := false // Set to true if soft-float doubles are passed, which are promoted by calls to labelExtend.
+	for i1, e1 := range args {
+		isFloat := e1.DataType() == types.Float
+		if isFloat && variadic[i1] && g.fext != "d" {
+			widen = rf.soft
+			if locs[i1].reg, locs[i1].hi = nextPair(rf, &ii); locs[i1].reg == nil {
+				size = align(size, savedSize)
+				locs[i1].offset = size
+				size += savedSize
+			} else {
+				pairs = true
+			}
+			continue
+		}
+		if locs[i1].reg = nextArgument(rf, isFloat, variadic[i1], &ii, &fi); locs[i1].reg == nil {
+			locs[i1].offset = size
+			size += g.wordSize
+		}
+	}
+	conv := 0 // Offset of the stack slots that move doubles to register pairs, above the stack arguments.
+	if widen {
+		// Every argument is kept in a stack slot while the doubles are promoted.
+		size = align(size, savedSize)
+		conv = size
+		size += savedSize * len(args)
+	} else if pairs {
+		size = align(size, savedSize)
+		conv = size
+		size += savedSize
+	}
+	size = align(size, stackAlign)
+	sp := rf.SP().String()
+	if size > 0 {
+		wr.Write("\taddi\t%s, %s, %d\n", sp, sp, -size)
+	}
+
+	// Generate argument passing.
+	if widen {
+		g.genWidened(args, variadic, locs, conv, fun, rf, wr)
+	} else {
+		for i1, e1 := range args {
+			loc := locs[i1]
+			switch {
+			case e1.DataType() == types.Float && variadic[i1] && g.fext != "d":
+				// Convert to double in scratch register, and move it through memory to the register pair, if any.
+				src := g.argument(e1, fun, rf, wr)
+				tmp := rf.GetF(scratchf[1]).String()
+				wr.Write("\tfcvt.d.s\t%s, %s\n", tmp, src.String())
+				if loc.reg == nil {
+					wr.Write("\tfsd\t%s, %d(%s)\n", tmp, loc.offset, sp)
+					break
+				}
+				wr.Write("\tfsd\t%s, %d(%s)\n", tmp, conv, sp)
+				wr.Write("\t%s\t%s, %d(%s)\n", loadWord(loc.reg), loc.reg.String(), conv, sp)
+				wr.Write("\t%s\t%s, %d(%s)\n", loadWord(loc.hi), loc.hi.String(), conv+g.wordSize, sp)
+			case loc.reg != nil:
+				g.genArgument(loc.reg, e1, fun, rf, wr)
+			default:
+				src := g.argument(e1, fun, rf, wr)
+				wr.Write("\t%s\t%s, %d(%s)\n", store(src), src.String(), loc.offset, sp)
+			}
+		}
+	}
+
+	// Call function.
+	wr.Write("\tcall\t%s\n", g.symbol(v.Target().Name()))
+
+	// De-allocate stack for arguments, if any.
+	if size > 0 {
+		wr.Write("\taddi\t%s, %s, %d\n", sp, sp, size)
+	}
+	return nil
+}
+
+// genWidened generates the passing of the arguments args of a soft-float function call on RV32, whose variadic floats
+// are promoted to doubles by calls to labelExtend. The calls clobber the argument registers, hence every argument is
+// stored in its stack slot at offset conv first. The promoted doubles replace the floats in their slots, before the
+// arguments are loaded into their argument registers or copied to their stack slots of locs.
+func (g *generator) genWidened(args []lir.Value, variadic []bool, locs []location, conv int, fun *lir.Function,
+	rf RegisterFile,
+	wr *util.Writer) {
+	sp := rf.SP().String()
+	for i1, e1 := range args {
+		src := g.argument(e1, fun, rf, wr)
+		wr.Write("\t%s\t%s, %d(%s)\n", storeWord(src), src.String(), conv+savedSize*i1, sp)
+	}
+	lo, hi := rf.GetI(a0), rf.GetI(a1)
+	for i1, e1 := range args {
+		if e1.DataType() == types.Float && variadic[i1] {
+			offset := conv + savedSize*i1
+			wr.Write("\t%s\t%s, %d(%s)\n", loadWord(lo), lo.String(), offset, sp)
+			wr.Write("\tcall\t%s\n", labelExtend)
+			wr.Write("\t%s\t%s, %d(%s)\n", storeWord(lo), lo.String(), offset, sp)
+			wr.Write("\t%s\t%s, %d(%s)\n", storeWord(hi), hi.String(), offset+g.wordSize, sp)
+		}
+	}
+	for i1, e1 := range args {
+		offset := conv + savedSize*i1
+		words := 1
+		if e1.DataType() == types.Float && variadic[i1] {
+			words = 2
+		}
+		for i2 := 0; i2 < words; i2++ {
+			switch loc := locs[i1]; {
+			case loc.reg != nil && i2 == 0:
+				wr.Write("\t%s\t%s, %d(%s)\n", loadWord(loc.reg), loc.reg.String(), offset, sp)
+			case loc.reg != nil:
+				wr.Write("\t%s\t%s, %d(%s)\n", loadWord(loc.hi), loc.hi.String(), offset+g.wordSize, sp)
+			default:
+				tmp := rf.GetI(scratchi[0])
+				wr.Write("\t%s\t%s, %d(%s)\n", loadWord(tmp), tmp.String(), offset+g.wordSize*i2, sp)
+				wr.Write("\t%s\t%s, %d(%s)\n", storeWord(tmp), tmp.String(), loc.offset+g.wordSize*i2, sp)
+			}
+		}
+	}
+}
+
+// genArgument moves the function call argument arg to the argument register dst. Spilled arguments are loaded from
+// their spill slot directly. Floats are moved bit by bit to integer argument registers.
+func (g *generator) genArgument(dst regfile.Register, arg lir.Value, fun *lir.Function, rf RegisterFile,
+	wr *util.Writer) {
+	if n := spilled(arg); n != nil {
+		wr.Write("\t%s\t%s, %d(%s)\n", load(dst), dst.String(), g.spillOffset(fun, n), rf.FP().String())
+		return
+	}
+	src := arg.GetHW().Reg
+	switch {
+	case dst.Type() == int(types.Float):
+		wr.Write("\t%s\t%s, %s\n", g.fop("fmv"), dst.String(), src.String())
+	case src.Type() == int(types.Float):
+		wr.Write("\tfmv.x.%s\t%s, %s\n", g.fbits, dst.String(), src.String())
+	default:
+		wr.Write("\tmv\t%s, %s\n", dst.String(), src.String())
+	}
+}
+
+// argument returns the register holding the function call argument arg. Spilled arguments are loaded from their
+// spill slot into a scratch register.
+func (g *generator) argument(arg lir.Value, fun *lir.Function, rf RegisterFile, wr *util.Writer) regfile.Register {
+	n := spilled(arg)
+	if n == nil {
+		return arg.GetHW().Reg
+	}
+	var r regfile.Register
+	if isInt(rf, arg.DataType()) {
+		r = rf.GetI(scratchi[0])
+	} else {
+		r = rf.GetF(scratchf[0])
+	}
+	wr.Write("\t%s\t%s, %d(%s)\n", load(r), r.String(), g.spillOffset(fun, n), rf.FP().String())
+	return r
+}
+
+// isInt returns true if values of type typ are held in integer registers of the register file rf. Strings are
+// addresses, and soft-float holds floats in integer registers.
+func isInt(rf RegisterFile, typ types.DataType) bool {
+	return typ == types.Int || typ == types.String || rf.soft
+}
+
+// align returns n rounded up to the nearest multiple of a.
+func align(n, a int) int {
+	if res := n % a; res != 0 {
+		n += a - res
+	}
+	return n
+}
@@ -0,0 +1,107 @@
+package riscv
+
+import (
+	"testing"
+	"vslc/src/util"
+)
+
+// TestNextArgument verifies that floats are passed in the floating point argument registers, then in the integer
+// argument registers, and variadic floats in the integer argument registers only.
+func TestNextArgument(t *testing.T) {
+	rf := CreateRegisterFile(util.Options{TargetArch: util.Riscv64})
+	ii, fi := 0, 0
+	var res []string
+	for i1 := 0; i1 < paramReg+1; i1++ {
+		res = append(res, nextArgument(rf, true, false, &ii, &fi).String())
+	}
+	res = append(res, nextArgument(rf, false, false, &ii, &fi).String())
+	res = append(res, nextArgument(rf, true, true, &ii, &fi).String())
+	for ii < paramReg {
+		nextArgument(rf, false, false, &ii, &fi)
+	}
+	if r := nextArgument(rf, true, false, &ii, &fi); r != nil {
+		t.Errorf("expected float to be passed on stack, got %s", r.String())
+	}
+
+	exp := []string{"fa0", "fa1", "fa2", "fa3", "fa4", "fa5", "fa6", "fa7", "a0", "a1", "a2"}
+	for i1, e1 := range exp {
+		if res[i1] != e1 {
+			t.Errorf("expected argument %d in %s, got %s", i1, e1, res[i1])
+		}
+	}
+}
+
+// TestNextPair verifies that variadic doubles on RV32 are passed in aligned pairs of integer argument registers, and on
+// the stack when no pair is left.
+func TestNextPair(t *testing.T) {
+	rf := CreateRegisterFile(util.Options{TargetArch: util.Riscv32})
+	ii, fi := 0, 0
+	var res []string
+	nextArgument(rf, false, false, &ii, &fi)
+	for i1 := 0; i1 < 3; i1++ {
+		lo, hi := nextPair(rf, &ii)
+		res = append(res, lo.String(), hi.String())
+	}
+	nextArgument(rf, false, false, &ii, &fi)
+	if lo, hi := nextPair(rf, &ii); lo != nil || hi != nil {
+		t.Errorf("expected double to be passed on stack, got %v and %v", lo, hi)
+	}
+	if ii != paramReg {
+		t.Errorf("expected all integer argument registers to be used, got %d", ii)
+	}
+
+	exp := []string{"a2", "a3", "a4", "a5", "a6", "a7"}
+	if len(res) != len(exp) {
+		t.Fatalf("expected %d registers, got %d", len(exp), len(res))
+	}
+	for i1, e1 := range exp {
+		if res[i1] != e1 {
+			t.Errorf("expected register %d to be %s, got %s", i1, e1, res[i1])
+		}
+	}
+}
+
+// TestTarget verifies that the word size, loads and stores and the floating point precision follow the RV32 or RV64
+// target.
+func TestTarget(t *testing.T) {
+	tests := []struct {
+		arch      int
+		word      int
+		label     string
+		ops       []string
+		loadi     string
+		storef    string
+		loadf     string
+		saveFloat string
+	}{
+		{util.Riscv32, wordSize32, "word", []string{"fadd.s", "fmv.x.w", "fcvt.w.s"}, "lw", "fsw", "flw", "fsd"},
+		{util.Riscv64, wordSize64, "dword", []string{"fadd.d", "fmv.x.d", "fcvt.l.d"}, "ld", "fsd", "fld", "fsd"},
+	}
+	for _, e1 := range tests {
+		opt := util.Options{TargetArch: e1.arch}
+		g := newGenerator(opt)
+		rf := CreateRegisterFile(opt)
+		res := []string{g.fop("fadd"), "fmv.x." + g.fbits, "fcvt." + g.iext + "." + g.fext}
+		for i1, e2 := range e1.ops {
+			if res[i1] != e2 {
+				t.Errorf("arch %d: expected %s, got %s", e1.arch, e2, res[i1])
+			}
+		}
+		if g.wordSize != e1.word || g.wordLabel != e1.label {
+			t.Errorf("arch %d: expected word size %d and label %s, got %d and %s", e1.arch, e1.word, e1.label,
+				g.wordSize, g.wordLabel)
+		}
+		if op := load(rf.GetI(a0)); op != e1.loadi {
+			t.Errorf("arch %d: expected integer load %s, got %s", e1.arch, e1.loadi, op)
+		}
+		if op := store(rf.GetF(fa0)); op != e1.storef {
+			t.Errorf("arch %d: expected float store %s, got %s", e1.arch, e1.storef, op)
+		}
+		if op := load(rf.GetF(fa0)); op != e1.loadf {
+			t.Errorf("arch %d: expected float load %s, got %s", e1.arch, e1.loadf, op)
+		}
+		if op := saveOp(rf.GetF(fs0), true); op != e1.saveFloat {
+			t.Errorf("arch %d: expected callee-saved float store %s, got %s", e1.arch, e1.saveFloat, op)
+		}
+	}
+}
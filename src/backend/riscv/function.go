@@ -0,0 +1,517 @@
+package riscv
+
+import (
+	"fmt"
+	"math"
+	"vslc/src/backend/regfile"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// frame defines the stack frame layout of a function.
+type frame struct {
+	size    int                // Size of the stack frame in bytes, aligned with the stack alignment.
+	saved   []regfile.Register // Callee-saved registers stored at the bottom of the stack frame.
+	offsets []int              // SP relative offsets of the stack slots of the callee-saved registers.
+	canary  bool               // Set to true if a stack protector canary is stored below RA and FP.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// genFunction generates RISC-V assembler code for an integer or floating point return type function.
+//
+// General steps:
+//
+// - Grow stack with word size * (arguments + locals + spill slots) + RA and FP + used callee-saved registers. Align
+//   with stack alignment.
+// - Save used callee-saved registers at the bottom of the stack frame.
+// - Store a canary directly below RA and FP if -fstack-protector is set, and check it before returning.
+// - Store all arguments on stack to maximise available registers.
+// - Generate function body.
+// - De-allocate stack.
+// - Return a0 for integer functions, use fa0 for floating point functions.
+func (g *generator) genFunction(fun *lir.Function, rf RegisterFile, wr *util.Writer) error {
+	if len(fun.Blocks()) < 1 {
+		return nil
+	}
+
+	ann := g.annotator.Function()
+
+	// Calculate new stack size. Stack slots are addressed by 12-bit immediate offsets.
+	fr := g.newFrame(fun, rf)
+	if fr.size > maxImm {
+		return fmt.Errorf("stack frame of function %s exceeds %d bytes", fun.Name(), maxImm)
+	}
+
+	// Write function name label.
+	wr.Write("\n\t.align\t2\n")
+	wr.Write("\t.type\t%s, @function\n", fun.Name())
+	wr.Label(fun.Name())
+	wr.Write("\t.cfi_startproc\n")
+
+	// Allocate stack frame and save RA, FP and used callee-saved registers.
+	g.genPrologue(fr, rf, wr)
+
+	ii := 0 // Number of integer argument registers used.
+	fi := 0 // Number of float argument registers used.
+	si := 0 // Number of parameters passed on stack.
+
+	// Put arguments on stack.
+	fp := rf.FP().String()
+	for i1, e1 := range fun.Params() {
+		offset := g.param(i1)
+		r := nextArgument(rf, e1.DataType() == types.Float, false, &ii, &fi)
+		if r == nil {
+			// Load from stack, store on stack. Reuse the scratch register, because no value is spilled yet.
+			r = rf.GetI(scratchi[0])
+			wr.Write("\t%s\t%s, %d(%s)\n", load(r), r.String(), g.wordSize*si, fp)
+			si++
+		}
+
+		// Store directly on stack from register. Floats passed in integer registers are stored bit by bit.
+		wr.Write("\t%s\t%s, %d(%s)\n", store(r), r.String(), offset, fp)
+	}
+
+	// Generate function body.
+	blocks := fun.Blocks()
+	for i1, e1 := range blocks {
+		var next *lir.Block
+		if i1+1 < len(blocks) {
+			next = blocks[i1+1]
+		}
+
+		// Write label for basic block.
+		wr.Label(e1.Name())
+		for _, e2 := range e1.Instructions() {
+			ann.Annotate(wr, e2)
+
+			// Load spilled operands into scratch registers.
+			reloaded := g.genReload(e2, fun, rf, wr)
+
+			switch e2.Type() {
+			case types.DataInstruction:
+				if e2.DataType() == types.VaList {
+					// VaList is handled by genFunctionCall.
+					break
+				}
+				if err := g.genExpression(e2.(*lir.DataInstruction), rf, wr); err != nil {
+					return locate(e2, err)
+				}
+			case types.LoadInstruction:
+				dst := e2.GetHW().Reg
+				if e2.DataType() == types.String {
+					genAddress(dst, e2.Operand1().Name(), wr)
+					break
+				}
+				switch e2.Operand1().Type() {
+				case types.DeclareInstruction:
+					src := e2.Operand1().(*lir.DeclareInstruction)
+					wr.Write("\t%s\t%s, %d(%s)\n", load(dst), dst.String(), g.local(fun, src.Seq()), fp)
+				case types.Param:
+					src := e2.Operand1().(*lir.Param)
+					wr.Write("\t%s\t%s, %d(%s)\n", load(dst), dst.String(), g.param(src.Id()), fp)
+				case types.Global:
+					// Used t6 for storing the temporary value that is &GLOBAL_VARIABLE.
+					genAccess(load(dst), dst, rf.GetI(scratchi[1]), e2.Operand1().Name(), wr)
+				default:
+					panic(fmt.Sprintf("compiler error: unexpected load source type %s", e2.Operand1().Type().String()))
+				}
+			case types.StoreInstruction:
+				src := e2.Operand1().GetHW().Reg
+				switch e2.Operand2().Type() {
+				case types.DeclareInstruction:
+					dst := e2.Operand2().(*lir.DeclareInstruction)
+					wr.Write("\t%s\t%s, %d(%s)\n", store(src), src.String(), g.local(fun, dst.Seq()), fp)
+				case types.Param:
+					dst := e2.Operand2().(*lir.Param)
+					wr.Write("\t%s\t%s, %d(%s)\n", store(src), src.String(), g.param(dst.Id()), fp)
+				case types.Global:
+					// Used t6 for storing the temporary value that is &GLOBAL_VARIABLE.
+					genAccess(store(src), src, rf.GetI(scratchi[1]), e2.Operand2().Name(), wr)
+				default:
+					panic(fmt.Sprintf("compiler error: unexpected store destination type %d", e2.Operand2().Type()))
+				}
+			case types.Constant:
+				r := e2.GetHW().Reg // Assigned hardware register.
+				cnst := e2.(*lir.Constant)
+				if e2.DataType() == types.Int {
+					// The assembler synthesises any integer from lui and addi, or a longer sequence on RV64.
+					wr.Write("\tli\t%s, %d\n", r.String(), cnst.Value().(int))
+				} else if val := cnst.Value().(float64); val == 0 && !math.Signbit(val) {
+					// Copy positive zero from the zero register.
+					if r.Type() == int(types.Int) {
+						wr.Write("\tmv\t%s, zero\n", r.String())
+					} else {
+						wr.Write("\tfmv.%s.x\t%s, zero\n", g.fbits, r.String())
+					}
+				} else {
+					// Load float from the literal pool, into an integer register for soft-float. Use t6 as temporary
+					// register.
+					fstr := fmt.Sprintf("%s%d", labelConstant, cnst.GlobalSeq())
+					genAccess(load(r), r, rf.GetI(scratchi[1]), fstr, wr)
+					cnst.Use()
+				}
+			case types.CastInstruction:
+				dst := e2.GetHW().Reg
+				src := e2.Operand1().GetHW().Reg
+				if e2.DataType() == types.Int {
+					// Cast float to int. Round to nearest.
+					wr.Write("\tfcvt.%s.%s\t%s, %s, rne\n", g.iext, g.fext, dst.String(), src.String())
+				} else {
+					// Cast int to float.
+					wr.Write("\tfcvt.%s.%s\t%s, %s\n", g.fext, g.iext, dst.String(), src.String())
+				}
+			case types.BranchInstruction:
+				if err := g.genBranch(e2.(*lir.BranchInstruction), next, rf, wr); err != nil {
+					return locate(e2, err)
+				}
+			case types.ReturnInstruction:
+				g.genReturn(e2.(*lir.ReturnInstruction), fun, fr, rf, wr)
+			case types.FunctionCallInstruction:
+				if err := g.genFunctionCall(e2.(*lir.FunctionCallInstruction), fun, rf, wr); err != nil {
+					return locate(e2, err)
+				}
+			case types.PreserveInstruction:
+				// Preserves a0 or fa0 from function calls.
+				dst := e2.GetHW().Reg
+				src := e2.Operand1().GetHW().Reg
+				if dst.Id() == src.Id() {
+					// Coalesced by the register allocator.
+				} else if dst.Type() == int(types.Int) {
+					wr.Write("\tmv\t%s, %s\n", dst.String(), src.String())
+				} else {
+					wr.Write("\t%s\t%s, %s\n", g.fop("fmv"), dst.String(), src.String())
+				}
+			case types.PrintInstruction, types.Global, types.Param, types.DeclareInstruction:
+				// Ignore, because they've been handled during LIR construction.
+				continue
+			default:
+				return locate(e2, fmt.Errorf("unexpected LIR instruction type %d", e2.Type()))
+			}
+
+			// Store spilled result to its spill slot.
+			g.genSpill(e2, reloaded, fun, rf, wr)
+		}
+	}
+	genProcEnd(fun.Name(), wr)
+	return nil
+}
+
+// genReturn generates a function return statement that tears down the stack frame fr. Soft-float return values have
+// been converted to the function's type by lir.LowerFloat.
+func (g *generator) genReturn(v *lir.ReturnInstruction, fun *lir.Function, fr frame, rf RegisterFile, wr *util.Writer) {
+	r := v.Operand1().GetHW().Reg
+	typ := v.Operand1().DataType()
+	switch {
+	case typ != fun.DataType() && typ == types.Int:
+		// Cast integer to float.
+		wr.Write("\tfcvt.%s.%s\t%s, %s\n", g.fext, g.iext, rf.GetF(fa0).String(), r.String())
+	case typ != fun.DataType():
+		// Cast float to integer. Round to nearest.
+		wr.Write("\tfcvt.%s.%s\t%s, %s, rne\n", g.iext, g.fext, rf.GetI(a0).String(), r.String())
+	case r.Type() == int(types.Int) && r.Id() != a0:
+		wr.Write("\tmv\t%s, %s\n", rf.GetI(a0).String(), r.String())
+	case r.Type() == int(types.Float) && r.Id() != fa0:
+		wr.Write("\t%s\t%s, %s\n", g.fop("fmv"), rf.GetF(fa0).String(), r.String())
+	}
+
+	// Restore callee-saved registers, RA and FP, and de-allocate stack.
+	g.genEpilogue(fr, rf, wr)
+}
+
+// genPrologue generates the allocation of the stack frame fr, which stores RA and FP at its top and the callee-saved
+// registers at its bottom, and sets FP to the old SP. Call frame information directives are generated after each
+// instruction that changes the canonical frame address or saves a register.
+func (g *generator) genPrologue(fr frame, rf RegisterFile, wr *util.Writer) {
+	sa, sp := fr.size, rf.SP().String()
+
+	// Adjust stack.
+	wr.Write("\taddi\t%s, %s, %d\n", sp, sp, -sa)
+	wr.Write("\t.cfi_def_cfa_offset\t%d\n", sa)
+
+	// Save return address and old frame pointer.
+	wr.Write("\t%s\t%s, %d(%s)\n", storeWord(rf.LR()), rf.LR().String(), sa-g.wordSize, sp)
+	wr.Write("\t%s\t%s, %d(%s)\n", storeWord(rf.FP()), rf.FP().String(), sa-(g.wordSize<<1), sp)
+	wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(rf.LR()), -g.wordSize)
+	wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(rf.FP()), -(g.wordSize << 1))
+
+	// Save callee-saved registers that are used by the function body.
+	for i1, e1 := range fr.saved {
+		wr.Write("\t%s\t%s, %d(%s)\n", saveOp(e1, true), e1.String(), fr.offsets[i1], sp)
+		wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(e1), fr.offsets[i1]-sa)
+	}
+
+	// Set frame pointer to old stack pointer. The canonical frame address follows FP from here on.
+	wr.Write("\taddi\t%s, %s, %d\n", rf.FP().String(), sp, sa)
+	wr.Write("\t.cfi_def_cfa\t%d, 0\n", dwarfReg(rf.FP()))
+
+	// Store the stack protector canary directly below RA and FP.
+	if fr.canary {
+		g.genCanary(rf.FP(), rf.GetI(scratchi[0]), -g.wordSize*3, wr)
+	}
+}
+
+// genEpilogue generates the restoring of the callee-saved registers, RA and FP, de-allocates the stack frame fr set up
+// by genPrologue, and returns. The call frame information state is remembered before and restored after the
+// epilogue, because code following the return is still inside the stack frame.
+func (g *generator) genEpilogue(fr frame, rf RegisterFile, wr *util.Writer) {
+	sa, sp := fr.size, rf.SP().String()
+	wr.Write("\t.cfi_remember_state\n")
+
+	// Check that the stack protector canary is intact. The failure handler doesn't return.
+	if fr.canary {
+		g.genCanaryCheck(rf.FP(), rf.GetI(scratchi[0]), rf.GetI(scratchi[1]), -g.wordSize*3, wr)
+	}
+
+	// Restore callee-saved registers.
+	for i1, e1 := range fr.saved {
+		wr.Write("\t%s\t%s, %d(%s)\n", saveOp(e1, false), e1.String(), fr.offsets[i1], sp)
+	}
+
+	// SP still equals FP-sa, so the canonical frame address can be described by SP before FP is restored.
+	wr.Write("\t.cfi_def_cfa\t%d, %d\n", dwarfReg(rf.SP()), sa)
+
+	// Restore RA and FP.
+	wr.Write("\t%s\t%s, %d(%s)\n", loadWord(rf.LR()), rf.LR().String(), sa-g.wordSize, sp)
+	wr.Write("\t%s\t%s, %d(%s)\n", loadWord(rf.FP()), rf.FP().String(), sa-(g.wordSize<<1), sp)
+
+	// De-allocate stack.
+	wr.Write("\taddi\t%s, %s, %d\n", sp, sp, sa)
+	wr.Write("\t.cfi_def_cfa_offset\t0\n")
+	wr.Write("\tret\n")
+	wr.Write("\t.cfi_restore_state\n")
+}
+
+// saveOp returns the instruction that stores the callee-saved register r to its stack slot if store is true, or that
+// loads it from its stack slot otherwise. Floating point registers are saved in full, regardless of the float width.
+func saveOp(r regfile.Register, store bool) string {
+	switch {
+	case r.Type() == int(types.Float) && store:
+		return "fsd"
+	case r.Type() == int(types.Float):
+		return "fld"
+	case store:
+		return storeWord(r)
+	default:
+		return loadWord(r)
+	}
+}
+
+// genProcEnd generates the end of the call frame information and the size of the function name.
+func genProcEnd(name string, wr *util.Writer) {
+	wr.Write("\t.cfi_endproc\n")
+	wr.Write("\t.size\t%s, .-%s\n", name, name)
+}
+
+// dwarfReg returns the DWARF register number of register r, which is used by the call frame information directives.
+func dwarfReg(r regfile.Register) int {
+	if r.Type() == int(types.Float) {
+		return dwarfFloat + r.Id()
+	}
+	return r.Id()
+}
+
+// calleeSaved returns the callee-saved registers s1-s11 and fs0-fs11 that are written by the body of Function fun,
+// ordered by type and index. Register s0 is the frame pointer, which is always saved.
+func calleeSaved(fun *lir.Function, rf RegisterFile) []regfile.Register {
+	usedi := make([]bool, len(rf.regi))
+	usedf := make([]bool, len(rf.regf))
+	for _, e1 := range fun.Blocks() {
+		for _, e2 := range e1.Instructions() {
+			n := e2.GetHW()
+			if n == nil || e2.DataType() == types.VaList {
+				// No code is generated for writing variable argument lists.
+				continue
+			}
+			if r := n.Reg; r != nil {
+				if r.Type() == int(types.Int) {
+					usedi[r.Id()] = true
+				} else {
+					usedf[r.Id()] = true
+				}
+			}
+		}
+	}
+
+	res := make([]regfile.Register, 0, s11-s2+fs11-fs2+5)
+	for i1, e1 := range usedi {
+		if e1 && (i1 == s1 || (s2 <= i1 && i1 <= s11)) {
+			res = append(res, rf.GetI(i1))
+		}
+	}
+	for i1, e1 := range usedf {
+		if e1 && ((fs0 <= i1 && i1 <= fs1) || (fs2 <= i1 && i1 <= fs11)) {
+			res = append(res, rf.GetF(i1))
+		}
+	}
+	return res
+}
+
+// newFrame returns the stack frame of Function fun, which holds its parameters, local variables, spill slots, RA and
+// FP and the callee-saved registers written by the function body. The size is aligned with the stack alignment.
+func (g *generator) newFrame(fun *lir.Function, rf RegisterFile) frame {
+	fr := frame{
+		saved:  calleeSaved(fun, rf),
+		canary: g.stackProtector,
+	}
+	fr.offsets = make([]int, 0, len(fr.saved))
+
+	// Integer registers go first, followed by floating point registers in slots aligned with their size.
+	size := 0
+	for _, e1 := range fr.saved {
+		if e1.Type() == int(types.Float) {
+			if res := size % savedSize; res != 0 {
+				size += savedSize - res
+			}
+			fr.offsets = append(fr.offsets, size)
+			size += savedSize
+		} else {
+			fr.offsets = append(fr.offsets, size)
+			size += g.wordSize
+		}
+	}
+	n := len(fun.Params()) + len(fun.Locals()) + spillSlots(fun) + 2 // RA and FP.
+	if fr.canary {
+		n++
+	}
+	fr.size = g.wordSize*n + size
+	if spill := fr.size % stackAlign; spill != 0 {
+		fr.size += stackAlign - spill
+	}
+	return fr
+}
+
+// param returns the frame pointer relative offset of the stack slot of parameter number id. Parameters go first on
+// the stack.
+func (g *generator) param(id int) int {
+	// Add 3 to offset: 1 to align for bottom-down, 2 for skipping stack saved RA and FP.
+	return -g.wordSize * (id + 3 + g.canarySlots())
+}
+
+// local returns the frame pointer relative offset of the stack slot of local variable number seq of Function fun.
+// Locals are stored after parameters.
+func (g *generator) local(fun *lir.Function, seq int) int {
+	return g.param(seq + len(fun.Params()))
+}
+
+// spillSlots returns the number of spill slots assigned to the values of Function fun by the register allocator.
+func spillSlots(fun *lir.Function) int {
+	res := 0
+	for _, e1 := range fun.Blocks() {
+		for _, e2 := range e1.Instructions() {
+			if n := e2.GetHW(); n != nil && n.Spill && n.Slot >= res {
+				res = n.Slot + 1
+			}
+		}
+	}
+	return res
+}
+
+// spillOffset returns the frame pointer relative offset of the spill slot of the spilled LiveNode n of Function fun.
+// Spill slots are stored after the parameters and local variables.
+func (g *generator) spillOffset(fun *lir.Function, n *lir.LiveNode) int {
+	// Add 3 to offset: 1 to align for bottom-down, 2 for skipping stack saved RA and FP.
+	return -g.wordSize * (n.Slot + 3 + g.canarySlots() + len(fun.Params()) + len(fun.Locals()))
+}
+
+// canarySlots returns the number of stack slots between RA and FP and the parameters, which hold the stack protector
+// canary if -fstack-protector is set.
+func (g *generator) canarySlots() int {
+	if g.stackProtector {
+		return 1
+	}
+	return 0
+}
+
+// spilled returns the LiveNode of LIR value v if v is spilled to a spill slot, and nil otherwise.
+func spilled(v lir.Value) *lir.LiveNode {
+	if v == nil {
+		return nil
+	}
+	if n := v.GetHW(); n != nil && n.Spill {
+		return n
+	}
+	return nil
+}
+
+// genReload loads the spilled operands of the LIR instruction v from their spill slots into the scratch registers,
+// and assigns a scratch register to the result of v if it's spilled. Function calls load spilled arguments by
+// themselves. Returns the LiveNodes that were assigned a scratch register, which are released by genSpill.
+func (g *generator) genReload(v lir.Value, fun *lir.Function, rf RegisterFile, wr *util.Writer) []*lir.LiveNode {
+	switch v.Type() {
+	case types.DataInstruction, types.LoadInstruction, types.StoreInstruction, types.Constant,
+		types.CastInstruction, types.PreserveInstruction, types.BranchInstruction, types.ReturnInstruction:
+		if v.DataType() == types.VaList {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	res := make([]*lir.LiveNode, 0, 3)
+	ii := 0
+	fi := 0
+	for _, e1 := range []lir.Value{v.Operand1(), v.Operand2()} {
+		n := spilled(e1)
+		if n == nil || n.Reg != nil {
+			// Not spilled or already loaded, because both operands are the same value.
+			continue
+		}
+		if isInt(rf, e1.DataType()) {
+			n.Reg = rf.GetI(scratchi[ii])
+			ii++
+		} else {
+			n.Reg = rf.GetF(scratchf[fi])
+			fi++
+		}
+		r := n.Reg
+		wr.Write("\t%s\t%s, %d(%s)\n", load(r), r.String(), g.spillOffset(fun, n), rf.FP().String())
+		res = append(res, n)
+	}
+
+	// The result may overwrite an operand's scratch register, because the operands are read first.
+	if n := spilled(v); n != nil {
+		if isInt(rf, v.DataType()) {
+			n.Reg = rf.GetI(scratchi[0])
+		} else {
+			n.Reg = rf.GetF(scratchf[0])
+		}
+		res = append(res, n)
+	}
+	return res
+}
+
+// genSpill stores the result of the LIR instruction v to its spill slot, if it's spilled, and releases the scratch
+// registers of the LiveNodes reloaded returned by genReload.
+func (g *generator) genSpill(v lir.Value, reloaded []*lir.LiveNode, fun *lir.Function, rf RegisterFile,
+	wr *util.Writer) {
+	if n := spilled(v); n != nil && n.Reg != nil {
+		r := n.Reg
+		wr.Write("\t%s\t%s, %d(%s)\n", store(r), r.String(), g.spillOffset(fun, n), rf.FP().String())
+	}
+	for _, e1 := range reloaded {
+		e1.Reg = nil
+	}
+}
+
+// locate returns err as a util.CodegenError at the source location of the LIR instruction v, which prefixes the message of
+// err if the location is known.
+func locate(v lir.Value, err error) error {
+	loc := v.Location()
+	return &util.CodegenError{Position: util.Position{Line: loc.Line, Pos: loc.Pos}, Err: err}
+}
@@ -0,0 +1,1057 @@
+// Package riscv provides means to generate RISC-V assembly code from the lightweight intermediate representation.
+package riscv
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+)
+
+import (
+	"vslc/src/backend"
+	"vslc/src/backend/regfile"
+	"vslc/src/backend/vslrt"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// register defines a physical register, of either type integer or floating point, and an index (x0-x31 or f0-f31).
+type register struct {
+	typ  int // Type of register (integer or floating point).
+	size int // Size of register in bits (64 or 32).
+	idx  int // Index of register (0 = x0, 10 = x10, 4 = f4 etc.).
+	use  int // Set to 1 if the register is allocated by GetNextTempI or GetNextTempF.
+}
+
+// generator holds the configuration of the RISC-V assembler generated for one compilation, which is derived from its
+// util.Options.
+type generator struct {
+	wordSize       int                // Size of the architecture word in bytes.
+	wordLabel      string             // Directive of the architecture word: dword for 64-bit, word for 32-bit.
+	fext           string             // Suffix of floating point instructions: d for double and s for single precision.
+	fbits          string             // Suffix of moves of float bits between register types: d for 64 and w for 32 bits.
+	iext           string             // Integer suffix of conversions: l for 64-bit and w for 32-bit integers.
+	wext           string             // Suffix of integer arithmetic: w for 32-bit integers on RV64, and empty otherwise.
+	pic            bool               // Set to true if global data is addressed through the global offset table.
+	stackProtector bool               // Set to true if prologues store a canary below RA, which epilogues check.
+	freestanding   bool               // Set to true if the program enters at _start and calls the runtime of genRuntime.
+	runtime        bool               // Set to true if main parses the command line arguments with the VSL runtime.
+	trapv          bool               // Set to true if checked integer operations call lir.LabelOverflow.
+	annotator      *backend.Annotator // Writes the source lines and LIR instructions of functions as comments, if set.
+}
+
+// RegisterFile defines a virtual register file during compilation time. It holds 32 integer and 32 floating point
+// registers per the RISC-V calling convention.
+type RegisterFile struct {
+	regi []regfile.Register
+	regf []regfile.Register
+	soft bool // Set to true if the ISA lacks floating point registers, such that floats are held in integer registers.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+const labelMain = "main"          // String literal of name of main function as defined in the output assembler.
+const labelConstant = "_L_CONST_" // String literal for all constants.
+const labelArgCount = "vsl.argc"  // Label of the word that holds argc for the builtin functions argc and arg.
+const labelArgVector = "vsl.argv" // Label of the word that holds argv for the builtin functions argc and arg.
+const labelArgEmpty = "vsl.empty" // Label of the empty string returned for program arguments out of range.
+
+// labelOverflowMsg is the label of the message written by the integer overflow handler lir.LabelOverflow.
+const labelOverflowMsg = "vsl.overflow.msg"
+
+const (
+	bitSize64  = 64 // Number of bits in 64-bit architecture.
+	bitSize32  = 32 // Number of bits in 32-bit architecture.
+	wordSize64 = 8  // Word size in bytes for 64-bit architecture.
+	wordSize32 = 4  // Word size in bytes for 32-bit architecture.
+)
+
+// savedSize defines the size in bytes of the stack slot of a callee-saved floating point register. The D extension's
+// 64-bit registers are preserved across calls in full, even if RV32 only computes single precision floats in them.
+const savedSize = 8
+
+// stackAlign defines the stack alignment of the RISC-V stack. If the stack grows or shrinks, it must do so in
+// multiples of the stackAlign value.
+const stackAlign = 16 // Per chapter 2.1 of the RISC-V calling convention.
+
+// paramReg defines the maximum number of arguments that can go in registers.
+const paramReg = 8
+
+const minImm = -2048 // minImm defines the minimum 12-bit signed immediate value.
+const maxImm = 2047  // maxImm defines the maximum 12-bit signed immediate value.
+
+// dwarfFloat defines the DWARF register number of f0. Integer registers are numbered 0-31.
+const dwarfFloat = 32
+
+const (
+	labelGuard     = "__stack_chk_guard" // labelGuard is the C library's stack protector canary.
+	labelGuardFail = "__stack_chk_fail"  // labelGuardFail is the C library's stack protector failure handler.
+)
+
+// Soft-float routines of libgcc that convert between single and double precision.
+const (
+	labelExtend   = "__extendsfdf2" // labelExtend promotes a float to a double.
+	labelTruncate = "__truncdfsf2"  // labelTruncate rounds a double to a float.
+)
+
+// Integer registers.
+const (
+	zero = iota // Hard-wired zero.
+	ra          // Return address.
+	sp          // Stack pointer.
+	gp          // Global pointer.
+	tp          // Thread pointer.
+	t0          // Temporary register 0.
+	t1          // Temporary register 1.
+	t2          // Temporary register 2.
+	s0          // Saved register 0, frame pointer.
+	s1          // Saved register 1.
+	a0          // Argument register 0 and return value register.
+	a1          // Argument register 1.
+	a2          // Argument register 2.
+	a3          // Argument register 3.
+	a4          // Argument register 4.
+	a5          // Argument register 5.
+	a6          // Argument register 6.
+	a7          // Argument register 7.
+	s2          // Saved register 2.
+	s3          // Saved register 3.
+	s4          // Saved register 4.
+	s5          // Saved register 5.
+	s6          // Saved register 6.
+	s7          // Saved register 7.
+	s8          // Saved register 8.
+	s9          // Saved register 9.
+	s10         // Saved register 10.
+	s11         // Saved register 11.
+	t3          // Temporary register 3.
+	t4          // Temporary register 4.
+	t5          // Temporary register 5.
+	t6          // Temporary register 6.
+)
+
+// Floating point registers.
+const (
+	ft0  = iota // Temporary register 0.
+	ft1         // Temporary register 1.
+	ft2         // Temporary register 2.
+	ft3         // Temporary register 3.
+	ft4         // Temporary register 4.
+	ft5         // Temporary register 5.
+	ft6         // Temporary register 6.
+	ft7         // Temporary register 7.
+	fs0         // Saved register 0.
+	fs1         // Saved register 1.
+	fa0         // Argument register 0 and return value register.
+	fa1         // Argument register 1.
+	fa2         // Argument register 2.
+	fa3         // Argument register 3.
+	fa4         // Argument register 4.
+	fa5         // Argument register 5.
+	fa6         // Argument register 6.
+	fa7         // Argument register 7.
+	fs2         // Saved register 2.
+	fs3         // Saved register 3.
+	fs4         // Saved register 4.
+	fs5         // Saved register 5.
+	fs6         // Saved register 6.
+	fs7         // Saved register 7.
+	fs8         // Saved register 8.
+	fs9         // Saved register 9.
+	fs10        // Saved register 10.
+	fs11        // Saved register 11.
+	ft8         // Temporary register 8.
+	ft9         // Temporary register 9.
+	ft10        // Temporary register 10.
+	ft11        // Temporary register 11.
+)
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// regi defines print friendly ABI names of the integer registers.
+var regi = [...]string{
+	"zero", "ra", "sp", "gp", "tp", "t0", "t1", "t2",
+	"s0", "s1", "a0", "a1", "a2", "a3", "a4", "a5",
+	"a6", "a7", "s2", "s3", "s4", "s5", "s6", "s7",
+	"s8", "s9", "s10", "s11", "t3", "t4", "t5", "t6",
+}
+
+// regf defines print friendly ABI names of the floating point registers.
+var regf = [...]string{
+	"ft0", "ft1", "ft2", "ft3", "ft4", "ft5", "ft6", "ft7",
+	"fs0", "fs1", "fa0", "fa1", "fa2", "fa3", "fa4", "fa5",
+	"fa6", "fa7", "fs2", "fs3", "fs4", "fs5", "fs6", "fs7",
+	"fs8", "fs9", "fs10", "fs11", "ft8", "ft9", "ft10", "ft11",
+}
+
+// tempi defines the integer registers that are handed out to virtual registers, in order of preference. Temporary
+// registers go first, then saved registers. The scratch registers are excluded.
+var tempi = [...]int{t0, t1, t2, t3, t4, s1, s2, s3, s4, s5, s6, s7, s8, s9, s10, s11}
+
+// tempf defines the floating point registers that are handed out to virtual registers, in order of preference. The
+// scratch registers are excluded.
+var tempf = [...]int{ft0, ft1, ft2, ft3, ft4, ft5, ft6, ft7, ft8, ft9,
+	fs0, fs1, fs2, fs3, fs4, fs5, fs6, fs7, fs8, fs9, fs10, fs11}
+
+// Scratch registers that hold spilled values while an instruction executes. Register t6 also holds the address of
+// global data. They're never allocated to virtual registers.
+var (
+	scratchi = [...]int{t5, t6}
+	scratchf = [...]int{ft10, ft11}
+)
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// GenRiscv generates RISC-V assembler code from the LIR Module m, whose registers have been allocated. The first
+// function of the syntax tree root is called from an implicit main function. Print statements and the parsing of
+// command line arguments call printf, strtol and strtod of the C standard library, hence only linux-gnu targets are
+// supported, unless opt.Freestanding is set. Freestanding output replaces the C library by a runtime that uses Linux
+// system calls, and optionally comes with a linker script written to opt.LinkerScript. ISAs without the D extension
+// pass floats in integer registers per the ilp32 and lp64 calling conventions, and compute them by the soft-float calls
+// of lir.LowerFloat, which must be linked with libgcc and the C math library.
+func GenRiscv(opt util.Options, m *lir.Module, root *ir.Node) error {
+	march, compressed, err := isa(opt)
+	if err != nil {
+		return err
+	}
+
+	// The calling convention follows from the ISA, which validate verified to match the ABI given.
+	opt.ABI = ""
+
+	// Generate .text section.
+	wr := opt.NewWriter()
+	defer wr.Close()
+	g := newGenerator(opt)
+	wr.Write("\t.file\t%q\n", filepath.Base(opt.Src))
+	wr.Write("\t.attribute\tarch, %q\n", march)
+	if compressed {
+		// Let the assembler compress instructions.
+		wr.Write("\t.option\trvc\n")
+	} else {
+		wr.Write("\t.option\tnorvc\n")
+	}
+	if g.pic {
+		wr.Write("\t.option\tpic\n")
+	} else {
+		wr.Write("\t.option\tnopic\n")
+	}
+	wr.Write("\t.text\n")
+	wr.Write("\t.globl\t%s\n", labelMain)
+	wr.Write("\t.type\t%s, @function\n", labelMain)
+
+	// Generate functions. The register file is only read, so it's shared by all worker go routines.
+	rf := CreateRegisterFile(opt)
+	ws := wr.Split(len(m.Functions()))
+	if opt.Threads > 1 {
+		// Parallel. Every function is generated to its own Writer, which are written in the order of the functions.
+		pool := opt.NewPool("codegen")
+		if err := pool.Run(opt.Context(), len(m.Functions()), func(w *util.Worker, i int) error {
+			e1 := m.Functions()[i]
+			if len(e1.Blocks()) < 1 {
+				return nil // External functions are only declared, and generate no code.
+			}
+			w.Log.Infof("generating function %s", e1.Name())
+			return g.genFunction(e1, rf, &ws[i])
+		}); err != nil {
+			return err
+		}
+	} else {
+		// Sequential.
+		for i1, e1 := range m.Functions() {
+			if err := g.genFunction(e1, rf, &ws[i1]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Generate main function.
+	// Find first defined function, which will be called implicitly from main.
+	var callee *lir.Function
+	for _, e1 := range root.Children {
+		if e1.Typ == ir.FUNCTION {
+			if callee = m.GetFunction(e1.Children[0].Data.Str); callee == nil {
+				return errors.New("no functions defined for module")
+			}
+			break
+		}
+	}
+
+	// Generate implicit main function for program entry.
+	builtins := m.UsesArgs()
+	if err := g.genMain(rf, callee, builtins, &wr); err != nil {
+		return err
+	}
+	if builtins {
+		g.genArgs(rf, &wr)
+	}
+	if g.trapv {
+		g.genOverflow(rf, &wr)
+	}
+	if g.freestanding {
+		g.genStart(rf, &wr)
+		g.genRuntime(rf, &wr)
+	}
+
+	// Generate global data.
+	wr.Write("\n\t.data\n")
+	wr.Write("\t.align\t3\n")
+	for _, e1 := range m.Globals() {
+		wr.Label(e1.Name())
+		// Write globals with initial values 0. VSL doesn't support variable initialisation on declaration.
+		wr.Write("\t.%s\t0x0\n", g.wordLabel)
+	}
+	if builtins {
+		wr.Label(labelArgCount)
+		wr.Write("\t.%s\t0x0\n", g.wordLabel)
+		wr.Label(labelArgVector)
+		wr.Write("\t.%s\t0x0\n", g.wordLabel)
+	}
+	if g.freestanding && g.stackProtector {
+		g.genGuard(&wr)
+	}
+
+	// Generate constant data.
+	for _, e1 := range m.Constants() {
+		// Only write constants that have been used. Integer constants are always synthesised by li.
+		if e1.Used() {
+			wr.Label(fmt.Sprintf("%s%d", labelConstant, e1.GlobalSeq()))
+			if g.fext == "s" {
+				fl := math.Float32bits(float32(e1.Value().(float64)))
+				wr.Write("\t.word\t0x%x\t# %f\n", fl, e1.Value().(float64))
+			} else {
+				fl := math.Float64bits(e1.Value().(float64))
+				wr.Write("\t.dword\t0x%x\t# %f\n", fl, e1.Value().(float64))
+			}
+		}
+	}
+
+	// Generate string data.
+	for _, e1 := range m.Strings() {
+		wr.Label(e1.Name())
+		wr.Write("\t.asciz\t%q\n", e1.Value())
+	}
+	if builtins {
+		wr.Label(labelArgEmpty)
+		wr.Write("\t.asciz\t\"\"\n")
+	}
+	if g.trapv {
+		wr.Label(labelOverflowMsg)
+		wr.Write("\t.asciz\t%q\n", lir.OverflowMsg)
+	}
+
+	// Mark the stack as non-executable.
+	wr.Write("\n\t.section\t.note.GNU-stack,\"\",@progbits\n")
+	if len(opt.LinkerScript) > 0 {
+		return writeLinkerScript(opt.LinkerScript)
+	}
+	return nil
+}
+
+// newGenerator returns the generator of the RISC-V assembler of the compilation configured by opt, which holds the
+// word size, integer width and floating point precision of the target architecture opt.TargetArch. RV64 computes
+// floats in double precision with the D extension. RV32 computes floats in single precision with the F extension, like
+// the LLVM backend, but follows the ilp32d calling convention, such that printf can be passed doubles. 32-bit integers
+// on RV64 are kept sign extended in 64-bit registers, as the w-suffixed instructions compute them.
+func newGenerator(opt util.Options) *generator {
+	g := &generator{
+		wordSize:       wordSize64,
+		wordLabel:      "dword",
+		fext:           "d",
+		fbits:          "d",
+		iext:           "l",
+		pic:            opt.PIC,
+		stackProtector: opt.SSP,
+		freestanding:   opt.Freestanding,
+		runtime:        opt.Runtime,
+		trapv:          opt.Trapv,
+		annotator:      backend.NewAnnotator(opt, "#"),
+	}
+	if opt.TargetArch == util.Riscv32 {
+		g.wordSize, g.wordLabel = wordSize32, "word"
+		g.fext, g.fbits, g.iext = "s", "w", "w"
+	} else if opt.IntBits() == 32 {
+		g.iext, g.wext = "w", "w"
+	}
+	return g
+}
+
+// validate returns an error if the configuration opt isn't supported by the RISC-V target, such as an ISA string
+// that doesn't match the target architecture or ABI, or a combination of options that requires the C library, or
+// freestanding output.
+func validate(opt util.Options) error {
+	if !opt.Freestanding && (opt.TargetOS == util.Windows || opt.TargetOS == util.MAC) {
+		return errors.New("RISC-V code generation requires a linux-gnu target")
+	}
+	if !opt.Freestanding && len(opt.LinkerScript) > 0 {
+		return errors.New("linker script requires freestanding output")
+	}
+	if opt.Freestanding && opt.SoftFloat() {
+		return errors.New("freestanding output requires the D extension")
+	}
+	if opt.Freestanding && opt.Runtime {
+		return errors.New("the VSL runtime library requires the C library")
+	}
+	if opt.TargetArch == util.Riscv32 && opt.IntBits() == 64 {
+		return errors.New("64-bit integers are not supported for RV32")
+	}
+	march, _, err := isa(opt)
+	if err != nil {
+		return err
+	}
+	if abi := opt.ABI; len(abi) > 0 {
+		opt.ABI = ""
+		if abi != opt.TargetABI() {
+			return fmt.Errorf("ABI %s doesn't match ISA %s, which uses %s", abi, march, opt.TargetABI())
+		}
+	}
+	return nil
+}
+
+// isa returns the ISA string opt.March, or rv64gc or rv32gc for the target architecture if it isn't set, and true if
+// the ISA includes the C extension. An error is returned if the ISA doesn't match the target architecture, or if it
+// lacks the M extension required by the generated code. ISAs without the D extension use soft-float.
+func isa(opt util.Options) (string, bool, error) {
+	base := "rv64"
+	if opt.TargetArch == util.Riscv32 {
+		base = "rv32"
+	}
+	if len(opt.March) == 0 {
+		return base + "gc", true, nil
+	}
+	if !strings.HasPrefix(opt.March, base) {
+		return "", false, fmt.Errorf("ISA %s doesn't match target architecture %s", opt.March, base)
+	}
+	ext := util.Extensions(opt.March)
+	if !ext['i'] {
+		return "", false, fmt.Errorf("ISA %s doesn't have base integer instruction set I", opt.March)
+	}
+	if !ext['m'] {
+		return "", false, fmt.Errorf("ISA %s lacks the M extension", opt.March)
+	}
+	return opt.March, ext['c'], nil
+}
+
+// fop returns the floating point instruction op with the suffix of the target's floating point precision.
+func (g *generator) fop(op string) string {
+	return op + "." + g.fext
+}
+
+// genTruncate sign extends the lower word of the long in register r, if integers are 32 bits wide on RV64.
+func (g *generator) genTruncate(r regfile.Register, wr *util.Writer) {
+	if g.wext == "w" {
+		wr.Write("\tsext.w\t%s, %s\n", r.String(), r.String())
+	}
+}
+
+// genMain generates an implicit main function that checks input command-line arguments and calls the function callee.
+// After the function callee returns the main function exits the program with the return value of the call to callee.
+// If the return value of callee is a floating point value, the value is cast to integer. If builtins is set, argc and
+// argv are stored for the builtin functions argc and arg, and any number of arguments is accepted if callee has no
+// parameters.
+func (g *generator) genMain(rf RegisterFile, callee *lir.Function, builtins bool, wr *util.Writer) error {
+	if callee == nil {
+		return errors.New("no functions defined for module")
+	}
+	wr.Write("\n\t.align\t2\n")
+	wr.Label(labelMain)
+	wr.Write("\t.cfi_startproc\n")
+
+	// Stack from top to bottom. Register s1 holds the index of the argument being parsed, for error reporting.
+	//
+	// TOP
+	// <--- FP
+	// RA
+	// FP
+	// argc
+	// **argv
+	// parsed argument 0
+	// parsed argument 1
+	// ...
+	// parsed argument n
+	// s1
+	// <--- SP
+	//
+	// BOTTOM
+	fr := frame{saved: []regfile.Register{rf.GetI(s1)}, offsets: []int{0}}
+	fr.size = g.wordSize * (5 + len(callee.Params()))
+	if res := fr.size % stackAlign; res != 0 {
+		fr.size += stackAlign - res
+	}
+	if fr.size > maxImm {
+		return fmt.Errorf("function %s has too many parameters", callee.Name())
+	}
+	fpOffsetArgc := -g.wordSize * 3 // Offset of argc on stack from FP.
+	fpOffsetArgv := -g.wordSize * 4 // Offset of argv on stack from FP.
+	arg := func(i1 int) int {
+		// Offset of parsed argument i1 on stack from FP. The runtime library stores arguments in ascending order.
+		if g.runtime {
+			return fpOffsetArgv - g.wordSize*(len(callee.Params())-i1)
+		}
+		return fpOffsetArgv - g.wordSize*(i1+1)
+	}
+
+	g.genPrologue(fr, rf, wr)
+	fp := rf.FP().String()
+	wr.Write("\t%s\t%s, %d(%s)\n", storeWord(rf.GetI(a0)), rf.GetI(a0).String(), fpOffsetArgc, fp)
+	wr.Write("\t%s\t%s, %d(%s)\n", storeWord(rf.GetI(a1)), rf.GetI(a1).String(), fpOffsetArgv, fp)
+	if builtins {
+		genAccess(storeWord(rf.GetI(a0)), rf.GetI(a0), rf.GetI(t0), labelArgCount, wr)
+		genAccess(storeWord(rf.GetI(a1)), rf.GetI(a1), rf.GetI(t0), labelArgVector, wr)
+	}
+	check := !builtins || len(callee.Params()) > 0 // Arguments are read by the builtin functions otherwise.
+
+	// Jump labels for error checking.
+	largcok := "_L_argc_ok"     // Jump to label if argc matches parameter count of callee.
+	largverr := "_L_argv_error" // Jump to label if parameter is not integer or float.
+
+	if g.runtime && check {
+		// Parse and store arguments on stack using the runtime library, which exits on errors. The arguments argc and
+		// argv are still in a0 and a1. The types string and the arguments are null if callee has no parameters.
+		if len(callee.Params()) > 0 {
+			genAddress(rf.GetI(a2), callee.CreateGlobalString(vslrt.Types(callee)).Name(), wr)
+			wr.Write("\taddi\t%s, %s, %d\n", rf.GetI(a3).String(), fp, arg(0))
+		} else {
+			wr.Write("\tli\t%s, 0\n", rf.GetI(a2).String())
+			wr.Write("\tli\t%s, 0\n", rf.GetI(a3).String())
+		}
+		wr.Write("\tcall\t%s\n", g.symbol(vslrt.LabelArgs))
+	} else if check {
+		// Check parameter count and argc. First argument is application path.
+		wr.Write("\taddi\t%s, %s, -1\n", rf.GetI(a1).String(), rf.GetI(a0).String())
+		wr.Write("\tli\t%s, %d\n", rf.GetI(t0).String(), len(callee.Params()))
+		wr.Write("\tbeq\t%s, %s, %s\n", rf.GetI(a1).String(), rf.GetI(t0).String(), largcok)
+
+		// argc is not ok.
+		var errstr *lir.String
+		if len(callee.Params()) == 1 {
+			errstr = callee.CreateGlobalString("Argument error: expected 1 argument, got %d\n")
+		} else {
+			errstr = callee.CreateGlobalString(fmt.Sprintf("Argument error: expected %d arguments, got %%d\n", len(callee.Params())))
+		}
+
+		// Load format string and call printf.
+		genAddress(rf.GetI(a0), errstr.Name(), wr)
+		wr.Write("\tcall\t%s\n", g.symbol("printf"))
+
+		// Set return code and return.
+		wr.Write("\tli\t%s, 1\n", rf.GetI(a0).String())
+		g.genEpilogue(fr, rf, wr)
+
+		// argc is ok.
+		wr.Label(largcok)
+
+		// Parse and store on stack to avoid overwriting during strtol/strtod calls. The end pointer of the parse is
+		// stored in the stack slot of the argument until the argument is verified.
+		for i1, e1 := range callee.Params() {
+			// Put the i'th element of argv into a0 and the address of the end pointer into a1 for strtol or strtod.
+			wr.Write("\t%s\t%s, %d(%s)\t# Load argv\n", loadWord(rf.GetI(t0)), rf.GetI(t0).String(), fpOffsetArgv, fp)
+			wr.Write("\t%s\t%s, %d(%s)\t# Load argv[%d]\n",
+				loadWord(rf.GetI(a0)), rf.GetI(a0).String(), g.wordSize*(i1+1), rf.GetI(t0).String(), i1+1)
+			wr.Write("\taddi\t%s, %s, %d\n", rf.GetI(a1).String(), fp, arg(i1))
+
+			// Save current argv index in s1 for error reporting.
+			wr.Write("\tli\t%s, %d\n", rf.GetI(s1).String(), i1+1)
+
+			if e1.DataType() == types.Int {
+				// Parse argv[i1+1] as decimal int using strtol.
+				wr.Write("\tli\t%s, 10\n", rf.GetI(a2).String())
+				wr.Write("\tcall\t%s\n", g.symbol("strtol"))
+				g.genTruncate(rf.GetI(a0), wr)
+			} else if rf.soft {
+				// Parse argv[i1+1] as float using strtod, which returns a double in integer registers.
+				wr.Write("\tcall\t%s\n", g.symbol("strtod"))
+				if g.fext != "d" {
+					wr.Write("\tcall\t%s\n", labelTruncate)
+				}
+			} else {
+				// Parse argv[i1+1] as float using strtod, which returns a double.
+				wr.Write("\tcall\t%s\n", g.symbol("strtod"))
+				if g.fext != "d" {
+					wr.Write("\t%s\t%s, %s\n", g.fop("fcvt")+".d", rf.GetF(fa0).String(), rf.GetF(fa0).String())
+				}
+			}
+
+			// Verify that argument was non-empty and parsed to its end.
+			wr.Write("\t%s\t%s, %d(%s)\t# Load end pointer\n", loadWord(rf.GetI(t1)), rf.GetI(t1).String(), arg(i1), fp)
+			wr.Write("\t%s\t%s, %d(%s)\n", loadWord(rf.GetI(t0)), rf.GetI(t0).String(), fpOffsetArgv, fp)
+			wr.Write("\t%s\t%s, %d(%s)\n",
+				loadWord(rf.GetI(t2)), rf.GetI(t2).String(), g.wordSize*(i1+1), rf.GetI(t0).String())
+			wr.Write("\tbeq\t%s, %s, %s\n", rf.GetI(t1).String(), rf.GetI(t2).String(), largverr)
+			wr.Write("\tlbu\t%s, 0(%s)\n", rf.GetI(t2).String(), rf.GetI(t1).String())
+			wr.Write("\tbnez\t%s, %s\n", rf.GetI(t2).String(), largverr)
+			if e1.DataType() == types.Float && !rf.soft {
+				wr.Write("\t%s\t%s, %d(%s)\n", store(rf.GetF(fa0)), rf.GetF(fa0).String(), arg(i1), fp)
+			} else {
+				wr.Write("\t%s\t%s, %d(%s)\n", storeWord(rf.GetI(a0)), rf.GetI(a0).String(), arg(i1), fp)
+			}
+		}
+	}
+
+	// Count arguments passed on stack.
+	ii, fi, stack := 0, 0, 0
+	for _, e1 := range callee.Params() {
+		if nextArgument(rf, e1.DataType() == types.Float, false, &ii, &fi) == nil {
+			stack++
+		}
+	}
+	size := stack * g.wordSize
+	if res := size % stackAlign; res != 0 {
+		size += stackAlign - res
+	}
+	if size > 0 {
+		wr.Write("\taddi\t%s, %s, %d\n", rf.SP().String(), rf.SP().String(), -size)
+	}
+
+	// Load arguments from stack into registers or pass on stack.
+	ii, fi = 0, 0
+	si := 0 // Index of next stack slot.
+	for i1, e1 := range callee.Params() {
+		isFloat := e1.DataType() == types.Float
+		if r := nextArgument(rf, isFloat, false, &ii, &fi); r != nil {
+			wr.Write("\t%s\t%s, %d(%s)\t# Load parsed argv[%d]\n", load(r), r.String(), arg(i1), fp, i1+1)
+		} else {
+			tmp := rf.GetI(t0)
+			wr.Write("\t%s\t%s, %d(%s)\n", loadWord(tmp), tmp.String(), arg(i1), fp)
+			wr.Write("\t%s\t%s, %d(%s)\n", storeWord(tmp), tmp.String(), g.wordSize*si, rf.SP().String())
+			si++
+		}
+	}
+
+	// Call VSL callee function.
+	wr.Write("\tcall\t%s\n", callee.Name())
+	if size > 0 {
+		wr.Write("\taddi\t%s, %s, %d\n", rf.SP().String(), rf.SP().String(), size)
+	}
+
+	// Convert float result from fa0 to a0 if necessary. Soft-float rounds the float in a0 by the C math library.
+	if callee.DataType() == types.Float && rf.soft {
+		wr.Write("\tcall\t%s\n", choose(g.fext == "d", "lrint", "lrintf"))
+	} else if callee.DataType() == types.Float {
+		// Round to nearest.
+		wr.Write("\tfcvt.%s.%s\t%s, %s, rne\n", g.iext, g.fext, rf.GetI(a0).String(), rf.GetF(fa0).String())
+	}
+
+	// De-allocate stack and return, result from callee is already in a0.
+	g.genEpilogue(fr, rf, wr)
+
+	if len(callee.Params()) > 0 && !g.runtime {
+		// argv errors jump here.
+		wr.Label(largverr)
+		errstr := callee.CreateGlobalString("Argument error: argument %ld is neither int nor float\n")
+
+		// Load format string and call printf.
+		genAddress(rf.GetI(a0), errstr.Name(), wr)
+		wr.Write("\tmv\t%s, %s\n", rf.GetI(a1).String(), rf.GetI(s1).String()) // Move saved argument index into a1.
+		wr.Write("\tcall\t%s\n", g.symbol("printf"))
+
+		// Set return code and return.
+		wr.Write("\tli\t%s, 1\n", rf.GetI(a0).String())
+		g.genEpilogue(fr, rf, wr)
+	}
+	genProcEnd(labelMain, wr)
+	return nil
+}
+
+// genArgs generates the runtime routines of the builtin functions argc and arg, which read argc and argv as stored by
+// the implicit main function. Program arguments out of range are the empty string, which strtol and strtod parse as
+// zero. The end pointer of the parse is stored in the stack frame, because freestanding strtol and strtod require it.
+func (g *generator) genArgs(rf RegisterFile, wr *util.Writer) {
+	ra0, rt0 := rf.GetI(a0), rf.GetI(t0)
+	start := func(name string) {
+		wr.Write("\n\t.align\t2\n")
+		wr.Write("\t.type\t%s, @function\n", name)
+		wr.Label(name)
+		wr.Write("\t.cfi_startproc\n")
+	}
+
+	// argc returns the number of arguments, which excludes the program name.
+	start(lir.LabelArgc)
+	genAccess(loadWord(ra0), ra0, ra0, labelArgCount, wr)
+	wr.Write("\taddi\t%s, %s, -1\n", ra0.String(), ra0.String())
+	wr.Write("\tret\n")
+	genProcEnd(lir.LabelArgc, wr)
+
+	// The string of argument a0 is argv[a0], or the empty string if a0 is out of range.
+	start(lir.LabelArgString)
+	genAccess(loadWord(rt0), rt0, rt0, labelArgCount, wr)
+	wr.Write("\tblez\t%s, 2f\n", ra0.String())
+	wr.Write("\tbge\t%s, %s, 2f\n", ra0.String(), rt0.String())
+	genAccess(loadWord(rt0), rt0, rt0, labelArgVector, wr)
+	wr.Write("\tslli\t%s, %s, %s\n", ra0.String(), ra0.String(), choose(g.wordSize == wordSize64, "3", "2"))
+	wr.Write("\tadd\t%s, %s, %s\n", rt0.String(), rt0.String(), ra0.String())
+	wr.Write("\t%s\t%s, 0(%s)\n", loadWord(ra0), ra0.String(), rt0.String())
+	wr.Write("\tret\n")
+	wr.Write("2:\n")
+	genAddress(ra0, labelArgEmpty, wr)
+	wr.Write("\tret\n")
+	genProcEnd(lir.LabelArgString, wr)
+
+	// Integer and float arguments parse the string of the argument. The end pointer is stored at the bottom of the
+	// frame, below RA.
+	sp := rf.SP().String()
+	for _, e1 := range []string{lir.LabelArgInt, lir.LabelArgFloat} {
+		start(e1)
+		wr.Write("\taddi\t%s, %s, %d\n", sp, sp, -stackAlign)
+		wr.Write("\t.cfi_def_cfa_offset\t%d\n", stackAlign)
+		wr.Write("\t%s\t%s, %d(%s)\n", storeWord(rf.LR()), rf.LR().String(), stackAlign-g.wordSize, sp)
+		wr.Write("\t.cfi_offset\t%d, %d\n", dwarfReg(rf.LR()), -g.wordSize)
+		wr.Write("\tcall\t%s\n", lir.LabelArgString)
+		wr.Write("\tmv\t%s, %s\n", rf.GetI(a1).String(), sp)
+		switch {
+		case e1 == lir.LabelArgInt:
+			wr.Write("\tli\t%s, 10\n", rf.GetI(a2).String())
+			wr.Write("\tcall\t%s\n", g.symbol("strtol"))
+			g.genTruncate(rf.GetI(a0), wr)
+		case rf.soft:
+			// Soft-float strtod returns a double in integer registers.
+			wr.Write("\tcall\t%s\n", g.symbol("strtod"))
+			if g.fext != "d" {
+				wr.Write("\tcall\t%s\n", labelTruncate)
+			}
+		default:
+			wr.Write("\tcall\t%s\n", g.symbol("strtod"))
+			if g.fext != "d" {
+				wr.Write("\t%s\t%s, %s\n", g.fop("fcvt")+".d", rf.GetF(fa0).String(), rf.GetF(fa0).String())
+			}
+		}
+		wr.Write("\t%s\t%s, %d(%s)\n", loadWord(rf.LR()), rf.LR().String(), stackAlign-g.wordSize, sp)
+		wr.Write("\taddi\t%s, %s, %d\n", sp, sp, stackAlign)
+		wr.Write("\t.cfi_def_cfa_offset\t0\n")
+		wr.Write("\tret\n")
+		genProcEnd(e1, wr)
+	}
+}
+
+// genOverflow generates the handler of integer overflows, which writes the overflow message to stderr and aborts the
+// program. Freestanding output writes the message by a system call and exits with the exit code of abort, like the
+// stack protector failure handler of genRuntime.
+func (g *generator) genOverflow(rf RegisterFile, wr *util.Writer) {
+	wr.Write("\n\t.align\t2\n")
+	wr.Write("\t.type\t%s, @function\n", lir.LabelOverflow)
+	wr.Label(lir.LabelOverflow)
+	wr.Write("\t.cfi_startproc\n")
+	wr.Write("\tli\t%s, 2\n", rf.GetI(a0).String())
+	genAddress(rf.GetI(a1), labelOverflowMsg, wr)
+	if g.freestanding {
+		wr.Write("\tli\t%s, %d\n", rf.GetI(a2).String(), len(lir.OverflowMsg))
+		wr.Write("\tli\t%s, %d\n", rf.GetI(a7).String(), sysWrite)
+		wr.Write("\tecall\n")
+		wr.Write("\tli\t%s, 134\n", rf.GetI(a0).String()) // Exit code of abort.
+		genExit(rf, wr)
+	} else {
+		wr.Write("\tcall\t%s\n", g.symbol("dprintf"))
+		wr.Write("\tcall\t%s\n", g.symbol("abort"))
+	}
+	genProcEnd(lir.LabelOverflow, wr)
+}
+
+// CreateRegisterFile returns a new RISC-V RegisterFile, with 32-bit or 64-bit integer registers depending on the target
+// architecture opt.TargetArch. The size of floating point registers is the size of the floats computed in them, which
+// is 32-bit for RV32 and 64-bit for RV64. No floating point registers are allocated if opt.SoftFloat is set.
+func CreateRegisterFile(opt util.Options) RegisterFile {
+	size := bitSize64
+	if opt.TargetArch == util.Riscv32 {
+		size = bitSize32
+	}
+	rf := RegisterFile{
+		regi: make([]regfile.Register, len(regi)),
+		regf: make([]regfile.Register, len(regf)),
+		soft: opt.SoftFloat(),
+	}
+
+	// Initiate registers.
+	for i1 := range rf.regi {
+		rf.regi[i1] = &register{
+			typ:  int(types.Int),
+			size: size,
+			idx:  i1,
+		}
+		rf.regf[i1] = &register{
+			typ:  int(types.Float),
+			size: size,
+			idx:  i1,
+		}
+	}
+	return rf
+}
+
+// genAddress generates the instructions that put the address of the label of module data in integer register dst. The
+// auipc and addi pair is paired by a numbered local label referenced by %pcrel_lo, which is position-independent and
+// may be relaxed by the linker.
+func genAddress(dst regfile.Register, label string, wr *util.Writer) {
+	wr.Write("1:\tauipc\t%s, %%pcrel_hi(%s)\n", dst.String(), label)
+	wr.Write("\taddi\t%s, %s, %%pcrel_lo(1b)\n", dst.String(), dst.String())
+}
+
+// genAccess generates the load or store op of register r from or to the word at the label of module data, using
+// integer register tmp to hold the upper part of the pc-relative address, like genAddress.
+func genAccess(op string, r, tmp regfile.Register, label string, wr *util.Writer) {
+	wr.Write("1:\tauipc\t%s, %%pcrel_hi(%s)\n", tmp.String(), label)
+	wr.Write("\t%s\t%s, %%pcrel_lo(1b)(%s)\n", op, r.String(), tmp.String())
+}
+
+// genExternal generates the load of the word at the external label into integer register r. The la pseudo-instruction
+// loads the address from the global offset table if position-independent code is generated.
+func genExternal(r regfile.Register, label string, wr *util.Writer) {
+	wr.Write("\tla\t%s, %s\n", r.String(), label)
+	wr.Write("\t%s\t%s, 0(%s)\n", loadWord(r), r.String(), r.String())
+}
+
+// genCanary generates the store of the stack protector canary to offset(fp), using integer register tmp to load the
+// canary.
+func (g *generator) genCanary(fp, tmp regfile.Register, offset int, wr *util.Writer) {
+	genExternal(tmp, g.symbol(labelGuard), wr)
+	wr.Write("\t%s\t%s, %d(%s)\n", storeWord(tmp), tmp.String(), offset, fp.String())
+}
+
+// genCanaryCheck generates the comparison of the stack protector canary at offset(fp) with the C library's canary,
+// using integer registers tmp1 and tmp2, and the call to the failure handler if they differ. The failure handler
+// doesn't return.
+func (g *generator) genCanaryCheck(fp, tmp1, tmp2 regfile.Register, offset int, wr *util.Writer) {
+	wr.Write("\t%s\t%s, %d(%s)\n", loadWord(tmp1), tmp1.String(), offset, fp.String())
+	genExternal(tmp2, g.symbol(labelGuard), wr)
+	wr.Write("\tbeq\t%s, %s, 2f\n", tmp1.String(), tmp2.String())
+	wr.Write("\tcall\t%s\n", g.symbol(labelGuardFail))
+	wr.Write("2:\n")
+}
+
+// load returns the instruction that loads a value of the size of register r into it.
+func load(r regfile.Register) string {
+	if r.Type() != int(types.Float) {
+		return loadWord(r)
+	}
+	if r.(*register).size == bitSize32 {
+		return "flw"
+	}
+	return "fld"
+}
+
+// store returns the instruction that stores a value of the size of register r from it.
+func store(r regfile.Register) string {
+	if r.Type() != int(types.Float) {
+		return storeWord(r)
+	}
+	if r.(*register).size == bitSize32 {
+		return "fsw"
+	}
+	return "fsd"
+}
+
+// loadWord returns the instruction that loads a pointer sized word into integer register r.
+func loadWord(r regfile.Register) string {
+	switch r.(*register).size {
+	case bitSize64:
+		return "ld"
+	case bitSize32:
+		return "lw"
+	default:
+		panic(fmt.Sprintf("compiler error: unexpected register size %d", r.(*register).size))
+	}
+}
+
+// storeWord returns the instruction that stores a pointer sized word from integer register r.
+func storeWord(r regfile.Register) string {
+	switch r.(*register).size {
+	case bitSize64:
+		return "sd"
+	case bitSize32:
+		return "sw"
+	default:
+		panic(fmt.Sprintf("compiler error: unexpected register size %d", r.(*register).size))
+	}
+}
+
+// ----------------------------
+// ----- Register methods -----
+// ----------------------------
+
+// String returns the assembler string of the register.
+func (r register) String() string {
+	if r.typ == int(types.Int) {
+		return regi[r.idx]
+	}
+	return regf[r.idx]
+}
+
+// Id returns the index of the register r.
+func (r register) Id() int {
+	return r.idx
+}
+
+// Type returns the register type, 0 = integer and 1 = floating point.
+func (r register) Type() int {
+	return r.typ
+}
+
+// Used returns true if the register has been allocated (is in use).
+func (r register) Used() bool {
+	return r.use == 1
+}
+
+// ---------------------------------
+// ----- Register file methods -----
+// ---------------------------------
+
+// GetI returns integer register with index i.
+func (rf RegisterFile) GetI(i int) regfile.Register {
+	if i < 0 || i >= len(rf.regi) {
+		return nil
+	}
+	return rf.regi[i]
+}
+
+// GetF returns floating point register with index i.
+func (rf RegisterFile) GetF(i int) regfile.Register {
+	if i < 0 || i >= len(rf.regf) {
+		return nil
+	}
+	return rf.regf[i]
+}
+
+// ArgI returns the integer argument register with index i, where a0 is the return value register.
+func (rf RegisterFile) ArgI(i int) regfile.Register {
+	if i < 0 || i >= paramReg {
+		return nil
+	}
+	return rf.regi[a0+i]
+}
+
+// ArgF returns the floating point argument register with index i, where fa0 is the return value register. Soft-float
+// passes floats in integer argument registers, hence nil is returned.
+func (rf RegisterFile) ArgF(i int) regfile.Register {
+	if i < 0 || i >= paramReg || rf.soft {
+		return nil
+	}
+	return rf.regf[fa0+i]
+}
+
+// GetNextTempI returns the next available integer register that hasn't been allocated yet.
+// If no registers are vacant, <nil> is returned.
+func (rf RegisterFile) GetNextTempI() regfile.Register {
+	return nextTemp(rf.regi, tempi[:], nil)
+}
+
+// GetNextTempF returns the next available floating point register that hasn't been allocated yet.
+// If no registers are vacant, <nil> is returned.
+func (rf RegisterFile) GetNextTempF() regfile.Register {
+	if rf.soft {
+		return nil
+	}
+	return nextTemp(rf.regf, tempf[:], nil)
+}
+
+// GetNextTempIExclude returns the next available integer register that hasn't been allocated yet and is
+// not in the exclusion list. If no registers are vacant, <nil> is returned.
+func (rf RegisterFile) GetNextTempIExclude(exc []regfile.Register) regfile.Register {
+	return nextTemp(rf.regi, tempi[:], exc)
+}
+
+// GetNextTempFExclude returns the next available floating point register that hasn't been allocated yet and is
+// not in the exclusion list. If no registers are vacant, <nil> is returned.
+func (rf RegisterFile) GetNextTempFExclude(exc []regfile.Register) regfile.Register {
+	if rf.soft {
+		return nil
+	}
+	return nextTemp(rf.regf, tempf[:], exc)
+}
+
+// nextTemp returns the first register of regs, indexed by the temporary registers temps, that is not in the exclusion
+// list exc. If exc is nil, the register must not have been allocated, and is marked as allocated. If no registers are
+// vacant, <nil> is returned.
+func nextTemp(regs []regfile.Register, temps []int, exc []regfile.Register) regfile.Register {
+	for _, e1 := range temps {
+		r := regs[e1].(*register)
+		if exc == nil {
+			if r.use == 0 {
+				r.use = 1
+				return r
+			}
+			continue
+		}
+		excluded := false
+		for _, e2 := range exc {
+			if e2.Id() == r.idx && e2.Type() == r.typ {
+				// Register already in use by neighbour.
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			return r
+		}
+	}
+	return nil
+}
+
+// FreeI frees integer register with index i.
+func (rf RegisterFile) FreeI(i int) {
+	if i < 0 || i >= len(rf.regi) {
+		return
+	}
+	rf.regi[i].(*register).use = 0
+}
+
+// FreeF frees floating point register with index i.
+func (rf RegisterFile) FreeF(i int) {
+	if i < 0 || i >= len(rf.regf) {
+		return
+	}
+	rf.regf[i].(*register).use = 0
+}
+
+// SP returns a pointer to the register file's stack pointer.
+func (rf RegisterFile) SP() regfile.Register {
+	return rf.regi[sp]
+}
+
+// FP returns a pointer to the register file's frame pointer.
+func (rf RegisterFile) FP() regfile.Register {
+	return rf.regi[s0]
+}
+
+// LR returns a pointer to the register file's return address register.
+func (rf RegisterFile) LR() regfile.Register {
+	return rf.regi[ra]
+}
+
+// CallerSaved returns the registers that are clobbered by function calls: t0-t6, a0-a7, ft0-ft11 and fa0-fa7. The
+// floating point registers are omitted for soft-float.
+func (rf RegisterFile) CallerSaved() []regfile.Register {
+	res := make([]regfile.Register, 0, 7+8+12+8)
+	res = append(res, rf.regi[t0:t2+1]...)
+	res = append(res, rf.regi[a0:a7+1]...)
+	res = append(res, rf.regi[t3:t6+1]...)
+	if rf.soft {
+		return res
+	}
+	res = append(res, rf.regf[ft0:ft7+1]...)
+	res = append(res, rf.regf[fa0:fa7+1]...)
+	return append(res, rf.regf[ft8:ft11+1]...)
+}
+
+// Ki returns the number of usable temporary integer registers.
+func (rf RegisterFile) Ki() int {
+	return len(tempi)
+}
+
+// Kf returns the number of usable temporary floating point registers, which is zero for soft-float.
+func (rf RegisterFile) Kf() int {
+	if rf.soft {
+		return 0
+	}
+	return len(tempf)
+}
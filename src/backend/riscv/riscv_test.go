@@ -0,0 +1,47 @@
+package riscv
+
+import (
+	"testing"
+	"vslc/src/util"
+)
+
+// TestISA verifies that ISA strings are checked against the target architecture and the required extensions, and that
+// the C extension and soft-float ISAs are detected.
+func TestISA(t *testing.T) {
+	tests := []struct {
+		arch       int
+		march      string
+		exp        string
+		compressed bool
+		soft       bool
+		ok         bool
+	}{
+		{util.Riscv64, "", "rv64gc", true, false, true},
+		{util.Riscv32, "", "rv32gc", true, false, true},
+		{util.Riscv64, "rv64g", "rv64g", false, false, true},
+		{util.Riscv64, "rv64imafdc", "rv64imafdc", true, false, true},
+		{util.Riscv64, "rv64i2p1_m2p0_a2p1_f2p2_d2p2_c2p0_zicsr2p0", "rv64i2p1_m2p0_a2p1_f2p2_d2p2_c2p0_zicsr2p0", true, false, true},
+		{util.Riscv64, "rv64imfd_zca", "rv64imfd_zca", false, false, true},
+		{util.Riscv64, "rv64imac", "rv64imac", true, true, true},
+		{util.Riscv32, "rv32imac", "rv32imac", true, true, true},
+		{util.Riscv32, "rv32imaf", "rv32imaf", false, true, true},
+		{util.Riscv64, "rv32gc", "", false, false, false},
+		{util.Riscv32, "rv32iac", "", false, true, false},
+		{util.Riscv32, "rv32e", "", false, true, false},
+	}
+	for _, e1 := range tests {
+		opt := util.Options{TargetArch: e1.arch, March: e1.march}
+		if opt.SoftFloat() != e1.soft {
+			t.Errorf("%q: expected soft-float %t, got %t", e1.march, e1.soft, opt.SoftFloat())
+		}
+		res, compressed, err := isa(opt)
+		if (err == nil) != e1.ok {
+			t.Errorf("%q: expected ok %t, got error %v", e1.march, e1.ok, err)
+			continue
+		}
+		if res != e1.exp || compressed != e1.compressed {
+			t.Errorf("%q: expected %q and compressed %t, got %q and %t", e1.march, e1.exp, e1.compressed, res,
+				compressed)
+		}
+	}
+}
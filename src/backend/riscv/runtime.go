@@ -0,0 +1,426 @@
+package riscv
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"vslc/src/util"
+)
+
+// -----------------------------
+// ----- Type definitions ------
+// -----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// Linux system call numbers, which are also served by the RISC-V proxy kernel and QEMU user mode emulation.
+const (
+	sysWrite = 64
+	sysExit  = 93
+)
+
+const (
+	labelStart    = "_start"            // labelStart is the program entry of freestanding output.
+	labelGP       = "__global_pointer$" // labelGP is the global pointer defined by the linker.
+	labelStackTop = "__stack_top"       // labelStackTop is the initial stack pointer defined by the linker script.
+)
+
+// smashMsg is written to stderr by the freestanding stack protector failure handler.
+const smashMsg = "*** stack smashing detected ***\n"
+
+// guardValue is the stack protector canary of freestanding output, which has no source of randomness. The zero low
+// byte stops string functions from reading or writing past the canary.
+const guardValue = 0x595e9fbd94fda700
+
+// runtimeLib is the freestanding replacement of the C library functions printf, dprintf, strtol and strtod. Only the
+// conversions %d, %ld, %lld, %f, %s and %% are supported by printf, which is everything generated by VSL print
+// statements and main. %lld is treated like %ld, as integers are never wider than the word. dprintf shares the body of
+// printf, which writes to the file descriptor in a4 rather than standard output.
+// strtol and strtod parse decimal numbers with an optional minus sign, and store the end of the number through their
+// second argument, which is the start of the string if no digit is parsed.
+// Numbers are written backwards into a 32 byte buffer at the bottom of the stack frame of printf, above which a0-a7
+// are saved, such that the variadic arguments are read sequentially from a1 and onwards into the caller's stack
+// arguments. Doubles are aligned with 8 bytes, which matches the register pairs of variadic doubles on RV32.
+//
+// The tokens in braces are replaced by genRuntime with the target's word size dependent instructions and offsets.
+const runtimeLib = `
+	.align	2
+	.type	_vsl_dprintf, @function
+_vsl_dprintf:
+	addi	sp, sp, -{frame}
+{save}	mv	a4, a0
+	mv	t0, a1
+	addi	t1, sp, {dva}
+	j	_L_printf_loop
+	.size	_vsl_dprintf, .-_vsl_dprintf
+
+	.align	2
+	.type	_vsl_printf, @function
+_vsl_printf:
+	addi	sp, sp, -{frame}
+{save}	li	a4, 1
+	mv	t0, a0
+	addi	t1, sp, {va}
+_L_printf_loop:
+	lbu	t2, 0(t0)
+	beqz	t2, _L_printf_done
+	li	t3, 37		# '%'
+	beq	t2, t3, _L_printf_conv
+	mv	a1, t0
+1:	addi	t0, t0, 1
+	lbu	t2, 0(t0)
+	beqz	t2, 2f
+	bne	t2, t3, 1b
+2:	sub	a2, t0, a1
+	mv	a0, a4
+	li	a7, {write}
+	ecall
+	j	_L_printf_loop
+_L_printf_conv:
+	lbu	t2, 1(t0)
+	beqz	t2, _L_printf_done
+	addi	t0, t0, 2
+	li	t4, 0
+	li	t3, 108		# 'l'
+	bne	t2, t3, 1f
+	li	t4, 1
+	lbu	t2, 0(t0)
+	beqz	t2, _L_printf_done
+	addi	t0, t0, 1
+	bne	t2, t3, 1f	# ll
+	lbu	t2, 0(t0)
+	beqz	t2, _L_printf_done
+	addi	t0, t0, 1
+1:	li	t3, 100		# 'd'
+	beq	t2, t3, _L_printf_int
+	li	t3, 102		# 'f'
+	beq	t2, t3, _L_printf_float
+	li	t3, 115		# 's'
+	beq	t2, t3, _L_printf_str
+	li	t3, 37		# '%'
+	bne	t2, t3, _L_printf_loop
+	addi	a1, t0, -1
+	li	a2, 1
+	mv	a0, a4
+	li	a7, {write}
+	ecall
+	j	_L_printf_loop
+_L_printf_str:
+	{ld}	a1, 0(t1)
+	addi	t1, t1, {w}
+	mv	a2, a1
+1:	lbu	t2, 0(a2)
+	beqz	t2, 2f
+	addi	a2, a2, 1
+	j	1b
+2:	sub	a2, a2, a1
+	mv	a0, a4
+	li	a7, {write}
+	ecall
+	j	_L_printf_loop
+_L_printf_int:
+	{ld}	t5, 0(t1)
+	addi	t1, t1, {w}
+{sext}	sltz	t6, t5
+	beqz	t6, 1f
+	neg	t5, t5
+1:	addi	a1, sp, 32
+	li	a3, 1
+	j	_L_printf_digits
+_L_printf_float:
+	addi	t1, t1, 7
+	andi	t1, t1, -8
+	fld	ft0, 0(t1)
+	addi	t1, t1, 8
+	fcvt.d.w	ft1, zero
+	flt.d	t6, ft0, ft1
+	beqz	t6, 1f
+	fneg.d	ft0, ft0
+1:	fcvt.{l}.d	t5, ft0, rtz
+	fcvt.d.{l}	ft1, t5
+	fsub.d	ft0, ft0, ft1
+	li	t2, 1000000
+	fcvt.d.w	ft1, t2
+	fmul.d	ft0, ft0, ft1
+	fcvt.{l}.d	t3, ft0, rne
+	bne	t3, t2, 1f
+	addi	t5, t5, 1
+	li	t3, 0
+1:	addi	a1, sp, 32
+	li	a3, 6
+	li	t2, 10
+2:	remu	a2, t3, t2
+	divu	t3, t3, t2
+	addi	a2, a2, 48
+	addi	a1, a1, -1
+	sb	a2, 0(a1)
+	addi	a3, a3, -1
+	bgtz	a3, 2b
+	li	a2, 46		# '.'
+	addi	a1, a1, -1
+	sb	a2, 0(a1)
+	li	a3, 1
+_L_printf_digits:
+	li	t2, 10
+1:	remu	a2, t5, t2
+	divu	t5, t5, t2
+	addi	a2, a2, 48
+	addi	a1, a1, -1
+	sb	a2, 0(a1)
+	addi	a3, a3, -1
+	bnez	t5, 1b
+	bgtz	a3, 1b
+	beqz	t6, 2f
+	li	a2, 45		# '-'
+	addi	a1, a1, -1
+	sb	a2, 0(a1)
+2:	addi	a2, sp, 32
+	sub	a2, a2, a1
+	mv	a0, a4
+	li	a7, {write}
+	ecall
+	j	_L_printf_loop
+_L_printf_done:
+	li	a0, 0
+	addi	sp, sp, {frame}
+	ret
+	.size	_vsl_printf, .-_vsl_printf
+
+	.align	2
+	.type	_vsl_strtol, @function
+_vsl_strtol:
+	mv	t5, a0
+	li	t0, 0
+	li	t1, 0
+	li	t3, 10
+	lbu	t2, 0(a0)
+	li	t4, 45		# '-'
+	bne	t2, t4, 1f
+	li	t1, 1
+	addi	a0, a0, 1
+1:	mv	t6, a0
+2:	lbu	t2, 0(a0)
+	addi	t2, t2, -48
+	bgeu	t2, t3, 3f
+	mul	t0, t0, t3
+	add	t0, t0, t2
+	addi	a0, a0, 1
+	j	2b
+3:	bne	a0, t6, 4f
+	mv	a0, t5		# No digits.
+4:	{st}	a0, 0(a1)
+	mv	a0, t0
+	beqz	t1, 5f
+	neg	a0, a0
+5:	ret
+	.size	_vsl_strtol, .-_vsl_strtol
+
+	.align	2
+	.type	_vsl_strtod, @function
+_vsl_strtod:
+	mv	t5, a0
+	li	t6, 0
+	li	t1, 0
+	li	t3, 10
+	fcvt.d.w	fa0, zero
+	fcvt.d.w	ft1, t3
+	li	t4, 1
+	fcvt.d.w	ft2, t4
+	lbu	t2, 0(a0)
+	li	t4, 45		# '-'
+	bne	t2, t4, 1f
+	li	t1, 1
+	addi	a0, a0, 1
+1:	lbu	t2, 0(a0)
+	addi	t2, t2, -48
+	bgeu	t2, t3, 2f
+	fmul.d	fa0, fa0, ft1
+	fcvt.d.w	ft0, t2
+	fadd.d	fa0, fa0, ft0
+	addi	a0, a0, 1
+	addi	t6, t6, 1
+	j	1b
+2:	li	t4, -2		# '.' - '0'
+	bne	t2, t4, 4f
+	addi	a0, a0, 1
+3:	lbu	t2, 0(a0)
+	addi	t2, t2, -48
+	bgeu	t2, t3, 4f
+	fmul.d	fa0, fa0, ft1
+	fcvt.d.w	ft0, t2
+	fadd.d	fa0, fa0, ft0
+	fmul.d	ft2, ft2, ft1
+	addi	a0, a0, 1
+	addi	t6, t6, 1
+	j	3b
+4:	fdiv.d	fa0, fa0, ft2
+	beqz	t1, 5f
+	fneg.d	fa0, fa0
+5:	bnez	t6, 6f
+	mv	a0, t5		# No digits.
+6:	{st}	a0, 0(a1)
+	ret
+	.size	_vsl_strtod, .-_vsl_strtod
+`
+
+// linkerScript places freestanding output in the RAM of the QEMU virt machine and Spike, which starts at 0x80000000,
+// with _start first. The stack grows down from the top of RAM.
+const linkerScript = `/* Linker script for freestanding VSL programs. */
+OUTPUT_ARCH(riscv)
+ENTRY(_start)
+
+MEMORY
+{
+	RAM (rwx) : ORIGIN = 0x80000000, LENGTH = 128M
+}
+
+SECTIONS
+{
+	.text : { *(.text.init) *(.text .text.*) } > RAM
+	.rodata : { *(.rodata .rodata.*) } > RAM
+	.data : { *(.data .data.*) } > RAM
+	.sdata : {
+		__global_pointer$ = . + 0x800;
+		*(.sdata .sdata.*)
+	} > RAM
+	.bss : { *(.sbss .sbss.* .bss .bss.*) *(COMMON) } > RAM
+	__stack_top = ORIGIN(RAM) + LENGTH(RAM);
+}
+`
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// runtimeSyms maps the C library symbols referenced by the generated code to their replacements in freestanding
+// output.
+var runtimeSyms = map[string]string{
+	"printf":       "_vsl_printf",
+	"dprintf":      "_vsl_dprintf",
+	"strtol":       "_vsl_strtol",
+	"strtod":       "_vsl_strtod",
+	labelGuard:     "_vsl_stack_chk_guard",
+	labelGuardFail: "_vsl_stack_chk_fail",
+}
+
+// --------------------
+// ----- Function -----
+// --------------------
+
+// symbol returns the symbol that the generated code references for the C library symbol name, which is replaced by
+// the freestanding runtime if freestanding output is generated.
+func (g *generator) symbol(name string) string {
+	if s, ok := runtimeSyms[name]; ok && g.freestanding {
+		return s
+	}
+	return name
+}
+
+// genStart generates the program entry _start of freestanding output, which initialises the global pointer, calls main
+// and exits with the return value of main. Program arguments are read from the initial stack set up by the loader.
+// If the linker script defines __stack_top, the program runs on bare metal, where SP is initialised to __stack_top and
+// the program gets no arguments.
+func (g *generator) genStart(rf RegisterFile, wr *util.Writer) {
+	wr.Write("\n\t.section\t.text.init,\"ax\",@progbits\n")
+	wr.Write("\t.align\t2\n")
+	wr.Write("\t.globl\t%s\n", labelStart)
+	wr.Write("\t.type\t%s, @function\n", labelStart)
+	wr.Write("\t.weak\t%s\n", labelStackTop)
+	wr.Label(labelStart)
+
+	// The global pointer must be set without linker relaxation, which would make it relative to itself.
+	wr.Write("\t.option\tpush\n")
+	wr.Write("\t.option\tnorelax\n")
+	wr.Write("\tlla\t%s, %s\n", rf.GetI(gp).String(), labelGP)
+	wr.Write("\t.option\tpop\n")
+
+	// argc is at the top of the initial stack, followed by argv.
+	wr.Write("\t%s\t%s, 0(%s)\n", loadWord(rf.GetI(a0)), rf.GetI(a0).String(), rf.SP().String())
+	wr.Write("\taddi\t%s, %s, %d\n", rf.GetI(a1).String(), rf.SP().String(), g.wordSize)
+
+	// Use the stack of the linker script on bare metal, where argc is 1 for the missing program name. The address of
+	// the weak symbol is absolute, because a pc-relative address can't be resolved to 0 if it's undefined.
+	tmp := rf.GetI(t0)
+	if g.pic {
+		wr.Write("\tla\t%s, %s\n", tmp.String(), labelStackTop)
+	} else {
+		wr.Write("\tlui\t%s, %%hi(%s)\n", tmp.String(), labelStackTop)
+		wr.Write("\taddi\t%s, %s, %%lo(%s)\n", tmp.String(), tmp.String(), labelStackTop)
+	}
+	wr.Write("\tbeqz\t%s, 1f\n", tmp.String())
+	wr.Write("\tmv\t%s, %s\n", rf.SP().String(), tmp.String())
+	wr.Write("\tli\t%s, 1\n", rf.GetI(a0).String())
+	wr.Write("1:\tcall\t%s\n", labelMain)
+	genExit(rf, wr)
+	wr.Write("\t.size\t%s, .-%s\n", labelStart, labelStart)
+	wr.Write("\t.text\n")
+}
+
+// genExit generates the exit system call with the exit code in a0.
+func genExit(rf RegisterFile, wr *util.Writer) {
+	wr.Write("\tli\t%s, %d\n", rf.GetI(a7).String(), sysExit)
+	wr.Write("\tecall\n")
+}
+
+// genRuntime generates the freestanding runtime, which replaces the C library functions called by the generated code
+// by functions that use system calls, and the stack protector failure handler if -fstack-protector is set.
+func (g *generator) genRuntime(rf RegisterFile, wr *util.Writer) {
+	frame := 32 + paramReg*g.wordSize // Number buffer and a0-a7.
+	var save strings.Builder
+	for i1 := 0; i1 < paramReg; i1++ {
+		save.WriteString(fmt.Sprintf("\t%s\ta%d, %d(sp)\n", storeWord(rf.GetI(a0)), i1, 32+i1*g.wordSize))
+	}
+	sext := ""
+	if g.wordSize == wordSize64 {
+		// %d is 32-bit int.
+		sext = "\tbnez\tt4, 1f\n\tsext.w\tt5, t5\n1:"
+	}
+	r := strings.NewReplacer(
+		"{save}", save.String(),
+		"{sext}", sext,
+		"{ld}", loadWord(rf.GetI(a0)),
+		"{st}", storeWord(rf.GetI(a0)),
+		"{w}", fmt.Sprint(g.wordSize),
+		"{l}", g.iext,
+		"{va}", fmt.Sprint(32+g.wordSize),
+		"{dva}", fmt.Sprint(32+2*g.wordSize),
+		"{frame}", fmt.Sprint(frame),
+		"{write}", fmt.Sprint(sysWrite),
+	)
+	wr.WriteString(r.Replace(runtimeLib))
+
+	if g.stackProtector {
+		wr.Write("\n\t.align\t2\n")
+		wr.Write("\t.type\t%s, @function\n", g.symbol(labelGuardFail))
+		wr.Label(g.symbol(labelGuardFail))
+		wr.Write("\tli\ta0, 2\n")
+		wr.Write("\tlla\ta1, _L_smash_msg\n")
+		wr.Write("\tli\ta2, %d\n", len(smashMsg))
+		wr.Write("\tli\ta7, %d\n", sysWrite)
+		wr.Write("\tecall\n")
+		wr.Write("\tli\ta0, 134\n") // Exit code of abort.
+		genExit(rf, wr)
+		wr.Write("\t.size\t%s, .-%s\n", g.symbol(labelGuardFail), g.symbol(labelGuardFail))
+		wr.Write("\n\t.section\t.rodata\n")
+		wr.Label("_L_smash_msg")
+		wr.Write("\t.ascii\t%q\n", smashMsg)
+		wr.Write("\t.text\n")
+	}
+}
+
+// genGuard generates the stack protector canary of freestanding output in the data section.
+func (g *generator) genGuard(wr *util.Writer) {
+	wr.Label(g.symbol(labelGuard))
+	if g.wordSize == wordSize64 {
+		wr.Write("\t.dword\t0x%x\n", uint64(guardValue))
+	} else {
+		wr.Write("\t.word\t0x%x\n", uint32(guardValue&0xffffffff))
+	}
+}
+
+// writeLinkerScript writes the linker script of freestanding output to path.
+func writeLinkerScript(path string) error {
+	return ioutil.WriteFile(path, []byte(linkerScript), 0644)
+}
@@ -0,0 +1,26 @@
+package riscv
+
+import "testing"
+
+// TestSymbol verifies that C library symbols are replaced by the freestanding runtime only in freestanding output, and
+// that other symbols are left alone.
+func TestSymbol(t *testing.T) {
+	tests := []struct {
+		name         string
+		freestanding bool
+		exp          string
+	}{
+		{"printf", false, "printf"},
+		{"printf", true, "_vsl_printf"},
+		{"dprintf", true, "_vsl_dprintf"},
+		{"strtod", true, "_vsl_strtod"},
+		{labelGuardFail, true, "_vsl_stack_chk_fail"},
+		{"fib", true, "fib"},
+	}
+	for _, e1 := range tests {
+		g := &generator{freestanding: e1.freestanding}
+		if res := g.symbol(e1.name); res != e1.exp {
+			t.Errorf("freestanding %t: expected %s for %s, got %s", e1.freestanding, e1.exp, e1.name, res)
+		}
+	}
+}
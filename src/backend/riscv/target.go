@@ -0,0 +1,88 @@
+package riscv
+
+import (
+	"vslc/src/backend"
+	"vslc/src/backend/regfile"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// target implements backend.Target for RV32 and RV64.
+type target struct {
+	bits int // bits is the width of the integer registers, XLEN.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+func init() {
+	backend.Register(util.Riscv64, target{bits: bitSize64})
+	backend.Register(util.Riscv32, target{bits: bitSize32})
+}
+
+// Name returns the name of the target architecture.
+func (t target) Name() string {
+	if t.bits == bitSize32 {
+		return "riscv32"
+	}
+	return "riscv64"
+}
+
+// WordSize returns the width of integers in bits.
+func (t target) WordSize() int {
+	return t.bits
+}
+
+// CreateRegisterFile returns a new register file for the ISA configured by opt, whose floating point registers are
+// unused for soft-float.
+func (target) CreateRegisterFile(opt util.Options) regfile.RegisterFile {
+	return CreateRegisterFile(opt)
+}
+
+// Validate returns an error if the configuration opt isn't supported for the ISA and ABI it selects.
+func (target) Validate(opt util.Options) error {
+	return validate(opt)
+}
+
+// Generate generates assembler of LIR Module m.
+func (target) Generate(opt util.Options, m *lir.Module, root *ir.Node) error {
+	return GenRiscv(opt, m, root)
+}
+
+// Immediate returns false, because constants are always loaded into a register.
+func (target) Immediate(_ util.Options, _ *lir.Constant) bool {
+	return false
+}
+
+// Select returns false, because the base ISA has no conditional select instruction.
+func (target) Select() bool {
+	return false
+}
+
+// Toolchain returns the target triple of the C compiler driver that assembles and links the output, and the flags
+// that select the ISA and calling convention of the output. Soft-float output passes floats in integer registers.
+func (t target) Toolchain(opt util.Options) (string, []string, error) {
+	march, _, err := isa(opt)
+	if err != nil {
+		return "", nil, err
+	}
+	triple := t.Name() + "-linux-gnu"
+	if opt.Freestanding {
+		triple = t.Name() + "-unknown-elf"
+	}
+	return triple, []string{"-march=" + march, "-mabi=" + opt.TargetABI()}, nil
+}
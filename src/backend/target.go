@@ -0,0 +1,90 @@
+package backend
+
+import (
+	"fmt"
+	"vslc/src/backend/regfile"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Target defines a target architecture that LIR can be compiled to. Targets are implemented in their own packages,
+// which register them by calling Register from an init function, such that importing the package adds the target to
+// the compiler.
+type Target interface {
+	Name() string  // Name returns the name of the target architecture, such as aarch64.
+	WordSize() int // WordSize returns the width of integers in bits.
+
+	// CreateRegisterFile returns the register file that LIR values are allocated to for the target configured by opt.
+	// Targets that don't assign registers to values, such as stack machines, return nil.
+	CreateRegisterFile(opt util.Options) regfile.RegisterFile
+
+	// Validate returns an error if the target doesn't support the configuration opt, such as an option of another
+	// target. The configuration is validated before any source code is compiled, such that Generate is only called
+	// with configurations supported by the target.
+	Validate(opt util.Options) error
+
+	// Generate generates the output of LIR Module m, whose values are allocated to the registers of the Target's
+	// register file, if any. The first function of the syntax tree root is called by the program's entry point. An
+	// error is returned if m can't be generated for the configuration opt.
+	Generate(opt util.Options, m *lir.Module, root *ir.Node) error
+
+	// Immediate returns true if the integer Constant c is encoded as an immediate operand by all of its users, such
+	// that it doesn't need a register. Whether an operand fits may depend on the configuration opt.
+	Immediate(opt util.Options, c *lir.Constant) bool
+
+	// Select returns true if the target has a conditional select instruction, such that simple IF-THEN-ELSE
+	// constructs should be if-converted.
+	Select() bool
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// targets maps target architecture identifiers, such as util.Aarch64, to their registered Target.
+var targets = make(map[int]Target)
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// Register makes the Target t available for the target architecture identifier arch. Register must be called from
+// init functions only, and panics if a Target is already registered for arch.
+func Register(arch int, t Target) {
+	if t == nil {
+		panic("backend: Register target is nil")
+	}
+	if _, ok := targets[arch]; ok {
+		panic(fmt.Sprintf("backend: Register called twice for target %s", t.Name()))
+	}
+	targets[arch] = t
+	util.RegisterBackend(t.Name())
+}
+
+// Lookup returns the Target registered for the target architecture identifier arch. An error is returned if no
+// Target is registered for arch.
+func Lookup(arch int) (Target, error) {
+	if t, ok := targets[arch]; ok {
+		return t, nil
+	}
+	return nil, fmt.Errorf("unsupported output architecture %d", arch)
+}
+
+// Validate returns an error if the target architecture of opt isn't registered, or its Target doesn't support the
+// configuration opt.
+func Validate(opt util.Options) error {
+	t, err := Lookup(opt.TargetArch)
+	if err != nil {
+		return err
+	}
+	return t.Validate(opt)
+}
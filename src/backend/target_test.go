@@ -0,0 +1,41 @@
+package backend
+
+import (
+	"testing"
+	"vslc/src/backend/regfile"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// fake is a Target without registers that generates nothing.
+type fake struct{}
+
+func (fake) Name() string                                           { return "fake" }
+func (fake) WordSize() int                                          { return 64 }
+func (fake) CreateRegisterFile(_ util.Options) regfile.RegisterFile { return nil }
+func (fake) Validate(_ util.Options) error                          { return nil }
+func (fake) Generate(_ util.Options, _ *lir.Module, _ *ir.Node) error {
+	return nil
+}
+func (fake) Immediate(_ util.Options, _ *lir.Constant) bool { return false }
+func (fake) Select() bool                                   { return false }
+
+// TestRegister verifies that registered targets are found by Lookup, and that registering a target twice panics.
+func TestRegister(t *testing.T) {
+	const arch = -1
+	if _, err := Lookup(arch); err == nil {
+		t.Fatalf("expected error for unregistered target %d", arch)
+	}
+	Register(arch, fake{})
+	if tg, err := Lookup(arch); err != nil || tg.Name() != "fake" {
+		t.Fatalf("expected fake target, got %v, %v", tg, err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic when registering target %d twice", arch)
+		}
+	}()
+	Register(arch, fake{})
+}
@@ -0,0 +1,109 @@
+// Package vslrt provides the VSL runtime library, which parses the command line arguments of the implicit main
+// function of generated programs and exits on errors. The library is written in C, such that it's compiled for any
+// target by the C compiler driver that links the program.
+package vslrt
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// LabelArgs is the symbol of the runtime function that parses the command line arguments.
+const LabelArgs = "vslrt_args"
+
+// Source is the C source code of the runtime library.
+//
+// vslrt_args parses the program arguments argv[1] to argv[argc-1] into args[0] to args[argc-2], as integers or floats
+// according to the characters 'i' and 'f' of the parameter types string, which is null if there are no parameters.
+// Integers and floats have the width of the target's word, like VSL values. The program exits with status 1 if the
+// argument count doesn't match the number of parameters, or if an argument is empty or isn't parsed as a whole by
+// strtol or strtod, with the same error messages that the generated main function prints without the runtime library.
+const Source = `/* VSL runtime library. */
+#include <stdint.h>
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+
+#if UINTPTR_MAX > 0xffffffffu
+typedef int64_t vsl_int;
+typedef double vsl_float;
+#else
+typedef int32_t vsl_int;
+typedef float vsl_float;
+#endif
+
+typedef union {
+	vsl_int i;
+	vsl_float f;
+} vsl_word;
+
+void vslrt_args(int argc, char **argv, const char *types, vsl_word *args) {
+	int n = types ? (int) strlen(types) : 0;
+	if (argc - 1 != n) {
+		if (n == 1) {
+			printf("Argument error: expected 1 argument, got %d\n", argc - 1);
+		} else {
+			printf("Argument error: expected %d arguments, got %d\n", n, argc - 1);
+		}
+		exit(1);
+	}
+	for (int i = 0; i < n; i++) {
+		char *end;
+		if (types[i] == 'i') {
+			args[i].i = (vsl_int) strtol(argv[i + 1], &end, 10);
+		} else {
+			args[i].f = (vsl_float) strtod(argv[i + 1], &end);
+		}
+		if (end == argv[i + 1] || *end != '\0') {
+			printf("Argument error: argument %d is neither int nor float\n", i + 1);
+			exit(1);
+		}
+	}
+}
+`
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// Types returns the parameter types string of Function f that is passed to LabelArgs.
+func Types(f *lir.Function) string {
+	sb := strings.Builder{}
+	for _, e1 := range f.Params() {
+		if e1.DataType() == types.Float {
+			sb.WriteByte('f')
+		} else {
+			sb.WriteByte('i')
+		}
+	}
+	return sb.String()
+}
+
+// WriteSource writes Source to a new temporary file, and returns its path. The caller removes the file.
+func WriteSource() (string, error) {
+	f, err := ioutil.TempFile("", "vslrt-*.c")
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.WriteString(Source); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), f.Close()
+}
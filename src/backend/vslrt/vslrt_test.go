@@ -0,0 +1,46 @@
+package vslrt
+
+import (
+	"path/filepath"
+	"testing"
+	"vslc/src/frontend"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// srcPath defines the relative path to the typed VSL source files.
+const srcPath = "../../../resources/vsl_typed/"
+
+// TestTypes verifies the parameter types strings of the functions of a program with mixed parameter types.
+func TestTypes(t *testing.T) {
+	opt := util.Options{Src: filepath.Join(srcPath, "cast.vsl"), Threads: 1, TargetArch: util.Aarch64}
+	s, err := util.ReadSource(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := frontend.Parse(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ir.Optimise(opt, root); err != nil {
+		t.Fatal(err)
+	}
+	m, err := lir.GenLIR(opt, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := map[string]string{"casting": "iiff", "foo": "ff"}
+	for _, e1 := range m.Functions() {
+		if types, ok := exp[e1.Name()]; ok {
+			if res := Types(e1); res != types {
+				t.Errorf("%s: expected types %q, got %q", e1.Name(), types, res)
+			}
+			delete(exp, e1.Name())
+		}
+	}
+	for e1 := range exp {
+		t.Errorf("function %s not found", e1)
+	}
+}
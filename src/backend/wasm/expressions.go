@@ -0,0 +1,277 @@
+package wasm
+
+import (
+	"fmt"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+)
+
+// -----------------------------
+// ----- Type definitions ------
+// -----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// --------------------
+// ----- Function -----
+// --------------------
+
+// genInstruction generates WebAssembly of the non-terminating LIR instruction v. Values held in locals are computed on
+// the operand stack and assigned to their local. An error is returned if something went wrong.
+func (fn *function) genInstruction(v lir.Value) error {
+	switch v := v.(type) {
+	case *lir.Constant, *lir.DeclareInstruction, *lir.VaList, *lir.PhiInstruction:
+		// Constants are pushed where they're used, locals are declared on function entry, variable argument lists are
+		// stored on function call and phi instructions are assigned on the branches to their Block.
+		return nil
+	case *lir.LoadInstruction:
+		switch src := v.Operand1().(type) {
+		case *lir.String:
+			return nil
+		case *lir.Global:
+			fn.line("global.get $%s", src.Name())
+		default:
+			fn.line("local.get %s", local(src))
+		}
+	case *lir.StoreInstruction:
+		fn.get(v.Operand1())
+		fn.convert(v.Operand1().DataType(), v.Operand2().DataType())
+		if dst, ok := v.Operand2().(*lir.Global); ok {
+			fn.line("global.set $%s", dst.Name())
+		} else {
+			fn.line("local.set %s", local(v.Operand2()))
+		}
+		return nil
+	case *lir.DataInstruction:
+		if err := fn.genExpression(v); err != nil {
+			return err
+		}
+	case *lir.CastInstruction:
+		fn.get(v.Operand1())
+		fn.convert(v.Operand1().DataType(), v.DataType())
+	case *lir.PreserveInstruction:
+		fn.get(v.Operand1())
+	case *lir.SelectInstruction:
+		fn.get(v.True())
+		fn.get(v.False())
+		fn.get(v.Operand1())
+		fn.get(v.Operand2())
+		fn.line(fn.g.compare(v.Operator(), v.Operand1().DataType()))
+		fn.line("select")
+	case *lir.FunctionCallInstruction:
+		return fn.genFunctionCall(v)
+	default:
+		return fmt.Errorf("unexpected instruction %s", v.String())
+	}
+	fn.line("local.set %s", local(v))
+	return nil
+}
+
+// genExpression pushes the result of the arithmetic LIR instruction v on the operand stack. Integer division by zero
+// traps, like the native backends, which leave it to the hardware or the operating system.
+func (fn *function) genExpression(v *lir.DataInstruction) error {
+	pre := fn.g.valType(v.DataType())
+	if v.Operand2() == nil {
+		// Unary expression.
+		switch v.Operator() {
+		case types.Sub, types.Neg:
+			if v.DataType() == types.Float {
+				fn.get(v.Operand1())
+				fn.line("f64.neg")
+			} else {
+				fn.line("%s.const 0", pre)
+				fn.get(v.Operand1())
+				fn.line("%s.sub", pre)
+			}
+		case types.Not:
+			fn.get(v.Operand1())
+			fn.line("%s.const -1", pre)
+			fn.line("%s.xor", pre)
+		default:
+			return fmt.Errorf("unexpected unary operator %q", v.Operator().String())
+		}
+		return nil
+	}
+
+	// Binary expression. Choose instruction from operator.
+	var op string
+	if v.DataType() == types.Int {
+		switch v.Operator() {
+		case types.Add:
+			op = "add"
+		case types.Sub:
+			op = "sub"
+		case types.Mul:
+			op = "mul"
+		case types.Div:
+			op = "div_s"
+		case types.Rem:
+			op = "rem_s"
+		case types.And:
+			op = "and"
+		case types.Xor:
+			op = "xor"
+		case types.Or:
+			op = "or"
+		case types.RShift:
+			op = "shr_u"
+		case types.ARShift:
+			op = "shr_s"
+		case types.LShift:
+			op = "shl"
+		}
+	} else {
+		switch v.Operator() {
+		case types.Add:
+			op = "add"
+		case types.Sub:
+			op = "sub"
+		case types.Mul:
+			op = "mul"
+		case types.Div:
+			op = "div"
+		}
+	}
+	if op == "" {
+		// MulHigh has no WebAssembly equivalent, which is why division isn't lowered for WebAssembly.
+		return fmt.Errorf("unexpected binary operator %q", v.Operator().String())
+	}
+	fn.get(v.Operand1())
+	fn.get(v.Operand2())
+	fn.line("%s.%s", pre, op)
+	return nil
+}
+
+// genFunctionCall generates WebAssembly of the function call v. The arguments of printf are stored in the variable
+// argument list in linear memory, and printf is passed the addresses of the format string and the list. dprintf is
+// passed the file descriptor before them. Arguments of VSL functions are converted to the type of their parameter.
+func (fn *function) genFunctionCall(v *lir.FunctionCallInstruction) error {
+	args := v.Arguments()
+	if len(v.Target().Blocks()) < 1 {
+		// Call to printf or dprintf, the only functions imported.
+		var fd lir.Value
+		if v.Target().Name() == labelDprintf {
+			if len(args) != 3 {
+				return fmt.Errorf("expected 3 arguments to %s, got %d", v.Target().Name(), len(args))
+			}
+			fd, args = args[0], args[1:]
+		}
+		if len(args) != 2 {
+			return fmt.Errorf("expected 2 arguments to %s, got %d", v.Target().Name(), len(args))
+		}
+		l, ok := args[1].(*lir.VaList)
+		if !ok {
+			return fmt.Errorf("expected variable argument list to %s, got %s", v.Target().Name(), args[1].Name())
+		}
+		for i1, e1 := range l.Values() {
+			fn.line("i32.const %d", fn.lay.args+slotSize*i1)
+			fn.get(e1)
+			if typ := fn.g.valType(e1.DataType()); e1.DataType() == types.Int && typ == "i32" {
+				fn.line("i64.extend_i32_s")
+				fn.line("i64.store")
+			} else {
+				fn.line("%s.store", typ)
+			}
+		}
+		if fd != nil {
+			fn.get(fd)
+			if fn.g.intType == "i64" {
+				fn.line("i32.wrap_i64")
+			}
+		}
+		fn.get(args[0])
+		fn.line("i32.const %d", fn.lay.args)
+		fn.line("call $%s", v.Target().Name())
+		if hasLocal(v) && fn.g.intType == "i64" {
+			fn.line("i64.extend_i32_s")
+		}
+	} else {
+		params := v.Target().Params()
+		if len(args) != len(params) {
+			return fmt.Errorf("expected %d arguments to %s, got %d", len(params), v.Target().Name(), len(args))
+		}
+		for i1, e1 := range args {
+			fn.get(e1)
+			fn.convert(e1.DataType(), params[i1].DataType())
+		}
+		fn.line("call $%s", v.Target().Name())
+	}
+
+	if hasLocal(v) {
+		fn.line("local.set %s", local(v))
+	} else {
+		fn.line("drop")
+	}
+	return nil
+}
+
+// get pushes the LIR value v on the operand stack. Constants and the addresses of strings are pushed immediately,
+// other values are read from their local.
+func (fn *function) get(v lir.Value) {
+	switch v := v.(type) {
+	case *lir.Constant:
+		if f, ok := v.Value().(float64); ok {
+			fn.line("f64.const %s", float(f))
+		} else {
+			fn.line("%s.const %d", fn.g.intType, v.Value())
+		}
+	case *lir.LoadInstruction:
+		if s, ok := v.Operand1().(*lir.String); ok {
+			fn.line("i32.const %d", fn.lay.strings[s])
+		} else {
+			fn.line("local.get %s", local(v))
+		}
+	default:
+		fn.line("local.get %s", local(v))
+	}
+}
+
+// convert converts the value on top of the operand stack from data type src to data type dst. Floats are converted to
+// integers rounding towards zero and saturating on overflow, like the native backends.
+func (fn *function) convert(src, dst types.DataType) {
+	switch {
+	case src == dst:
+	case src == types.Int && dst == types.Float:
+		fn.line("f64.convert_%s_s", fn.g.intType)
+	case src == types.Float && dst == types.Int:
+		fn.line("%s.trunc_sat_f64_s", fn.g.intType)
+	}
+}
+
+// compare returns the WebAssembly instruction that compares two values of data type typ using the relational
+// operator op.
+func (g *generator) compare(op types.RelationalOperation, typ types.DataType) string {
+	var s string
+	switch op {
+	case types.Eq:
+		s = "eq"
+	case types.Neq:
+		s = "ne"
+	case types.LessThan:
+		s = "lt"
+	case types.LessThanOrEqual:
+		s = "le"
+	case types.GreaterThan:
+		s = "gt"
+	case types.GreaterThanOrEqual:
+		s = "ge"
+	}
+	if typ == types.Float {
+		return "f64." + s
+	}
+	return g.intType + "." + s + choose(op == types.Eq || op == types.Neq, "", "_s")
+}
+
+// choose returns the string a if cond is true, and b otherwise.
+func choose(cond bool, a, b string) string {
+	if cond {
+		return a
+	}
+	return b
+}
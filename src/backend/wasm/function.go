@@ -0,0 +1,280 @@
+package wasm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"vslc/src/backend"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// function holds the state of the generation of a single function body.
+type function struct {
+	f     *lir.Function
+	lay   *layout
+	wr    *util.Writer
+	dt    *lir.DomTree       // dt is the dominator tree of the function's control flow graph.
+	rpo   map[*lir.Block]int // rpo holds the reverse post order number of every reachable Block.
+	loop  map[*lir.Block]bool
+	merge map[*lir.Block]bool
+	depth int                // depth is the nesting depth of the instruction being written.
+	ann   *backend.Annotator // ann comments the instructions with their source lines, if set.
+	g     *generator         // g is the generator of the module.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// genFunction generates the WebAssembly function of the LIR Function fun, which is exported by its name. Functions
+// without body are imported, and generate nothing.
+//
+// The control flow graph is translated to structured control flow per "Beyond Relooper" by Norman Ramsey, which
+// requires the graph to be reducible, as every graph generated from VSL is. Blocks are visited along the dominator
+// tree:
+//
+// - A Block that is the target of a back edge is wrapped in a loop, which back edges continue.
+// - A Block with more than one forward predecessor is a merge Block. It follows a block, wrapping the code of its
+//   immediate dominator, which forward branches to the merge Block leave.
+// - Any other Block is placed directly at the branch to it, which it's the only forward predecessor of.
+func (g *generator) genFunction(fun *lir.Function, lay *layout, wr *util.Writer) error {
+	if len(fun.Blocks()) < 1 {
+		return nil
+	}
+	fn := &function{
+		f:     fun,
+		lay:   lay,
+		wr:    wr,
+		dt:    fun.Dominators(),
+		rpo:   make(map[*lir.Block]int, len(fun.Blocks())),
+		loop:  make(map[*lir.Block]bool),
+		merge: make(map[*lir.Block]bool),
+		ann:   g.annotator.Function(),
+		g:     g,
+	}
+	for i1, e1 := range fun.ReversePostOrder() {
+		fn.rpo[e1] = i1
+	}
+	for b := range fn.rpo {
+		forward := 0
+		for _, e1 := range b.Predecessors() {
+			if _, ok := fn.rpo[e1]; !ok {
+				// Unreachable predecessor.
+				continue
+			}
+			if fn.backward(e1, b) {
+				fn.loop[b] = true
+			} else {
+				forward++
+			}
+		}
+		fn.merge[b] = forward > 1
+	}
+
+	// Write signature, with parameters in the order of the Function's parameters.
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("\n\t(func $%s (export %q)", fun.Name(), fun.Name()))
+	for _, e1 := range fun.Params() {
+		sb.WriteString(fmt.Sprintf(" (param $%s %s)", e1.Name(), g.valType(e1.DataType())))
+	}
+	sb.WriteString(fmt.Sprintf(" (result %s)\n", g.valType(fun.DataType())))
+	wr.WriteString(sb.String())
+
+	// Declare local variables, followed by a local per LIR value.
+	fn.depth = 2
+	for _, e1 := range fun.Locals() {
+		fn.line("(local %s %s)", local(e1), g.valType(e1.DataType()))
+	}
+	for _, e1 := range fun.Blocks() {
+		for _, e2 := range e1.Instructions() {
+			if hasLocal(e2) {
+				fn.line("(local %s %s)", local(e2), g.valType(e2.DataType()))
+			}
+		}
+	}
+
+	// Generate function body. Control never reaches the end of the body, which is marked unreachable for validation,
+	// because the structured control flow may end in constructs without result.
+	if err := fn.doTree(fun.Blocks()[0]); err != nil {
+		return err
+	}
+	fn.line("unreachable")
+	wr.Write("\t)\n")
+	return nil
+}
+
+// doTree generates the code of Block b and of the Blocks it dominates, which are placed inside a loop if b is a loop
+// header.
+func (fn *function) doTree(b *lir.Block) error {
+	// Merge Blocks immediately dominated by b, ordered such that the last Block in reverse post order is placed
+	// last, in the outermost block.
+	ys := make([]*lir.Block, 0, len(fn.dt.Children(b)))
+	for _, e1 := range fn.dt.Children(b) {
+		if fn.merge[e1] {
+			ys = append(ys, e1)
+		}
+	}
+	sort.Slice(ys, func(i1, i2 int) bool {
+		return fn.rpo[ys[i1]] > fn.rpo[ys[i2]]
+	})
+
+	if !fn.loop[b] {
+		return fn.nodeWithin(b, ys)
+	}
+	fn.line("loop $%s.loop", b.Name())
+	fn.depth++
+	if err := fn.nodeWithin(b, ys); err != nil {
+		return err
+	}
+	fn.depth--
+	fn.line("end")
+	return nil
+}
+
+// nodeWithin generates the code of Block b, wrapped by a block per merge Block of ys, which follow their blocks.
+func (fn *function) nodeWithin(b *lir.Block, ys []*lir.Block) error {
+	if len(ys) > 0 {
+		fn.line("block $%s", ys[0].Name())
+		fn.depth++
+		if err := fn.nodeWithin(b, ys[1:]); err != nil {
+			return err
+		}
+		fn.depth--
+		fn.line("end")
+		return fn.doTree(ys[0])
+	}
+
+	for _, e1 := range b.Instructions() {
+		for _, e2 := range fn.ann.Lines(e1) {
+			fn.line("%s", e2)
+		}
+		switch v := e1.(type) {
+		case *lir.BranchInstruction:
+			if v.Else() == nil {
+				return fn.doBranch(b, v.Then())
+			}
+			fn.get(v.Operand1())
+			fn.get(v.Operand2())
+			fn.line(fn.g.compare(v.Operator(), v.Operand1().DataType()))
+			fn.line("if")
+			fn.depth++
+			if err := fn.doBranch(b, v.Then()); err != nil {
+				return err
+			}
+			fn.depth--
+			fn.line("else")
+			fn.depth++
+			if err := fn.doBranch(b, v.Else()); err != nil {
+				return err
+			}
+			fn.depth--
+			fn.line("end")
+			return nil
+		case *lir.ReturnInstruction:
+			fn.get(v.Operand1())
+			fn.convert(v.Operand1().DataType(), fn.f.DataType())
+			fn.line("return")
+			return nil
+		default:
+			if err := fn.genInstruction(e1); err != nil {
+				return locate(e1, err)
+			}
+		}
+	}
+	return fmt.Errorf("%s: %s is not terminated", fn.f.Name(), b.Name())
+}
+
+// doBranch generates the branch from Block src to Block dst. The phi instructions of dst are assigned the values
+// incoming from src first, which are all read before any of them is assigned.
+func (fn *function) doBranch(src, dst *lir.Block) error {
+	phis := make([]*lir.PhiInstruction, 0)
+	for _, e1 := range dst.Instructions() {
+		p, ok := e1.(*lir.PhiInstruction)
+		if !ok {
+			break
+		}
+		v := p.IncomingFrom(src)
+		if v == nil {
+			return fmt.Errorf("%s: %s has no value for predecessor block %s", fn.f.Name(), p.Name(), src.Name())
+		}
+		fn.get(v)
+		phis = append(phis, p)
+	}
+	for i1 := len(phis) - 1; i1 >= 0; i1-- {
+		fn.line("local.set %s", local(phis[i1]))
+	}
+
+	switch {
+	case fn.backward(src, dst):
+		fn.line("br $%s.loop", dst.Name())
+	case fn.merge[dst]:
+		fn.line("br $%s", dst.Name())
+	default:
+		return fn.doTree(dst)
+	}
+	return nil
+}
+
+// backward returns true if the edge from Block src to Block dst is a back edge, which goes to a Block that precedes
+// or is src in reverse post order.
+func (fn *function) backward(src, dst *lir.Block) bool {
+	return fn.rpo[dst] <= fn.rpo[src]
+}
+
+// line writes a line of the function body at the current nesting depth.
+func (fn *function) line(format string, args ...interface{}) {
+	fn.wr.Write("%s%s\n", strings.Repeat("\t", fn.depth), fmt.Sprintf(format, args...))
+}
+
+// local returns the name of the WebAssembly local holding the LIR value v. Parameters keep their names, local
+// variables are suffixed by their sequence number, because nested scopes may declare the same name, and other values
+// are named like in textual LIR.
+func local(v lir.Value) string {
+	switch v := v.(type) {
+	case *lir.Param:
+		return "$" + v.Name()
+	case *lir.DeclareInstruction:
+		return fmt.Sprintf("$%s.%d", v.Name(), v.Seq())
+	default:
+		return "$" + v.Name()
+	}
+}
+
+// hasLocal returns true if the LIR instruction v computes a value that is held in a local. Constants and the addresses
+// of strings are pushed where they're used instead.
+func hasLocal(v lir.Value) bool {
+	switch v.Type() {
+	case types.DataInstruction, types.LoadInstruction, types.CastInstruction, types.PreserveInstruction,
+		types.PhiInstruction, types.SelectInstruction, types.FunctionCallInstruction:
+		if v.DataType() != types.Int && v.DataType() != types.Float {
+			return false
+		}
+		if v.Type() == types.FunctionCallInstruction && len(v.Users()) == 0 {
+			return false
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// locate returns err as a util.CodegenError at the source location of the LIR instruction v, which prefixes the message of
+// err if the location is known.
+func locate(v lir.Value, err error) error {
+	loc := v.Location()
+	return &util.CodegenError{Position: util.Position{Line: loc.Line, Pos: loc.Pos}, Err: err}
+}
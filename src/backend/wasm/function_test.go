@@ -0,0 +1,95 @@
+package wasm
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"vslc/src/frontend"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// srcPath defines the relative path to the typed VSL source files.
+const srcPath = "../../../resources/vsl_typed/"
+
+// generate compiles the VSL source file src to LIR, optionally promoting local variables to SSA form, and returns the
+// WebAssembly of its first function.
+func generate(t *testing.T, src string, ssa bool) string {
+	opt := util.Options{Src: filepath.Join(srcPath, src), Threads: 1, TargetArch: util.Wasm}
+	s, err := util.ReadSource(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := frontend.Parse(s)
+	if err != nil {
+		t.Fatalf("%s: %s", src, err)
+	}
+	if err := ir.Optimise(opt, root); err != nil {
+		t.Fatalf("%s: %s", src, err)
+	}
+	m, err := lir.GenLIR(opt, root)
+	if err != nil {
+		t.Fatalf("%s: %s", src, err)
+	}
+	lir.SimplifyCFG(opt, m)
+	if ssa {
+		lir.Mem2Reg(opt, m)
+	}
+
+	var res string
+	wr := util.Writer{}
+	if err := newGenerator(opt).genFunction(m.Functions()[0], newLayout(m), &wr); err != nil {
+		t.Fatalf("%s: %s", src, err)
+	}
+	wr.Transform(func(s string) string {
+		res = s
+		return ""
+	})
+	return res
+}
+
+// TestGenFunction verifies that the structured control flow of generated functions is well nested, and that every
+// branch targets an enclosing block or loop.
+func TestGenFunction(t *testing.T) {
+	for _, src := range []string{"while_test.vsl", "euclid.vsl", "return_nested.vsl", "if_test.vsl"} {
+		for _, ssa := range []bool{false, true} {
+			var labels []string
+			loops := 0
+			for _, e1 := range strings.Split(generate(t, src, ssa), "\n") {
+				f := strings.Fields(e1)
+				if len(f) < 1 {
+					continue
+				}
+				switch f[0] {
+				case "block", "loop":
+					labels = append(labels, f[1])
+					if f[0] == "loop" {
+						loops++
+					}
+				case "if":
+					labels = append(labels, "")
+				case "end":
+					if len(labels) < 1 {
+						t.Fatalf("%s: unbalanced end", src)
+					}
+					labels = labels[:len(labels)-1]
+				case "br":
+					found := false
+					for _, e2 := range labels {
+						found = found || e2 == f[1]
+					}
+					if !found {
+						t.Errorf("%s: branch to %s outside of its block", src, f[1])
+					}
+				}
+			}
+			if len(labels) > 0 {
+				t.Errorf("%s: %d unterminated blocks", src, len(labels))
+			}
+			if src == "while_test.vsl" && loops != 1 {
+				t.Errorf("%s: expected 1 loop, got %d", src, loops)
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package wasm
+
+import (
+	"errors"
+	"vslc/src/backend"
+	"vslc/src/backend/regfile"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// target implements backend.Target for WebAssembly.
+type target struct{}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+func init() {
+	backend.Register(util.Wasm, target{})
+}
+
+// Name returns the name of the target architecture.
+func (target) Name() string {
+	return "wasm"
+}
+
+// WordSize returns the width of integers in bits.
+func (target) WordSize() int {
+	return 64
+}
+
+// CreateRegisterFile returns nil, because WebAssembly is a stack machine whose values are held in locals.
+func (target) CreateRegisterFile(_ util.Options) regfile.RegisterFile {
+	return nil
+}
+
+// Validate returns an error for freestanding output, stack protection and integer overflow trapping, which aren't
+// supported. The exported main function takes its arguments from the host, hence the VSL runtime library isn't
+// supported either.
+func (target) Validate(opt util.Options) error {
+	switch {
+	case opt.Freestanding:
+		return errors.New("freestanding output is only supported for RISC-V")
+	case opt.Runtime:
+		return errors.New("the VSL runtime library isn't supported for WebAssembly")
+	case opt.SSP:
+		return errors.New("stack protector is not supported for WebAssembly")
+	case opt.Trapv:
+		return errors.New("integer overflow trapping is not supported for WebAssembly")
+	}
+	return nil
+}
+
+// Generate generates a WebAssembly text module of LIR Module m.
+func (target) Generate(opt util.Options, m *lir.Module, root *ir.Node) error {
+	return GenWasm(opt, m, root)
+}
+
+// Immediate returns false, because constants are pushed onto the operand stack.
+func (target) Immediate(_ util.Options, _ *lir.Constant) bool {
+	return false
+}
+
+// Select returns true, because the select instruction chooses between two operands.
+func (target) Select() bool {
+	return true
+}
@@ -0,0 +1,239 @@
+// Package wasm provides means to generate a WebAssembly text format (.wat) module from the lightweight intermediate
+// representation.
+//
+// Integers are i64 values, or i32 values given -m32, and floats are f64 values. Every VSL function is exported by name, and the first function of the
+// program is also exported as main. Strings are stored in the exported linear memory, named memory, as null-terminated
+// byte arrays. Print statements call the imported function env.printf, which takes the address of the format string
+// and the address of the variable argument list, and returns the number of bytes written. Print statements redirected
+// to another file, such as eprint, call the imported function env.dprintf, which takes the file descriptor first. The
+// variable argument list holds one 8-byte slot per argument, which is an i64 for %d and %lld and an f64 for %f
+// conversions, like a C va_list. i32 integers are sign extended to their slot. The host parses command-line arguments,
+// if any, and passes them to main, so the builtin functions argc and arg are not supported.
+package wasm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+import (
+	"vslc/src/backend"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// generator holds the configuration of the WebAssembly module generated for one compilation, which is derived from
+// its util.Options.
+type generator struct {
+	intType   string             // Value type of integers: i32 for 32-bit and i64 for 64-bit integers.
+	annotator *backend.Annotator // Writes the source lines and LIR instructions of functions as comments, if set.
+}
+
+// layout defines the addresses of the data stored in linear memory.
+type layout struct {
+	strings map[*lir.String]int // strings maps every String of the Module to its address.
+	args    int                 // args is the address of the variable argument list passed to printf.
+	size    int                 // size is the number of bytes of linear memory in use.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+const labelMain = "main"       // String literal of the name under which the program's entry function is exported.
+const labelPrintf = "printf"   // String literal of the name of the C library function printf, which is imported.
+const labelDprintf = "dprintf" // String literal of the name of the C library function dprintf, which is imported.
+
+const pageSize = 1 << 16 // Size of a WebAssembly memory page in bytes.
+const slotSize = 8       // Size of a slot of the variable argument list in bytes.
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// newGenerator returns the generator of the WebAssembly module of the compilation configured by opt.
+func newGenerator(opt util.Options) *generator {
+	return &generator{intType: fmt.Sprintf("i%d", opt.IntBits()), annotator: backend.NewAnnotator(opt, ";;")}
+}
+
+// GenWasm generates a WebAssembly text format module from the LIR Module m. The first function of the syntax tree root
+// is exported as main. No registers are allocated, because every LIR value is held in a WebAssembly local.
+func GenWasm(opt util.Options, m *lir.Module, root *ir.Node) error {
+	if m.UsesArgs() {
+		return errors.New("builtin functions argc and arg are not supported for WebAssembly")
+	}
+	g := newGenerator(opt)
+
+	// Find first defined function, which is exported as main.
+	var entry *lir.Function
+	for _, e1 := range root.Children {
+		if e1.Typ == ir.FUNCTION {
+			if entry = m.GetFunction(e1.Children[0].Data.Str); entry == nil {
+				return errors.New("no functions defined for module")
+			}
+			break
+		}
+	}
+	if entry == nil {
+		return errors.New("no functions defined for module")
+	}
+
+	// Only printf and dprintf are provided by the host.
+	printf, dprintf := false, false
+	for _, e1 := range m.Functions() {
+		if len(e1.Blocks()) > 0 {
+			if e1.Name() == labelMain && e1 != entry {
+				return fmt.Errorf("function %s clashes with the exported entry point", e1.Name())
+			}
+			continue
+		}
+		switch e1.Name() {
+		case labelPrintf:
+			printf = true
+		case labelDprintf:
+			dprintf = true
+		default:
+			return fmt.Errorf("external function %s is not supported for WebAssembly", e1.Name())
+		}
+	}
+	lay := newLayout(m)
+
+	// Generate imports, memory and data.
+	wr := opt.NewWriter()
+	defer wr.Close()
+	wr.Write(";; %s\n", opt.Src)
+	wr.Write("(module\n")
+	if printf {
+		wr.Write("\t(import \"env\" %q (func $%s (param i32 i32) (result i32)))\n", labelPrintf, labelPrintf)
+	}
+	if dprintf {
+		wr.Write("\t(import \"env\" %q (func $%s (param i32 i32 i32) (result i32)))\n", labelDprintf, labelDprintf)
+	}
+	wr.Write("\t(memory (export \"memory\") %d)\n", (lay.size+pageSize-1)/pageSize)
+	for _, e1 := range m.Globals() {
+		// VSL doesn't support variable initialisation on declaration.
+		typ := g.valType(e1.DataType())
+		wr.Write("\t(global $%s (mut %s) (%s.const 0))\n", e1.Name(), typ, typ)
+	}
+	for _, e1 := range m.Strings() {
+		wr.Write("\t(data (i32.const %d) %s)\n", lay.strings[e1], quote(e1.Value()+"\x00"))
+	}
+
+	// Generate functions.
+	ws := wr.Split(len(m.Functions()))
+	if opt.Threads > 1 {
+		// Parallel. Every function is generated to its own Writer, which are written in the order of the functions.
+		pool := opt.NewPool("codegen")
+		if err := pool.Run(opt.Context(), len(m.Functions()), func(w *util.Worker, i int) error {
+			e1 := m.Functions()[i]
+			if len(e1.Blocks()) < 1 {
+				return nil // External functions are only declared, and generate no code.
+			}
+			w.Log.Infof("generating function %s", e1.Name())
+			return g.genFunction(e1, lay, &ws[i])
+		}); err != nil {
+			return err
+		}
+	} else {
+		// Sequential.
+		for i1, e1 := range m.Functions() {
+			if err := g.genFunction(e1, lay, &ws[i1]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Export entry function as main.
+	if entry.Name() != labelMain {
+		wr.Write("\t(export %q (func $%s))\n", labelMain, entry.Name())
+	}
+	wr.Write(")\n")
+	return nil
+}
+
+// newLayout returns the layout of the linear memory of Module m. Strings are stored from address 0, followed by the
+// variable argument list, which is large enough to hold the arguments of every call to printf.
+func newLayout(m *lir.Module) *layout {
+	lay := &layout{strings: make(map[*lir.String]int, len(m.Strings()))}
+	for _, e1 := range m.Strings() {
+		lay.strings[e1] = lay.size
+		lay.size += len(e1.Value()) + 1
+	}
+	if res := lay.size % slotSize; res != 0 {
+		lay.size += slotSize - res
+	}
+	lay.args = lay.size
+
+	// Find the largest variable argument list.
+	n := 0
+	for _, e1 := range m.Functions() {
+		for _, e2 := range e1.Blocks() {
+			for _, e3 := range e2.Instructions() {
+				if l, ok := e3.(*lir.VaList); ok && len(l.Values()) > n {
+					n = len(l.Values())
+				}
+			}
+		}
+	}
+	lay.size += slotSize * n
+	if lay.size == 0 {
+		lay.size = 1 // Export at least one page of memory.
+	}
+	return lay
+}
+
+// valType returns the WebAssembly value type of the LIR data type typ. Strings are addresses in linear memory.
+func (g *generator) valType(typ types.DataType) string {
+	switch typ {
+	case types.Int:
+		return g.intType
+	case types.Float:
+		return "f64"
+	default:
+		return "i32"
+	}
+}
+
+// quote returns the WebAssembly string literal of s. Printable ASCII characters are written as is, except for quotes
+// and backslashes, and all other bytes are written as hexadecimal escapes.
+func quote(s string) string {
+	sb := strings.Builder{}
+	sb.Grow(len(s) + 2)
+	sb.WriteByte('"')
+	for i1 := 0; i1 < len(s); i1++ {
+		c := s[i1]
+		if c < 0x20 || c >= 0x7f || c == '"' || c == '\\' {
+			sb.WriteString(fmt.Sprintf("\\%02x", c))
+		} else {
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// float returns the shortest WebAssembly literal of the float f that reads back as f.
+func float(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "nan"
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,46 @@
+package wasm
+
+import (
+	"math"
+	"testing"
+)
+
+// TestQuote verifies that string literals escape quotes, backslashes and non-printable bytes.
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp string
+	}{
+		{"", `""`},
+		{"Hello, world!", `"Hello, world!"`},
+		{"%d\n\x00", `"%d\0a\00"`},
+		{`a "b" \c`, `"a \22b\22 \5cc"`},
+		{"\xc3\xb8", `"\c3\b8"`},
+	}
+	for _, e1 := range tests {
+		if res := quote(e1.s); res != e1.exp {
+			t.Errorf("expected quote(%q) to be %s, got %s", e1.s, e1.exp, res)
+		}
+	}
+}
+
+// TestFloat verifies that float literals read back exactly, and that non-finite floats use WebAssembly keywords.
+func TestFloat(t *testing.T) {
+	tests := []struct {
+		f   float64
+		exp string
+	}{
+		{0, "0"},
+		{3.14, "3.14"},
+		{-0.5, "-0.5"},
+		{1e21, "1e+21"},
+		{math.Inf(1), "inf"},
+		{math.Inf(-1), "-inf"},
+		{math.NaN(), "nan"},
+	}
+	for _, e1 := range tests {
+		if res := float(e1.f); res != e1.exp {
+			t.Errorf("expected float(%g) to be %s, got %s", e1.f, e1.exp, res)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+// format.go provides the formatter of VSL source code, which indents statements by their nesting and spaces the
+// tokens of every line uniformly, keeping the line breaks and comments of the source code.
+
+package frontend
+
+import "strings"
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// formatter computes the indentation level of the tokens of VSL source code, following the structure of its grammar.
+type formatter struct {
+	tokens []item // tokens are the tokens of the source code, excluding EOF.
+	levels []int  // levels are the indentation levels of the tokens, which apply to the first token of each line.
+	i      int    // i is the index of the next token.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// indent is the indentation of one level.
+const indent = "    "
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Format returns the VSL source code src formatted. Statements are indented by four spaces per level of nesting, the
+// bodies of functions, if and while statements by one level unless they're blocks, and the lines that continue a
+// statement by one level. Binary operators are surrounded by spaces, and commas are followed by one. Line breaks and
+// comments are kept, though consecutive blank lines are merged. An error is returned if src can't be parsed.
+func Format(src string) (string, error) {
+	if _, err := parse(src, nil, nil); err != nil {
+		return "", err
+	}
+	f := formatter{tokens: lex(src)}
+	f.levels = make([]int, len(f.tokens))
+	for f.i < len(f.tokens) {
+		if f.peek() == DEF {
+			f.function()
+		} else {
+			f.declaration(0)
+		}
+	}
+
+	// Tokens by source line.
+	lines := strings.Split(strings.TrimRight(src, " \t\r\n"), "\n")
+	byLine := make([][]int, len(lines))
+	for i1, e1 := range f.tokens {
+		if e1.line-1 < len(byLine) {
+			byLine[e1.line-1] = append(byLine[e1.line-1], i1)
+		}
+	}
+
+	sb := strings.Builder{}
+	blank := true // Leading blank lines are removed.
+	for i1, e1 := range lines {
+		// Comments are found after the last token of the line, where they can't be part of a string.
+		end := 0
+		if toks := byLine[i1]; len(toks) > 0 {
+			t := f.tokens[toks[len(toks)-1]]
+			end = t.pos - 1 + len(t.val)
+			if t.typ == STRING {
+				end++
+			}
+		}
+		comment := ""
+		if end < len(e1) {
+			if i2 := strings.Index(e1[end:], "//"); i2 >= 0 {
+				comment = strings.TrimRight(e1[end+i2:], " \t\r")
+			}
+		}
+		if len(byLine[i1]) == 0 && len(comment) == 0 {
+			if !blank {
+				sb.WriteRune('\n')
+			}
+			blank = true
+			continue
+		}
+		blank = false
+
+		// Comment lines are indented like the next line of code.
+		level := 0
+		if len(byLine[i1]) > 0 {
+			level = f.levels[byLine[i1][0]]
+		} else {
+			for _, e2 := range byLine[i1:] {
+				if len(e2) > 0 {
+					level = f.levels[e2[0]]
+					break
+				}
+			}
+		}
+		sb.WriteString(strings.Repeat(indent, level))
+		for i2, e2 := range byLine[i1] {
+			if i2 > 0 && f.space(e2) {
+				sb.WriteRune(' ')
+			}
+			if f.tokens[e2].typ == STRING {
+				sb.WriteString("\"" + f.tokens[e2].val + "\"")
+			} else {
+				sb.WriteString(f.tokens[e2].val)
+			}
+		}
+		if len(comment) > 0 {
+			if len(byLine[i1]) > 0 {
+				sb.WriteRune(' ')
+			}
+			sb.WriteString(comment)
+		}
+		sb.WriteRune('\n')
+	}
+	return sb.String(), nil
+}
+
+// lex returns the tokens of VSL source code src, excluding EOF.
+func lex(src string) []item {
+	l := newLexer(src, lexGlobal)
+	go l.run()
+	defer l.stop()
+	var res []item
+	for t := l.nextItem(); t.typ != itemEOF && t.typ != itemError; t = l.nextItem() {
+		res = append(res, t)
+	}
+	return res
+}
+
+// function indents a function definition, whose body is indented by one level unless it's a block.
+func (f *formatter) function() {
+	f.next(0)
+	for f.i < len(f.tokens) && f.peek() != ')' {
+		f.next(1)
+	}
+	f.next(1) // Closing parenthesis.
+	f.next(1) // Return type.
+	f.statement(1, 0)
+}
+
+// declaration indents a variable declaration at level.
+func (f *formatter) declaration(level int) {
+	f.next(level)
+	for f.i < len(f.tokens) && f.tokens[f.i-1].typ != TYPE {
+		f.next(level + 1)
+	}
+}
+
+// statement indents a statement at level, or at level block if it's a block, whose statements are indented by one
+// more level.
+func (f *formatter) statement(level, block int) {
+	switch f.peek() {
+	case BEGIN:
+		f.next(block)
+		for f.peek() == VAR {
+			f.declaration(block + 1)
+		}
+		for f.i < len(f.tokens) && f.peek() != END {
+			f.statement(block+1, block+1)
+		}
+		f.next(block)
+	case IF, WHILE:
+		f.next(level)
+		for f.i < len(f.tokens) && f.peek() != THEN && f.peek() != DO {
+			f.next(level + 1)
+		}
+		f.next(level + 1)
+		f.statement(level+1, level)
+		if f.peek() == ELSE {
+			f.next(level)
+			f.statement(level+1, level)
+		}
+	default:
+		// Assignment, return, print and continue statements end before the next keyword, or the next identifier that
+		// doesn't follow an operator.
+		f.next(level)
+		for f.i < len(f.tokens) {
+			switch f.peek() {
+			case BEGIN, END, IF, WHILE, ELSE, RETURN, PRINT, CONTINUE, VAR, DEF:
+				return
+			case IDENTIFIER:
+				if operand(f.tokens[f.i-1]) {
+					return
+				}
+			}
+			f.next(level + 1)
+		}
+	}
+}
+
+// peek returns the type of the next token, or itemEOF if there are none.
+func (f *formatter) peek() itemType {
+	if f.i < len(f.tokens) {
+		return f.tokens[f.i].typ
+	}
+	return itemEOF
+}
+
+// next indents the next token at level, if any.
+func (f *formatter) next(level int) {
+	if f.i < len(f.tokens) {
+		f.levels[f.i] = level
+		f.i++
+	}
+}
+
+// space returns true if token i is preceded by a space on its line.
+func (f *formatter) space(i int) bool {
+	prev, t := f.tokens[i-1], f.tokens[i]
+	switch {
+	case t.typ == ')' || t.typ == ',' || prev.typ == '(' || prev.typ == '~':
+		return false
+	case prev.typ == '-':
+		// Unary minus, which follows an operator rather than an operand.
+		return i > 1 && operand(f.tokens[i-2])
+	case t.typ == '(':
+		return prev.typ != IDENTIFIER
+	}
+	return true
+}
+
+// operand returns true if token t ends an operand, or a continue statement, such that the next identifier begins a new
+// statement.
+func operand(t item) bool {
+	switch t.typ {
+	case IDENTIFIER, INTEGER, FLOAT, STRING, ')', CONTINUE:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package frontend
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// TestFormat verifies that source code is indented by its nesting and spaced uniformly, keeping comments and line
+// breaks.
+func TestFormat(t *testing.T) {
+	exp := []struct {
+		src string
+		out string
+	}{
+		{
+			src: "def f ( a,b int ) int\nreturn a+b*-a // Sum.",
+			out: "def f(a, b int) int\n    return a + b * -a // Sum.\n",
+		},
+		{
+			src: "\n\nvar x int\n\n\n// Comment.\n  def g() int\nbegin\nvar a int\nif a>0 then\nbegin\na:=g( )\nprint \"a // b\",a\nend\n" +
+				"else\n  a := 1\n\t  while a < 10 do a := a +\n1\n        return -(a)\nend\n",
+			out: "var x int\n\n// Comment.\ndef g() int\nbegin\n    var a int\n    if a > 0 then\n    begin\n        a := g()\n" +
+				"        print \"a // b\", a\n    end\n    else\n        a := 1\n    while a < 10 do a := a +\n            1\n" +
+				"    return -(a)\nend\n",
+		},
+	}
+	for _, e1 := range exp {
+		out, err := Format(e1.src)
+		if err != nil {
+			t.Errorf("%q: %s", e1.src, err)
+		} else if out != e1.out {
+			t.Errorf("expected %q, got %q", e1.out, out)
+		}
+	}
+	if _, err := Format("def f() int\nreturn"); err == nil {
+		t.Error("expected syntax error, got none")
+	}
+}
+
+// TestFormatIdempotent verifies that the bundled VSL programs are unchanged when formatted twice.
+func TestFormatIdempotent(t *testing.T) {
+	paths, err := filepath.Glob("../../resources/vsl_typed/*.vsl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e1 := range paths {
+		b, err := ioutil.ReadFile(e1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := Format(string(b))
+		if err != nil {
+			t.Errorf("%s: %s", e1, err)
+			continue
+		}
+		if out2, err := Format(out); err != nil || out2 != out {
+			t.Errorf("%s: expected formatted source code to be unchanged, got %q", e1, out2)
+		}
+	}
+}
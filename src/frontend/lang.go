@@ -39,9 +39,13 @@ var rw = [...][]reservedItem{
 	// Six-grams
 	{
 		{val: "return", typ: RETURN},
+		{val: "eprint", typ: PRINT},
+		{val: "printf", typ: PRINT},
 	},
 	// Seven-grams
-	{},
+	{
+		{val: "eprintf", typ: PRINT},
+	},
 	// Eight-grams
 	{
 		{val: "continue", typ: CONTINUE},
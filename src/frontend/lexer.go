@@ -9,10 +9,11 @@
 package frontend
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 	"unicode/utf8"
+	"vslc/src/ir"
+	"vslc/src/util"
 )
 
 // ----------------------------
@@ -35,15 +36,29 @@ type item struct {
 
 // lexer is a lexical type that traverse a source stream character by character and emits lexemes.
 type lexer struct {
-	input       string     // The source stream of characters to scan for lexemes.
-	start       int        // The starting position of the current token.
-	pos         int        // The current position of the scanner in the source stream.
-	width       int        // The width of the currently scanned rune/character in bytes.
-	line        int        // The current line in the source stream. Not zero-indexed.
-	startOnLine int        // The start position of the current token on the current line. Not zero-indexed.
-	state       stateFunc  // The start state of the lexer.
-	err         chan error // A channel for reporting errors.
-	items       chan item  // A channel for emitting item tokens.
+	input       string         // The source stream of characters to scan for lexemes.
+	start       int            // The starting position of the current token.
+	pos         int            // The current position of the scanner in the source stream.
+	width       int            // The width of the currently scanned rune/character in bytes.
+	line        int            // The current line in the source stream. Not zero-indexed.
+	startOnLine int            // The start position of the current token on the current line. Not zero-indexed.
+	state       stateFunc      // The start state of the lexer.
+	items       chan item      // A channel for emitting item tokens, which is closed by the lexer when it stops.
+	done        chan struct{}  // Closed once by the consumer of the tokens when it stops reading them, see stop.
+	stopped     bool           // Set true by the lexer when done is closed, which ends the scan.
+	root        *ir.Node       // The root node of the syntax tree, set by the parser.
+	last        item           // The last token passed to the parser, which is where syntax errors are reported.
+	fail        error          // The first lexical or syntax error, reported as a util.SyntaxError. Parser only.
+	comments    []Comment      // The comments of the source stream, which aren't passed to the parser.
+	names       *util.Interner // Interns the identifiers, types and strings of the syntax tree. Nil if not interned.
+	nodes       *ir.Arena      // Allocates the Nodes of the syntax tree. Nil if they're allocated one by one.
+}
+
+// Comment is a comment of the source code, which the parser ignores.
+type Comment struct {
+	Line int    // Line is the source line of the comment.
+	Pos  int    // Pos is the position of the comment on its line.
+	Text string // Text is the comment, including the leading //.
 }
 
 // ---------------------
@@ -52,6 +67,8 @@ type lexer struct {
 
 const eof = 0 // Same as '\0' for null-terminated C strings.
 
+const maxFileDigits = 9 // Maximum number of digits of the file descriptor of a redirected print statement.
+
 const (
 	itemEOF itemType = iota
 	itemError
@@ -83,6 +100,10 @@ func (i item) String() string {
 // A token compatible with the goyacc parser is put in the lval argument, and the token type is returned.
 func (l *lexer) Lex(lval *yySymType) int {
 	i := l.nextItem()
+	l.last = i
+	if i.typ == itemError && l.fail == nil {
+		l.fail = util.SyntaxErrorf(i.line, i.pos, "%s", i.val)
+	}
 	lval.typ = int(i.typ)
 	lval.val = i.val
 	lval.line = i.line
@@ -90,9 +111,20 @@ func (l *lexer) Lex(lval *yySymType) int {
 	return int(i.typ)
 }
 
-// Called by the parser when a parse error is encountered.
+// Called by the parser when a parse error is encountered, which is reported at the last token passed to the parser.
+// Only the first error is kept, which is returned once the parser returns. The parser stops the lexer then.
 func (l *lexer) Error(e string) {
-	l.err <- errors.New(e)
+	if l.fail == nil {
+		l.fail = util.SyntaxErrorf(l.last.line, l.last.pos, "%s", e)
+	}
+}
+
+// failAt reports a syntax error at line and pos of the source code, whose message is formatted like fmt.Errorf, unless
+// an error has been reported already.
+func (l *lexer) failAt(line, pos int, format string, args ...interface{}) {
+	if l.fail == nil {
+		l.fail = util.SyntaxErrorf(line, pos, format, args...)
+	}
 }
 
 // newLexer creates and returns a pointer to a new lexer.
@@ -105,42 +137,48 @@ func newLexer(src string, start stateFunc) *lexer {
 		line:        1,
 		startOnLine: 1,
 		state:       start,
-		err:         make(chan error),
 		items:       make(chan item, 2),
+		done:        make(chan struct{}),
 	}
 }
 
 // run initiates the traversal of the input stream of the lexer, resulting in tokens being emitted
-// on the lexer's items channel.
+// on the lexer's items channel. The scan ends at the end of the input, at a lexical error or once the consumer calls
+// stop, and the items channel is closed, which is the only channel the lexer closes.
 func (l *lexer) run() {
 	defer close(l.items)
-	defer close(l.err)
-	for state := l.state; state != nil; {
-		select {
-		case err := <-l.err:
-			fmt.Printf("Syntax error: %s\n", err)
-			return
-		default:
-			state = state(l)
-		}
+	for state := l.state; state != nil && !l.stopped; {
+		state = state(l)
+	}
+}
+
+// stop tells the lexer that no more tokens are read, such that it stops instead of blocking on emitting them. It must
+// be called exactly once by the consumer of the tokens, which owns the done channel.
+func (l *lexer) stop() {
+	close(l.done)
+}
+
+// send sends the item i to the consumer, unless the consumer has stopped reading items, which stops the lexer.
+func (l *lexer) send(i item) {
+	select {
+	case l.items <- i:
+	case <-l.done:
+		l.stopped = true
 	}
 }
 
 // emit sends an item of type typ back to the caller.
 func (l *lexer) emit(typ itemType) {
-	defer func() {
-		if r := recover(); r != nil {
-			// Send on closed channel.
-			l.state = nil // Stop the lexer.
-		}
-	}()
-
-	l.items <- item{
+	val := l.input[l.start:l.pos]
+	if l.names != nil && (typ == IDENTIFIER || typ == TYPE || typ == STRING) {
+		val = l.names.Intern(val)
+	}
+	l.send(item{
 		typ:  typ,
-		val:  l.input[l.start:l.pos],
+		val:  val,
 		line: l.line,
 		pos:  l.startOnLine,
-	}
+	})
 	l.startOnLine += len(l.input[l.start:l.pos])
 	l.start = l.pos
 }
@@ -201,11 +239,11 @@ func (l *lexer) nextItem() item {
 // errorf returns an error token and terminates the scan by passing back a nil pointer
 // that will be the next state, terminating l.run.
 func (l *lexer) errorf(format string, args ...interface{}) stateFunc {
-	l.items <- item{
+	l.send(item{
 		typ:  itemError,
 		val:  fmt.Sprintf(format, args...),
-		line: 0,
-		pos:  0,
-	}
+		line: l.line,
+		pos:  l.startOnLine,
+	})
 	return nil
 }
@@ -1,6 +1,9 @@
 package frontend
 
-import "unicode/utf8"
+import (
+	"strings"
+	"unicode/utf8"
+)
 
 // lexGlobal starts the lexing process and serves as the default state.
 func lexGlobal(l *lexer) stateFunc {
@@ -34,13 +37,19 @@ func lexGlobal(l *lexer) stateFunc {
 			l.next()
 			l.emit(LSHIFT)
 		case r == '>' && l.peek() == '>':
-			// Right shift operator.
+			// Right shift operator, which is arithmetic, or logical if it's >>>. Both share the RSHIFT token, whose
+			// value tells them apart.
 			l.next()
+			if l.peek() == '>' {
+				l.next()
+			}
 			l.emit(RSHIFT)
 		case r == '/' && l.peek() == '/':
 			// Ignore comments.
-			for c := l.next(); c != '\n'; c = l.next() {
+			for c := l.next(); c != '\n' && c != eof; c = l.next() {
 			}
+			text := strings.TrimRight(l.input[l.start:l.pos], "\r\n")
+			l.comments = append(l.comments, Comment{Line: l.line, Pos: l.startOnLine, Text: text})
 			l.ignore()
 			l.line++
 			l.startOnLine = 1
@@ -65,6 +74,9 @@ func lexWord(l *lexer) stateFunc {
 		if !isAlpha(r) && !isDigit(r) && r != '_' {
 			l.backup()
 			kw, typ := isKeyword(l.input[l.start:l.pos])
+			if kw && typ == PRINT && !lexRedirect(l) {
+				return l.errorf("expected file descriptor after %q", strings.TrimSpace(l.input[l.start:l.pos]))
+			}
 			if kw {
 				l.emit(typ)
 			} else {
@@ -75,6 +87,22 @@ func lexWord(l *lexer) stateFunc {
 	}
 }
 
+// lexRedirect extends the print or printf keyword scanned by the redirection of its output to a file descriptor, such
+// as print > 2, if any. The redirection is part of the PRINT token, as a print list can't start with '>'. It returns
+// false if the '>' isn't followed by a file descriptor of at most maxFileDigits digits.
+func lexRedirect(l *lexer) bool {
+	pos := l.pos
+	l.acceptRun(" \t")
+	if kw := l.input[l.start:pos]; kw != "print" && kw != "printf" || !l.accept(">") || l.peek() == '>' {
+		l.pos = pos
+		return true
+	}
+	l.acceptRun(" \t")
+	digits := l.pos
+	l.acceptRun("0123456789")
+	return l.pos > digits && l.pos-digits <= maxFileDigits
+}
+
 // lexNumber scans the input stream for an integer number.
 // This function accepts zero leading numbers and numbers consisting of all zeros.
 func lexNumber(l *lexer) stateFunc {
@@ -109,7 +137,7 @@ func lexString(l *lexer) stateFunc {
 	for {
 		r := l.next()
 		if r == eof {
-			return l.errorf("unclosed string literal at line %d:%d", l.line, l.startOnLine)
+			return l.errorf("unclosed string literal")
 		}
 		// Check for escaped string termination (\").
 		if r == '"' && prev != '\\' {
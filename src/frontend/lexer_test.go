@@ -133,6 +133,7 @@ func TestLexer(t *testing.T) {
 
 	l := newLexer(s, lexGlobal)
 	go l.run()
+	defer l.stop()
 
 	for i1 := 0; ; i1++ {
 		tok := l.nextItem()
@@ -29,7 +29,7 @@ import "vslc/src/ir"
 
 %token DEF BEGIN END RETURN PRINT IF THEN ELSE WHILE DO CONTINUE VAR    // Reserved words.
 %token INTEGER FLOAT IDENTIFIER STRING                                  // Data 'terminals'.
-%token LSHIFT RSHIFT                                                    // Bitwise operators left and right shift.
+%token LSHIFT RSHIFT                                                    // Bitwise operators left and right shift (>> or >>>).
 %token ASSIGN                                                           // The assignment operator (:=).
 %token TYPE                                                             // Datatype (int or float).
 
@@ -37,96 +37,96 @@ import "vslc/src/ir"
 
 %%
 
-program             :   global_list                                     { ir.Root = nodeInit(ir.PROGRAM, nil, $1.line, $1.pos, $1).node }
+program             :   global_list                                     { yylex.(*lexer).root = nodeInit(yylex, ir.PROGRAM, "", $1.line, $1.pos, $1).node }
 
-global_list         :   global                                          { $$ = nodeInit(ir.GLOBAL_LIST, nil, $1.line, $1.pos, $1) }
-                    |   global_list global                              { $$ = nodeInit(ir.GLOBAL_LIST, nil, $1.line, $1.pos, $1, $2) }
+global_list         :   global                                          { $$ = nodeInit(yylex, ir.GLOBAL_LIST, "", $1.line, $1.pos, $1) }
+                    |   global_list global                              { $$ = nodeInit(yylex, ir.GLOBAL_LIST, "", $1.line, $1.pos, $1, $2) }
 
-global              :   function                                        { $$ = nodeInit(ir.GLOBAL, nil, $1.line, $1.pos, $1) }
-                    |   declaration                                     { $$ = nodeInit(ir.GLOBAL, nil, $1.line, $1.pos, $1) }
+global              :   function                                        { $$ = nodeInit(yylex, ir.GLOBAL, "", $1.line, $1.pos, $1) }
+                    |   declaration                                     { $$ = nodeInit(yylex, ir.GLOBAL, "", $1.line, $1.pos, $1) }
 
-statement_list      :   statement                                       { $$ = nodeInit(ir.STATEMENT_LIST, nil, $1.line, $1.pos, $1) }
-                    |   statement_list statement                        { $$ = nodeInit(ir.STATEMENT_LIST, nil, $1.line, $1.pos, $1, $2) }
+statement_list      :   statement                                       { $$ = nodeInit(yylex, ir.STATEMENT_LIST, "", $1.line, $1.pos, $1) }
+                    |   statement_list statement                        { $$ = nodeInit(yylex, ir.STATEMENT_LIST, "", $1.line, $1.pos, $1, $2) }
 
-print_list          :   print_item                                      { $$ = nodeInit(ir.PRINT_LIST, nil, $1.line, $1.pos, $1) }
-                    |   print_list ',' print_item                       { $$ = nodeInit(ir.PRINT_LIST, nil, $1.line, $1.pos, $1, $3) }
+print_list          :   print_item                                      { $$ = nodeInit(yylex, ir.PRINT_LIST, "", $1.line, $1.pos, $1) }
+                    |   print_list ',' print_item                       { $$ = nodeInit(yylex, ir.PRINT_LIST, "", $1.line, $1.pos, $1, $3) }
 
-expression_list     :   expression                                      { $$ = nodeInit(ir.EXPRESSION_LIST, nil, $1.line, $1.pos, $1) }
-                    |   expression_list ',' expression                  { $$ = nodeInit(ir.EXPRESSION_LIST, nil, $1.line, $1.pos, $1, $3) }
+expression_list     :   expression                                      { $$ = nodeInit(yylex, ir.EXPRESSION_LIST, "", $1.line, $1.pos, $1) }
+                    |   expression_list ',' expression                  { $$ = nodeInit(yylex, ir.EXPRESSION_LIST, "", $1.line, $1.pos, $1, $3) }
 
-typed_variable_list :   variable_list type                              { $$ = nodeInit(ir.TYPED_VARIABLE_LIST, nil, $1.line, $1.pos, $2, $1) }
+typed_variable_list :   variable_list type                              { $$ = nodeInit(yylex, ir.TYPED_VARIABLE_LIST, "", $1.line, $1.pos, $2, $1) }
 
-variable_list       :   identifier                                      { $$ = nodeInit(ir.VARIABLE_LIST, nil, $1.line, $1.pos, $1) }
-                    |   variable_list ',' identifier                    { $$ = nodeInit(ir.VARIABLE_LIST, nil, $1.line, $1.pos, $1, $3) }
+variable_list       :   identifier                                      { $$ = nodeInit(yylex, ir.VARIABLE_LIST, "", $1.line, $1.pos, $1) }
+                    |   variable_list ',' identifier                    { $$ = nodeInit(yylex, ir.VARIABLE_LIST, "", $1.line, $1.pos, $1, $3) }
 
-argument_list       :   expression_list                                 { $$ = nodeInit(ir.ARGUMENT_LIST, nil, $1.line, $1.pos, $1) }
-                    |                                                   { $$ = nodeInit(ir.PARAMETER_LIST, nil, 0, 0) }
+argument_list       :   expression_list                                 { $$ = nodeInit(yylex, ir.ARGUMENT_LIST, "", $1.line, $1.pos, $1) }
+                    |                                                   { $$ = nodeInit(yylex, ir.PARAMETER_LIST, "", 0, 0) }
 
-parameter_list      :   typed_variable_list                             { $$ = nodeInit(ir.PARAMETER_LIST, nil, $1.line, $1.pos, $1) }
-                    |   parameter_list ',' typed_variable_list          { $$ = nodeInit(ir.PARAMETER_LIST, nil, $1.line, $1.pos, $1, $3) }
-                    |                                                   { $$ = nodeInit(ir.PARAMETER_LIST, nil, 0, 0) }
+parameter_list      :   typed_variable_list                             { $$ = nodeInit(yylex, ir.PARAMETER_LIST, "", $1.line, $1.pos, $1) }
+                    |   parameter_list ',' typed_variable_list          { $$ = nodeInit(yylex, ir.PARAMETER_LIST, "", $1.line, $1.pos, $1, $3) }
+                    |                                                   { $$ = nodeInit(yylex, ir.PARAMETER_LIST, "", 0, 0) }
 
-declaration_list    :   declaration                                     { $$ = nodeInit(ir.DECLARATION_LIST, nil, $1.line, $1.pos, $1) }
-                    |   declaration_list declaration                    { $$ = nodeInit(ir.DECLARATION_LIST, nil, $1.line, $1.pos, $1, $2) }
+declaration_list    :   declaration                                     { $$ = nodeInit(yylex, ir.DECLARATION_LIST, "", $1.line, $1.pos, $1) }
+                    |   declaration_list declaration                    { $$ = nodeInit(yylex, ir.DECLARATION_LIST, "", $1.line, $1.pos, $1, $2) }
 
-function            :   DEF identifier '(' parameter_list ')' type statement { $$ = nodeInit(ir.FUNCTION, nil, $1.line, $1.pos, $2, $6, $4, $7) }
+function            :   DEF identifier '(' parameter_list ')' type statement { $$ = nodeInit(yylex, ir.FUNCTION, "", $1.line, $1.pos, $2, $6, $4, $7) }
 
-statement           :   assign_statement                                { $$ = nodeInit(ir.STATEMENT, nil, $1.line, $1.pos, $1) }
-                    |   return_statement                                { $$ = nodeInit(ir.STATEMENT, nil, $1.line, $1.pos, $1) }
-                    |   print_statement                                 { $$ = nodeInit(ir.STATEMENT, nil, $1.line, $1.pos, $1) }
-                    |   if_statement                                    { $$ = nodeInit(ir.STATEMENT, nil, $1.line, $1.pos, $1) }
-                    |   while_statement                                 { $$ = nodeInit(ir.STATEMENT, nil, $1.line, $1.pos, $1) }
-                    |   null_statement                                  { $$ = nodeInit(ir.STATEMENT, nil, $1.line, $1.pos, $1) }
-                    |   block                                           { $$ = nodeInit(ir.STATEMENT, nil, $1.line, $1.pos, $1) }
+statement           :   assign_statement                                { $$ = nodeInit(yylex, ir.STATEMENT, "", $1.line, $1.pos, $1) }
+                    |   return_statement                                { $$ = nodeInit(yylex, ir.STATEMENT, "", $1.line, $1.pos, $1) }
+                    |   print_statement                                 { $$ = nodeInit(yylex, ir.STATEMENT, "", $1.line, $1.pos, $1) }
+                    |   if_statement                                    { $$ = nodeInit(yylex, ir.STATEMENT, "", $1.line, $1.pos, $1) }
+                    |   while_statement                                 { $$ = nodeInit(yylex, ir.STATEMENT, "", $1.line, $1.pos, $1) }
+                    |   null_statement                                  { $$ = nodeInit(yylex, ir.STATEMENT, "", $1.line, $1.pos, $1) }
+                    |   block                                           { $$ = nodeInit(yylex, ir.STATEMENT, "", $1.line, $1.pos, $1) }
 
-block               :   BEGIN declaration_list statement_list END       { $$ = nodeInit(ir.BLOCK, nil, $1.line, $1.pos, $2, $3) }
-                    |   BEGIN statement_list END                        { $$ = nodeInit(ir.BLOCK, nil, $1.line, $1.pos, $2) }
+block               :   BEGIN declaration_list statement_list END       { $$ = nodeInit(yylex, ir.BLOCK, "", $1.line, $1.pos, $2, $3) }
+                    |   BEGIN statement_list END                        { $$ = nodeInit(yylex, ir.BLOCK, "", $1.line, $1.pos, $2) }
 
-assign_statement    :   identifier ASSIGN expression                    { $$ = nodeInit(ir.ASSIGNMENT_STATEMENT, nil, $1.line, $1.pos, $1, $3) }
+assign_statement    :   identifier ASSIGN expression                    { $$ = nodeInit(yylex, ir.ASSIGNMENT_STATEMENT, "", $1.line, $1.pos, $1, $3) }
 
-return_statement    :   RETURN expression                               { $$ = nodeInit(ir.RETURN_STATEMENT, nil, $1.line, $1.pos, $2) }
+return_statement    :   RETURN expression                               { $$ = nodeInit(yylex, ir.RETURN_STATEMENT, "", $1.line, $1.pos, $2) }
 
-print_statement     :   PRINT print_list                                { $$ = nodeInit(ir.PRINT_STATEMENT, nil, $1.line, $1.pos, $2) }
+print_statement     :   PRINT print_list                                { $$ = nodeInit(yylex, printType($1.val), $1.val, $1.line, $1.pos, $2) }
 
-null_statement      :   CONTINUE                                        { $$ = nodeInit(ir.NULL_STATEMENT, nil, $1.line, $1.pos) }
+null_statement      :   CONTINUE                                        { $$ = nodeInit(yylex, ir.NULL_STATEMENT, "", $1.line, $1.pos) }
 
-if_statement        :   IF relation THEN statement                      { $$ = nodeInit(ir.IF_STATEMENT, nil, $1.line, $1.pos, $2, $4) }
-                    |   IF relation THEN statement ELSE statement       { $$ = nodeInit(ir.IF_STATEMENT, nil, $1.line, $1.pos, $2, $4, $6) }
+if_statement        :   IF relation THEN statement                      { $$ = nodeInit(yylex, ir.IF_STATEMENT, "", $1.line, $1.pos, $2, $4) }
+                    |   IF relation THEN statement ELSE statement       { $$ = nodeInit(yylex, ir.IF_STATEMENT, "", $1.line, $1.pos, $2, $4, $6) }
 
-while_statement     :   WHILE relation DO statement                     { $$ = nodeInit(ir.WHILE_STATEMENT, nil, $1.line, $1.pos, $2, $4) }
+while_statement     :   WHILE relation DO statement                     { $$ = nodeInit(yylex, ir.WHILE_STATEMENT, "", $1.line, $1.pos, $2, $4) }
 
-relation            :   expression '=' expression                       { $$ = nodeInit(ir.RELATION, "=", $1.line, $1.pos, $1, $3) }
-                    |   expression '<' expression                       { $$ = nodeInit(ir.RELATION, "<", $1.line, $1.pos, $1, $3) }
-                    |   expression '>' expression                       { $$ = nodeInit(ir.RELATION, ">", $1.line, $1.pos, $1, $3) }
+relation            :   expression '=' expression                       { $$ = nodeInit(yylex, ir.RELATION, "=", $1.line, $1.pos, $1, $3) }
+                    |   expression '<' expression                       { $$ = nodeInit(yylex, ir.RELATION, "<", $1.line, $1.pos, $1, $3) }
+                    |   expression '>' expression                       { $$ = nodeInit(yylex, ir.RELATION, ">", $1.line, $1.pos, $1, $3) }
 
-expression          :   expression '+' expression                       { $$ = nodeInit(ir.EXPRESSION, "+", $1.line, $1.pos, $1, $3) }
-                    |   expression '-' expression                       { $$ = nodeInit(ir.EXPRESSION, "-", $1.line, $1.pos, $1, $3) }
-                    |   expression '*' expression                       { $$ = nodeInit(ir.EXPRESSION, "*", $1.line, $1.pos, $1, $3) }
-                    |   expression '/' expression                       { $$ = nodeInit(ir.EXPRESSION, "/", $1.line, $1.pos, $1, $3) }
-                    |   expression '|' expression                       { $$ = nodeInit(ir.EXPRESSION, "|", $1.line, $1.pos, $1, $3) }
-                    |   expression '^' expression                       { $$ = nodeInit(ir.EXPRESSION, "^", $1.line, $1.pos, $1, $3) }
-                    |   expression '&' expression                       { $$ = nodeInit(ir.EXPRESSION, "&", $1.line, $1.pos, $1, $3) }
-                    |   expression LSHIFT expression                    { $$ = nodeInit(ir.EXPRESSION, "<<", $1.line, $1.pos, $1, $3) }
-                    |   expression RSHIFT expression                    { $$ = nodeInit(ir.EXPRESSION, ">>", $1.line, $1.pos, $1, $3) }
-                    |   '-' expression %prec UMINUS                     { $$ = nodeInit(ir.EXPRESSION, "-", $1.line, $1.pos, $2) }
-                    |   '~' expression                                  { $$ = nodeInit(ir.EXPRESSION, "~", $1.line, $1.pos, $2) }
-                    |   '(' expression ')'                              { $$ = nodeInit(ir.EXPRESSION, nil, $2.line, $2.pos, $2) }
-                    |   number                                          { $$ = nodeInit(ir.EXPRESSION, nil, $1.line, $1.pos, $1) }
-                    |   identifier                                      { $$ = nodeInit(ir.EXPRESSION, nil, $1.line, $1.pos, $1) }
-                    |   identifier '(' argument_list ')'                { $$ = nodeInit(ir.EXPRESSION, nil, $1.line, $1.pos, $1, $3) }
+expression          :   expression '+' expression                       { $$ = nodeInit(yylex, ir.EXPRESSION, "+", $1.line, $1.pos, $1, $3) }
+                    |   expression '-' expression                       { $$ = nodeInit(yylex, ir.EXPRESSION, "-", $1.line, $1.pos, $1, $3) }
+                    |   expression '*' expression                       { $$ = nodeInit(yylex, ir.EXPRESSION, "*", $1.line, $1.pos, $1, $3) }
+                    |   expression '/' expression                       { $$ = nodeInit(yylex, ir.EXPRESSION, "/", $1.line, $1.pos, $1, $3) }
+                    |   expression '|' expression                       { $$ = nodeInit(yylex, ir.EXPRESSION, "|", $1.line, $1.pos, $1, $3) }
+                    |   expression '^' expression                       { $$ = nodeInit(yylex, ir.EXPRESSION, "^", $1.line, $1.pos, $1, $3) }
+                    |   expression '&' expression                       { $$ = nodeInit(yylex, ir.EXPRESSION, "&", $1.line, $1.pos, $1, $3) }
+                    |   expression LSHIFT expression                    { $$ = nodeInit(yylex, ir.EXPRESSION, "<<", $1.line, $1.pos, $1, $3) }
+                    |   expression RSHIFT expression                    { $$ = nodeInit(yylex, ir.EXPRESSION, $2.val, $1.line, $1.pos, $1, $3) }
+                    |   '-' expression %prec UMINUS                     { $$ = nodeInit(yylex, ir.EXPRESSION, "-", $1.line, $1.pos, $2) }
+                    |   '~' expression                                  { $$ = nodeInit(yylex, ir.EXPRESSION, "~", $1.line, $1.pos, $2) }
+                    |   '(' expression ')'                              { $$ = nodeInit(yylex, ir.EXPRESSION, "", $2.line, $2.pos, $2) }
+                    |   number                                          { $$ = nodeInit(yylex, ir.EXPRESSION, "", $1.line, $1.pos, $1) }
+                    |   identifier                                      { $$ = nodeInit(yylex, ir.EXPRESSION, "", $1.line, $1.pos, $1) }
+                    |   identifier '(' argument_list ')'                { $$ = nodeInit(yylex, ir.EXPRESSION, "", $1.line, $1.pos, $1, $3) }
 
-declaration         :   VAR variable_list type                          { $$ = nodeInit(ir.DECLARATION, nil, $2.line, $2.pos, $3, $2) }
+declaration         :   VAR variable_list type                          { $$ = nodeInit(yylex, ir.DECLARATION, "", $2.line, $2.pos, $3, $2) }
 
-print_item          :   expression                                      { $$ = nodeInit(ir.PRINT_ITEM, nil, $1.line, $1.pos, $1) }
-                    |   string                                          { $$ = nodeInit(ir.PRINT_ITEM, nil, $1.line, $1.pos, $1) }
+print_item          :   expression                                      { $$ = nodeInit(yylex, ir.PRINT_ITEM, "", $1.line, $1.pos, $1) }
+                    |   string                                          { $$ = nodeInit(yylex, ir.PRINT_ITEM, "", $1.line, $1.pos, $1) }
 
-identifier          :   IDENTIFIER                                      { $$ = nodeInit(ir.IDENTIFIER_DATA, $1.val, $1.line, $1.pos) }
+identifier          :   IDENTIFIER                                      { $$ = nodeInit(yylex, ir.IDENTIFIER_DATA, $1.val, $1.line, $1.pos) }
 
-number              :   INTEGER                                         { $$ = nodeInit(ir.INTEGER_DATA, $1.val, $1.line, $1.pos) }
-                    |   FLOAT                                           { $$ = nodeInit(ir.FLOAT_DATA, $1.val, $1.line, $1.pos) }
+number              :   INTEGER                                         { $$ = nodeInit(yylex, ir.INTEGER_DATA, $1.val, $1.line, $1.pos) }
+                    |   FLOAT                                           { $$ = nodeInit(yylex, ir.FLOAT_DATA, $1.val, $1.line, $1.pos) }
 
-string              :   STRING                                          { $$ = nodeInit(ir.STRING_DATA, $1.val, $1.line, $1.pos) }
+string              :   STRING                                          { $$ = nodeInit(yylex, ir.STRING_DATA, $1.val, $1.line, $1.pos) }
 
-type                :   TYPE                                            { $$ = nodeInit(ir.TYPE_DATA, $1.val, $1.line, $1.pos) }
+type                :   TYPE                                            { $$ = nodeInit(yylex, ir.TYPE_DATA, $1.val, $1.line, $1.pos) }
 
 %%
@@ -0,0 +1,89 @@
+// split.go provides a pre-scan of VSL source code for the boundaries of its functions and global declarations, which
+// splits the source code into chunks that are parsed concurrently by ParseParallel.
+
+package frontend
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// chunk is a part of the source code that starts at the beginning of a line with a function or global declaration.
+type chunk struct {
+	off  int // off is the byte offset of the chunk in the source code.
+	line int // line is the source line that the chunk starts on.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// parseChunk is the least number of bytes of source code parsed by a worker thread of ParseParallel.
+const parseChunk = 1 << 14
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// splitGlobals splits the source code src into at most n chunks of about the same size, in source order. A chunk
+// starts at the beginning of a line whose first token is def or var outside of any begin and end, which starts a
+// function or a global declaration of a valid program. Comments and strings are skipped like by the lexer, whose
+// lines don't count the newlines of strings.
+func splitGlobals(src string, n int) []chunk {
+	res := []chunk{{off: 0, line: 1}}
+	if n < 2 {
+		return res
+	}
+	size := len(src) / n
+	depth := 0    // depth is the number of begin keywords without a matching end.
+	line := 1     // line is the line of the lexer at i.
+	lineOff := 0  // lineOff is the offset of the beginning of the current line.
+	first := true // first is true if no token precedes i on the current line.
+	seen := false // seen is true after the first function or global declaration, such that no chunk is empty.
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			i++
+			line++
+			lineOff, first = i, true
+		case isSpace(rune(c)):
+			i++
+		case c == '/' && i+1 < len(src) && src[i+1] == '/':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '"':
+			// Skip the string literal, whose quotes may be escaped.
+			prev := c
+			for i++; i < len(src) && (src[i] != '"' || prev == '\\'); i++ {
+				prev = src[i]
+			}
+			i++
+			first = false
+		case isAlpha(rune(c)):
+			j := i + 1
+			for j < len(src) && (isAlpha(rune(src[j])) || isDigit(rune(src[j])) || src[j] == '_') {
+				j++
+			}
+			switch src[i:j] {
+			case "begin":
+				depth++
+			case "end":
+				depth--
+			case "def", "var":
+				if depth == 0 && first && seen && lineOff >= len(res)*size {
+					res = append(res, chunk{off: lineOff, line: line})
+					if len(res) == n {
+						return res
+					}
+				}
+				seen = seen || depth == 0
+			}
+			i, first = j, false
+		default:
+			i++
+			first = false
+		}
+	}
+	return res
+}
@@ -14,33 +14,209 @@ import (
 	"vslc/src/util"
 )
 
-// Parse parses the syntax tree from the source code.
-func Parse(src string) error {
-	l := newLexer(src, lexGlobal)
-
+func init() {
 	yyErrorVerbose = true
+}
+
+// Parse parses the syntax tree from the source code, and returns its root node. Equal identifiers, types and strings of
+// the syntax tree share their memory, which isn't the memory of the source code.
+func Parse(src string) (*ir.Node, error) {
+	return parse(src, &util.Interner{}, &ir.Arena{})
+}
+
+// ParseArena parses the syntax tree from the source code like Parse, and returns its root node, whose Nodes are
+// allocated by Arena a. The syntax tree must not be used once a is freed.
+func ParseArena(src string, a *ir.Arena) (*ir.Node, error) {
+	return parse(src, &util.Interner{}, a)
+}
+
+// ParseParallel parses the syntax tree from the source code like ParseArena, and returns its root node. Source code of
+// more than one chunk of parseChunk bytes is split at the boundaries of its functions and global declarations, and
+// its chunks are parsed concurrently by up to threads worker threads, and merged into one syntax tree. The Nodes of the
+// chunks are allocated by Arenas split from a. An error is returned if the source code doesn't parse, which is the
+// error of parsing it as a whole, or if opt.Ctx is cancelled.
+func ParseParallel(opt util.Options, src string, a *ir.Arena) (*ir.Node, error) {
+	n := len(src) / parseChunk
+	if n > opt.Threads {
+		n = opt.Threads
+	}
+	chunks := splitGlobals(src, n)
+	names := &util.Interner{}
+	if len(chunks) < 2 {
+		return parse(src, names, a)
+	}
+
+	arenas := a.Split(len(chunks))
+	roots := make([]*ir.Node, len(chunks))
+	ctx := opt.Context()
+	if err := opt.NewPool("parse").Run(ctx, len(chunks), func(w *util.Worker, i int) error {
+		end := len(src)
+		if i+1 < len(chunks) {
+			end = chunks[i+1].off
+		}
+		w.Log.Infof("parsing chunk %d from line %d", i, chunks[i].line)
+		l, err := parseLexer(src[chunks[i].off:end], chunks[i].line, names, arenas[i])
+		if err != nil {
+			return err
+		}
+		roots[i] = l.root
+		return nil
+	}); err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		// A chunk may fail to parse where the source code as a whole fails later, such as after a begin without an end,
+		// hence the error of the source code is reported.
+		return parse(src, names, a)
+	}
+	return merge(roots), nil
+}
+
+// ParseComments parses the syntax tree from the source code like Parse, and returns its root node and the comments
+// of the source code, in order of appearance.
+func ParseComments(src string) (*ir.Node, []Comment, error) {
+	l, err := parseLexer(src, 1, &util.Interner{}, &ir.Arena{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return l.root, l.comments, nil
+}
+
+// ParseFiles parses the source code srcs of the source files named names concurrently, and merges their global lists
+// into one syntax tree, whose root node is returned. Functions and global variables keep the order of the files.
+// An error is returned if a function or global variable is declared more than once, or if opt.Ctx is cancelled.
+func ParseFiles(opt util.Options, names, srcs []string) (*ir.Node, error) {
+	roots, err := ParseTrees(opt, names, srcs)
+	if err != nil {
+		return nil, err
+	}
+	return merge(roots), nil
+}
+
+// ParseTrees parses the source code srcs of the source files named names concurrently, by up to opt.Threads worker
+// threads, and returns the root node of the syntax tree of each file. Identifiers are interned across the files, like
+// by Parse. An error is returned if a function or global variable is declared more than once, or if opt.Ctx is
+// cancelled.
+func ParseTrees(opt util.Options, names, srcs []string) ([]*ir.Node, error) {
+	roots := make([]*ir.Node, len(srcs))
+	in := &util.Interner{} // Shared by the files, whose global symbols refer to each other.
+	if err := opt.NewPool("parse").Run(opt.Context(), len(srcs), func(w *util.Worker, i int) error {
+		w.Log.Infof("parsing %s", names[i])
+		var err error
+		if roots[i], err = parse(srcs[i], in, &ir.Arena{}); err != nil {
+			return util.InFile(err, names[i])
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	decl := make(map[string]string) // Maps global symbols to the location of their declaration.
+	for i1, e1 := range roots {
+		for _, e2 := range globals(e1.Children[0]) {
+			for _, e3 := range symbols(e2) {
+				name := e3.Data.Str
+				if prev, ok := decl[name]; ok {
+					err := util.SyntaxErrorf(e3.Line, e3.Pos, "duplicate declaration of %q, already declared at %s",
+						name, prev)
+					return nil, util.InFile(err, names[i1])
+				}
+				decl[name] = util.Position{File: names[i1], Line: e3.Line, Pos: e3.Pos}.String()
+			}
+		}
+	}
+	return roots, nil
+}
+
+// parse parses the source code and returns the root node of its syntax tree, whose identifiers, types and strings are
+// interned by names, and whose Nodes are allocated by nodes, unless they're nil.
+func parse(src string, names *util.Interner, nodes *ir.Arena) (*ir.Node, error) {
+	l, err := parseLexer(src, 1, names, nodes)
+	if err != nil {
+		return nil, err
+	}
+	return l.root, nil
+}
+
+// parseLexer parses the source code, whose first line is line, and returns the lexer, which holds the root node of the
+// syntax tree and the comments of the source code.
+func parseLexer(src string, line int, names *util.Interner, nodes *ir.Arena) (*lexer, error) {
+	l := newLexer(src, lexGlobal)
+	l.line, l.names, l.nodes = line, names, nodes
 
-	// Start scanner and run it concurrently to the parser.
+	// Start scanner and run it concurrently to the parser, which stops it once it's done parsing.
 	go l.run()
+	defer l.stop()
 
-	// Start parser.
-	if a := yyParse(l); a != 0 {
-		return fmt.Errorf("parser returned %d", a)
+	// Start parser. Errors reported by the actions of the parser, such as invalid literals, don't stop it.
+	a := yyParse(l)
+	if l.fail != nil {
+		return nil, l.fail
+	}
+	if a != 0 {
+		return nil, util.SyntaxErrorf(l.last.line, l.last.pos, "parser returned %d", a)
 	}
 
 	// Check if parser successfully created the syntax tree.
-	if ir.Root == nil {
-		return errors.New("root node is <nil>")
+	if l.root == nil {
+		return nil, errors.New("root node is <nil>")
+	}
+	return l, nil
+}
+
+// merge merges the global lists of the syntax trees roots into the left recursive list structure built by the parser,
+// which the optimiser flattens, and returns the root node of the merged syntax tree. Functions and global variables
+// keep the order of roots.
+func merge(roots []*ir.Node) *ir.Node {
+	var list *ir.Node
+	for _, e1 := range roots {
+		for _, e2 := range globals(e1.Children[0]) {
+			if list == nil {
+				list = &ir.Node{Typ: ir.GLOBAL_LIST, Line: e2.Line, Pos: e2.Pos, Children: []*ir.Node{e2}}
+			} else {
+				list = &ir.Node{Typ: ir.GLOBAL_LIST, Line: list.Line, Pos: list.Pos, Children: []*ir.Node{list, e2}}
+			}
+		}
+	}
+	return &ir.Node{Typ: ir.PROGRAM, Line: list.Line, Pos: list.Pos, Children: []*ir.Node{list}}
+}
+
+// globals returns the GLOBAL nodes of the left recursive GLOBAL_LIST n, in source order.
+func globals(n *ir.Node) []*ir.Node {
+	var res []*ir.Node
+	ir.Inspect(n, func(n *ir.Node) bool {
+		if n.Typ != ir.GLOBAL_LIST {
+			res = append(res, n)
+			return false
+		}
+		return true
+	})
+	return res
+}
+
+// symbols returns the identifier nodes of the function or global variables declared by the GLOBAL node n.
+func symbols(n *ir.Node) []*ir.Node {
+	c := n.Children[0]
+	if c.Typ == ir.FUNCTION {
+		return c.Children[:1]
 	}
-	return nil
+	var res []*ir.Node
+	ir.Inspect(c.Children[1], func(n *ir.Node) bool { // Variable list of DECLARATION.
+		if n.Typ == ir.IDENTIFIER_DATA {
+			res = append(res, n)
+		}
+		return true
+	})
+	return res
 }
 
-// TokenStream outputs the token stream from the given source string.
-func TokenStream(src string) error {
+// TokenStream outputs the token stream from the given source string to the Output of opt.
+func TokenStream(opt util.Options, src string) error {
 	l := newLexer(src, lexGlobal)
 	go l.run()
+	defer l.stop()
 
-	wr := util.NewWriter()
+	wr := opt.NewWriter()
 	defer wr.Close()
 	sb := strings.Builder{}
 	tw := tabwriter.NewWriter(&sb, 10, 20, 2, ' ', 0)
@@ -57,7 +233,7 @@ func TokenStream(src string) error {
 			return err
 		case itemError:
 			wr.WriteString(sb.String())
-			return errors.New(t.val)
+			return util.SyntaxErrorf(t.line, t.pos, "%s", t.val)
 		default:
 			if len(t.val) > 20 {
 				_, _ = fmt.Fprintf(tw, "%.17q...\t%s\tline: %d:%d\n", t.val, yyTokname(int(t.typ)), t.line, t.pos)
@@ -68,46 +244,72 @@ func TokenStream(src string) error {
 	}
 }
 
-// nodeInit creates a yySymType struct which holds an ir.Node datatype.
-func nodeInit(typ ir.NodeType, data interface{}, line, pos int, args ...yySymType) yySymType {
-	n := ir.Node{Typ: typ, Line: line, Pos: pos, Children: make([]*ir.Node, len(args))}
-	switch typ {
-	case ir.INTEGER_DATA:
+// nodeInit creates a yySymType struct which holds an ir.Node datatype, allocated by the Arena of the lexer yylex. The
+// Node holds no Data if data is empty.
+func nodeInit(yylex yyLexer, typ ir.NodeType, data string, line, pos int, args ...yySymType) yySymType {
+	a := yylex.(*lexer).nodes
+	n := a.New()
+	n.Typ, n.Line, n.Pos, n.Children = typ, line, pos, a.Children(len(args))
+	switch {
+	case data == "":
+	case typ == ir.INTEGER_DATA:
 		if num, err := parseInteger(data); err == nil {
-			n.Data = num
+			n.Data = ir.Int(num)
 		} else {
-			fmt.Println(err)
-			n.Data = data.(string)
+			yylex.(*lexer).failAt(line, pos, "invalid integer literal %s: %s", data, err.(*strconv.NumError).Err)
+			n.Data = ir.Str(data)
 		}
-	case ir.FLOAT_DATA:
+	case typ == ir.FLOAT_DATA:
 		if num, err := parseFloat(data); err == nil {
-			n.Data = num
+			n.Data = ir.Float(num)
 		} else {
-			fmt.Println(err)
-			n.Data = data.(string)
+			yylex.(*lexer).failAt(line, pos, "invalid float literal %s: %s", data, err.(*strconv.NumError).Err)
+			n.Data = ir.Str(data)
+		}
+	case typ == ir.PRINT_STATEMENT || typ == ir.PRINTF_STATEMENT:
+		// The print keyword tells the file descriptor printed to, which is standard output unless redirected.
+		if fd := printFile(data); fd != ir.Stdout {
+			n.Data = ir.Int(fd)
 		}
 	default:
-		n.Data = data
+		n.Data = ir.Str(data)
 	}
 	for i1, e := range args {
 		n.Children[i1] = e.node
 	}
-	return yySymType{typ: int(typ), val: "N/A", node: &n}
+	return yySymType{typ: int(typ), val: "N/A", line: line, pos: pos, node: n}
 }
 
-// parseInteger parses an interface{} as an integer. This function returns a 32-bit integer value.
-func parseInteger(n interface{}) (int, error) {
-	if s, ok := n.(string); ok {
-		i, err := strconv.Atoi(s)
-		return int(int32(i)), err
+// printType returns the type of the statement of the print keyword s, which is a format print statement for printf
+// and eprintf, optionally redirected to a file descriptor, such as printf > 2, and a print statement otherwise.
+func printType(s string) ir.NodeType {
+	if strings.HasPrefix(s, "printf") || s == "eprintf" {
+		return ir.PRINTF_STATEMENT
 	}
-	return -1, fmt.Errorf("could not parse integer number from %v", n)
+	return ir.PRINT_STATEMENT
 }
 
-// parseFloat parses an interface{} as an integer. This function returns a 32-bit floating point value.
-func parseFloat(n interface{}) (float64, error) {
-	if s, ok := n.(string); ok {
-		return strconv.ParseFloat(s, 32)
+// printFile returns the file descriptor printed to by the print keyword s, which is eprint or eprintf for standard
+// error, or print or printf, optionally redirected to a file descriptor, such as print > 2.
+func printFile(s string) int {
+	if s == "eprint" || s == "eprintf" {
+		return ir.Stderr
 	}
-	return -1.0, fmt.Errorf("could not parse float from %v", n)
+	if i1 := strings.IndexByte(s, '>'); i1 >= 0 {
+		fd, _ := strconv.Atoi(strings.TrimSpace(s[i1+1:]))
+		return fd
+	}
+	return ir.Stdout
+}
+
+// parseInteger parses a string as a 64-bit integer. Whether it fits in the integers of the target is checked by the
+// optimiser, which knows their width.
+func parseInteger(s string) (int, error) {
+	i, err := strconv.ParseInt(s, 10, 64)
+	return int(i), err
+}
+
+// parseFloat parses a string as a float. This function returns a 32-bit floating point value.
+func parseFloat(s string) (float64, error) {
+	return strconv.ParseFloat(s, 32)
 }
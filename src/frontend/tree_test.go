@@ -0,0 +1,327 @@
+package frontend
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"unsafe"
+	"vslc/src/ir"
+	"vslc/src/util"
+)
+
+// TestParseFiles verifies that the global lists of several source files are merged in order, and that symbols declared
+// in more than one file are rejected.
+func TestParseFiles(t *testing.T) {
+	a := "var x int\ndef f() int\nbegin\n\treturn g()\nend\n"
+	b := "def g() int\nbegin\n\treturn x\nend\n"
+	root, err := ParseFiles(util.Options{Threads: 2}, []string{"a.vsl", "b.vsl"}, []string{a, b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e1 := range globals(root.Children[0]) {
+		for _, e2 := range symbols(e1) {
+			names = append(names, e2.Data.Str)
+		}
+	}
+	if res := strings.Join(names, ","); res != "x,f,g" {
+		t.Errorf("expected globals %q, got %q", "x,f,g", res)
+	}
+
+	c := "var y, g float\n"
+	_, err = ParseFiles(util.Options{Threads: 2}, []string{"a.vsl", "b.vsl", "c.vsl"}, []string{a, b, c})
+	if exp := "c.vsl:1:8: duplicate declaration of \"g\", already declared at b.vsl:1:5"; err == nil || err.Error() != exp {
+		t.Errorf("expected error %q, got %v", exp, err)
+	}
+	if !errors.Is(err, util.ErrSyntax) {
+		t.Errorf("expected syntax error, got %v", err)
+	}
+}
+
+// TestParseErrors verifies that lexical and syntax errors are reported as syntax errors at their source location.
+func TestParseErrors(t *testing.T) {
+	exp := []struct {
+		src string
+		pos util.Position
+		msg string
+	}{
+		{
+			src: "def f( int\n",
+			pos: util.Position{Line: 1, Pos: 8},
+			msg: "syntax error: unexpected TYPE, expecting ',' or ')'",
+		},
+		{
+			src: "def f() int\nbegin\n\tprint \"abc\nend\n",
+			pos: util.Position{Line: 3, Pos: 9},
+			msg: "unclosed string literal",
+		},
+		{
+			src: "def f() int\nbegin\n\tprint 1 $ 2\n\treturn 0\nend\n",
+			pos: util.Position{Line: 3, Pos: 10},
+			msg: "syntax error: unexpected $unk",
+		},
+		{
+			src: "def f() int\nbegin\n\tprint > x \"a\"\n\treturn 0\nend\n",
+			pos: util.Position{Line: 3, Pos: 2},
+			msg: "expected file descriptor after \"print >\"",
+		},
+		{
+			src: "def f() int\nbegin\n\tprint 99999999999999999999\n\treturn 0\nend\n",
+			pos: util.Position{Line: 3, Pos: 8},
+			msg: "invalid integer literal 99999999999999999999: value out of range",
+		},
+	}
+	for _, e1 := range exp {
+		_, err := Parse(e1.src)
+		var se *util.SyntaxError
+		if !errors.As(err, &se) || se.Position != e1.pos || se.Err.Error() != e1.msg {
+			t.Errorf("expected syntax error %q at %s, got %v", e1.msg, e1.pos, err)
+		}
+	}
+}
+
+// TestParseErrorsConcurrent verifies that concurrent parses, such as those of the language server on every edit, each
+// report their first syntax error, also when the lexer is still emitting tokens after it. Run with -race.
+func TestParseErrorsConcurrent(t *testing.T) {
+	src := "def f() int\nbegin\n\tprint 1 $ 2\n" + strings.Repeat("\tprint 1, 2, 3\n", 100) + "\treturn 0\nend\n"
+	exp := util.Position{Line: 3, Pos: 10}
+	errs := make(chan error, 64)
+	for i1 := 0; i1 < cap(errs); i1++ {
+		go func() {
+			_, err := Parse(src)
+			errs <- err
+		}()
+	}
+	for i1 := 0; i1 < cap(errs); i1++ {
+		var se *util.SyntaxError
+		if err := <-errs; !errors.As(err, &se) || se.Position != exp {
+			t.Errorf("expected syntax error at %s, got %v", exp, err)
+		}
+	}
+}
+
+// TestParseComments verifies that comments are returned with their source location, including a comment that ends
+// the source code without a newline.
+func TestParseComments(t *testing.T) {
+	src := "// f returns 1.\ndef f() int\n\treturn 1 // One.\n//"
+	_, comments, err := ParseComments(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var res []string
+	for _, e1 := range comments {
+		res = append(res, fmt.Sprintf("%d:%d %s", e1.Line, e1.Pos, e1.Text))
+	}
+	exp := "1:1 // f returns 1.,3:11 // One.,4:1 //"
+	if strings.Join(res, ",") != exp {
+		t.Errorf("expected %q, got %q", exp, strings.Join(res, ","))
+	}
+}
+
+// TestParseShift verifies that the arithmetic and logical right shift operators >> and >>> are told apart, and that
+// they're left associative.
+func TestParseShift(t *testing.T) {
+	root, err := Parse("def f(a, b int) int\n\treturn a >> b >>> 1\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var find func(n *ir.Node) *ir.Node
+	find = func(n *ir.Node) *ir.Node {
+		if n == nil || n.Typ == ir.EXPRESSION {
+			return n
+		}
+		for _, e1 := range n.Children {
+			if res := find(e1); res != nil {
+				return res
+			}
+		}
+		return nil
+	}
+	n := find(root)
+	if n == nil || n.Data.Str != ">>>" || n.Children[0].Typ != ir.EXPRESSION || n.Children[0].Data.Str != ">>" {
+		t.Errorf("expected (a >> b) >>> 1, got %v", n)
+	}
+}
+
+// TestParsePrint verifies that printf and eprintf are format print statements, which are redirected like print, and
+// that print and eprint aren't.
+func TestParsePrint(t *testing.T) {
+	root, err := Parse("def f() int\nbegin\n\tprint \"50%\", 1\n\tprintf \"%d\", 1\n\teprint \"a\"\n" +
+		"\teprintf \"%d\", 2\n\tprintf > 3 \"%d\", 3\n\treturn 0\nend\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var prints []*ir.Node
+	var find func(n *ir.Node)
+	find = func(n *ir.Node) {
+		if n == nil {
+			return
+		}
+		if n.Typ == ir.PRINT_STATEMENT || n.Typ == ir.PRINTF_STATEMENT {
+			prints = append(prints, n)
+		}
+		for _, e1 := range n.Children {
+			find(e1)
+		}
+	}
+	find(root)
+	exp := []struct {
+		format bool
+		file   int
+	}{{false, ir.Stdout}, {true, ir.Stdout}, {false, ir.Stderr}, {true, ir.Stderr}, {true, 3}}
+	if len(prints) != len(exp) {
+		t.Fatalf("expected %d print statements, got %d", len(exp), len(prints))
+	}
+	for i1, e1 := range exp {
+		if prints[i1].FormatPrint() != e1.format || prints[i1].File() != e1.file {
+			t.Errorf("statement %d: expected format print %t to file %d, got %t to file %d", i1, e1.format, e1.file,
+				prints[i1].FormatPrint(), prints[i1].File())
+		}
+	}
+}
+
+// TestParseInterned verifies that equal identifiers of the syntax trees of one or more files share their memory.
+func TestParseInterned(t *testing.T) {
+	data := func(s string) uintptr {
+		return (*reflect.StringHeader)(unsafe.Pointer(&s)).Data
+	}
+	a := "def f(x int) int\nreturn x + x\n"
+	b := "def g() int\nreturn f(1)\n"
+	roots, err := ParseTrees(util.Options{Threads: 2}, []string{"a.vsl", "b.vsl"}, []string{a, b})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	names := map[string]uintptr{}
+	var walk func(n *ir.Node)
+	walk = func(n *ir.Node) {
+		if s := n.Data.Str; n.Typ == ir.IDENTIFIER_DATA {
+			if p, ok := names[s]; ok && p != data(s) {
+				t.Errorf("expected identifier %q at %x, got %x", s, p, data(s))
+			}
+			names[s] = data(s)
+		}
+		for _, e1 := range n.Children {
+			if e1 != nil {
+				walk(e1)
+			}
+		}
+	}
+	for _, e1 := range roots {
+		walk(e1)
+	}
+	if len(names) != 3 || names["f"] == data(a[4:5]) {
+		t.Errorf("expected interned identifiers f, x and g, got %v", names)
+	}
+}
+
+func TestSplitGlobals(t *testing.T) {
+	src := "// def in a comment\n" +
+		"var x int\n" +
+		"def f() int\n" +
+		"begin\n" +
+		"    var y int\n" +
+		"    print \"a\ndef\"\n" + // The lexer doesn't count the newline of the string.
+		"    return y\n" +
+		"end def g() int return 1\n" +
+		"def h() int return 2\n"
+	exp := []chunk{{0, 1}, {strings.Index(src, "def f"), 3}, {strings.Index(src, "def h"), 9}}
+	if res := splitGlobals(src, len(src)); !reflect.DeepEqual(res, exp) {
+		t.Errorf("expected chunks %v, got %v", exp, res)
+	}
+	if res := splitGlobals(src, 2); len(res) != 2 || res[1] != exp[2] {
+		t.Errorf("expected 2 chunks split at %v, got %v", exp[2], res)
+	}
+	if res := splitGlobals(src, 1); len(res) != 1 {
+		t.Errorf("expected 1 chunk, got %v", res)
+	}
+}
+
+// TestParseParallel verifies that source code parsed in parallel chunks gives the same syntax tree as parsing it as a
+// whole, including source locations, and that syntax errors are reported the same.
+func TestParseParallel(t *testing.T) {
+	var dump func(sb *strings.Builder, n *ir.Node)
+	dump = func(sb *strings.Builder, n *ir.Node) {
+		sb.WriteString(fmt.Sprintf("%s %d:%d\n", n, n.Line, n.Pos))
+		for _, e1 := range n.Children {
+			dump(sb, e1)
+		}
+	}
+	src := "var g int\n// Comment\n" + generated(500)
+	if n := len(splitGlobals(src, len(src)/parseChunk)); n < 2 {
+		t.Fatalf("expected source code to be split, got %d chunk", n)
+	}
+	root, err := Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &ir.Arena{}
+	defer a.Free()
+	par, err := ParseParallel(util.Options{Threads: 8}, src, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp, res := strings.Builder{}, strings.Builder{}
+	dump(&exp, root)
+	dump(&res, par)
+	if exp.String() != res.String() {
+		t.Errorf("expected equal syntax trees of parsing in parallel and as a whole")
+	}
+
+	// An unbalanced begin fails the chunk it's in, but the source code as a whole at its end.
+	bad := strings.Replace(src, "    return f1(", "    begin\n    return f1(", 1)
+	_, exp1 := Parse(bad)
+	_, res1 := ParseParallel(util.Options{Threads: 8}, bad, nil)
+	if exp1 == nil || res1 == nil || exp1.Error() != res1.Error() {
+		t.Errorf("expected error %v, got %v", exp1, res1)
+	}
+}
+
+// generated returns a generated VSL program of n functions with arithmetic, loops and calls, which is large enough
+// for its allocations to dominate the time of parsing.
+func generated(n int) string {
+	sb := strings.Builder{}
+	for i1 := 0; i1 < n; i1++ {
+		sb.WriteString(fmt.Sprintf("def f%d(a, b int) int\nbegin\n    var c, d int\n    c := a * %d + b\n", i1, i1))
+		sb.WriteString("    while c > 0 do\n    begin\n        d := d + c * 3 - a / (b + 2)\n        c := c - 1\n    end\n")
+		sb.WriteString(fmt.Sprintf("    if d > 100 then print \"big\", d\n    return f%d(d, c) + d\nend\n", (i1+1)%n))
+	}
+	return sb.String()
+}
+
+// BenchmarkParse measures parsing a large generated program, whose Nodes are allocated in blocks by an Arena, which
+// are either freed by the garbage collector or reused by the next parse, and parsing it in parallel chunks.
+func BenchmarkParse(b *testing.B) {
+	src := generated(2000)
+	b.Run("arena", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			if _, err := Parse(src); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("pool", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			a := &ir.Arena{}
+			if _, err := ParseArena(src, a); err != nil {
+				b.Fatal(err)
+			}
+			a.Free()
+		}
+	})
+	for _, e1 := range []int{2, 4, 8} {
+		b.Run(fmt.Sprintf("parallel-%d", e1), func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				a := &ir.Arena{}
+				if _, err := ParseParallel(util.Options{Threads: e1}, src, a); err != nil {
+					b.Fatal(err)
+				}
+				a.Free()
+			}
+		})
+	}
+}
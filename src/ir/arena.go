@@ -0,0 +1,114 @@
+// arena.go provides the Arena that allocates the Nodes of syntax trees in blocks, which cuts the number of allocations
+// and the pressure on the garbage collector when parsing large programs.
+
+package ir
+
+import "sync"
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Arena allocates Nodes and slices of children in blocks instead of one by one. A block is freed wholesale by the
+// garbage collector once none of its Nodes are referenced, or returned to a pool for the next syntax tree by Free.
+// The zero value is an empty Arena, and a nil Arena allocates every Node by itself. An Arena isn't safe for concurrent
+// use, hence every syntax tree built in parallel has its own.
+type Arena struct {
+	nodes    []Node     // nodes is the unused part of the current block of Nodes.
+	children []*Node    // children is the unused part of the current block of child pointers.
+	blocks   []*[]Node  // blocks are the blocks of Nodes handed out, which are reused once the Arena is freed.
+	lists    []*[]*Node // lists are the blocks of child pointers handed out.
+	split    []*Arena   // split are the Arenas split from the Arena, which are freed along with it.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// arenaBlock is the number of Nodes, and child pointers, of a block of an Arena.
+const arenaBlock = 1024
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// Blocks of freed Arenas, which are cleared before they're reused.
+var (
+	nodeBlocks  = sync.Pool{New: func() interface{} { b := make([]Node, arenaBlock); return &b }}
+	childBlocks = sync.Pool{New: func() interface{} { b := make([]*Node, arenaBlock); return &b }}
+)
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// New returns a new Node of Arena a, whose fields are zero.
+func (a *Arena) New() *Node {
+	if a == nil {
+		return &Node{}
+	}
+	if len(a.nodes) == 0 {
+		b := nodeBlocks.Get().(*[]Node)
+		a.blocks = append(a.blocks, b)
+		a.nodes = *b
+	}
+	res := &a.nodes[0]
+	a.nodes = a.nodes[1:]
+	return res
+}
+
+// Children returns a slice of n child pointers of Arena a, which are nil. Its capacity is n, such that appending to
+// it doesn't overwrite the children of another Node.
+func (a *Arena) Children(n int) []*Node {
+	if a == nil || n > arenaBlock/8 {
+		return make([]*Node, n)
+	}
+	if len(a.children) < n {
+		b := childBlocks.Get().(*[]*Node)
+		a.lists = append(a.lists, b)
+		a.children = *b
+	}
+	res := a.children[:n:n]
+	a.children = a.children[n:]
+	return res
+}
+
+// Split returns n new Arenas, which are freed along with Arena a, such that n syntax trees are built in parallel.
+// A nil Arena is split into nil Arenas.
+func (a *Arena) Split(n int) []*Arena {
+	res := make([]*Arena, n)
+	if a == nil {
+		return res
+	}
+	for i1 := range res {
+		res[i1] = &Arena{}
+	}
+	a.split = append(a.split, res...)
+	return res
+}
+
+// Free returns the blocks of Arena a to the pool of blocks, which are reused by the syntax trees built next, and
+// empties a. The Nodes of a must not be used once it's freed, such as after the compilation of their syntax tree.
+func (a *Arena) Free() {
+	if a == nil {
+		return
+	}
+	for _, e1 := range a.blocks {
+		b := *e1
+		for i1 := range b {
+			b[i1] = Node{}
+		}
+		nodeBlocks.Put(e1)
+	}
+	for _, e1 := range a.lists {
+		b := *e1
+		for i1 := range b {
+			b[i1] = nil
+		}
+		childBlocks.Put(e1)
+	}
+	for _, e1 := range a.split {
+		e1.Free()
+	}
+	*a = Arena{}
+}
@@ -0,0 +1,49 @@
+package ir
+
+import "testing"
+
+func TestArena(t *testing.T) {
+	a := &Arena{}
+	var nodes []*Node
+	for i1 := 0; i1 < 2*arenaBlock+1; i1++ {
+		n := a.New()
+		if n.Typ != 0 || !n.Data.IsZero() || n.Children != nil {
+			t.Fatalf("node %d: expected zero Node, got %v", i1, n)
+		}
+		n.Typ, n.Data, n.Children = EXPRESSION, Int(i1), a.Children(i1%3)
+		nodes = append(nodes, n)
+	}
+	if len(a.blocks) != 3 {
+		t.Errorf("expected 3 blocks of Nodes, got %d", len(a.blocks))
+	}
+	for i1, e1 := range nodes {
+		if e1.Data.Int != i1 {
+			t.Fatalf("node %d: overwritten by node %v", i1, e1.Data)
+		}
+		if len(e1.Children) != i1%3 || cap(e1.Children) != i1%3 {
+			t.Fatalf("node %d: expected %d children with equal capacity, got len %d cap %d", i1, i1%3,
+				len(e1.Children), cap(e1.Children))
+		}
+	}
+	if c := a.Children(arenaBlock); len(c) != arenaBlock {
+		t.Errorf("expected %d children, got %d", arenaBlock, len(c))
+	}
+
+	a.Free()
+	if a.nodes != nil || a.blocks != nil || a.lists != nil {
+		t.Errorf("expected empty Arena after Free")
+	}
+	if n := nodes[0]; n.Typ != 0 || !n.Data.IsZero() || n.Children != nil {
+		t.Errorf("expected freed Node to be cleared, got %v", n)
+	}
+
+	// A nil Arena allocates every Node by itself.
+	var nilArena *Arena
+	if n := nilArena.New(); n == nil || n.Typ != 0 {
+		t.Errorf("expected zero Node of nil Arena, got %v", n)
+	}
+	if c := nilArena.Children(2); len(c) != 2 || cap(c) != 2 {
+		t.Errorf("expected 2 children of nil Arena, got len %d cap %d", len(c), cap(c))
+	}
+	nilArena.Free()
+}
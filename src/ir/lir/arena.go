@@ -0,0 +1,71 @@
+package lir
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// arena allocates the most common instructions of a Function in blocks instead of one by one, which cuts the number
+// of allocations of generating LIR for large programs. Blocks grow from arenaMin to arenaMax instructions, such that
+// small functions don't waste memory. A block is freed wholesale by the garbage collector once none of its
+// instructions are referenced. Like the Function, an arena must only be used by one go routine at a time.
+type arena struct {
+	data   []DataInstruction  // data is the unused part of the current block of DataInstructions.
+	loads  []LoadInstruction  // loads is the unused part of the current block of LoadInstructions.
+	stores []StoreInstruction // stores is the unused part of the current block of StoreInstructions.
+	size   [3]int             // size holds the size of the last block of data, loads and stores, in that order.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// Bounds of the number of instructions of a block of an arena.
+const (
+	arenaMin = 8
+	arenaMax = 256
+)
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// grow returns the size of the next block of kind i of arena a, which doubles the size of the last block.
+func (a *arena) grow(i int) int {
+	switch {
+	case a.size[i] < arenaMin:
+		a.size[i] = arenaMin
+	case a.size[i] < arenaMax:
+		a.size[i] *= 2
+	}
+	return a.size[i]
+}
+
+// newData returns a new DataInstruction of arena a, whose fields are zero.
+func (a *arena) newData() *DataInstruction {
+	if len(a.data) == 0 {
+		a.data = make([]DataInstruction, a.grow(0))
+	}
+	res := &a.data[0]
+	a.data = a.data[1:]
+	return res
+}
+
+// newLoad returns a new LoadInstruction of arena a, whose fields are zero.
+func (a *arena) newLoad() *LoadInstruction {
+	if len(a.loads) == 0 {
+		a.loads = make([]LoadInstruction, a.grow(1))
+	}
+	res := &a.loads[0]
+	a.loads = a.loads[1:]
+	return res
+}
+
+// newStore returns a new StoreInstruction of arena a, whose fields are zero.
+func (a *arena) newStore() *StoreInstruction {
+	if len(a.stores) == 0 {
+		a.stores = make([]StoreInstruction, a.grow(2))
+	}
+	res := &a.stores[0]
+	a.stores = a.stores[1:]
+	return res
+}
@@ -0,0 +1,73 @@
+package lir
+
+import (
+	"fmt"
+	"vslc/src/ir/lir/types"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// Symbols of the runtime routines that implement the builtin functions argc and arg of VSL. They are defined by the
+// backends next to the implicit main function, which stores the program arguments for them if they are declared.
+const (
+	LabelArgc      = "vsl_argc" // LabelArgc returns the number of program arguments.
+	LabelArgInt    = "vsl_argi" // LabelArgInt returns a program argument parsed as an integer by strtol.
+	LabelArgFloat  = "vsl_argf" // LabelArgFloat returns a program argument parsed as a float by strtod.
+	LabelArgString = "vsl_args" // LabelArgString returns a program argument as a null-terminated string.
+)
+
+// Names of the builtin functions of VSL, which are shadowed by VSL functions of the same name.
+const (
+	builtinArgc = "argc"
+	builtinArg  = "arg"
+)
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// UsesArgs returns true if Module m calls the builtin function argc or arg, such that the implicit main function must
+// store the program arguments, and the backend must define the routines that read them. The implicit main function
+// of an entry function without parameters accepts any number of arguments if it does.
+func (m *Module) UsesArgs() bool {
+	for _, e1 := range m.Functions() {
+		switch e1.Name() {
+		case LabelArgc, LabelArgInt, LabelArgFloat, LabelArgString:
+			if len(e1.Blocks()) < 1 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CreateArgc creates an LIR call to the runtime routine that returns the number of program arguments.
+func (b *Block) CreateArgc() *PreserveInstruction {
+	target := b.f.m.declareNamed(LabelArgc, types.Int, nil, nil)
+	return b.CreateFunctionCall(target, []Value{})
+}
+
+// CreateArg creates an LIR call to the runtime routine that returns program argument i, counted from 1, as the data
+// type typ. Integers and floats are parsed by strtol and strtod, which return zero if the argument doesn't start
+// with a number, and strings are the argument as is. Arguments out of range are zero or the empty string.
+func (b *Block) CreateArg(i Value, typ types.DataType) *PreserveInstruction {
+	var name string
+	switch typ {
+	case types.Int:
+		name = LabelArgInt
+	case types.Float:
+		name = LabelArgFloat
+	case types.String:
+		name = LabelArgString
+	default:
+		panic(fmt.Sprintf("program arguments can't be of data type %s", typ.String()))
+	}
+	target := b.f.m.declareNamed(name, typ, []string{"i"}, []types.DataType{types.Int})
+	return b.CreateFunctionCall(target, []Value{i})
+}
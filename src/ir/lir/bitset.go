@@ -0,0 +1,83 @@
+package lir
+
+import "math/bits"
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// bitset is a dense set of small non-negative integers, such as the ids of the virtual registers of a Function, which
+// spends one bit on every integer below its size. Unions and membership tests take time proportional to the size of
+// the set rather than the number of its members, which keeps liveness fast for large functions.
+type bitset []uint64
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// wordBits is the number of integers held by a word of a bitset.
+const wordBits = 64
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// newBitset returns an empty bitset that holds the integers from 0 to n-1.
+func newBitset(n int) bitset {
+	return make(bitset, (n+wordBits-1)/wordBits)
+}
+
+// add adds i to bitset s.
+func (s bitset) add(i int) {
+	s[i/wordBits] |= 1 << uint(i%wordBits)
+}
+
+// remove removes i from bitset s.
+func (s bitset) remove(i int) {
+	s[i/wordBits] &^= 1 << uint(i%wordBits)
+}
+
+// has returns true if i is a member of bitset s.
+func (s bitset) has(i int) bool {
+	return s[i/wordBits]&(1<<uint(i%wordBits)) != 0
+}
+
+// union adds the members of o to bitset s, which must be of the same size, and returns true if s changed.
+func (s bitset) union(o bitset) bool {
+	changed := false
+	for i1, e1 := range o {
+		if w := s[i1] | e1; w != s[i1] {
+			s[i1] = w
+			changed = true
+		}
+	}
+	return changed
+}
+
+// unionDiff adds the members of o that aren't members of d to bitset s, all of the same size, and returns true if s
+// changed.
+func (s bitset) unionDiff(o, d bitset) bool {
+	changed := false
+	for i1, e1 := range o {
+		if w := s[i1] | e1&^d[i1]; w != s[i1] {
+			s[i1] = w
+			changed = true
+		}
+	}
+	return changed
+}
+
+// set replaces the members of bitset s with the members of o, which must be of the same size.
+func (s bitset) set(o bitset) {
+	copy(s, o)
+}
+
+// forEach calls fn for every member of bitset s, in increasing order.
+func (s bitset) forEach(fn func(i int)) {
+	for i1, e1 := range s {
+		for e1 != 0 {
+			fn(i1*wordBits + bits.TrailingZeros64(e1))
+			e1 &= e1 - 1
+		}
+	}
+}
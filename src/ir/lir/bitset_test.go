@@ -0,0 +1,42 @@
+package lir
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestBitset verifies the set operations of bitsets that span several words.
+func TestBitset(t *testing.T) {
+	members := func(s bitset) []int {
+		res := []int{}
+		s.forEach(func(i int) {
+			res = append(res, i)
+		})
+		return res
+	}
+	s, o, d := newBitset(130), newBitset(130), newBitset(130)
+	for _, e1 := range []int{0, 63, 64, 129} {
+		s.add(e1)
+	}
+	s.remove(63)
+	if exp := []int{0, 64, 129}; !reflect.DeepEqual(members(s), exp) || !s.has(64) || s.has(63) {
+		t.Errorf("expected members %v, got %v", exp, members(s))
+	}
+	o.add(1)
+	o.add(64)
+	o.add(100)
+	d.add(100)
+	if !s.unionDiff(o, d) || s.unionDiff(o, d) {
+		t.Error("expected the first difference to change the set, and the second not to")
+	}
+	if !s.union(o) || s.union(o) {
+		t.Error("expected the first union to change the set, and the second not to")
+	}
+	if exp := []int{0, 1, 64, 100, 129}; !reflect.DeepEqual(members(s), exp) {
+		t.Errorf("expected members %v, got %v", exp, members(s))
+	}
+	s.set(d)
+	if exp := []int{100}; !reflect.DeepEqual(members(s), exp) {
+		t.Errorf("expected members %v, got %v", exp, members(s))
+	}
+}
@@ -3,6 +3,7 @@ package lir
 import (
 	"fmt"
 	"strings"
+	tree "vslc/src/ir"
 	"vslc/src/ir/lir/types"
 )
 
@@ -17,6 +18,9 @@ type Block struct {
 	id           int       // id is th unique global identifier of the block.
 	instructions []Value   // instructions holds all the instructions defined for the Block.
 	term         Value     // term defines the terminating instruction of the Block.
+	preds        []*Block  // preds holds the Blocks that may branch to this Block.
+	succs        []*Block  // succs holds the Blocks that this Block may branch to.
+	ip           Value     // ip is the instruction that new instructions are inserted before. If nil, they are appended.
 }
 
 // ---------------------
@@ -68,50 +72,191 @@ func (b *Block) Instructions() []Value {
 	return b.instructions
 }
 
+// Predecessors returns the Blocks that may branch to Block b, in the order their branches were created.
+func (b *Block) Predecessors() []*Block {
+	return b.preds
+}
+
+// Successors returns the Blocks that Block b may branch to. For conditional branches the then Block precedes the
+// else Block. Blocks terminated by a return statement have no successors.
+func (b *Block) Successors() []*Block {
+	return b.succs
+}
+
+// link adds a control flow edge from Block b to Block target.
+func (b *Block) link(target *Block) {
+	b.succs = append(b.succs, target)
+	target.preds = append(target.preds, b)
+}
+
+// unlink removes all control flow edges from Block b to its successors, including the incoming values of phi
+// instructions in the successors.
+func (b *Block) unlink() {
+	for len(b.succs) > 0 {
+		b.removeEdge(b.succs[0])
+	}
+}
+
+// removeEdge removes a single control flow edge from Block b to Block target, and the corresponding incoming Value of
+// every phi instruction in target. The branch instruction of b is not modified.
+func (b *Block) removeEdge(target *Block) {
+	for i1, e1 := range b.succs {
+		if e1 == target {
+			b.succs = append(b.succs[:i1], b.succs[i1+1:]...)
+			break
+		}
+	}
+	for i1, e1 := range target.preds {
+		if e1 == b {
+			target.preds = append(target.preds[:i1], target.preds[i1+1:]...)
+			break
+		}
+	}
+	if target.hasPred(b) {
+		// Phi instructions keep their Value for the remaining edge.
+		return
+	}
+	for _, e1 := range target.instructions {
+		p, ok := e1.(*PhiInstruction)
+		if !ok {
+			break
+		}
+		for i2, e2 := range p.incoming {
+			if e2.b == b {
+				e2.val.removeUser(p)
+				p.incoming = append(p.incoming[:i2], p.incoming[i2+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// insert inserts the instruction v at index idx of Block b's instructions.
+func (b *Block) insert(idx int, v Value) {
+	b.instructions = append(b.instructions, nil)
+	copy(b.instructions[idx+1:], b.instructions[idx:])
+	b.instructions[idx] = v
+}
+
+// emit inserts the instructions v at the insertion point of Block b and gives them the current source Location of
+// the Function. If no insertion point is set, the instructions are appended to the end of the Block.
+func (b *Block) emit(v ...Value) {
+	for _, e1 := range v {
+		e1.SetLocation(b.f.loc)
+	}
+	if b.ip == nil {
+		b.instructions = append(b.instructions, v...)
+		return
+	}
+	idx := b.indexOf(b.ip)
+	if idx < 0 {
+		panic(fmt.Sprintf("insertion point %s is not part of basic block %s", b.ip.Name(), b.Name()))
+	}
+	for i1, e1 := range v {
+		b.insert(idx+i1, e1)
+	}
+}
+
+// SetInsertPoint makes the builders of Block b insert new instructions immediately before instruction v, in the order
+// they are created. If v is nil, new instructions are appended to the end of the Block. Terminating instructions are
+// always appended and phi instructions are always put at the top of the Block.
+func (b *Block) SetInsertPoint(v Value) {
+	if v != nil && b.indexOf(v) < 0 {
+		panic(fmt.Sprintf("cannot set insertion point: %s is not part of basic block %s", v.Name(), b.Name()))
+	}
+	b.ip = v
+}
+
+// InsertBefore moves instruction v immediately before instruction pos of Block b. If v belongs to another Block, it is
+// removed from that Block. Terminating instructions cannot be moved.
+func (b *Block) InsertBefore(pos, v Value) {
+	b.move(pos, v, 0)
+}
+
+// InsertAfter moves instruction v immediately after instruction pos of Block b. If v belongs to another Block, it is
+// removed from that Block. Terminating instructions cannot be moved, and nothing can be inserted after them.
+func (b *Block) InsertAfter(pos, v Value) {
+	if pos == b.term {
+		panic(fmt.Sprintf("cannot insert %s after terminator of basic block %s", v.Name(), b.Name()))
+	}
+	b.move(pos, v, 1)
+}
+
+// move moves instruction v to the index of instruction pos plus offset in Block b.
+func (b *Block) move(pos, v Value, offset int) {
+	if pos == v {
+		return
+	}
+	src := blockOf(v)
+	if src != nil && src.term == v {
+		panic(fmt.Sprintf("cannot move terminator %s of basic block %s", v.Name(), src.Name()))
+	}
+	if b.indexOf(pos) < 0 {
+		panic(fmt.Sprintf("cannot insert %s: %s is not part of basic block %s", v.Name(), pos.Name(), b.Name()))
+	}
+	if src != nil {
+		if idx := src.indexOf(v); idx >= 0 {
+			if src.ip == v {
+				panic(fmt.Sprintf("cannot move %s: it is the insertion point of basic block %s", v.Name(), src.Name()))
+			}
+			src.instructions = append(src.instructions[:idx], src.instructions[idx+1:]...)
+		}
+	}
+	b.insert(b.indexOf(pos)+offset, v)
+	setBlock(v, b)
+}
+
+// insertBeforeTerminator inserts the instruction v immediately before the terminating instruction of Block b. If
+// Block b is not terminated, v is appended to the end of the Block.
+func (b *Block) insertBeforeTerminator(v Value) {
+	if b.term == nil {
+		b.instructions = append(b.instructions, v)
+		return
+	}
+	b.insert(b.indexOf(b.term), v)
+}
+
+// indexOf returns the index of instruction v in Block b. If v is not part of Block b, -1 is returned.
+func (b *Block) indexOf(v Value) int {
+	for i1, e1 := range b.instructions {
+		if e1 == v {
+			return i1
+		}
+	}
+	return -1
+}
+
 // ---------------------------------
 // ----- Constant instructions -----
 // ---------------------------------
 
 // CreateConstantInt creates an integer constant.
 func (b *Block) CreateConstantInt(i int) *Constant {
-	b.f.m.Lock()
-	seq := b.f.m.seq
-	b.f.m.seq++
-	b.f.m.Unlock()
-	inst := &Constant{
-		b:    b,
-		id:   b.f.getId(),
-		typ:  types.Int,
-		val:  i,
-		lseq: seq,
-		en:   true,
-	}
-	inst.name = fmt.Sprintf("%s%d", labelDataInstruction, inst.id)
-	b.instructions = append(b.instructions, inst)
-	b.f.m.Lock()
-	b.f.m.constants = append(b.f.m.constants, inst) // Append to Module's slice of constants.
-	b.f.m.Unlock()
+	inst := b.newConstant(types.Int, i)
+	b.emit(inst)
 	return inst
 }
 
 // CreateConstantFloat creates a floating point constant.
 func (b *Block) CreateConstantFloat(f float64) *Constant {
-	b.f.m.Lock()
-	seq := b.f.m.seq
-	b.f.m.seq++
-	b.f.m.Unlock()
+	inst := b.newConstant(types.Float, f)
+	b.emit(inst)
+	return inst
+}
+
+// newConstant creates a Constant owned by Block b and links it to the Module's data entry of its value. The Constant
+// is not inserted into the Block's instructions.
+func (b *Block) newConstant(typ types.DataType, val interface{}) *Constant {
 	inst := &Constant{
-		b:    b,
-		id:   b.f.getId(),
-		typ:  types.Float,
-		val:  f,
-		lseq: seq,
-		en:   true,
+		b:   b,
+		id:  b.f.getId(),
+		typ: typ,
+		val: val,
+		en:  true,
 	}
 	inst.name = fmt.Sprintf("%s%d", labelDataInstruction, inst.id)
-	b.instructions = append(b.instructions, inst)
 	b.f.m.Lock()
-	b.f.m.constants = append(b.f.m.constants, inst) // Append to Module's slice of constants.
+	b.f.m.createConstant(inst)
 	b.f.m.Unlock()
 	return inst
 }
@@ -134,7 +279,8 @@ func (b *Block) CreateIntToFloat(v Value) *CastInstruction {
 		src: v,
 		en:  true,
 	}
-	b.instructions = append(b.instructions, inst)
+	b.emit(inst)
+	use(inst)
 	return inst
 }
 
@@ -152,7 +298,8 @@ func (b *Block) CreateFloatToInt(v Value) *CastInstruction {
 		src: v,
 		en:  true,
 	}
-	b.instructions = append(b.instructions, inst)
+	b.emit(inst)
+	use(inst)
 	return inst
 }
 
@@ -218,12 +365,26 @@ func (b *Block) CreateLShift(op1, op2 Value) *DataInstruction {
 	return b.createArithmeticInstruction(types.LShift, op1, op2)
 }
 
-// CreateRShift creates an LIR right shift instruction and puts the result in the returned virtual register.
-// Result = op1 >> op2
+// CreateRShift creates an LIR logical right shift instruction and puts the result in the returned virtual register.
+// Zeros are shifted in.
+// Result = op1 >>> op2
 func (b *Block) CreateRShift(op1, op2 Value) *DataInstruction {
 	return b.createArithmeticInstruction(types.RShift, op1, op2)
 }
 
+// CreateMulHigh creates an LIR signed multiply high instruction and puts the result in the returned virtual register.
+// Result = (op1 * op2) >> wordsize
+func (b *Block) CreateMulHigh(op1, op2 Value) *DataInstruction {
+	return b.createArithmeticInstruction(types.MulHigh, op1, op2)
+}
+
+// CreateARShift creates an LIR arithmetic right shift instruction and puts the result in the returned virtual
+// register. The sign bit of op1 is shifted in.
+// Result = op1 >> op2
+func (b *Block) CreateARShift(op1, op2 Value) *DataInstruction {
+	return b.createArithmeticInstruction(types.ARShift, op1, op2)
+}
+
 // CreateAnd creates an LIR arithmetic and instruction and puts the result in the returned virtual register.
 // Result = op1 & op2
 func (b *Block) CreateAnd(op1, op2 Value) *DataInstruction {
@@ -292,7 +453,8 @@ func (b *Block) createArithmeticInstruction(op types.ArithmeticOperation, op1, o
 	}
 
 	// Create, append and return the expression.
-	inst := &DataInstruction{
+	inst := b.f.arena.newData()
+	*inst = DataInstruction{
 		b:   b,
 		id:  b.f.getId(),
 		op:  op,
@@ -300,7 +462,8 @@ func (b *Block) createArithmeticInstruction(op types.ArithmeticOperation, op1, o
 		op2: op2,
 		en:  true,
 	}
-	b.instructions = append(b.instructions, inst)
+	b.emit(inst)
+	use(inst)
 	return inst
 }
 
@@ -347,7 +510,9 @@ func (b *Block) CreateFunctionCall(target *Function, arguments []Value) *Preserv
 		src: inst,
 		en:  true,
 	}
-	b.instructions = append(b.instructions, inst, preserve)
+	b.emit(inst, preserve)
+	use(inst)
+	use(preserve)
 	return preserve
 }
 
@@ -370,7 +535,9 @@ func (b *Block) CreateBranch(target *Block) *BranchInstruction {
 		en:  true,
 	}
 	b.instructions = append(b.instructions, inst)
+	inst.loc = b.f.loc
 	b.term = inst
+	b.link(target)
 	return inst
 }
 
@@ -406,17 +573,25 @@ func (b *Block) CreateConditionalBranch(op types.RelationalOperation, op1, op2 V
 		en:  true,
 	}
 	b.instructions = append(b.instructions, inst)
+	use(inst)
+	inst.loc = b.f.loc
 	b.term = inst
+	b.link(thn)
+	b.link(els)
 	return inst
 }
 
 // CreateReturn creates a return statement. This method terminates Block b.
 func (b *Block) CreateReturn(val Value) *ReturnInstruction {
+	if b.term != nil {
+		panic(fmt.Sprintf("basic block %s is already terminated", b.Name()))
+	}
 	if val.Type() != types.DataInstruction &&
 		val.Type() != types.Constant &&
 		val.Type() != types.LoadInstruction &&
 		val.Type() != types.PreserveInstruction &&
-		val.Type() != types.FunctionCallInstruction {
+		val.Type() != types.FunctionCallInstruction &&
+		val.Type() != types.PhiInstruction {
 		panic(fmt.Sprintf("cannot use value %s as return value", val.Name()))
 	}
 	inst := &ReturnInstruction{
@@ -426,6 +601,8 @@ func (b *Block) CreateReturn(val Value) *ReturnInstruction {
 		en:  true,
 	}
 	b.instructions = append(b.instructions, inst)
+	inst.loc = b.f.loc
+	use(inst)
 	b.term = inst
 	return inst
 }
@@ -459,14 +636,16 @@ func (b *Block) CreateStore(src, dst Value) *StoreInstruction {
 			src = b.CreateFloatToInt(src)
 		}
 	}
-	inst := &StoreInstruction{
+	inst := b.f.arena.newStore()
+	*inst = StoreInstruction{
 		b:   b,
 		id:  b.f.getId(),
 		src: src,
 		dst: dst,
 		en:  true,
 	}
-	b.instructions = append(b.instructions, inst)
+	b.emit(inst)
+	use(inst)
 	return inst
 }
 
@@ -481,13 +660,15 @@ func (b *Block) CreateLoad(src Value) *LoadInstruction {
 		panic(fmt.Sprintf("cannot create load from %s: can only load from globals, arguments or locally declared variables",
 			src.Type().String()))
 	}
-	inst := &LoadInstruction{
+	inst := b.f.arena.newLoad()
+	*inst = LoadInstruction{
 		b:   b,
 		id:  b.f.getId(),
 		src: src,
 		en:  true,
 	}
-	b.instructions = append(b.instructions, inst)
+	b.emit(inst)
+	use(inst)
 	return inst
 }
 
@@ -504,6 +685,7 @@ func (b *Block) CreateDeclare(name string, typ types.DataType) *DeclareInstructi
 	inst := &DeclareInstruction{
 		b:   b,
 		id:  b.f.getId(),
+		seq: b.f.getVSeq(),
 		typ: typ,
 		en:  true,
 	}
@@ -512,6 +694,7 @@ func (b *Block) CreateDeclare(name string, typ types.DataType) *DeclareInstructi
 	} else {
 		inst.name = fmt.Sprintf("%s%d", labelDeclare, inst.id)
 	}
+	inst.loc = b.f.loc
 	// Append declaration to Block b's Function's slice of locally declared variables.
 	b.f.variables = append(b.f.variables, inst)
 	return inst
@@ -521,54 +704,9 @@ func (b *Block) CreateDeclare(name string, typ types.DataType) *DeclareInstructi
 // ----- Print statement -----
 // ---------------------------
 
-// CreatePrint creates an LIR function call statement that prints a slice of LIR Values.
+// CreatePrint creates an LIR function call statement that prints a slice of LIR Values to the file descriptor fd.
 // Runtime execution uses standard library printf. Print appends a newline character to the printout.
-func (b *Block) CreatePrint(val []Value) *FunctionCallInstruction {
-	for _, e1 := range val {
-		if e1.Type() != types.DataInstruction &&
-			e1.Type() != types.LoadInstruction &&
-			e1.Type() != types.Constant &&
-			e1.Type() != types.FunctionCallInstruction &&
-			e1.Type() != types.PreserveInstruction &&
-			e1.Type() != types.CastInstruction {
-			panic(fmt.Sprintf("cannot print a %s value", e1.Type().String()))
-		}
-	}
-
-	// Check if printf is defined.
-	printf := b.f.m.GetFunction(reservedNames[0])
-	if printf == nil {
-		// Define printf and add it to Module m.
-		b.f.m.Lock()
-		printf = &Function{
-			m:      b.f.m,
-			id:     b.f.m.seq,
-			name:   reservedNames[0],
-			typ:    types.Int,
-			params: make([]*Param, 2),
-		}
-		b.f.m.seq++
-		format := &Param{
-			f:    printf,
-			id:   printf.getId(),
-			name: "format",
-			typ:  types.String,
-			en:   true,
-		}
-		valist := &Param{
-			f:    printf,
-			id:   printf.getId(),
-			name: "args",
-			typ:  types.VaList,
-			en:   true,
-		}
-		printf.params[0] = format
-		printf.params[1] = valist
-		b.f.m.functions = append(b.f.m.functions, printf)
-		b.f.m.fmap[printf.name] = printf
-		b.f.m.Unlock()
-	}
-
+func (b *Block) CreatePrint(fd int, val []Value) *FunctionCallInstruction {
 	// Pre allocate string buffer.
 	sb := strings.Builder{}
 	sb.Grow(len(val) * 3) // A % and data type format identifier plus a single space (newline at end).
@@ -578,13 +716,20 @@ func (b *Block) CreatePrint(val []Value) *FunctionCallInstruction {
 	for i1, e1 := range val {
 		switch e1.DataType() {
 		case types.Int:
-			sb.WriteString("%d")
+			sb.WriteString(tree.IntConversion(b.f.m.intBits))
 			vars = append(vars, e1)
 		case types.Float:
 			sb.WriteString("%f")
 			vars = append(vars, e1)
 		case types.String:
-			sb.WriteString(e1.Operand1().(*String).val) // Put string literal in format string.
+			if str, ok := e1.Operand1().(*String); ok {
+				// Put string literal in format string, where its percent signs are printed as is.
+				sb.WriteString(strings.ReplaceAll(str.val, "%", "%%"))
+			} else {
+				// String computed at runtime, such as a program argument.
+				sb.WriteString("%s")
+				vars = append(vars, e1)
+			}
 		default:
 			panic(fmt.Sprintf("cannot print data type %s", e1.String()))
 		}
@@ -593,29 +738,60 @@ func (b *Block) CreatePrint(val []Value) *FunctionCallInstruction {
 		}
 	}
 	sb.WriteRune('\n')
+	return b.CreatePrintf(fd, sb.String(), vars)
+}
+
+// CreatePrintf creates an LIR function call statement that calls standard library printf with the format string
+// format and the int and float Values val as its variable arguments, whose types must match the conversion
+// specifications of format. Output to other file descriptors fd than standard output calls dprintf, which is passed
+// fd before the format string.
+func (b *Block) CreatePrintf(fd int, format string, val []Value) *FunctionCallInstruction {
+	for _, e1 := range val {
+		if e1.Type() != types.DataInstruction &&
+			e1.Type() != types.LoadInstruction &&
+			e1.Type() != types.Constant &&
+			e1.Type() != types.FunctionCallInstruction &&
+			e1.Type() != types.PreserveInstruction &&
+			e1.Type() != types.CastInstruction {
+			panic(fmt.Sprintf("cannot print a %s value", e1.Type().String()))
+		}
+	}
+
+	// Declare printf or dprintf if it isn't declared.
+	var target *Function
+	var args []Value
+	if fd == stdout {
+		target = b.f.m.declareNamed(reservedNames[0], types.Int, []string{"format", "args"},
+			[]types.DataType{types.String, types.VaList})
+	} else {
+		target = b.f.m.declareNamed(reservedNames[4], types.Int, []string{"fd", "format", "args"},
+			[]types.DataType{types.Int, types.String, types.VaList})
+		args = append(args, b.CreateConstantInt(fd))
+	}
 
 	// Create string constant and load the constant address.
-	format := b.f.m.CreateGlobalString(sb.String())
-	fload := b.CreateLoad(format)
+	fload := b.CreateLoad(b.f.m.CreateGlobalString(format))
 
 	// Create variable argument list.
 	valist := &VaList{
 		b:    b,
 		id:   b.f.getId(),
-		vars: vars,
+		vars: val,
 		en:   true,
 	}
 
-	b.instructions = append(b.instructions, valist)
+	b.emit(valist)
+	use(valist)
 
-	// Create function call to printf.
+	// Create function call to printf or dprintf.
 	inst := &FunctionCallInstruction{
 		b:         b,
 		id:        b.f.getId(),
-		target:    printf,
-		arguments: []Value{fload, valist},
+		target:    target,
+		arguments: append(args, fload, valist),
 		en:        true,
 	}
-	b.instructions = append(b.instructions, inst)
+	b.emit(inst)
+	use(inst)
 	return inst
 }
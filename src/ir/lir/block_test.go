@@ -0,0 +1,142 @@
+package lir
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"vslc/src/frontend"
+	tree "vslc/src/ir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// TestBlockEdges verifies that branch instructions maintain the predecessor and successor lists of Blocks.
+func TestBlockEdges(t *testing.T) {
+	f := createCounterLoop()
+	b := f.Blocks()
+	entry, head, body, exit := b[0], b[1], b[2], b[3]
+
+	exp := []struct {
+		b     *Block
+		preds []*Block
+		succs []*Block
+	}{
+		{b: entry, preds: nil, succs: []*Block{head}},
+		{b: head, preds: []*Block{entry, body}, succs: []*Block{body, exit}},
+		{b: body, preds: []*Block{head}, succs: []*Block{head}},
+		{b: exit, preds: []*Block{head}, succs: nil},
+	}
+	for _, e1 := range exp {
+		if !equalBlocks(e1.b.Predecessors(), e1.preds) {
+			t.Errorf("%s: unexpected predecessors", e1.b.Name())
+		}
+		if !equalBlocks(e1.b.Successors(), e1.succs) {
+			t.Errorf("%s: unexpected successors", e1.b.Name())
+		}
+	}
+}
+
+// equalBlocks returns true if a and b hold the same Blocks in the same order.
+func equalBlocks(a, b []*Block) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i1 := range a {
+		if a[i1] != b[i1] {
+			return false
+		}
+	}
+	return true
+}
+
+// TestConstantDedup verifies that identical constants and strings share a single data entry of the Module.
+func TestConstantDedup(t *testing.T) {
+	m := CreateModule("test")
+	b := m.CreateFunction("f", types.Int).CreateBlock()
+
+	c1 := b.CreateConstantInt(1 << 40)
+	c2 := b.CreateConstantInt(1 << 40)
+	f1 := b.CreateConstantFloat(0.0)
+	f2 := b.CreateConstantFloat(math.Copysign(0, -1))
+	if c1 == c2 {
+		t.Fatalf("expected distinct instructions for identical constants")
+	}
+	if c1.GlobalSeq() != c2.GlobalSeq() {
+		t.Errorf("expected identical constants to share data entry, got %d and %d", c1.GlobalSeq(), c2.GlobalSeq())
+	}
+	if f1.GlobalSeq() == f2.GlobalSeq() {
+		t.Errorf("expected 0.0 and -0.0 to have distinct data entries")
+	}
+	if len(m.Constants()) != 3 {
+		t.Errorf("expected 3 data entries, got %d", len(m.Constants()))
+	}
+	c2.Use()
+	if !c1.Used() {
+		t.Errorf("expected use of identical constant to mark the shared data entry as used")
+	}
+
+	if m.CreateGlobalString("%d\n") != m.CreateGlobalString("%d\n") {
+		t.Errorf("expected identical strings to be deduplicated")
+	}
+}
+
+// TestInsertPoint verifies that builders insert at the insertion point and that instructions can be moved between
+// Blocks.
+func TestInsertPoint(t *testing.T) {
+	m := CreateModule("test")
+	f := m.CreateFunction("insert", types.Int)
+	entry := f.CreateBlock()
+	exit := f.CreateBlock()
+
+	c1 := entry.CreateConstantInt(1)
+	ret := entry.CreateAdd(c1, c1)
+	entry.SetInsertPoint(ret)
+	c2 := entry.CreateConstantInt(2)
+	add := entry.CreateAdd(c1, c2)
+	entry.SetInsertPoint(nil)
+	entry.CreateBranch(exit)
+	if !equalValues(entry.Instructions(), []Value{c1, c2, add, ret, entry.term}) {
+		t.Errorf("unexpected instruction order:\n%s", entry.String())
+	}
+
+	// Hoist the add instruction into the exit Block.
+	r := exit.CreateReturn(ret)
+	exit.InsertBefore(r, add)
+	exit.InsertAfter(add, c2)
+	if !equalValues(exit.Instructions(), []Value{add, c2, r}) {
+		t.Errorf("unexpected instruction order:\n%s", exit.String())
+	}
+	if !equalValues(entry.Instructions(), []Value{c1, ret, entry.term}) {
+		t.Errorf("unexpected instruction order:\n%s", entry.String())
+	}
+	if add.b != exit || c2.b != exit {
+		t.Errorf("expected moved instructions to be owned by %s", exit.Name())
+	}
+}
+
+// BenchmarkGenLIR measures generating the LIR of a large generated program, whose most common instructions are
+// allocated in blocks by their Function.
+func BenchmarkGenLIR(b *testing.B) {
+	sb := strings.Builder{}
+	for i1 := 0; i1 < 2000; i1++ {
+		sb.WriteString(fmt.Sprintf("def f%d(a, b int) int\nbegin\n    var c, d int\n    c := a * %d + b\n", i1, i1))
+		sb.WriteString("    while c > 0 do\n    begin\n        d := d + c * 3 - a / (b + 2)\n        c := c - 1\n    end\n")
+		sb.WriteString(fmt.Sprintf("    if d > 100 then print \"big\", d\n    return f%d(d, c) + d\nend\n", (i1+1)%2000))
+	}
+	root, err := frontend.Parse(sb.String())
+	if err != nil {
+		b.Fatal(err)
+	}
+	opt := util.Options{Threads: 1}
+	if err := tree.Optimise(opt, root); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := GenLIR(opt, root); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
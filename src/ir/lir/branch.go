@@ -17,17 +17,21 @@ type BranchInstruction struct {
 	els      *Block                    // els is the target for conditional ELSE block. Is <nil> for unconditional branches.
 	op1, op2 Value                     // op1 and op2 are the Values to check compare for conditional branches. Is <nil> for unconditional branches.
 	op       types.RelationalOperation // op defines the type of relation operation of conditional branch.
-	hw       interface{}
+	hw       *LiveNode
 	en       bool // Set to true if instruction is enabled.
+	uses          // uses holds the instructions that use the BranchInstruction.
+	location      // location holds the source location of the BranchInstruction.
 }
 
 // ReturnInstruction defines a return statement.
 type ReturnInstruction struct {
-	b   *Block // b is the basic block element that owns this instruction.
-	id  int    // id is the unique identifier of this instruction in function body.
-	val Value  // val is the returned value of the return statement.
-	hw  interface{}
-	en  bool // Set to true if instruction is enabled.
+	b        *Block // b is the basic block element that owns this instruction.
+	id       int    // id is the unique identifier of this instruction in function body.
+	val      Value  // val is the returned value of the return statement.
+	hw       *LiveNode
+	en       bool // Set to true if instruction is enabled.
+	uses          // uses holds the instructions that use the ReturnInstruction.
+	location      // location holds the source location of the ReturnInstruction.
 }
 
 // ---------------------
@@ -73,12 +77,12 @@ func (inst *BranchInstruction) String() string {
 }
 
 // SetHW panics for the BranchInstruction, because it doesn't put the result in a new virtual register.
-func (inst *BranchInstruction) SetHW(hw interface{}) {
+func (inst *BranchInstruction) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW panics for the BranchInstruction.
-func (inst *BranchInstruction) GetHW() interface{} {
+func (inst *BranchInstruction) GetHW() *LiveNode {
 	return inst.hw
 }
 
@@ -148,12 +152,12 @@ func (inst *ReturnInstruction) String() string {
 }
 
 // SetHW panics for the ReturnInstruction, because it doesn't put the result in a new virtual register.
-func (inst *ReturnInstruction) SetHW(hw interface{}) {
+func (inst *ReturnInstruction) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW returns <nil> for the ReturnInstruction.
-func (inst *ReturnInstruction) GetHW() interface{} {
+func (inst *ReturnInstruction) GetHW() *LiveNode {
 	return inst.hw
 }
 
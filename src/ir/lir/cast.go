@@ -12,12 +12,14 @@ import (
 // CastInstruction defines an instruction that casts either types.Int to types.Float,
 // or vice versa.
 type CastInstruction struct {
-	b   *Block         // b is the basic block element that owns this instruction.
-	id  int            // id is the unique identifier of this instruction in function body.
-	typ types.DataType // typ defines the resulting types.DataType that the instructions casts to.
-	src Value          // src is the source Value that was cast.
-	hw  interface{}    // hw defines the hardware register of the CastInstruction's virtual register.
-	en  bool           // Set to true if instruction is enabled.
+	b        *Block         // b is the basic block element that owns this instruction.
+	id       int            // id is the unique identifier of this instruction in function body.
+	typ      types.DataType // typ defines the resulting types.DataType that the instructions casts to.
+	src      Value          // src is the source Value that was cast.
+	hw       *LiveNode      // hw defines the hardware register of the CastInstruction's virtual register.
+	en       bool           // Set to true if instruction is enabled.
+	uses                    // uses holds the instructions that use the CastInstruction.
+	location                // location holds the source location of the CastInstruction.
 }
 
 // ---------------------
@@ -58,12 +60,12 @@ func (inst *CastInstruction) String() string {
 }
 
 // SetHW panics for the CastInstruction, because it's a memory value, not a virtual register.
-func (inst *CastInstruction) SetHW(hw interface{}) {
+func (inst *CastInstruction) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW returns <nil> for the CastInstruction.
-func (inst *CastInstruction) GetHW() interface{} {
+func (inst *CastInstruction) GetHW() *LiveNode {
 	return inst.hw
 }
 
@@ -0,0 +1,362 @@
+package lir
+
+import "vslc/src/util"
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// SimplifyCFG simplifies the control flow graph of all Functions of Module m. The parameter opt.Threads is the
+// maximum number of threads allowed to run in parallel.
+func SimplifyCFG(opt util.Options, m *Module) {
+	forEachFunction(opt, m, (*Function).SimplifyCFG)
+}
+
+// SimplifyCFG simplifies the control flow graph of Function f. Jumps to Blocks that only contain an unconditional
+// jump are threaded through to the final target, unreachable Blocks are removed, and a Block with a single successor
+// is merged with that successor if the Block is its only predecessor.
+func (f *Function) SimplifyCFG() {
+	if len(f.blocks) < 1 {
+		return
+	}
+	for changed := true; changed; {
+		changed = f.threadJumps()
+		if f.removeUnreachable() {
+			changed = true
+		}
+		if f.mergeBlocks() {
+			changed = true
+		}
+	}
+}
+
+// SplitCriticalEdges splits every critical edge of Function f, which is an edge from a Block with multiple successors
+// to a Block with multiple predecessors, by inserting an empty Block that jumps unconditionally to the original target.
+// The inserted Block is placed directly before the target Block. Returns the inserted Blocks.
+func (f *Function) SplitCriticalEdges() []*Block {
+	res := make([]*Block, 0)
+	for i1 := 0; i1 < len(f.blocks); i1++ {
+		b := f.blocks[i1]
+		br, ok := b.term.(*BranchInstruction)
+		if !ok || br.els == nil {
+			continue
+		}
+		for _, e1 := range []**Block{&br.thn, &br.els} {
+			if len((*e1).preds) < 2 {
+				continue
+			}
+			s := f.splitEdge(b, *e1)
+			*e1 = s
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// splitEdge replaces a single control flow edge from Block b to Block target with an edge from b to a new Block that
+// jumps unconditionally to target, and returns the new Block. Incoming Values of phi instructions in target are
+// moved to the new Block. The branch instruction of b must be retargeted by the caller.
+func (f *Function) splitEdge(b, target *Block) *Block {
+	phis := target.phis()
+	vals := make([]Value, len(phis))
+	for i1, e1 := range phis {
+		vals[i1] = e1.IncomingFrom(b)
+	}
+	b.removeEdge(target)
+
+	// Insert the new Block before target, such that it falls through to target.
+	s := &Block{
+		f:            f,
+		id:           f.m.getId(),
+		instructions: make([]Value, 0, 1),
+	}
+	idx := 0
+	for f.blocks[idx] != target {
+		idx++
+	}
+	f.blocks = append(f.blocks, nil)
+	copy(f.blocks[idx+1:], f.blocks[idx:])
+	f.blocks[idx] = s
+
+	s.CreateBranch(target)
+	b.link(s)
+	for i1, e1 := range phis {
+		e1.AddIncoming(vals[i1], s)
+	}
+	return s
+}
+
+// threadJumps retargets branches to empty Blocks, that only jump unconditionally to another Block, to the final
+// target of the jump chain. Conditional branches whose targets become identical are made unconditional. Returns true
+// if any branch was changed.
+func (f *Function) threadJumps() bool {
+	changed := false
+	for _, e1 := range f.blocks {
+		br, ok := e1.term.(*BranchInstruction)
+		if !ok {
+			continue
+		}
+		for _, e2 := range []**Block{&br.thn, &br.els} {
+			if *e2 == nil {
+				continue
+			}
+			target, last := f.jumpTarget(*e2)
+			if target == *e2 {
+				continue
+			}
+
+			// Phi instructions of the target receive the Value that flowed through the jump chain. A Block can only
+			// have a single incoming Value per phi instruction.
+			if len(target.phis()) > 0 {
+				if target.hasPred(e1) {
+					continue
+				}
+				for _, e3 := range target.phis() {
+					e3.AddIncoming(e3.IncomingFrom(last), e1)
+				}
+			}
+			e1.removeEdge(*e2)
+			*e2 = target
+			e1.link(target)
+			changed = true
+		}
+
+		if br.els != nil && br.thn == br.els {
+			// Both targets are identical: jump unconditionally.
+			unuse(br)
+			e1.removeEdge(br.els)
+			br.els = nil
+			br.op1 = nil
+			br.op2 = nil
+			changed = true
+		}
+	}
+	return changed
+}
+
+// jumpTarget follows the chain of empty Blocks starting at Block b, that only jump unconditionally to another Block,
+// and returns the final target and the last Block of the chain. If b is not empty, b is returned for both.
+func (f *Function) jumpTarget(b *Block) (*Block, *Block) {
+	target, last := b, b
+	visited := make(map[*Block]bool)
+	for target != f.blocks[0] && len(target.instructions) == 1 && !visited[target] {
+		br, ok := target.term.(*BranchInstruction)
+		if !ok || br.els != nil {
+			break
+		}
+		visited[target] = true
+		last = target
+		target = br.thn
+	}
+	if visited[target] {
+		// Empty infinite loop: leave the jump chain untouched.
+		return b, b
+	}
+	return target, last
+}
+
+// removeUnreachable removes the Blocks of Function f that cannot be reached from the entry Block. Returns true if
+// any Block was removed.
+func (f *Function) removeUnreachable() bool {
+	po := f.PostOrder()
+	if len(po) == len(f.blocks) {
+		return false
+	}
+	reachable := make(map[*Block]bool, len(po))
+	for _, e1 := range po {
+		reachable[e1] = true
+	}
+	res := f.blocks[:0]
+	for _, e1 := range f.blocks {
+		if reachable[e1] {
+			res = append(res, e1)
+		} else {
+			e1.unlink()
+			for _, e2 := range e1.instructions {
+				unuse(e2)
+			}
+		}
+	}
+	f.blocks = res
+	return true
+}
+
+// mergeBlocks merges every Block that jumps unconditionally to a successor with that successor, if the Block is the
+// successor's only predecessor. Returns true if any Blocks were merged.
+func (f *Function) mergeBlocks() bool {
+	changed := false
+	repl := make(map[Value]Value)
+	for i1 := 0; i1 < len(f.blocks); i1++ {
+		b := f.blocks[i1]
+		for {
+			br, ok := b.term.(*BranchInstruction)
+			if !ok || br.els != nil {
+				break
+			}
+			s := br.thn
+			if s == b || s == f.blocks[0] || len(s.preds) != 1 {
+				break
+			}
+
+			// Phi instructions of a Block with a single predecessor select a single Value.
+			phis := s.phis()
+			for _, e1 := range phis {
+				unuse(e1)
+				repl[e1] = e1.incoming[0].val
+			}
+
+			// Replace the unconditional jump of b with the instructions of s.
+			b.instructions = append(b.instructions[:len(b.instructions)-1], s.instructions[len(phis):]...)
+			for _, e1 := range s.instructions[len(phis):] {
+				setBlock(e1, b)
+			}
+			for _, e1 := range f.variables {
+				if e1.b == s {
+					e1.b = b
+				}
+			}
+			b.term = s.term
+			b.succs = s.succs
+			for _, e1 := range s.succs {
+				for i2, e2 := range e1.preds {
+					if e2 == s {
+						e1.preds[i2] = b
+					}
+				}
+				for _, e2 := range e1.phis() {
+					for i3, e3 := range e2.incoming {
+						if e3.b == s {
+							e2.incoming[i3].b = b
+						}
+					}
+				}
+			}
+
+			// Remove s from the Function.
+			for i2, e2 := range f.blocks {
+				if e2 == s {
+					f.blocks = append(f.blocks[:i2], f.blocks[i2+1:]...)
+					if i2 < i1 {
+						i1--
+					}
+					break
+				}
+			}
+			changed = true
+		}
+	}
+	if len(repl) > 0 {
+		for k, v := range repl {
+			for r, ok := repl[v]; ok; r, ok = repl[v] {
+				v = r
+			}
+			repl[k] = v
+		}
+		for _, e1 := range f.blocks {
+			for _, e2 := range e1.instructions {
+				replaceOperands(e2, repl)
+			}
+		}
+	}
+	return changed
+}
+
+// phis returns the phi instructions at the top of Block b.
+func (b *Block) phis() []*PhiInstruction {
+	var res []*PhiInstruction
+	for _, e1 := range b.instructions {
+		p, ok := e1.(*PhiInstruction)
+		if !ok {
+			break
+		}
+		res = append(res, p)
+	}
+	return res
+}
+
+// hasPred returns true if Block p is a predecessor of Block b.
+func (b *Block) hasPred(p *Block) bool {
+	for _, e1 := range b.preds {
+		if e1 == p {
+			return true
+		}
+	}
+	return false
+}
+
+// setBlock sets the owning Block of instruction v to b.
+func setBlock(v Value, b *Block) {
+	switch inst := v.(type) {
+	case *DataInstruction:
+		inst.b = b
+	case *LoadInstruction:
+		inst.b = b
+	case *StoreInstruction:
+		inst.b = b
+	case *Constant:
+		inst.b = b
+	case *BranchInstruction:
+		inst.b = b
+	case *ReturnInstruction:
+		inst.b = b
+	case *FunctionCallInstruction:
+		inst.b = b
+	case *CastInstruction:
+		inst.b = b
+	case *PreserveInstruction:
+		inst.b = b
+	case *VaList:
+		inst.b = b
+	case *PhiInstruction:
+		inst.b = b
+	case *PrintInstruction:
+		inst.b = b
+	case *SelectInstruction:
+		inst.b = b
+	}
+}
+
+// blockOf returns the owning Block of instruction v. If v is not an instruction, nil is returned.
+func blockOf(v Value) *Block {
+	switch inst := v.(type) {
+	case *DataInstruction:
+		return inst.b
+	case *LoadInstruction:
+		return inst.b
+	case *StoreInstruction:
+		return inst.b
+	case *Constant:
+		return inst.b
+	case *BranchInstruction:
+		return inst.b
+	case *ReturnInstruction:
+		return inst.b
+	case *FunctionCallInstruction:
+		return inst.b
+	case *CastInstruction:
+		return inst.b
+	case *PreserveInstruction:
+		return inst.b
+	case *VaList:
+		return inst.b
+	case *PhiInstruction:
+		return inst.b
+	case *PrintInstruction:
+		return inst.b
+	case *SelectInstruction:
+		return inst.b
+	}
+	return nil
+}
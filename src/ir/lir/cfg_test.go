@@ -0,0 +1,87 @@
+package lir
+
+import (
+	"testing"
+	"vslc/src/ir/lir/types"
+)
+
+// TestSimplifyCFG verifies that jumps through empty Blocks are threaded to their final target and that the resulting
+// chain of Blocks is merged into the entry Block.
+func TestSimplifyCFG(t *testing.T) {
+	m := CreateModule("test")
+	f := m.CreateFunction("chain", types.Int)
+	entry := f.CreateBlock()
+	empty1 := f.CreateBlock()
+	empty2 := f.CreateBlock()
+	exit := f.CreateBlock()
+	dead := f.CreateBlock()
+
+	entry.CreateBranch(empty1)
+	empty1.CreateBranch(empty2)
+	empty2.CreateBranch(exit)
+	exit.CreateReturn(exit.CreateConstantInt(0))
+	dead.CreateBranch(exit)
+
+	f.SimplifyCFG()
+	if len(f.Blocks()) != 1 {
+		t.Fatalf("expected 1 block after SimplifyCFG, got %d:\n%s", len(f.Blocks()), f.String())
+	}
+	if f.Blocks()[0] != entry {
+		t.Errorf("expected entry block to remain")
+	}
+	if entry.term.Type() != types.ReturnInstruction {
+		t.Errorf("expected return terminator, got %s", entry.term.String())
+	}
+	if len(entry.Successors()) != 0 {
+		t.Errorf("expected 0 successors, got %d", len(entry.Successors()))
+	}
+
+	// Loops must keep their phi instructions intact.
+	f = createCounterLoop()
+	f.Mem2Reg()
+	f.SimplifyCFG()
+	for _, e1 := range f.Blocks() {
+		for _, e2 := range e1.phis() {
+			if vals, _ := e2.Incoming(); len(vals) != len(e1.Predecessors()) {
+				t.Errorf("expected %d incoming values, got %d: %s", len(e1.Predecessors()), len(vals), e2.String())
+			}
+		}
+	}
+}
+
+// TestSplitCriticalEdges verifies that the critical edge of an if-then construct is split and that the phi
+// instruction of the join Block selects its Value from the inserted Block.
+func TestSplitCriticalEdges(t *testing.T) {
+	m := CreateModule("test")
+	f := m.CreateFunction("split", types.Int)
+	entry := f.CreateBlock()
+	thn := f.CreateBlock()
+	join := f.CreateBlock()
+
+	c0 := entry.CreateConstantInt(0)
+	c1 := thn.CreateConstantInt(1)
+	entry.CreateConditionalBranch(types.Eq, c0, c0, thn, join)
+	thn.CreateBranch(join)
+	p := join.CreatePhi(types.Int)
+	p.AddIncoming(c1, thn)
+	p.AddIncoming(c0, entry)
+	join.CreateReturn(p)
+
+	split := f.SplitCriticalEdges()
+	if len(split) != 1 {
+		t.Fatalf("expected 1 split edge, got %d:\n%s", len(split), f.String())
+	}
+	s := split[0]
+	if !equalBlocks(s.Predecessors(), []*Block{entry}) || !equalBlocks(s.Successors(), []*Block{join}) {
+		t.Errorf("unexpected edges of inserted block %s", s.Name())
+	}
+	if !equalBlocks(join.Predecessors(), []*Block{thn, s}) {
+		t.Errorf("unexpected predecessors of %s", join.Name())
+	}
+	if p.IncomingFrom(s) != c0 || p.IncomingFrom(entry) != nil {
+		t.Errorf("expected phi to select %s from %s: %s", c0.Name(), s.Name(), p.String())
+	}
+	if len(f.SplitCriticalEdges()) != 0 {
+		t.Errorf("expected no critical edges after splitting")
+	}
+}
@@ -2,6 +2,7 @@ package lir
 
 import (
 	"fmt"
+	"math"
 	"vslc/src/ir/lir/types"
 )
 
@@ -11,15 +12,19 @@ import (
 
 // Constant defines an integer or floating point constant
 type Constant struct {
-	b    *Block         // b is the basic block element that owns this instruction.
-	id   int            // id is the unique identifier of this instruction in function body.
-	name string         // name defines the optional name of the local variable.
-	typ  types.DataType // typ defines the variable's data type.
-	val  interface{}    // val holds the constant's data value.
-	lseq int            // lseq holds the global data segment label sequence number of the Constant.
-	used int            // used gets incremented every time the constant is loaded from the data segment.
-	hw   interface{}    // Hardware register of the DataInstruction's virtual register.
-	en   bool           // Set to true if instruction is enabled.
+	b        *Block         // b is the basic block element that owns this instruction.
+	id       int            // id is the unique identifier of this instruction in function body.
+	name     string         // name defines the optional name of the local variable.
+	typ      types.DataType // typ defines the variable's data type.
+	val      interface{}    // val holds the constant's data value.
+	lseq     int            // lseq holds the global data segment label sequence number of the Constant.
+	used     int            // used gets incremented every time the constant is loaded from the data segment.
+	data     *Constant      // data is the Module's Constant that holds the data segment entry of identical constants.
+	hw       *LiveNode      // Hardware register of the DataInstruction's virtual register.
+	imm      bool           // Set to true if every user encodes the Constant as an immediate operand.
+	en       bool           // Set to true if instruction is enabled.
+	uses                    // uses holds the instructions that use the Constant.
+	location                // location holds the source location of the Constant.
 }
 
 // ---------------------
@@ -63,12 +68,12 @@ func (inst *Constant) String() string {
 }
 
 // SetHW panics for the Constant, because it's a memory value, not a virtual register.
-func (inst *Constant) SetHW(hw interface{}) {
+func (inst *Constant) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW returns <nil> for the Constant.
-func (inst *Constant) GetHW() interface{} {
+func (inst *Constant) GetHW() *LiveNode {
 	return inst.hw
 }
 
@@ -107,12 +112,31 @@ func (inst *Constant) GlobalSeq() int {
 	return inst.lseq
 }
 
-// Use increments the use counter of the Constant.
+// Use increments the use counter of the Constant's data entry.
 func (inst *Constant) Use() {
-	inst.used++
+	inst.data.used++
 }
 
-// Used returns true if the Constant has been loaded.
+// Used returns true if the Constant, or any identical Constant, has been loaded.
 func (inst *Constant) Used() bool {
-	return inst.used > 0
+	return inst.data.used > 0
+}
+
+// SetImmediate marks the Constant as encoded as an immediate operand by all of its users if b is true, such that it
+// doesn't occupy a virtual register.
+func (inst *Constant) SetImmediate(b bool) {
+	inst.imm = b
+}
+
+// Immediate returns true if the Constant is encoded as an immediate operand by all of its users.
+func (inst *Constant) Immediate() bool {
+	return inst.imm
+}
+
+// key returns the hash key identifying the value of the Constant.
+func (inst *Constant) key() constKey {
+	if inst.typ == types.Int {
+		return constKey{typ: inst.typ, bits: uint64(inst.val.(int))}
+	}
+	return constKey{typ: inst.typ, bits: math.Float64bits(inst.val.(float64))}
 }
@@ -15,9 +15,11 @@ type DataInstruction struct {
 	b        *Block                    // b is the basic block element that owns this instruction.
 	id       int                       // id is the unique identifier of this instruction in function body.
 	op       types.ArithmeticOperation // op defines the type of arithmetic operation of this instruction.
-	hw       interface{}               // Hardware register of the DataInstruction's virtual register.
+	hw       *LiveNode                 // Hardware register of the DataInstruction's virtual register.
 	op1, op2 Value                     // op1 and op2 holds the first and second operands respectively.
 	en       bool                      // Set to true if instruction is enabled.
+	uses                               // uses holds the instructions that use the DataInstruction.
+	location                           // location holds the source location of the DataInstruction.
 }
 
 // ---------------------
@@ -48,6 +50,8 @@ var expLut = [2][2][types.Not+1]bool {
 			true, // And
 			true, // Xor
 			true, // Or
+			true, // MulHigh
+			true, // ARShift
 			true, // Neg
 			true, // Not
 		},
@@ -64,6 +68,8 @@ var expLut = [2][2][types.Not+1]bool {
 			false, // And
 			false, // Xor
 			false, // Or
+			false, // MulHigh
+			false, // ARShift
 			false, // Neg
 			false, // Not
 		},
@@ -83,6 +89,8 @@ var expLut = [2][2][types.Not+1]bool {
 			false, // And
 			false, // Xor
 			false, // Or
+			false, // MulHigh
+			false, // ARShift
 			false, // Neg
 			false, // Not
 		},
@@ -99,6 +107,8 @@ var expLut = [2][2][types.Not+1]bool {
 			false, // And
 			false, // Xor
 			false, // Or
+			false, // MulHigh
+			false, // ARShift
 			false, // Neg
 			false, // Not
 		},
@@ -149,12 +159,12 @@ func (inst *DataInstruction) String() string {
 }
 
 // SetHW sets the DataInstruction's assigned hardware register during register allocation.
-func (inst *DataInstruction) SetHW(hw interface{}) {
+func (inst *DataInstruction) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW retrieves the DataInstruction's assigned hardware register.
-func (inst *DataInstruction) GetHW() interface{} {
+func (inst *DataInstruction) GetHW() *LiveNode {
 	return inst.hw
 }
 
@@ -11,13 +11,15 @@ import (
 
 // DeclareInstruction defines a local variable allocation in memory.
 type DeclareInstruction struct {
-	b    *Block         // b is the basic block element that owns this instruction.
-	id   int            // id is the unique identifier of this instruction in function body.
-	seq  int            // seq is the unique sequence number given to the variable.
-	name string         // name defines the optional name of the local variable.
-	typ  types.DataType // typ defines the variable's data type.
-	hw   interface{}
-	en   bool // Set to true if instruction is enabled.
+	b        *Block         // b is the basic block element that owns this instruction.
+	id       int            // id is the unique identifier of this instruction in function body.
+	seq      int            // seq is the unique sequence number given to the variable.
+	name     string         // name defines the optional name of the local variable.
+	typ      types.DataType // typ defines the variable's data type.
+	hw       *LiveNode
+	en       bool // Set to true if instruction is enabled.
+	uses          // uses holds the instructions that use the DeclareInstruction.
+	location      // location holds the source location of the DeclareInstruction.
 }
 
 // ---------------------
@@ -61,12 +63,12 @@ func (inst *DeclareInstruction) String() string {
 }
 
 // SetHW panics for the DeclareInstruction, because it's a memory value, not a virtual register.
-func (inst *DeclareInstruction) SetHW(hw interface{}) {
+func (inst *DeclareInstruction) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW returns <nil> for the DeclareInstruction.
-func (inst *DeclareInstruction) GetHW() interface{} {
+func (inst *DeclareInstruction) GetHW() *LiveNode {
 	return inst.hw
 }
 
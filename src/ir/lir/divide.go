@@ -0,0 +1,220 @@
+package lir
+
+import (
+	"math/bits"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// LowerDivision lowers integer division and remainder by constants in all Functions of Module m, using magic numbers
+// for the integer width opt.IntBits(). The parameter opt.Threads is the maximum number of threads allowed to run in
+// parallel.
+func LowerDivision(opt util.Options, m *Module) {
+	wordSize := divisionWordSize(opt)
+	if wordSize == 0 {
+		return
+	}
+	forEachFunction(opt, m, func(f *Function) {
+		f.LowerDivision(wordSize)
+	})
+}
+
+// divisionWordSize returns the word size that division by constants is lowered for, which is the integer width
+// opt.IntBits(), or 0 if it isn't lowered. The backends compute the upper word of products of 32-bit integers, and
+// any other integer operation, in 32 bits, even if the target's registers are wider.
+func divisionWordSize(opt util.Options) int {
+	if opt.TargetArch == util.Wasm {
+		// WebAssembly has no multiply high instruction, and its engines lower division by constants themselves.
+		return 0
+	}
+	return opt.IntBits()
+}
+
+// LowerDivision replaces integer division and remainder by constants with a shift sequence for powers of two, and a
+// multiply high and shift sequence using a magic number for the given word size in bits otherwise. The lowered
+// sequences round towards zero, like signed division.
+func (f *Function) LowerDivision(wordSize int) {
+	for _, e1 := range f.blocks {
+		for i1 := 0; i1 < len(e1.instructions); i1++ {
+			inst, ok := e1.instructions[i1].(*DataInstruction)
+			if !ok || inst.DataType() != types.Int || (inst.op != types.Div && inst.op != types.Rem) {
+				continue
+			}
+			c, ok := inst.op2.(*Constant)
+			if !ok {
+				continue
+			}
+			d := c.val.(int)
+			if d < 0 && -d < 0 {
+				// Minimum integer value is a power of two.
+				continue
+			}
+			ad := abs(d)
+			if ad < 2 {
+				continue
+			}
+			if ad&(ad-1) == 0 {
+				i1 += e1.lowerPowerDivision(i1, inst, d, wordSize)
+			} else {
+				i1 += e1.lowerDivision(i1, inst, d, wordSize)
+			}
+		}
+	}
+}
+
+// lowerPowerDivision inserts the shift sequence for the division of inst by the constant d, that is plus or minus a
+// power of two, before index idx of Block b, and transforms inst into the final instruction of the sequence. Negative
+// dividends are biased by |d|-1 before the arithmetic shift, to round towards zero. Returns the number of inserted
+// instructions.
+func (b *Block) lowerPowerDivision(idx int, inst *DataInstruction, d, wordSize int) int {
+	k := bits.TrailingZeros(uint(abs(d)))
+	n := inst.op1
+	seq := make([]Value, 0, 10)
+	emit := func(v Value) Value {
+		v.SetLocation(inst.loc)
+		seq = append(seq, v)
+		return v
+	}
+	data := func(op types.ArithmeticOperation, op1, op2 Value) Value {
+		return emit(&DataInstruction{b: b, id: b.f.getId(), op: op, op1: op1, op2: op2, en: true})
+	}
+
+	// t = n + (n < 0 ? |d|-1 : 0), where the bias is the sign bit shifted into the k lower bits.
+	t := n
+	if k > 1 {
+		t = data(types.ARShift, t, emit(b.newConstant(types.Int, k-1)))
+	}
+	t = data(types.RShift, t, emit(b.newConstant(types.Int, wordSize-k)))
+	t = data(types.Add, n, t)
+
+	unuse(inst)
+	if inst.op == types.Div {
+		// q = t >> k, negated for negative divisors.
+		if d > 0 {
+			inst.op, inst.op1, inst.op2 = types.ARShift, t, emit(b.newConstant(types.Int, k))
+		} else {
+			q := data(types.ARShift, t, emit(b.newConstant(types.Int, k)))
+			inst.op, inst.op1, inst.op2 = types.Neg, q, nil
+		}
+	} else {
+		// r = n - (t & -|d|), where the sign of the remainder follows the dividend.
+		inst.op, inst.op1, inst.op2 = types.Sub, n, data(types.And, t, emit(b.newConstant(types.Int, -abs(d))))
+	}
+	use(inst)
+
+	for i1, e1 := range seq {
+		b.insert(idx+i1, e1)
+		use(e1)
+	}
+	return len(seq)
+}
+
+// lowerDivision inserts the multiply high and shift sequence for the division of inst by the constant d before index
+// idx of Block b, and transforms inst into the final instruction of the sequence. Returns the number of inserted
+// instructions.
+func (b *Block) lowerDivision(idx int, inst *DataInstruction, d, wordSize int) int {
+	magic, shift := magicSigned(d, wordSize)
+	n := inst.op1
+	seq := make([]Value, 0, 10)
+	emit := func(v Value) Value {
+		v.SetLocation(inst.loc)
+		seq = append(seq, v)
+		return v
+	}
+	data := func(op types.ArithmeticOperation, op1, op2 Value) Value {
+		return emit(&DataInstruction{b: b, id: b.f.getId(), op: op, op1: op1, op2: op2, en: true})
+	}
+
+	// q = mulh(n, magic), corrected for magic numbers that overflow into the sign bit.
+	q := data(types.MulHigh, n, emit(b.newConstant(types.Int, magic)))
+	if d > 0 && magic < 0 {
+		q = data(types.Add, q, n)
+	} else if d < 0 && magic > 0 {
+		q = data(types.Sub, q, n)
+	}
+	if shift > 0 {
+		q = data(types.ARShift, q, emit(b.newConstant(types.Int, shift)))
+	}
+
+	// Add one to negative quotients to round towards zero.
+	t := data(types.RShift, q, emit(b.newConstant(types.Int, wordSize-1)))
+	unuse(inst)
+	if inst.op == types.Div {
+		inst.op, inst.op1, inst.op2 = types.Add, q, t
+	} else {
+		q = data(types.Add, q, t)
+		inst.op, inst.op1, inst.op2 = types.Sub, n, data(types.Mul, q, inst.op2)
+	}
+	use(inst)
+
+	for i1, e1 := range seq {
+		b.insert(idx+i1, e1)
+		use(e1)
+	}
+	return len(seq)
+}
+
+// magicSigned returns the magic number and shift amount for signed division by the constant d, where |d| >= 2, for
+// the given word size in bits. The magic number is sign extended from the word size. From Hacker's Delight, 2nd
+// edition, section 10-4.
+func magicSigned(d, wordSize int) (int, int) {
+	mask := ^uint64(0) >> (64 - uint(wordSize))
+	two := uint64(1) << (uint(wordSize) - 1) // two is 2^(wordSize-1).
+	ad := uint64(abs(d)) & mask
+	t := two
+	if d < 0 {
+		t++
+	}
+	anc := t - 1 - t%ad // Absolute value of nc.
+	p := wordSize - 1
+	q1, r1 := two/anc, two%anc // Quotient and remainder of 2^p/|nc|.
+	q2, r2 := two/ad, two%ad   // Quotient and remainder of 2^p/|d|.
+	for {
+		p++
+		q1, r1 = (q1<<1)&mask, (r1<<1)&mask
+		if r1 >= anc {
+			q1++
+			r1 -= anc
+		}
+		q2, r2 = (q2<<1)&mask, (r2<<1)&mask
+		if r2 >= ad {
+			q2++
+			r2 -= ad
+		}
+		if delta := ad - r2; q1 >= delta && (q1 != delta || r1 != 0) {
+			break
+		}
+	}
+
+	// Sign extend the magic number from the word size.
+	magic := (q2 + 1) & mask
+	if d < 0 {
+		magic = -magic & mask
+	}
+	sign := uint(64 - wordSize)
+	return int(int64(magic<<sign) >> sign), p - wordSize
+}
+
+// abs returns the absolute value of the integer i.
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
@@ -0,0 +1,200 @@
+package lir
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// DomTree defines the dominator tree of a Function's control flow graph. A Block a dominates Block b if every path
+// from the entry Block to b passes through a. Only Blocks reachable from the entry Block are part of the tree.
+type DomTree struct {
+	f        *Function           // f is the Function that the DomTree was calculated for.
+	idom     map[*Block]*Block   // idom maps every reachable Block to its immediate dominator.
+	children map[*Block][]*Block // children maps every Block to the Blocks it immediately dominates, in Block order.
+	df       map[*Block][]*Block // df maps every Block to its dominance frontier.
+	pre      map[*Block]int      // pre holds the pre order number of every Block in the dominator tree.
+	post     map[*Block]int      // post holds the post order number of every Block in the dominator tree.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Dominators calculates the dominator tree and dominance frontiers of Function f using the iterative algorithm of
+// Cooper, Harvey and Kennedy. The DomTree is a snapshot; it must be re-calculated if the control flow graph changes.
+func (f *Function) Dominators() *DomTree {
+	dt := &DomTree{
+		f:        f,
+		idom:     make(map[*Block]*Block, len(f.blocks)),
+		children: make(map[*Block][]*Block, len(f.blocks)),
+		df:       make(map[*Block][]*Block, len(f.blocks)),
+		pre:      make(map[*Block]int, len(f.blocks)),
+		post:     make(map[*Block]int, len(f.blocks)),
+	}
+	if len(f.blocks) < 1 {
+		return dt
+	}
+	po := f.PostOrder()
+	idx := make(map[*Block]int, len(po))
+	for i1, e1 := range po {
+		idx[e1] = i1
+	}
+	entry := f.blocks[0]
+	idom := dt.idom
+	idom[entry] = entry
+
+	intersect := func(b1, b2 *Block) *Block {
+		for b1 != b2 {
+			for idx[b1] < idx[b2] {
+				b1 = idom[b1]
+			}
+			for idx[b2] < idx[b1] {
+				b2 = idom[b2]
+			}
+		}
+		return b1
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		// Reverse post order, skipping the entry Block.
+		for i1 := len(po) - 2; i1 >= 0; i1-- {
+			b := po[i1]
+			var nidom *Block
+			for _, e1 := range b.preds {
+				if _, ok := idom[e1]; !ok {
+					continue
+				}
+				if nidom == nil {
+					nidom = e1
+				} else {
+					nidom = intersect(e1, nidom)
+				}
+			}
+			if idom[b] != nidom {
+				idom[b] = nidom
+				changed = true
+			}
+		}
+	}
+
+	// Dominator tree children, in Block order.
+	for _, e1 := range f.blocks[1:] {
+		if d, ok := idom[e1]; ok {
+			dt.children[d] = append(dt.children[d], e1)
+		}
+	}
+
+	// Number the dominator tree for constant time dominance queries.
+	n := 0
+	var number func(b *Block)
+	number = func(b *Block) {
+		dt.pre[b] = n
+		n++
+		for _, e1 := range dt.children[b] {
+			number(e1)
+		}
+		dt.post[b] = n
+		n++
+	}
+	number(entry)
+
+	// Dominance frontiers. Only join points may be part of a frontier.
+	for _, b := range f.blocks {
+		if _, ok := idom[b]; !ok || len(b.preds) < 2 {
+			continue
+		}
+		for _, e1 := range b.preds {
+			if _, ok := idom[e1]; !ok {
+				// Unreachable predecessor.
+				continue
+			}
+			for r := e1; r != idom[b]; r = idom[r] {
+				dup := false
+				for _, e2 := range dt.df[r] {
+					if e2 == b {
+						dup = true
+						break
+					}
+				}
+				if !dup {
+					dt.df[r] = append(dt.df[r], b)
+				}
+			}
+		}
+	}
+	return dt
+}
+
+// Idom returns the immediate dominator of Block b. The entry Block and unreachable Blocks have no immediate
+// dominator, for which <nil> is returned.
+func (dt *DomTree) Idom(b *Block) *Block {
+	if d := dt.idom[b]; d != b {
+		return d
+	}
+	return nil
+}
+
+// Children returns the Blocks that are immediately dominated by Block b.
+func (dt *DomTree) Children(b *Block) []*Block {
+	return dt.children[b]
+}
+
+// Frontier returns the dominance frontier of Block b: the Blocks where b's dominance ends.
+func (dt *DomTree) Frontier(b *Block) []*Block {
+	return dt.df[b]
+}
+
+// Dominates returns true if Block a dominates Block b. Every reachable Block dominates itself.
+func (dt *DomTree) Dominates(a, b *Block) bool {
+	pa, ok1 := dt.pre[a]
+	pb, ok2 := dt.pre[b]
+	if !ok1 || !ok2 {
+		return false
+	}
+	return pa <= pb && dt.post[b] <= dt.post[a]
+}
+
+// StrictlyDominates returns true if Block a dominates Block b and a is not b.
+func (dt *DomTree) StrictlyDominates(a, b *Block) bool {
+	return a != b && dt.Dominates(a, b)
+}
+
+// PostOrder returns the Blocks of Function f that are reachable from the entry Block, in post order.
+func (f *Function) PostOrder() []*Block {
+	res := make([]*Block, 0, len(f.blocks))
+	if len(f.blocks) < 1 {
+		return res
+	}
+	visited := make(map[*Block]bool, len(f.blocks))
+	var visit func(b *Block)
+	visit = func(b *Block) {
+		visited[b] = true
+		for _, e1 := range b.succs {
+			if !visited[e1] {
+				visit(e1)
+			}
+		}
+		res = append(res, b)
+	}
+	visit(f.blocks[0])
+	return res
+}
+
+// ReversePostOrder returns the Blocks of Function f that are reachable from the entry Block, in reverse post order.
+// In reverse post order every Block precedes its successors, except along back edges.
+func (f *Function) ReversePostOrder() []*Block {
+	res := f.PostOrder()
+	for i1, i2 := 0, len(res)-1; i1 < i2; i1, i2 = i1+1, i2-1 {
+		res[i1], res[i2] = res[i2], res[i1]
+	}
+	return res
+}
@@ -0,0 +1,32 @@
+package lir
+
+import "testing"
+
+// TestDominators verifies the dominator tree and dominance frontiers of a Function with a single loop.
+func TestDominators(t *testing.T) {
+	f := createCounterLoop()
+	b := f.Blocks()
+	entry, head, body, exit := b[0], b[1], b[2], b[3]
+	dt := f.Dominators()
+
+	if d := dt.Idom(entry); d != nil {
+		t.Errorf("expected entry block to have no immediate dominator, got %s", d.Name())
+	}
+	for _, e1 := range []struct{ b, idom *Block }{{head, entry}, {body, head}, {exit, head}} {
+		if d := dt.Idom(e1.b); d != e1.idom {
+			t.Errorf("%s: expected immediate dominator %s", e1.b.Name(), e1.idom.Name())
+		}
+	}
+	if !dt.Dominates(entry, exit) || !dt.Dominates(head, head) || dt.Dominates(body, exit) {
+		t.Error("unexpected dominance relation")
+	}
+	if dt.StrictlyDominates(head, head) {
+		t.Error("a block must not strictly dominate itself")
+	}
+	if !equalBlocks(dt.Frontier(body), []*Block{head}) || !equalBlocks(dt.Frontier(head), []*Block{head}) {
+		t.Error("unexpected dominance frontier of loop blocks")
+	}
+	if len(dt.Frontier(entry)) != 0 || len(dt.Frontier(exit)) != 0 {
+		t.Error("expected empty dominance frontier of entry and exit blocks")
+	}
+}
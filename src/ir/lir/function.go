@@ -22,28 +22,36 @@ type Function struct {
 	seq       int                   // seq defines the locally unique sequence identifier for all children of Function.
 	vseq      int                   // vseq defines the unique sequence number for local variables of the Function.
 	en        bool                  // Set to true if instruction is enabled.
+	loc       Location              // loc is the source Location given to instructions created by the builders.
+	decl      Location              // decl is the source Location of the name of the function in its definition.
+	arena     arena                 // arena allocates the most common instructions created by the builders.
+	symbols   []Symbol              // symbols are the identifiers of the function body, resolved when LIR is generated.
 }
 
 // Param defines an LIR Function parameter.
 type Param struct {
-	f       *Function      // f is the Function that owns this parameter.
-	id      int            // id is the unique function local id of the parameter.
-	name    string         // name is the string identifier name given to this parameter.
-	typ     types.DataType // typ is the data type of the parameter.
-	styp    types.DataType // styp defines the subtype data type of arrays.
-	operand Value          // Used for **argv.
-	hw      interface{}    // hw defines the instruction's hardware allocated register. Usually set to argument register 0-7.
-	en      bool           // Set to true if instruction is enabled.
+	f        *Function      // f is the Function that owns this parameter.
+	id       int            // id is the unique function local id of the parameter.
+	name     string         // name is the string identifier name given to this parameter.
+	typ      types.DataType // typ is the data type of the parameter.
+	styp     types.DataType // styp defines the subtype data type of arrays.
+	operand  Value          // Used for **argv.
+	hw       *LiveNode      // hw defines the instruction's hardware allocated register. Usually set to argument register 0-7.
+	en       bool           // Set to true if instruction is enabled.
+	uses                    // uses holds the instructions that use the Param.
+	location                // location holds the source location of the Param.
 }
 
 // FunctionCallInstruction defines an LIR function call.
 type FunctionCallInstruction struct {
-	b         *Block      // b is the basic block element that owns this instruction.
-	id        int         // id is the unique identifier of this instruction in function body.
-	target    *Function   // target points to the target Function to call.
-	arguments []Value     // arguments provides the arguments to pass to the Function during the call.
-	hw        interface{} // hw defines the instruction's hardware allocated register. Usually set to argument register 0.
-	en        bool        // Set to true if instruction is enabled.
+	b         *Block    // b is the basic block element that owns this instruction.
+	id        int       // id is the unique identifier of this instruction in function body.
+	target    *Function // target points to the target Function to call.
+	arguments []Value   // arguments provides the arguments to pass to the Function during the call.
+	hw        *LiveNode // hw defines the instruction's hardware allocated register. Usually set to argument register 0.
+	en        bool      // Set to true if instruction is enabled.
+	uses                // uses holds the instructions that use the FunctionCallInstruction.
+	location            // location holds the source location of the FunctionCallInstruction.
 }
 
 // ---------------------
@@ -168,6 +176,12 @@ func (f *Function) CreateBlock() *Block {
 	return b
 }
 
+// SetLocation sets the source Location given to the instructions that are subsequently created by the builders of
+// the Function's Blocks.
+func (f *Function) SetLocation(l Location) {
+	f.loc = l
+}
+
 // CreateGlobalString creates and returns a global string.
 func (f *Function) CreateGlobalString(s string) *String {
 	return f.m.CreateGlobalString(s)
@@ -218,12 +232,12 @@ func (inst *Param) String() string {
 }
 
 // SetHW panics for the Param, because it's a memory value, not a virtual register.
-func (inst *Param) SetHW(hw interface{}) {
+func (inst *Param) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW returns <nil> for the Param.
-func (inst *Param) GetHW() interface{} {
+func (inst *Param) GetHW() *LiveNode {
 	return inst.hw
 }
 
@@ -290,12 +304,12 @@ func (inst *FunctionCallInstruction) String() string {
 }
 
 // SetHW panics for the FunctionCallInstruction, because it's a memory value, not a virtual register.
-func (inst *FunctionCallInstruction) SetHW(hw interface{}) {
+func (inst *FunctionCallInstruction) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW returns <nil> for the FunctionCallInstruction.
-func (inst *FunctionCallInstruction) GetHW() interface{} {
+func (inst *FunctionCallInstruction) GetHW() *LiveNode {
 	return inst.hw
 }
 
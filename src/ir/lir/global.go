@@ -11,12 +11,15 @@ import (
 
 // Global defines an LIR global variable.
 type Global struct {
-	m    *Module        // m is the Module that owns this Global.
-	id   int            // id is the unique identifier of the global variable.
-	name string         // name defines the unique string name of the global variable.
-	typ  types.DataType // typ defines the data type of the global variable.
-	hw   interface{}
-	en   bool // Set to true if instruction is enabled.
+	m        *Module        // m is the Module that owns this Global.
+	id       int            // id is the unique identifier of the global variable.
+	name     string         // name defines the unique string name of the global variable.
+	typ      types.DataType // typ defines the data type of the global variable.
+	hw       *LiveNode
+	en       bool // Set to true if instruction is enabled.
+	ext      bool // Set to true if the Global is defined by another LIR object.
+	uses          // uses holds the instructions that use the Global.
+	location      // location holds the source location of the Global.
 }
 
 // ---------------------
@@ -57,12 +60,12 @@ func (inst *Global) String() string {
 }
 
 // SetHW panics for the Global, because it's a memory value, not a virtual register.
-func (inst *Global) SetHW(hw interface{}) {
+func (inst *Global) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW returns <nil> for the Global.
-func (inst *Global) GetHW() interface{} {
+func (inst *Global) GetHW() *LiveNode {
 	return inst.hw
 }
 
@@ -90,3 +93,22 @@ func (inst *Global) Disable() {
 func (inst *Global) IsEnabled() bool {
 	return inst.en
 }
+
+// External returns true if the Global is declared by a LIR object, but defined by another object it's linked with.
+func (inst *Global) External() bool {
+	return inst.ext
+}
+
+// addUser records a use of the Global by instruction v. Functions may be built in parallel, so the Module is locked.
+func (inst *Global) addUser(v Value) {
+	inst.m.Lock()
+	defer inst.m.Unlock()
+	inst.uses.addUser(v)
+}
+
+// removeUser removes a single use of the Global by instruction v.
+func (inst *Global) removeUser(v Value) {
+	inst.m.Lock()
+	defer inst.m.Unlock()
+	inst.uses.removeUser(v)
+}
@@ -0,0 +1,186 @@
+package lir
+
+import (
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// maxSpeculated defines the maximum number of instructions of each arm of a conditional that may be executed
+// unconditionally when the conditional is replaced by a SelectInstruction.
+const maxSpeculated = 4
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// IfConvert replaces simple IF-THEN-ELSE constructs with SelectInstructions in all Functions of Module m. The
+// parameter opt.Threads is the maximum number of threads allowed to run in parallel.
+func IfConvert(opt util.Options, m *Module) {
+	forEachFunction(opt, m, (*Function).IfConvert)
+}
+
+// IfConvert replaces the IF-THEN-ELSE constructs of Function f, whose arms only compute a single value each, with
+// SelectInstructions in the Block of the conditional branch. Each arm may either store its value to the same
+// variable, or pass its values to the phi instructions of the Block both arms jump to. The instructions of the arms
+// must be free of side effects, because they are executed unconditionally. Nested constructs are converted from the
+// inside out.
+func (f *Function) IfConvert() {
+	for changed := true; changed; {
+		changed = false
+		for i1 := 0; i1 < len(f.blocks); i1++ {
+			if f.ifConvert(f.blocks[i1]) {
+				changed = true
+			}
+		}
+		if changed {
+			// Merge the Block of the branch with the join Block, such that enclosing constructs can be converted.
+			f.SimplifyCFG()
+		}
+	}
+}
+
+// ifConvert replaces the conditional branch terminating Block h with SelectInstructions, if its then and else Blocks
+// only compute the selected values and join in the same Block. Returns true if Block h was converted.
+func (f *Function) ifConvert(h *Block) bool {
+	br, ok := h.term.(*BranchInstruction)
+	if !ok || br.els == nil {
+		return false
+	}
+	thn, els := br.thn, br.els
+	if thn == els || thn == f.blocks[0] || els == f.blocks[0] || len(thn.preds) != 1 || len(els.preds) != 1 {
+		return false
+	}
+	join, tst, ok := arm(thn)
+	if !ok {
+		return false
+	}
+	join2, fst, ok := arm(els)
+	if !ok || join != join2 || join == h || join == thn || join == els {
+		return false
+	}
+
+	// Both arms must store to the same variable, or not store at all.
+	phis := join.phis()
+	if (tst == nil) != (fst == nil) || (tst == nil && len(phis) < 1) {
+		return false
+	}
+	if tst != nil && (tst.dst != fst.dst || tst.src.DataType() != fst.src.DataType()) {
+		return false
+	}
+	for _, e1 := range phis {
+		if e1.IncomingFrom(thn).DataType() != e1.IncomingFrom(els).DataType() {
+			return false
+		}
+	}
+
+	// Hoist the instructions of the arms above the branch.
+	for _, e1 := range []*Block{thn, els} {
+		for _, e2 := range e1.instructions[:len(e1.instructions)-1] {
+			if e2.Type() == types.StoreInstruction {
+				continue
+			}
+			h.insertBeforeTerminator(e2)
+			setBlock(e2, h)
+		}
+	}
+
+	// Select the stored value and the values of the phi instructions.
+	op, op1, op2 := br.op, br.op1, br.op2
+	h.SetInsertPoint(br)
+	if tst != nil {
+		sel := h.CreateSelect(op, op1, op2, tst.src, fst.src)
+		sel.SetLocation(br.Location())
+		h.InsertBefore(br, tst)
+		replaceOperands(tst, map[Value]Value{tst.src: sel})
+		unuse(fst)
+	}
+	sels := make([]*SelectInstruction, len(phis))
+	for i1, e1 := range phis {
+		sels[i1] = h.CreateSelect(op, op1, op2, e1.IncomingFrom(thn), e1.IncomingFrom(els))
+		sels[i1].SetLocation(br.Location())
+	}
+	h.SetInsertPoint(nil)
+
+	// Jump unconditionally to the join Block.
+	unuse(br)
+	h.unlink()
+	thn.unlink()
+	els.unlink()
+	br.thn = join
+	br.els = nil
+	br.op1 = nil
+	br.op2 = nil
+	h.link(join)
+	for i1, e1 := range phis {
+		e1.AddIncoming(sels[i1], h)
+	}
+
+	// Remove the arms from the Function.
+	for _, e1 := range f.variables {
+		if e1.b == thn || e1.b == els {
+			e1.b = h
+		}
+	}
+	res := f.blocks[:0]
+	for _, e1 := range f.blocks {
+		if e1 != thn && e1 != els {
+			res = append(res, e1)
+		}
+	}
+	f.blocks = res
+	return true
+}
+
+// arm returns the Block that Block b jumps to unconditionally and the store of the value computed by Block b, if
+// Block b ends with a store. Returns false if Block b has too many instructions, or instructions with side effects
+// other than the final store.
+func arm(b *Block) (*Block, *StoreInstruction, bool) {
+	br, ok := b.term.(*BranchInstruction)
+	if !ok || br.els != nil {
+		return nil, nil, false
+	}
+	insts := b.instructions[:len(b.instructions)-1]
+	var st *StoreInstruction
+	if n := len(insts); n > 0 {
+		if st, ok = insts[n-1].(*StoreInstruction); ok {
+			insts = insts[:n-1]
+		}
+	}
+	if len(insts) > maxSpeculated {
+		return nil, nil, false
+	}
+	for _, e1 := range insts {
+		if !speculatable(e1) {
+			return nil, nil, false
+		}
+	}
+	return br.thn, st, true
+}
+
+// speculatable returns true if instruction v may be executed even if control flow wouldn't reach it, because it has
+// no side effects and is cheap to execute.
+func speculatable(v Value) bool {
+	if v.DataType() > types.Float {
+		return false
+	}
+	switch inst := v.(type) {
+	case *Constant, *LoadInstruction, *CastInstruction:
+		return true
+	case *DataInstruction:
+		// Division is expensive and traps on some targets if the divisor is zero.
+		return inst.op != types.Div && inst.op != types.Rem
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package lir
+
+import (
+	"testing"
+	"vslc/src/ir/lir/types"
+)
+
+// createDiamond returns a Function that assigns a parameter to a local variable in the then arm of an
+// IF-THEN-ELSE construct, a constant in the else arm, and returns the variable.
+func createDiamond() *Function {
+	m := CreateModule("test")
+	f := m.CreateFunction("diamond", types.Int)
+	a := f.CreateParam("a", types.Int)
+	entry := f.CreateBlock()
+	thn := f.CreateBlock()
+	els := f.CreateBlock()
+	join := f.CreateBlock()
+
+	x := entry.CreateDeclare("x", types.Int)
+	entry.CreateConditionalBranch(types.LessThan, entry.CreateLoad(a), entry.CreateConstantInt(10), thn, els)
+	thn.CreateStore(thn.CreateLoad(a), x)
+	thn.CreateBranch(join)
+	els.CreateStore(els.CreateConstantInt(3), x)
+	els.CreateBranch(join)
+	join.CreateReturn(join.CreateLoad(x))
+	return f
+}
+
+// TestIfConvert verifies that a conditional assignment is replaced by a select, both when the variable is stored to
+// in each arm and when it's selected by a phi instruction, and that arms with side effects are left alone.
+func TestIfConvert(t *testing.T) {
+	for _, ssa := range []bool{false, true} {
+		f := createDiamond()
+		if ssa {
+			f.Mem2Reg()
+		}
+		f.IfConvert()
+		if len(f.Blocks()) != 1 {
+			t.Fatalf("ssa %t: expected 1 block after if-conversion, got %d:\n%s", ssa, len(f.Blocks()), f.String())
+		}
+		selects := 0
+		for _, e1 := range f.Blocks()[0].Instructions() {
+			switch e1.Type() {
+			case types.SelectInstruction:
+				selects++
+				if len(e1.Users()) != 1 {
+					t.Errorf("ssa %t: expected 1 user of %s, got %d", ssa, e1.Name(), len(e1.Users()))
+				}
+			case types.PhiInstruction, types.BranchInstruction:
+				t.Errorf("ssa %t: unexpected instruction %s", ssa, e1.String())
+			}
+		}
+		if selects != 1 {
+			t.Errorf("ssa %t: expected 1 select, got %d:\n%s", ssa, selects, f.String())
+		}
+	}
+
+	// Function calls must not be executed unconditionally.
+	f := createDiamond()
+	els := f.Blocks()[2]
+	els.SetInsertPoint(els.Instructions()[0])
+	els.CreateFunctionCall(f, []Value{els.CreateConstantInt(1)})
+	els.SetInsertPoint(nil)
+	f.IfConvert()
+	if len(f.Blocks()) != 4 {
+		t.Errorf("expected arm with function call to be kept:\n%s", f.String())
+	}
+}
@@ -0,0 +1,238 @@
+package lir
+
+import (
+	"fmt"
+	"path/filepath"
+	tree "vslc/src/ir"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// GenObject generates a LIR object of the syntax tree root, which may call the functions and use the global variables
+// declared by the syntax trees decls of other source files and defined by the LIR objects objs. Those functions are
+// declared without a body, and those global variables are declared as external, such that they're resolved by Link.
+func GenObject(opt util.Options, root *tree.Node, decls []*tree.Node, objs []*Module) (*Module, error) {
+	m := CreateModule(filepath.Base(opt.Src))
+	for _, e1 := range decls {
+		for _, e2 := range e1.Children {
+			if e2.Typ == tree.DECLARATION {
+				if err := genDeclarationGlobal(e2, m); err != nil {
+					return nil, err
+				}
+			} else if _, err := genFunctionHeader(e2, m); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, e1 := range objs {
+		for _, e2 := range e1.functions {
+			if len(e2.blocks) < 1 {
+				continue
+			}
+			if m.fmap[e2.name] != nil || m.gmap[e2.name] != nil {
+				return nil, linkError(e1, "duplicate declaration of function %q", e2.name)
+			}
+			params := make([]types.DataType, len(e2.params))
+			for i1, e3 := range e2.params {
+				params[i1] = e3.typ
+			}
+			m.declare(e2.name, e2.typ, params...)
+		}
+		for _, e2 := range e1.globals {
+			if e2.ext {
+				continue
+			}
+			if m.fmap[e2.name] != nil || m.gmap[e2.name] != nil {
+				return nil, linkError(e1, "duplicate declaration of global variable %q", e2.name)
+			}
+			if e2.typ == types.Int {
+				m.CreateGlobalInt(e2.name)
+			} else {
+				m.CreateGlobalFloat(e2.name)
+			}
+		}
+	}
+	for _, e1 := range m.globals {
+		e1.ext = true
+	}
+	m.symbols = nil // The declarations of other source files aren't identifiers of this one.
+	return genModule(opt, m, root)
+}
+
+// Link links the LIR objects objs into a new Module called name, which holds their function definitions and global
+// variables in the order of the objects. Functions without a body and external global variables are resolved to
+// their definition in any of the objects, and identical constants and strings are merged. The objects can't be used
+// after they're linked. An error is returned if a symbol is defined more than once, if a declared symbol isn't
+// defined, if its declaration doesn't match its definition, or if the objects have integers of different widths.
+func Link(name string, objs []*Module) (*Module, error) {
+	m := CreateModule(name)
+	if len(objs) > 0 {
+		m.intBits = objs[0].intBits
+	}
+	for _, e1 := range objs {
+		if e1.intBits != m.intBits {
+			return nil, linkError(e1, "LIR object has %d-bit integers, expected %d-bit integers", e1.intBits, m.intBits)
+		}
+	}
+
+	// Move definitions to the linked Module.
+	for _, e1 := range objs {
+		for _, e2 := range e1.globals {
+			if e2.ext {
+				continue
+			}
+			if m.fmap[e2.name] != nil || m.gmap[e2.name] != nil {
+				return nil, linkError(e1, "duplicate definition of global variable %q", e2.name)
+			}
+			e2.m = m
+			e2.id = m.seq
+			m.seq++
+			m.globals = append(m.globals, e2)
+			m.gmap[e2.name] = e2
+		}
+		for _, e2 := range e1.functions {
+			if len(e2.blocks) < 1 {
+				continue
+			}
+			if m.fmap[e2.name] != nil || m.gmap[e2.name] != nil {
+				return nil, linkError(e1, "duplicate definition of function %q", e2.name)
+			}
+			e2.m = m
+			e2.id = m.seq
+			m.seq++
+			m.functions = append(m.functions, e2)
+			m.fmap[e2.name] = e2
+		}
+	}
+
+	// Resolve declarations to definitions.
+	funcs := make(map[*Function]*Function)
+	for _, e1 := range objs {
+		for _, e2 := range e1.globals {
+			if !e2.ext {
+				continue
+			}
+			g := m.gmap[e2.name]
+			if g == nil {
+				return nil, linkError(e1, "undefined global variable %q", e2.name)
+			}
+			if g.typ != e2.typ {
+				return nil, linkError(e1, "global variable %q declared as %s, but defined as %s",
+					e2.name, e2.typ, g.typ)
+			}
+			ReplaceAllUsesWith(e2, g)
+		}
+		for _, e2 := range e1.functions {
+			if len(e2.blocks) > 0 {
+				continue
+			}
+			f := m.fmap[e2.name]
+			if f == nil && reserved(e2.name) {
+				// Functions of the C library, such as printf, are declared once by the linked Module.
+				e2.m = m
+				e2.id = m.seq
+				m.seq++
+				m.functions = append(m.functions, e2)
+				m.fmap[e2.name] = e2
+				continue
+			}
+			if f == nil {
+				return nil, linkError(e1, "undefined function %q", e2.name)
+			}
+			if sig, def := signature(e2), signature(f); sig != def {
+				return nil, linkError(e1, "function %q declared as %s, but defined as %s", e2.name, sig, def)
+			}
+			funcs[e2] = f
+		}
+	}
+
+	// Merge constants and strings, call the definitions of declared functions, and give Blocks labels that are unique
+	// in the linked Module.
+	for _, e1 := range objs {
+		data := make(map[*Constant]*Constant, len(e1.constants))
+		for _, e2 := range e1.constants {
+			if d, ok := m.cmap[e2.key()]; ok {
+				d.used += e2.used
+				data[e2] = d
+				continue
+			}
+			e2.lseq = m.seq
+			m.seq++
+			m.cmap[e2.key()] = e2
+			m.constants = append(m.constants, e2)
+			data[e2] = e2
+		}
+		for _, e2 := range e1.strings {
+			if s, ok := m.smap[e2.val]; ok {
+				ReplaceAllUsesWith(e2, s)
+				continue
+			}
+			e2.m = m
+			e2.id = m.seq
+			m.seq++
+			m.strings = append(m.strings, e2)
+			m.smap[e2.val] = e2
+		}
+		for _, e2 := range e1.functions {
+			for _, e3 := range e2.blocks {
+				e3.id = m.seq
+				m.seq++
+				for _, e4 := range e3.instructions {
+					switch inst := e4.(type) {
+					case *Constant:
+						inst.data = data[inst.data]
+						inst.lseq = inst.data.lseq
+					case *FunctionCallInstruction:
+						if f, ok := funcs[inst.target]; ok {
+							inst.target = f
+						}
+					}
+				}
+			}
+		}
+	}
+	return m, nil
+}
+
+// reserved returns true if name is a reserved function name, which VSL functions can't be given.
+func reserved(name string) bool {
+	for _, e1 := range reservedNames {
+		if e1 == name {
+			return true
+		}
+	}
+	return false
+}
+
+// signature returns the return and parameter data types of Function f, such as int(int, float).
+func signature(f *Function) string {
+	res := f.typ.String() + "("
+	for i1, e1 := range f.params {
+		if i1 > 0 {
+			res += ", "
+		}
+		res += e1.typ.String()
+	}
+	return res + ")"
+}
+
+// linkError returns a util.TypeError in the source file of LIR object m, whose message is formatted like fmt.Errorf.
+func linkError(m *Module, format string, args ...interface{}) error {
+	return &util.TypeError{Position: util.Position{File: m.name}, Err: fmt.Errorf(format, args...)}
+}
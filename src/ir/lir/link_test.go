@@ -0,0 +1,106 @@
+package lir
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"vslc/src/frontend"
+	tree "vslc/src/ir"
+	"vslc/src/util"
+)
+
+// genObjects generates the LIR objects of the VSL source files srcs named names, and restores them from their
+// serialized form.
+func genObjects(t *testing.T, names, srcs []string) []*Module {
+	roots, err := frontend.ParseTrees(util.Options{Threads: 1}, names, srcs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e1 := range roots {
+		if err := tree.Optimise(util.Options{Threads: 1}, e1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	res := make([]*Module, len(roots))
+	for i1, e1 := range roots {
+		decls := append(append([]*tree.Node{}, roots[:i1]...), roots[i1+1:]...)
+		m, err := GenObject(util.Options{Src: names[i1], Threads: 1}, e1, decls, nil)
+		if err != nil {
+			t.Fatalf("%s: %s", names[i1], err)
+		}
+		buf := bytes.Buffer{}
+		if err := m.Encode(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if res[i1], err = Decode(&buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return res
+}
+
+// TestLink verifies that calls and global variables of linked LIR objects are resolved to their definitions, and that
+// identical strings and constants are merged.
+func TestLink(t *testing.T) {
+	a := "def f() int\nbegin\n\tprint \"f\", g(2)\n\treturn x + 7\nend\n"
+	b := "var x int\ndef g(a int) int\nbegin\n\tprint \"f\", a\n\tx := 7\n\treturn a\nend\n"
+	m, err := Link("a.lo", genObjects(t, []string{"a.vsl", "b.vsl"}, []string{a, b}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, e1 := range m.Functions() {
+		names = append(names, e1.Name())
+		for _, e2 := range e1.Blocks() {
+			for _, e3 := range e2.Instructions() {
+				switch inst := e3.(type) {
+				case *FunctionCallInstruction:
+					if m.GetFunction(inst.Target().Name()) != inst.Target() {
+						t.Errorf("%s: call to %s isn't resolved", e1.Name(), inst.Target().Name())
+					}
+				case *LoadInstruction:
+					if g, ok := inst.src.(*Global); ok && m.GetGlobalVariable(g.Name()) != g {
+						t.Errorf("%s: load of %s isn't resolved", e1.Name(), g.Name())
+					}
+				}
+			}
+		}
+	}
+	if res := strings.Join(names, ","); res != "f,g,printf" {
+		t.Errorf("expected functions %q, got %q", "f,g,printf", res)
+	}
+	if len(m.Globals()) != 1 || m.Globals()[0].External() {
+		t.Errorf("expected global variable x, got %v", m.Globals())
+	}
+	if len(m.Strings()) != 2 {
+		t.Errorf("expected 2 strings, got %d", len(m.Strings()))
+	}
+	if len(m.Constants()) != 2 {
+		t.Errorf("expected 2 constants, got %d", len(m.Constants()))
+	}
+}
+
+// TestLinkErrors verifies that symbols that are defined more than once, or not at all, and objects with integers of
+// different widths are rejected.
+func TestLinkErrors(t *testing.T) {
+	a := "def f() int\nbegin\n\treturn g()\nend\n"
+	b := "def g() int\nbegin\n\treturn 1\nend\n"
+	objs := genObjects(t, []string{"a.vsl", "b.vsl"}, []string{a, b})
+	_, err := Link("a.lo", objs[:1])
+	if err == nil || err.Error() != "a.vsl: undefined function \"g\"" || !errors.Is(err, util.ErrType) {
+		t.Errorf("expected undefined function error, got %v", err)
+	}
+	objs = genObjects(t, []string{"a.vsl", "b.vsl"}, []string{a, b})
+	objs = append(objs, genObjects(t, []string{"b.vsl"}, []string{b})...)
+	if _, err := Link("a.lo", objs); err == nil || err.Error() != "b.vsl: duplicate definition of function \"g\"" {
+		t.Errorf("expected duplicate definition error, got %v", err)
+	}
+	objs = genObjects(t, []string{"a.vsl", "b.vsl"}, []string{a, b})
+	objs[1].intBits = 32
+	if _, err := Link("a.lo", objs); err == nil ||
+		err.Error() != "b.vsl: LIR object has 32-bit integers, expected 64-bit integers" {
+		t.Errorf("expected integer width error, got %v", err)
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
-	"vslc/src/ir/lir/types"
+	"vslc/src/backend/regfile"
 	"vslc/src/util"
 )
 
@@ -14,11 +14,12 @@ import (
 
 // LiveNode wraps an ir.Value instruction and its dependencies.
 type LiveNode struct {
-	Val     Value       // Val is the ir.Value instruction that is wrapped by the LiveNode.
-	Dep     []*LiveNode // Dep is the dependencies of the wrapped ir.Value node Val.
-	Enabled bool        // Set to true if the LiveNode is present in the graph. Set to false if it should be disabled.
-	Spill   bool        // Set to true if the hardware register has to be spilled.
-	Reg     interface{} // Hardware register assigned to Value Val.
+	Val     Value            // Val is the ir.Value instruction that is wrapped by the LiveNode.
+	Dep     []*LiveNode      // Dep is the dependencies of the wrapped ir.Value node Val.
+	Enabled bool             // Set to true if the LiveNode is present in the graph. Set to false if it should be disabled.
+	Spill   bool             // Set to true if the hardware register has to be spilled.
+	Slot    int              // Stack slot of the function's spill area that holds Value Val, if Spill is true.
+	Reg     regfile.Register // Hardware register assigned to Value Val, or nil if it's not assigned.
 }
 
 // ---------------------
@@ -36,17 +37,7 @@ type LiveNode struct {
 // CalcLiveness calculates the virtual register liveness of Module m.
 // The parameter p is the maximum number of threads allowed to run in parallel.
 func CalcLiveness(opt util.Options, m *Module) [][]*LiveNode {
-	// Initiate global wrappers used for load and store instructions.
-	for _, e1 := range m.globals {
-		e1.SetHW(&LiveNode{
-			Val: e1,
-		})
-	}
-	for _, e1 := range m.strings {
-		e1.SetHW(&LiveNode{
-			Val: e1,
-		})
-	}
+	CalcLivenessGlobals(m)
 
 	// Calculate liveness per function.
 	rigs := make([][]*LiveNode, len(m.Functions()))
@@ -77,7 +68,7 @@ func CalcLiveness(opt util.Options, m *Module) [][]*LiveNode {
 				defer wg.Done()
 				i2 := start
 				for _, e2 := range m.Functions()[start:end] {
-					rigs[i2] = calcLivenessFunction(e2)
+					rigs[i2] = CalcLivenessFunction(e2)
 					i2++
 				}
 			}(start, end, &wg)
@@ -91,13 +82,28 @@ func CalcLiveness(opt util.Options, m *Module) [][]*LiveNode {
 	} else {
 		// Sequential.
 		for i1, e1 := range m.Functions() {
-			rig := calcLivenessFunction(e1)
+			rig := CalcLivenessFunction(e1)
 			rigs[i1] = rig
 		}
 	}
 	return rigs
 }
 
+// CalcLivenessGlobals wraps the global variables and strings of Module m, which are used by load and store
+// instructions, in LiveNodes, like CalcLiveness does before calculating the liveness of the Module's Functions.
+func CalcLivenessGlobals(m *Module) {
+	for _, e1 := range m.globals {
+		e1.SetHW(&LiveNode{
+			Val: e1,
+		})
+	}
+	for _, e1 := range m.strings {
+		e1.SetHW(&LiveNode{
+			Val: e1,
+		})
+	}
+}
+
 // String creates a print friendly string representing this node. It returns a string of the instruction
 // ln.val and the live/neighbour variables at the instructions point in the program.
 func (n *LiveNode) String() string {
@@ -136,14 +142,16 @@ func (n *LiveNode) GetEnabledNeighbours() []*LiveNode {
 	return res
 }
 
-// calcLivenessFunction calculates virtual register liveness throughout the function body.
-func calcLivenessFunction(f *Function) []*LiveNode {
+// CalcLivenessFunction calculates virtual register liveness throughout the body of Function f, and returns its
+// register interference graph. Liveness is propagated along the control flow graph, such that virtual registers that
+// are live around loops interfere with every instruction of the loop. The interference is symmetric for all virtual
+// registers.
+func CalcLivenessFunction(f *Function) []*LiveNode {
 	l := 0
 	for _, e1 := range f.Blocks() {
 		l += len(e1.Instructions())
 	}
 	vars := make([]*LiveNode, 0, l)
-	live := make([]*LiveNode, 0, l)
 
 	// Bind parameters.
 	for _, e1 := range f.params {
@@ -171,95 +179,154 @@ func calcLivenessFunction(f *Function) []*LiveNode {
 		}
 	}
 
-	// Fill live.
-	for i1 := len(vars) - 1; i1 >= 0; i1-- {
-		// Reverse order; from end of function to top of function.
-		e1 := vars[i1]
-
-		// Check for virtual registers referenced by instruction.
-		for _, e2 := range ref(e1) {
-			for _, e3 := range live {
-				if e3.Val.Id() == e2.Val.Id() {
-					// Already live.
-					goto cont
-				}
-			}
-			// Append unreferenced variable to live variables.
-			live = append(live, e2)
-		cont:
+	// Every instruction interferes with the virtual registers that are live before it executes.
+	lv := f.Liveness()
+	for _, e1 := range vars {
+		live := lv.LiveIn(e1.Val)
+		e1.Dep = make([]*LiveNode, len(live))
+		for i1, e2 := range live {
+			e1.Dep[i1] = e2.GetHW()
 		}
+	}
 
-		// Check for virtual registers defined by instruction.
-		if def := def(e1); def != nil {
-			// Variable declared. Remove from live slice.
-			for i2, e2 := range live {
-				if def.Val.Id() == e2.Val.Id() {
-					// Delete from live. Order is unimportant. Used fast method.
-					// https://stackoverflow.com/questions/37334119/how-to-delete-an-element-from-a-slice-in-golang
-					live[i2] = live[len(live)-1]
-					live = live[:len(live)-1]
-					break
+	// Make interference symmetric for virtual registers. The dependencies of a LiveNode are mirrored by a bitset keyed
+	// by the ids of their Values once other LiveNodes are added to them, such that membership is tested in constant
+	// time.
+	n := f.seq
+	for _, e1 := range vars {
+		if id := e1.Val.Id(); id >= n {
+			n = id + 1
+		}
+	}
+	deps := make([]bitset, n)
+	for _, e1 := range vars {
+		if !isRegister(e1.Val) {
+			continue
+		}
+		for _, e2 := range e1.Dep {
+			d := deps[e2.Val.Id()]
+			if d == nil {
+				d = newBitset(n)
+				for _, e3 := range e2.Dep {
+					d.add(e3.Val.Id())
 				}
+				deps[e2.Val.Id()] = d
+			}
+			if !d.has(e1.Val.Id()) {
+				d.add(e1.Val.Id())
+				e2.Dep = append(e2.Dep, e1)
 			}
 		}
-
-		e1.Dep = make([]*LiveNode, 0, len(live))
-		e1.Dep = append(e1.Dep, live...)
 	}
 	return vars
 }
 
-// ref returns a slice of operands that are referenced by the ir.Value instruction wrapped by LiveNode n.
-// If no ir.Value instructions are referenced, <nil> is returned.
-func ref(n *LiveNode) []*LiveNode {
-	v := n.Val
-
-	// Loads reference external data: no dependencies.
-	if v.Type() == types.LoadInstruction {
-		return nil
+// liveSets calculates the virtual registers that are live into and out of every Block of Function f by iterating
+// the data flow equations over the control flow graph until a fixed point is reached. The sets are bitsets keyed by
+// the ids of the virtual registers, and vals maps every id back to its virtual register.
+func liveSets(f *Function) (in, out map[*Block]bitset, vals []Value) {
+	vals = registers(f)
+	n := len(vals)
+	use := make(map[*Block]bitset, len(f.blocks))
+	defs := make(map[*Block]bitset, len(f.blocks))
+	phiUse := make(map[*Block]map[*Block]bitset, len(f.blocks)) // phiUse[s][p] holds Values used by phis of s from p.
+	for _, e1 := range f.blocks {
+		u := newBitset(n)
+		d := newBitset(n)
+		for _, e2 := range e1.instructions {
+			if p, ok := e2.(*PhiInstruction); ok {
+				for _, e3 := range p.incoming {
+					if isRegister(e3.val) {
+						if phiUse[e1] == nil {
+							phiUse[e1] = make(map[*Block]bitset)
+						}
+						if phiUse[e1][e3.b] == nil {
+							phiUse[e1][e3.b] = newBitset(n)
+						}
+						phiUse[e1][e3.b].add(e3.val.Id())
+					}
+				}
+			} else {
+				for _, e3 := range operands(e2) {
+					if isRegister(e3) && !d.has(e3.Id()) {
+						u.add(e3.Id())
+					}
+				}
+			}
+			if isRegister(e2) {
+				d.add(e2.Id())
+			}
+		}
+		use[e1] = u
+		defs[e1] = d
 	}
 
-	// Function calls have multiple dependencies.
-	if v.Type() == types.FunctionCallInstruction {
-		res := make([]*LiveNode, len(v.(*FunctionCallInstruction).arguments))
-		for i1, e1 := range v.(*FunctionCallInstruction).arguments {
-			res[i1] = e1.GetHW().(*LiveNode)
+	in = make(map[*Block]bitset, len(f.blocks))
+	out = make(map[*Block]bitset, len(f.blocks))
+	for _, e1 := range f.blocks {
+		in[e1] = newBitset(n)
+		out[e1] = newBitset(n)
+	}
+	for changed := true; changed; {
+		changed = false
+		for i1 := len(f.blocks) - 1; i1 >= 0; i1-- {
+			b := f.blocks[i1]
+			o := out[b]
+			for _, e1 := range b.succs {
+				if o.union(in[e1]) {
+					changed = true
+				}
+				if s := phiUse[e1][b]; s != nil && o.union(s) {
+					changed = true
+				}
+			}
+			if in[b].union(use[b]) {
+				changed = true
+			}
+			if in[b].unionDiff(o, defs[b]) {
+				changed = true
+			}
 		}
-		return res
 	}
+	return in, out, vals
+}
 
-	// VaLists have multiple dependencies.
-	if v.Type() == types.DataInstruction && v.DataType() == types.VaList {
-		res := make([]*LiveNode, len(v.(*VaList).vars))
-		for i1, e1 := range v.(*VaList).vars {
-			res[i1] = e1.GetHW().(*LiveNode)
+// registers returns the virtual registers defined or used by the instructions of Function f, indexed by their ids.
+// Ids that aren't virtual registers map to nil.
+func registers(f *Function) []Value {
+	vals := make([]Value, f.seq)
+	add := func(v Value) {
+		if !isRegister(v) {
+			return
+		}
+		for v.Id() >= len(vals) {
+			vals = append(vals, nil)
 		}
-		return res
+		vals[v.Id()] = v
 	}
-
-	// Remaining instructions are two or three address code instructions.
-	if op1 := v.Operand1(); op1 != nil {
-		res := make([]*LiveNode, 1, 2)
-		res[0] = op1.GetHW().(*LiveNode)
-		if op2 := v.Operand2(); op2 != nil {
-			res = append(res, op2.GetHW().(*LiveNode))
+	for _, e1 := range f.blocks {
+		for _, e2 := range e1.instructions {
+			add(e2)
+			if p, ok := e2.(*PhiInstruction); ok {
+				for _, e3 := range p.incoming {
+					add(e3.val)
+				}
+			} else {
+				for _, e3 := range operands(e2) {
+					add(e3)
+				}
+			}
 		}
-		return res
 	}
-	return nil
+	return vals
 }
 
-// def returns the virtual register ir.Value that is defined by instruction wrapped by LiveNode n, if it
-// generates a new virtual register ir.Value.
-func def(n *LiveNode) *LiveNode {
-	v := n.Val
-	if v.Type() == types.DataInstruction ||
-		v.Type() == types.LoadInstruction ||
-		v.Type() == types.FunctionCallInstruction ||
-		v.Type() == types.Constant ||
-		v.Type() == types.CastInstruction ||
-		v.Type() == types.PreserveInstruction {
-		return v.GetHW().(*LiveNode)
-	}
-	return nil
+// setValues returns the virtual registers of bitset s, ordered by id, where vals maps every id to its virtual
+// register.
+func setValues(s bitset, vals []Value) []Value {
+	res := make([]Value, 0, 8)
+	s.forEach(func(i int) {
+		res = append(res, vals[i])
+	})
+	return res
 }
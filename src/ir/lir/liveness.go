@@ -0,0 +1,155 @@
+package lir
+
+import "vslc/src/ir/lir/types"
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Liveness defines the virtual register liveness of a Function. Instructions are numbered linearly in Block order,
+// starting at 0, and a virtual register is live at an instruction if its Value may be used by a later instruction
+// before being redefined. Uses by phi instructions occur at the end of the corresponding predecessor Block.
+type Liveness struct {
+	f         *Function          // f is the Function that the Liveness was calculated for.
+	in        map[*Block][]Value // in maps every Block to the virtual registers that are live into the Block.
+	out       map[*Block][]Value // out maps every Block to the virtual registers that are live out of the Block.
+	before    map[Value][]Value  // before maps every instruction to the virtual registers live before it executes.
+	after     map[Value][]Value  // after maps every instruction to the virtual registers live after it executes.
+	pos       map[Value]int      // pos maps every instruction to its linear position in the Function.
+	intervals map[Value]Interval // intervals maps every virtual register to its live interval.
+}
+
+// Interval defines the live interval of a virtual register as the inclusive range of linear instruction positions
+// from its definition to its last use. Blocks that are not executed in between may leave holes in the interval.
+type Interval struct {
+	Start int // Start is the position of the first instruction where the virtual register is live or defined.
+	End   int // End is the position of the last instruction where the virtual register is live or used.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Liveness calculates the virtual register liveness of Function f. The Liveness is a snapshot; it must be
+// re-calculated if the instructions or the control flow graph of f change.
+func (f *Function) Liveness() *Liveness {
+	l := 0
+	for _, e1 := range f.blocks {
+		l += len(e1.instructions)
+	}
+	lv := &Liveness{
+		f:         f,
+		before:    make(map[Value][]Value, l),
+		after:     make(map[Value][]Value, l),
+		pos:       make(map[Value]int, l),
+		intervals: make(map[Value]Interval, l),
+	}
+	in, out, vals := liveSets(f)
+	lv.in = make(map[*Block][]Value, len(f.blocks))
+	lv.out = make(map[*Block][]Value, len(f.blocks))
+
+	pos := 0
+	live := newBitset(len(vals))
+	for _, e1 := range f.blocks {
+		lv.in[e1] = setValues(in[e1], vals)
+		lv.out[e1] = setValues(out[e1], vals)
+		for _, e2 := range e1.instructions {
+			lv.pos[e2] = pos
+			pos++
+		}
+
+		// Walk the Block backwards from the virtual registers that are live out of the Block.
+		live.set(out[e1])
+		for i1 := len(e1.instructions) - 1; i1 >= 0; i1-- {
+			v := e1.instructions[i1]
+			lv.after[v] = setValues(live, vals)
+			if isRegister(v) {
+				live.remove(v.Id())
+			}
+			if v.Type() != types.PhiInstruction {
+				for _, e2 := range operands(v) {
+					if isRegister(e2) {
+						live.add(e2.Id())
+					}
+				}
+			}
+			lv.before[v] = setValues(live, vals)
+		}
+	}
+
+	// Extend the live interval of every virtual register over the positions where it is defined or live.
+	extend := func(v Value, p int) {
+		if iv, ok := lv.intervals[v]; ok {
+			if p < iv.Start {
+				iv.Start = p
+			}
+			if p > iv.End {
+				iv.End = p
+			}
+			lv.intervals[v] = iv
+		} else {
+			lv.intervals[v] = Interval{Start: p, End: p}
+		}
+	}
+	for v, p := range lv.pos {
+		if isRegister(v) {
+			extend(v, p)
+		}
+		for _, e1 := range lv.before[v] {
+			extend(e1, p)
+		}
+		for _, e1 := range lv.after[v] {
+			extend(e1, p)
+		}
+	}
+	return lv
+}
+
+// BlockIn returns the virtual registers that are live into Block b, ordered by id.
+func (lv *Liveness) BlockIn(b *Block) []Value {
+	return lv.in[b]
+}
+
+// BlockOut returns the virtual registers that are live out of Block b, ordered by id.
+func (lv *Liveness) BlockOut(b *Block) []Value {
+	return lv.out[b]
+}
+
+// LiveIn returns the virtual registers that are live immediately before instruction v executes, ordered by id.
+func (lv *Liveness) LiveIn(v Value) []Value {
+	return lv.before[v]
+}
+
+// LiveOut returns the virtual registers that are live immediately after instruction v executes, ordered by id.
+func (lv *Liveness) LiveOut(v Value) []Value {
+	return lv.after[v]
+}
+
+// Position returns the linear position of instruction v in the Function. If v is not an instruction of the Function,
+// -1 is returned.
+func (lv *Liveness) Position(v Value) int {
+	if p, ok := lv.pos[v]; ok {
+		return p
+	}
+	return -1
+}
+
+// Interval returns the live interval of virtual register v. The boolean is false if v is not a virtual register of
+// the Function.
+func (lv *Liveness) Interval(v Value) (Interval, bool) {
+	iv, ok := lv.intervals[v]
+	return iv, ok
+}
+
+// Overlaps returns true if the Intervals iv and o share at least one position.
+func (iv Interval) Overlaps(o Interval) bool {
+	return iv.Start <= o.End && o.Start <= iv.End
+}
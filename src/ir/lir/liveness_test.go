@@ -0,0 +1,62 @@
+package lir
+
+import (
+	"testing"
+	"vslc/src/ir/lir/types"
+)
+
+// TestLiveness verifies the per Block and per instruction liveness, and the live intervals of the counter loop in
+// SSA form.
+func TestLiveness(t *testing.T) {
+	f := createCounterLoop()
+	f.Mem2Reg()
+	b := f.Blocks()
+	head, body, exit := b[1], b[2], b[3]
+	phi := head.phis()[0]
+	var inc Value
+	for _, e1 := range body.instructions {
+		if e1.Type() == types.DataInstruction {
+			inc = e1
+		}
+	}
+	ret := exit.term
+
+	lv := f.Liveness()
+	if !equalValues(lv.BlockIn(body), []Value{phi}) {
+		t.Errorf("expected %s live into %s, got %v", phi.Name(), body.Name(), lv.BlockIn(body))
+	}
+	if !equalValues(lv.BlockOut(body), []Value{inc}) {
+		t.Errorf("expected %s live out of %s, got %v", inc.Name(), body.Name(), lv.BlockOut(body))
+	}
+	if !equalValues(lv.LiveIn(ret), []Value{phi}) || len(lv.LiveOut(ret)) != 0 {
+		t.Errorf("unexpected liveness of %s: in %v, out %v", ret.String(), lv.LiveIn(ret), lv.LiveOut(ret))
+	}
+
+	iv, ok := lv.Interval(phi)
+	if !ok {
+		t.Fatalf("expected live interval of %s", phi.Name())
+	}
+	if iv.Start != lv.Position(phi) || iv.End != lv.Position(ret) {
+		t.Errorf("expected interval [%d, %d] of %s, got [%d, %d]", lv.Position(phi), lv.Position(ret), phi.Name(),
+			iv.Start, iv.End)
+	}
+	if inc, _ := lv.Interval(inc); !iv.Overlaps(inc) {
+		t.Errorf("expected intervals of %s and increment to overlap", phi.Name())
+	}
+	if _, ok := lv.Interval(body.term); ok {
+		t.Errorf("unexpected live interval of branch instruction")
+	}
+}
+
+// equalValues returns true if a and b hold the same Values in the same order.
+func equalValues(a, b []Value) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i1 := range a {
+		if a[i1] != b[i1] {
+			return false
+		}
+	}
+	return true
+}
@@ -12,22 +12,26 @@ import (
 // LoadInstruction defines a load instruction that loads the data from a global variable, a parameter or a locally
 // declared variable. Loading a string equals loading the pointer value of the first byte of the string.
 type LoadInstruction struct {
-	b   *Block      // b is the basic block element that owns this instruction.
-	id  int         // id is the unique identifier of this instruction in function body.
-	src Value       // src defines the variable to load. Either global, param or local.
-	hw  interface{} // Hardware register of the LoadInstruction's virtual register.
-	en  bool        // Set to true if instruction is enabled.
+	b        *Block    // b is the basic block element that owns this instruction.
+	id       int       // id is the unique identifier of this instruction in function body.
+	src      Value     // src defines the variable to load. Either global, param or local.
+	hw       *LiveNode // Hardware register of the LoadInstruction's virtual register.
+	en       bool      // Set to true if instruction is enabled.
+	uses               // uses holds the instructions that use the LoadInstruction.
+	location           // location holds the source location of the LoadInstruction.
 }
 
 // StoreInstruction defines a store instruction that saves the contents of a virtual register to a memory allocated
 // variable. A variable may be a global variable, local variable or function parameter.
 type StoreInstruction struct {
-	b   *Block // b is the basic block element that owns this instruction.
-	id  int    // id is the unique identifier of this instruction in function body.
-	src Value  // src defines the virtual register to save from.
-	dst Value  // dst defines the variable to store to. Either global, param or local.
-	hw  interface{}
-	en  bool // Set to true if instruction is enabled.
+	b        *Block // b is the basic block element that owns this instruction.
+	id       int    // id is the unique identifier of this instruction in function body.
+	src      Value  // src defines the virtual register to save from.
+	dst      Value  // dst defines the variable to store to. Either global, param or local.
+	hw       *LiveNode
+	en       bool // Set to true if instruction is enabled.
+	uses          // uses holds the instructions that use the StoreInstruction.
+	location      // location holds the source location of the StoreInstruction.
 }
 
 // ---------------------
@@ -74,12 +78,12 @@ func (inst *LoadInstruction) String() string {
 }
 
 // SetHW sets the LoadInstruction's assigned hardware register during register allocation.
-func (inst *LoadInstruction) SetHW(hw interface{}) {
+func (inst *LoadInstruction) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW retrieves the LoadInstruction's assigned hardware register.
-func (inst *LoadInstruction) GetHW() interface{} {
+func (inst *LoadInstruction) GetHW() *LiveNode {
 	return inst.hw
 }
 
@@ -134,12 +138,12 @@ func (inst *StoreInstruction) String() string {
 }
 
 // SetHW panics the StoreInstruction because it operates on existing virtual registers only.
-func (inst *StoreInstruction) SetHW(hw interface{}) {
+func (inst *StoreInstruction) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW return <nil> the StoreInstruction.
-func (inst *StoreInstruction) GetHW() interface{} {
+func (inst *StoreInstruction) GetHW() *LiveNode {
 	return inst.hw
 }
 
@@ -11,17 +11,33 @@ import (
 // ----- Type definitions -----
 // ----------------------------
 
-// Module defines the global scope of the lightweight intermediate representation.
+// Module defines the global scope of the lightweight intermediate representation. The globals and functions of the
+// program are declared before their function bodies are generated, and the Module is sealed by seal once they are, such
+// that the function bodies look them up without locking the Module. Runtime routines, such as printf and the soft-float
+// routines, are declared on demand, and are looked up with the Module locked.
 type Module struct {
-	name       string               // name defines the module name.
-	functions  []*Function          // functions defines the globally declared functions of the program.
-	globals    []*Global            // globals defines the globally declared variables of the program.
-	fmap       map[string]*Function // A hash map for quickly accessing globally declared functions.
-	gmap       map[string]*Global   // A hash map for quickly accessing globally declared variables.
-	constants  []*Constant          // All constants are linked globally in case they need to be loaded from global data instead of immediate values.
-	strings    []*String            // strings declares the string data used in the program.
-	seq        int                  // seq is the global sequence number that generates unique identifiers for global LIR objects.
-	sync.Mutex                      // Mutex synchronizes worker go routine access to global data.
+	name       string                 // name defines the module name.
+	intBits    int                    // intBits is the number of bits of integers, which integer constants fit in.
+	functions  []*Function            // functions defines the globally declared functions of the program.
+	globals    []*Global              // globals defines the globally declared variables of the program.
+	fmap       map[string]*Function   // A hash map for quickly accessing globally declared functions. Read-only once sealed.
+	gmap       map[string]*Global     // A hash map for quickly accessing globally declared variables. Read-only once sealed.
+	rmap       map[string]*Function   // rmap holds the runtime routines declared once the Module is sealed.
+	sealed     bool                   // Set to true once the globals and functions of the program are declared.
+	constants  []*Constant            // All constants are linked globally in case they need to be loaded from global data instead of immediate values.
+	cmap       map[constKey]*Constant // A hash map for finding the data entry of identical constants.
+	strings    []*String              // strings declares the string data used in the program.
+	smap       map[string]*String     // A hash map for finding identical strings.
+	seq        int                    // seq is the global sequence number that generates unique identifiers for global LIR objects.
+	symbols    []Symbol               // symbols are the identifiers of the source code, resolved when LIR is generated.
+	sync.Mutex                        // Mutex synchronizes worker go routine access to global data.
+}
+
+// constKey identifies the value of a constant, such that identical constants share a single data entry. Floating
+// point values are identified by their bit pattern, to keep 0.0 and -0.0 apart.
+type constKey struct {
+	typ  types.DataType // typ is the data type of the constant.
+	bits uint64         // bits holds the integer value or the floating point bit pattern of the constant.
 }
 
 // ---------------------
@@ -40,6 +56,9 @@ const gSize = 16
 // fSize pre-defines a reasonable number of parameters, local variables and basic blocks for functions.
 const fSize = 8
 
+// stdout is the file descriptor of standard output, which is printed to by printf rather than dprintf.
+const stdout = 1
+
 // -------------------
 // ----- Globals -----
 // -------------------
@@ -48,8 +67,14 @@ const fSize = 8
 var reservedNames = [...]string{
 	"printf",
 	"main",
-	"atoi",
-	"atof",
+	"strtol",
+	"strtod",
+	"dprintf",
+	"abort",
+	LabelArgc,
+	LabelArgInt,
+	LabelArgFloat,
+	LabelArgString,
 }
 
 // ---------------------
@@ -62,10 +87,14 @@ func CreateModule(name string) *Module {
 		functions: make([]*Function, 0, gSize),
 		fmap:      make(map[string]*Function),
 		gmap:      make(map[string]*Global),
+		rmap:      make(map[string]*Function),
 		constants: make([]*Constant, 0, gSize),
+		cmap:      make(map[constKey]*Constant, gSize),
 		strings:   make([]*String, 0, gSize),
+		smap:      make(map[string]*String, gSize),
 		Mutex:     sync.Mutex{},
 		seq:       1 << 20, // Offset by a large number, because function's local sequence numbers start at 0.
+		intBits:   64,
 	}
 	if len(name) > 0 {
 		m.name = name
@@ -80,6 +109,11 @@ func (m *Module) Name() string {
 	return m.name
 }
 
+// IntBits returns the number of bits of the integers of Module m, which are wrapped to this width when they overflow.
+func (m *Module) IntBits() int {
+	return m.intBits
+}
+
 // String returns the textual LIR representation of Module m.
 func (m *Module) String() string {
 	sb := strings.Builder{}
@@ -129,6 +163,7 @@ func (m *Module) CreateGlobalInt(name string) *Global {
 	}
 	m.Lock()
 	defer m.Unlock()
+	m.checkSealed(name)
 	if _, ok := m.fmap[name]; ok {
 		panic(fmt.Sprintf("duplicate declaration: function with name %q already defined for module %s",
 			name, m.name))
@@ -157,6 +192,7 @@ func (m *Module) CreateGlobalFloat(name string) *Global {
 	}
 	m.Lock()
 	defer m.Unlock()
+	m.checkSealed(name)
 	if _, ok := m.fmap[name]; ok {
 		panic(fmt.Sprintf("duplicate declaration: function with name %q already defined for module %s",
 			name, m.name))
@@ -178,25 +214,40 @@ func (m *Module) CreateGlobalFloat(name string) *Global {
 	return inst
 }
 
-// createConstant appends a float or int constant to the Module m.
+// createConstant links the float or int constant v to the data entry of Module m that holds its value. If no
+// identical constant exists, v becomes the data entry and is appended to the Module's constants. The caller must hold
+// the Module's lock.
 func (m *Module) createConstant(v Value) {
 	if v.Type() != types.Constant && v.DataType() != types.Int && v.DataType() != types.Float {
 		panic(fmt.Sprintf("cannot create constant: expected %s %s or %s %s, got %s %s",
 			types.Constant.String(), types.Int.String(), types.Constant.String(),
 			types.Float, v.Type().String(), v.DataType()))
 	}
-	m.Lock()
-	defer m.Unlock()
-	m.constants = append(m.constants, v.(*Constant))
+	c := v.(*Constant)
+	key := c.key()
+	if d, ok := m.cmap[key]; ok {
+		c.data = d
+		c.lseq = d.lseq
+		return
+	}
+	c.data = c
+	c.lseq = m.seq
+	m.seq++
+	m.cmap[key] = c
+	m.constants = append(m.constants, c)
 }
 
-// CreateGlobalString creates a global constant string.
+// CreateGlobalString creates a global constant string. If an identical string already exists, the existing String
+// is returned.
 func (m *Module) CreateGlobalString(s string) *String {
 	if len(s) < 1 {
 		panic("cannot create string constant: no string provided")
 	}
 	m.Lock()
 	defer m.Unlock()
+	if str, ok := m.smap[s]; ok {
+		return str
+	}
 	str := &String{
 		m:   m,
 		id:  m.seq,
@@ -205,15 +256,18 @@ func (m *Module) CreateGlobalString(s string) *String {
 	}
 	m.seq++
 	m.strings = append(m.strings, str)
+	m.smap[s] = str
 	return str
 }
 
-// GetGlobalVariable returns a *Global variable if it exists. If it does not exist, <nil> is returned.
+// GetGlobalVariable returns a *Global variable if it exists. If it does not exist, <nil> is returned. The Module is
+// only locked if it isn't sealed.
 func (m *Module) GetGlobalVariable(name string) *Global {
-	if g, ok := m.gmap[name]; ok {
-		return g
+	if !m.sealed {
+		m.Lock()
+		defer m.Unlock()
 	}
-	return nil
+	return m.gmap[name]
 }
 
 // Globals returns a slice of all the globally declared variables of Module m.
@@ -226,7 +280,8 @@ func (m *Module) Strings() []*String {
 	return m.strings
 }
 
-// Constants returns a slice of all the declared constants defined for Module m.
+// Constants returns a slice of the unique constants of Module m. Identical constants are represented by the first
+// Constant created with that value, which holds the shared data entry.
 func (m *Module) Constants() []*Constant {
 	return m.constants
 }
@@ -252,6 +307,7 @@ func (m *Module) CreateFunction(name string, typ types.DataType) *Function {
 	// Check for duplicate declarations.
 	m.Lock()
 	defer m.Unlock()
+	m.checkSealed(name)
 	if _, ok := m.fmap[name]; ok {
 		panic(fmt.Sprintf("duplicate declaration: function %q already defined for module %s",
 			name, m.name))
@@ -277,14 +333,84 @@ func (m *Module) CreateFunction(name string, typ types.DataType) *Function {
 	return f
 }
 
-// GetFunction returns the named function if it exists. If it does not exist, <nil> is returned.
+// declare returns the external Function name of Module m that returns typ and takes parameters of the types params,
+// such as a routine of a runtime library. The Function is declared without a body if it doesn't exist.
+func (m *Module) declare(name string, typ types.DataType, params ...types.DataType) *Function {
+	names := make([]string, len(params))
+	for i1 := range params {
+		names[i1] = fmt.Sprintf("p%d", i1)
+	}
+	return m.declareNamed(name, typ, names, params)
+}
+
+// declareNamed returns the external Function name of Module m like declare, whose parameters are named names.
+func (m *Module) declareNamed(name string, typ types.DataType, names []string, params []types.DataType) *Function {
+	m.Lock()
+	defer m.Unlock()
+	if f := m.lookupFunction(name); f != nil {
+		return f
+	}
+	f := &Function{
+		m:      m,
+		id:     m.seq,
+		name:   name,
+		typ:    typ,
+		params: make([]*Param, len(params)),
+	}
+	m.seq++
+	for i1, e1 := range params {
+		f.params[i1] = &Param{
+			f:    f,
+			id:   f.getId(),
+			name: names[i1],
+			typ:  e1,
+			en:   true,
+		}
+	}
+	m.functions = append(m.functions, f)
+	if m.sealed {
+		m.rmap[name] = f
+	} else {
+		m.fmap[name] = f
+	}
+	return f
+}
+
+// GetFunction returns the named function if it exists. If it does not exist, <nil> is returned. The functions of the
+// program are looked up without locking the Module once it's sealed.
 func (m *Module) GetFunction(name string) *Function {
+	if m.sealed {
+		if f, ok := m.fmap[name]; ok {
+			return f
+		}
+	}
 	m.Lock()
 	defer m.Unlock()
+	return m.lookupFunction(name)
+}
+
+// lookupFunction returns the named function of Module m, or <nil> if it doesn't exist. The caller must hold the
+// Module's lock.
+func (m *Module) lookupFunction(name string) *Function {
 	if f, ok := m.fmap[name]; ok {
 		return f
 	}
-	return nil
+	return m.rmap[name]
+}
+
+// seal marks the globals and functions of Module m as declared, such that they are looked up without locking the
+// Module. It must be called before the Module is shared by the go routines that generate function bodies, and no
+// globals or functions may be created afterwards, except for runtime routines.
+func (m *Module) seal() {
+	m.sealed = true
+}
+
+// checkSealed panics if Module m is sealed, such that the global or function name can't be declared. The caller must
+// hold the Module's lock.
+func (m *Module) checkSealed(name string) {
+	if m.sealed {
+		panic(fmt.Sprintf("cannot declare %q: the globals and functions of module %s are sealed", name, m.name))
+	}
 }
 
 // Functions returns a slice of all the functions defined for Module m.
@@ -0,0 +1,59 @@
+package lir
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"vslc/src/ir/lir/types"
+)
+
+// TestSeal verifies that the globals and functions of a sealed Module are looked up, that no more are declared, and
+// that runtime routines declared concurrently are declared once.
+func TestSeal(t *testing.T) {
+	m := CreateModule("seal")
+	g := m.CreateGlobalInt("x")
+	f := m.CreateFunction("f", types.Int)
+	funcs := make([]*Function, 8)
+	for i1 := range funcs {
+		funcs[i1] = m.CreateFunction(fmt.Sprintf("f%d", i1), types.Int)
+	}
+	m.seal()
+	if m.GetGlobalVariable("x") != g || m.GetFunction("f") != f || m.GetFunction("g") != nil {
+		t.Error("expected the globals and functions declared before the Module was sealed")
+	}
+	for _, e1 := range []func(){
+		func() { m.CreateGlobalFloat("y") },
+		func() { m.CreateFunction("g", types.Int) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic declaring a global of a sealed Module")
+				}
+			}()
+			e1()
+		}()
+	}
+
+	// Every function body may print, hence declare printf.
+	wg := sync.WaitGroup{}
+	res := make([]*Function, len(funcs))
+	for i1 := range funcs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			b := funcs[i].CreateBlock()
+			b.CreatePrint(stdout, []Value{b.CreateConstantInt(i)})
+			res[i] = m.GetFunction(reservedNames[0])
+		}(i1)
+	}
+	wg.Wait()
+	for _, e1 := range res {
+		if e1 == nil || e1 != res[0] {
+			t.Fatalf("expected printf to be declared once, got %v", res)
+		}
+	}
+	if n := len(m.Functions()); n != len(funcs)+2 {
+		t.Errorf("expected %d functions, got %d", len(funcs)+2, n)
+	}
+}
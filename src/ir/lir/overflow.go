@@ -0,0 +1,32 @@
+package lir
+
+import "vslc/src/ir/lir/types"
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// LabelOverflow is the symbol of the runtime routine that writes OverflowMsg to standard error and aborts the
+// program. It's called by the integer expressions that overflow if -ftrapv is set, and defined by the backends next to
+// the implicit main function. The dot keeps it from clashing with VSL functions.
+const LabelOverflow = "vsl.overflow"
+
+// OverflowMsg is the message written to standard error when an integer expression overflows.
+const OverflowMsg = "Overflow error: integer overflow\n"
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Traps returns true if DataInstruction inst is an integer addition, subtraction or multiplication, which are checked
+// for signed overflow if -ftrapv is set. Negation is a subtraction from zero.
+func (inst *DataInstruction) Traps() bool {
+	if inst.DataType() != types.Int || inst.op2 == nil {
+		return false
+	}
+	switch inst.op {
+	case types.Add, types.Sub, types.Mul:
+		return true
+	}
+	return false
+}
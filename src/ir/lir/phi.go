@@ -0,0 +1,168 @@
+package lir
+
+import (
+	"fmt"
+	"strings"
+	"vslc/src/ir/lir/types"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// PhiInstruction defines an SSA phi node. The PhiInstruction selects one of its incoming Values based on which
+// predecessor Block control flow arrived from. Phi instructions are always placed at the top of their Block.
+type PhiInstruction struct {
+	b        *Block         // b is the basic block element that owns this instruction.
+	id       int            // id is the unique identifier of this instruction in function body.
+	typ      types.DataType // typ defines the data type of the selected Value.
+	incoming []phiEdge      // incoming holds one Value per predecessor Block.
+	hw       *LiveNode      // hw defines the hardware register of the PhiInstruction's virtual register.
+	en       bool           // Set to true if instruction is enabled.
+	uses                    // uses holds the instructions that use the PhiInstruction.
+	location                // location holds the source location of the PhiInstruction.
+}
+
+// phiEdge pairs an incoming Value with the predecessor Block it flows from.
+type phiEdge struct {
+	val Value  // val is the Value selected when control flow arrives from b.
+	b   *Block // b is the predecessor Block.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// labelPhi is the textual LIR operator of phi instructions.
+const labelPhi = "phi"
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// CreatePhi creates a PhiInstruction of the given data type and puts it at the top of Block b, after any existing
+// phi instructions. Incoming Values are added using PhiInstruction.AddIncoming.
+func (b *Block) CreatePhi(typ types.DataType) *PhiInstruction {
+	if typ > types.Float {
+		panic(fmt.Sprintf("cannot create phi: only %s and %s values are allowed",
+			types.Int.String(), types.Float.String()))
+	}
+	inst := &PhiInstruction{
+		b:   b,
+		id:  b.f.getId(),
+		typ: typ,
+		en:  true,
+	}
+	inst.loc = b.f.loc
+
+	// Phi instructions must precede all other instructions of the Block.
+	idx := 0
+	for idx < len(b.instructions) && b.instructions[idx].Type() == types.PhiInstruction {
+		idx++
+	}
+	b.insert(idx, inst)
+	return inst
+}
+
+// Id returns the unique id of the PhiInstruction.
+func (inst *PhiInstruction) Id() int {
+	return inst.id
+}
+
+// Name returns the textual representation of the virtual register Value of the PhiInstruction.
+func (inst *PhiInstruction) Name() string {
+	return fmt.Sprintf("%s%d", labelDataInstruction, inst.id)
+}
+
+// Type returns the constant identifying this instruction as a PhiInstruction.
+func (inst *PhiInstruction) Type() types.InstructionType {
+	return types.PhiInstruction
+}
+
+// DataType returns the DataType of the PhiInstruction's incoming Values.
+func (inst *PhiInstruction) DataType() types.DataType {
+	return inst.typ
+}
+
+// String returns the textual LIR representation of the PhiInstruction.
+func (inst *PhiInstruction) String() string {
+	sb := strings.Builder{}
+	for i1, e1 := range inst.incoming {
+		sb.WriteString(fmt.Sprintf("[%s, %s]", e1.val.Name(), e1.b.Name()))
+		if i1 < len(inst.incoming)-1 {
+			sb.WriteString(", ")
+		}
+	}
+	return fmt.Sprintf("%s = %s %s %s", inst.Name(), labelPhi, inst.typ.String(), sb.String())
+}
+
+// SetHW sets the PhiInstruction's assigned hardware register during register allocation.
+func (inst *PhiInstruction) SetHW(hw *LiveNode) {
+	inst.hw = hw
+}
+
+// GetHW retrieves the PhiInstruction's assigned hardware register.
+func (inst *PhiInstruction) GetHW() *LiveNode {
+	return inst.hw
+}
+
+// Operand1 returns <nil> for the PhiInstruction. Use PhiInstruction.Incoming to access the operands.
+func (inst *PhiInstruction) Operand1() Value {
+	return nil
+}
+
+// Operand2 returns <nil> for the PhiInstruction.
+func (inst *PhiInstruction) Operand2() Value {
+	return nil
+}
+
+// Enable enables the instruction, resulting in that it will be printed using Module.String.
+func (inst *PhiInstruction) Enable() {
+	inst.en = true
+}
+
+// Disable disables the instruction, resulting in that it won't be printed using Module.String.
+func (inst *PhiInstruction) Disable() {
+	inst.en = false
+}
+
+// IsEnabled returns true if the instruction is enabled.
+func (inst *PhiInstruction) IsEnabled() bool {
+	return inst.en
+}
+
+// AddIncoming adds the Value v as the selected Value when control flow arrives from the predecessor Block b.
+func (inst *PhiInstruction) AddIncoming(v Value, b *Block) {
+	if v == nil || b == nil {
+		panic("cannot add incoming value to phi: value or block is <nil>")
+	}
+	inst.incoming = append(inst.incoming, phiEdge{val: v, b: b})
+	v.addUser(inst)
+}
+
+// Incoming returns the incoming Values and their predecessor Blocks of the PhiInstruction inst. The i'th Value is
+// selected when control flow arrives from the i'th Block.
+func (inst *PhiInstruction) Incoming() ([]Value, []*Block) {
+	vals := make([]Value, len(inst.incoming))
+	blocks := make([]*Block, len(inst.incoming))
+	for i1, e1 := range inst.incoming {
+		vals[i1] = e1.val
+		blocks[i1] = e1.b
+	}
+	return vals, blocks
+}
+
+// IncomingFrom returns the Value selected when control flow arrives from Block b. If b is not a predecessor of the
+// PhiInstruction's Block, <nil> is returned.
+func (inst *PhiInstruction) IncomingFrom(b *Block) Value {
+	for _, e1 := range inst.incoming {
+		if e1.b == b {
+			return e1.val
+		}
+	}
+	return nil
+}
@@ -12,11 +12,13 @@ import (
 // PreserveInstruction defines an instruction that casts either types.Int to types.Float,
 // or vice versa.
 type PreserveInstruction struct {
-	b   *Block         // b is the basic block element that owns this instruction.
-	id  int            // id is the unique identifier of this instruction in function body.
-	src Value          // src is the source Value that was preserve.
-	hw  interface{}    // hw defines the hardware register of the PreserveInstruction's virtual register.
-	en  bool           // Set to true if instruction is enabled.
+	b        *Block    // b is the basic block element that owns this instruction.
+	id       int       // id is the unique identifier of this instruction in function body.
+	src      Value     // src is the source Value that was preserve.
+	hw       *LiveNode // hw defines the hardware register of the PreserveInstruction's virtual register.
+	en       bool      // Set to true if instruction is enabled.
+	uses               // uses holds the instructions that use the PreserveInstruction.
+	location           // location holds the source location of the PreserveInstruction.
 }
 
 // ---------------------
@@ -57,12 +59,12 @@ func (inst *PreserveInstruction) String() string {
 }
 
 // SetHW panics for the PreserveInstruction, because it's a memory value, not a virtual register.
-func (inst *PreserveInstruction) SetHW(hw interface{}) {
+func (inst *PreserveInstruction) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW returns <nil> for the PreserveInstruction.
-func (inst *PreserveInstruction) GetHW() interface{} {
+func (inst *PreserveInstruction) GetHW() *LiveNode {
 	return inst.hw
 }
 
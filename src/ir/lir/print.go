@@ -13,20 +13,24 @@ import (
 
 // PrintInstruction defines an instruction that uses system calls to print a single Value to stdout.
 type PrintInstruction struct {
-	b   *Block // b is the basic block element that owns this instruction.
-	id  int    // id is the unique identifier of this instruction in function body.
-	val Value  // Value to print.
-	hw  interface{}
-	en  bool // Set to true if instruction is enabled.
+	b        *Block // b is the basic block element that owns this instruction.
+	id       int    // id is the unique identifier of this instruction in function body.
+	val      Value  // Value to print.
+	hw       *LiveNode
+	en       bool // Set to true if instruction is enabled.
+	uses          // uses holds the instructions that use the PrintInstruction.
+	location      // location holds the source location of the PrintInstruction.
 }
 
 // VaList defines a variable argument list.
 type VaList struct {
-	b    *Block      // b is the basic block element that owns this instruction.
-	id   int         // id is the unique identifier of this instruction in function body.
-	vars []Value     // Value slice of values that's passed in the VaList.
-	hw   interface{} // hw defines the hardware register assigned to VaList.
-	en   bool        // Set to true if instruction is enabled.
+	b        *Block    // b is the basic block element that owns this instruction.
+	id       int       // id is the unique identifier of this instruction in function body.
+	vars     []Value   // Value slice of values that's passed in the VaList.
+	hw       *LiveNode // hw defines the hardware register assigned to VaList.
+	en       bool      // Set to true if instruction is enabled.
+	uses               // uses holds the instructions that use the VaList.
+	location           // location holds the source location of the VaList.
 }
 
 // ---------------------
@@ -70,12 +74,12 @@ func (inst *PrintInstruction) String() string {
 }
 
 // SetHW panics for the PrintInstruction, because it's a memory value, not a virtual register.
-func (inst *PrintInstruction) SetHW(hw interface{}) {
+func (inst *PrintInstruction) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW returns <nil> for the PrintInstruction.
-func (inst *PrintInstruction) GetHW() interface{} {
+func (inst *PrintInstruction) GetHW() *LiveNode {
 	return inst.hw
 }
 
@@ -140,12 +144,12 @@ func (inst *VaList) String() string {
 }
 
 // SetHW panics for the VaList, because it's a memory value, not a virtual register.
-func (inst *VaList) SetHW(hw interface{}) {
+func (inst *VaList) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW returns <nil> for the VaList.
-func (inst *VaList) GetHW() interface{} {
+func (inst *VaList) GetHW() *LiveNode {
 	return inst.hw
 }
 
@@ -0,0 +1,141 @@
+package lir
+
+import (
+	"fmt"
+	"vslc/src/ir/lir/types"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// SelectInstruction defines a conditional select. The SelectInstruction compares its two operands using its relational
+// operator, and selects its true Value if the relation holds and its false Value otherwise. Both Values are evaluated
+// before the SelectInstruction, such that no branch is needed.
+type SelectInstruction struct {
+	b        *Block                    // b is the basic block element that owns this instruction.
+	id       int                       // id is the unique identifier of this instruction in function body.
+	typ      types.DataType            // typ defines the data type of the selected Value.
+	op       types.RelationalOperation // op defines the relation between op1 and op2.
+	op1, op2 Value                     // op1 and op2 are the compared Values.
+	tval     Value                     // tval is selected if the relation holds.
+	fval     Value                     // fval is selected if the relation doesn't hold.
+	hw       *LiveNode                 // hw defines the hardware register of the SelectInstruction's virtual register.
+	en       bool                      // Set to true if instruction is enabled.
+	uses                               // uses holds the instructions that use the SelectInstruction.
+	location                           // location holds the source location of the SelectInstruction.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// labelSelect is the textual LIR operator of select instructions.
+const labelSelect = "select"
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// CreateSelect creates a SelectInstruction that selects tval if op1 and op2 satisfy the relational operator op, and
+// fval otherwise. The selected Values must be of the same data type.
+func (b *Block) CreateSelect(op types.RelationalOperation, op1, op2, tval, fval Value) *SelectInstruction {
+	if tval.DataType() != fval.DataType() || tval.DataType() > types.Float {
+		panic(fmt.Sprintf("cannot create select: %s and %s must both be either %s or %s values",
+			tval.Name(), fval.Name(), types.Int.String(), types.Float.String()))
+	}
+	inst := &SelectInstruction{
+		b:    b,
+		id:   b.f.getId(),
+		typ:  tval.DataType(),
+		op:   op,
+		op1:  op1,
+		op2:  op2,
+		tval: tval,
+		fval: fval,
+		en:   true,
+	}
+	b.emit(inst)
+	use(inst)
+	return inst
+}
+
+// Id returns the unique id of the SelectInstruction.
+func (inst *SelectInstruction) Id() int {
+	return inst.id
+}
+
+// Name returns the textual representation of the virtual register Value of the SelectInstruction.
+func (inst *SelectInstruction) Name() string {
+	return fmt.Sprintf("%s%d", labelDataInstruction, inst.id)
+}
+
+// Type returns the constant identifying this instruction as a SelectInstruction.
+func (inst *SelectInstruction) Type() types.InstructionType {
+	return types.SelectInstruction
+}
+
+// DataType returns the DataType of the SelectInstruction's selected Values.
+func (inst *SelectInstruction) DataType() types.DataType {
+	return inst.typ
+}
+
+// String returns the textual LIR representation of the SelectInstruction.
+func (inst *SelectInstruction) String() string {
+	return fmt.Sprintf("%s = %s %s, %s, %s ? %s : %s", inst.Name(), labelSelect, inst.op.String(), inst.op1.Name(),
+		inst.op2.Name(), inst.tval.Name(), inst.fval.Name())
+}
+
+// SetHW sets the SelectInstruction's assigned hardware register during register allocation.
+func (inst *SelectInstruction) SetHW(hw *LiveNode) {
+	inst.hw = hw
+}
+
+// GetHW retrieves the SelectInstruction's assigned hardware register.
+func (inst *SelectInstruction) GetHW() *LiveNode {
+	return inst.hw
+}
+
+// Operand1 returns the first compared Value of the SelectInstruction.
+func (inst *SelectInstruction) Operand1() Value {
+	return inst.op1
+}
+
+// Operand2 returns the second compared Value of the SelectInstruction.
+func (inst *SelectInstruction) Operand2() Value {
+	return inst.op2
+}
+
+// Enable enables the instruction, resulting in that it will be printed using Module.String.
+func (inst *SelectInstruction) Enable() {
+	inst.en = true
+}
+
+// Disable disables the instruction, resulting in that it won't be printed using Module.String.
+func (inst *SelectInstruction) Disable() {
+	inst.en = false
+}
+
+// IsEnabled returns true if the instruction is enabled.
+func (inst *SelectInstruction) IsEnabled() bool {
+	return inst.en
+}
+
+// Operator returns the relational operator of SelectInstruction inst.
+func (inst *SelectInstruction) Operator() types.RelationalOperation {
+	return inst.op
+}
+
+// True returns the Value selected if the relation of SelectInstruction inst holds.
+func (inst *SelectInstruction) True() Value {
+	return inst.tval
+}
+
+// False returns the Value selected if the relation of SelectInstruction inst doesn't hold.
+func (inst *SelectInstruction) False() Value {
+	return inst.fval
+}
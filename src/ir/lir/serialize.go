@@ -0,0 +1,558 @@
+package lir
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+	"sync"
+	"vslc/src/ir/lir/types"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// encKind identifies the concrete Value type of an encoded Value.
+type encKind uint8
+
+// encScope identifies the scope an encoded Value reference is resolved in.
+type encScope uint8
+
+// encModule is the serialized form of a Module.
+type encModule struct {
+	Name      string        // Name is the Module name.
+	Seq       int           // Seq is the Module's global sequence number.
+	IntBits   int           // IntBits is the number of bits of integers.
+	Globals   []encValue    // Globals holds the global variables.
+	Strings   []encValue    // Strings holds the global string constants.
+	Functions []encFunction // Functions holds the Functions, including external declarations such as printf.
+	Constants []encValue    // Constants holds every Constant of the Module, in Module order.
+}
+
+// encFunction is the serialized form of a Function.
+type encFunction struct {
+	Id     int            // Id is the Function's unique identifier.
+	Name   string         // Name is the Function name.
+	Typ    types.DataType // Typ is the return data type.
+	Seq    int            // Seq is the Function local sequence number.
+	VSeq   int            // VSeq is the local variable sequence number.
+	En     bool           // En is true if the Function is enabled.
+	Params []encValue     // Params holds the Function parameters.
+	Locals []encValue     // Locals holds the locally declared variables.
+	Blocks []encBlock     // Blocks holds the basic blocks of the Function body.
+}
+
+// encBlock is the serialized form of a Block.
+type encBlock struct {
+	Id           int        // Id is the Block's unique identifier.
+	Instructions []encValue // Instructions holds the Block's instructions, including its terminator.
+}
+
+// encValue is the serialized form of any Value. Only the fields relevant to Kind are set.
+type encValue struct {
+	Kind   encKind        // Kind identifies the concrete type of the Value.
+	Id     int            // Id is the unique identifier of the Value.
+	Name   string         // Name is the name of named Values.
+	Typ    types.DataType // Typ is the data type of the Value.
+	Op     int            // Op is the arithmetic or relational operation.
+	Seq    int            // Seq is the variable sequence number of declarations and label sequence of constants.
+	Int    int            // Int is the value of integer constants.
+	Float  float64        // Float is the value of floating point constants.
+	Str    string         // Str is the value of string constants.
+	Used   int            // Used is the use count of constants.
+	Func   int            // Func is the owning Function of constants or the target of function calls.
+	Block  int            // Block is the owning Block of declarations and constants.
+	Thn    int            // Thn is the then, or unconditional, target Block of branches.
+	Els    int            // Els is the else target Block of conditional branches.
+	Ops    []encRef       // Ops holds the operands of the Value.
+	Blocks []int          // Blocks holds the predecessor Block of each operand of phi instructions.
+	En     bool           // En is true if the Value is enabled.
+	Ext    bool           // Ext is true if a global variable is defined by another LIR object.
+	Loc    Location       // Loc is the source Location of the Value.
+}
+
+// encRef is the serialized form of a reference to a Value.
+type encRef struct {
+	Scope encScope // Scope defines where Id is resolved.
+	Id    int      // Id is the unique identifier of the referenced Value.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+const (
+	encData encKind = iota
+	encLoad
+	encStore
+	encConstant
+	encBranch
+	encReturn
+	encDeclare
+	encCall
+	encCast
+	encPreserve
+	encVaList
+	encPhi
+	encPrint
+	encGlobal
+	encParam
+	encString
+	encSelect
+)
+
+const (
+	scopeNone   encScope = iota // scopeNone identifies a <nil> reference.
+	scopeLocal                  // scopeLocal identifies parameters, locals and instructions of the Function.
+	scopeModule                 // scopeModule identifies globals and strings of the Module.
+)
+
+// noBlock identifies an absent Block reference.
+const noBlock = -1
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Encode writes a binary representation of Module m to w. The Module can be restored using Decode. Hardware
+// register assignments are not encoded.
+func (m *Module) Encode(w io.Writer) error {
+	m.Lock()
+	em := encModule{
+		Name:      m.name,
+		Seq:       m.seq,
+		IntBits:   m.intBits,
+		Globals:   make([]encValue, 0, len(m.globals)),
+		Strings:   make([]encValue, 0, len(m.strings)),
+		Functions: make([]encFunction, 0, len(m.functions)),
+		Constants: make([]encValue, 0, len(m.constants)),
+	}
+	funcs := m.functions
+	m.Unlock()
+
+	for _, e1 := range m.globals {
+		em.Globals = append(em.Globals, encValue{Kind: encGlobal, Id: e1.id, Name: e1.name, Typ: e1.typ, En: e1.en,
+			Ext: e1.ext, Loc: e1.loc})
+	}
+	for _, e1 := range m.strings {
+		em.Strings = append(em.Strings, encValue{Kind: encString, Id: e1.id, Str: e1.val, En: e1.en})
+	}
+	for _, e1 := range m.constants {
+		ev := encValue{
+			Kind:  encConstant,
+			Id:    e1.id,
+			Name:  e1.name,
+			Typ:   e1.typ,
+			Seq:   e1.lseq,
+			Used:  e1.used,
+			Func:  noBlock,
+			Block: noBlock,
+			En:    e1.en,
+		}
+		if e1.typ == types.Int {
+			ev.Int = e1.val.(int)
+		} else {
+			ev.Float = e1.val.(float64)
+		}
+		if e1.b != nil {
+			ev.Func = e1.b.f.id
+			ev.Block = e1.b.id
+		}
+		em.Constants = append(em.Constants, ev)
+	}
+	for _, e1 := range funcs {
+		ef, err := encodeFunction(e1)
+		if err != nil {
+			return err
+		}
+		em.Functions = append(em.Functions, ef)
+	}
+	if err := gob.NewEncoder(w).Encode(&em); err != nil {
+		return fmt.Errorf("could not encode module %s: %s", m.name, err)
+	}
+	return nil
+}
+
+// Decode reads a Module written by Module.Encode from r.
+func Decode(r io.Reader) (*Module, error) {
+	em := encModule{}
+	if err := gob.NewDecoder(r).Decode(&em); err != nil {
+		return nil, fmt.Errorf("could not decode module: %s", err)
+	}
+
+	m := &Module{
+		name:      em.Name,
+		functions: make([]*Function, 0, len(em.Functions)),
+		globals:   make([]*Global, 0, len(em.Globals)),
+		fmap:      make(map[string]*Function, len(em.Functions)),
+		gmap:      make(map[string]*Global, len(em.Globals)),
+		constants: make([]*Constant, 0, len(em.Constants)),
+		cmap:      make(map[constKey]*Constant, len(em.Constants)),
+		strings:   make([]*String, 0, len(em.Strings)),
+		smap:      make(map[string]*String, len(em.Strings)),
+		seq:       em.Seq,
+		intBits:   em.IntBits,
+		Mutex:     sync.Mutex{},
+	}
+
+	// Module scope values.
+	mvals := make(map[int]Value, len(em.Globals)+len(em.Strings))
+	for _, e1 := range em.Globals {
+		g := &Global{m: m, id: e1.Id, name: e1.Name, typ: e1.Typ, en: e1.En, ext: e1.Ext}
+		g.loc = e1.Loc
+		m.globals = append(m.globals, g)
+		m.gmap[g.name] = g
+		mvals[g.id] = g
+	}
+	for _, e1 := range em.Strings {
+		s := &String{m: m, id: e1.Id, val: e1.Str, en: e1.En}
+		m.strings = append(m.strings, s)
+		m.smap[s.val] = s
+		mvals[s.id] = s
+	}
+
+	// Function headers and blocks must exist before any instruction can reference them.
+	fmap := make(map[int]*Function, len(em.Functions))
+	bmap := make(map[int]*Block)
+	lvals := make(map[*Function]map[int]Value, len(em.Functions))
+	for _, e1 := range em.Functions {
+		f := &Function{
+			m:         m,
+			id:        e1.Id,
+			name:      e1.Name,
+			typ:       e1.Typ,
+			seq:       e1.Seq,
+			vseq:      e1.VSeq,
+			en:        e1.En,
+			blocks:    make([]*Block, 0, len(e1.Blocks)),
+			params:    make([]*Param, 0, len(e1.Params)),
+			variables: make([]*DeclareInstruction, 0, len(e1.Locals)),
+		}
+		for _, e2 := range e1.Blocks {
+			b := &Block{
+				f:            f,
+				id:           e2.Id,
+				instructions: make([]Value, 0, len(e2.Instructions)),
+			}
+			f.blocks = append(f.blocks, b)
+			bmap[b.id] = b
+		}
+		m.functions = append(m.functions, f)
+		m.fmap[f.name] = f
+		fmap[f.id] = f
+		lvals[f] = make(map[int]Value)
+	}
+
+	// Constants are shared between the Module and the Function bodies.
+	for _, e1 := range em.Constants {
+		c := decodeConstant(e1)
+		c.data = c
+		m.cmap[c.key()] = c
+		if b, ok := bmap[e1.Block]; ok {
+			c.b = b
+			lvals[b.f][c.id] = c
+		}
+		m.constants = append(m.constants, c)
+	}
+
+	for i1, e1 := range em.Functions {
+		if err := decodeFunction(m.functions[i1], e1, lvals[m.functions[i1]], mvals, fmap, bmap); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// encodeFunction returns the serialized form of Function f.
+func encodeFunction(f *Function) (encFunction, error) {
+	ef := encFunction{
+		Id:     f.id,
+		Name:   f.name,
+		Typ:    f.typ,
+		Seq:    f.seq,
+		VSeq:   f.vseq,
+		En:     f.en,
+		Params: make([]encValue, 0, len(f.params)),
+		Locals: make([]encValue, 0, len(f.variables)),
+		Blocks: make([]encBlock, 0, len(f.blocks)),
+	}
+	for _, e1 := range f.params {
+		ef.Params = append(ef.Params, encValue{Kind: encParam, Id: e1.id, Name: e1.name, Typ: e1.typ, En: e1.en,
+			Loc: e1.loc})
+	}
+	for _, e1 := range f.variables {
+		ev := encValue{Kind: encDeclare, Id: e1.id, Name: e1.name, Typ: e1.typ, Seq: e1.seq, Block: noBlock, En: e1.en,
+			Loc: e1.loc}
+		if e1.b != nil {
+			ev.Block = e1.b.id
+		}
+		ef.Locals = append(ef.Locals, ev)
+	}
+	for _, e1 := range f.blocks {
+		eb := encBlock{
+			Id:           e1.id,
+			Instructions: make([]encValue, 0, len(e1.instructions)),
+		}
+		for _, e2 := range e1.instructions {
+			ev, err := encodeInstruction(e2)
+			if err != nil {
+				return ef, fmt.Errorf("could not encode function %s: %s", f.name, err)
+			}
+			eb.Instructions = append(eb.Instructions, ev)
+		}
+		ef.Blocks = append(ef.Blocks, eb)
+	}
+	return ef, nil
+}
+
+// encodeInstruction returns the serialized form of the instruction v.
+func encodeInstruction(v Value) (encValue, error) {
+	ev := encValue{Id: v.Id(), Typ: v.DataType(), Thn: noBlock, Els: noBlock, Block: noBlock, En: v.IsEnabled(),
+		Loc: v.Location()}
+	switch inst := v.(type) {
+	case *DataInstruction:
+		ev.Kind = encData
+		ev.Op = int(inst.op)
+		ev.Ops = encodeRefs(inst.op1, inst.op2)
+	case *LoadInstruction:
+		ev.Kind = encLoad
+		ev.Ops = encodeRefs(inst.src)
+	case *StoreInstruction:
+		ev.Kind = encStore
+		ev.Ops = encodeRefs(inst.src, inst.dst)
+	case *Constant:
+		// Data entries are encoded with the Module's constants. Identical constants refer to the data entry by value.
+		ev.Kind = encConstant
+		ev.Name = inst.name
+		ev.Seq = inst.lseq
+		if inst.typ == types.Int {
+			ev.Int = inst.val.(int)
+		} else {
+			ev.Float = inst.val.(float64)
+		}
+	case *BranchInstruction:
+		ev.Kind = encBranch
+		ev.Op = int(inst.op)
+		ev.Ops = encodeRefs(inst.op1, inst.op2)
+		ev.Thn = inst.thn.id
+		if inst.els != nil {
+			ev.Els = inst.els.id
+		}
+	case *ReturnInstruction:
+		ev.Kind = encReturn
+		ev.Ops = encodeRefs(inst.val)
+	case *FunctionCallInstruction:
+		ev.Kind = encCall
+		ev.Func = inst.target.id
+		ev.Ops = encodeRefs(inst.arguments...)
+	case *CastInstruction:
+		ev.Kind = encCast
+		ev.Ops = encodeRefs(inst.src)
+	case *PreserveInstruction:
+		ev.Kind = encPreserve
+		ev.Ops = encodeRefs(inst.src)
+	case *VaList:
+		ev.Kind = encVaList
+		ev.Ops = encodeRefs(inst.vars...)
+	case *PhiInstruction:
+		ev.Kind = encPhi
+		ev.Blocks = make([]int, len(inst.incoming))
+		ops := make([]Value, len(inst.incoming))
+		for i1, e1 := range inst.incoming {
+			ops[i1] = e1.val
+			ev.Blocks[i1] = e1.b.id
+		}
+		ev.Ops = encodeRefs(ops...)
+	case *PrintInstruction:
+		ev.Kind = encPrint
+		ev.Ops = encodeRefs(inst.val)
+	case *SelectInstruction:
+		ev.Kind = encSelect
+		ev.Op = int(inst.op)
+		ev.Ops = encodeRefs(inst.op1, inst.op2, inst.tval, inst.fval)
+	default:
+		return ev, fmt.Errorf("cannot encode instruction %s of type %T", v.Name(), v)
+	}
+	return ev, nil
+}
+
+// encodeRefs returns the serialized references to the Values vals.
+func encodeRefs(vals ...Value) []encRef {
+	res := make([]encRef, len(vals))
+	for i1, e1 := range vals {
+		switch e1.(type) {
+		case nil:
+			res[i1] = encRef{Scope: scopeNone}
+		case *Global, *String:
+			res[i1] = encRef{Scope: scopeModule, Id: e1.Id()}
+		default:
+			res[i1] = encRef{Scope: scopeLocal, Id: e1.Id()}
+		}
+	}
+	return res
+}
+
+// decodeFunction restores the parameters, local variables and instructions of Function f from ef. The map lvals
+// holds the Function's already decoded constants.
+func decodeFunction(f *Function, ef encFunction, lvals, mvals map[int]Value, fmap map[int]*Function,
+	bmap map[int]*Block) error {
+	for _, e1 := range ef.Params {
+		p := &Param{f: f, id: e1.Id, name: e1.Name, typ: e1.Typ, en: e1.En}
+		p.loc = e1.Loc
+		f.params = append(f.params, p)
+		lvals[p.id] = p
+	}
+	for _, e1 := range ef.Locals {
+		d := &DeclareInstruction{id: e1.Id, name: e1.Name, typ: e1.Typ, seq: e1.Seq, en: e1.En}
+		d.loc = e1.Loc
+		if e1.Block != noBlock {
+			d.b = bmap[e1.Block]
+		}
+		f.variables = append(f.variables, d)
+		lvals[d.id] = d
+	}
+
+	// First pass creates the instructions, such that operands may reference instructions that appear later.
+	for i1, e1 := range ef.Blocks {
+		b := f.blocks[i1]
+		for _, e2 := range e1.Instructions {
+			var v Value
+			switch e2.Kind {
+			case encData:
+				v = &DataInstruction{b: b, id: e2.Id, op: types.ArithmeticOperation(e2.Op), en: e2.En}
+			case encLoad:
+				v = &LoadInstruction{b: b, id: e2.Id, en: e2.En}
+			case encStore:
+				v = &StoreInstruction{b: b, id: e2.Id, en: e2.En}
+			case encConstant:
+				if c, ok := lvals[e2.Id]; ok {
+					v = c
+					break
+				}
+				c := decodeConstant(e2)
+				c.b = b
+				if c.data = f.m.cmap[c.key()]; c.data == nil {
+					return fmt.Errorf("could not decode function %s: constant %s has no data entry", f.name, c.name)
+				}
+				v = c
+			case encBranch:
+				v = &BranchInstruction{b: b, id: e2.Id, op: types.RelationalOperation(e2.Op), en: e2.En}
+			case encReturn:
+				v = &ReturnInstruction{b: b, id: e2.Id, en: e2.En}
+			case encCall:
+				v = &FunctionCallInstruction{b: b, id: e2.Id, en: e2.En}
+			case encCast:
+				v = &CastInstruction{b: b, id: e2.Id, typ: e2.Typ, en: e2.En}
+			case encPreserve:
+				v = &PreserveInstruction{b: b, id: e2.Id, en: e2.En}
+			case encVaList:
+				v = &VaList{b: b, id: e2.Id, en: e2.En}
+			case encPhi:
+				v = &PhiInstruction{b: b, id: e2.Id, typ: e2.Typ, en: e2.En}
+			case encPrint:
+				v = &PrintInstruction{b: b, id: e2.Id, en: e2.En}
+			case encSelect:
+				v = &SelectInstruction{b: b, id: e2.Id, typ: e2.Typ, op: types.RelationalOperation(e2.Op), en: e2.En}
+			default:
+				return fmt.Errorf("could not decode function %s: unexpected instruction kind %d", f.name, e2.Kind)
+			}
+			v.SetLocation(e2.Loc)
+			b.instructions = append(b.instructions, v)
+			lvals[e2.Id] = v
+		}
+	}
+
+	// Second pass resolves operands and control flow edges.
+	for i1, e1 := range ef.Blocks {
+		b := f.blocks[i1]
+		for i2, e2 := range e1.Instructions {
+			ops := make([]Value, len(e2.Ops))
+			for i3, e3 := range e2.Ops {
+				switch e3.Scope {
+				case scopeLocal:
+					ops[i3] = lvals[e3.Id]
+				case scopeModule:
+					ops[i3] = mvals[e3.Id]
+				}
+				if e3.Scope != scopeNone && ops[i3] == nil {
+					return fmt.Errorf("could not decode function %s: unknown operand %d of instruction %d",
+						f.name, e3.Id, e2.Id)
+				}
+			}
+			get := func(i int) Value {
+				if i < len(ops) {
+					return ops[i]
+				}
+				return nil
+			}
+			switch inst := b.instructions[i2].(type) {
+			case *DataInstruction:
+				inst.op1, inst.op2 = get(0), get(1)
+			case *LoadInstruction:
+				inst.src = get(0)
+			case *StoreInstruction:
+				inst.src, inst.dst = get(0), get(1)
+			case *BranchInstruction:
+				inst.op1, inst.op2 = get(0), get(1)
+				inst.thn = bmap[e2.Thn]
+				if inst.thn == nil {
+					return fmt.Errorf("could not decode function %s: unknown branch target %d", f.name, e2.Thn)
+				}
+				b.link(inst.thn)
+				if e2.Els != noBlock {
+					inst.els = bmap[e2.Els]
+					if inst.els == nil {
+						return fmt.Errorf("could not decode function %s: unknown branch target %d", f.name, e2.Els)
+					}
+					b.link(inst.els)
+				}
+				b.term = inst
+			case *ReturnInstruction:
+				inst.val = get(0)
+				b.term = inst
+			case *FunctionCallInstruction:
+				inst.target = fmap[e2.Func]
+				if inst.target == nil {
+					return fmt.Errorf("could not decode function %s: unknown call target %d", f.name, e2.Func)
+				}
+				inst.arguments = ops
+			case *CastInstruction:
+				inst.src = get(0)
+			case *PreserveInstruction:
+				inst.src = get(0)
+			case *VaList:
+				inst.vars = ops
+			case *PhiInstruction:
+				for i3, e3 := range ops {
+					pb := bmap[e2.Blocks[i3]]
+					if pb == nil {
+						return fmt.Errorf("could not decode function %s: unknown phi predecessor %d",
+							f.name, e2.Blocks[i3])
+					}
+					inst.incoming = append(inst.incoming, phiEdge{val: e3, b: pb})
+				}
+			case *PrintInstruction:
+				inst.val = get(0)
+			case *SelectInstruction:
+				inst.op1, inst.op2, inst.tval, inst.fval = get(0), get(1), get(2), get(3)
+			}
+			use(b.instructions[i2])
+		}
+	}
+	return nil
+}
+
+// decodeConstant returns the Constant encoded by ev. The Constant is not linked to a Block or a data entry.
+func decodeConstant(ev encValue) *Constant {
+	c := &Constant{id: ev.Id, name: ev.Name, typ: ev.Typ, lseq: ev.Seq, used: ev.Used, en: ev.En}
+	if ev.Typ == types.Int {
+		c.val = ev.Int
+	} else {
+		c.val = ev.Float
+	}
+	return c
+}
@@ -0,0 +1,63 @@
+package lir
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"vslc/src/frontend"
+	"vslc/src/ir"
+	"vslc/src/util"
+)
+
+// samples matches the bundled typed VSL source files.
+const samples = "../../../resources/vsl_typed/*.vsl"
+
+// genSample parses, optimises and generates the LIR Module of the VSL source file opt.Src.
+func genSample(t *testing.T, opt util.Options) *Module {
+	src, err := util.ReadSource(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := frontend.Parse(src)
+	if err != nil {
+		t.Fatalf("%s: %s", opt.Src, err)
+	}
+	if err := ir.Optimise(opt, root); err != nil {
+		t.Fatalf("%s: %s", opt.Src, err)
+	}
+	m, err := GenLIR(opt, root)
+	if err != nil {
+		t.Fatalf("%s: %s", opt.Src, err)
+	}
+	return m
+}
+
+// TestEncodeDecode verifies that Modules generated from the bundled typed VSL source files, both with and without
+// SSA promotion, are restored identically by Decode.
+func TestEncodeDecode(t *testing.T) {
+	files, err := filepath.Glob(samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e1 := range files {
+		for _, ssa := range []bool{false, true} {
+			opt := util.Options{Src: e1, Threads: 1}
+			m := genSample(t, opt)
+			if ssa {
+				Mem2Reg(opt, m)
+			}
+
+			buf := bytes.Buffer{}
+			if err := m.Encode(&buf); err != nil {
+				t.Fatalf("%s: %s", e1, err)
+			}
+			d, err := Decode(&buf)
+			if err != nil {
+				t.Fatalf("%s: %s", e1, err)
+			}
+			if exp, got := m.String(), d.String(); exp != got {
+				t.Errorf("%s: decoded module differs:\nexpected:\n%s\ngot:\n%s", e1, exp, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package lir
+
+import (
+	"fmt"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// LowerFloat replaces floating point arithmetic, casts and comparisons in all Functions of Module m by calls to the
+// soft-float routines of libgcc, for targets without floating point hardware. Floats are single precision on 32-bit
+// targets and double precision otherwise, like the RISC-V backend computes them. The parameter opt.Threads is the
+// maximum number of threads allowed to run in parallel.
+func LowerFloat(opt util.Options, m *Module) {
+	single := singleFloat(opt)
+	forEachFunction(opt, m, func(f *Function) {
+		f.LowerFloat(single)
+	})
+}
+
+// singleFloat returns true if the soft-float routines of the target architecture opt.TargetArch compute in single
+// precision.
+func singleFloat(opt util.Options) bool {
+	return opt.TargetArch == util.X86_32 || opt.TargetArch == util.Riscv32 || opt.TargetArch == util.Armv7
+}
+
+// LowerFloat replaces the floating point instructions of Function f by calls to soft-float routines, which compute
+// in single precision if single is true, and in double precision otherwise. Floats are rounded to the nearest integer
+// by lrintf or lrint of the C math library, like the hardware conversion. Comparisons call the libgcc routine of the
+// branch's operator, whose result is compared to zero by the same operator.
+func (f *Function) LowerFloat(single bool) {
+	mode, imode, round := "df", "di", "lrint"
+	if single {
+		mode, imode, round = "sf", "si", "lrintf"
+	}
+	arith := func(name string, n int) *Function {
+		params := make([]types.DataType, n)
+		for i1 := range params {
+			params[i1] = types.Float
+		}
+		return f.m.declare(name, types.Float, params...)
+	}
+	for _, e1 := range f.blocks {
+		for i1 := 0; i1 < len(e1.instructions); i1++ {
+			switch inst := e1.instructions[i1].(type) {
+			case *DataInstruction:
+				if inst.DataType() != types.Float {
+					continue
+				}
+				var target *Function
+				switch {
+				case inst.op2 == nil:
+					target = arith(fmt.Sprintf("__neg%s2", mode), 1)
+				case inst.op == types.Add:
+					target = arith(fmt.Sprintf("__add%s3", mode), 2)
+				case inst.op == types.Sub:
+					target = arith(fmt.Sprintf("__sub%s3", mode), 2)
+				case inst.op == types.Mul:
+					target = arith(fmt.Sprintf("__mul%s3", mode), 2)
+				case inst.op == types.Div:
+					target = arith(fmt.Sprintf("__div%s3", mode), 2)
+				default:
+					panic(fmt.Sprintf("unexpected floating point operator %s", inst.op.String()))
+				}
+				args := []Value{inst.op1}
+				if inst.op2 != nil {
+					args = append(args, inst.op2)
+				}
+				i1 += e1.replaceByCall(i1, inst, target, args...)
+			case *CastInstruction:
+				var target *Function
+				if inst.typ == types.Float {
+					target = f.m.declare(fmt.Sprintf("__float%s%s", imode, mode), types.Float, types.Int)
+				} else {
+					target = f.m.declare(round, types.Int, types.Float)
+				}
+				i1 += e1.replaceByCall(i1, inst, target, inst.src)
+			case *ReturnInstruction:
+				// Return values of another type than the Function's are converted by the backends in hardware.
+				var target *Function
+				if typ := inst.val.DataType(); typ == f.typ {
+					continue
+				} else if typ == types.Int {
+					target = f.m.declare(fmt.Sprintf("__float%s%s", imode, mode), types.Float, types.Int)
+				} else {
+					target = f.m.declare(round, types.Int, types.Float)
+				}
+				conv := e1.insertCall(i1, inst.loc, target, inst.val)
+				replaceOperands(inst, map[Value]Value{inst.val: conv})
+				i1 += 2
+			case *BranchInstruction:
+				if inst.op1 == nil || inst.op1.DataType() != types.Float {
+					continue
+				}
+				var name string
+				switch inst.op {
+				case types.Eq:
+					name = "__eq%s2"
+				case types.Neq:
+					name = "__ne%s2"
+				case types.LessThan:
+					name = "__lt%s2"
+				case types.LessThanOrEqual:
+					name = "__le%s2"
+				case types.GreaterThan:
+					name = "__gt%s2"
+				default:
+					name = "__ge%s2"
+				}
+				target := f.m.declare(fmt.Sprintf(name, mode), types.Int, types.Float, types.Float)
+				cmp := e1.insertCall(i1, inst.loc, target, inst.op1, inst.op2)
+				zero := e1.newConstant(types.Int, 0)
+				zero.SetLocation(inst.loc)
+				e1.insert(i1+2, zero)
+				old := inst.op2
+				unuse(inst)
+				inst.op1, inst.op2 = cmp, zero
+				use(inst)
+				i1 += 3
+
+				// Remove the floating point zero that the comparison was made against, if it's no longer used.
+				if c, ok := old.(*Constant); ok && len(c.Users()) == 0 {
+					if idx := e1.indexOf(c); idx >= 0 {
+						e1.instructions = append(e1.instructions[:idx], e1.instructions[idx+1:]...)
+						if idx < i1 {
+							i1--
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// replaceByCall replaces the instruction inst at index idx of Block b by a call to the Function target with the
+// arguments args. Returns the number of instructions inserted before the instruction that followed inst.
+func (b *Block) replaceByCall(idx int, inst Value, target *Function, args ...Value) int {
+	res := b.insertCall(idx, inst.Location(), target, args...)
+	ReplaceAllUsesWith(inst, res)
+	unuse(inst)
+	b.instructions = append(b.instructions[:idx+2], b.instructions[idx+3:]...)
+	return 1
+}
+
+// insertCall inserts a call to the Function target with the arguments args, and the PreserveInstruction of its
+// result, before index idx of Block b. Both instructions get the source Location loc. Returns the
+// PreserveInstruction.
+func (b *Block) insertCall(idx int, loc Location, target *Function, args ...Value) *PreserveInstruction {
+	call := &FunctionCallInstruction{
+		b:         b,
+		id:        b.f.getId(),
+		target:    target,
+		arguments: args,
+		en:        true,
+	}
+	res := &PreserveInstruction{
+		b:   b,
+		id:  b.f.getId(),
+		src: call,
+		en:  true,
+	}
+	for i1, e1 := range []Value{call, res} {
+		e1.SetLocation(loc)
+		b.insert(idx+i1, e1)
+		use(e1)
+	}
+	return res
+}
@@ -0,0 +1,75 @@
+package lir
+
+import (
+	"testing"
+	"vslc/src/ir/lir/types"
+)
+
+// TestLowerFloat verifies that floating point arithmetic, casts, comparisons and returns of integers from float
+// functions are replaced by calls to the soft-float routines of the chosen precision, and that the floating point zero
+// of the comparison is removed.
+func TestLowerFloat(t *testing.T) {
+	tests := []struct {
+		single bool
+		calls  []string
+	}{
+		{true, []string{"__floatsisf", "__mulsf3", "__ltsf2", "__subsf3", "__floatsisf"}},
+		{false, []string{"__floatdidf", "__muldf3", "__ltdf2", "__subdf3", "__floatdidf"}},
+	}
+	for _, e1 := range tests {
+		m := CreateModule("test")
+		f := m.CreateFunction("f", types.Float)
+		a := f.CreateParam("a", types.Float)
+		b := f.CreateParam("b", types.Int)
+		entry := f.CreateBlock()
+		thn := f.CreateBlock()
+		els := f.CreateBlock()
+
+		// if a * b < 0.0 then return 0.0 - a * b else return 1
+		x := entry.CreateMul(entry.CreateLoad(a), entry.CreateLoad(b))
+		entry.CreateConditionalBranch(types.LessThan, x, entry.CreateConstantFloat(0.0), thn, els)
+		thn.CreateReturn(thn.CreateSub(thn.CreateConstantFloat(0.0), x))
+		els.CreateReturn(els.CreateConstantInt(1))
+
+		f.LowerFloat(e1.single)
+		calls := make([]string, 0, len(e1.calls))
+		for _, e2 := range f.Blocks() {
+			for _, e3 := range e2.Instructions() {
+				switch inst := e3.(type) {
+				case *FunctionCallInstruction:
+					calls = append(calls, inst.Target().Name())
+					if len(inst.Target().Blocks()) != 0 || m.GetFunction(inst.Target().Name()) != inst.Target() {
+						t.Errorf("single %t: %s is not declared by the module", e1.single, inst.Target().Name())
+					}
+				case *CastInstruction:
+					t.Errorf("single %t: unexpected cast %s", e1.single, inst.String())
+				case *DataInstruction:
+					if inst.DataType() == types.Float {
+						t.Errorf("single %t: unexpected float instruction %s", e1.single, inst.String())
+					}
+				case *Constant:
+					if inst.DataType() == types.Float && len(inst.Users()) == 0 {
+						t.Errorf("single %t: unused float constant %s", e1.single, inst.String())
+					}
+				case *BranchInstruction:
+					if inst.Operand1().DataType() != types.Int || inst.Operand2().(*Constant).Value() != 0 {
+						t.Errorf("single %t: expected compare of int with 0, got %s", e1.single, inst.String())
+					}
+				case *ReturnInstruction:
+					if inst.Operand1().DataType() != types.Float {
+						t.Errorf("single %t: expected float return value, got %s", e1.single, inst.String())
+					}
+				}
+			}
+		}
+		if len(calls) != len(e1.calls) {
+			t.Fatalf("single %t: expected calls %v, got %v:\n%s", e1.single, e1.calls, calls, f.String())
+		}
+		for i1, e2 := range e1.calls {
+			if calls[i1] != e2 {
+				t.Errorf("single %t: expected calls %v, got %v", e1.single, e1.calls, calls)
+				break
+			}
+		}
+	}
+}
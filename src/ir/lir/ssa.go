@@ -0,0 +1,545 @@
+package lir
+
+import (
+	"fmt"
+	"sync"
+	"vslc/src/ir/lir/types"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- Globals -----
+// -------------------
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Mem2Reg promotes the locally declared variables of all Functions of Module m to virtual registers, transforming
+// the Module into SSA form. The parameter opt.Threads is the maximum number of threads allowed to run in parallel.
+func Mem2Reg(opt util.Options, m *Module) {
+	forEachFunction(opt, m, (*Function).Mem2Reg)
+}
+
+// DestructSSA transforms all Functions of Module m out of SSA form, such that the native backends can generate code
+// for it. The parameter opt.Threads is the maximum number of threads allowed to run in parallel.
+func DestructSSA(opt util.Options, m *Module) {
+	forEachFunction(opt, m, (*Function).DestructSSA)
+}
+
+// Mem2Reg promotes the locally declared variables of Function f to virtual registers. Loads of a variable are
+// replaced by the reaching stored Value, and PhiInstructions are placed on the iterated dominance frontier of the
+// Blocks that store to the variable. Variables that are read before they are assigned evaluate to zero.
+func (f *Function) Mem2Reg() {
+	if len(f.blocks) < 1 || len(f.variables) < 1 {
+		return
+	}
+	f.removeUnreachable()
+	dt := f.Dominators()
+
+	// Find the Blocks that store to each variable.
+	defs := make(map[*DeclareInstruction][]*Block, len(f.variables))
+	for _, e1 := range f.blocks {
+		for _, e2 := range e1.instructions {
+			if st, ok := e2.(*StoreInstruction); ok {
+				if d, ok := st.dst.(*DeclareInstruction); ok {
+					if l := len(defs[d]); l == 0 || defs[d][l-1] != e1 {
+						defs[d] = append(defs[d], e1)
+					}
+				}
+			}
+		}
+	}
+
+	// Place phi instructions on the iterated dominance frontier of every variable's defining Blocks.
+	phis := make(map[*PhiInstruction]*DeclareInstruction)
+	for _, e1 := range f.variables {
+		placed := make(map[*Block]bool)
+		work := append(make([]*Block, 0, len(defs[e1])), defs[e1]...)
+		for len(work) > 0 {
+			b := work[len(work)-1]
+			work = work[:len(work)-1]
+			for _, e2 := range dt.Frontier(b) {
+				if !placed[e2] {
+					placed[e2] = true
+					phis[e2.CreatePhi(e1.typ)] = e1
+					work = append(work, e2)
+				}
+			}
+		}
+	}
+
+	// Rename variables by walking the dominator tree.
+	stacks := make(map[*DeclareInstruction][]Value, len(f.variables))
+	undef := make(map[*DeclareInstruction]*Constant)
+	repl := make(map[Value]Value)
+	dead := make(map[Value]bool)
+	top := func(d *DeclareInstruction) Value {
+		if s := stacks[d]; len(s) > 0 {
+			return s[len(s)-1]
+		}
+		if c, ok := undef[d]; ok {
+			return c
+		}
+		var c *Constant
+		if d.typ == types.Int {
+			c = f.blocks[0].newConstant(types.Int, 0)
+		} else {
+			c = f.blocks[0].newConstant(types.Float, 0.0)
+		}
+		undef[d] = c
+		return c
+	}
+	var rename func(b *Block)
+	rename = func(b *Block) {
+		pushed := make([]*DeclareInstruction, 0, len(f.variables))
+		for _, e1 := range b.instructions {
+			if p, ok := e1.(*PhiInstruction); ok {
+				if d, ok := phis[p]; ok {
+					stacks[d] = append(stacks[d], p)
+					pushed = append(pushed, d)
+				}
+				continue
+			}
+			replaceOperands(e1, repl)
+			switch inst := e1.(type) {
+			case *LoadInstruction:
+				if d, ok := inst.src.(*DeclareInstruction); ok {
+					repl[inst] = top(d)
+					dead[inst] = true
+				}
+			case *StoreInstruction:
+				if d, ok := inst.dst.(*DeclareInstruction); ok {
+					stacks[d] = append(stacks[d], inst.src)
+					pushed = append(pushed, d)
+					dead[inst] = true
+				}
+			}
+		}
+
+		// Fill in the phi instructions of successor Blocks.
+		for _, e1 := range b.succs {
+			for _, e2 := range e1.instructions {
+				p, ok := e2.(*PhiInstruction)
+				if !ok {
+					break
+				}
+				if d, ok := phis[p]; ok {
+					p.AddIncoming(top(d), b)
+				}
+			}
+		}
+
+		for _, e1 := range dt.Children(b) {
+			rename(e1)
+		}
+		for _, e1 := range pushed {
+			stacks[e1] = stacks[e1][:len(stacks[e1])-1]
+		}
+	}
+	rename(f.blocks[0])
+
+	// Remove promoted loads and stores.
+	for _, e1 := range f.blocks {
+		res := e1.instructions[:0]
+		for _, e2 := range e1.instructions {
+			if dead[e2] {
+				unuse(e2)
+			} else {
+				res = append(res, e2)
+			}
+		}
+		e1.instructions = res
+	}
+
+	// Materialise zero constants for variables that were read before being assigned.
+	for _, e1 := range f.variables {
+		if c, ok := undef[e1]; ok {
+			f.blocks[0].insert(0, c)
+		}
+	}
+
+	f.simplifyPhis()
+
+	// All local variables have been promoted.
+	f.variables = f.variables[:0]
+	f.vseq = 0
+}
+
+// DestructSSA transforms Function f out of SSA form. Every PhiInstruction is given a stack slot which is stored to
+// at the end of each predecessor Block and loaded at the position of the phi. Critical edges are split beforehand.
+// Virtual registers that are live across
+// function calls are demoted to stack slots as well, because the native backends treat all temporary registers as
+// clobbered by calls.
+func (f *Function) DestructSSA() {
+	if len(f.blocks) < 1 {
+		return
+	}
+
+	// Collect phi instructions before modifying Blocks.
+	phis := make([]*PhiInstruction, 0, fSize)
+	for _, e1 := range f.blocks {
+		for _, e2 := range e1.instructions {
+			if p, ok := e2.(*PhiInstruction); ok {
+				phis = append(phis, p)
+			}
+		}
+	}
+
+	// Stores of incoming Values must only execute on the edge to the phi instruction's Block.
+	if len(phis) > 0 {
+		f.SplitCriticalEdges()
+	}
+
+	// Lower phi instructions to stack slots.
+	repl := make(map[Value]Value, len(phis))
+	for _, e1 := range phis {
+		slot := f.createSlot(e1.typ)
+		for _, e2 := range e1.incoming {
+			st := &StoreInstruction{
+				b:   e2.b,
+				id:  f.getId(),
+				src: e2.val,
+				dst: slot,
+				en:  true,
+			}
+			st.loc = e1.loc
+			e2.b.insertBeforeTerminator(st)
+			use(st)
+		}
+		ld := &LoadInstruction{
+			b:   e1.b,
+			id:  f.getId(),
+			src: slot,
+			en:  true,
+		}
+		ld.loc = e1.loc
+		unuse(e1)
+		use(ld)
+		e1.b.instructions[e1.b.indexOf(e1)] = ld
+		repl[e1] = ld
+	}
+	if len(repl) > 0 {
+		for _, e1 := range f.blocks {
+			for _, e2 := range e1.instructions {
+				replaceOperands(e2, repl)
+			}
+		}
+	}
+
+	f.demoteCallCrossing()
+}
+
+// demoteCallCrossing stores virtual registers that are live across function calls in stack slots and re-loads them
+// before every use.
+func (f *Function) demoteCallCrossing() {
+	_, out, vals := liveSets(f)
+	cross := newBitset(len(vals))
+	live := newBitset(len(vals))
+	for _, e1 := range f.blocks {
+		live.set(out[e1])
+		for i1 := len(e1.instructions) - 1; i1 >= 0; i1-- {
+			v := e1.instructions[i1]
+			if v.Type() == types.FunctionCallInstruction {
+				// The result of the call isn't live across the call itself.
+				self := isRegister(v) && live.has(v.Id()) && !cross.has(v.Id())
+				cross.union(live)
+				if self {
+					cross.remove(v.Id())
+				}
+			}
+			if isRegister(v) {
+				live.remove(v.Id())
+			}
+			for _, e2 := range operands(v) {
+				if isRegister(e2) {
+					live.add(e2.Id())
+				}
+			}
+		}
+	}
+	for _, e1 := range setValues(cross, vals) {
+		f.demote(e1)
+	}
+}
+
+// demote stores the virtual register v to a new stack slot immediately after its definition, and loads the value
+// from the slot before every use. String addresses are re-loaded from their global instead.
+func (f *Function) demote(v Value) {
+	var slot *DeclareInstruction
+	var st *StoreInstruction
+	if v.DataType() != types.String {
+		slot = f.createSlot(v.DataType())
+	}
+	for _, e1 := range f.blocks {
+		for i1 := 0; i1 < len(e1.instructions); i1++ {
+			e2 := e1.instructions[i1]
+			if e2 == v && slot != nil {
+				st = &StoreInstruction{
+					b:   e1,
+					id:  f.getId(),
+					src: v,
+					dst: slot,
+					en:  true,
+				}
+				st.SetLocation(v.Location())
+				e1.insert(i1+1, st)
+				use(st)
+				i1++
+				continue
+			}
+			if e2 == st {
+				continue
+			}
+			uses := false
+			for _, e3 := range operands(e2) {
+				if e3 == v {
+					uses = true
+					break
+				}
+			}
+			if !uses {
+				continue
+			}
+			ld := &LoadInstruction{
+				b:  e1,
+				id: f.getId(),
+				en: true,
+			}
+			if slot != nil {
+				ld.src = slot
+			} else {
+				ld.src = v.Operand1()
+			}
+			ld.loc = e2.Location()
+			e1.insert(i1, ld)
+			use(ld)
+			replaceOperands(e2, map[Value]Value{v: ld})
+			i1++
+		}
+	}
+}
+
+// simplifyPhis removes PhiInstructions that are never used, and replaces PhiInstructions that only select a single
+// Value with that Value.
+func (f *Function) simplifyPhis() {
+	for changed := true; changed; {
+		changed = false
+		repl := make(map[Value]Value)
+		used := make(map[Value]bool)
+		for _, e1 := range f.blocks {
+			for _, e2 := range e1.instructions {
+				for _, e3 := range operands(e2) {
+					if e3 != e2 {
+						used[e3] = true
+					}
+				}
+			}
+		}
+		for _, e1 := range f.blocks {
+			res := e1.instructions[:0]
+			for _, e2 := range e1.instructions {
+				if p, ok := e2.(*PhiInstruction); ok {
+					if !used[p] {
+						unuse(p)
+						changed = true
+						continue
+					}
+					var same Value
+					trivial := true
+					for _, e3 := range p.incoming {
+						if e3.val == p || e3.val == same {
+							continue
+						}
+						if same != nil {
+							trivial = false
+							break
+						}
+						same = e3.val
+					}
+					if trivial && same != nil {
+						unuse(p)
+						repl[p] = same
+						changed = true
+						continue
+					}
+				}
+				res = append(res, e2)
+			}
+			e1.instructions = res
+		}
+		if len(repl) > 0 {
+			// Resolve chains of replaced phi instructions.
+			for k, v := range repl {
+				for r, ok := repl[v]; ok; r, ok = repl[v] {
+					v = r
+				}
+				repl[k] = v
+			}
+			for _, e1 := range f.blocks {
+				for _, e2 := range e1.instructions {
+					replaceOperands(e2, repl)
+				}
+			}
+		}
+	}
+}
+
+// createSlot declares a compiler generated local variable of data type typ in Function f.
+func (f *Function) createSlot(typ types.DataType) *DeclareInstruction {
+	inst := &DeclareInstruction{
+		b:   f.blocks[0],
+		id:  f.getId(),
+		seq: f.getVSeq(),
+		typ: typ,
+		en:  true,
+	}
+	inst.name = fmt.Sprintf("%s%d", labelDeclare, inst.id)
+	f.variables = append(f.variables, inst)
+	return inst
+}
+
+// operands returns the Values read by instruction v. Memory operands, such as the variable of a load or store, are
+// included.
+func operands(v Value) []Value {
+	switch inst := v.(type) {
+	case *FunctionCallInstruction:
+		return inst.arguments
+	case *VaList:
+		return inst.vars
+	case *PhiInstruction:
+		res := make([]Value, len(inst.incoming))
+		for i1, e1 := range inst.incoming {
+			res[i1] = e1.val
+		}
+		return res
+	case *SelectInstruction:
+		return []Value{inst.op1, inst.op2, inst.tval, inst.fval}
+	}
+	res := make([]Value, 0, 2)
+	if op1 := v.Operand1(); op1 != nil {
+		res = append(res, op1)
+		if op2 := v.Operand2(); op2 != nil {
+			res = append(res, op2)
+		}
+	}
+	return res
+}
+
+// replaceOperands replaces the operands of instruction v that are keys in repl with their mapped Value.
+func replaceOperands(v Value, repl map[Value]Value) {
+	get := func(op Value) Value {
+		if op == nil {
+			return nil
+		}
+		if r, ok := repl[op]; ok {
+			return r
+		}
+		return op
+	}
+	unuse(v)
+	defer use(v)
+	switch inst := v.(type) {
+	case *DataInstruction:
+		inst.op1 = get(inst.op1)
+		inst.op2 = get(inst.op2)
+	case *CastInstruction:
+		inst.src = get(inst.src)
+	case *PreserveInstruction:
+		inst.src = get(inst.src)
+	case *LoadInstruction:
+		inst.src = get(inst.src)
+	case *StoreInstruction:
+		inst.src = get(inst.src)
+		inst.dst = get(inst.dst)
+	case *BranchInstruction:
+		inst.op1 = get(inst.op1)
+		inst.op2 = get(inst.op2)
+	case *ReturnInstruction:
+		inst.val = get(inst.val)
+	case *FunctionCallInstruction:
+		for i1, e1 := range inst.arguments {
+			inst.arguments[i1] = get(e1)
+		}
+	case *VaList:
+		for i1, e1 := range inst.vars {
+			inst.vars[i1] = get(e1)
+		}
+	case *PhiInstruction:
+		for i1, e1 := range inst.incoming {
+			inst.incoming[i1].val = get(e1.val)
+		}
+	case *SelectInstruction:
+		inst.op1 = get(inst.op1)
+		inst.op2 = get(inst.op2)
+		inst.tval = get(inst.tval)
+		inst.fval = get(inst.fval)
+	}
+}
+
+// isRegister returns true if instruction v defines a virtual register.
+func isRegister(v Value) bool {
+	switch v.Type() {
+	case types.DataInstruction, types.LoadInstruction, types.FunctionCallInstruction, types.CastInstruction,
+		types.PreserveInstruction, types.PhiInstruction, types.SelectInstruction:
+		return true
+	case types.Constant:
+		return !v.(*Constant).Immediate()
+	}
+	return false
+}
+
+// forEachFunction calls fn for every Function of Module m, using at most opt.Threads worker go routines.
+func forEachFunction(opt util.Options, m *Module, fn func(f *Function)) {
+	funcs := m.Functions()
+	if opt.Threads > 1 && len(funcs) > 1 {
+		// Parallel.
+		t := opt.Threads
+		l := len(funcs)
+		if t > l {
+			t = l
+		}
+		n := l / t
+		res := l % t
+
+		start := 0
+		end := n
+
+		wg := sync.WaitGroup{}
+
+		// Spawn t worker go routines.
+		wg.Add(t)
+		for i1 := 0; i1 < t; i1++ {
+			if i1 < res {
+				end++
+			}
+
+			// Spawn worker go routine.
+			go func(start, end int, wg *sync.WaitGroup) {
+				defer wg.Done()
+				for _, e2 := range funcs[start:end] {
+					fn(e2)
+				}
+			}(start, end, &wg)
+
+			start = end
+			end += n
+		}
+
+		// Wait for worker go routines to finish.
+		wg.Wait()
+	} else {
+		// Sequential.
+		for _, e1 := range funcs {
+			fn(e1)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package lir
+
+import (
+	"testing"
+	"vslc/src/ir/lir/types"
+)
+
+// createCounterLoop creates a Function that counts a local variable from 0 to 10 in a while loop and returns it.
+func createCounterLoop() *Function {
+	m := CreateModule("test")
+	f := m.CreateFunction("counter", types.Int)
+	entry := f.CreateBlock()
+	head := f.CreateBlock()
+	body := f.CreateBlock()
+	exit := f.CreateBlock()
+
+	x := entry.CreateDeclare("x", types.Int)
+	entry.CreateStore(entry.CreateConstantInt(0), x)
+	entry.CreateBranch(head)
+
+	head.CreateConditionalBranch(types.LessThan, head.CreateLoad(x), head.CreateConstantInt(10), body, exit)
+
+	body.CreateStore(body.CreateAdd(body.CreateLoad(x), body.CreateConstantInt(1)), x)
+	body.CreateBranch(head)
+
+	exit.CreateReturn(exit.CreateLoad(x))
+	return f
+}
+
+// TestMem2Reg verifies that local variables are promoted to virtual registers, with a phi instruction at the loop
+// head, and that DestructSSA lowers the phi instruction back to a stack slot.
+func TestMem2Reg(t *testing.T) {
+	f := createCounterLoop()
+	f.Mem2Reg()
+
+	if len(f.Locals()) != 0 {
+		t.Fatalf("expected 0 local variables after mem2reg, got %d", len(f.Locals()))
+	}
+	phis := 0
+	for _, e1 := range f.Blocks() {
+		for _, e2 := range e1.Instructions() {
+			switch e2.Type() {
+			case types.LoadInstruction, types.StoreInstruction:
+				t.Errorf("unexpected memory instruction after mem2reg: %s", e2.String())
+			case types.PhiInstruction:
+				phis++
+				if vals, _ := e2.(*PhiInstruction).Incoming(); len(vals) != 2 {
+					t.Errorf("expected 2 incoming values, got %d: %s", len(vals), e2.String())
+				}
+			}
+		}
+	}
+	if phis != 1 {
+		t.Fatalf("expected 1 phi instruction, got %d:\n%s", phis, f.String())
+	}
+
+	f.DestructSSA()
+	for _, e1 := range f.Blocks() {
+		for _, e2 := range e1.Instructions() {
+			if e2.Type() == types.PhiInstruction {
+				t.Errorf("unexpected phi instruction after DestructSSA: %s", e2.String())
+			}
+		}
+	}
+	if len(f.Locals()) != 1 {
+		t.Fatalf("expected 1 stack slot after DestructSSA, got %d", len(f.Locals()))
+	}
+}
@@ -11,11 +11,13 @@ import (
 
 // String defines an LIR String variable.
 type String struct {
-	m   *Module // m is the Module that owns this String.
-	id  int     // id is the unique identifier of the String variable.
-	val string  // val holds the value of the string constant.
-	hw  interface{}
-	en  bool // Set to true if instruction is enabled.
+	m        *Module // m is the Module that owns this String.
+	id       int     // id is the unique identifier of the String variable.
+	val      string  // val holds the value of the string constant.
+	hw       *LiveNode
+	en       bool // Set to true if instruction is enabled.
+	uses          // uses holds the instructions that use the String.
+	location      // location holds the source location of the String.
 }
 
 // StringPointer defines a word sized address pointer to a C-style null-terminated character array.
@@ -63,12 +65,12 @@ func (inst *String) String() string {
 }
 
 // SetHW panics for the String, because it's a memory value, not a virtual register.
-func (inst *String) SetHW(hw interface{}) {
+func (inst *String) SetHW(hw *LiveNode) {
 	inst.hw = hw
 }
 
 // GetHW returns <nil> for the String.
-func (inst *String) GetHW() interface{} {
+func (inst *String) GetHW() *LiveNode {
 	return inst.hw
 }
 
@@ -101,3 +103,17 @@ func (inst *String) IsEnabled() bool {
 func (inst *String) Value() string {
 	return inst.val
 }
+
+// addUser records a use of the String by instruction v. Functions may be built in parallel, so the Module is locked.
+func (inst *String) addUser(v Value) {
+	inst.m.Lock()
+	defer inst.m.Unlock()
+	inst.uses.addUser(v)
+}
+
+// removeUser removes a single use of the String by instruction v.
+func (inst *String) removeUser(v Value) {
+	inst.m.Lock()
+	defer inst.m.Unlock()
+	inst.uses.removeUser(v)
+}
@@ -0,0 +1,57 @@
+package lir
+
+import "vslc/src/ir/lir/types"
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// SymbolKind tells what an identifier of the source code refers to.
+type SymbolKind int
+
+// Symbol is an identifier of the source code, resolved by the symbol tables of LIR generation to the function or
+// variable it refers to, such that tools find the declaration and type of identifiers.
+type Symbol struct {
+	Name string         // Name is the identifier.
+	Kind SymbolKind     // Kind tells whether the identifier refers to a function, or to a variable and its scope.
+	Use  Location       // Use is the location of the identifier.
+	Def  Location       // Def is the location of the identifier of the declaration, which is Use for declarations.
+	Type types.DataType // Type is the data type of the variable, or the return type of the function.
+	Func *Function      // Func is the function referred to, or which declares the parameter or local variable.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+const (
+	SymbolFunction SymbolKind = iota // SymbolFunction is a function.
+	SymbolGlobal                     // SymbolGlobal is a global variable.
+	SymbolParam                      // SymbolParam is a function parameter.
+	SymbolLocal                      // SymbolLocal is a local variable.
+)
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Symbols returns the identifiers of the source code of Module m, in no particular order. Symbols are recorded when
+// LIR is generated from the syntax tree, hence there are none for LIR objects, and none following a type error. The
+// identifiers of function bodies are recorded by their Functions without locking, hence Symbols must not be called
+// while function bodies are generated.
+func (m *Module) Symbols() []Symbol {
+	m.Lock()
+	defer m.Unlock()
+	res := append([]Symbol(nil), m.symbols...)
+	for _, e1 := range m.functions {
+		res = append(res, e1.symbols...)
+	}
+	return res
+}
+
+// addSymbol records Symbol s of Module m.
+func (m *Module) addSymbol(s Symbol) {
+	m.Lock()
+	m.symbols = append(m.symbols, s)
+	m.Unlock()
+}
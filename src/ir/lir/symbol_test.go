@@ -0,0 +1,72 @@
+package lir
+
+import (
+	"fmt"
+	"testing"
+	"vslc/src/frontend"
+	tree "vslc/src/ir"
+	"vslc/src/util"
+)
+
+// TestSymbols verifies that identifiers are resolved to the declarations of their scope, also when the function
+// bodies are generated in parallel.
+func TestSymbols(t *testing.T) {
+	src := "var x int\n" +
+		"def f(a float) int\n" +
+		"begin\n" +
+		"\tvar x float\n" +
+		"\tx := a\n" +
+		"\tbegin\n" +
+		"\t\tvar a int\n" +
+		"\t\ta := g()\n" +
+		"\tend\n" +
+		"\treturn a\n" +
+		"end\n" +
+		"def g() int\n" +
+		"\treturn x\n"
+	for _, e1 := range []int{1, 4} {
+		testSymbols(t, src, util.Options{Threads: e1})
+	}
+}
+
+// testSymbols verifies the identifiers of the VSL source src, whose LIR is generated for opt.
+func testSymbols(t *testing.T, src string, opt util.Options) {
+	root, err := frontend.Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.Optimise(opt, root); err != nil {
+		t.Fatal(err)
+	}
+	m, err := GenLIR(opt, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := map[string]string{}
+	for _, e1 := range m.Symbols() {
+		res[fmt.Sprintf("%d:%d", e1.Use.Line, e1.Use.Pos)] = fmt.Sprintf("%s %d %d:%d %s", e1.Name, e1.Kind, e1.Def.Line, e1.Def.Pos, e1.Type)
+	}
+
+	exp := map[string]string{
+		"1:5":  "x 1 1:5 Int",   // Global variable.
+		"2:5":  "f 0 2:5 Int",   // Function.
+		"2:7":  "a 2 2:7 Float", // Parameter.
+		"4:6":  "x 3 4:6 Float", // Local variable, which hides the global.
+		"5:2":  "x 3 4:6 Float",
+		"5:7":  "a 2 2:7 Float",
+		"7:7":  "a 3 7:7 Int", // Local variable of the inner block, which hides the parameter.
+		"8:3":  "a 3 7:7 Int",
+		"8:8":  "g 0 12:5 Int",
+		"10:9": "a 2 2:7 Float",
+		"12:5": "g 0 12:5 Int",
+		"13:9": "x 1 1:5 Int",
+	}
+	for k, v := range exp {
+		if res[k] != v {
+			t.Errorf("threads %d, %s: expected %q, got %q", opt.Threads, k, v, res[k])
+		}
+	}
+	if len(res) != len(exp) {
+		t.Errorf("threads %d: expected %d symbols, got %d", opt.Threads, len(exp), len(res))
+	}
+}
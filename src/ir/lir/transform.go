@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
-	"sync"
 	tree "vslc/src/ir"
 	"vslc/src/ir/lir/types"
 	"vslc/src/util"
@@ -20,12 +19,18 @@ type funcWrapper struct {
 	entry *Function
 }
 
-// symTab is a symbol table that implements a hash map and a read/write mutex for thread safe access.
+// symTab is the symbol table of a local scope. It belongs to the function body being generated, and is only accessed by
+// the go routine generating it, hence it isn't locked.
 type symTab struct {
 	m map[string]Value
-	sync.RWMutex
 }
 
+// scopes is the stack of the local scopes of a function body, innermost last.
+type scopes []*symTab
+
+// loops is the stack of the head Blocks of the while statements around a statement, innermost last.
+type loops []*Block
+
 // ---------------------
 // ----- Constants -----
 // ---------------------
@@ -41,8 +46,14 @@ const mapSize = 16
 var reservedFunctionNames = []string{
 	"main",
 	"printf",
-	"atof",
-	"atoi",
+	"strtod",
+	"strtol",
+	"dprintf",
+	"abort",
+	LabelArgc,
+	LabelArgInt,
+	LabelArgFloat,
+	LabelArgString,
 }
 
 // ---------------------
@@ -51,155 +62,91 @@ var reservedFunctionNames = []string{
 
 // GenLIR generates lightweight intermediate representation from the syntax tree.
 func GenLIR(opt util.Options, root *tree.Node) (*Module, error) {
-	m := CreateModule(filepath.Base(opt.Src)) // The LIR module.
-	if opt.Threads > 1 {
-		// Parallel.
-		t := opt.Threads
-		l := len(root.Children)
-		if t > l {
-			t = l
-		}
-		n := l / t
-		res := l % t
-
-		start := 0
-		end := n
-
-		wg := sync.WaitGroup{}
-		wg.Add(t)
-
-		perr := util.NewPerror(t)
-
-		// funcs hold LIR function wrappers.
-		funcs := make([]funcWrapper, 0, t)
-
-		// cfunc recieves function wrappers from worker go routines.
-		cfuncs := make(chan []funcWrapper, t)
-
-		// Spawn t worker go routines.
-		for i1 := 0; i1 < t; i1++ {
-			if i1 < res {
-				// This worker go routine should perform one residual job.
-				end++
-			}
-
-			// Spawn go routine.
-			go func(start, end int, wg *sync.WaitGroup) {
-				defer wg.Done()
-				funcs := make([]funcWrapper, 0, end-start)
-				for _, e1 := range root.Children[start:end] {
-					if e1.Typ == tree.DECLARATION {
-						// Variable declaration.
-						if err := genDeclarationGlobal(e1, m); err != nil {
-							perr.Append(err)
-							continue
-						}
-					} else {
-						// Function declaration.
-						f, err := genFunctionHeader(e1, m)
-						if err != nil {
-							perr.Append(err)
-							continue
-						}
-						funcs = append(funcs, funcWrapper{
-							node:  e1,
-							entry: f,
-						})
-					}
-				}
-				cfuncs <- funcs
-			}(start, end, &wg)
+	return genModule(opt, CreateModule(filepath.Base(opt.Src)), root)
+}
 
-			start = end
-			end += n
+// GenHeaders declares the global variables and functions of the syntax tree root in a new Module, whose function
+// bodies are generated by GenFunction, such that the bodies may be generated one at a time. It returns the Module and
+// the Function declared by each global of root, which is nil for global variables.
+func GenHeaders(opt util.Options, root *tree.Node) (*Module, []*Function, error) {
+	m := CreateModule(filepath.Base(opt.Src))
+	m.intBits = opt.IntBits()
+	funcs, err := genHeaders(m, root)
+	if err != nil {
+		return nil, nil, err
+	}
+	m.seal()
+	res := make([]*Function, len(root.Children))
+	i1 := 0
+	for i2, e2 := range root.Children {
+		if i1 < len(funcs) && funcs[i1].node == e2 {
+			res[i2] = funcs[i1].entry
+			i1++
 		}
+	}
+	return m, res, nil
+}
 
-		// Wait for worker go routines.
-		wg.Wait()
-
-		// Check for errors.
-		if perr.Len() > 0 {
-			close(cfuncs)
-			for e1 := range perr.Errors() {
-				fmt.Println(e1)
-			}
-			return nil, fmt.Errorf("%d errors during parallel LIR generation", perr.Len())
-		}
-		perr.Flush()
+// GenFunction generates the body of Function f from the function n of the syntax tree, which was declared by
+// GenHeaders, and simplifies its control flow graph and promotes its local variables to virtual registers if opt.SSA
+// is set, like SimplifyCFG and Mem2Reg do for a Module.
+func GenFunction(opt util.Options, f *Function, n *tree.Node) error {
+	if err := genFunctionBody(n, f); err != nil {
+		return err
+	}
+	f.SimplifyCFG()
+	if opt.SSA {
+		f.Mem2Reg()
+	}
+	return nil
+}
 
-		// Retrieve all functions wrappers and append them to function definition slice.
-		close(cfuncs)
-		for e1 := range cfuncs {
-			funcs = append(funcs, e1...)
-		}
+// Lower lowers Function f for the target architecture of opt like IfConvert, LowerDivision and LowerFloat lower a
+// Module. Conditional assignments are converted to selects if sel is set, because the target has conditional selects.
+func Lower(opt util.Options, f *Function, sel bool) {
+	if sel {
+		f.IfConvert()
+	}
+	if wordSize := divisionWordSize(opt); wordSize > 0 {
+		f.LowerDivision(wordSize)
+	}
+	if opt.SoftFloat() {
+		f.LowerFloat(singleFloat(opt))
+	}
+}
 
-		// Generate LIR function bodies.
-		t = opt.Threads
-		l = len(funcs)
-		if t > l {
-			t = l
-		}
-		n = l / t
-		res = l % t
-
-		start = 0
-		end = n
-
-		// Spawn t worker go routines.
-		wg.Add(t)
-		for i1 := 0; i1 < t; i1++ {
-			if i1 < res {
-				end++
-			}
+// LowerModule lowers all Functions of Module m like Lower, using at most opt.Threads worker go routines. Conditional
+// assignments are converted to selects if sel is set.
+func LowerModule(opt util.Options, m *Module, sel bool) {
+	forEachFunction(opt, m, func(f *Function) {
+		Lower(opt, f, sel)
+	})
+}
 
-			// Spawn worker go routine.
-			go func(start, end int, wg *sync.WaitGroup) {
-				defer wg.Done()
-				for _, e2 := range funcs[start:end] {
-					if err := genFunctionBody(e2.node, e2.entry); err != nil {
-						perr.Append(err)
-					}
-				}
-			}(start, end, &wg)
-			start = end
-			end += n
-		}
+// genModule generates the global variables and functions of the syntax tree root in Module m. The global variables
+// and functions are declared one after another, and the Module is sealed before the function bodies are generated,
+// such that the function bodies look up globals without locking the Module.
+func genModule(opt util.Options, m *Module, root *tree.Node) (*Module, error) {
+	m.intBits = opt.IntBits()
+	funcs, err := genHeaders(m, root)
+	if err != nil {
+		return nil, err
+	}
+	m.seal()
 
-		// Wait for worker threads to finish,
-		wg.Wait()
+	if opt.Threads > 1 {
+		// Parallel.
+		pool := opt.NewPool("lir")
 
-		// Check for errors.
-		if perr.Len() > 0 {
-			for e1 := range perr.Errors() {
-				fmt.Println(e1)
-			}
-			return nil, fmt.Errorf("%d errors during parallel LIR generation", perr.Len())
+		// Generate LIR function bodies.
+		if err := pool.Run(opt.Context(), len(funcs), func(w *util.Worker, i int) error {
+			w.Log.Infof("generating function %s", funcs[i].entry.Name())
+			return genFunctionBody(funcs[i].node, funcs[i].entry)
+		}); err != nil {
+			return nil, err
 		}
-
-		perr.Stop()
 	} else {
 		// Sequential.
-		funcs := make([]funcWrapper, 0, len(root.Children))
-		for _, e1 := range root.Children {
-			if e1.Typ == tree.DECLARATION {
-				// Global variable declaration.
-				if err := genDeclarationGlobal(e1, m); err != nil {
-					return nil, err
-				}
-			} else {
-				// Function declaration.
-				f, err := genFunctionHeader(e1, m)
-				if err != nil {
-					return nil, err
-				}
-				funcs = append(funcs, funcWrapper{
-					node:  e1,
-					entry: f,
-				})
-			}
-		}
-
-		// Generate function bodies.
 		for _, e1 := range funcs {
 			if err := genFunctionBody(e1.node, e1.entry); err != nil {
 				return nil, err
@@ -209,18 +156,84 @@ func GenLIR(opt util.Options, root *tree.Node) (*Module, error) {
 	return m, nil
 }
 
+// genHeaders declares the global variables and functions of the syntax tree root in Module m, in the order they're
+// declared, and returns the functions with their Function headers.
+func genHeaders(m *Module, root *tree.Node) ([]funcWrapper, error) {
+	funcs := make([]funcWrapper, 0, len(root.Children))
+	for _, e1 := range root.Children {
+		if e1.Typ == tree.DECLARATION {
+			// Global variable declaration.
+			if err := genDeclarationGlobal(e1, m); err != nil {
+				return nil, err
+			}
+		} else {
+			// Function declaration.
+			f, err := genFunctionHeader(e1, m)
+			if err != nil {
+				return nil, err
+			}
+			funcs = append(funcs, funcWrapper{
+				node:  e1,
+				entry: f,
+			})
+		}
+	}
+	return funcs, nil
+}
+
+// nodeLocation returns the source Location of the ir.Node n.
+func nodeLocation(n *tree.Node) Location {
+	return Location{Line: n.Line, Pos: n.Pos}
+}
+
+// genSymbol records that the identifier n refers to the declaration at def, of the given kind and data type, in
+// Module m. f is the function referred to, or which declares the parameter or local variable.
+func genSymbol(m *Module, n *tree.Node, kind SymbolKind, def Location, typ types.DataType, f *Function) {
+	m.addSymbol(Symbol{Name: n.Data.Str, Kind: kind, Use: nodeLocation(n), Def: def, Type: typ, Func: f})
+}
+
+// genUse records that the identifier n in Block b refers to the declaration at def like genSymbol, in the Function of
+// b rather than its Module, such that function bodies record their identifiers without locking the Module.
+func genUse(b *Block, n *tree.Node, kind SymbolKind, def Location, typ types.DataType, f *Function) {
+	b.f.symbols = append(b.f.symbols,
+		Symbol{Name: n.Data.Str, Kind: kind, Use: nodeLocation(n), Def: def, Type: typ, Func: f})
+}
+
+// genReference records that the identifier n in Block b refers to the variable v, which is a local variable,
+// parameter or global variable.
+func genReference(b *Block, n *tree.Node, v Value) {
+	kind, f := SymbolLocal, b.f
+	switch v.Type() {
+	case types.Param:
+		kind = SymbolParam
+	case types.Global:
+		kind, f = SymbolGlobal, nil
+	}
+	genUse(b, n, kind, v.Location(), v.DataType(), f)
+}
+
 // genFunctionHeader generates a new Function in Module m from the ir.Node n.
 func genFunctionHeader(n *tree.Node, m *Module) (*Function, error) {
 	// Function's name.
-	name := n.Children[0].Data.(string)
+	name := n.Children[0].Data.Str
 	for _, e1 := range reservedFunctionNames {
 		if e1 == name {
 			return nil,
-				fmt.Errorf("line %d:%d: duplicate function name %q, %s is a reserved function name",
-					n.Children[0].Line, n.Children[0].Pos, name, name)
+				n.Children[0].TypeErrorf("duplicate function name %q, %s is a reserved function name", name, name)
 		}
 	}
 
+	// Check for duplicate declaration, such as of a function defined by a LIR object.
+	m.Lock()
+	_, dup := m.fmap[name]
+	if _, ok := m.gmap[name]; ok {
+		dup = true
+	}
+	m.Unlock()
+	if dup {
+		return nil, n.Children[0].TypeErrorf("duplicate declaration, global identifier %q already exists", name)
+	}
+
 	// Generate return data type.
 	ret, err := genType(n.Children[1])
 	if err != nil {
@@ -232,6 +245,8 @@ func genFunctionHeader(n *tree.Node, m *Module) (*Function, error) {
 	if err != nil {
 		return nil, err
 	}
+	f.decl = nodeLocation(n.Children[0])
+	genSymbol(m, n.Children[0], SymbolFunction, f.decl, ret, f)
 
 	// Generate function's parameters.
 	for _, e1 := range n.Children[2].Children {
@@ -244,13 +259,15 @@ func genFunctionHeader(n *tree.Node, m *Module) (*Function, error) {
 			// Integer parameter list.
 			for _, e2 := range e1.Children {
 				// Identifier names.
-				f.CreateParam(e2.Data.(string), types.Int)
+				f.CreateParam(e2.Data.Str, types.Int).SetLocation(nodeLocation(e2))
+				genSymbol(m, e2, SymbolParam, nodeLocation(e2), types.Int, f)
 			}
 		} else {
 			// Float parameter list.
 			for _, e2 := range e1.Children {
 				// Identifier names.
-				f.CreateParam(e2.Data.(string), types.Float)
+				f.CreateParam(e2.Data.Str, types.Float).SetLocation(nodeLocation(e2))
+				genSymbol(m, e2, SymbolParam, nodeLocation(e2), types.Float, f)
 			}
 		}
 	}
@@ -259,42 +276,42 @@ func genFunctionHeader(n *tree.Node, m *Module) (*Function, error) {
 
 // genFunctionBody recursively generates the instructions of the Function f starting at ir.Node n.
 func genFunctionBody(n *tree.Node, f *Function) error {
-	st := util.Stack{} // Scope stack.
-	ls := util.Stack{} // GlobalSeq stack for loops.
+	st := scopes{} // Scope stack.
+	ls := loops{}  // GlobalSeq stack for loops.
 
 	// Create new basic block for function body.
 	bb := f.CreateBlock()
 
 	// Generate function body recursively.
-	if _, err := gen(bb, n, &st, &ls); err != nil {
-		return err
-	}
-	return nil
+	_, err := gen(bb, n, &st, &ls)
+	f.SetLocation(Location{})
+	return err
 }
 
 // gen recursively generates LIR instructions in Block b. The returned Block is the block into which
 // the next sequential instructions is to be inserted.
-func gen(b *Block, n *tree.Node, st, ls *util.Stack) (*Block, error) {
+func gen(b *Block, n *tree.Node, st *scopes, ls *loops) (*Block, error) {
 	if b == nil {
-		return nil, fmt.Errorf("line %d:%d: unreacheable code",
-			n.Line, n.Pos)
+		return nil, n.TypeErrorf("unreacheable code")
+	}
+	if loc := nodeLocation(n); loc.IsKnown() {
+		b.f.SetLocation(loc)
 	}
 	var err error
 	switch n.Typ {
 	case tree.BLOCK:
 		// Add new scope.
-		st.Push(&symTab{
-			m:       make(map[string]Value, mapSize),
-			RWMutex: sync.RWMutex{},
+		st.push(&symTab{
+			m: make(map[string]Value, mapSize),
 		})
 		for _, e1 := range n.Children {
 			if b, err = gen(b, e1, st, ls); err != nil {
-				st.Pop()
+				st.pop()
 				return b, err
 			}
 		}
-		st.Pop()
-	case tree.PRINT_STATEMENT:
+		st.pop()
+	case tree.PRINT_STATEMENT, tree.PRINTF_STATEMENT:
 		if err := genPrint(b, n, st); err != nil {
 			return nil, err
 		}
@@ -342,20 +359,21 @@ func gen(b *Block, n *tree.Node, st, ls *util.Stack) (*Block, error) {
 
 // genDeclaration generates LIR instructions for declaring a local variable in the current scope of the
 // scope stack.
-func genDeclaration(b *Block, n *tree.Node, st *util.Stack) error {
+func genDeclaration(b *Block, n *tree.Node, st *scopes) error {
 	typ, err := genType(n)
 	if err != nil {
 		return err
 	}
-	if scope := st.Peek().(*symTab); scope != nil {
+	if scope := st.top(); scope != nil {
 		for _, e1 := range n.Children[0].Children {
-			name := e1.Data.(string)
+			name := e1.Data.Str
 			if _, ok := scope.m[name]; ok {
-				return fmt.Errorf("line %d:%d: duplicate variable declaration, %q is already declared in the same scope",
-					e1.Line, e1.Pos, name)
+				return e1.TypeErrorf("duplicate variable declaration, %q is already declared in the same scope", name)
 			}
+			b.f.SetLocation(nodeLocation(e1))
 			val := b.CreateDeclare(name, typ)
 			scope.m[name] = val
+			genUse(b, e1, SymbolLocal, nodeLocation(e1), typ, b.f)
 		}
 		return nil
 	}
@@ -370,44 +388,47 @@ func genDeclarationGlobal(n *tree.Node, m *Module) error {
 	}
 	for _, e1 := range n.Children[0].Children {
 		// Identifier names.
-		name := e1.Data.(string)
+		name := e1.Data.Str
 
 		// Check for duplicate declaration.
 		m.Lock()
-		if m.GetGlobalVariable(name) != nil {
+		if m.gmap[name] != nil || m.fmap[name] != nil {
 			m.Unlock()
 			return fmt.Errorf("duplicate declaration, global identifier %q already exists", name)
 		}
 		m.Unlock()
 
 		// Create global.
+		var g *Global
 		if typ == types.Int {
-			m.CreateGlobalInt(name)
+			g = m.CreateGlobalInt(name)
 		} else {
-			m.CreateGlobalFloat(name)
+			g = m.CreateGlobalFloat(name)
 		}
+		g.SetLocation(nodeLocation(e1))
+		genSymbol(m, e1, SymbolGlobal, nodeLocation(e1), typ, nil)
 	}
 	return nil
 }
 
 // genAssign creates LIR assignment procedure of value calculation and store instructions. An error is returned
 // if something went wrong.
-func genAssign(b *Block, n *tree.Node, st *util.Stack) error {
-	name := n.Children[0].Data.(string)
+func genAssign(b *Block, n *tree.Node, st *scopes) error {
+	name := n.Children[0]
 	c1 := n.Children[1]
 	switch c1.Typ {
 	case tree.INTEGER_DATA:
-		return genStore(name, b.CreateConstantInt(c1.Data.(int)), b, st)
+		return genStore(name, b.CreateConstantInt(c1.Data.Int), b, st)
 	case tree.FLOAT_DATA:
-		return genStore(name, b.CreateConstantFloat(c1.Data.(float64)), b, st)
+		return genStore(name, b.CreateConstantFloat(c1.Data.Float), b, st)
 	case tree.EXPRESSION:
-		if r, err := genExpression(b, c1, st); err != nil {
+		if r, err := genValue(b, c1, st, varType(name.Data.Str, b, st)); err != nil {
 			return err
 		} else {
 			return genStore(name, r, b, st)
 		}
 	case tree.IDENTIFIER_DATA:
-		if r, err := genLoad(c1.Data.(string), b, st); err != nil {
+		if r, err := genLoad(c1, b, st); err != nil {
 			return err
 		} else {
 			return genStore(name, r, b, st)
@@ -419,31 +440,34 @@ func genAssign(b *Block, n *tree.Node, st *util.Stack) error {
 
 // genExpression generates an LIR arithmetic expression defined by ir.Node n. An error is returned if something went
 // wrong.
-func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
+func genExpression(b *Block, n *tree.Node, st *scopes) (Value, error) {
 	c1 := n.Children[0]
 	var res Value
 
-	if n.Data == nil {
+	if n.Data.IsZero() {
 		// Function call.
-		name := c1.Data.(string)
+		name := c1.Data.Str
 		var target *Function
 
-		// Find function in module.
+		// Find function in module. The builtin functions are shadowed by VSL functions of the same name.
 		if target = b.f.m.GetFunction(name); target == nil {
-			return res, fmt.Errorf("undeclared function %q", name)
+			if builtin(b, n) {
+				return genBuiltin(b, n, st, types.Int)
+			}
+			return res, c1.TypeErrorf("undeclared function %q", name)
 		}
 
 		params := target.params
 		args := make([]Value, len(params))
 		if len(n.Children[1].Children) == 0 && len(params) != 0 {
-			return nil, fmt.Errorf("function %q expects %d parameters, got %d",
+			return nil, n.TypeErrorf("function %q expects %d parameters, got %d",
 				name, len(args), len(n.Children[1].Children))
 		}
 
 		if len(n.Children[1].Children) > 0 {
 			c2 := n.Children[1].Children[0] // tree.EXPRESSION_LIST. List with all arguments.
 			if len(args) != len(c2.Children) {
-				return nil, fmt.Errorf("function %q expects %d parameters, got %d",
+				return nil, n.TypeErrorf("function %q expects %d parameters, got %d",
 					name, len(args), len(c2.Children))
 			}
 
@@ -451,17 +475,17 @@ func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 				// Load argument.
 				switch e1.Typ {
 				case tree.INTEGER_DATA:
-					args[i1] = b.CreateConstantInt(e1.Data.(int))
+					args[i1] = b.CreateConstantInt(e1.Data.Int)
 				case tree.FLOAT_DATA:
-					args[i1] = b.CreateConstantFloat(e1.Data.(float64))
+					args[i1] = b.CreateConstantFloat(e1.Data.Float)
 				case tree.EXPRESSION:
-					if r, err := genExpression(b, e1, st); err != nil {
+					if r, err := genValue(b, e1, st, params[i1].DataType()); err != nil {
 						return nil, err
 					} else {
 						args[i1] = r
 					}
 				case tree.IDENTIFIER_DATA:
-					if r, err := genLoad(e1.Data.(string), b, st); err != nil {
+					if r, err := genLoad(e1, b, st); err != nil {
 						return nil, err
 					} else {
 						args[i1] = r
@@ -469,6 +493,7 @@ func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 				}
 			}
 		}
+		genUse(b, c1, SymbolFunction, target.decl, target.typ, target)
 		return b.CreateFunctionCall(target, args), nil
 	}
 	if len(n.Children) == 2 {
@@ -479,9 +504,9 @@ func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 		// Operand 1.
 		switch c1.Typ {
 		case tree.INTEGER_DATA:
-			op1 = b.CreateConstantInt(c1.Data.(int))
+			op1 = b.CreateConstantInt(c1.Data.Int)
 		case tree.FLOAT_DATA:
-			op1 = b.CreateConstantFloat(c1.Data.(float64))
+			op1 = b.CreateConstantFloat(c1.Data.Float)
 		case tree.EXPRESSION:
 			if r, err := genExpression(b, c1, st); err != nil {
 				return res, err
@@ -489,7 +514,7 @@ func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 				op1 = r
 			}
 		case tree.IDENTIFIER_DATA:
-			if r, err := genLoad(c1.Data.(string), b, st); err != nil {
+			if r, err := genLoad(c1, b, st); err != nil {
 				return res, err
 			} else {
 				op1 = r
@@ -499,9 +524,9 @@ func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 		// Operand 2.
 		switch c2.Typ {
 		case tree.INTEGER_DATA:
-			op2 = b.CreateConstantInt(c2.Data.(int))
+			op2 = b.CreateConstantInt(c2.Data.Int)
 		case tree.FLOAT_DATA:
-			op2 = b.CreateConstantFloat(c2.Data.(float64))
+			op2 = b.CreateConstantFloat(c2.Data.Float)
 		case tree.EXPRESSION:
 			if r, err := genExpression(b, c2, st); err != nil {
 				return res, err
@@ -509,7 +534,7 @@ func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 				op2 = r
 			}
 		case tree.IDENTIFIER_DATA:
-			if r, err := genLoad(c2.Data.(string), b, st); err != nil {
+			if r, err := genLoad(c2, b, st); err != nil {
 				return res, err
 			} else {
 				op2 = r
@@ -517,7 +542,7 @@ func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 		}
 
 		// Operator.
-		switch n.Data.(string) {
+		switch n.Data.Str {
 		case "+":
 			res = b.CreateAdd(op1, op2)
 		case "-":
@@ -531,6 +556,8 @@ func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 		case "<<":
 			res = b.CreateLShift(op1, op2)
 		case ">>":
+			res = b.CreateARShift(op1, op2)
+		case ">>>":
 			res = b.CreateRShift(op1, op2)
 		case "|":
 			res = b.CreateOr(op1, op2)
@@ -539,8 +566,7 @@ func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 		case "^":
 			res = b.CreateXor(op1, op2)
 		default:
-			return res, fmt.Errorf("line %d:%d: operator %q not defined for VSL",
-				n.Line, n.Pos, n.Data.(string))
+			return res, n.TypeErrorf("operator %q not defined for VSL", n.Data.Str)
 		}
 		return res, nil
 	} else {
@@ -550,9 +576,9 @@ func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 		// Operand 1.
 		switch c1.Typ {
 		case tree.INTEGER_DATA:
-			op1 = b.CreateConstantInt(c1.Data.(int))
+			op1 = b.CreateConstantInt(c1.Data.Int)
 		case tree.FLOAT_DATA:
-			op1 = b.CreateConstantFloat(c1.Data.(float64))
+			op1 = b.CreateConstantFloat(c1.Data.Float)
 		case tree.EXPRESSION:
 			if r, err := genExpression(b, c1, st); err != nil {
 				return nil, err
@@ -560,7 +586,7 @@ func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 				op1 = r
 			}
 		case tree.IDENTIFIER_DATA:
-			if r, err := genLoad(c1.Data.(string), b, st); err != nil {
+			if r, err := genLoad(c1, b, st); err != nil {
 				return res, err
 			} else {
 				op1 = r
@@ -568,36 +594,101 @@ func genExpression(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 		}
 
 		// Operator.
-		switch n.Data.(string) {
+		switch n.Data.Str {
 		case "-":
 			res = b.CreateSub(b.CreateConstantInt(0), op1)
 		case "~":
 			res = b.CreateXor(b.CreateConstantInt(^0), op1)
 		default:
-			return res, fmt.Errorf("line %d:%d: unsupported unary operator %q",
-				n.Line, n.Pos, n.Data.(string))
+			return res, n.TypeErrorf("unsupported unary operator %q", n.Data.Str)
 		}
 		return res, nil
 	}
 }
 
+// genValue generates the LIR value of the expression n like genExpression, where a call to the builtin function arg
+// returns the program argument as the data type typ of the context of the expression, such as the variable assigned
+// to or the parameter passed to.
+func genValue(b *Block, n *tree.Node, st *scopes, typ types.DataType) (Value, error) {
+	if builtin(b, n) {
+		return genBuiltin(b, n, st, typ)
+	}
+	return genExpression(b, n, st)
+}
+
+// builtin returns true if the expression n calls one of the builtin functions argc and arg, which isn't shadowed by a
+// VSL function of the same name.
+func builtin(b *Block, n *tree.Node) bool {
+	if !n.Data.IsZero() || n.Typ != tree.EXPRESSION {
+		return false
+	}
+	name := n.Children[0].Data.Str
+	return (name == builtinArgc || name == builtinArg) && b.f.m.GetFunction(name) == nil
+}
+
+// genBuiltin generates the call n to the builtin function argc, or to the builtin function arg, which returns the
+// program argument as the data type typ. The argument of arg is converted to an integer, and arguments of any other
+// data type than int and float are returned as strings. An error is returned if something went wrong.
+func genBuiltin(b *Block, n *tree.Node, st *scopes, typ types.DataType) (Value, error) {
+	c1 := n.Children[0]
+	var args []*tree.Node
+	if len(n.Children[1].Children) > 0 {
+		args = n.Children[1].Children[0].Children
+	}
+	if c1.Data.Str == builtinArgc {
+		if len(args) != 0 {
+			return nil, n.TypeErrorf("function %q expects 0 parameters, got %d", c1.Data.Str, len(args))
+		}
+		return b.CreateArgc(), nil
+	}
+	if len(args) != 1 {
+		return nil, n.TypeErrorf("function %q expects 1 parameter, got %d", c1.Data.Str, len(args))
+	}
+	var i Value
+	switch e1 := args[0]; e1.Typ {
+	case tree.INTEGER_DATA:
+		i = b.CreateConstantInt(e1.Data.Int)
+	case tree.FLOAT_DATA:
+		i = b.CreateConstantFloat(e1.Data.Float)
+	case tree.EXPRESSION:
+		r, err := genExpression(b, e1, st)
+		if err != nil {
+			return nil, err
+		}
+		i = r
+	case tree.IDENTIFIER_DATA:
+		r, err := genLoad(e1, b, st)
+		if err != nil {
+			return nil, err
+		}
+		i = r
+	}
+	if i.DataType() != types.Int {
+		i = b.CreateFloatToInt(i)
+	}
+	if typ != types.Int && typ != types.Float {
+		typ = types.String
+	}
+	return b.CreateArg(i, typ), nil
+}
+
 // genReturn generates an LIR return statement with the return value being generated recursively from ir.Node n's
 // children. An error is returned if something went wrong.
-func genReturn(b *Block, n *tree.Node, st *util.Stack) error {
+func genReturn(b *Block, n *tree.Node, st *scopes) error {
 	c1 := n.Children[0]
 	switch c1.Typ {
 	case tree.INTEGER_DATA:
-		b.CreateReturn(b.CreateConstantInt(c1.Data.(int)))
+		b.CreateReturn(b.CreateConstantInt(c1.Data.Int))
 	case tree.FLOAT_DATA:
-		b.CreateReturn(b.CreateConstantFloat(c1.Data.(float64)))
+		b.CreateReturn(b.CreateConstantFloat(c1.Data.Float))
 	case tree.EXPRESSION:
-		if r, err := genExpression(b, c1, st); err != nil {
+		if r, err := genValue(b, c1, st, b.f.DataType()); err != nil {
 			return err
 		} else {
 			b.CreateReturn(r)
 		}
 	case tree.IDENTIFIER_DATA:
-		if r, err := genLoad(c1.Data.(string), b, st); err != nil {
+		if r, err := genLoad(c1, b, st); err != nil {
 			return err
 		} else {
 			b.CreateReturn(r)
@@ -609,7 +700,7 @@ func genReturn(b *Block, n *tree.Node, st *util.Stack) error {
 // genRelation generates a LIR arithmetic relation. The relation loads both operands into virtual registers and performs
 // an arithmetic subtraction and returns the result in a new virtual register. An error is returned if something went
 // wrong.
-func genRelation(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
+func genRelation(b *Block, n *tree.Node, st *scopes) (Value, error) {
 	c1 := n.Children[0]
 	c2 := n.Children[1]
 	var op1, op2 Value
@@ -617,9 +708,9 @@ func genRelation(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 	// Operand 1.
 	switch c1.Typ {
 	case tree.INTEGER_DATA:
-		op1 = b.CreateConstantInt(c1.Data.(int))
+		op1 = b.CreateConstantInt(c1.Data.Int)
 	case tree.FLOAT_DATA:
-		op1 = b.CreateConstantFloat(c1.Data.(float64))
+		op1 = b.CreateConstantFloat(c1.Data.Float)
 	case tree.EXPRESSION:
 		if r, err := genExpression(b, c1, st); err != nil {
 			return nil, err
@@ -627,7 +718,7 @@ func genRelation(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 			op1 = r
 		}
 	case tree.IDENTIFIER_DATA:
-		if r, err := genLoad(c1.Data.(string), b, st); err != nil {
+		if r, err := genLoad(c1, b, st); err != nil {
 			return nil, err
 		} else {
 			op1 = r
@@ -637,9 +728,9 @@ func genRelation(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 	// Operand 2.
 	switch c2.Typ {
 	case tree.INTEGER_DATA:
-		op2 = b.CreateConstantInt(c2.Data.(int))
+		op2 = b.CreateConstantInt(c2.Data.Int)
 	case tree.FLOAT_DATA:
-		op2 = b.CreateConstantFloat(c2.Data.(float64))
+		op2 = b.CreateConstantFloat(c2.Data.Float)
 	case tree.EXPRESSION:
 		if r, err := genExpression(b, c2, st); err != nil {
 			return nil, err
@@ -647,7 +738,7 @@ func genRelation(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 			op2 = r
 		}
 	case tree.IDENTIFIER_DATA:
-		if r, err := genLoad(c2.Data.(string), b, st); err != nil {
+		if r, err := genLoad(c2, b, st); err != nil {
 			return nil, err
 		} else {
 			op2 = r
@@ -660,7 +751,7 @@ func genRelation(b *Block, n *tree.Node, st *util.Stack) (Value, error) {
 // genIf generates LIR IF-THEN or IF-THEN-ELSE statement. If the statement is an IF-THEN-ELSE, and both
 // branches terminate their respective blocks using RETURN, the returned Block will be <nil>, else the
 // returning Block is the converging block following the IF-THEN-ELSE statement.
-func genIf(b *Block, n *tree.Node, st, ls *util.Stack) (*Block, error) {
+func genIf(b *Block, n *tree.Node, st *scopes, ls *loops) (*Block, error) {
 	thn := b.f.CreateBlock()
 	var conv *Block
 
@@ -670,7 +761,7 @@ func genIf(b *Block, n *tree.Node, st, ls *util.Stack) (*Block, error) {
 		return nil, err
 	}
 	var op types.RelationalOperation
-	switch n.Children[0].Data.(string) {
+	switch n.Children[0].Data.Str {
 	case "=":
 		op = types.Eq
 	case "<":
@@ -678,7 +769,7 @@ func genIf(b *Block, n *tree.Node, st, ls *util.Stack) (*Block, error) {
 	case ">":
 		op = types.GreaterThan
 	default:
-		return nil, fmt.Errorf("undefined relation operator %q", n.Children[0].Data.(string))
+		return nil, n.Children[0].TypeErrorf("undefined relation operator %q", n.Children[0].Data.Str)
 	}
 
 	// Generate branches.
@@ -737,13 +828,15 @@ func genIf(b *Block, n *tree.Node, st, ls *util.Stack) (*Block, error) {
 }
 
 // genWhile generates LIR for a while statement and its body.
-func genWhile(b *Block, n *tree.Node, st, ls *util.Stack) (*Block, error) {
+func genWhile(b *Block, n *tree.Node, st *scopes, ls *loops) (*Block, error) {
 	head := b.f.CreateBlock()
 	body := b.f.CreateBlock()
 	conv := b.f.CreateBlock()
 
-	// Push head to lseq stack.
-	ls.Push(head)
+	// Push head to lseq stack, and pop it once the body is generated, such that continue statements after the
+	// while statement branch to the head of the loop around it.
+	ls.push(head)
+	defer ls.pop()
 
 	// Generate relation and branch to check if to jump to while body or converge.
 	b.CreateBranch(head)
@@ -753,7 +846,7 @@ func genWhile(b *Block, n *tree.Node, st, ls *util.Stack) (*Block, error) {
 		return nil, err
 	}
 	var op types.RelationalOperation
-	switch n.Children[0].Data.(string) {
+	switch n.Children[0].Data.Str {
 	case "=":
 		op = types.Eq
 	case "<":
@@ -761,7 +854,7 @@ func genWhile(b *Block, n *tree.Node, st, ls *util.Stack) (*Block, error) {
 	case ">":
 		op = types.GreaterThan
 	default:
-		return nil, fmt.Errorf("undefined relation operator %q", n.Children[0].Data.(string))
+		return nil, n.Children[0].TypeErrorf("undefined relation operator %q", n.Children[0].Data.Str)
 	}
 	if rel.DataType() == types.Int {
 		b.CreateConditionalBranch(op, rel, b.CreateConstantInt(0), body, conv)
@@ -781,18 +874,21 @@ func genWhile(b *Block, n *tree.Node, st, ls *util.Stack) (*Block, error) {
 }
 
 // genContinue generates an LIR continue statement in Block b.
-func genContinue(b *Block, ls *util.Stack) error {
-	var l interface{}
-	if l = ls.Peek(); l == nil {
+func genContinue(b *Block, ls *loops) error {
+	l := ls.top()
+	if l == nil {
 		return errors.New("continue without while-statement")
 	}
-	b.CreateBranch(l.(*Block))
+	b.CreateBranch(l)
 	return nil
 }
 
 // genPrint generates LIR print instructions using calls to Linux standard C library function printf. An error is
 // returned if something went wrong.
-func genPrint(b *Block, n *tree.Node, st *util.Stack) error {
+func genPrint(b *Block, n *tree.Node, st *scopes) error {
+	if n.FormatPrint() {
+		return genPrintf(b, n, st)
+	}
 	m := b.f.m
 	args := make([]Value, len(n.Children[0].Children))
 
@@ -800,24 +896,24 @@ func genPrint(b *Block, n *tree.Node, st *util.Stack) error {
 	for i1, e1 := range n.Children[0].Children {
 		switch e1.Typ {
 		case tree.STRING_DATA:
-			s := m.CreateGlobalString(e1.Data.(string))
+			s := m.CreateGlobalString(e1.Data.Str)
 			load := b.CreateLoad(s)
 			args[i1] = load
 		case tree.INTEGER_DATA:
-			c := b.CreateConstantInt(e1.Data.(int))
+			c := b.CreateConstantInt(e1.Data.Int)
 			args[i1] = c
 		case tree.FLOAT_DATA:
-			s := m.CreateGlobalString(fmt.Sprintf("%x", e1.Data.(float64)))
+			s := m.CreateGlobalString(fmt.Sprintf("%x", e1.Data.Float))
 			load := b.CreateLoad(s)
 			args[i1] = load
 		case tree.EXPRESSION:
-			val, err := genExpression(b, e1, st)
+			val, err := genValue(b, e1, st, types.String)
 			if err != nil {
 				return err
 			}
 			args[i1] = val
 		case tree.IDENTIFIER_DATA:
-			val, err := genLoad(e1.Data.(string), b, st)
+			val, err := genLoad(e1, b, st)
 			if err != nil {
 				return err
 			}
@@ -829,18 +925,71 @@ func genPrint(b *Block, n *tree.Node, st *util.Stack) error {
 	}
 
 	// Prepend format and create print.
-	b.CreatePrint(args)
+	b.CreatePrint(n.File(), args)
 
 	return nil
 }
 
-// genLoad generates a load of the named variable. The local scopes are searched first, followed by function parameters,
-// and lastly global variables. An error is returned if something went wrong.
-func genLoad(name string, b *Block, st *util.Stack) (Value, error) {
+// genPrintf generates the format print statement n, whose format string is passed to printf followed by a newline,
+// with the length modifier of the integers of the Module in its %d conversions. The conversion specifications of the
+// format string were verified by the syntax tree optimisation, except for the types of variables and expressions,
+// which are verified here. An error is returned if something went wrong.
+func genPrintf(b *Block, n *tree.Node, st *scopes) error {
+	items := n.Children[0].Children
+	format := items[0].Data.Str
+	verbs, err := tree.Verbs(format)
+	if err != nil {
+		return items[0].TypeErrorf("%v", err)
+	} else if len(verbs) != len(items)-1 {
+		return n.TypeErrorf("format string %q expects %d arguments, got %d", format, len(verbs), len(items)-1)
+	}
+	args := make([]Value, len(items)-1)
+	for i1, e1 := range items[1:] {
+		var val Value
+		switch e1.Typ {
+		case tree.INTEGER_DATA:
+			val = b.CreateConstantInt(e1.Data.Int)
+		case tree.FLOAT_DATA:
+			val = b.CreateConstantFloat(e1.Data.Float)
+		case tree.EXPRESSION:
+			typ := types.Float
+			if verbs[i1] == 'd' {
+				typ = types.Int
+			}
+			if val, err = genValue(b, e1, st, typ); err != nil {
+				return err
+			}
+		case tree.IDENTIFIER_DATA:
+			if val, err = genLoad(e1, b, st); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("format print statement expected argument of type INTEGER, FLOAT, EXPRESSION or "+
+				"IDENTIFIER, got %s", e1.Type())
+		}
+		if typ := val.DataType(); (typ == types.Int) != (verbs[i1] == 'd') {
+			name := tree.DTyp[tree.DataFloat]
+			if typ == types.Int {
+				name = tree.DTyp[tree.DataInteger]
+			}
+			return e1.TypeErrorf("argument %d of format string %q is %s, expected %%%c", i1+1, format, name,
+				verbs[i1])
+		}
+		args[i1] = val
+	}
+	b.CreatePrintf(n.File(), tree.Format(format, b.f.m.intBits)+"\n", args)
+	return nil
+}
+
+// genLoad generates a load of the variable named by the identifier node n. The local scopes are searched first, followed
+// by function parameters, and lastly global variables. An error is returned if something went wrong.
+func genLoad(n *tree.Node, b *Block, st *scopes) (Value, error) {
+	name := n.Data.Str
 	// Start by searching through local scopes, inner-most to outer-most, first.
-	for i1 := 1; i1 <= st.Size(); i1++ {
-		if scope := st.Get(i1).(*symTab); scope != nil {
+	for i1 := len(*st) - 1; i1 >= 0; i1-- {
+		if scope := (*st)[i1]; scope != nil {
 			if v, ok := scope.m[name]; ok {
+				genReference(b, n, v)
 				ld := b.CreateLoad(v)
 				return ld, nil
 			}
@@ -851,25 +1000,29 @@ func genLoad(name string, b *Block, st *util.Stack) (Value, error) {
 
 	// Search function parameters second.
 	if v := b.f.GetParam(name); v != nil {
+		genReference(b, n, v)
 		ld := b.CreateLoad(v)
 		return ld, nil
 	}
 
 	// Lastly, try searching global variables.
 	if v := b.f.m.GetGlobalVariable(name); v != nil {
+		genReference(b, n, v)
 		return b.CreateLoad(v), nil
 	}
 
-	return nil, fmt.Errorf("undeclared variable %q", name)
+	return nil, n.TypeErrorf("undeclared variable %q", name)
 }
 
-// genStore generates a store to the named variable dst. Variables are looked up by local scopes first, function
-// parameters second and global variables last. An error is returned if something went wrong.
-func genStore(dst string, src Value, b *Block, st *util.Stack) error {
+// genStore generates a store to the variable named by the identifier node n. Variables are looked up by local scopes
+// first, function parameters second and global variables last. An error is returned if something went wrong.
+func genStore(n *tree.Node, src Value, b *Block, st *scopes) error {
+	dst := n.Data.Str
 	// Start by searching local scopes first, top-to-bottom.
-	for i1 := 1; i1 <= st.Size(); i1++ {
-		if scope := st.Get(i1).(*symTab); scope != nil {
+	for i1 := len(*st) - 1; i1 >= 0; i1-- {
+		if scope := (*st)[i1]; scope != nil {
 			if v, ok := scope.m[dst]; ok {
+				genReference(b, n, v)
 				b.CreateStore(src, v)
 				return nil
 			}
@@ -880,28 +1033,49 @@ func genStore(dst string, src Value, b *Block, st *util.Stack) error {
 
 	// Check function parameters next.
 	if v := b.f.GetParam(dst); v != nil {
+		genReference(b, n, v)
 		b.CreateStore(src, v)
 		return nil
 	}
 
 	// Lastly, check global variables.
 	if v := b.f.m.GetGlobalVariable(dst); v != nil {
+		genReference(b, n, v)
 		b.CreateStore(src, v)
 		return nil
 	}
 	return nil
 }
 
+// varType returns the data type of the variable named name, which is looked up like genStore does. Integer is returned
+// for undeclared variables, which are reported by genStore.
+func varType(name string, b *Block, st *scopes) types.DataType {
+	for i1 := len(*st) - 1; i1 >= 0; i1-- {
+		if scope := (*st)[i1]; scope != nil {
+			if v, ok := scope.m[name]; ok {
+				return v.DataType()
+			}
+		}
+	}
+	if v := b.f.GetParam(name); v != nil {
+		return v.DataType()
+	}
+	if v := b.f.m.GetGlobalVariable(name); v != nil {
+		return v.DataType()
+	}
+	return types.Int
+}
+
 // genType takes an ir.TYPED_VARIABLE_LIST or ir.DECLARATION and returns the type of the data variable(s).
 func genType(n *tree.Node) (res types.DataType, _ error) {
 	if n == nil {
 		return types.Int, errors.New("cannot generate LIR type, node is <nil>")
 	}
-	if n.Data == nil {
+	if n.Data.IsZero() {
 		return res, fmt.Errorf("line %d:%d: syntax tree node of type %s doesn't carry data",
 			n.Line, n.Pos, n.Type())
 	}
-	switch n.Data.(string) {
+	switch n.Data.Str {
 	case "int":
 		return types.Int, nil
 	case "float":
@@ -911,3 +1085,39 @@ func genType(n *tree.Node) (res types.DataType, _ error) {
 			n.Type())
 	}
 }
+
+// push pushes the scope t onto the scope stack s.
+func (s *scopes) push(t *symTab) {
+	*s = append(*s, t)
+}
+
+// pop removes the innermost scope from the scope stack s.
+func (s *scopes) pop() {
+	*s = (*s)[:len(*s)-1]
+}
+
+// top returns the innermost scope of the scope stack s, or nil if s is empty.
+func (s scopes) top() *symTab {
+	if len(s) == 0 {
+		return nil
+	}
+	return s[len(s)-1]
+}
+
+// push pushes the loop head b onto the loop stack l.
+func (l *loops) push(b *Block) {
+	*l = append(*l, b)
+}
+
+// pop removes the innermost loop head from the loop stack l.
+func (l *loops) pop() {
+	*l = (*l)[:len(*l)-1]
+}
+
+// top returns the innermost loop head of the loop stack l, or nil if l is empty.
+func (l loops) top() *Block {
+	if len(l) == 0 {
+		return nil
+	}
+	return l[len(l)-1]
+}
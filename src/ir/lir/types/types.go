@@ -21,18 +21,20 @@ type DataType uint
 // ----- Constants -----
 // ---------------------
 const (
-	Add    ArithmeticOperation = iota // Add identifies the arithmetic operation a = b + c.
-	Sub                               // Sub identifies the arithmetic operation a = b - c.
-	Mul                               // Mul identifies the arithmetic operation a = b * c.
-	Div                               // Div identifies the arithmetic operation a = b / c.
-	Rem                               // Rem identifies the arithmetic operation a = b % c.
-	LShift                            // LShift identifies the arithmetic operation a = b << c.
-	RShift                            // RShift identifies the arithmetic operation a = b >> c.
-	And                               // And identifies the arithmetic operation a = b & c.
-	Xor                               // Xor identifies the arithmetic operation a = b ^ c.
-	Or                                // Or identifies the arithmetic operation a = b | c.
-	Neg                               // Neg identifies the arithmetic operation a = -b.
-	Not                               // Not identifies the arithmetic operation a = ~b.
+	Add     ArithmeticOperation = iota // Add identifies the arithmetic operation a = b + c.
+	Sub                                // Sub identifies the arithmetic operation a = b - c.
+	Mul                                // Mul identifies the arithmetic operation a = b * c.
+	Div                                // Div identifies the arithmetic operation a = b / c.
+	Rem                                // Rem identifies the arithmetic operation a = b % c.
+	LShift                             // LShift identifies the arithmetic operation a = b << c.
+	RShift                             // RShift identifies the logical (zero-extending) operation a = b >>> c.
+	And                                // And identifies the arithmetic operation a = b & c.
+	Xor                                // Xor identifies the arithmetic operation a = b ^ c.
+	Or                                 // Or identifies the arithmetic operation a = b | c.
+	MulHigh                            // MulHigh identifies the arithmetic operation a = (b * c) >> wordsize, signed.
+	ARShift                            // ARShift identifies the arithmetic (sign-extending) operation a = b >> c.
+	Neg                                // Neg identifies the arithmetic operation a = -b.
+	Not                                // Not identifies the arithmetic operation a = ~b.
 )
 
 const (
@@ -58,6 +60,8 @@ const (
 	PrintInstruction
 	CastInstruction
 	PreserveInstruction
+	PhiInstruction
+	SelectInstruction
 )
 
 const (
@@ -84,6 +88,8 @@ var aTyp = [...]string{
 	"and",
 	"xor",
 	"or",
+	"mulh",
+	"ashr",
 	"neg",
 	"not",
 }
@@ -93,17 +99,18 @@ var iTyp = [...]string{
 	"DataInstruction",
 	"LoadInstruction",
 	"StoreInstruction",
+	"Constant",
 	"BranchInstruction",
-	"ConditionalBranchInstruction",
 	"ReturnInstruction",
 	"DeclareInstruction",
 	"FunctionCallInstruction",
 	"Global",
 	"Param",
-	"Local",
 	"PrintInstruction",
 	"CastInstruction",
 	"PreserveInstruction",
+	"PhiInstruction",
+	"SelectInstruction",
 }
 
 // dTyp provides string literals for DataType constants.
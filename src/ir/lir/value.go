@@ -2,6 +2,7 @@
 package lir
 
 import (
+	"fmt"
 	"vslc/src/ir/lir/types"
 )
 
@@ -18,13 +19,35 @@ type Value interface {
 	Type() types.InstructionType
 	DataType() types.DataType
 	String() string
-	SetHW(hw interface{})
-	GetHW() interface{}
+	SetHW(hw *LiveNode)
+	GetHW() *LiveNode
 	Operand1() Value
 	Operand2() Value
 	Enable()
 	Disable()
 	IsEnabled() bool
+	Users() []Value
+	addUser(u Value)
+	removeUser(u Value)
+	Location() Location
+	SetLocation(l Location)
+}
+
+// Location defines a position in the VSL source code. The zero Location is unknown.
+type Location struct {
+	Line int // Line is the source code line, starting at 1.
+	Pos  int // Pos is the position on the line, starting at 1.
+}
+
+// location holds the source Location of a Value. It is embedded in every Value type.
+type location struct {
+	loc Location // loc is the source Location that the Value originates from.
+}
+
+// uses holds the def-use chain of a Value: the instructions that use the Value as an operand. It is embedded in every
+// Value type and maintained by the instruction builders and by the transformations of the package.
+type uses struct {
+	users []Value // users holds one entry per use, such that an instruction that uses a Value twice occurs twice.
 }
 
 // ---------------------
@@ -38,3 +61,79 @@ type Value interface {
 // ---------------------
 // ----- Functions -----
 // ---------------------
+
+// Users returns the instructions that use the Value as an operand, with one entry per use. The returned slice must
+// not be modified.
+func (u *uses) Users() []Value {
+	return u.users
+}
+
+// addUser records a use of the Value by instruction v.
+func (u *uses) addUser(v Value) {
+	u.users = append(u.users, v)
+}
+
+// removeUser removes a single use of the Value by instruction v.
+func (u *uses) removeUser(v Value) {
+	for i1, e1 := range u.users {
+		if e1 == v {
+			u.users = append(u.users[:i1], u.users[i1+1:]...)
+			return
+		}
+	}
+}
+
+// Location returns the source Location that the Value originates from.
+func (l *location) Location() Location {
+	return l.loc
+}
+
+// SetLocation sets the source Location that the Value originates from.
+func (l *location) SetLocation(loc Location) {
+	l.loc = loc
+}
+
+// IsKnown returns true if Location l refers to a position in the source code.
+func (l Location) IsKnown() bool {
+	return l.Line > 0
+}
+
+// String returns the textual representation of Location l, as used by compiler error messages.
+func (l Location) String() string {
+	return fmt.Sprintf("line %d:%d", l.Line, l.Pos)
+}
+
+// use records instruction v as a user of each of its operands.
+func use(v Value) {
+	for _, e1 := range operands(v) {
+		if e1 != nil {
+			e1.addUser(v)
+		}
+	}
+}
+
+// unuse removes instruction v from the users of each of its operands. It must be called before v is removed from
+// its Block or before its operands are modified.
+func unuse(v Value) {
+	for _, e1 := range operands(v) {
+		if e1 != nil {
+			e1.removeUser(v)
+		}
+	}
+}
+
+// ReplaceAllUsesWith replaces every use of Value old by Value v. The users of old become users of v.
+func ReplaceAllUsesWith(old, v Value) {
+	if old == v {
+		return
+	}
+	users := append(make([]Value, 0, len(old.Users())), old.Users()...)
+	repl := map[Value]Value{old: v}
+	seen := make(map[Value]bool, len(users))
+	for _, e1 := range users {
+		if !seen[e1] {
+			seen[e1] = true
+			replaceOperands(e1, repl)
+		}
+	}
+}
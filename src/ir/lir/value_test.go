@@ -0,0 +1,192 @@
+package lir
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"vslc/src/util"
+)
+
+// checkUsers verifies that the tracked users of every Value of Module m match the operands of the instructions.
+func checkUsers(t *testing.T, m *Module, stage string) {
+	exp := make(map[Value]map[Value]int)
+	got := make(map[Value]map[Value]int)
+	count := func(res map[Value]map[Value]int, v, u Value) {
+		if res[v] == nil {
+			res[v] = make(map[Value]int)
+		}
+		res[v][u]++
+	}
+	for _, e1 := range m.Functions() {
+		for _, e2 := range e1.Blocks() {
+			for _, e3 := range e2.Instructions() {
+				for _, e4 := range operands(e3) {
+					count(exp, e4, e3)
+				}
+				for _, e4 := range e3.Users() {
+					count(got, e3, e4)
+				}
+			}
+		}
+		for _, e2 := range e1.Params() {
+			for _, e3 := range e2.Users() {
+				count(got, e2, e3)
+			}
+		}
+		for _, e2 := range e1.Locals() {
+			for _, e3 := range e2.Users() {
+				count(got, e2, e3)
+			}
+		}
+	}
+	for _, e1 := range m.Globals() {
+		for _, e2 := range e1.Users() {
+			count(got, e1, e2)
+		}
+	}
+	for _, e1 := range m.Strings() {
+		for _, e2 := range e1.Users() {
+			count(got, e1, e2)
+		}
+	}
+	for v, users := range exp {
+		for u, n := range users {
+			if got[v][u] != n {
+				t.Errorf("%s: %s used %d times by %s, tracked %d times", stage, v.Name(), n, u.String(), got[v][u])
+			}
+		}
+	}
+	for v, users := range got {
+		for u, n := range users {
+			if exp[v][u] != n {
+				t.Errorf("%s: %s tracked as used %d times by %s, used %d times", stage, v.Name(), n, u.String(),
+					exp[v][u])
+			}
+		}
+	}
+}
+
+// TestUsers verifies that the def-use chains of the bundled typed VSL source files are maintained by the builders
+// and by every transformation of the compilation pipeline.
+func TestUsers(t *testing.T) {
+	files, err := filepath.Glob(samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e1 := range files {
+		opt := util.Options{Src: e1, Threads: 1}
+		m := genSample(t, opt)
+		checkUsers(t, m, e1+": GenLIR")
+		SimplifyCFG(opt, m)
+		checkUsers(t, m, e1+": SimplifyCFG")
+		Mem2Reg(opt, m)
+		checkUsers(t, m, e1+": Mem2Reg")
+		LowerDivision(opt, m)
+		checkUsers(t, m, e1+": LowerDivision")
+
+		buf := bytes.Buffer{}
+		if err := m.Encode(&buf); err != nil {
+			t.Fatalf("%s: %s", e1, err)
+		}
+		if m, err = Decode(&buf); err != nil {
+			t.Fatalf("%s: %s", e1, err)
+		}
+		checkUsers(t, m, e1+": Decode")
+		DestructSSA(opt, m)
+		checkUsers(t, m, e1+": DestructSSA")
+	}
+}
+
+// TestReplaceAllUsesWith verifies that replacing a Value moves its users to the replacement.
+func TestReplaceAllUsesWith(t *testing.T) {
+	f := createCounterLoop()
+	f.Mem2Reg()
+	head := f.Blocks()[1]
+	phi := head.phis()[0]
+	users := len(phi.Users())
+	if users == 0 {
+		t.Fatalf("expected users of %s", phi.Name())
+	}
+
+	c := head.CreateConstantFloat(0)
+	c.typ = phi.typ
+	c.val = 0
+	ReplaceAllUsesWith(phi, c)
+	if len(phi.Users()) != 0 {
+		t.Errorf("expected no users of %s, got %d", phi.Name(), len(phi.Users()))
+	}
+	if len(c.Users()) != users {
+		t.Errorf("expected %d users of %s, got %d", users, c.Name(), len(c.Users()))
+	}
+	for _, e1 := range c.Users() {
+		found := false
+		for _, e2 := range operands(e1) {
+			if e2 == c {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s does not use %s", e1.String(), c.Name())
+		}
+	}
+}
+
+// TestLocation verifies that every Value generated from the bundled typed VSL source files has a known source Location
+// and that the Locations survive serialization.
+func TestLocation(t *testing.T) {
+	files, err := filepath.Glob(samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e1 := range files {
+		m := genSample(t, util.Options{Src: e1, Threads: 1})
+		buf := bytes.Buffer{}
+		if err := m.Encode(&buf); err != nil {
+			t.Fatalf("%s: %s", e1, err)
+		}
+		dm, err := Decode(&buf)
+		if err != nil {
+			t.Fatalf("%s: %s", e1, err)
+		}
+
+		locs := make(map[string]Location)
+		for _, e2 := range m.Functions() {
+			if len(e2.Blocks()) == 0 {
+				// External declaration.
+				continue
+			}
+			vals := make([]Value, 0, len(e2.Params())+len(e2.Locals()))
+			for _, e3 := range e2.Params() {
+				vals = append(vals, e3)
+			}
+			for _, e3 := range e2.Locals() {
+				vals = append(vals, e3)
+			}
+			for _, e3 := range e2.Blocks() {
+				vals = append(vals, e3.Instructions()...)
+			}
+			for _, e3 := range vals {
+				if !e3.Location().IsKnown() {
+					t.Errorf("%s: %s: unknown location of %s", e1, e2.Name(), e3.String())
+				}
+				locs[fmt.Sprintf("%s.%d", e2.Name(), e3.Id())] = e3.Location()
+			}
+		}
+		for _, e2 := range dm.Functions() {
+			for _, e3 := range e2.Blocks() {
+				for _, e4 := range e3.Instructions() {
+					if exp := locs[fmt.Sprintf("%s.%d", e2.Name(), e4.Id())]; e4.Location() != exp {
+						t.Errorf("%s: %s: expected location %s of decoded %s, got %s", e1, e2.Name(), exp.String(),
+							e4.String(), e4.Location().String())
+					}
+				}
+			}
+		}
+		for i2, e2 := range m.Globals() {
+			if !e2.Location().IsKnown() || dm.Globals()[i2].Location() != e2.Location() {
+				t.Errorf("%s: unexpected location of global %s", e1, e2.Name())
+			}
+		}
+	}
+}
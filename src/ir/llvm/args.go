@@ -0,0 +1,214 @@
+package llvm
+
+import (
+	"tinygo.org/x/go-llvm"
+)
+
+import (
+	ast "vslc/src/ir"
+)
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// Names of the builtin functions of VSL, which are shadowed by VSL functions of the same name.
+const (
+	builtinArgc = "argc"
+	builtinArg  = "arg"
+)
+
+// Names of the functions and globals that implement the builtin functions argc and arg. The functions are declared
+// where they are called, and defined next to the implicit main function, which stores argc and argv for them.
+const (
+	labelArgc      = "vsl_argc" // labelArgc returns the number of program arguments.
+	labelArgInt    = "vsl_argi" // labelArgInt returns a program argument parsed as an integer by strtol.
+	labelArgFloat  = "vsl_argf" // labelArgFloat returns a program argument parsed as a float by strtod.
+	labelArgString = "vsl_args" // labelArgString returns a program argument as a null-terminated string.
+	labelArgCount  = "vsl.argc" // labelArgCount holds argc of main.
+	labelArgVector = "vsl.argv" // labelArgVector holds argv of main.
+)
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// builtin returns true if the expression n calls the builtin function argc or arg, which isn't shadowed by a VSL
+// function declared in the LLVM module m.
+func builtin(m llvm.Module, n *ast.Node) bool {
+	if n.Typ != ast.EXPRESSION || !n.Data.IsZero() || len(n.Children) < 1 {
+		return false
+	}
+	name := n.Children[0].Data.Str
+	return (name == builtinArgc || name == builtinArg) && m.NamedFunction(name).IsAFunction().IsNil()
+}
+
+// genValue generates LLVM IR for the expression n, whose value is converted to the type typ by the caller. Calls to the
+// builtin function arg return typ, which is a string for print statements, since the type of a program argument
+// can't be inferred from its use in an expression.
+func (g *generator) genValue(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *scopes,
+	typ llvm.Type) (llvm.Value, error) {
+	if builtin(m, n) {
+		return g.genBuiltin(b, m, fun, n, st, typ)
+	}
+	return g.genExpression(b, m, fun, n, st)
+}
+
+// genBuiltin generates the call of the builtin function argc or arg of the expression n. Program arguments are
+// parsed as integers, unless typ is a float or string type.
+func (g *generator) genBuiltin(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *scopes,
+	typ llvm.Type) (llvm.Value, error) {
+	name := n.Children[0].Data.Str
+	var args []*ast.Node
+	if len(n.Children) > 1 && len(n.Children[1].Children) > 0 {
+		args = n.Children[1].Children[0].Children
+	}
+	if name == builtinArgc {
+		if len(args) != 0 {
+			return llvm.Value{}, n.TypeErrorf("function %q expects 0 parameters, got %d", name, len(args))
+		}
+		return b.CreateCall(g.declareArg(m, labelArgc), []llvm.Value{}, ""), nil
+	}
+	if len(args) != 1 {
+		return llvm.Value{}, n.TypeErrorf("function %q expects 1 parameter, got %d", name, len(args))
+	}
+
+	// Load the index of the argument.
+	var i llvm.Value
+	var err error
+	switch e1 := args[0]; e1.Typ {
+	case ast.INTEGER_DATA:
+		i = llvm.ConstInt(g.intType(m), uint64(e1.Data.Int), true)
+	case ast.FLOAT_DATA:
+		i = llvm.ConstFloat(g.floatType(m), e1.Data.Float)
+	case ast.EXPRESSION:
+		i, err = g.genValue(b, m, fun, e1, st, g.intType(m))
+	case ast.IDENTIFIER_DATA:
+		i, err = genLoad(e1.Data.Str, b, m, fun, st)
+	default:
+		return llvm.Value{}, e1.TypeErrorf("function %q expects an int or float parameter, got %s", name, e1.Type())
+	}
+	if err != nil {
+		return llvm.Value{}, err
+	}
+	label := labelArgString
+	switch typ {
+	case g.intType(m):
+		label = labelArgInt
+	case g.floatType(m):
+		label = labelArgFloat
+	}
+	return b.CreateCall(g.declareArg(m, label), []llvm.Value{g.genCast(b, m, i, g.intType(m))}, ""), nil
+}
+
+// varType returns the type of the variable name, which is looked up like genStore does. Undeclared variables are
+// reported by genStore, and are integers until then.
+func (g *generator) varType(name string, m llvm.Module, st *scopes) llvm.Type {
+	for i1 := len(*st) - 1; i1 >= 0; i1-- {
+		if symtab := (*st)[i1]; symtab != nil {
+			if dst, ok := symtab.m[name]; ok {
+				return dst.Type().ElementType()
+			}
+		}
+	}
+	if dst := m.NamedGlobal(name); !dst.IsNil() {
+		return dst.Type().ElementType()
+	}
+	return g.intType(m)
+}
+
+// declareArg returns the function name of the builtin functions argc and arg in the LLVM module m, which is declared
+// if it doesn't exist.
+func (g *generator) declareArg(m llvm.Module, name string) llvm.Value {
+	if fun := m.NamedFunction(name); !fun.IsAFunction().IsNil() {
+		return fun
+	}
+	str := llvm.PointerType(m.Context().Int8Type(), 0)
+	var ftyp llvm.Type
+	switch name {
+	case labelArgc:
+		ftyp = llvm.FunctionType(g.intType(m), []llvm.Type{}, false)
+	case labelArgInt:
+		ftyp = llvm.FunctionType(g.intType(m), []llvm.Type{g.intType(m)}, false)
+	case labelArgFloat:
+		ftyp = llvm.FunctionType(g.floatType(m), []llvm.Type{g.intType(m)}, false)
+	default:
+		ftyp = llvm.FunctionType(str, []llvm.Type{g.intType(m)}, false)
+	}
+	return llvm.AddFunction(m, name, ftyp)
+}
+
+// usesArgs returns true if the LLVM module m calls the builtin function argc or arg.
+func usesArgs(m llvm.Module) bool {
+	for _, e1 := range []string{labelArgc, labelArgInt, labelArgFloat, labelArgString} {
+		if !m.NamedFunction(e1).IsAFunction().IsNil() {
+			return true
+		}
+	}
+	return false
+}
+
+// genArgStore generates the globals that hold argc and argv of the implicit main function main, and stores them at
+// the insertion point of Builder b.
+func genArgStore(b llvm.Builder, m llvm.Module, main llvm.Value) {
+	for i1, e1 := range []string{labelArgCount, labelArgVector} {
+		g := llvm.AddGlobal(m, main.Param(i1).Type(), e1)
+		g.SetLinkage(llvm.InternalLinkage)
+		g.SetInitializer(llvm.ConstNull(main.Param(i1).Type()))
+		b.CreateStore(main.Param(i1), g)
+	}
+}
+
+// genArgs defines the functions of the builtin functions argc and arg in the LLVM module m, which read the globals
+// stored by genArgStore. Program arguments out of range are the empty string, which strtol and strtod parse as zero.
+func (g *generator) genArgs(b llvm.Builder, m llvm.Module) {
+	argc, argv := m.NamedGlobal(labelArgCount), m.NamedGlobal(labelArgVector)
+	one := llvm.ConstInt(g.intType(m), 1, false)
+
+	// argc returns the number of arguments, which excludes the program name.
+	fun := g.declareArg(m, labelArgc)
+	b.SetInsertPointAtEnd(m.Context().AddBasicBlock(fun, ""))
+	b.CreateRet(b.CreateSub(b.CreateLoad(argc, ""), one, ""))
+
+	// The string of argument i is argv[i], or the empty string if i is out of range.
+	fun = g.declareArg(m, labelArgString)
+	i := fun.Param(0)
+	b.SetInsertPointAtEnd(m.Context().AddBasicBlock(fun, ""))
+	in := m.Context().AddBasicBlock(fun, "in")
+	out := m.Context().AddBasicBlock(fun, "out")
+	cmp := b.CreateAnd(
+		b.CreateICmp(llvm.IntSGE, i, one, ""),
+		b.CreateICmp(llvm.IntSLT, i, b.CreateLoad(argc, ""), ""),
+		"")
+	b.CreateCondBr(cmp, in, out)
+	b.SetInsertPointAtEnd(in)
+	b.CreateRet(b.CreateLoad(b.CreateGEP(b.CreateLoad(argv, ""), []llvm.Value{i}, ""), ""))
+	b.SetInsertPointAtEnd(out)
+	b.CreateRet(b.CreateGlobalStringPtr("", stringPrefix))
+
+	// Integer and float arguments parse the string of the argument, without an end pointer.
+	str := llvm.PointerType(m.Context().Int8Type(), 0)
+	end := llvm.ConstPointerNull(llvm.PointerType(str, 0))
+	strtol, strtod := m.NamedFunction("strtol"), m.NamedFunction("strtod")
+	if strtol.IsAFunction().IsNil() {
+		strtol = g.genStrtol(m)
+	}
+	if strtod.IsAFunction().IsNil() {
+		strtod = genStrtod(m)
+	}
+
+	fun = g.declareArg(m, labelArgInt)
+	b.SetInsertPointAtEnd(m.Context().AddBasicBlock(fun, ""))
+	s := b.CreateCall(g.declareArg(m, labelArgString), []llvm.Value{fun.Param(0)}, "")
+	res := b.CreateCall(strtol, []llvm.Value{s, end, llvm.ConstInt(m.Context().Int32Type(), 10, false)}, "")
+	b.CreateRet(b.CreateIntCast(res, g.intType(m), ""))
+
+	fun = g.declareArg(m, labelArgFloat)
+	b.SetInsertPointAtEnd(m.Context().AddBasicBlock(fun, ""))
+	s = b.CreateCall(g.declareArg(m, labelArgString), []llvm.Value{fun.Param(0)}, "")
+	res = b.CreateCall(strtod, []llvm.Value{s, end}, "")
+	if g.narrow {
+		res = b.CreateFPTrunc(res, g.floatType(m), "")
+	}
+	b.CreateRet(res)
+}
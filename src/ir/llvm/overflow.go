@@ -0,0 +1,74 @@
+package llvm
+
+import (
+	"fmt"
+)
+
+import (
+	"tinygo.org/x/go-llvm"
+)
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// labelOverflow is the name of the handler of integer overflows, which is called with the overflow bit of every
+// checked integer operation if -ftrapv is set. The dot keeps it from clashing with VSL functions.
+const labelOverflow = "vsl.overflow"
+
+// overflowMsg is written to stderr by the handler of integer overflows.
+const overflowMsg = "Overflow error: integer overflow\n"
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// genChecked generates the integer arithmetic operation op, which is sadd, ssub or smul, of op1 and op2 by the LLVM
+// intrinsic that returns whether it overflowed, and passes the overflow bit to the overflow handler. The intrinsic and
+// the handler are declared if they don't exist.
+func genChecked(b llvm.Builder, m llvm.Module, op string, op1, op2 llvm.Value) llvm.Value {
+	typ := op1.Type()
+	name := fmt.Sprintf("llvm.%s.with.overflow.i%d", op, typ.IntTypeWidth())
+	fun := m.NamedFunction(name)
+	if fun.IsAFunction().IsNil() {
+		res := m.Context().StructType([]llvm.Type{typ, m.Context().Int1Type()}, false)
+		fun = llvm.AddFunction(m, name, llvm.FunctionType(res, []llvm.Type{typ, typ}, false))
+	}
+	handler := m.NamedFunction(labelOverflow)
+	if handler.IsAFunction().IsNil() {
+		ftyp := llvm.FunctionType(m.Context().VoidType(), []llvm.Type{m.Context().Int1Type()}, false)
+		handler = llvm.AddFunction(m, labelOverflow, ftyp)
+	}
+	res := b.CreateCall(fun, []llvm.Value{op1, op2}, "")
+	b.CreateCall(handler, []llvm.Value{b.CreateExtractValue(res, 1, "")}, "")
+	return b.CreateExtractValue(res, 0, "")
+}
+
+// genOverflow defines the handler of integer overflows in the LLVM module m, which writes the overflow message to
+// stderr by dprintf and aborts the program if its parameter is set. The handler is declared with external linkage by
+// the functions that call it, such that the modules of parallel workers link with it, and is made internal once it's
+// defined.
+func genOverflow(b llvm.Builder, m llvm.Module) {
+	fun := m.NamedFunction(labelOverflow)
+	fun.SetLinkage(llvm.InternalLinkage)
+	b.SetInsertPointAtEnd(m.Context().AddBasicBlock(fun, ""))
+	trap := m.Context().AddBasicBlock(fun, "abort")
+	ok := m.Context().AddBasicBlock(fun, "ok")
+	b.CreateCondBr(fun.Param(0), trap, ok)
+	b.SetInsertPointAtEnd(ok)
+	b.CreateRetVoid()
+
+	b.SetInsertPointAtEnd(trap)
+	pf := m.NamedFunction("dprintf")
+	if pf.IsAFunction().IsNil() {
+		pf = genDprintf(m)
+	}
+	abort := m.NamedFunction("abort")
+	if abort.IsAFunction().IsNil() {
+		abort = llvm.AddFunction(m, "abort", llvm.FunctionType(m.Context().VoidType(), []llvm.Type{}, false))
+	}
+	msg := b.CreateGlobalStringPtr(overflowMsg, stringPrefix)
+	b.CreateCall(pf, []llvm.Value{llvm.ConstInt(m.Context().Int32Type(), 2, false), msg}, "")
+	b.CreateCall(abort, []llvm.Value{}, "")
+	b.CreateUnreachable()
+}
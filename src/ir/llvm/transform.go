@@ -5,6 +5,7 @@ package llvm
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -30,6 +31,34 @@ type symTab struct {
 	sync.RWMutex
 }
 
+// scopes is the stack of the scopes of a function body, innermost last. The function parameters are at the bottom.
+type scopes []*symTab
+
+// loops is the stack of the head basic blocks of the while statements around a statement, innermost last.
+type loops []llvm.BasicBlock
+
+// funcWrapper wraps an ast.Node pointer and an LLVM function declaration.
+type funcWrapper struct {
+	ll   llvm.Value // LLVM function declaration.
+	node *ast.Node  // Syntax tree node pointer of function.
+}
+
+// generator holds the code generation options of a compilation. It's read-only while function bodies are generated,
+// and shared by the worker go routines of genParallel.
+type generator struct {
+	narrow  bool // Set to true if the target architecture has 32-bit longs and floats, instead of 64-bit.
+	intBits int  // Number of bits of VSL integers, which is 32 or 64.
+	trapv   bool // Set to true if integer addition, subtraction and multiplication abort the program on overflow.
+}
+
+// worker holds the LLVM context of a worker go routine of genParallel, and the module it generates its functions into.
+type worker struct {
+	ctx   llvm.Context  // ctx is the worker's own context, since LLVM contexts aren't thread safe.
+	b     llvm.Builder  // b is the builder of ctx.
+	m     llvm.Module   // m declares the global variables and functions of the program.
+	funcs []funcWrapper // funcs holds the functions declared in m, or nil if declaring them failed.
+}
+
 // ---------------------
 // ----- Constants -----
 // ---------------------
@@ -41,24 +70,48 @@ const mapSize = 16 // Predefined size for a decently sized symbol table hash tab
 // -------------------
 
 var stringPrefix = "L_STR" // Prefix all global strings with this prefix.
-var i = llvm.Int64Type()   // i defines the integer type for the target architecture.
-var f = llvm.DoubleType()  // f defines the float type for the target architecture.
 
-// globals is the global symbol table that keeps track of globally declared variables and functions for easy access.
-var globals symTab
+// codeGenLevels maps the optimisation levels 0 to 3 to the optimisation levels of the LLVM code generator.
+var codeGenLevels = [...]llvm.CodeGenOptLevel{
+	llvm.CodeGenLevelNone,
+	llvm.CodeGenLevelLess,
+	llvm.CodeGenLevelDefault,
+	llvm.CodeGenLevelAggressive,
+}
+
+// codeModels maps the code model names of util.Options to LLVM code models. The empty name selects the target's
+// default code model.
+var codeModels = map[string]llvm.CodeModel{
+	"":       llvm.CodeModelDefault,
+	"tiny":   llvm.CodeModelTiny,
+	"small":  llvm.CodeModelSmall,
+	"kernel": llvm.CodeModelKernel,
+	"medium": llvm.CodeModelMedium,
+	"large":  llvm.CodeModelLarge,
+}
 
 // reservedFunctionNames defines a list of function names that cannot be assigned to VSL functions.
 var reservedFunctionNames = []string{
+	"abort",
+	"dprintf",
 	"main",
 	"printf",
-	"atof",
-	"atoi",
+	"strtod",
+	"strtol",
+	labelArgc,
+	labelArgFloat,
+	labelArgInt,
+	labelArgString,
 }
 
 // ---------------------
 // ----- functions -----
 // ---------------------
 
+func init() {
+	util.RegisterLLVM(llvm.Version)
+}
+
 // GenLLVM generates LLVM IR from the root ast.Node of the syntax tree.
 func GenLLVM(opt util.Options, root *ast.Node) error {
 	if root == nil {
@@ -68,18 +121,12 @@ func GenLLVM(opt util.Options, root *ast.Node) error {
 		return errors.New("syntax tree node has no children")
 	}
 
-	if opt.TargetArch == util.Riscv32 {
-		i = llvm.Int32Type()
-		f = llvm.FloatType()
-	}
-
-	// funcWrapper wraps an ast.Node pointer and an LLVM function definition.
-	type funcWrapper struct {
-		ll   llvm.Value // LLVM function definition.
-		node *ast.Node  // Syntax tree node pointer of function.
+	g := &generator{
+		narrow:  opt.TargetArch == util.Riscv32 || opt.TargetArch == util.Armv7,
+		intBits: opt.IntBits(),
+		trapv:   opt.Trapv,
 	}
 
-	globals.m = make(map[string]llvm.Value, mapSize)
 	ctx := llvm.NewContext()
 	defer ctx.Dispose()
 
@@ -91,166 +138,39 @@ func GenLLVM(opt util.Options, root *ast.Node) error {
 	m := ctx.NewModule(filepath.Base(opt.Src))
 	defer m.Dispose()
 
-	if opt.Threads > 1 {
-		// Parallel.
-		t := opt.Threads
-		l := len(root.Children)
-		if t > l {
-			t = l
-		}
-		n := l / t
-		res := l % t
-
-		start := 0
-		end := n
-
-		wg := sync.WaitGroup{}
-		wg.Add(t)
-
-		cerr := make(chan error, t) // One buffer per worker thread.
-		errs := make([]error, 0, l) // Pre-allocate one error per global definition.
-
-		// Error listener.
-		go func(cerr chan error, errs *[]error) {
-			defer close(cerr)
-			for {
-				err := <-cerr
-				if err == nil {
-					return
-				} else {
-					*errs = append(*errs, err)
-				}
-			}
-		}(cerr, &errs)
-
-		funcs := make([]funcWrapper, 0, len(root.Children))
-		cfunc := make(chan []funcWrapper, t)
-
-		// Generate global variables and function declarations.
-		for i1 := 0; i1 < t; i1++ {
-			// Spawn t threads.
-			if i1 < res {
-				// This thread should do one extra residual job.
-				end++
-			}
-			go func(start, end int, cerr chan error, cfunc chan []funcWrapper, wg *sync.WaitGroup) {
-				defer wg.Done()
-				funcs := make([]funcWrapper, 0, end-start)
-				for _, e1 := range root.Children[start:end] {
-					if e1.Typ == ast.FUNCTION {
-						if fun, err := genFuncHeader(m, e1); err != nil {
-							cerr <- err
-						} else {
-							funcs = append(funcs, funcWrapper{ll: fun, node: e1})
-						}
-					} else if e1.Typ == ast.DECLARATION {
-						if err := genDeclarationGlobal(m, e1); err != nil {
-							cerr <- err
-						}
-					} else {
-						cerr <- fmt.Errorf("line %d:%d: expected FUNCTION or DECLARATION, got %s",
-							e1.Line, e1.Pos, e1.Type())
-					}
-				}
-				cfunc <- funcs
-			}(start, end, cerr, cfunc, &wg)
-
-			start = end
-			end += n
-		}
-
-		// Wait for generation of function declarations and global variables.
-		wg.Wait()
-		close(cfunc)
-		for e1 := range cfunc {
-			funcs = append(funcs, e1...)
-		}
-
-		// Stop error listener.
-		cerr <- nil
-
-		// Check for errors.
-		if len(errs) > 0 {
-			for _, e1 := range errs {
-				fmt.Println(e1)
-			}
-			return errors.New("multiple errors during parallel compilation")
-		}
-
-		// Calculate worker threads for function body generation.
-		l = len(funcs)
-		t = opt.Threads
-		if t > l {
-			t = l
-		}
-		n = l / t
-		res = l % t
-		start = 0
-		end = n
-
-		cerr = make(chan error, t) // One buffer per worker thread.
-		errs = make([]error, 0, l) // Pre-allocate one error per global definition.
-
-		wg.Add(t)
-		// Generate function bodies.
-		for i1 := 0; i1 < t; i1++ {
-			// Spawn t threads.
-			if i1 < res {
-				// This thread should do one extra residual job.
-				end++
-			}
-
-			go func(start, end int, wg *sync.WaitGroup, cerr chan error) {
-				defer wg.Done()
-				// Give each thread its own builder, else there will be multiple threads writing different functions,
-				// interchanging basic blocks concurrently.
-				b := ctx.NewBuilder()
-				defer b.Dispose()
-				for _, e1 := range funcs[start:end] {
-					if err := genFuncBody(b, m, e1.ll, e1.node); err != nil {
-						cerr <- err
-					}
-				}
-			}(start, end, &wg, cerr)
+	// Define global variables and declare functions.
+	funcs, err := g.genDeclarations(m, root, true)
+	if err != nil {
+		return err
+	}
 
-			start = end
-			end += n
+	if opt.Threads > 1 && len(funcs) > 1 {
+		// Parallel.
+		if err := g.genParallel(opt, m, root, len(funcs)); err != nil {
+			return err
 		}
-
-		// Wait for generation of function bodies.
-		wg.Wait()
 	} else {
 		// Sequential.
-		funcs := make([]funcWrapper, 0, len(root.Children)) // Pre-allocate sufficient space for functions of root.
-		for _, e1 := range root.Children {
-			if e1.Typ == ast.FUNCTION {
-				if fun, err := genFuncHeader(m, e1); err != nil {
-					return err
-				} else {
-					funcs = append(funcs, funcWrapper{ll: fun, node: e1})
-				}
-			} else if e1.Typ == ast.DECLARATION {
-				// Global variable declaration.
-				if err := genDeclarationGlobal(m, e1); err != nil {
-					return err
-				}
-			} else {
-				return fmt.Errorf("expected node of type FUNCTION or DECLARATION, got %s", e1.Type())
-			}
-		}
 		for _, e1 := range funcs {
-			if err := genFuncBody(b, m, e1.ll, e1.node); err != nil {
+			if err := g.genFuncBody(b, m, e1.ll, e1.node); err != nil {
 				return err
 			}
 		}
 	}
-	if err := genMain(b, m, root); err != nil {
+	if err := g.genMain(b, m, root); err != nil {
 		return err
 	}
+	if !m.NamedFunction(labelOverflow).IsAFunction().IsNil() {
+		genOverflow(b, m)
+	}
+
+	if opt.Log.Logging(util.LogDebug) {
+		opt.Log.Dump("LLVM IR", m.String())
+	}
 
-	if opt.Verbose {
-		fmt.Println("LLVM IR:")
-		m.Dump()
+	// Report invalid IR instead of miscompiling it.
+	if err := verify(m); err != nil {
+		return err
 	}
 
 	// Initialise LLVM code generation.
@@ -266,21 +186,20 @@ func GenLLVM(opt util.Options, root *ast.Node) error {
 	}
 
 	// Configure hardware properties for target.
-	var cpu string
-	switch opt.TargetArch {
-	case util.Riscv64:
-		cpu = "generic-rv64" // TODO: Causes LLVM to crash.
-	case util.Riscv32:
-		cpu = "generic-rv32"
-	default:
-		cpu = "generic"
+	cpu, features, err := genTargetConfig(opt)
+	if err != nil {
+		return err
 	}
-	features := "" // Ignore extra features for this simple compiler.
 
+	// Objects that go into shared libraries and position-independent executables must be relocatable.
+	reloc := llvm.RelocDefault
+	if opt.PIC {
+		reloc = llvm.RelocPIC
+	}
 	tm := t.CreateTargetMachine(tt, cpu, features,
-		llvm.CodeGenLevelNone,
-		llvm.RelocDefault,
-		llvm.CodeModelDefault)
+		codeGenLevels[opt.OptLevel],
+		reloc,
+		codeModels[opt.CodeModel])
 	defer tm.Dispose()
 
 	td := tm.CreateTargetData()
@@ -288,43 +207,179 @@ func GenLLVM(opt util.Options, root *ast.Node) error {
 
 	m.SetDataLayout(td.String())
 	m.SetTarget(tm.Triple())
+	if abi := opt.TargetABI(); len(abi) > 0 {
+		// The code generator reads the ABI from a module flag, like the modules generated by clang.
+		m.AddNamedMetadataOperand("llvm.module.flags", ctx.MDNode([]llvm.Metadata{
+			llvm.ConstInt(ctx.Int32Type(), 1, false).ConstantAsMetadata(), // Error if linked modules disagree.
+			ctx.MDString("target-abi"),
+			ctx.MDString(abi),
+		}))
+	}
 
-	// Set target file type.
-	ft := llvm.ObjectFile
-
-	// Compile target and store in memory.
-	buf, err := tm.EmitToMemoryBuffer(m, ft)
-	if err != nil {
-		return err
-	} else if buf.IsNil() {
-		return errors.New("could not emit compiled code to memory")
+	// Run middle-end optimisations on the module.
+	optimise(opt, m)
+	if opt.Log.Logging(util.LogDebug) && (opt.OptLevel > 0 || opt.OptSize) {
+		opt.Log.Dump("Optimised LLVM IR", m.String())
 	}
 
-	// Open/create file and write compiled code to output file.
-	var out string
-	if len(opt.Out) > 0 {
-		out = opt.Out
-	} else {
-		out = fmt.Sprintf("./%s.o", strings.TrimSuffix(filepath.Base(opt.Src), filepath.Ext(opt.Src)))
+	// Write the artifacts produced by LLVM. Tokens and the syntax tree are emitted before LLVM IR is generated.
+	for _, e1 := range opt.Artifacts() {
+		var b []byte
+		switch e1.Kind {
+		case util.EmitLLVMIR:
+			if err := writeText(opt, opt.Output(e1), m.String()); err != nil {
+				return err
+			}
+			continue
+		case util.EmitAsm:
+			// Compile target to assembler, which is written like the output of the native backends.
+			buf, err := tm.EmitToMemoryBuffer(m, llvm.AssemblyFile)
+			if err != nil {
+				return err
+			} else if buf.IsNil() {
+				return errors.New("could not emit assembler to memory")
+			}
+			s := string(buf.Bytes())
+			buf.Dispose()
+			if err := writeText(opt, opt.Output(e1), s); err != nil {
+				return err
+			}
+			continue
+		case util.EmitLLVMBC:
+			buf := llvm.WriteBitcodeToMemoryBuffer(m)
+			b = buf.Bytes()
+			buf.Dispose()
+		case util.EmitObj:
+			// Compile target and store in memory.
+			buf, err := tm.EmitToMemoryBuffer(m, llvm.ObjectFile)
+			if err != nil {
+				return err
+			} else if buf.IsNil() {
+				return errors.New("could not emit compiled code to memory")
+			}
+			b = buf.Bytes()
+			buf.Dispose()
+		default:
+			continue
+		}
+		if err := writeArtifact(opt.Output(e1), b); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	// Write to file sequentially.
-	if fd, err := os.OpenFile(out, os.O_CREATE|os.O_WRONLY, 0755); err != nil {
+// writeArtifact writes b to the file at path out, or stdout if out is empty.
+func writeArtifact(out string, b []byte) error {
+	if len(out) == 0 {
+		_, err := os.Stdout.Write(b)
 		return err
-	} else {
-		defer func() {
-			if err := fd.Close(); err != nil {
+	}
+	return ioutil.WriteFile(out, b, 0644)
+}
+
+// writeText writes the text s to the file at path out, or stdout if out is empty, through a Writer like the textual
+// output of the compiler.
+func writeText(opt util.Options, out, s string) error {
+	f := os.Stdout
+	if len(out) > 0 {
+		var err error
+		if f, err = os.OpenFile(out, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644); err != nil {
+			return err
+		}
+		defer func(f *os.File) {
+			if err := f.Close(); err != nil {
 				fmt.Println(err)
 			}
-		}()
-		if _, err2 := fd.Write(buf.Bytes()); err2 != nil {
-			return err
+		}(f)
+	}
+	opt.Dst = util.NewOutput(f)
+	wr := opt.NewWriter()
+	wr.WriteString(s)
+	wr.Close()
+	return opt.Dst.Err()
+}
+
+// genParallel generates the bodies of the n functions of the syntax tree root on a pool of opt.Threads worker go
+// routines, and links them into the LLVM module m. LLVM contexts aren't thread safe, hence every worker generates the
+// functions it's given into a module of its own context, which declares the global variables and functions of the
+// program. The worker modules are passed to the context of m as bitcode.
+func (g *generator) genParallel(opt util.Options, m llvm.Module, root *ast.Node, n int) error {
+	pool := opt.NewPool("llvm")
+	ws := make([]*worker, pool.Workers(n))
+	defer func() {
+		for _, e1 := range ws {
+			if e1 != nil {
+				e1.m.Dispose()
+				e1.b.Dispose()
+				e1.ctx.Dispose()
+			}
+		}
+	}()
+
+	if err := pool.Run(opt.Context(), n, func(w *util.Worker, i int) error {
+		wk := ws[w.ID]
+		if wk == nil {
+			// Create the module of the worker on its first job.
+			ctx := llvm.NewContext()
+			wk = &worker{ctx: ctx, b: ctx.NewBuilder(), m: ctx.NewModule(filepath.Base(opt.Src))}
+			ws[w.ID] = wk
+			funcs, err := g.genDeclarations(wk.m, root, false)
+			if err != nil {
+				return err
+			}
+			wk.funcs = funcs
 		}
+		if wk.funcs == nil {
+			return nil // The declaration error is reported by the worker's first job.
+		}
+		w.Log.Infof("generating function %s", wk.funcs[i].ll.Name())
+		return g.genFuncBody(wk.b, wk.m, wk.funcs[i].ll, wk.funcs[i].node)
+	}); err != nil {
+		return err
 	}
 
+	// Link worker modules into m. The context of m takes ownership of the bitcode buffers.
+	ctx := m.Context()
+	for _, e1 := range ws {
+		if e1 == nil {
+			continue // The worker's jobs were stolen before it started.
+		}
+		src, err := ctx.ParseIR(llvm.WriteBitcodeToMemoryBuffer(e1.m))
+		if err != nil {
+			return fmt.Errorf("could not parse functions generated in parallel: %s", err)
+		}
+		if err := llvm.LinkModules(m, src); err != nil {
+			return fmt.Errorf("could not link functions generated in parallel: %s", err)
+		}
+	}
 	return nil
 }
 
+// genDeclarations declares the global variables and functions of the syntax tree root in the LLVM module m, and
+// returns the declared functions. Global variables are defined in m if define is set, or declared as external
+// otherwise.
+func (g *generator) genDeclarations(m llvm.Module, root *ast.Node, define bool) ([]funcWrapper, error) {
+	funcs := make([]funcWrapper, 0, len(root.Children)) // Pre-allocate sufficient space for functions of root.
+	for _, e1 := range root.Children {
+		if e1.Typ == ast.FUNCTION {
+			if fun, err := g.genFuncHeader(m, e1); err != nil {
+				return nil, err
+			} else {
+				funcs = append(funcs, funcWrapper{ll: fun, node: e1})
+			}
+		} else if e1.Typ == ast.DECLARATION {
+			// Global variable declaration.
+			if err := g.genDeclarationGlobal(m, e1, define); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, fmt.Errorf("line %d:%d: expected FUNCTION or DECLARATION, got %s", e1.Line, e1.Pos, e1.Type())
+		}
+	}
+	return funcs, nil
+}
+
 // gen recursively generates LLVM IR by iterating the sub-tree of ast.Node n.
 //
 // Parameters:
@@ -339,41 +394,42 @@ func GenLLVM(opt util.Options, root *ast.Node) error {
 //
 // bool		-	Set true if the sub-tree generated a RETURN statement which terminates the current basic block.
 // error	-	<nil> if everything went ok, error message if something went wrong.
-func gen(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st, ls *util.Stack) (bool, error) {
+func (g *generator) gen(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *scopes,
+	ls *loops) (bool, error) {
 	ret := false
 	var err error
 	switch n.Typ {
 	case ast.BLOCK:
 		// Add new scope.
-		st.Push(&symTab{
+		st.push(&symTab{
 			m:       make(map[string]llvm.Value, mapSize),
 			RWMutex: sync.RWMutex{},
 		})
 		for _, e1 := range n.Children {
-			if ret, err = gen(b, m, fun, e1, st, ls); err != nil {
-				st.Pop()
+			if ret, err = g.gen(b, m, fun, e1, st, ls); err != nil {
+				st.pop()
 				return ret, err
 			}
 		}
-		st.Pop()
-	case ast.PRINT_STATEMENT:
-		if err = genPrint(b, m, fun, n, st); err != nil {
+		st.pop()
+	case ast.PRINT_STATEMENT, ast.PRINTF_STATEMENT:
+		if err = g.genPrint(b, m, fun, n, st); err != nil {
 			return ret, err
 		}
 	case ast.ASSIGNMENT_STATEMENT:
-		if err = genAssign(b, m, fun, n, st); err != nil {
+		if err = g.genAssign(b, m, fun, n, st); err != nil {
 			return ret, err
 		}
 	case ast.DECLARATION:
-		if err = genDeclaration(b, n, st); err != nil {
+		if err = g.genDeclaration(b, m, n, st); err != nil {
 			return ret, err
 		}
 	case ast.WHILE_STATEMENT:
-		if err = genWhile(b, m, fun, n, st, ls); err != nil {
+		if err = g.genWhile(b, m, fun, n, st, ls); err != nil {
 			return ret, err
 		}
 	case ast.IF_STATEMENT:
-		if err = genIf(b, m, fun, n, st, ls); err != nil {
+		if err = g.genIf(b, m, fun, n, st, ls); err != nil {
 			return ret, err
 		}
 	case ast.NULL_STATEMENT:
@@ -381,14 +437,14 @@ func gen(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st, ls *uti
 			return ret, err
 		}
 	case ast.RETURN_STATEMENT:
-		if err = genReturn(b, m, fun, n, st); err != nil {
+		if err = g.genReturn(b, m, fun, n, st); err != nil {
 			return true, err
 		}
 		return true, nil
 	default:
 		// Recursively generate LLVM IR.
 		for _, e1 := range n.Children {
-			if ret, err = gen(b, m, fun, e1, st, ls); err != nil {
+			if ret, err = g.gen(b, m, fun, e1, st, ls); err != nil {
 				return ret, err
 			}
 		}
@@ -398,13 +454,13 @@ func gen(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st, ls *uti
 
 // genFuncHeader generates the LLVM IR declaration of a function. The declaration defines a function's name, parameters
 // and return type.
-func genFuncHeader(m llvm.Module, n *ast.Node) (llvm.Value, error) {
+func (g *generator) genFuncHeader(m llvm.Module, n *ast.Node) (llvm.Value, error) {
 	if n.Typ != ast.FUNCTION {
 		return llvm.Value{}, fmt.Errorf("expected node type FUNCTION, got %s", n.String())
 	}
 
 	// Function's name.
-	name := n.Children[0].Data.(string)
+	name := n.Children[0].Data.Str
 	for _, e1 := range reservedFunctionNames {
 		if e1 == name {
 			return llvm.Value{},
@@ -414,7 +470,7 @@ func genFuncHeader(m llvm.Module, n *ast.Node) (llvm.Value, error) {
 	}
 
 	// Define function's return type.
-	ret, err := genType(n.Children[1])
+	ret, err := g.genType(m, n.Children[1])
 	if err != nil {
 		return llvm.Value{}, err
 	}
@@ -424,51 +480,44 @@ func genFuncHeader(m llvm.Module, n *ast.Node) (llvm.Value, error) {
 	aname := make([]string, 0, 8)   // Assume no more than 8 parameters.
 	for _, e1 := range n.Children[2].Children {
 		// Typed variable list.
-		typ, err := genType(n.Children[1])
+		typ, err := g.genType(m, e1)
 		if err != nil {
 			return llvm.Value{}, err
 		}
 		for _, e2 := range e1.Children {
 			// Identifiers.
 			atyp = append(atyp, typ)
-			aname = append(aname, e2.Data.(string))
+			aname = append(aname, e2.Data.Str)
 		}
 	}
-	ftyp := llvm.FunctionType(ret, atyp, false) // TODO: Sigseg during parallel.
-
-	// Used mutex for parallel thread safety.
-	globals.Lock()
-	defer globals.Unlock()
+	ftyp := llvm.FunctionType(ret, atyp, false)
 
 	// Check for duplicate declarations.
-	if val, ok := globals.m[name]; ok {
-		if !val.IsAFunction().IsNil() {
-			return llvm.Value{}, fmt.Errorf("duplicate declaration, function %q already declared", name)
-		}
+	if !m.NamedFunction(name).IsNil() {
+		return llvm.Value{}, fmt.Errorf("duplicate declaration, function %q already declared", name)
+	}
+	if !m.NamedGlobal(name).IsNil() {
 		return llvm.Value{}, fmt.Errorf("duplicate declaration, global identifer %q already declared", name)
 	}
 
 	// Declare function in module m.
-	fun := llvm.AddFunction(m, name, ftyp) // TODO: sigseg during parallel run.
+	fun := llvm.AddFunction(m, name, ftyp)
 
 	// Set parameter names.
 	for i1, e1 := range fun.Params() {
 		e1.SetName(aname[i1])
 	}
-
-	// Add function to global symbol table.
-	globals.m[name] = fun
 	return fun, nil
 }
 
 // genFuncBody generates the LLVM IR definition fo a function. A function definition defines a function's executing
 // instructions that's run when the function is called.
-func genFuncBody(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node) error {
-	st := util.Stack{} // Scope stack.
-	ls := util.Stack{} // GlobalSeq stack for loops.
+func (g *generator) genFuncBody(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node) error {
+	st := scopes{} // Scope stack.
+	ls := loops{}  // GlobalSeq stack for loops.
 
 	// Create new basic block for function body.
-	bb := llvm.AddBasicBlock(fun, "")
+	bb := m.Context().AddBasicBlock(fun, "")
 	b.SetInsertPointAtEnd(bb)
 
 	// Allocate memory for the function's parameters.
@@ -477,7 +526,7 @@ func genFuncBody(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node) err
 		RWMutex: sync.RWMutex{},
 	}
 	for _, e1 := range fun.Params() {
-		alloc := b.CreateAlloca(e1.Type(), "") // Allocate stack memory for parameter e1. TODO: Sigseg during parallel.
+		alloc := b.CreateAlloca(e1.Type(), "") // Allocate stack memory for parameter e1.
 		b.CreateStore(e1, alloc)               // Store the value passed to parameter e1 to stack.
 		fscope.Lock()
 		fscope.m[e1.Name()] = alloc            // Put variable holding parameter e1 on scope stack.
@@ -485,43 +534,47 @@ func genFuncBody(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node) err
 	}
 
 	// Push the function parameters to the bottom of the stack.
-	st.Push(&fscope)
-	defer st.Pop()
+	st.push(&fscope)
+	defer st.pop()
 
 	// Generate function body recursively.
-	if _, err := gen(b, m, fun, n, &st, &ls); err != nil {
+	if _, err := g.gen(b, m, fun, n, &st, &ls); err != nil {
 		return err
 	}
 	return nil
 }
 
 // genExpression generates LLVM IR from the expression ast.Node n.
-func genExpression(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *util.Stack) (llvm.Value, error) {
+func (g *generator) genExpression(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node,
+	st *scopes) (llvm.Value, error) {
 	c1 := n.Children[0]
 	var res llvm.Value
 
-	if n.Data == nil {
+	if n.Data.IsZero() {
 		// Function call.
-		name := c1.Data.(string)
+		name := c1.Data.Str
 		var target llvm.Value
 
-		// Find function in module.
+		// Find function in module. Calls of builtin functions in expressions compute with integer arguments.
+		if builtin(m, n) {
+			return g.genBuiltin(b, m, fun, n, st, g.intType(m))
+		}
 		if target = m.NamedFunction(name); target.IsAFunction().IsNil() {
-			return res, fmt.Errorf("undeclared function %q", name)
+			return res, c1.TypeErrorf("undeclared function %q", name)
 		}
 
 		params := target.Params()
 		args := make([]llvm.Value, len(params))
 
 		if len(n.Children[1].Children) == 0 && len(params) != 0 {
-			return llvm.Value{}, fmt.Errorf("function %q expects %d parameters, got %d",
+			return llvm.Value{}, n.TypeErrorf("function %q expects %d parameters, got %d",
 				name, len(args), len(n.Children[1].Children))
 		}
 		if len(n.Children[1].Children) > 0 {
 
 			c2 := n.Children[1].Children[0] // ast.EXPRESSION_LIST. List with all arguments.
 			if len(args) != len(c2.Children) {
-				return llvm.Value{}, fmt.Errorf("function %q expects %d parameters, got %d",
+				return llvm.Value{}, n.TypeErrorf("function %q expects %d parameters, got %d",
 					name, len(args), len(c2.Children))
 			}
 
@@ -529,17 +582,17 @@ func genExpression(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, s
 				// Load argument.
 				switch e1.Typ {
 				case ast.INTEGER_DATA:
-					args[i1] = llvm.ConstInt(i, uint64(e1.Data.(int)), true)
+					args[i1] = llvm.ConstInt(g.intType(m), uint64(e1.Data.Int), true)
 				case ast.FLOAT_DATA:
-					args[i1] = llvm.ConstFloat(f, e1.Data.(float64))
+					args[i1] = llvm.ConstFloat(g.floatType(m), e1.Data.Float)
 				case ast.EXPRESSION:
-					if r, err := genExpression(b, m, fun, e1, st); err != nil {
+					if r, err := g.genValue(b, m, fun, e1, st, params[i1].Type()); err != nil {
 						return llvm.Value{}, err
 					} else {
 						args[i1] = r
 					}
 				case ast.IDENTIFIER_DATA:
-					if r, err := genLoad(e1.Data.(string), b, m, fun, st); err != nil {
+					if r, err := genLoad(e1.Data.Str, b, m, fun, st); err != nil {
 						return llvm.Value{}, err
 					} else {
 						args[i1] = r
@@ -548,6 +601,10 @@ func genExpression(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, s
 			}
 		}
 
+		// Convert arguments to the types of the parameters.
+		for i1, e1 := range params {
+			args[i1] = g.genCast(b, m, args[i1], e1.Type())
+		}
 		return b.CreateCall(target, args, ""), nil
 	}
 	if len(n.Children) == 2 {
@@ -558,17 +615,17 @@ func genExpression(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, s
 		// Operand 1.
 		switch c1.Typ {
 		case ast.INTEGER_DATA:
-			op1 = llvm.ConstInt(i, uint64(c1.Data.(int)), true)
+			op1 = llvm.ConstInt(g.intType(m), uint64(c1.Data.Int), true)
 		case ast.FLOAT_DATA:
-			op1 = llvm.ConstFloat(f, c1.Data.(float64))
+			op1 = llvm.ConstFloat(g.floatType(m), c1.Data.Float)
 		case ast.EXPRESSION:
-			if r, err := genExpression(b, m, fun, c1, st); err != nil {
+			if r, err := g.genExpression(b, m, fun, c1, st); err != nil {
 				return res, err
 			} else {
 				op1 = r
 			}
 		case ast.IDENTIFIER_DATA:
-			if r, err := genLoad(c1.Data.(string), b, m, fun, st); err != nil {
+			if r, err := genLoad(c1.Data.Str, b, m, fun, st); err != nil {
 				return res, err
 			} else {
 				op1 = r
@@ -578,31 +635,66 @@ func genExpression(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, s
 		// Operand 2.
 		switch c2.Typ {
 		case ast.INTEGER_DATA:
-			op2 = llvm.ConstInt(i, uint64(c2.Data.(int)), true)
+			op2 = llvm.ConstInt(g.intType(m), uint64(c2.Data.Int), true)
 		case ast.FLOAT_DATA:
-			op2 = llvm.ConstFloat(f, c2.Data.(float64))
+			op2 = llvm.ConstFloat(g.floatType(m), c2.Data.Float)
 		case ast.EXPRESSION:
-			if r, err := genExpression(b, m, fun, c2, st); err != nil {
+			if r, err := g.genExpression(b, m, fun, c2, st); err != nil {
 				return res, err
 			} else {
 				op2 = r
 			}
 		case ast.IDENTIFIER_DATA:
-			if r, err := genLoad(c2.Data.(string), b, m, fun, st); err != nil {
+			if r, err := genLoad(c2.Data.Str, b, m, fun, st); err != nil {
 				return res, err
 			} else {
 				op2 = r
 			}
 		}
 
+		// Promote integer operand to float if the operands' types differ.
+		if op1.Type() != op2.Type() {
+			op1 = g.genCast(b, m, op1, g.floatType(m))
+			op2 = g.genCast(b, m, op2, g.floatType(m))
+		}
+
+		// Floats only have arithmetic operators.
+		if op1.Type() == g.floatType(m) {
+			switch n.Data.Str {
+			case "+":
+				res = b.CreateFAdd(op1, op2, "")
+			case "-":
+				res = b.CreateFSub(op1, op2, "")
+			case "*":
+				res = b.CreateFMul(op1, op2, "")
+			case "/":
+				res = b.CreateFDiv(op1, op2, "")
+			default:
+				return res, n.TypeErrorf("operator %q not defined for floats", n.Data.Str)
+			}
+			return res, nil
+		}
+
 		// Operator.
-		switch n.Data.(string) {
+		switch n.Data.Str {
 		case "+":
-			res = b.CreateAdd(op1, op2, "")
+			if g.trapv {
+				res = genChecked(b, m, "sadd", op1, op2)
+			} else {
+				res = b.CreateAdd(op1, op2, "")
+			}
 		case "-":
-			res = b.CreateSub(op1, op2, "")
+			if g.trapv {
+				res = genChecked(b, m, "ssub", op1, op2)
+			} else {
+				res = b.CreateSub(op1, op2, "")
+			}
 		case "*":
-			res = b.CreateMul(op1, op2, "")
+			if g.trapv {
+				res = genChecked(b, m, "smul", op1, op2)
+			} else {
+				res = b.CreateMul(op1, op2, "")
+			}
 		case "/":
 			res = b.CreateSDiv(op1, op2, "")
 		case "%":
@@ -610,6 +702,8 @@ func genExpression(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, s
 		case "<<":
 			res = b.CreateShl(op1, op2, "")
 		case ">>":
+			res = b.CreateAShr(op1, op2, "")
+		case ">>>":
 			res = b.CreateLShr(op1, op2, "")
 		case "|":
 			res = b.CreateOr(op1, op2, "")
@@ -618,7 +712,7 @@ func genExpression(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, s
 		case "^":
 			res = b.CreateXor(op1, op2, "")
 		default:
-			return res, fmt.Errorf("operator %q not defined for VSL", n.Data.(string))
+			return res, fmt.Errorf("operator %q not defined for VSL", n.Data.Str)
 		}
 		return res, nil
 	} else {
@@ -628,17 +722,17 @@ func genExpression(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, s
 		// Operand 1.
 		switch c1.Typ {
 		case ast.INTEGER_DATA:
-			op1 = llvm.ConstInt(i, uint64(c1.Data.(int)), true)
+			op1 = llvm.ConstInt(g.intType(m), uint64(c1.Data.Int), true)
 		case ast.FLOAT_DATA:
-			op1 = llvm.ConstFloat(f, c1.Data.(float64))
+			op1 = llvm.ConstFloat(g.floatType(m), c1.Data.Float)
 		case ast.EXPRESSION:
-			if r, err := genExpression(b, m, fun, c1, st); err != nil {
+			if r, err := g.genExpression(b, m, fun, c1, st); err != nil {
 				return llvm.Value{}, err
 			} else {
 				op1 = r
 			}
 		case ast.IDENTIFIER_DATA:
-			if r, err := genLoad(c1.Data.(string), b, m, fun, st); err != nil {
+			if r, err := genLoad(c1.Data.Str, b, m, fun, st); err != nil {
 				return res, err
 			} else {
 				op1 = r
@@ -646,34 +740,44 @@ func genExpression(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, s
 		}
 
 		// Operator.
-		switch n.Data.(string) {
+		switch n.Data.Str {
 		case "-":
-			res = b.CreateSub(llvm.ConstInt(i, 0, false), op1, "")
+			if op1.Type() == g.floatType(m) {
+				res = b.CreateFNeg(op1, "")
+				break
+			}
+			if g.trapv {
+				res = genChecked(b, m, "ssub", llvm.ConstInt(g.intType(m), 0, false), op1)
+				break
+			}
+			res = b.CreateSub(llvm.ConstInt(g.intType(m), 0, false), op1, "")
 		case "~":
-			res = b.CreateXor(llvm.ConstInt(i, ^uint64(0), false), op1, "")
+			if op1.Type() == g.floatType(m) {
+				return res, n.TypeErrorf("operator %q not defined for floats", n.Data.Str)
+			}
+			res = b.CreateXor(llvm.ConstInt(g.intType(m), ^uint64(0), false), op1, "")
 		default:
-			return res, fmt.Errorf("line %d:%d: unsupported unary operator %q",
-				n.Line, n.Pos, n.Data.(string))
+			return res, n.TypeErrorf("unsupported unary operator %q", n.Data.Str)
 		}
 		return res, nil
 	}
 }
 
 // genDeclaration generates LLVM IR that declares one or many new local variables in the inner-most scope.
-func genDeclaration(b llvm.Builder, n *ast.Node, st *util.Stack) error {
-	typ, err := genType(n)
+func (g *generator) genDeclaration(b llvm.Builder, m llvm.Module, n *ast.Node, st *scopes) error {
+	typ, err := g.genType(m, n)
 	if err != nil {
 		return fmt.Errorf("genDeclaration(): %s. Node was %s", err, n.String())
 	}
 
-	if scope := st.Peek().(*symTab); scope != nil {
+	if scope := st.top(); scope != nil {
 		for _, e1 := range n.Children[0].Children {
-			name := e1.Data.(string)
+			name := e1.Data.Str
 			if _, ok := scope.m[name]; ok {
 				return fmt.Errorf("duplicate variable declaration, %q is already declared in the same scope",
 					name)
 			}
-			val := b.CreateAlloca(typ, name) // TODO: Sigseg during parallel.
+			val := b.CreateAlloca(typ, name)
 			scope.m[name] = val
 		}
 		return nil
@@ -681,61 +785,60 @@ func genDeclaration(b llvm.Builder, n *ast.Node, st *util.Stack) error {
 	return errors.New("compiler error, no scope on the scope stack")
 }
 
-// genDeclarationGlobal generates LLVM IR that declares a global variable and adds it to the global symbol table.
-func genDeclarationGlobal(m llvm.Module, n *ast.Node) error {
-	typ, err := genType(n)
+// genDeclarationGlobal generates LLVM IR that declares a global variable in the LLVM module m. The variable is
+// defined and zero initialised if define is set, or declared as external otherwise.
+func (g *generator) genDeclarationGlobal(m llvm.Module, n *ast.Node, define bool) error {
+	typ, err := g.genType(m, n)
 	if err != nil {
 		return fmt.Errorf("genDeclarationGlobal(): %s. Node was %s", err, n.String())
 	}
 	for _, e1 := range n.Children[0].Children {
 		// Identifier names.
-		name := e1.Data.(string)
+		name := e1.Data.Str
 
-		// Look in global symbol table for duplicate declaration.
-		globals.Lock()
-		if _, ok := globals.m[name]; ok {
-			globals.Unlock()
+		// Look in module for duplicate declaration.
+		if !m.NamedGlobal(name).IsNil() || !m.NamedFunction(name).IsNil() {
 			return fmt.Errorf("duplicate declaration, identifier %q already exists", name)
 		}
 
-		// Create global variable and add it to the global symbol table.
-		g := llvm.AddGlobal(m, typ, name)
-		g.SetInitializer(g)
-		globals.m[name] = g
-		globals.Unlock()
+		// Create global variable.
+		v := llvm.AddGlobal(m, typ, name)
+		if define {
+			v.SetInitializer(llvm.ConstNull(typ))
+		}
 	}
 	return nil
 }
 
 // genAssign generates LLVM IR that assigns a value to an existing variable.
-func genAssign(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *util.Stack) error {
-	name := n.Children[0].Data.(string)
+func (g *generator) genAssign(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *scopes) error {
+	name := n.Children[0].Data.Str
 	c1 := n.Children[1]
 
 	switch c1.Typ {
 	case ast.INTEGER_DATA:
-		cnst := llvm.ConstInt(i, uint64(c1.Data.(int)), true)
-		if err := genStore(cnst, name, b, m, fun, st); err != nil {
+		cnst := llvm.ConstInt(g.intType(m), uint64(c1.Data.Int), true)
+		if err := g.genStore(cnst, name, b, m, fun, st); err != nil {
 			return err
 		}
 	case ast.FLOAT_DATA:
-		cnst := llvm.ConstFloat(f, c1.Data.(float64))
-		if err := genStore(cnst, name, b, m, fun, st); err != nil {
+		cnst := llvm.ConstFloat(g.floatType(m), c1.Data.Float)
+		if err := g.genStore(cnst, name, b, m, fun, st); err != nil {
 			return err
 		}
 	case ast.EXPRESSION:
-		if tmp1, err := genExpression(b, m, fun, c1, st); err != nil {
+		if tmp1, err := g.genValue(b, m, fun, c1, st, g.varType(name, m, st)); err != nil {
 			return err
 		} else {
-			if err = genStore(tmp1, name, b, m, fun, st); err != nil {
+			if err = g.genStore(tmp1, name, b, m, fun, st); err != nil {
 				return err
 			}
 		}
 	case ast.IDENTIFIER_DATA:
-		if src, err := genLoad(c1.Data.(string), b, m, fun, st); err != nil {
+		if src, err := genLoad(c1.Data.Str, b, m, fun, st); err != nil {
 			return err
 		} else {
-			if err = genStore(src, name, b, m, fun, st); err != nil {
+			if err = g.genStore(src, name, b, m, fun, st); err != nil {
 				return err
 			}
 		}
@@ -744,39 +847,35 @@ func genAssign(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *u
 }
 
 // genReturn generates LLVM IR that terminates the current basic block with a return statement.
-func genReturn(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *util.Stack) error {
+func (g *generator) genReturn(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *scopes) error {
 	c1 := n.Children[0]
+	ret := fun.Type().ElementType().ReturnType() // Return values are converted to the function's return type.
 	switch c1.Typ {
 	case ast.INTEGER_DATA:
-		b.CreateRet(llvm.ConstInt(i, uint64(c1.Data.(int)), true))
+		b.CreateRet(g.genCast(b, m, llvm.ConstInt(g.intType(m), uint64(c1.Data.Int), true), ret))
 	case ast.FLOAT_DATA:
-		b.CreateRet(llvm.ConstFloat(f, c1.Data.(float64)))
+		b.CreateRet(g.genCast(b, m, llvm.ConstFloat(g.floatType(m), c1.Data.Float), ret))
 	case ast.EXPRESSION:
-		if val, err := genExpression(b, m, fun, c1, st); err != nil {
+		if val, err := g.genValue(b, m, fun, c1, st, ret); err != nil {
 			return err
 		} else {
-			b.CreateRet(val)
+			b.CreateRet(g.genCast(b, m, val, ret))
 		}
 	case ast.IDENTIFIER_DATA:
-		if val, err := genLoad(c1.Data.(string), b, m, fun, st); err != nil {
+		if val, err := genLoad(c1.Data.Str, b, m, fun, st); err != nil {
 			return err
 		} else {
-			b.CreateRet(val)
+			b.CreateRet(g.genCast(b, m, val, ret))
 		}
 	}
 	return nil
 }
 
 // genPrint generates LLVM IR that calls printf to print constants, identifiers or expressions.
-func genPrint(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *util.Stack) error {
-	var pf llvm.Value
-
-	// Check if printf is defined.
-	globals.Lock()
-	if pf = m.NamedFunction("printf"); pf.IsAFunction().IsNil() {
-		pf = genPrintf(m)
+func (g *generator) genPrint(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *scopes) error {
+	if n.FormatPrint() {
+		return g.genPrintFormat(b, m, fun, n, st)
 	}
-	globals.Unlock()
 
 	// Build printf arguments.
 	args := make([]llvm.Value, len(n.Children[0].Children)+1)
@@ -785,33 +884,32 @@ func genPrint(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *ut
 		switch e1.Typ {
 		case ast.STRING_DATA:
 			sb.WriteString("%s")
-			globals.Lock()
-			s := b.CreateGlobalStringPtr(e1.Data.(string), stringPrefix)
-			globals.Unlock()
-			args[i1+1] = s
+			args[i1+1] = b.CreateGlobalStringPtr(e1.Data.Str, stringPrefix)
 		case ast.INTEGER_DATA:
-			sb.WriteString("%d")
-			args[i1+1] = llvm.ConstInt(i, uint64(e1.Data.(int)), true)
+			sb.WriteString(ast.IntConversion(g.intBits))
+			args[i1+1] = llvm.ConstInt(g.intType(m), uint64(e1.Data.Int), true)
 		case ast.FLOAT_DATA:
 			sb.WriteString("%f")
-			args[i1+1] = llvm.ConstFloat(f, e1.Data.(float64))
+			args[i1+1] = llvm.ConstFloat(g.floatType(m), e1.Data.Float)
 		case ast.EXPRESSION:
-			if val, err := genExpression(b, m, fun, e1, st); err != nil {
+			if val, err := g.genValue(b, m, fun, e1, st, llvm.PointerType(m.Context().Int8Type(), 0)); err != nil {
 				return err
 			} else {
-				if val.Type() == i {
-					sb.WriteString("%d")
+				if val.Type() == g.intType(m) {
+					sb.WriteString(ast.IntConversion(g.intBits))
+				} else if val.Type().TypeKind() == llvm.PointerTypeKind {
+					sb.WriteString("%s")
 				} else {
 					sb.WriteString("%f")
 				}
 				args[i1+1] = val
 			}
 		case ast.IDENTIFIER_DATA:
-			if val, err := genLoad(e1.Data.(string), b, m, fun, st); err != nil {
+			if val, err := genLoad(e1.Data.Str, b, m, fun, st); err != nil {
 				return err
 			} else {
-				if val.Type() == i {
-					sb.WriteString("%d")
+				if val.Type() == g.intType(m) {
+					sb.WriteString(ast.IntConversion(g.intBits))
 				} else {
 					sb.WriteString("%f")
 				}
@@ -832,21 +930,102 @@ func genPrint(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *ut
 	sb.WriteRune('\n')
 
 	// Construct format string and store in globals.
-	globals.Lock()
 	frmt := b.CreateGlobalStringPtr(sb.String(), stringPrefix)
-	globals.Unlock()
+
+	// Variadic float arguments are passed as doubles.
+	for i1, e1 := range args[1:] {
+		if e1.Type() == m.Context().FloatType() {
+			args[i1+1] = b.CreateFPExt(e1, m.Context().DoubleType(), "")
+		}
+	}
 
 	// Prepend format string to arguments.
 	args[0] = frmt
 
 	// Call printf.
-	b.CreateCall(pf, args, "")
+	genPrintCall(b, m, n, args)
 
 	return nil
 }
 
+// genPrintFormat generates LLVM IR for the format print statement n, whose format string is passed to printf followed
+// by a newline, with the length modifier of the integers in its %d conversions. The types of variables and expressions are verified against the conversion specifications
+// of the format string.
+func (g *generator) genPrintFormat(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *scopes) error {
+	items := n.Children[0].Children
+	format := items[0].Data.Str
+	verbs, err := ast.Verbs(format)
+	if err != nil {
+		return items[0].TypeErrorf("%v", err)
+	} else if len(verbs) != len(items)-1 {
+		return n.TypeErrorf("format string %q expects %d arguments, got %d", format, len(verbs), len(items)-1)
+	}
+	args := make([]llvm.Value, len(items))
+	args[0] = b.CreateGlobalStringPtr(ast.Format(format, g.intBits)+"\n", stringPrefix)
+	for i1, e1 := range items[1:] {
+		var val llvm.Value
+		switch e1.Typ {
+		case ast.INTEGER_DATA:
+			val = llvm.ConstInt(g.intType(m), uint64(e1.Data.Int), true)
+		case ast.FLOAT_DATA:
+			val = llvm.ConstFloat(g.floatType(m), e1.Data.Float)
+		case ast.EXPRESSION:
+			typ := g.floatType(m)
+			if verbs[i1] == 'd' {
+				typ = g.intType(m)
+			}
+			if val, err = g.genValue(b, m, fun, e1, st, typ); err != nil {
+				return err
+			}
+		case ast.IDENTIFIER_DATA:
+			if val, err = genLoad(e1.Data.Str, b, m, fun, st); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("format print statement expected argument of type INTEGER, FLOAT, EXPRESSION or "+
+				"IDENTIFIER, got %s", e1.Type())
+		}
+		if isInt := val.Type() == g.intType(m); isInt != (verbs[i1] == 'd') {
+			name := ast.DTyp[ast.DataFloat]
+			if isInt {
+				name = ast.DTyp[ast.DataInteger]
+			}
+			return e1.TypeErrorf("argument %d of format string %q is %s, expected %%%c", i1+1, format, name,
+				verbs[i1])
+		}
+
+		// Variadic float arguments are passed as doubles.
+		if val.Type() == m.Context().FloatType() {
+			val = b.CreateFPExt(val, m.Context().DoubleType(), "")
+		}
+		args[i1+1] = val
+	}
+	genPrintCall(b, m, n, args)
+	return nil
+}
+
+// genPrintCall generates the call to printf of the print statement n with the format string and arguments args. Print
+// statements redirected to another file call dprintf, which is passed the file descriptor first.
+func genPrintCall(b llvm.Builder, m llvm.Module, n *ast.Node, args []llvm.Value) {
+	if fd := n.File(); fd != ast.Stdout {
+		pf := m.NamedFunction("dprintf")
+		if pf.IsAFunction().IsNil() {
+			pf = genDprintf(m)
+		}
+		args = append([]llvm.Value{llvm.ConstInt(m.Context().Int32Type(), uint64(fd), false)}, args...)
+		b.CreateCall(pf, args, "")
+		return
+	}
+	pf := m.NamedFunction("printf")
+	if pf.IsAFunction().IsNil() {
+		pf = genPrintf(m)
+	}
+	b.CreateCall(pf, args, "")
+}
+
 // genRelation generates LLVM IR that compares two operands with the given relation.
-func genRelation(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *util.Stack) (llvm.Value, error) {
+func (g *generator) genRelation(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node,
+	st *scopes) (llvm.Value, error) {
 	c1 := n.Children[0]
 	c2 := n.Children[1]
 	var op1, op2 llvm.Value
@@ -854,17 +1033,17 @@ func genRelation(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st
 	// Operand 1.
 	switch c1.Typ {
 	case ast.INTEGER_DATA:
-		op1 = llvm.ConstInt(i, uint64(c1.Data.(int)), true)
+		op1 = llvm.ConstInt(g.intType(m), uint64(c1.Data.Int), true)
 	case ast.FLOAT_DATA:
-		op1 = llvm.ConstFloat(f, c1.Data.(float64))
+		op1 = llvm.ConstFloat(g.floatType(m), c1.Data.Float)
 	case ast.EXPRESSION:
-		if r, err := genExpression(b, m, fun, c1, st); err != nil {
+		if r, err := g.genExpression(b, m, fun, c1, st); err != nil {
 			return llvm.Value{}, err
 		} else {
 			op1 = r
 		}
 	case ast.IDENTIFIER_DATA:
-		if r, err := genLoad(c1.Data.(string), b, m, fun, st); err != nil {
+		if r, err := genLoad(c1.Data.Str, b, m, fun, st); err != nil {
 			return llvm.Value{}, err
 		} else {
 			op1 = r
@@ -874,64 +1053,70 @@ func genRelation(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st
 	// Operand 2.
 	switch c2.Typ {
 	case ast.INTEGER_DATA:
-		op2 = llvm.ConstInt(i, uint64(c2.Data.(int)), true)
+		op2 = llvm.ConstInt(g.intType(m), uint64(c2.Data.Int), true)
 	case ast.FLOAT_DATA:
-		op2 = llvm.ConstFloat(f, c2.Data.(float64))
+		op2 = llvm.ConstFloat(g.floatType(m), c2.Data.Float)
 	case ast.EXPRESSION:
-		if r, err := genExpression(b, m, fun, c2, st); err != nil {
+		if r, err := g.genExpression(b, m, fun, c2, st); err != nil {
 			return llvm.Value{}, err
 		} else {
 			op2 = r
 		}
 	case ast.IDENTIFIER_DATA:
-		if r, err := genLoad(c2.Data.(string), b, m, fun, st); err != nil {
+		if r, err := genLoad(c2.Data.Str, b, m, fun, st); err != nil {
 			return llvm.Value{}, err
 		} else {
 			op2 = r
 		}
 	}
 
+	// Compare as floats if the operands' types differ.
+	if op1.Type() != op2.Type() {
+		op1 = g.genCast(b, m, op1, g.floatType(m))
+		op2 = g.genCast(b, m, op2, g.floatType(m))
+	}
+
 	// Operator.
-	switch n.Data.(string) {
+	switch n.Data.Str {
 	case "=":
-		if op1.Type() == i {
+		if op1.Type() == g.intType(m) {
 			return b.CreateICmp(llvm.IntEQ, op1, op2, ""), nil
 		} else {
 			return b.CreateFCmp(llvm.FloatOEQ, op1, op2, ""), nil
 		}
 	case "<":
-		if op1.Type() == i {
+		if op1.Type() == g.intType(m) {
 			return b.CreateICmp(llvm.IntSLT, op1, op2, ""), nil
 		} else {
 			return b.CreateFCmp(llvm.FloatOLT, op1, op2, ""), nil
 		}
 	case ">":
-		if op1.Type() == i {
+		if op1.Type() == g.intType(m) {
 			return b.CreateICmp(llvm.IntSGT, op1, op2, ""), nil
 		} else {
 			return b.CreateFCmp(llvm.FloatOGT, op1, op2, ""), nil
 		}
 	default:
-		return llvm.Value{}, fmt.Errorf("undefined relation operator %q", n.Children[0].Data.(string))
+		return llvm.Value{}, fmt.Errorf("undefined relation operator %q", n.Children[0].Data.Str)
 	}
 }
 
 // genIf generates LLVM IR for either IF-THEN or IF-THEN-ELSE statements.
-func genIf(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st, ls *util.Stack) error {
+func (g *generator) genIf(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *scopes, ls *loops) error {
 	// Generate relation.
 	var conv llvm.BasicBlock
 	var val llvm.Value
 	var err error
-	if val, err = genRelation(b, m, fun, n.Children[0], st); err != nil {
+	if val, err = g.genRelation(b, m, fun, n.Children[0], st); err != nil {
 		return err
 	}
 
 	// Set up new basic block(s).
-	thn := llvm.AddBasicBlock(fun, "")
+	thn := m.Context().AddBasicBlock(fun, "")
 
 	if len(n.Children) == 2 {
 		// IF-THEN.
-		conv = llvm.AddBasicBlock(fun, "")
+		conv = m.Context().AddBasicBlock(fun, "")
 
 		// Generate branch.
 		b.CreateCondBr(val, thn, conv)
@@ -939,7 +1124,7 @@ func genIf(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st, ls *u
 		// Generate THEN.
 		b.SetInsertPointAtEnd(thn)
 		for _, e1 := range n.Children[1].Children {
-			if ret, err := gen(b, m, fun, e1, st, ls); err != nil {
+			if ret, err := g.gen(b, m, fun, e1, st, ls); err != nil {
 				return err
 			} else if !ret {
 				b.CreateBr(conv)
@@ -949,31 +1134,31 @@ func genIf(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st, ls *u
 	} else {
 		// IF-THEN-ELSE.
 		var retA, retB bool
-		els := llvm.AddBasicBlock(fun, "")
+		els := m.Context().AddBasicBlock(fun, "")
 
 		// Generate branch.
 		b.CreateCondBr(val, thn, els)
 
 		// Generate THEN.
 		b.SetInsertPointAtEnd(thn)
-		if retA, err = gen(b, m, fun, n.Children[1], st, ls); err != nil {
+		if retA, err = g.gen(b, m, fun, n.Children[1], st, ls); err != nil {
 			return err
 		}
 
 		if !retA {
-			conv = llvm.AddBasicBlock(fun, "")
+			conv = m.Context().AddBasicBlock(fun, "")
 			b.CreateBr(conv)
 		}
 
 		// Generate ELSE.
 		b.SetInsertPointAtEnd(els)
-		if retB, err = gen(b, m, fun, n.Children[2], st, ls); err != nil {
+		if retB, err = g.gen(b, m, fun, n.Children[2], st, ls); err != nil {
 			return err
 		}
 
 		if !retB {
 			if conv.IsNil() {
-				conv = llvm.AddBasicBlock(fun, "")
+				conv = m.Context().AddBasicBlock(fun, "")
 			}
 			b.CreateBr(conv)
 		}
@@ -987,18 +1172,18 @@ func genIf(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st, ls *u
 }
 
 // genWhile generates LLVM IR for loops of type WHILE(relation) DO.
-func genWhile(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st, ls *util.Stack) error {
-	head := llvm.AddBasicBlock(fun, "")
-	body := llvm.AddBasicBlock(fun, "")
-	conv := llvm.AddBasicBlock(fun, "")
+func (g *generator) genWhile(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st *scopes, ls *loops) error {
+	head := m.Context().AddBasicBlock(fun, "")
+	body := m.Context().AddBasicBlock(fun, "")
+	conv := m.Context().AddBasicBlock(fun, "")
 
 	// Push head to label stack for CONTINUE statement.
-	ls.Push(head)
+	ls.push(head)
 
 	// Generate relation and branch.
 	b.CreateBr(head)
 	b.SetInsertPointAtEnd(head)
-	rel, err := genRelation(b, m, fun, n.Children[0], st)
+	rel, err := g.genRelation(b, m, fun, n.Children[0], st)
 	if err != nil {
 		return err
 	}
@@ -1007,7 +1192,7 @@ func genWhile(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st, ls
 	// Generate WHILE body.
 	b.SetInsertPointAtEnd(body)
 
-	if ret, err := gen(b, m, fun, n.Children[1], st, ls); err != nil {
+	if ret, err := g.gen(b, m, fun, n.Children[1], st, ls); err != nil {
 		return err
 	} else if !ret {
 		// Jump back to loop head.
@@ -1018,36 +1203,29 @@ func genWhile(b llvm.Builder, m llvm.Module, fun llvm.Value, n *ast.Node, st, ls
 	b.SetInsertPointAtEnd(conv)
 
 	// Pop label stack.
-	ls.Pop()
+	ls.pop()
 	return nil
 }
 
 // genContinue generates LLVM IR for a continue statement for loops.
-func genContinue(b llvm.Builder, ls *util.Stack) error {
-	var l interface{}
-	if l = ls.Peek(); l == nil {
+func genContinue(b llvm.Builder, ls *loops) error {
+	if len(*ls) == 0 {
 		return errors.New("label stack is empty")
 	}
 
-	b.CreateBr(l.(llvm.BasicBlock))
+	b.CreateBr((*ls)[len(*ls)-1])
 	return nil
 }
 
 // genStore generates LLVM IR store instruction that stores the src llvm.Value in the requested identifier with
 // given name.
-func genStore(src llvm.Value, name string, b llvm.Builder, m llvm.Module, fun llvm.Value, st *util.Stack) error {
+func (g *generator) genStore(src llvm.Value, name string, b llvm.Builder, m llvm.Module, fun llvm.Value,
+	st *scopes) error {
 	// Check local scopes. Function parameters are on the bottom of the scope stack.
-	for i1 := 1; i1 <= st.Size(); i1++ {
-		if symtab := st.Get(i1).(*symTab); symtab != nil {
+	for i1 := len(*st) - 1; i1 >= 0; i1-- {
+		if symtab := (*st)[i1]; symtab != nil {
 			if dst, ok := symtab.m[name]; ok {
-				if src.Type() != dst.Type() {
-					if dst.Type() == i {
-						src = b.CreateSIToFP(src, i, "")
-					} else {
-						src = b.CreateSIToFP(src, f, "")
-					}
-				}
-				_ = b.CreateStore(src, dst)
+				_ = b.CreateStore(g.genCast(b, m, src, dst.Type().ElementType()), dst)
 				return nil
 			}
 		}
@@ -1055,26 +1233,19 @@ func genStore(src llvm.Value, name string, b llvm.Builder, m llvm.Module, fun ll
 
 	// Check global scope.
 	if dst := m.NamedGlobal(name); dst.IsNil() {
-		return fmt.Errorf("undeclared variable %q", name)
+		return util.TypeErrorf(0, 0, "undeclared variable %q", name)
 	} else {
-		if src.Type() != dst.Type().ElementType() {
-			if dst.Type() == i {
-				src = b.CreateSIToFP(src, i, "")
-			} else {
-				src = b.CreateSIToFP(src, f, "")
-			}
-		}
-		_ = b.CreateStore(src, dst)
+		_ = b.CreateStore(g.genCast(b, m, src, dst.Type().ElementType()), dst)
 		return nil
 	}
 }
 
 // genLoad generates LLVM IR load instruction for the requested identifier with given name and returns the
 // resulting llvm.Value.
-func genLoad(name string, b llvm.Builder, m llvm.Module, fun llvm.Value, st *util.Stack) (llvm.Value, error) {
+func genLoad(name string, b llvm.Builder, m llvm.Module, fun llvm.Value, st *scopes) (llvm.Value, error) {
 	// Check local scopes. Function parameters are on the bottom of the scope stack.
-	for i1 := 1; i1 <= st.Size(); i1++ {
-		if symtab := st.Get(i1).(*symTab); symtab != nil {
+	for i1 := len(*st) - 1; i1 >= 0; i1-- {
+		if symtab := (*st)[i1]; symtab != nil {
 			if src, ok := symtab.m[name]; ok {
 				return b.CreateLoad(src, ""), nil
 			}
@@ -1083,36 +1254,70 @@ func genLoad(name string, b llvm.Builder, m llvm.Module, fun llvm.Value, st *uti
 
 	// Check global scope.
 	if val := m.NamedGlobal(name); val.IsNil() {
-		return llvm.Value{}, fmt.Errorf("undeclared variable %q", name)
+		return llvm.Value{}, util.TypeErrorf(0, 0, "undeclared variable %q", name)
 	} else {
 		return b.CreateLoad(val, ""), nil
 	}
 }
 
+// genCast converts the value v to the type typ, like the implicit conversions of VSL: integers to floats by sitofp and
+// floats to integers by fptosi. v is returned as is if it already has the type typ.
+func (g *generator) genCast(b llvm.Builder, m llvm.Module, v llvm.Value, typ llvm.Type) llvm.Value {
+	switch {
+	case v.Type() == typ:
+		return v
+	case typ == g.intType(m):
+		return b.CreateFPToSI(v, typ, "")
+	default:
+		return b.CreateSIToFP(v, typ, "")
+	}
+}
+
 // genType takes an ast.TYPED_VARIABLE_LIST or ast.DECLARATION and returns the type of the data variable(s).
-func genType(n *ast.Node) (res llvm.Type, _ error) {
+func (g *generator) genType(m llvm.Module, n *ast.Node) (res llvm.Type, _ error) {
 	if n == nil {
 		return llvm.Type{}, errors.New("cannot generate LLVM type, node is <nil>")
 	}
-	if n.Data == nil {
+	if n.Data.IsZero() {
 		return res, errors.New("syntax tree node doesn't carry data")
 	}
-	switch n.Data.(string) {
+	switch n.Data.Str {
 	case "int":
-		return i, nil
+		return g.intType(m), nil
 	case "float":
-		return f, nil
+		return g.floatType(m), nil
 	default:
 		return res, fmt.Errorf("expected DECLARATION or TYPED_VARIABLE_LIST, got %s",
 			n.Type())
 	}
 }
 
+// intType returns the type of VSL integers, which have intBits bits, in the context of the LLVM module m.
+func (g *generator) intType(m llvm.Module) llvm.Type {
+	return m.Context().IntType(g.intBits)
+}
+
+// longType returns the long type of the target architecture in the context of the LLVM module m.
+func (g *generator) longType(m llvm.Module) llvm.Type {
+	if g.narrow {
+		return m.Context().Int32Type()
+	}
+	return m.Context().Int64Type()
+}
+
+// floatType returns the float type of the target architecture in the context of the LLVM module m.
+func (g *generator) floatType(m llvm.Module) llvm.Type {
+	if g.narrow {
+		return m.Context().FloatType()
+	}
+	return m.Context().DoubleType()
+}
+
 // genMain generates LLVM IR for the implicit main function. The main function takes the input arguments
 // from the operating system and calls the first function defined in the syntax tree.
-func genMain(b llvm.Builder, m llvm.Module, n *ast.Node) error {
+func (g *generator) genMain(b llvm.Builder, m llvm.Module, n *ast.Node) error {
 	var callee *ast.Node
-	var fun, atoi, atof llvm.Value
+	var fun, strtol, strtod llvm.Value
 
 	// Find first declared function.
 	for _, e1 := range n.Children {
@@ -1127,36 +1332,50 @@ func genMain(b llvm.Builder, m llvm.Module, n *ast.Node) error {
 	}
 
 	// Find the function's LLVM IR entry.
-	if fun = m.NamedFunction(callee.Children[0].Data.(string)); fun.IsNil() {
+	if fun = m.NamedFunction(callee.Children[0].Data.Str); fun.IsNil() {
 		return errors.New("first function does not have LLVM IR global declaration")
 	}
 
 	// Define main function.
 	var typ llvm.Type
-	switch callee.Children[1].Data.(string) {
+	switch callee.Children[1].Data.Str {
 	case "int":
-		typ = i
+		typ = g.intType(m)
 	case "float":
-		typ = f
+		typ = g.floatType(m)
 	default:
 		return fmt.Errorf("undefined return data type of function %q, expected int or float, got %s",
-			callee.Children[0].Data.(string), callee.Children[1].Data.(string))
+			callee.Children[0].Data.Str, callee.Children[1].Data.Str)
 	}
-	params := []llvm.Type{i, llvm.PointerType(llvm.PointerType(llvm.Int8Type(), 0), 0)}
-	ftyp := llvm.FunctionType(i, params, false)
+	params := []llvm.Type{g.intType(m), llvm.PointerType(llvm.PointerType(m.Context().Int8Type(), 0), 0)}
+	ftyp := llvm.FunctionType(g.intType(m), params, false)
 	main := llvm.AddFunction(m, "main", ftyp)
 	main.Param(0).SetName("argc")
 	main.Param(1).SetName("argv")
-	bb := llvm.AddBasicBlock(main, "")
+	bb := m.Context().AddBasicBlock(main, "")
 	b.SetInsertPointAtEnd(bb)
-	argcGood := llvm.AddBasicBlock(main, "argcGood")
-	argcBad := llvm.AddBasicBlock(main, "argcBad")
+	argcGood := m.Context().AddBasicBlock(main, "argcGood")
+	argcBad := m.Context().AddBasicBlock(main, "argcBad")
 	var argvBad llvm.BasicBlock
 
-	// Verify arguments before calling VSL function.
-	argc := b.CreateSub(main.Param(0), llvm.ConstInt(i, 1, true), "")
-	cmp := b.CreateICmp(llvm.IntEQ, argc, llvm.ConstInt(i, uint64(len(fun.Params())), true), "")
-	b.CreateCondBr(cmp, argcGood, argcBad)
+	// End pointer of the parsed arguments.
+	end := b.CreateAlloca(llvm.PointerType(m.Context().Int8Type(), 0), "end")
+
+	// Store argc and argv for the builtin functions argc and arg.
+	builtins := usesArgs(m)
+	if builtins {
+		genArgStore(b, m, main)
+	}
+
+	// Verify arguments before calling VSL function. Any number of arguments is accepted if they're read by the
+	// builtin functions instead.
+	argc := b.CreateSub(main.Param(0), llvm.ConstInt(g.intType(m), 1, true), "")
+	cmp := b.CreateICmp(llvm.IntEQ, argc, llvm.ConstInt(g.intType(m), uint64(len(fun.Params())), true), "")
+	if builtins && len(fun.Params()) == 0 {
+		b.CreateBr(argcGood)
+	} else {
+		b.CreateCondBr(cmp, argcGood, argcBad)
+	}
 
 	// Generate argc is ok.
 	b.SetInsertPointAtEnd(argcGood)
@@ -1168,23 +1387,25 @@ func genMain(b llvm.Builder, m llvm.Module, n *ast.Node) error {
 
 	// argv[1] is the first argument to the called function.
 	// i1 is the "iterator/incrementor" variable pointing to the right index of argv.
-	i1 := llvm.ConstInt(i, 1, false)
+	i1 := llvm.ConstInt(g.intType(m), 1, false)
 
 	// Compile time indexer.
 	idx := 0
 
 	if len(callee.Children[2].Children) > 0 {
-		argvBad = llvm.AddBasicBlock(main, "argvBad")
+		argvBad = m.Context().AddBasicBlock(main, "argvBad")
 		for _, e1 := range callee.Children[2].Children {
 			// Typed variable list.
-			typ, err := genType(e1)
+			typ, err := g.genType(m, e1)
 			if err != nil {
 				return err
 			}
-			if typ == i && atoi.IsAFunction().IsNil() {
-				atoi = genAtoi(m)
-			} else if atof.IsAFunction().IsNil() {
-				atof = genAtof(m)
+			if typ == g.intType(m) {
+				if strtol.IsAFunction().IsNil() {
+					strtol = g.genStrtol(m)
+				}
+			} else if strtod.IsAFunction().IsNil() {
+				strtod = genStrtod(m)
 			}
 
 			for range e1.Children {
@@ -1199,23 +1420,33 @@ func genMain(b llvm.Builder, m llvm.Module, n *ast.Node) error {
 					"")
 
 				var param llvm.Value
-				newBB := llvm.AddBasicBlock(main, "")
-				if typ == i {
-					param = b.CreateCall(atoi, []llvm.Value{b.CreateLoad(ptr, "")}, "")
-					cmp = b.CreateICmp(llvm.IntEQ, llvm.ConstInt(i, 0, false), param, "")
-					b.CreateCondBr(cmp, argvBad, newBB)
+				newBB := m.Context().AddBasicBlock(main, "")
+				str := b.CreateLoad(ptr, "")
+				if typ == g.intType(m) {
+					param = b.CreateCall(strtol, []llvm.Value{str, end,
+						llvm.ConstInt(m.Context().Int32Type(), 10, false)}, "")
+					param = b.CreateIntCast(param, typ, "")
 				} else {
-					param = b.CreateCall(atof, []llvm.Value{b.CreateLoad(ptr, "")}, "")
-					cmp = b.CreateFCmp(llvm.FloatOEQ, llvm.ConstFloat(f, 0.0), param, "")
-					b.CreateCondBr(cmp, argvBad, newBB)
+					param = b.CreateCall(strtod, []llvm.Value{str, end}, "")
+					if g.narrow {
+						param = b.CreateFPTrunc(param, g.floatType(m), "")
+					}
 				}
+
+				// The argument is bad unless it's non-empty and parsed to its end.
+				endp := b.CreateLoad(end, "")
+				cmp = b.CreateOr(
+					b.CreateICmp(llvm.IntEQ, endp, str, ""),
+					b.CreateICmp(llvm.IntNE, b.CreateLoad(endp, ""), llvm.ConstInt(m.Context().Int8Type(), 0, false), ""),
+					"")
+				b.CreateCondBr(cmp, argvBad, newBB)
 				b.SetInsertPointAtEnd(newBB)
 				if idx < len(fun.Params())-1 {
-					//ptr = b.CreateAdd(ptr, llvm.ConstInt(i, ib, false), "")
+					//ptr = b.CreateAdd(ptr, llvm.ConstInt(intType(m), ib, false), "")
 				}
 				args[idx] = param
 				idx++
-				i1 = b.CreateAdd(i1, llvm.ConstInt(i, 1, false), "")
+				i1 = b.CreateAdd(i1, llvm.ConstInt(g.intType(m), 1, false), "")
 			}
 		}
 	}
@@ -1224,12 +1455,12 @@ func genMain(b llvm.Builder, m llvm.Module, n *ast.Node) error {
 	ret := b.CreateCall(fun, args, "")
 
 	// Check return value and exit.
-	if typ == i {
+	if typ == g.intType(m) {
 		// Simply return the returned value.
 		b.CreateRet(ret)
 	} else {
 		// Cast to integer and return.
-		b.CreateRet(b.CreateFPToSI(ret, i, ""))
+		b.CreateRet(b.CreateFPToSI(ret, g.intType(m), ""))
 	}
 
 	// Generate param parse mismatch.
@@ -1246,7 +1477,7 @@ func genMain(b llvm.Builder, m llvm.Module, n *ast.Node) error {
 			"failed to parse argument\n",
 			stringPrefix)
 		b.CreateCall(pf, []llvm.Value{errMsg}, "")
-		b.CreateRet(llvm.ConstInt(i, 1, false))
+		b.CreateRet(llvm.ConstInt(g.intType(m), 1, false))
 	}
 
 	// Generate argc mismatch.
@@ -1257,31 +1488,155 @@ func genMain(b llvm.Builder, m llvm.Module, n *ast.Node) error {
 		stringPrefix)
 	errArgs := []llvm.Value{errMsg, argc}
 	b.CreateCall(pf, errArgs, "")
-	b.CreateRet(llvm.ConstInt(i, 1, false))
+	b.CreateRet(llvm.ConstInt(g.intType(m), 1, false))
 
+	if builtins {
+		g.genArgs(b, m)
+	}
 	return nil
 }
 
 // genPrintf generates the LLVM IR printf definition.
 func genPrintf(m llvm.Module) llvm.Value {
 	// Declare printf.
-	args := []llvm.Type{llvm.PointerType(llvm.Int8Type(), 0)}
-	ftyp := llvm.FunctionType(llvm.Int32Type(), args, true)
+	args := []llvm.Type{llvm.PointerType(m.Context().Int8Type(), 0)}
+	ftyp := llvm.FunctionType(m.Context().Int32Type(), args, true)
 	return llvm.AddFunction(m, "printf", ftyp)
 }
 
-// genAtof generates the Atoi function LLVM IR definition.
-func genAtoi(m llvm.Module) llvm.Value {
-	params := []llvm.Type{llvm.PointerType(llvm.Int8Type(), 0)}
-	ftyp := llvm.FunctionType(llvm.Int32Type(), params, false)
-	return llvm.AddFunction(m, "atoi", ftyp)
+// genDprintf generates the LLVM IR dprintf definition.
+func genDprintf(m llvm.Module) llvm.Value {
+	args := []llvm.Type{m.Context().Int32Type(), llvm.PointerType(m.Context().Int8Type(), 0)}
+	ftyp := llvm.FunctionType(m.Context().Int32Type(), args, true)
+	return llvm.AddFunction(m, "dprintf", ftyp)
+}
+
+// genStrtol generates the strtol function LLVM IR definition, whose result is of the long type of the target. It's
+// converted to the integer type of VSL by the callers.
+func (g *generator) genStrtol(m llvm.Module) llvm.Value {
+	str := llvm.PointerType(m.Context().Int8Type(), 0)
+	params := []llvm.Type{str, llvm.PointerType(str, 0), m.Context().Int32Type()}
+	ftyp := llvm.FunctionType(g.longType(m), params, false)
+	return llvm.AddFunction(m, "strtol", ftyp)
+}
+
+// genStrtod generates the strtod function LLVM IR definition.
+func genStrtod(m llvm.Module) llvm.Value {
+	str := llvm.PointerType(m.Context().Int8Type(), 0)
+	params := []llvm.Type{str, llvm.PointerType(str, 0)}
+	ftyp := llvm.FunctionType(m.Context().DoubleType(), params, false)
+	return llvm.AddFunction(m, "strtod", ftyp)
+}
+
+// verify verifies the LLVM module m. An error is returned with the verifier's output and the names of the functions
+// that have invalid IR, if any.
+func verify(m llvm.Module) error {
+	err := llvm.VerifyModule(m, llvm.ReturnStatusAction)
+	if err == nil {
+		return nil
+	}
+	var names []string
+	for fun := m.FirstFunction(); !fun.IsNil(); fun = llvm.NextFunction(fun) {
+		if !fun.IsDeclaration() && llvm.VerifyFunction(fun, llvm.ReturnStatusAction) != nil {
+			names = append(names, fun.Name())
+		}
+	}
+	if len(names) > 0 {
+		return fmt.Errorf("invalid LLVM IR in function %s:\n%s", strings.Join(names, ", "), strings.TrimSpace(err.Error()))
+	}
+	return fmt.Errorf("invalid LLVM IR:\n%s", strings.TrimSpace(err.Error()))
+}
+
+// optimise runs the function and module passes of the optimisation level opt.OptLevel on the LLVM module m. Small code
+// is preferred if opt.OptSize is set, which optimises at level 2 if no level is given, like clang -Os.
+func optimise(opt util.Options, m llvm.Module) {
+	level := opt.OptLevel
+	if opt.OptSize && level == 0 {
+		level = 2
+	}
+	if level == 0 {
+		return
+	}
+
+	pmb := llvm.NewPassManagerBuilder()
+	defer pmb.Dispose()
+	pmb.SetOptLevel(level)
+
+	// Inline functions with the same thresholds as clang.
+	switch {
+	case opt.OptSize:
+		pmb.SetSizeLevel(1)
+		pmb.UseInlinerWithThreshold(75)
+	case level > 2:
+		pmb.UseInlinerWithThreshold(275)
+	case level > 1:
+		pmb.UseInlinerWithThreshold(225)
+	}
+
+	// Function passes.
+	fpm := llvm.NewFunctionPassManagerForModule(m)
+	defer fpm.Dispose()
+	pmb.PopulateFunc(fpm)
+	fpm.InitializeFunc()
+	for fun := m.FirstFunction(); !fun.IsNil(); fun = llvm.NextFunction(fun) {
+		if !fun.IsDeclaration() {
+			fpm.RunFunc(fun)
+		}
+	}
+	fpm.FinalizeFunc()
+
+	// Module passes, including inlining.
+	mpm := llvm.NewPassManager()
+	defer mpm.Dispose()
+	pmb.Populate(mpm)
+	mpm.Run(m)
 }
 
-// genAtof generates the Atof function LLVM IR definition.
-func genAtof(m llvm.Module) llvm.Value {
-	params := []llvm.Type{llvm.PointerType(llvm.Int8Type(), 0)}
-	ftyp := llvm.FunctionType(llvm.DoubleType(), params, false)
-	return llvm.AddFunction(m, "atof", ftyp)
+// genTargetConfig returns the LLVM target CPU and features given the compiler options. The generic CPU of the target
+// architecture is used unless opt.CPU is set. RISC-V features default to the extensions of the ISA opt.March, or
+// rv64gc and rv32gc, which must match the ABI.
+func genTargetConfig(opt util.Options) (string, string, error) {
+	cpu := opt.CPU
+	if len(cpu) == 0 {
+		switch opt.TargetArch {
+		case util.Riscv64:
+			cpu = "generic-rv64"
+		case util.Riscv32:
+			cpu = "generic-rv32"
+		default:
+			cpu = "generic"
+		}
+	}
+	if len(opt.Features) > 0 || opt.TargetArch != util.Riscv64 && opt.TargetArch != util.Riscv32 {
+		return cpu, opt.Features, nil
+	}
+
+	march, prefix := opt.March, "lp64"
+	if opt.TargetArch == util.Riscv32 {
+		prefix = "ilp32"
+	}
+	if len(march) == 0 {
+		march = "rv64gc"
+		if opt.TargetArch == util.Riscv32 {
+			march = "rv32gc"
+		}
+	}
+	ext := util.Extensions(march)
+	var features []string
+	for _, e1 := range "mafdc" {
+		if ext[e1] {
+			features = append(features, "+"+string(e1))
+		}
+	}
+
+	// Floats are passed in floating point registers by the ABIs that end with f or d, which requires the extension.
+	abi := opt.TargetABI()
+	if fp := strings.TrimPrefix(abi, prefix); len(fp) == len(abi) || fp != "" && fp != "f" && fp != "d" {
+		return "", "", fmt.Errorf("unexpected ABI %s for %s, expected %s, %sf or %sd", abi, march, prefix, prefix, prefix)
+	} else if fp != "" && !ext[rune(fp[0])] {
+		return "", "", fmt.Errorf("ABI %s requires the %s extension, which ISA %s lacks", abi, strings.ToUpper(fp), march)
+	}
+	return cpu, strings.Join(features, ","), nil
 }
 
 // genTargetTriple generates an LLVM target triple given the compiler options.
@@ -1290,7 +1645,10 @@ func genTargetTriple(opt *util.Options) (llvm.Target, string, error) {
 	var triple string
 
 	// Target architecture. Revert to host system default if unknown.
-	if opt.TargetArch == util.UnknownArch {
+	if len(opt.Triple) > 0 {
+		// Used the target triple given on the command line.
+		triple = opt.Triple
+	} else if opt.TargetArch == util.UnknownArch {
 		// Used compiler host's default triple.
 		triple = llvm.DefaultTargetTriple()
 	} else {
@@ -1304,6 +1662,8 @@ func genTargetTriple(opt *util.Options) (llvm.Target, string, error) {
 			sb.WriteString("riscv64")
 		case util.Riscv32:
 			sb.WriteString("riscv32")
+		case util.Armv7:
+			sb.WriteString("armv7")
 		case util.X86_64:
 			sb.WriteString("x86_64")
 		case util.X86_32:
@@ -1349,14 +1709,17 @@ func genTargetTriple(opt *util.Options) (llvm.Target, string, error) {
 
 		// Target abi/environment.
 		sb.WriteRune('-')
-		sb.WriteString("gnu") // Default to GNU for now.
+		if opt.TargetArch == util.Armv7 {
+			// Hard-float EABI, which passes floats in VFP registers.
+			sb.WriteString("gnueabihf")
+		} else {
+			sb.WriteString("gnu") // Default to GNU for now.
+		}
 
 		triple = sb.String()
 	}
 
-	if opt.Verbose {
-		fmt.Printf("compiling for target %s\n", triple)
-	}
+	opt.Log.Infof("compiling for target %s", triple)
 	llvm.InitializeAllTargets()
 	if tt, err := llvm.GetTargetFromTriple(triple); err != nil {
 		return llvm.Target{}, "", err
@@ -1364,3 +1727,31 @@ func genTargetTriple(opt *util.Options) (llvm.Target, string, error) {
 		return tt, triple, nil
 	}
 }
+
+// push pushes the scope t onto the scope stack s.
+func (s *scopes) push(t *symTab) {
+	*s = append(*s, t)
+}
+
+// pop removes the innermost scope from the scope stack s.
+func (s *scopes) pop() {
+	*s = (*s)[:len(*s)-1]
+}
+
+// top returns the innermost scope of the scope stack s, or nil if s is empty.
+func (s scopes) top() *symTab {
+	if len(s) == 0 {
+		return nil
+	}
+	return s[len(s)-1]
+}
+
+// push pushes the loop head b onto the loop stack l.
+func (l *loops) push(b llvm.BasicBlock) {
+	*l = append(*l, b)
+}
+
+// pop removes the innermost loop head from the loop stack l.
+func (l *loops) pop() {
+	*l = (*l)[:len(*l)-1]
+}
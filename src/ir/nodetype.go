@@ -1,6 +1,12 @@
 package ir
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"vslc/src/util"
+)
 
 // ----------------------------
 // ----- Type definitions -----
@@ -11,20 +17,37 @@ type NodeType int
 
 // Node represents a single node in the intermediate syntax tree representation.
 type Node struct {
-	Typ      NodeType    // The type of Node, i.e. string data, relation node or number data.
-	Line     int         // Line in source code Node is declared.
-	Pos      int         // Position on the line in source code Node is declared.
-	Data     interface{} // Data node is holding: used for strings, number data and identifier data.
-	//Entry    *Symbol     // Symbol table entry for this node, if it exists.
-	Children []*Node     // Children of this node that constitutes its local sub-tree.
+	Typ      NodeType // The type of Node, i.e. string data, relation node or number data.
+	Line     int      // Line in source code Node is declared.
+	Pos      int      // Position on the line in source code Node is declared.
+	Data     Data     // Data node is holding: used for strings, number data and identifier data.
+	Children []*Node  // Children of this node that constitutes its local sub-tree.
+}
+
+// DataKind tells which field of Data holds the value of a Node.
+type DataKind int
+
+// Data is the value held by a Node: the name of an identifier or type, an operator or a string literal, an integer or
+// a floating point number, as told by its Kind. Setting Data doesn't allocate, and reading a field of another kind
+// gives the zero value of the field.
+type Data struct {
+	Kind  DataKind // Kind of the value, which is NoData for Nodes that don't hold a value.
+	Str   string   // Str is the value of StrData.
+	Int   int      // Int is the value of IntData.
+	Float float64  // Float is the value of FloatData.
 }
 
 // ---------------------
 // ----- Constants -----
 // ---------------------
 
-// Root node of program.
-var Root *Node
+// Kinds of Data.
+const (
+	NoData DataKind = iota
+	StrData
+	IntData
+	FloatData
+)
 
 const (
 	PROGRAM NodeType = iota
@@ -44,6 +67,7 @@ const (
 	ASSIGNMENT_STATEMENT
 	RETURN_STATEMENT
 	PRINT_STATEMENT
+	PRINTF_STATEMENT
 	NULL_STATEMENT
 	IF_STATEMENT
 	WHILE_STATEMENT
@@ -77,6 +101,7 @@ var nt = [...]string{
 	"ASSIGNMENT_STATEMENT",
 	"RETURN_STATEMENT",
 	"PRINT_STATEMENT",
+	"PRINTF_STATEMENT",
 	"NULL_STATEMENT",
 	"IF_STATEMENT",
 	"WHILE_STATEMENT",
@@ -105,20 +130,49 @@ func (n *Node) String() string {
 		// This Node has been mis-configured.
 		return fmt.Sprintf("---> MISCONFIGURED NODE [Node.Typ = %d]", typ)
 	}
-	if n.Data == nil {
+	switch n.Data.Kind {
+	case IntData:
+		return fmt.Sprintf("%s [%d]", nt[n.Typ], n.Data.Int)
+	case FloatData:
+		return fmt.Sprintf("%s [%f]", nt[n.Typ], n.Data.Float)
+	case StrData:
+		return fmt.Sprintf("%s [%q]", nt[n.Typ], n.Data.Str)
+	default:
 		return fmt.Sprintf("%s", nt[n.Typ])
 	}
+}
 
-	switch n.Typ {
-	case STRING_DATA:
-		return fmt.Sprintf("%s [%q]", nt[n.Typ], n.Data)
-	case INTEGER_DATA:
-		return fmt.Sprintf("%s [%d]", nt[n.Typ], n.Data)
-	case FLOAT_DATA:
-		return fmt.Sprintf("%s [%f]", nt[n.Typ], n.Data)
-	default:
-		return fmt.Sprintf("%s [%q]", nt[n.Typ], n.Data)
+// Str returns the Data of the string s.
+func Str(s string) Data {
+	return Data{Kind: StrData, Str: s}
+}
+
+// Int returns the Data of the integer i.
+func Int(i int) Data {
+	return Data{Kind: IntData, Int: i}
+}
+
+// Float returns the Data of the floating point number f.
+func Float(f float64) Data {
+	return Data{Kind: FloatData, Float: f}
+}
+
+// IsZero returns true if Data d holds no value.
+func (d Data) IsZero() bool {
+	return d.Kind == NoData
+}
+
+// String returns the value of Data d formatted like by fmt.Sprint, or the empty string if it holds no value.
+func (d Data) String() string {
+	switch d.Kind {
+	case StrData:
+		return d.Str
+	case IntData:
+		return strconv.Itoa(d.Int)
+	case FloatData:
+		return strconv.FormatFloat(d.Float, 'g', -1, 64)
 	}
+	return ""
 }
 
 // Type returns a print friendly string of the Node n' type.
@@ -126,29 +180,39 @@ func (n *Node) Type() string {
 	return nt[n.Typ]
 }
 
+// TypeErrorf returns a util.TypeError at the source location of Node n, whose message is formatted like fmt.Errorf.
+func (n *Node) TypeErrorf(format string, args ...interface{}) error {
+	return util.TypeErrorf(n.Line, n.Pos, format, args...)
+}
+
 // Print recursively prints this Node and all its Children while indenting for every recursive call.
 // depth is the number of times nodes are padded to the right, having the root node with padding 0.
 // If showDepth is true the method also prints the depths of the nodes.
 func (n *Node) Print(depth int, showDepth bool) {
+	n.Fprint(os.Stdout, depth, showDepth)
+}
+
+// Fprint is equal to Print, but it writes to w.
+func (n *Node) Fprint(w io.Writer, depth int, showDepth bool) {
 	if depth < 0 {
 		depth = 0
 	}
 
 	if n == nil {
 		if showDepth {
-			fmt.Printf("%d %*c%s\n", depth, depth<<1, 0, "---> NIL")
+			_, _ = fmt.Fprintf(w, "%d %*s%s\n", depth, depth<<1, "", "---> NIL")
 		} else {
-			fmt.Printf("%*c%s\n", depth<<1, 0, "---> NIL")
+			_, _ = fmt.Fprintf(w, "%*s%s\n", depth<<1, "", "---> NIL")
 		}
 		return
 	}
 	if showDepth {
-		fmt.Printf("%d %*c%s\n", depth, depth<<1, 0, n.String())
+		_, _ = fmt.Fprintf(w, "%d %*s%s\n", depth, depth<<1, "", n.String())
 	} else {
-		fmt.Printf("%*c%s\n", depth<<1, 0, n.String())
+		_, _ = fmt.Fprintf(w, "%*s%s\n", depth<<1, "", n.String())
 	}
 
 	for _, e := range n.Children {
-		e.Print(depth+1, showDepth)
+		e.Fprint(w, depth+1, showDepth)
 	}
 }
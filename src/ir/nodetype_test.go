@@ -0,0 +1,32 @@
+package ir
+
+import "testing"
+
+func TestData(t *testing.T) {
+	for _, e1 := range []struct {
+		n    *Node
+		data string
+		str  string
+	}{
+		{&Node{Typ: BLOCK}, "", "BLOCK"},
+		{&Node{Typ: IDENTIFIER_DATA, Data: Str("a")}, "a", `IDENTIFIER_DATA ["a"]`},
+		{&Node{Typ: INTEGER_DATA, Data: Int(-42)}, "-42", "INTEGER_DATA [-42]"},
+		{&Node{Typ: FLOAT_DATA, Data: Float(2.5)}, "2.5", "FLOAT_DATA [2.500000]"},
+	} {
+		if s := e1.n.Data.String(); s != e1.data {
+			t.Errorf("expected data %q, got %q", e1.data, s)
+		}
+		if s := e1.n.String(); s != e1.str {
+			t.Errorf("expected node %q, got %q", e1.str, s)
+		}
+	}
+
+	// Fields of another kind are zero instead of panicking.
+	d := Int(1)
+	if d.IsZero() || d.Str != "" || d.Float != 0 {
+		t.Errorf("expected integer data only, got %+v", d)
+	}
+	if !(Data{}).IsZero() {
+		t.Errorf("expected zero Data to hold no value")
+	}
+}
@@ -1,10 +1,7 @@
 package ir
 
 import (
-	"errors"
-	"fmt"
 	"math/bits"
-	"sync"
 	"vslc/src/util"
 )
 
@@ -20,126 +17,149 @@ import (
 // ----- globals -----
 // -------------------
 
+// optimisations rewrites the syntax tree into its optimised form. Lists are flattened and expressions folded bottom up,
+// such that lists and expressions are optimised before their parents.
+var optimisations = NewRewriter().
+	Register(inPlace((*Node).flattenList), EXPRESSION_LIST, PRINT_LIST, VARIABLE_LIST, STATEMENT_LIST, GLOBAL_LIST,
+		DECLARATION_LIST, ARGUMENT_LIST, PARAMETER_LIST).
+	Register(inPlace((*Node).liftVariableType), TYPED_VARIABLE_LIST).
+	Register(inPlace((*Node).liftDeclarationType), DECLARATION).
+	Register(checked((*Node).constantFolding), EXPRESSION).
+	Register(inPlace((*Node).deleteLonelyNode), STATEMENT, PRINT_ITEM, GLOBAL).
+	Register(checked((*Node).checkFormat), PRINTF_STATEMENT)
+
 // ---------------------
 // ----- functions -----
 // ---------------------
 
-// Optimise applies optimisations to the parse tree starting at the root node.
-func Optimise(opt util.Options) error {
+// Optimise applies optimisations to the parse tree starting at the root node, whose global list is replaced by the
+// globals it holds. An error is returned for integer constants that don't fit in integers of opt.IntBits() bits.
+func Optimise(opt util.Options, root *Node) error {
 	if opt.Threads > 1 {
 		// Parallel.
-		wg := sync.WaitGroup{} // Used for synchronising worker threads with main thread.
-
 		// Flatten global list so that we can calculate the number of declared functions.
-		Root.Children[0].paraPrepare()
-
-		// Initiate worker threads.
-		t := opt.Threads                    // Max number of threads to initiate.
-		l := len(Root.Children[0].Children) // Number of functions defined in program.
-		if t > l {
-			t = l // Cannot launch more threads than functions.
-		}
-		n := l / t   // Number of jobs per worker thread.
-		res := l % t // Residual work for res first threads.
-
-		start := 0
-		end := n
-
-		// Used parallel error listener for listening for errors from worker threads.
-		errs := util.NewPerror(t)
-
-		// Tell main thread that we're launching t threads (go routines).
-		wg.Add(t)
-
-		// Launch t threads.
-		for i1 := 0; i1 < t; i1++ {
-			if i1 < res {
-				// This worker thread should do one residual job.
-				end++
-			}
-
-			go func(start, end int, wg *sync.WaitGroup) {
-				defer wg.Done()
-				for _, e2 := range Root.Children[0].Children[start:end] {
-					if err := e2.optimise(); err != nil {
-						errs.Append(err)
-					}
-				}
-			}(start, end, &wg)
-			start = end
-			end += n
-		}
-
-		// Wait for worker threads to finish.
-		wg.Wait()
-		errs.Stop()
-
-		// Check for errors.
-		if errs.Len() > 0 {
-			for e1 := range errs.Errors() {
-				fmt.Println(e1)
-			}
-			return errors.New("errors during parallel optimisation")
+		root.Children[0].paraPrepare()
+
+		// Optimise every global on the worker pool.
+		globals := root.Children[0].Children
+		pool := opt.NewPool("optimise")
+		if err := pool.Run(opt.Context(), len(globals), func(w *util.Worker, i int) error {
+			return globals[i].optimise(opt.IntBits())
+		}); err != nil {
+			return err
 		}
 	} else {
 		// Sequential.
-		if err := Root.optimise(); err != nil {
+		if err := root.optimise(opt.IntBits()); err != nil {
 			return err
 		}
 	}
 	// Remove GLOBAL_LIST.
-	Root.Children = Root.Children[0].Children
+	root.Children = root.Children[0].Children
 
 	return nil
 }
 
+// OptimiseHeaders applies optimisations to the parse tree starting at the root node like Optimise, except for the
+// bodies of its functions, which are optimised by OptimiseBody. Hence, the functions can be declared before their
+// bodies are optimised. It returns the globals of root, in source order.
+func OptimiseHeaders(opt util.Options, root *Node) ([]*Node, error) {
+	root.Children[0].paraPrepare()
+	globals := root.Children[0].Children
+	for _, e1 := range globals {
+		c := e1.Children[0]
+		if c.Typ != FUNCTION {
+			if err := e1.optimise(opt.IntBits()); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		// The function's identifier, return type and parameters precede its body.
+		for _, e2 := range c.Children[:len(c.Children)-1] {
+			if err := e2.optimise(opt.IntBits()); err != nil {
+				return nil, err
+			}
+		}
+		e1.deleteLonelyNode()
+	}
+	root.Children = globals
+	return globals, nil
+}
+
+// OptimiseBody applies optimisations to the body of the function n, whose header was optimised by OptimiseHeaders.
+func OptimiseBody(opt util.Options, n *Node) error {
+	return n.Children[len(n.Children)-1].optimise(opt.IntBits())
+}
+
 // paraPrepare eliminates the global list structure of the root node in preparation
 // for the parallel optimisation run.
 func (n *Node) paraPrepare() {
-	if n.Typ != GLOBAL_LIST {
-		return
-	}
+	_, _ = Walk(n, Visitor{
+		Pre: func(n *Node) (*Node, error) {
+			// Only the global list nodes are flattened.
+			if n.Typ != GLOBAL_LIST {
+				return n, SkipChildren
+			}
+			return n, nil
+		},
+		Post: func(n *Node) (*Node, error) {
+			if n.Typ == GLOBAL_LIST {
+				n.flattenList()
+			}
+			return n, nil
+		},
+	})
+}
 
-	// Recursively locate all global list nodes.
-	for _, e1 := range n.Children {
-		e1.paraPrepare()
+// optimise applies the optimisations of the syntax tree to the subtree of Node n in a single bottom-up rewrite, and
+// checks that its integer constants fit in integers of the given number of bits. It must not be called for the root
+// node by the parallel run.
+func (n *Node) optimise(bits int) error {
+	if _, err := optimisations.Rewrite(n); err != nil {
+		return err
 	}
-
-	// Flatten global list structure.
-	n.flattenList()
+	return n.checkIntegers(bits)
 }
 
-// optimise starts the recursive optimisation process. This function must not be called
-// by the parallel run form the root node.
-func (n *Node) optimise() error {
-	// Traverse the subtree recursively.
-	for _, e1 := range n.Children {
-		if err := e1.optimise(); err != nil {
-			return err
+// checkIntegers returns an error for the first integer constant in the subtree of Node n that doesn't fit in integers
+// of the given number of bits. Constants are checked once they're folded, such that the most negative integer, which
+// is the negation of a literal that doesn't fit, is accepted.
+func (n *Node) checkIntegers(bits int) error {
+	min, max := -1<<uint(bits-1), 1<<uint(bits-1)-1
+	_, err := Walk(n, Visitor{Pre: func(n *Node) (*Node, error) {
+		if n.Typ == INTEGER_DATA && (n.Data.Int < min || n.Data.Int > max) {
+			return n, n.TypeErrorf("integer %d overflows %d-bit integers", n.Data.Int, bits)
 		}
+		return n, nil
+	}})
+	return err
+}
+
+// inPlace returns a Rule that rewrites a Node in place by f.
+func inPlace(f func(n *Node)) Rule {
+	return func(n *Node) (*Node, error) {
+		f(n)
+		return n, nil
 	}
+}
 
-	// Look for optimisation option.
-	switch n.Typ {
-	case EXPRESSION_LIST, PRINT_LIST, VARIABLE_LIST, STATEMENT_LIST, GLOBAL_LIST, DECLARATION_LIST, ARGUMENT_LIST,
-		PARAMETER_LIST:
-		n.flattenList()
-	case TYPED_VARIABLE_LIST:
-		// Move type data to this node and remove variable list.
-		n.Data = n.Children[0].Data
-		n.Children = n.Children[1].Children
-	case DECLARATION:
-		// Move type data to this node.
-		n.Data = n.Children[0].Data
-		n.Children = n.Children[1:]
-	case EXPRESSION:
-		if err := n.constantFolding(); err != nil {
-			return err
-		}
-	case STATEMENT, PRINT_ITEM, GLOBAL:
-		n.deleteLonelyNode()
+// checked returns a Rule that rewrites a Node in place by f, which returns an error if the Node is invalid.
+func checked(f func(n *Node) error) Rule {
+	return func(n *Node) (*Node, error) {
+		return n, f(n)
 	}
-	return nil
+}
+
+// liftVariableType moves the type of a typed variable list to Node n, and replaces its children by the variables.
+func (n *Node) liftVariableType() {
+	n.Data = n.Children[0].Data
+	n.Children = n.Children[1].Children
+}
+
+// liftDeclarationType moves the type of a declaration to Node n, and removes the type from its children.
+func (n *Node) liftDeclarationType() {
+	n.Data = n.Children[0].Data
+	n.Children = n.Children[1:]
 }
 
 // constantFolding eliminates arithmetic expressions that consists of only constant values.
@@ -156,10 +176,10 @@ func (n *Node) constantFolding() error {
 		// Check for two integers expression.
 		if c0.Typ == INTEGER_DATA && c1.Typ == INTEGER_DATA {
 			// Both operands are integer constants.
-			a := c0.Data.(int)
-			b := c1.Data.(int)
+			a := c0.Data.Int
+			b := c1.Data.Int
 			var res int
-			switch n.Data.(string) {
+			switch n.Data.Str {
 			case "+":
 				res = a + b
 			case "-":
@@ -168,14 +188,12 @@ func (n *Node) constantFolding() error {
 				res = a * b
 			case "/":
 				if b == 0 {
-					return fmt.Errorf("line %d:%d: expression %d / %d not allowed: cannot divide by zero",
-						c1.Line, c1.Pos, a, b)
+					return c1.TypeErrorf("expression %d / %d not allowed: cannot divide by zero", a, b)
 				}
 				res = a / b
 			case "%":
 				if b == 0 {
-					return fmt.Errorf("line %d:%d: expression %d %% %d not allowed: cannot divide by zero",
-						c1.Line, c1.Pos, a, b)
+					return c1.TypeErrorf("expression %d %% %d not allowed: cannot divide by zero", a, b)
 				}
 				res = a % b
 			case "&":
@@ -186,21 +204,27 @@ func (n *Node) constantFolding() error {
 				res = a ^ b
 			case ">>":
 				res = a >> b
+			case ">>>":
+				if a < 0 {
+					// The zeros shifted in depend on the integer width of the target.
+					return nil
+				}
+				res = a >> b
 			case "<<":
 				res = a << b
 			}
 			*n = *(c0)
-			n.Data = res
+			n.Data = Int(res)
 			return nil
 		}
 
 		// Check for two float expression.
 		if c0.Typ == FLOAT_DATA && c1.Typ == FLOAT_DATA {
 			// Both operands are floating point constants.
-			a := c0.Data.(float64)
-			b := c1.Data.(float64)
+			a := c0.Data.Float
+			b := c1.Data.Float
 			var res float64
-			switch n.Data.(string) {
+			switch n.Data.Str {
 			case "+":
 				res = a + b
 			case "-":
@@ -209,16 +233,14 @@ func (n *Node) constantFolding() error {
 				res = a * b
 			case "/":
 				if b == 0.0 {
-					return fmt.Errorf("line %d:%d: expression %f / %f not allowed: cannot divide by zero",
-						c1.Line, c1.Pos, a, b)
+					return c1.TypeErrorf("expression %f / %f not allowed: cannot divide by zero", a, b)
 				}
 				res = a / b
 			default:
-				return fmt.Errorf("line %d:%d: binary operator %s not defined for %s",
-					n.Line, n.Pos, n.Data.(string), DTyp[DataFloat])
+				return n.TypeErrorf("binary operator %s not defined for %s", n.Data.Str, DTyp[DataFloat])
 			}
 			*n = *c0
-			n.Data = res
+			n.Data = Float(res)
 			return nil
 		}
 
@@ -227,11 +249,11 @@ func (n *Node) constantFolding() error {
 			// First operator is an integer constant.
 			switch c1.Typ {
 			case FLOAT_DATA:
-				a := float64(c0.Data.(int))
-				b := c1.Data.(float64)
+				a := float64(c0.Data.Int)
+				b := c1.Data.Float
 				var res float64
 				// These optimisations will leave the result of the expression as float.
-				switch n.Data.(string) {
+				switch n.Data.Str {
 				case "+":
 					res = a + b
 				case "-":
@@ -240,22 +262,21 @@ func (n *Node) constantFolding() error {
 					res = a * b
 				case "/":
 					if b == 0.0 {
-						return fmt.Errorf("line %d:%d: expression %d / %f not allowed: cannot divide by zero",
-							n.Line, n.Pos, c0.Data.(int), b)
+						return n.TypeErrorf("expression %d / %f not allowed: cannot divide by zero", c0.Data.Int, b)
 					}
 					res = a / b
 				default:
-					return fmt.Errorf("line %d:%d: operator %s not defined for %s and %s",
-						n.Line, n.Pos, n.Data.(string), DTyp[DataInteger], DTyp[DataFloat])
+					return n.TypeErrorf("operator %s not defined for %s and %s",
+						n.Data.Str, DTyp[DataInteger], DTyp[DataFloat])
 				}
 				*n = *c1
-				n.Data = res
+				n.Data = Float(res)
 			case IDENTIFIER_DATA:
 				// Identifier data may be bool or float, but is caught in symbol table validation.
 				// These optimisations do not require knowing the type of the identifier.
-				switch n.Data.(string) {
+				switch n.Data.Str {
 				case "*":
-					switch c0.Data.(int) {
+					switch c0.Data.Int {
 					case 1:
 						// Multiply by 1: set result to other operand.
 						*n = *(c1)
@@ -265,19 +286,18 @@ func (n *Node) constantFolding() error {
 					}
 				case "|":
 					// OR by 0: set result to other operand.
-					if c0.Data.(int) == 0 {
+					if c0.Data.Int == 0 {
 						*n = *(c1)
 					}
 				case "&":
 					// AND by 0: set result to zero.
-					if c0.Data.(int) == 0 {
+					if c0.Data.Int == 0 {
 						*n = *(c1)
-						n.Data = 0
+						n.Data = Int(0)
 					}
 				}
 			default:
-				return fmt.Errorf("line %d:%d: operation %s not defined for %s and unknown",
-					n.Line, n.Pos, n.Data.(string), DTyp[DataInteger])
+				return n.TypeErrorf("operation %s not defined for %s and unknown", n.Data.Str, DTyp[DataInteger])
 			}
 			return nil
 		}
@@ -288,10 +308,10 @@ func (n *Node) constantFolding() error {
 			// Replace multiply and division with left and right shift if possible.
 			switch c0.Typ {
 			case FLOAT_DATA:
-				a := c0.Data.(float64)
-				b := float64(c1.Data.(int))
+				a := c0.Data.Float
+				b := float64(c1.Data.Int)
 				var res float64
-				switch n.Data.(string) {
+				switch n.Data.Str {
 				case "+":
 					res = a + b
 				case "-":
@@ -300,27 +320,26 @@ func (n *Node) constantFolding() error {
 					res = a * b
 				case "/":
 					if b == 0.0 {
-						return fmt.Errorf("line %d:%d: expression %d / %f not allowed: cannot divide by zero",
-							n.Line, n.Pos, c0.Data.(int), b)
+						return n.TypeErrorf("expression %d / %f not allowed: cannot divide by zero", c0.Data.Int, b)
 					}
 					res = a / b
 				default:
-					return fmt.Errorf("line %d:%d: operator %s not defined for %s and %s",
-						n.Line, n.Pos, n.Data.(string), DTyp[DataFloat], DTyp[DataInteger])
+					return n.TypeErrorf("operator %s not defined for %s and %s",
+						n.Data.Str, DTyp[DataFloat], DTyp[DataInteger])
 				}
 				*n = *c0
-				n.Data = res
+				n.Data = Float(res)
 			case IDENTIFIER_DATA:
-				switch n.Data.(string) {
+				switch n.Data.Str {
 				case "*":
-					if c1.Data.(int) == 1 {
+					if c1.Data.Int == 1 {
 						// Multiplication by identity integer.
 						*n = *(c0)
-					} else if b := bits.OnesCount(uint(c1.Data.(int))); b == 1 {
+					} else if c := uint(c1.Data.Int); c1.Data.Int > 0 && c&(c-1) == 0 {
 						// Multiplication by integer that is power of 2.
-						n.Data = "<<"
-						c1.Data = b
-					} else if b == 2 && c1.Data.(int)&0x1 == 0x1 && c0.Typ == IDENTIFIER_DATA {
+						n.Data = Str("<<")
+						c1.Data = Int(bits.TrailingZeros(c))
+					} else if c1.Data.Int > 2 && (c-1)&(c-2) == 0 && c0.Typ == IDENTIFIER_DATA {
 						// Operator op1 is a power of 2 plus one.
 						//
 						// This i helpful when a = b * c, where
@@ -333,14 +352,14 @@ func (n *Node) constantFolding() error {
 							Typ:  EXPRESSION,
 							Line: n.Line,
 							Pos:  n.Pos,
-							Data: "+",
+							Data: Str("+"),
 							//Entry:    nil,
 							Children: make([]*Node, 2),
 						}
 
 						// Adjust original expression.
-						n.Data = "<<"
-						c1.Data = b - 1
+						n.Data = Str("<<")
+						c1.Data = Int(bits.TrailingZeros(c - 1))
 
 						// Node n is the set as first child of new expression.
 						ex0 := *n
@@ -356,89 +375,51 @@ func (n *Node) constantFolding() error {
 						*n = exp
 					}
 				case "/":
-					if c1.Data.(int) == 1 {
+					if c1.Data.Int == 1 {
 						// Division by identity integer.
 						*n = *(c0)
-					} else if b := bits.OnesCount(uint(c1.Data.(int))); b == 1 {
-						// Division by integer that is power of 2.
-						n.Data = ">>"
-						c1.Data = b
-					} else if b == 2 && c1.Data.(int)&0x1 == 0x1 && c0.Typ == IDENTIFIER_DATA {
-						// Operator op1 is a power of 2 plus one.
-						//
-						// This i helpful when a = b / c, where
-						// b is an IDENTIFIER
-						// c is 9 for example, where 9 = 8 + 1
-						// Which gives: (b >> 3) - b
-
-						// Create a new expression.
-						exp := Node{
-							Typ:  EXPRESSION,
-							Line: n.Line,
-							Pos:  n.Pos,
-							Data: "-",
-							//Entry:    nil,
-							Children: make([]*Node, 2),
-						}
-
-						// Adjust original expression.
-						n.Data = ">>"
-						c1.Data = b - 1
-
-						// Node n is the set as first child of new expression.
-						ex0 := *n
-						exp.Children[0] = &ex0
-
-						// Result of first child is added to the result of the shift.
-						ex1 := *c0
-
-						// Second child is added to the results of the ex0 expression.
-						exp.Children[1] = &ex1
-
-						// Set exp as the new Node n.
-						*n = exp
 					}
+					// Division by other constants, including powers of 2, is lowered to shift sequences by LIR.
 				case "%":
-					if c1.Data.(int) == 1 {
+					if c1.Data.Int == 1 {
 						*n = *(c0)
 					}
 				case "|":
-					if c1.Data.(int) == 0 {
+					if c1.Data.Int == 0 {
 						*n = *(c0)
 					}
 				case "&":
-					if c1.Data.(int) == 0 {
+					if c1.Data.Int == 0 {
 						*n = *(c0)
-						n.Data = 0
+						n.Data = Int(0)
 					}
 				}
 			default:
-				return fmt.Errorf("line %d:%d: operation %s not defined for unknown and %s",
-					n.Line, n.Pos, n.Data.(string), DTyp[DataInteger])
+				return n.TypeErrorf("operation %s not defined for unknown and %s", n.Data.Str, DTyp[DataInteger])
 			}
 		}
 	}
 
 	// Unary operators.
 	if len(n.Children) == 1 {
-		if n.Data == nil {
+		if n.Data.IsZero() {
 			*n = *(n.Children[0])
 		} else if n.Children[0].Typ == INTEGER_DATA {
 			// Unary operators.
-			switch n.Data.(string) {
+			switch n.Data.Str {
 			case "-":
-				data := -(n.Children[0].Data.(int))
+				data := -(n.Children[0].Data.Int)
 				*n = *(n.Children[0])
-				n.Data = data
+				n.Data = Int(data)
 			case "~":
-				data := int(bits.Reverse(uint(n.Children[0].Data.(int))))
+				data := int(bits.Reverse(uint(n.Children[0].Data.Int)))
 				*n = *(n.Children[0])
-				n.Data = data
+				n.Data = Int(data)
 			default:
-				return fmt.Errorf("unary operatior %s not defined for %s", n.Data.(string), DTyp[DataInteger])
+				return n.TypeErrorf("unary operatior %s not defined for %s", n.Data.Str, DTyp[DataInteger])
 			}
 		} else if n.Children[0].Typ == FLOAT_DATA {
-			return fmt.Errorf("unary operatior %s not defined for %s", n.Data.(string), DTyp[DataFloat])
+			return n.TypeErrorf("unary operatior %s not defined for %s", n.Data.Str, DTyp[DataFloat])
 		}
 	}
 
@@ -461,7 +442,7 @@ func (n *Node) flattenList() {
 // deleteLonelyNode removes nodes that have a single child and puts the contents
 // of the child into the current node. Does not delete node if node holds data.
 func (n *Node) deleteLonelyNode() {
-	if len(n.Children) != 1 && n.Data != nil {
+	if len(n.Children) != 1 && !n.Data.IsZero() {
 		return
 	}
 	*n = *(n.Children[0])
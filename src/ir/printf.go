@@ -0,0 +1,134 @@
+// printf.go provides the format print statements of VSL, such as printf "x = %d, y = %f", x, y, whose string literal
+// is passed to printf as its format string, followed by the arguments of the format, and the print statements that
+// are redirected to other files than standard output, such as eprint and print > 2.
+
+package ir
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// File descriptors of the standard output and standard error of a program.
+const (
+	Stdout = 1
+	Stderr = 2
+)
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// File returns the file descriptor printed to by the print statement Node n, which is Stdout unless it's redirected.
+func (n *Node) File() int {
+	if n.Data.Kind != IntData {
+		return Stdout
+	}
+	return n.Data.Int
+}
+
+// FormatPrint returns true if Node n is a format print statement, such as printf "x = %d", x, whose first item is the
+// printf format string of the other items. Print statements print their items as is, separated by spaces, including
+// string literals with percent signs.
+func (n *Node) FormatPrint() bool {
+	return n.Typ == PRINTF_STATEMENT
+}
+
+// IntConversion returns the printf conversion specification of integers of intBits bits. 64-bit integers have the
+// length modifier ll, as long is 32-bit on some targets.
+func IntConversion(intBits int) string {
+	if intBits == 64 {
+		return "%lld"
+	}
+	return "%d"
+}
+
+// Format returns the printf format string of the VSL format string format, whose %d conversions print integers of
+// intBits bits. Its conversion specifications must have been verified by Verbs.
+func Format(format string, intBits int) string {
+	conv := IntConversion(intBits)
+	if conv == "%d" {
+		return format
+	}
+	sb := strings.Builder{}
+	for i1 := 0; i1 < len(format); i1++ {
+		if format[i1] != '%' || i1+1 == len(format) {
+			sb.WriteByte(format[i1])
+			continue
+		}
+		if i1++; format[i1] == 'd' {
+			sb.WriteString(conv)
+		} else {
+			sb.WriteByte('%')
+			sb.WriteByte(format[i1])
+		}
+	}
+	return sb.String()
+}
+
+// Verbs returns the conversion characters of the printf format string format, in order, which are 'd' for integer
+// arguments and 'f' for float arguments. An escaped percent sign %% takes no argument. An error is returned for any
+// other conversion specification, which has no VSL argument type.
+func Verbs(format string) ([]byte, error) {
+	var res []byte
+	for i1 := 0; i1 < len(format); i1++ {
+		if format[i1] != '%' {
+			continue
+		}
+		if i1++; i1 == len(format) {
+			return res, fmt.Errorf("incomplete conversion specification at end of format string %q", format)
+		}
+		switch format[i1] {
+		case '%':
+		case 'd', 'f':
+			res = append(res, format[i1])
+		default:
+			return res, fmt.Errorf("unsupported conversion specification %%%c in format string %q, expected %%d, "+
+				"%%f or %%%%", format[i1], format)
+		}
+	}
+	return res, nil
+}
+
+// checkFormat verifies that the format string of the format print statement Node n has a conversion specification
+// of the right type for every argument. The types of variables and expressions are unknown to the syntax tree, and
+// are verified when the print statement is generated.
+func (n *Node) checkFormat() error {
+	if !n.FormatPrint() {
+		return nil
+	}
+	items := n.Children[0].Children
+	if items[0].Typ != STRING_DATA {
+		return items[0].TypeErrorf("expected string literal as format string of printf")
+	}
+	verbs, err := Verbs(items[0].Data.Str)
+	if err != nil {
+		return items[0].TypeErrorf("%v", err)
+	}
+	if len(verbs) != len(items)-1 {
+		return n.TypeErrorf("format string %q expects %d arguments, got %d", items[0].Data.Str, len(verbs),
+			len(items)-1)
+	}
+	for i1, e1 := range items[1:] {
+		switch {
+		case e1.Typ == STRING_DATA:
+			return e1.TypeErrorf("string %q can't be an argument of format string %q", e1.Data.Str,
+				items[0].Data.Str)
+		case e1.Typ == INTEGER_DATA && verbs[i1] != 'd':
+			return e1.TypeErrorf("argument %d of format string %q is %s, expected %%%c", i1+1, items[0].Data.Str,
+				DTyp[DataInteger], verbs[i1])
+		case e1.Typ == FLOAT_DATA && verbs[i1] != 'f':
+			return e1.TypeErrorf("argument %d of format string %q is %s, expected %%%c", i1+1, items[0].Data.Str,
+				DTyp[DataFloat], verbs[i1])
+		}
+	}
+	return nil
+}
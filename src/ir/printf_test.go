@@ -0,0 +1,87 @@
+package ir
+
+import "testing"
+
+// printStatement returns a print statement of the items, which is a printf statement if format is set.
+func printStatement(format bool, items ...*Node) *Node {
+	n := &Node{Typ: PRINT_STATEMENT, Children: []*Node{{Typ: PRINT_LIST, Children: items}}}
+	if format {
+		n.Typ = PRINTF_STATEMENT
+	}
+	return n
+}
+
+// TestVerbs verifies the conversions of printf format strings, and that unsupported conversions are rejected.
+func TestVerbs(t *testing.T) {
+	tests := []struct {
+		format string
+		exp    string
+		err    bool
+	}{
+		{"x = %d, y = %f", "df", false},
+		{"100%% of %d", "d", false},
+		{"no conversions", "", false},
+		{"%s", "", true},
+		{"%5d", "", true},
+		{"trailing %", "", true},
+	}
+	for _, e1 := range tests {
+		res, err := Verbs(e1.format)
+		if (err != nil) != e1.err || (err == nil && string(res) != e1.exp) {
+			t.Errorf("%q: expected verbs %q and error %t, got %q and %v", e1.format, e1.exp, e1.err, res, err)
+		}
+	}
+}
+
+// TestCheckFormat verifies which print statements are format prints, and that their arguments are checked against
+// the conversions of the format string.
+func TestCheckFormat(t *testing.T) {
+	str := func(s string) *Node { return &Node{Typ: STRING_DATA, Data: Str(s)} }
+	id := &Node{Typ: IDENTIFIER_DATA, Data: Str("x")}
+	i := &Node{Typ: INTEGER_DATA, Data: Int(1)}
+	f := &Node{Typ: FLOAT_DATA, Data: Float(1)}
+	tests := []struct {
+		n      *Node
+		format bool
+		err    bool
+	}{
+		{printStatement(false, str("%d %d")), false, false},
+		{printStatement(false, str("50%"), id), false, false},
+		{printStatement(false, str("x = %d, y = %f"), id, f), false, false},
+		{printStatement(true, str("100%%")), true, false},
+		{printStatement(true, str("x = %d, y = %f"), id, f), true, false},
+		{printStatement(true, str("%d"), i, i), true, true},
+		{printStatement(true, str("%d"), f), true, true},
+		{printStatement(true, str("%f"), str("y")), true, true},
+		{printStatement(true, str("%x"), i), true, true},
+		{printStatement(true, id, i), true, true},
+	}
+	for i1, e1 := range tests {
+		if res := e1.n.FormatPrint(); res != e1.format {
+			t.Errorf("test %d: expected format print %t, got %t", i1, e1.format, res)
+		}
+		if err := e1.n.checkFormat(); (err != nil) != e1.err {
+			t.Errorf("test %d: expected error %t, got %v", i1, e1.err, err)
+		}
+	}
+}
+
+// TestFormat verifies that the %d conversions of format strings have the length modifier of 64-bit integers, and that
+// other conversions are kept.
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		intBits int
+		exp     string
+	}{
+		{"x = %d, y = %f", 32, "x = %d, y = %f"},
+		{"x = %d, y = %f", 64, "x = %lld, y = %f"},
+		{"%d%% of %d", 64, "%lld%% of %lld"},
+		{"%%d", 64, "%%d"},
+	}
+	for _, e1 := range tests {
+		if res := Format(e1.format, e1.intBits); res != e1.exp {
+			t.Errorf("%q with %d-bit integers: expected %q, got %q", e1.format, e1.intBits, e1.exp, res)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// rewrite.go provides the rewriter of syntax trees, which applies the rules registered for every type of Node in a
+// single bottom-up walk, such as the optimisations of the syntax tree.
+
+package ir
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Rule rewrites Node n of a syntax tree, whose children are already rewritten, and returns the Node that replaces n.
+// It returns n itself to keep it, possibly modified in place, or nil to remove n from the children of its parent.
+// The rewrite is aborted if an error is returned.
+type Rule func(n *Node) (*Node, error)
+
+// Rewriter rewrites syntax trees by the Rules registered for the types of their Nodes.
+type Rewriter struct {
+	rules map[NodeType][]Rule // rules holds the Rules of every NodeType, in the order they were registered.
+}
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// NewRewriter returns a Rewriter without Rules.
+func NewRewriter() *Rewriter {
+	return &Rewriter{rules: make(map[NodeType][]Rule)}
+}
+
+// Register registers Rule rule for the Nodes of every type of types, after the Rules registered before.
+func (r *Rewriter) Register(rule Rule, types ...NodeType) *Rewriter {
+	for _, e1 := range types {
+		r.rules[e1] = append(r.rules[e1], rule)
+	}
+	return r
+}
+
+// Rewrite rewrites the syntax tree rooted at Node n bottom up in a single walk, and returns the Node that replaces n,
+// which is nil if n was removed. The Rules of a Node are applied in the order they were registered once its children
+// are rewritten, until one of them replaces the Node by a Node of another type, which has been rewritten already. The
+// error of the first Rule that fails is returned, in which case the syntax tree is left partially rewritten.
+func (r *Rewriter) Rewrite(n *Node) (*Node, error) {
+	return Walk(n, Visitor{Post: func(n *Node) (*Node, error) {
+		typ := n.Typ
+		for _, e1 := range r.rules[typ] {
+			res, err := e1(n)
+			if err != nil || res == nil {
+				return res, err
+			}
+			if n = res; n.Typ != typ {
+				break
+			}
+		}
+		return n, nil
+	}})
+}
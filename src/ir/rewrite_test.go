@@ -0,0 +1,65 @@
+package ir
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestRewrite verifies that the Rules of a Node are applied bottom up in the order they were registered, that the
+// Rules of the original type stop once a Node is replaced by one of another type, and that Nodes are removed.
+func TestRewrite(t *testing.T) {
+	var order []string
+	rename := func(s string) Rule {
+		return func(n *Node) (*Node, error) {
+			order = append(order, n.Data.Str+s)
+			n.Data = Str(n.Data.Str + s)
+			return n, nil
+		}
+	}
+	r := NewRewriter().
+		Register(rename("1"), EXPRESSION).
+		Register(func(n *Node) (*Node, error) {
+			if n.Data.Str == "c" {
+				return nil, nil
+			}
+			return n, nil
+		}, IDENTIFIER_DATA).
+		Register(func(n *Node) (*Node, error) {
+			if len(n.Children) == 0 {
+				return &Node{Typ: IDENTIFIER_DATA, Data: Str("d")}, nil
+			}
+			return n, nil
+		}, EXPRESSION).
+		Register(rename("2"), EXPRESSION)
+	res, err := r.Rewrite(tree())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := names(res); s != "*12(+12(ab)d)" {
+		t.Errorf("expected %q, got %q", "*12(+12(ab)d)", s)
+	}
+	exp := "+1,+12,-1,*1,*12"
+	if s := strings.Join(order, ","); s != exp {
+		t.Errorf("expected rules applied in order %q, got %q", exp, s)
+	}
+}
+
+// TestRewriteError verifies that the error of a failed Rule aborts the rewrite, and that no node is lost.
+func TestRewriteError(t *testing.T) {
+	fail := errors.New("fail")
+	applied := 0
+	res, err := NewRewriter().Register(func(n *Node) (*Node, error) {
+		applied++
+		if n.Data.Str == "+" {
+			return n, fail
+		}
+		return n, nil
+	}, EXPRESSION, IDENTIFIER_DATA).Rewrite(tree())
+	if err != fail || applied != 3 {
+		t.Errorf("expected error %q after 3 rules, got %v after %d", fail, err, applied)
+	}
+	if s := names(res); s != "*(+(ab)-(c))" {
+		t.Errorf("expected %q, got %q", "*(+(ab)-(c))", s)
+	}
+}
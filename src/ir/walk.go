@@ -0,0 +1,94 @@
+// walk.go provides the traversal of syntax trees used by the optimiser and analyses of the syntax tree, such that
+// they don't each implement their own recursive traversal.
+
+package ir
+
+import "errors"
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// VisitFunc is called by Walk for Node n, and returns the Node that replaces n in the syntax tree. It returns n itself
+// to keep it, or nil to remove n from the children of its parent. The walk is aborted if an error is returned.
+type VisitFunc func(n *Node) (*Node, error)
+
+// Visitor holds the functions that Walk calls for every Node of a syntax tree. Pre is called before the children of a
+// Node are visited, and Post after, such that Post sees the children that replaced the original ones. Either may be
+// nil.
+type Visitor struct {
+	Pre  VisitFunc
+	Post VisitFunc
+}
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// SkipChildren is returned by the Pre function of a Visitor to skip the children of the Node it returns. Post is still
+// called for the Node.
+var SkipChildren = errors.New("skip children")
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// Walk visits the syntax tree rooted at Node n depth first, in the order of the children, and returns the Node that
+// replaces n, which is nil if n was removed. Nodes replaced by Pre are visited in place of the original Node. The
+// error returned by the first failed visit is returned, in which case the syntax tree is left partially rewritten,
+// but every Node that wasn't removed is kept.
+func Walk(n *Node, v Visitor) (*Node, error) {
+	if n == nil {
+		return nil, nil
+	}
+	skip := false
+	if v.Pre != nil {
+		res, err := v.Pre(n)
+		if err == SkipChildren {
+			skip = true
+		} else if err != nil {
+			return n, err
+		}
+		if res == nil {
+			return nil, nil
+		}
+		n = res
+	}
+
+	// Visit the children, and compact the children that were kept.
+	if !skip {
+		i2 := 0
+		for i1, e1 := range n.Children {
+			c, err := Walk(e1, v)
+			if c != nil {
+				n.Children[i2] = c
+				i2++
+			}
+			if err != nil {
+				n.Children = append(n.Children[:i2], n.Children[i1+1:]...)
+				return n, err
+			}
+		}
+		n.Children = n.Children[:i2]
+	}
+
+	if v.Post != nil {
+		res, err := v.Post(n)
+		if err != nil {
+			return n, err
+		}
+		return res, nil
+	}
+	return n, nil
+}
+
+// Inspect calls f for every Node of the syntax tree rooted at Node n, depth first in the order of the children, like
+// the Pre function of a Visitor. The children of a Node are skipped if f returns false.
+func Inspect(n *Node, f func(n *Node) bool) {
+	_, _ = Walk(n, Visitor{Pre: func(n *Node) (*Node, error) {
+		if !f(n) {
+			return n, SkipChildren
+		}
+		return n, nil
+	}})
+}
@@ -0,0 +1,137 @@
+package ir
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// tree returns the syntax tree of the expression (a + b) * -c, whose nodes hold their names as data.
+func tree() *Node {
+	leaf := func(name string) *Node {
+		return &Node{Typ: IDENTIFIER_DATA, Data: Str(name)}
+	}
+	return &Node{Typ: EXPRESSION, Data: Str("*"), Children: []*Node{
+		{Typ: EXPRESSION, Data: Str("+"), Children: []*Node{leaf("a"), leaf("b")}},
+		{Typ: EXPRESSION, Data: Str("-"), Children: []*Node{leaf("c")}},
+	}}
+}
+
+// names returns the data of the nodes of the syntax tree rooted at n in pre-order, with parentheses around children.
+func names(n *Node) string {
+	sb := strings.Builder{}
+	_, _ = Walk(n, Visitor{
+		Pre: func(n *Node) (*Node, error) {
+			sb.WriteString(n.Data.Str)
+			if len(n.Children) > 0 {
+				sb.WriteString("(")
+			}
+			return n, nil
+		},
+		Post: func(n *Node) (*Node, error) {
+			if len(n.Children) > 0 {
+				sb.WriteString(")")
+			}
+			return n, nil
+		},
+	})
+	return sb.String()
+}
+
+// TestWalk verifies the order of visits, and that nodes are replaced, removed and skipped.
+func TestWalk(t *testing.T) {
+	exp := []struct {
+		name string
+		v    Visitor
+		res  string
+	}{
+		{name: "identity", res: "*(+(ab)-(c))"},
+		{
+			name: "replace",
+			v: Visitor{Pre: func(n *Node) (*Node, error) {
+				if n.Data.Str == "-" {
+					return &Node{Typ: IDENTIFIER_DATA, Data: Str("d")}, nil
+				}
+				return n, nil
+			}},
+			res: "*(+(ab)d)",
+		},
+		{
+			name: "remove",
+			v: Visitor{Post: func(n *Node) (*Node, error) {
+				if n.Data.Str == "a" || n.Data.Str == "c" {
+					return nil, nil
+				}
+				return n, nil
+			}},
+			res: "*(+(b)-)",
+		},
+		{
+			name: "skip",
+			v: Visitor{Pre: func(n *Node) (*Node, error) {
+				if n.Data.Str == "+" {
+					n.Data = Str("p")
+					return n, SkipChildren
+				}
+				n.Data = Str(strings.ToUpper(n.Data.Str))
+				return n, nil
+			}},
+			res: "*(p(ab)-(C))",
+		},
+		{
+			name: "post",
+			v: Visitor{Post: func(n *Node) (*Node, error) {
+				// Fold the children into their parent, which sees the folded children.
+				for _, e1 := range n.Children {
+					n.Data = Str(n.Data.Str + e1.Data.Str)
+				}
+				n.Children = nil
+				return n, nil
+			}},
+			res: "*+ab-c",
+		},
+	}
+	for _, e1 := range exp {
+		res, err := Walk(tree(), e1.v)
+		if err != nil {
+			t.Fatalf("%s: %s", e1.name, err)
+		}
+		if s := names(res); s != e1.res {
+			t.Errorf("%s: expected %q, got %q", e1.name, e1.res, s)
+		}
+	}
+}
+
+// TestWalkError verifies that a failed visit aborts the walk, and that no node is lost.
+func TestWalkError(t *testing.T) {
+	fail := errors.New("fail")
+	visited := 0
+	res, err := Walk(tree(), Visitor{Post: func(n *Node) (*Node, error) {
+		visited++
+		switch n.Data.Str {
+		case "a":
+			return nil, nil
+		case "b":
+			return n, fail
+		}
+		return n, nil
+	}})
+	if err != fail || visited != 2 {
+		t.Errorf("expected error %q after 2 visits, got %v after %d", fail, err, visited)
+	}
+	if s := names(res); s != "*(+(b)-(c))" {
+		t.Errorf("expected %q, got %q", "*(+(b)-(c))", s)
+	}
+}
+
+// TestInspect verifies that Inspect visits nodes in pre-order, and skips children.
+func TestInspect(t *testing.T) {
+	var res []string
+	Inspect(tree(), func(n *Node) bool {
+		res = append(res, n.Data.Str)
+		return n.Data.Str != "-"
+	})
+	if s := strings.Join(res, ","); s != "*,+,a,b,-" {
+		t.Errorf("expected %q, got %q", "*,+,a,b,-", s)
+	}
+}
@@ -0,0 +1,468 @@
+// lsp.go provides the language server of vslc, which speaks the Language Server Protocol over a pair of streams, such
+// that editors show the diagnostics of VSL source code, go to the declarations of identifiers, show their types on
+// hover and format documents.
+
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+	"vslc/src/frontend"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+	"vslc/src/vslc"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Server is a language server, which reads requests and notifications from its input, and writes responses and
+// notifications to its output.
+type Server struct {
+	r        *bufio.Reader
+	w        io.Writer
+	docs     map[string]*document // docs are the open documents by URI.
+	shutdown bool                 // shutdown is set when the client has requested the server to shut down.
+}
+
+// document is an open document, and its identifiers resolved to their declarations if it compiles without errors.
+type document struct {
+	text    string
+	symbols []lir.Symbol
+}
+
+// message is a JSON-RPC request, response or notification.
+type message struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  *json.RawMessage `json:"result,omitempty"`
+	Error   *responseError   `json:"error,omitempty"`
+}
+
+// responseError is the error of a response.
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// position is a position in a document: a line and the UTF-16 offset on the line, both starting at 0.
+type position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+// span is a range of a document, excluding its end.
+type span struct {
+	Start position `json:"start"`
+	End   position `json:"end"`
+}
+
+// location is a range of a document.
+type location struct {
+	URI   string `json:"uri"`
+	Range span   `json:"range"`
+}
+
+// diagnostic is an error in a document.
+type diagnostic struct {
+	Range    span   `json:"range"`
+	Severity int    `json:"severity"`
+	Source   string `json:"source"`
+	Message  string `json:"message"`
+}
+
+// textEdit replaces a range of a document.
+type textEdit struct {
+	Range   span   `json:"range"`
+	NewText string `json:"newText"`
+}
+
+// hover is the information shown when the pointer hovers over an identifier.
+type hover struct {
+	Contents markupContent `json:"contents"`
+	Range    span          `json:"range"`
+}
+
+// markupContent is formatted text.
+type markupContent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+// textDocument identifies a document, and holds its text when it's opened.
+type textDocument struct {
+	URI  string `json:"uri"`
+	Text string `json:"text"`
+}
+
+// documentParams are the parameters of notifications and requests that concern a document, and a position in it.
+type documentParams struct {
+	TextDocument   textDocument `json:"textDocument"`
+	Position       position     `json:"position"`
+	ContentChanges []struct {
+		Text string `json:"text"`
+	} `json:"contentChanges"`
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// Error codes of JSON-RPC and the Language Server Protocol.
+const (
+	codeParseError     = -32700
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeRequestFailed  = -32803
+)
+
+// severityError is the severity of diagnostics that are errors.
+const severityError = 1
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// NewServer returns a Server that reads from r and writes to w.
+func NewServer(r io.Reader, w io.Writer) *Server {
+	return &Server{r: bufio.NewReader(r), w: w, docs: map[string]*document{}}
+}
+
+// Serve handles the messages of the client until it sends the exit notification. Documents are synchronised in full,
+// and diagnostics are published whenever a document is opened or changed. An error is returned if the input ends or
+// can't be read, if the output can't be written, or if the client exits without shutting the server down first.
+func (s *Server) Serve() error {
+	for {
+		msg, err := s.read()
+		var se *json.SyntaxError
+		switch {
+		case errors.As(err, &se):
+			if err := s.reply(nil, nil, &responseError{Code: codeParseError, Message: err.Error()}); err != nil {
+				return err
+			}
+			continue
+		case err != nil:
+			return err
+		case msg.Method == "exit":
+			if !s.shutdown {
+				return errors.New("exit notification before shutdown request")
+			}
+			return nil
+		}
+		if err := s.handle(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// handle handles the request or notification msg. Requests are responded to, and unknown notifications are ignored.
+func (s *Server) handle(msg *message) error {
+	var p documentParams
+	if len(msg.Params) > 0 {
+		if err := json.Unmarshal(msg.Params, &p); err != nil {
+			if msg.ID == nil {
+				return nil
+			}
+			return s.reply(msg.ID, nil, &responseError{Code: codeInvalidParams, Message: err.Error()})
+		}
+	}
+	uri := p.TextDocument.URI
+
+	switch msg.Method {
+	case "initialize":
+		return s.reply(msg.ID, map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync":           1, // Full.
+				"definitionProvider":         true,
+				"hoverProvider":              true,
+				"documentFormattingProvider": true,
+			},
+			"serverInfo": map[string]string{"name": "vslc", "version": util.Build().Version},
+		}, nil)
+	case "shutdown":
+		s.shutdown = true
+		return s.reply(msg.ID, nil, nil)
+	case "textDocument/didOpen":
+		return s.update(uri, p.TextDocument.Text)
+	case "textDocument/didChange":
+		if len(p.ContentChanges) == 0 {
+			return nil
+		}
+		return s.update(uri, p.ContentChanges[len(p.ContentChanges)-1].Text)
+	case "textDocument/didClose":
+		delete(s.docs, uri)
+		return s.notify("textDocument/publishDiagnostics", map[string]interface{}{
+			"uri":         uri,
+			"diagnostics": []diagnostic{},
+		})
+	case "textDocument/definition", "textDocument/hover", "textDocument/formatting":
+		doc, ok := s.docs[uri]
+		if !ok {
+			return s.reply(msg.ID, nil, &responseError{Code: codeRequestFailed, Message: "unknown document " + uri})
+		}
+		switch msg.Method {
+		case "textDocument/definition":
+			return s.reply(msg.ID, doc.definition(uri, p.Position), nil)
+		case "textDocument/hover":
+			return s.reply(msg.ID, doc.hover(p.Position), nil)
+		}
+		edits, err := doc.format()
+		if err != nil {
+			return s.reply(msg.ID, nil, &responseError{Code: codeRequestFailed, Message: err.Error()})
+		}
+		return s.reply(msg.ID, edits, nil)
+	}
+	if msg.ID != nil {
+		return s.reply(msg.ID, nil, &responseError{Code: codeMethodNotFound, Message: "unknown method " + msg.Method})
+	}
+	return nil
+}
+
+// update sets the text of the document identified by uri, compiles it and publishes its diagnostics. The identifiers
+// of the document are resolved if it compiles without errors, such that definitions and hovers are never stale.
+func (s *Server) update(uri, text string) error {
+	doc, ok := s.docs[uri]
+	if !ok {
+		doc = &document{}
+		s.docs[uri] = doc
+	}
+	doc.text = text
+
+	_, diags := vslc.Compile(text, vslc.Options{Threads: 1, SyntaxOnly: true})
+	res := make([]diagnostic, len(diags))
+	for i1, e1 := range diags {
+		res[i1] = diagnostic{
+			Range:    doc.identifier(e1.Line, e1.Pos, ""),
+			Severity: severityError,
+			Source:   "vslc",
+			Message:  e1.Msg,
+		}
+	}
+	doc.symbols = nil
+	if len(diags) == 0 {
+		doc.symbols = symbols(text)
+	}
+	return s.notify("textDocument/publishDiagnostics", map[string]interface{}{"uri": uri, "diagnostics": res})
+}
+
+// symbols returns the identifiers of VSL source code src, resolved to their declarations, or nil if it can't be
+// compiled.
+func symbols(src string) []lir.Symbol {
+	opt := util.Options{Threads: 1}
+	root, err := frontend.Parse(src)
+	if err != nil {
+		return nil
+	}
+	if err := ir.Optimise(opt, root); err != nil {
+		return nil
+	}
+	m, err := lir.GenLIR(opt, root)
+	if err != nil {
+		return nil
+	}
+	return m.Symbols()
+}
+
+// symbol returns the identifier of document d at position p, if any.
+func (d *document) symbol(p position) (lir.Symbol, bool) {
+	line, col := d.offset(p)
+	for _, e1 := range d.symbols {
+		if e1.Use.Line == line && col >= e1.Use.Pos && col < e1.Use.Pos+len(e1.Name) {
+			return e1, true
+		}
+	}
+	return lir.Symbol{}, false
+}
+
+// definition returns the location of the declaration of the identifier at position p of document d, which is
+// identified by uri, or nil if there's none.
+func (d *document) definition(uri string, p position) *location {
+	s, ok := d.symbol(p)
+	if !ok || s.Def.Line == 0 {
+		return nil
+	}
+	return &location{URI: uri, Range: d.identifier(s.Def.Line, s.Def.Pos, s.Name)}
+}
+
+// hover returns the declaration and scope of the identifier at position p of document d, or nil if there's none.
+func (d *document) hover(p position) *hover {
+	s, ok := d.symbol(p)
+	if !ok {
+		return nil
+	}
+	typ := strings.ToLower(s.Type.String())
+	var decl, scope string
+	switch s.Kind {
+	case lir.SymbolFunction:
+		params := make([]string, len(s.Func.Params()))
+		for i1, e1 := range s.Func.Params() {
+			params[i1] = e1.Name() + " " + strings.ToLower(e1.DataType().String())
+		}
+		decl = fmt.Sprintf("def %s(%s) %s", s.Name, strings.Join(params, ", "), typ)
+	case lir.SymbolGlobal:
+		decl, scope = fmt.Sprintf("var %s %s", s.Name, typ), "Global variable."
+	case lir.SymbolParam:
+		decl, scope = fmt.Sprintf("%s %s", s.Name, typ), fmt.Sprintf("Parameter of function %s.", s.Func.Name())
+	case lir.SymbolLocal:
+		decl, scope = fmt.Sprintf("var %s %s", s.Name, typ), fmt.Sprintf("Local variable of function %s.", s.Func.Name())
+	}
+	value := "```vsl\n" + decl + "\n```"
+	if len(scope) > 0 {
+		value += "\n\n" + scope
+	}
+	return &hover{
+		Contents: markupContent{Kind: "markdown", Value: value},
+		Range:    d.identifier(s.Use.Line, s.Use.Pos, s.Name),
+	}
+}
+
+// format returns the edits that format document d, which replace the whole document if it isn't formatted.
+func (d *document) format() ([]textEdit, error) {
+	text, err := frontend.Format(d.text)
+	if err != nil {
+		return nil, err
+	}
+	if text == d.text {
+		return []textEdit{}, nil
+	}
+	lines := strings.Split(d.text, "\n")
+	end := d.position(len(lines), len(lines[len(lines)-1])+1)
+	return []textEdit{{Range: span{End: end}, NewText: text}}, nil
+}
+
+// identifier returns the range of the identifier name at line and pos of document d, which start at 1. The word at
+// that position is used if name is empty, or one character if there's no word. An empty range at the start of the
+// document is returned if line is 0.
+func (d *document) identifier(line, pos int, name string) span {
+	if line == 0 {
+		return span{}
+	}
+	n := len(name)
+	if n == 0 {
+		lines := strings.Split(d.text, "\n")
+		if line <= len(lines) && pos <= len(lines[line-1]) {
+			s := lines[line-1][pos-1:]
+			for n < len(s) && (s[n] == '_' || s[n] >= 'a' && s[n] <= 'z' || s[n] >= 'A' && s[n] <= 'Z' ||
+				s[n] >= '0' && s[n] <= '9' || s[n] == '.') {
+				n++
+			}
+		}
+		if n == 0 {
+			n = 1
+		}
+	}
+	return span{Start: d.position(line, pos), End: d.position(line, pos+n)}
+}
+
+// position returns the position of line and byte position pos of document d, which start at 1.
+func (d *document) position(line, pos int) position {
+	lines := strings.Split(d.text, "\n")
+	if line > len(lines) {
+		return position{Line: line - 1, Character: pos - 1}
+	}
+	s := lines[line-1]
+	if pos-1 < len(s) {
+		s = s[:pos-1]
+	}
+	return position{Line: line - 1, Character: len(utf16.Encode([]rune(s)))}
+}
+
+// offset returns the line and byte position, which start at 1, of position p of document d.
+func (d *document) offset(p position) (int, int) {
+	lines := strings.Split(d.text, "\n")
+	if p.Line >= len(lines) {
+		return p.Line + 1, p.Character + 1
+	}
+	s, n, pos := lines[p.Line], 0, 0
+	for n < p.Character && pos < len(s) {
+		r, w := utf8.DecodeRuneInString(s[pos:])
+		n += utf16.RuneLen(r)
+		pos += w
+	}
+	return p.Line + 1, pos + 1
+}
+
+// read returns the next message of the client. Messages are preceded by headers, of which Content-Length is required.
+func (s *Server) read() (*message, error) {
+	n := -1
+	for {
+		line, err := s.r.ReadString('\n')
+		if err == io.EOF && len(line) == 0 {
+			return nil, io.ErrUnexpectedEOF
+		} else if err != nil {
+			return nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if len(line) == 0 {
+			break
+		}
+		if kv := strings.SplitN(line, ":", 2); len(kv) == 2 && strings.EqualFold(kv[0], "Content-Length") {
+			if n, err = strconv.Atoi(strings.TrimSpace(kv[1])); err != nil {
+				return nil, fmt.Errorf("invalid header %q", line)
+			}
+		}
+	}
+	if n < 0 {
+		return nil, errors.New("message without Content-Length header")
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(s.r, b); err != nil {
+		return nil, err
+	}
+	msg := &message{}
+	if err := json.Unmarshal(b, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// reply responds to the request identified by id with result, or with err if it isn't nil.
+func (s *Server) reply(id *json.RawMessage, result interface{}, err *responseError) error {
+	if id == nil && err == nil {
+		return nil
+	}
+	msg := &message{JSONRPC: "2.0", ID: id, Error: err}
+	if id == nil {
+		null := json.RawMessage("null")
+		msg.ID = &null
+	}
+	if err == nil {
+		b, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		raw := json.RawMessage(b)
+		msg.Result = &raw
+	}
+	return s.write(msg)
+}
+
+// notify sends the notification of method with params to the client.
+func (s *Server) notify(method string, params interface{}) error {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return s.write(&message{JSONRPC: "2.0", Method: method, Params: b})
+}
+
+// write sends msg to the client.
+func (s *Server) write(msg *message) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(s.w, "Content-Length: %d\r\n\r\n%s", len(b), b)
+	return err
+}
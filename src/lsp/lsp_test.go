@@ -0,0 +1,72 @@
+package lsp
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestServer verifies that diagnostics are published for opened and changed documents, and that definitions, hovers
+// and formatting are responded to.
+func TestServer(t *testing.T) {
+	const uri = "file:///prog.vsl"
+	src := "var n int\ndef f(a int) int\nbegin\n    n := a\n    return g(n)\nend\ndef g(x float) int\nreturn x\n"
+	msgs := []string{
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`,
+		`{"jsonrpc":"2.0","method":"initialized","params":{}}`,
+		fmt.Sprintf(`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":%q,"text":%q}}}`,
+			uri, "def f() int\nreturn m\n"),
+		fmt.Sprintf(`{"jsonrpc":"2.0","method":"textDocument/didChange","params":{"textDocument":{"uri":%q},`+
+			`"contentChanges":[{"text":%q}]}}`, uri, src),
+		fmt.Sprintf(`{"jsonrpc":"2.0","id":2,"method":"textDocument/definition","params":{"textDocument":{"uri":%q},`+
+			`"position":{"line":4,"character":13}}}`, uri),
+		fmt.Sprintf(`{"jsonrpc":"2.0","id":3,"method":"textDocument/hover","params":{"textDocument":{"uri":%q},`+
+			`"position":{"line":4,"character":11}}}`, uri),
+		fmt.Sprintf(`{"jsonrpc":"2.0","id":4,"method":"textDocument/hover","params":{"textDocument":{"uri":%q},`+
+			`"position":{"line":2,"character":0}}}`, uri),
+		fmt.Sprintf(`{"jsonrpc":"2.0","id":5,"method":"textDocument/formatting","params":{"textDocument":{"uri":%q}}}`,
+			uri),
+		`{"jsonrpc":"2.0","id":6,"method":"workspace/symbol","params":{}}`,
+		`{"jsonrpc":"2.0","id":7,"method":"shutdown"}`,
+		`{"jsonrpc":"2.0","method":"exit"}`,
+	}
+	in := bytes.Buffer{}
+	for _, e1 := range msgs {
+		in.WriteString(fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(e1), e1))
+	}
+	out := bytes.Buffer{}
+	if err := NewServer(&in, &out).Serve(); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []string{
+		`"capabilities":{"definitionProvider":true,"documentFormattingProvider":true,"hoverProvider":true,` +
+			`"textDocumentSync":1}`,
+		`"diagnostics":[{"range":{"start":{"line":1,"character":7},"end":{"line":1,"character":8}},"severity":1,` +
+			`"source":"vslc","message":"undeclared variable \"m\""}]`,
+		`"diagnostics":[]`,
+		`"id":2,"result":{"uri":"file:///prog.vsl","range":{"start":{"line":0,"character":4},` +
+			`"end":{"line":0,"character":5}}}`,
+		`"id":3,"result":{"contents":{"kind":"markdown","value":"` + "```vsl\\ndef g(x float) int\\n```" + `"},` +
+			`"range":{"start":{"line":4,"character":11},"end":{"line":4,"character":12}}}`,
+		`"id":4,"result":null`,
+		`"id":5,"result":[{"range":{"start":{"line":0,"character":0},"end":{"line":8,"character":0}},` +
+			`"newText":"var n int\ndef f(a int) int\nbegin\n    n := a\n    return g(n)\nend\ndef g(x float) int\n` +
+			`    return x\n"}]`,
+		`"id":6,"error":{"code":-32601,"message":"unknown method workspace/symbol"}`,
+		`"id":7,"result":null`,
+	}
+	var res []string
+	for _, e1 := range strings.Split(out.String(), "Content-Length: ")[1:] {
+		res = append(res, strings.SplitN(e1, "\r\n\r\n", 2)[1])
+	}
+	if len(res) != len(exp) {
+		t.Fatalf("expected %d messages, got %d: %q", len(exp), len(res), res)
+	}
+	for i1, e1 := range exp {
+		if !strings.Contains(res[i1], strings.ReplaceAll(e1, "\n", `\n`)) {
+			t.Errorf("expected %s in message %d, got %s", e1, i1+1, res[i1])
+		}
+	}
+}
@@ -3,10 +3,23 @@
 package main
 
 import (
+	"bufio"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
 	"vslc/src/backend"
-	lir2 "vslc/src/backend/lir"
+	_ "vslc/src/backend/arm"
+	_ "vslc/src/backend/armv7"
+	_ "vslc/src/backend/riscv"
+	_ "vslc/src/backend/wasm"
 	"vslc/src/ir/lir"
 )
 
@@ -14,117 +27,855 @@ import (
 	"vslc/src/frontend"
 	"vslc/src/ir"
 	"vslc/src/ir/llvm"
+	"vslc/src/lsp"
 	"vslc/src/util"
+	"vslc/src/vslc"
 )
 
 // run begins reading source code and executes compiler stages.
-// Behaviour is defined by the util.Options structure.
-func run(opt util.Options) error {
+// Behaviour is defined by the util.Options structure. The returned exit code is non-zero if an error occurred, or
+// the interpreted program's exit code if the program was run using the -run flag. The compiler stages from the parsed
+// syntax tree on are run by vslc.Build until every artifact of opt has been emitted. Panics are recovered and returned
+// as internal compiler errors.
+func run(opt util.Options) (ret int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			ret, err = util.ExitInternal, util.Recovered(r)
+		}
+	}()
+	last := opt.LastStage()
+
+	// Create the output directory, if given.
+	if len(opt.OutDir) > 0 {
+		if err := os.MkdirAll(opt.OutDir, 0755); err != nil {
+			return util.ExitIO, err
+		}
+	}
+
+	// Compile source files to LIR objects, or link LIR objects, if requested.
+	if opt.Compile || opt.Linking() {
+		return separate(opt)
+	}
+
 	// Read source code.
-	src, err := util.ReadSource(opt)
+	st := opt.Timing.StartStage("read")
+	srcs, err := util.ReadSources(opt)
+	st.Stop()
 	if err != nil {
-		return fmt.Errorf("could not read source code: %s\n", err)
+		return util.ExitIO, fmt.Errorf("could not read source code: %s\n", err)
 	}
 
-	// If -ts flag was passed: output token stream and exit.
-	if opt.TokenStream {
-		if err := frontend.TokenStream(src); err != nil {
-			return fmt.Errorf("syntax error: %s\n", err)
+	// Output token stream, if requested.
+	if err := emit(opt, util.EmitTokens, func(opt util.Options) error {
+		for _, e1 := range srcs {
+			if err := frontend.TokenStream(opt, e1); err != nil {
+				return err
+			}
 		}
 		return nil
+	}); err != nil {
+		return util.ExitSyntax, fmt.Errorf("syntax error: %s\n", err)
+	}
+	if last == util.EmitTokens && !opt.Run {
+		return 0, nil
 	}
 
-	// Generate syntax tree by lexing and parsing source code.
-	if err := frontend.Parse(src); err != nil {
-		return err
+	// Generate syntax tree by lexing and parsing source code. Multiple source files, and the functions of large source
+	// files, are parsed concurrently.
+	st = opt.Timing.StartStage("parse")
+	var root *ir.Node
+	if len(srcs) > 1 {
+		root, err = frontend.ParseFiles(opt, opt.Srcs, srcs)
+	} else {
+		root, err = frontend.ParseParallel(opt, srcs[0], &ir.Arena{})
+	}
+	st.Stop()
+	if err != nil {
+		return util.ExitSyntax, err
+	}
+
+	opt.Log.Infof("parsed %d source files", len(srcs))
+	return vslc.Build(opt, root, sink())
+}
+
+// sink returns the vslc.Sink that writes the artifacts of a compilation to their output files or stdout, assembles
+// and links the program, and generates it with the LLVM framework if requested. Interpreted programs write to stdout
+// and stderr.
+func sink() vslc.Sink {
+	return vslc.Sink{
+		Emit: emit,
+		LLVM: func(opt util.Options, root *ir.Node) error {
+			if err := llvm.GenLLVM(opt, root); err != nil {
+				return fmt.Errorf("error reported by LLVM: %w", err)
+			}
+			return nil
+		},
+		Assemble: assemble,
+		Stdout:   os.Stdout,
+		Stderr:   os.Stderr,
 	}
+}
 
-	// Optimise syntax tree.
-	if err := ir.Optimise(opt); err != nil {
-		return fmt.Errorf("syntax tree error: %s\n", err)
+// separate compiles each source file of opt to a LIR object, whose functions may call the functions of the other
+// source files and LIR objects given. The LIR objects are written to their object files if opt.Compile is set.
+// Otherwise, they're linked with the LIR objects given into one program, whose entry function is the first function of
+// the first file, and the program is generated like one compiled from source.
+func separate(opt util.Options) (int, error) {
+	var names, paths []string // Paths to source files and LIR objects.
+	for _, e1 := range opt.Srcs {
+		if util.IsObject(e1) {
+			paths = append(paths, e1)
+		} else {
+			names = append(names, e1)
+		}
+	}
+	if len(opt.Srcs) == 0 {
+		names = []string{util.Stdin} // Source code is read from stdin.
 	}
 
-	if opt.Verbose {
-		fmt.Println("Syntax tree:")
-		ir.Root.Print(0, true)
+	// Read LIR objects.
+	objs := make(map[string]*lir.Module, len(opt.Srcs))
+	decls := make([]*lir.Module, len(paths))
+	for i1, e1 := range paths {
+		m, err := readObject(e1)
+		if err != nil {
+			return util.ExitIO, err
+		}
+		objs[e1] = m
+		decls[i1] = m
 	}
 
-	// Gen LLVM and exit, if flag is passed.
-	if opt.LLVM {
-		if err = llvm.GenLLVM(opt, ir.Root); err != nil {
-			return fmt.Errorf("error reported by LLVM: %s", err)
+	if len(names) > 0 {
+		// Read and parse source code.
+		o := opt
+		o.Src, o.Srcs = names[0], names
+		if names[0] == util.Stdin {
+			o.Srcs = nil
 		}
-		return nil
+		st := opt.Timing.StartStage("read")
+		srcs, err := util.ReadSources(o)
+		st.Stop()
+		if err != nil {
+			return util.ExitIO, fmt.Errorf("could not read source code: %s\n", err)
+		}
+		st = opt.Timing.StartStage("parse")
+		trees, err := frontend.ParseTrees(opt, names, srcs)
+		st.Stop()
+		if err != nil {
+			return util.ExitSyntax, err
+		}
+
+		// Optimise syntax trees.
+		st = opt.Timing.StartStage("optimise")
+		for i1, e1 := range trees {
+			if err := ir.Optimise(opt, e1); err != nil {
+				st.Stop()
+				return util.ExitType, fmt.Errorf("syntax tree error: %w", util.InFile(err, names[i1]))
+			}
+		}
+		st.Stop()
+
+		// Generate the LIR object of each source file, which declares the symbols of the other files.
+		for i1, e1 := range trees {
+			if err := opt.Context().Err(); err != nil {
+				return util.ExitFailure, err
+			}
+			o.Src = names[i1]
+			others := append(append([]*ir.Node{}, trees[:i1]...), trees[i1+1:]...)
+			st = opt.Timing.StartStage("lir")
+			m, err := lir.GenObject(o, e1, others, decls)
+			if err != nil {
+				st.Stop()
+				return util.ExitType, util.InFile(err, names[i1])
+			}
+			lir.SimplifyCFG(opt, m)
+			if opt.SSA {
+				lir.Mem2Reg(opt, m)
+			}
+			st.Stop()
+			if opt.Compile {
+				if err := writeObject(opt.Object(names[i1]), m); err != nil {
+					return util.ExitIO, err
+				}
+				opt.Log.Infof("wrote LIR object %s", opt.Object(names[i1]))
+			}
+			objs[names[i1]] = m
+		}
+	}
+	if opt.Compile {
+		return 0, nil
 	}
 
-	// Generate SSA from optimised and validated parse tree.
-	m, err := lir.GenLIR(opt, ir.Root)
+	// Link LIR objects in the order of the files.
+	mods := make([]*lir.Module, len(opt.Srcs))
+	for i1, e1 := range opt.Srcs {
+		mods[i1] = objs[e1]
+	}
+	root := entry(mods)
+	st := opt.Timing.StartStage("lir")
+	m, err := lir.Link(filepath.Base(opt.Src), mods)
+	st.Stop()
 	if err != nil {
-		return err
+		return util.ExitType, err
+	}
+	if m.IntBits() != opt.IntBits() {
+		return util.ExitUsage, fmt.Errorf("LIR objects have %d-bit integers, expected %d-bit integers of the target",
+			m.IntBits(), opt.IntBits())
+	}
+	opt.Log.Infof("linked %d LIR objects", len(mods))
+	if opt.SyntaxOnly {
+		return 0, nil
 	}
+	return vslc.Generate(opt, m, root, sink())
+}
 
-	if opt.Verbose {
-		fmt.Println("\nLIR intermediate representation:")
-		fmt.Println(m.String())
+// entry returns a syntax tree that declares the first function defined by the LIR objects objs, which is called by
+// the entry point of the linked program.
+func entry(objs []*lir.Module) *ir.Node {
+	root := &ir.Node{Typ: ir.PROGRAM}
+	for _, e1 := range objs {
+		for _, e2 := range e1.Functions() {
+			if len(e2.Blocks()) > 0 {
+				id := &ir.Node{Typ: ir.IDENTIFIER_DATA, Data: ir.Str(e2.Name())}
+				root.Children = append(root.Children, &ir.Node{Typ: ir.FUNCTION, Children: []*ir.Node{id}})
+				return root
+			}
+		}
 	}
+	return root
+}
 
-	// Allocate hardware registers to LIR virtual registers.
-	if err := lir2.AllocateRegisters(opt, m); err != nil {
+// readObject reads the LIR object at path.
+func readObject(path string) (*lir.Module, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	m, err := lir.Decode(bufio.NewReader(f))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	return m, nil
+}
+
+// writeObject writes LIR Module m to the LIR object at path.
+func writeObject(path string, m *lir.Module) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err := m.Encode(w); err != nil {
+		_ = f.Close()
 		return err
 	}
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
 
-	// Generate assembler.
-	if err := backend.GenerateAssembler(opt, m, ir.Root); err != nil {
+// assemble writes the assembler generated by gen to a temporary file, which is emitted if requested, assembled into an
+// object file if requested, and linked into an executable if opt.Link is set.
+func assemble(opt util.Options, gen func(opt util.Options) error) error {
+	f, err := ioutil.TempFile("", "vslc-*.s")
+	if err != nil {
 		return err
 	}
+	tmp := f.Name()
+	defer func() {
+		_ = os.Remove(tmp)
+	}()
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := write(opt, tmp, gen); err != nil {
+		return err
+	}
+
+	if a, ok := opt.Artifact(util.EmitAsm); ok {
+		b, err := ioutil.ReadFile(tmp)
+		if err != nil {
+			return err
+		}
+		if out := opt.Output(a); len(out) > 0 {
+			err = ioutil.WriteFile(out, b, 0644)
+		} else {
+			_, err = os.Stdout.Write(b)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if a, ok := opt.Artifact(util.EmitObj); ok {
+		st := opt.Timing.StartStage("assemble")
+		err := backend.Assemble(opt, tmp, opt.Output(a))
+		st.Stop()
+		if err != nil {
+			return err
+		}
+	}
+	if opt.Link {
+		defer opt.Timing.StartStage("link").Stop()
+		return backend.Link(opt, tmp, opt.Out)
+	}
 	return nil
 }
 
+// emit writes the output of gen to the output path of the artifact of the given kind, if it's emitted. gen writes its
+// output using Writers returned by the NewWriter method of the Options it's called with.
+func emit(opt util.Options, kind string, gen func(opt util.Options) error) error {
+	a, ok := opt.Artifact(kind)
+	if !ok {
+		return nil
+	}
+	return write(opt, opt.Output(a), gen)
+}
+
+// write writes the output of gen to the file at path out, or stdout if out is empty.
+func write(opt util.Options, out string, gen func(opt util.Options) error) error {
+	f := os.Stdout
+	if len(out) > 0 {
+		// Attempt to open output file. Create new file if necessary.
+		var err error
+		if f, err = os.OpenFile(out, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644); err != nil {
+			return err
+		}
+		defer func(f *os.File) {
+			if err := f.Close(); err != nil {
+				fmt.Println(err)
+			}
+		}(f)
+	}
+	opt.Dst = util.NewOutput(f)
+	if err := gen(opt); err != nil {
+		return err
+	}
+	return opt.Dst.Err()
+}
+
+// test runs the golden file tests of the vslc test subcommand, the differential tests if -diff is given, or the
+// execution tests if -exec is given. args are the flags of the subcommand, followed by the optional directories of the
+// VSL source files and the golden files. It returns the exit code.
+func test(args []string) int {
+	const usage = "usage: vslc test [-update | -diff | -exec] [-native arch ...] [source directory [golden directory]]"
+	update, diff, run := false, false, false
+	var natives []int
+	for len(args) > 0 && strings.HasPrefix(args[0], "-") {
+		switch args[0] {
+		case "-update":
+			update = true
+		case "-diff":
+			diff = true
+		case "-exec":
+			run = true
+		case "-native":
+			if len(args) < 2 {
+				fmt.Printf("Command line argument error: got flag %s but no argument\n", args[0])
+				return util.ExitUsage
+			}
+			arch, err := nativeArch(args[1])
+			if err != nil {
+				fmt.Printf("Command line argument error: %s\n", err)
+				return util.ExitUsage
+			}
+			natives = append(natives, arch)
+			args = args[1:]
+		default:
+			fmt.Printf("Command line argument error: unexpected flag %s, %s\n", args[0], usage)
+			return util.ExitUsage
+		}
+		args = args[1:]
+	}
+	dirs := []string{"resources/vsl_typed", "resources/golden"}
+	if len(args) > len(dirs) || diff && (update || run || len(args) > 1) || update && run ||
+		!diff && !run && len(natives) > 0 {
+		fmt.Printf("Command line argument error: %s\n", usage)
+		return util.ExitUsage
+	}
+	copy(dirs, args)
+	switch {
+	case diff:
+		return differential(dirs[0], natives)
+	case run:
+		return execute(dirs[0], dirs[1], natives)
+	}
+
+	res, err := vslc.Golden(dirs[0], dirs[1], update)
+	for _, e1 := range res {
+		fmt.Println(e1)
+	}
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return util.ExitIO
+	}
+	if len(res) > 0 {
+		fmt.Printf("%d golden files don't match, run vslc test -update to update them\n", len(res))
+		return util.ExitFailure
+	}
+	return util.ExitOK
+}
+
+// differential runs the programs of the VSL source files in directory src by the interpreter, as LLVM IR generated
+// from LIR and by the LLVM framework, and as executables of the target architectures natives, and reports the
+// programs whose output or exit code differs from the interpreted program. It returns the exit code.
+func differential(src string, natives []int) int {
+	runners := []vslc.Runner{vslc.Interpreter()}
+	if lli := vslc.LLI(); lli != nil {
+		runners = append(runners, vslc.LLVMIR(lli), llvmRunner(lli))
+	} else {
+		fmt.Println("lli not found, LLVM IR isn't run")
+	}
+	for _, e1 := range natives {
+		runners = append(runners, vslc.Native(e1))
+	}
+
+	res, err := vslc.Differential(context.Background(), src, runners)
+	for _, e1 := range res {
+		fmt.Println(e1)
+	}
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return util.ExitIO
+	}
+	if len(res) > 0 {
+		fmt.Printf("%d programs diverge\n", len(res))
+		return util.ExitFailure
+	}
+	return util.ExitOK
+}
+
+// execute runs the executables of the target architectures natives, or aarch64 and riscv64 if none are given, that
+// are compiled from the VSL source files in directory src, and compares their output and exit code with the golden
+// files in directory dir. Targets whose toolchain isn't found are skipped. It returns the exit code.
+func execute(src, dir string, natives []int) int {
+	if len(natives) == 0 {
+		natives = []int{util.Aarch64, util.Riscv64}
+	}
+	ret, n := util.ExitOK, 0
+	for _, e1 := range natives {
+		name := vslc.Native(e1).Name
+		if _, err := vslc.Toolchain(e1); err != nil {
+			fmt.Printf("Skipping %s: %s\n", name, err)
+			continue
+		}
+		n++
+		res, err := vslc.Execute(context.Background(), src, dir, e1)
+		for _, e2 := range res {
+			fmt.Println(e2)
+		}
+		if err != nil {
+			fmt.Printf("Error: %s\n", err)
+			return util.ExitIO
+		}
+		if len(res) > 0 {
+			fmt.Printf("%d %s executables don't match their golden files\n", len(res), name)
+			ret = util.ExitFailure
+		}
+	}
+	if n == 0 {
+		fmt.Println("No target could be run, install a cross toolchain and QEMU user mode emulation")
+	}
+	return ret
+}
+
+// llvmRunner returns the Runner that generates LLVM IR from the syntax tree by the LLVM framework, and runs it by the
+// LLVM interpreter, whose command line is lli.
+func llvmRunner(lli []string) vslc.Runner {
+	return vslc.Runner{
+		Name: "llvm",
+		Run: func(ctx context.Context, path string, args []string) (vslc.Result, error) {
+			dir, err := ioutil.TempDir("", "vslc")
+			if err != nil {
+				return vslc.Result{}, err
+			}
+			defer os.RemoveAll(dir)
+			ll := filepath.Join(dir, "prog.ll")
+			opt := util.Options{
+				Src:        path,
+				Srcs:       []string{path},
+				Threads:    1,
+				LLVM:       true,
+				TargetArch: vslc.HostArch(),
+				Emit:       []util.Artifact{{Kind: util.EmitLLVMIR, Out: ll}},
+				Ctx:        ctx,
+			}
+			if _, err := run(opt); err != nil {
+				return vslc.Result{}, err
+			}
+			return vslc.Exec(ctx, append(append([]string{}, lli...), ll), args)
+		},
+	}
+}
+
+// diffIR prints the structural difference between the LIR of the two files of the vslc diff-ir subcommand, which are
+// VSL source files, LIR objects or textual LIR, and are given by args after the optional -ssa flag. It returns the exit
+// code, which is 1 if the LIR differs, like diff.
+func diffIR(args []string) int {
+	opt := vslc.Options{Threads: 1}
+	if len(args) > 0 && args[0] == "-ssa" {
+		opt.SSA = true
+		args = args[1:]
+	}
+	if len(args) != 2 || strings.HasPrefix(args[0], "-") || strings.HasPrefix(args[1], "-") {
+		fmt.Println("Command line argument error: usage: vslc diff-ir [-ssa] old new")
+		return util.ExitUsage
+	}
+	var ir [2]string
+	for i1, e1 := range args {
+		s, err := vslc.LoadIR(e1, opt)
+		if err != nil {
+			fmt.Printf("Error: %s\n", err)
+			var d vslc.Diagnostic
+			if errors.As(err, &d) {
+				return d.Code
+			}
+			return util.ExitIO
+		}
+		ir[i1] = s
+	}
+	d := vslc.DiffIR(ir[0], ir[1])
+	if len(d) == 0 {
+		return util.ExitOK
+	}
+	fmt.Printf("--- %s\n+++ %s\n%s", args[0], args[1], d)
+	return util.ExitFailure
+}
+
+// doc prints the documentation of the VSL source files of the vslc doc subcommand, as Markdown or as HTML if the
+// -html flag is given. It returns the exit code.
+func doc(args []string) int {
+	html := false
+	if len(args) > 0 && args[0] == "-html" {
+		html = true
+		args = args[1:]
+	}
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		fmt.Println("Command line argument error: usage: vslc doc [-html] file.vsl ...")
+		return util.ExitUsage
+	}
+	srcs := make([]string, len(args))
+	for i1, e1 := range args {
+		b, err := ioutil.ReadFile(e1)
+		if err != nil {
+			fmt.Printf("Error: %s\n", err)
+			return util.ExitIO
+		}
+		srcs[i1] = string(b)
+	}
+	s, err := vslc.Doc(args, srcs, html)
+	switch {
+	case errors.Is(err, util.ErrSyntax):
+		fmt.Printf("Error: %s\n", err)
+		return util.ExitSyntax
+	case err != nil:
+		fmt.Printf("Error: %s\n", err)
+		return util.ExitType
+	}
+	fmt.Print(s)
+	return util.ExitOK
+}
+
+// bench runs the stage benchmarks of the vslc bench subcommand, which compile the VSL source files given, or those of
+// the source directories given, for each number of worker threads given by -t, and print the mean time of every
+// compiler stage as JSON, or as CSV if -csv is given. It returns the exit code.
+func bench(args []string) int {
+	const usage = "usage: vslc bench [-csv] [-fno-pipeline] [-t threads,...] [-n runs] [-arch arch] " +
+		"[source directory | file.vsl ...]"
+	opt := vslc.Options{TargetArch: util.Aarch64}
+	threads, runs, csv := []int{1, 2, 4, 8, 16}, 5, false
+	for len(args) > 0 && strings.HasPrefix(args[0], "-") {
+		switch args[0] {
+		case "-csv":
+			csv = true
+		case "-fno-pipeline":
+			opt.NoPipeline = true
+		case "-t", "-n", "-arch":
+			if len(args) < 2 {
+				fmt.Printf("Command line argument error: got flag %s but no argument\n", args[0])
+				return util.ExitUsage
+			}
+			var err error
+			switch args[0] {
+			case "-t":
+				threads = nil
+				for _, e1 := range strings.Split(args[1], ",") {
+					t, e := strconv.Atoi(e1)
+					if e != nil || t < 1 || t > 64 {
+						err = fmt.Errorf("expected threads in range [1, 64], got %s", e1)
+						break
+					}
+					threads = append(threads, t)
+				}
+			case "-n":
+				if runs, err = strconv.Atoi(args[1]); err == nil && runs < 1 {
+					err = fmt.Errorf("expected at least 1 run, got %d", runs)
+				}
+			default:
+				opt.TargetArch, err = nativeArch(args[1])
+			}
+			if err != nil {
+				fmt.Printf("Command line argument error: %s\n", err)
+				return util.ExitUsage
+			}
+			args = args[1:]
+		default:
+			fmt.Printf("Command line argument error: unexpected flag %s, %s\n", args[0], usage)
+			return util.ExitUsage
+		}
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		args = []string{"resources/vsl_typed"}
+	}
+
+	// Source directories are benchmarked by their VSL source files, in lexical order.
+	var paths []string
+	for _, e1 := range args {
+		if fi, err := os.Stat(e1); err != nil || !fi.IsDir() {
+			paths = append(paths, e1)
+			continue
+		}
+		p, _ := filepath.Glob(filepath.Join(e1, "*.vsl"))
+		paths = append(paths, p...)
+	}
+
+	res, err := vslc.Bench(context.Background(), paths, threads, runs, opt)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		var d vslc.Diagnostic
+		if errors.As(err, &d) {
+			return d.Code
+		}
+		return util.ExitIO
+	}
+	if csv {
+		err = vslc.WriteBenchCSV(os.Stdout, res)
+	} else {
+		err = vslc.WriteBenchJSON(os.Stdout, res)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		return util.ExitIO
+	}
+	return util.ExitOK
+}
+
+// explore prints the compiler explorer report of the vslc explore subcommand, an HTML page that shows the LIR and
+// assembler generated from each line of a VSL source file, for the target given by -arch. It returns the exit code.
+func explore(args []string) int {
+	opt := vslc.Options{Threads: 1, TargetArch: util.Aarch64}
+	usage := "usage: vslc explore [-ssa] [-arch <arch>] file.vsl"
+	for len(args) > 1 {
+		switch args[0] {
+		case "-ssa":
+			opt.SSA = true
+		case "-arch":
+			arch, err := nativeArch(args[1])
+			if err != nil {
+				fmt.Printf("Command line argument error: %s\n", err)
+				return util.ExitUsage
+			}
+			opt.TargetArch = arch
+			args = args[1:]
+		default:
+			fmt.Printf("Command line argument error: unexpected flag %s, %s\n", args[0], usage)
+			return util.ExitUsage
+		}
+		args = args[1:]
+	}
+	if len(args) != 1 || strings.HasPrefix(args[0], "-") {
+		fmt.Printf("Command line argument error: %s\n", usage)
+		return util.ExitUsage
+	}
+	b, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return util.ExitIO
+	}
+	s, err := vslc.Explore(filepath.Base(args[0]), string(b), opt)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		var d vslc.Diagnostic
+		if errors.As(err, &d) {
+			return d.Code
+		}
+		return util.ExitFailure
+	}
+	fmt.Print(s)
+	return util.ExitOK
+}
+
+// repl runs the interactive REPL of the vslc repl subcommand, which reads function definitions, global variable
+// declarations and statements from r, and prints their output. Input that isn't complete is continued on the next
+// line. Ctrl-C interrupts the running statement. It returns the exit code when r ends or :quit is entered.
+func repl(r io.Reader) int {
+	s := vslc.Session{Opt: vslc.Options{Threads: 1}}
+	sc := bufio.NewScanner(r)
+	input := ""
+	for fmt.Print("> "); sc.Scan(); {
+		line := sc.Text()
+		switch strings.TrimSpace(line) {
+		case ":quit":
+			return util.ExitOK
+		case ":reset":
+			s.Reset()
+			input = ""
+			fmt.Print("> ")
+			continue
+		}
+		input += line + "\n"
+
+		// Interrupt the statement, rather than the REPL, on Ctrl-C.
+		ctx, cancel := context.WithCancel(context.Background())
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt)
+		go func() {
+			select {
+			case <-sig:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		out, err := s.Eval(ctx, input)
+		signal.Stop(sig)
+		interrupted := ctx.Err() != nil
+		cancel()
+
+		switch {
+		case errors.Is(err, vslc.ErrIncomplete):
+			fmt.Print("... ")
+			continue
+		case err != nil && interrupted:
+			fmt.Println("Error: interrupted")
+		case err != nil:
+			fmt.Printf("Error: %s\n", err)
+		}
+		fmt.Print(out)
+		input = ""
+		fmt.Print("> ")
+	}
+	fmt.Println()
+	if err := sc.Err(); err != nil {
+		fmt.Printf("Error: %s\n", err)
+		return util.ExitIO
+	}
+	return util.ExitOK
+}
+
+// serveLSP runs the language server of the vslc lsp subcommand on standard input and output. It returns the exit code
+// when the client exits.
+func serveLSP() int {
+	// Standard output is reserved for the protocol, which only the server writes to, hence errors are printed to
+	// standard error.
+	if err := lsp.NewServer(os.Stdin, os.Stdout).Serve(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		return util.ExitFailure
+	}
+	return util.ExitOK
+}
+
+// nativeArch returns the target architecture identifier of the backend named name, such as riscv64.
+func nativeArch(name string) (int, error) {
+	for _, e1 := range vslc.GoldenTargets {
+		if t, err := backend.Lookup(e1); err == nil && t.Name() == name {
+			return e1, nil
+		}
+	}
+	return util.UnknownArch, fmt.Errorf("unexpected architecture identifier: %s", name)
+}
+
 func main() {
+	// Run subcommands: golden file or differential tests, the difference between the LIR of two files, the REPL, the
+	// language server, the documentation generator, the compiler explorer or the stage benchmarks.
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "test":
+			os.Exit(test(os.Args[2:]))
+		case "diff-ir":
+			os.Exit(diffIR(os.Args[2:]))
+		case "repl":
+			os.Exit(repl(os.Stdin))
+		case "lsp":
+			os.Exit(serveLSP())
+		case "doc":
+			os.Exit(doc(os.Args[2:]))
+		case "explore":
+			os.Exit(explore(os.Args[2:]))
+		case "bench":
+			os.Exit(bench(os.Args[2:]))
+		}
+	}
+
 	// Parse command line arguments.
 	opt, err := util.ParseArgs()
+	if err == nil {
+		err = vslc.Validate(opt)
+	}
 	if err != nil {
 		fmt.Printf("Command line argument error: %s\n", err)
-		os.Exit(1)
-	}
-
-	// Initiate output writer.
-	if opt.LLVM && opt.TokenStream {
-		fmt.Println("Error: cannot run token stream and LLVM generation at the same time.")
-		os.Exit(1)
-	}
-	if !opt.LLVM {
-		// Writing LLVM generated object code in parallel is outside the scope of this project.
-		if len(opt.Out) > 0 {
-			// Attempt to open output file. Create new file if necessary.
-			if f, err := os.OpenFile(opt.Out, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644); err == nil {
-				defer func(f *os.File) {
-					err := f.Close()
-					if err != nil {
-						fmt.Println(err)
-					}
-				}(f)
-				util.ListenWrite(opt, f)
-			} else {
-				fmt.Println(err)
-				os.Exit(1)
-			}
-		} else {
-			// Write results to stdout.
-			util.ListenWrite(opt, nil)
+		os.Exit(util.ExitUsage)
+	}
+	opt.Log = util.NewLogger(os.Stderr, opt.Verbosity)
+	if opt.TimeReport {
+		opt.Timing = util.NewTiming()
+	}
+
+	// Cancel compilation on Ctrl-C, SIGTERM or timeout. A second Ctrl-C kills the compiler.
+	ctx, cancel := context.Background(), context.CancelFunc(nil)
+	if opt.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, opt.Timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-sig:
+			cancel()
+		case <-ctx.Done():
 		}
+		signal.Stop(sig)
+	}()
+	opt.Ctx = ctx
+
+	// Profile the compiler, if requested.
+	stop, err := util.StartProfiling(opt)
+	if err != nil {
+		fmt.Printf("Error: %s\n", err)
+		os.Exit(util.ExitIO)
 	}
 
-	ret := 0
-	if err := run(opt); err != nil {
+	ret, err := run(opt)
+	if err != nil {
+		var ie *util.InternalError
+		if errors.As(err, &ie) {
+			ret = util.ExitInternal
+		}
+		switch ctx.Err() {
+		case context.DeadlineExceeded:
+			ret, err = util.ExitFailure, fmt.Errorf("compilation timed out after %s", opt.Timeout)
+		case context.Canceled:
+			ret, err = util.ExitFailure, errors.New("compilation interrupted")
+		}
 		fmt.Printf("Error: %s\n", err)
-		ret = 1
+	}
+	cancel()
+	if err := stop(); err != nil {
+		fmt.Printf("Error: %s\n", err)
+		if ret == 0 {
+			ret = util.ExitIO
+		}
 	}
 
-	if !opt.LLVM {
-		util.Close()
+	// Print the time spent in each compiler stage, if requested.
+	if opt.TimeReport {
+		opt.Timing.TimeReport(os.Stderr)
 	}
 
-	// Wait for code generation to complete.
+	// Exit with the code of the stage that failed, or ExitIO if only the profiles couldn't be written.
 	os.Exit(ret)
 }
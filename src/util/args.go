@@ -1,28 +1,74 @@
 package util
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 )
 
 // ----------------------------
 // ----- Type definitions -----
 // ----------------------------
 
+// Artifact is a kind of output emitted by the compiler, and the path it's written to.
+type Artifact struct {
+	Kind string // Kind of output, such as EmitAsm.
+	Out  string // Path to output file. Empty for the default path of the artifact.
+}
+
 type Options struct {
-	Src          string // Path to source file.
-	Out          string // Path to output file.
-	Threads      int    // Thread count.
-	Verbose      bool   // Set true if compiler should log statistical data to stdout.
-	TokenStream  bool   // Set true if compiler should output token stream and exit.
-	LLVM         bool   // Set true if compiler should use the LLVM framework to issue optimisations and code generaton.
-	TargetArch   int    // Output target architecture.
-	TargetVendor int    // Output target vendor type. 0 = unknown.
-	TargetCPU    int    // Output target CPU. 0 = generic CPU.
-	TargetOS     int    // Output target operating system type.
+	Src          string          // Path to source file. The first source file if several are given, or Stdin.
+	Srcs         []string        // Paths to all source files, in the order given. Empty if source is read from stdin.
+	Out          string          // Path to output file.
+	OutDir       string          // Directory of output files named after the source file. Empty for the working directory.
+	Threads      int             // Thread count.
+	Verbosity    int             // Highest level of log messages written to stderr, such as LogInfo for -v.
+	DumpRegAlloc bool            // Set true if compiler should print register allocation statistics and interference graphs.
+	PIC          bool            // Set true if compiler should generate position-independent code.
+	OmitFP       bool            // Set true if compiler should omit the frame pointer of leaf functions.
+	OptSize      bool            // Set true if compiler should prefer smaller code over faster code.
+	OptLevel     int             // Optimisation level 0 to 3 of the LLVM pass pipeline and code generator.
+	SSP          bool            // Set true if compiler should check a stack-smashing protector canary before returning.
+	Trapv        bool            // Set true if integer addition, subtraction and multiplication abort on overflow.
+	IntWidth     int             // Bits of VSL integers given by -m32 or -m64. Zero for the default of the target.
+	Freestanding bool            // Set true if compiler should generate code that runs without the C runtime.
+	LinkerScript string          // Path to linker script written for freestanding output. Empty if none.
+	LLVM         bool            // Set true if compiler should use the LLVM framework to issue optimisations and code generaton.
+	SSA          bool            // Set true if compiler should promote local variables to virtual registers in SSA form.
+	Run          bool            // Set true if compiler should interpret the program instead of generating code.
+	Args         []string        // Args holds the program arguments passed to the interpreted program.
+	TargetArch   int             // Output target architecture.
+	TargetVendor int             // Output target vendor type. 0 = unknown.
+	CPU          string          // LLVM target CPU, such as cortex-a53. Empty for the generic CPU of the target architecture.
+	Features     string          // LLVM target features, such as +neon,-crypto. Empty for the features of opt.March on RISC-V.
+	ABI          string          // Target ABI, such as lp64d. Empty for the default ABI of the target architecture.
+	CodeModel    string          // LLVM code model: tiny, small, kernel, medium or large. Empty for the target's default.
+	TargetOS     int             // Output target operating system type.
+	Triple       string          // Target triple given by --target, such as riscv64-unknown-freebsd. Empty if not given.
+	March        string          // RISC-V ISA string, such as rv64gc. Empty for the default ISA of the target architecture.
+	Emit         []Artifact      // Artifacts to emit, in the order they were given. Empty for target assembler only.
+	Link         bool            // Set true if compiler should assemble and link the output into an executable.
+	Annotate     bool            // Set true if compiler should comment assembler with its source lines and LIR instructions.
+	Runtime      bool            // Set true if main should parse command line arguments using the VSL runtime library.
+	Compile      bool            // Set true if compiler should write a LIR object of each source file instead of a program.
+	CPUProfile   string          // Path to CPU profile written while compiling. Empty if not profiled.
+	MemProfile   string          // Path to heap profile written after compiling. Empty if not profiled.
+	Trace        string          // Path to execution trace written while compiling. Empty if not traced.
+	TimeReport   bool            // Set true if compiler should print the time spent in each compiler stage to stderr.
+	SyntaxOnly   bool            // Set true if compiler should only check the program's syntax and semantics.
+	NoPipeline   bool            // Set true if compiler should finish every stage for all functions before the next stage.
+	Timeout      time.Duration   // Time after which compilation is cancelled. Zero for no timeout.
+	Ctx          context.Context // Cancels the compiler stages, such as on Ctrl-C or timeout. Nil if never cancelled.
+	Dst          *Output         // Receives the output of the Writers returned by NewWriter. Nil for stdout.
+	Log          Logger          // Logs the progress of the compilation. The zero Logger logs nothing.
+	Timing       *Timing         // Accumulates the time spent in each compiler stage. Nil if the compilation isn't timed.
 }
 
 // ---------------------
@@ -30,7 +76,11 @@ type Options struct {
 // ---------------------
 
 const maxThreads = 64 // Maximum threads allowed executing in parallel.
-const appVersion = "vsl compiler 1.0"
+
+// envFlags names the environment variable holding white space separated default flags, which precede the command line
+// arguments, such that flags given on the command line take precedence.
+const envFlags = "VSLCFLAGS"
+const version = "1.0" // Compiler version.
 
 // Target machine architectures.
 const (
@@ -40,8 +90,42 @@ const (
 	Aarch64
 	Riscv64
 	Riscv32
+	Armv7
+	Wasm
+)
+
+// Kinds of output selected by --emit, in the order of the compiler stages that produce them.
+const (
+	EmitTokens = "tokens"  // EmitTokens selects the token stream of the source code.
+	EmitAST    = "ast"     // EmitAST selects the optimised syntax tree.
+	EmitLIR    = "lir"     // EmitLIR selects the LIR, before it's lowered for the target.
+	EmitLLVMIR = "llvm-ir" // EmitLLVMIR selects textual LLVM IR, generated from LIR unless the LLVM framework is used.
+	EmitLLVMBC = "llvm-bc" // EmitLLVMBC selects LLVM bitcode, which is only generated by the LLVM framework.
+	EmitAsm    = "asm"     // EmitAsm selects target assembler, which is the default.
+	EmitObj    = "obj"     // EmitObj selects an object file assembled by the target's C compiler driver.
 )
 
+// emitKinds holds the kinds of output in the order of the compiler stages that produce them, and their default file
+// extensions.
+var emitKinds = []struct {
+	kind string
+	ext  string
+}{
+	{EmitTokens, ".tokens"},
+	{EmitAST, ".ast"},
+	{EmitLIR, ".lir"},
+	{EmitLLVMIR, ".ll"},
+	{EmitLLVMBC, ".bc"},
+	{EmitAsm, ".s"},
+	{EmitObj, ".o"},
+}
+
+// ObjectExt is the file extension of LIR objects, which are written by -c and linked when given as source files.
+const ObjectExt = ".lo"
+
+// Stdin is the name of source code read from stdin, which names it in diagnostics. Its output files are named stdin.
+const Stdin = "<stdin>"
+
 // Target operating system.
 const (
 	UnknownOS = iota
@@ -61,30 +145,35 @@ const (
 	AMD
 )
 
-// Target CPU.
-const (
-	CPUGeneric = iota
-)
-
 // ---------------------
 // ----- functions -----
 // ---------------------
 
-// ParseArgs parses command line arguments.
+// ParseArgs parses command line arguments, preceded by the default flags of the VSLCFLAGS environment variable. The
+// artifacts selected on the command line by --emit or -ts replace those selected by VSLCFLAGS, rather than adding to
+// them.
 func ParseArgs() (Options, error) {
 	opt := Options{
 		TargetArch: Aarch64,
 	}
-	if len(os.Args) < 2 {
-		return opt, nil
-	}
 	args := os.Args[1:]
+	if info(args, os.Stdout) {
+		os.Exit(0)
+	}
+	if len(args) == 0 {
+		args = []string{"-"} // Read source code from stdin.
+	}
+	env := strings.Fields(os.Getenv(envFlags))
+	args = append(env, args...)
+	emitted := false // emitted is set once an artifact is selected on the command line.
+	emit := func(i1 int, a Artifact) error {
+		if i1 >= len(env) && !emitted {
+			opt.Emit, emitted = nil, true
+		}
+		return addArtifact(&opt, a)
+	}
 	for i1 := 0; i1 < len(args)-1; i1++ {
 		switch args[i1] {
-		case "-h", "--h", "-help", "--help":
-			// Help and usage.
-			printHelp()
-			os.Exit(0)
 		case "-ll":
 			// Used LLVM IR and LLVM code generator.
 			opt.LLVM = true
@@ -131,6 +220,10 @@ func ParseArgs() (Options, error) {
 				opt.TargetArch = X86_64
 			case "x86_32":
 				opt.TargetArch = X86_32
+			case "armv7", "arm":
+				opt.TargetArch = Armv7
+			case "wasm", "wasm32":
+				opt.TargetArch = Wasm
 			default:
 				return opt, fmt.Errorf("unexpected architecture identifier: %s", args[i1+1])
 			}
@@ -143,15 +236,8 @@ func ParseArgs() (Options, error) {
 			if strings.HasPrefix(args[i1+1], "-") {
 				return opt, fmt.Errorf("expected operating system identifier, got new flag %s", args[i1+1])
 			}
-			switch args[i1+1] {
-			case "linux":
-				opt.TargetOS = Linux
-			case "windows":
-				opt.TargetOS = Windows
-			case "mac":
-				opt.TargetOS = MAC
-			default:
-				return opt, fmt.Errorf("unexpected operating system identifier: %s", args[i1+1])
+			if err := parseOS(&opt, args[i1+1]); err != nil {
+				return opt, err
 			}
 			i1++
 		case "-vendor":
@@ -173,38 +259,609 @@ func ParseArgs() (Options, error) {
 				return opt, fmt.Errorf("unexpected vendor identifier: %s", args[i1+1])
 			}
 			i1++
+		case "-run", "--run":
+			// Interpret program.
+			opt.Run = true
+		case "-args":
+			// Program arguments for interpreted program. May start with '-' for negative numbers.
+			if i1+1 >= len(args)-1 {
+				return opt, fmt.Errorf("got flag %s but no argument", args[i1])
+			}
+			opt.Args = strings.Fields(args[i1+1])
+			i1++
+		case "-c":
+			// Write a LIR object of each source file.
+			opt.Compile = true
+		case "-link", "--link":
+			// Assemble and link an executable.
+			opt.Link = true
+		case "-vslrt", "--vslrt":
+			// Parse command line arguments using the VSL runtime library.
+			opt.Runtime = true
+		case "-ssa":
+			// Promote local variables to SSA virtual registers.
+			opt.SSA = true
+		case "-dump-regalloc", "--dump-regalloc":
+			// Print register allocation statistics and interference graphs.
+			opt.DumpRegAlloc = true
+		case "-fpic":
+			// Address global data through the global offset table.
+			opt.PIC = true
+		case "-fverbose-asm":
+			// Comment assembler with the source lines and LIR instructions it's generated from.
+			opt.Annotate = true
+		case "-fsyntax-only":
+			// Check syntax and semantics without generating output.
+			opt.SyntaxOnly = true
+		case "-ftime-report":
+			// Print the time spent in each compiler stage.
+			opt.TimeReport = true
+		case "-fno-pipeline":
+			// Don't stream functions through the compiler stages.
+			opt.NoPipeline = true
+		case "-fomit-frame-pointer":
+			// Don't save FP and LR in leaf functions.
+			opt.OmitFP = true
+		case "-Os":
+			// Prefer smaller code over faster code.
+			opt.OptSize = true
+		case "-O0", "-O1", "-O2", "-O3":
+			// LLVM optimisation level.
+			opt.OptLevel = int(args[i1][2] - '0')
+		case "-fstack-protector":
+			// Check a stack canary before returning from functions.
+			opt.SSP = true
+		case "-ftrapv":
+			// Abort on signed integer overflow.
+			opt.Trapv = true
+		case "-m32":
+			// 32-bit integers.
+			opt.IntWidth = 32
+		case "-m64":
+			// 64-bit integers.
+			opt.IntWidth = 64
+		case "-freestanding", "--freestanding":
+			// Start from _start and use system calls instead of the C library.
+			opt.Freestanding = true
 		case "-ts":
 			// Output token stream
-			opt.TokenStream = true
-		case "-v", "--v", "-version", "--version":
-			// Application version.
-			fmt.Println(appVersion)
-			os.Exit(0)
-		case "-vb":
-			// Verbose mode.
-			opt.Verbose = true
+			if err := emit(i1, Artifact{Kind: EmitTokens}); err != nil {
+				return opt, err
+			}
+		case "-v", "-vb":
+			// Log the progress of compiler stages.
+			if opt.Verbosity < LogInfo {
+				opt.Verbosity = LogInfo
+			}
+		case "-vv":
+			// Log intermediate representations too.
+			opt.Verbosity = LogDebug
 		default:
+			if id, ok := cutPrefix(args[i1], "--target-os=", "-target-os="); ok {
+				// Output operating system type.
+				if err := parseOS(&opt, id); err != nil {
+					return opt, err
+				}
+				break
+			}
+			if triple, ok := cutPrefix(args[i1], "--target=", "-target="); ok {
+				// Raw target triple.
+				if err := parseTriple(&opt, triple); err != nil {
+					return opt, err
+				}
+				break
+			}
+			if isa, ok := cutPrefix(args[i1], "-march=", "--march="); ok {
+				// RISC-V ISA string.
+				opt.March = strings.ToLower(isa)
+				break
+			}
+			if cpu, ok := cutPrefix(args[i1], "-mcpu=", "--mcpu="); ok {
+				// LLVM target CPU.
+				opt.CPU = cpu
+				break
+			}
+			if features, ok := cutPrefix(args[i1], "-mattr=", "--mattr="); ok {
+				// LLVM target features.
+				opt.Features = features
+				break
+			}
+			if abi, ok := cutPrefix(args[i1], "-mabi=", "--mabi="); ok {
+				// Target ABI.
+				opt.ABI = strings.ToLower(abi)
+				break
+			}
+			if model, ok := cutPrefix(args[i1], "-mcode-model=", "-mcmodel="); ok {
+				// LLVM code model. The RISC-V names of GCC are accepted too.
+				switch model = strings.ToLower(model); model {
+				case "tiny", "small", "kernel", "medium", "large":
+					opt.CodeModel = model
+				case "medlow":
+					opt.CodeModel = "small"
+				case "medany":
+					opt.CodeModel = "medium"
+				default:
+					return opt, fmt.Errorf("unexpected code model: %s", model)
+				}
+				break
+			}
+			if kinds, ok := cutPrefix(args[i1], "--emit=", "-emit="); ok {
+				// Comma separated kinds of output, with optional output paths.
+				for _, e1 := range strings.Split(kinds, ",") {
+					a := Artifact{Kind: e1}
+					if i2 := strings.IndexByte(e1, '='); i2 >= 0 {
+						a = Artifact{Kind: e1[:i2], Out: e1[i2+1:]}
+					}
+					if err := emit(i1, a); err != nil {
+						return opt, err
+					}
+				}
+				break
+			}
+			if dir, ok := cutPrefix(args[i1], "--out-dir=", "-out-dir="); ok {
+				// Directory of output files without paths.
+				opt.OutDir = dir
+				break
+			}
+			if path, ok := cutPrefix(args[i1], "--cpuprofile=", "-cpuprofile="); ok {
+				// CPU profile of the compiler.
+				opt.CPUProfile = path
+				break
+			}
+			if path, ok := cutPrefix(args[i1], "--memprofile=", "-memprofile="); ok {
+				// Heap profile of the compiler.
+				opt.MemProfile = path
+				break
+			}
+			if path, ok := cutPrefix(args[i1], "--trace=", "-trace="); ok {
+				// Execution trace of the compiler.
+				opt.Trace = path
+				break
+			}
+			if d, ok := cutPrefix(args[i1], "--timeout=", "-timeout="); ok {
+				// Time after which compilation is cancelled.
+				t, err := time.ParseDuration(d)
+				if err != nil || t <= 0 {
+					return opt, fmt.Errorf("expected positive duration, such as 10s, got: %s", d)
+				}
+				opt.Timeout = t
+				break
+			}
+			if path, ok := cutPrefix(args[i1], "--linker-script=", "-linker-script="); ok {
+				// Linker script for freestanding output.
+				opt.LinkerScript = path
+				break
+			}
+			if !strings.HasPrefix(args[i1], "-") {
+				// Source file compiled into the same program as the last argument.
+				opt.Srcs = append(opt.Srcs, args[i1])
+				break
+			}
 			return opt, fmt.Errorf("unexpected flag: %s", args[i1])
 		}
 	}
-	if len(args) > 0 {
-		opt.Src = args[len(args)-1]
+	// The source file -, like no source file, reads source code from stdin.
+	if len(args) > 0 && args[len(args)-1] != "-" {
+		opt.Srcs = append(opt.Srcs, args[len(args)-1])
+		opt.Src = opt.Srcs[0]
+	} else if len(opt.Srcs) == 0 {
+		opt.Src = Stdin
+	}
+
+	// Reject artifacts produced by compiler stages that don't run.
+	for _, e1 := range opt.Emit {
+		switch {
+		case opt.LLVM && e1.Kind == EmitLIR:
+			return opt, fmt.Errorf("can't emit %s when generating code using LLVM", e1.Kind)
+		case !opt.LLVM && e1.Kind == EmitLLVMBC:
+			return opt, fmt.Errorf("can't emit %s without generating code using LLVM", e1.Kind)
+		case opt.Run && stage(e1.Kind) > stage(EmitLIR):
+			return opt, fmt.Errorf("can't emit %s when interpreting the program", e1.Kind)
+		}
+	}
+	if opt.SyntaxOnly && (opt.LLVM || opt.Run || opt.Link || opt.Compile || len(opt.Emit) > 0) {
+		return opt, errors.New("-fsyntax-only doesn't generate output")
+	}
+	if opt.Link && (opt.LLVM || opt.Run) {
+		return opt, errors.New("linking requires assembler output")
+	}
+	if opt.Runtime && opt.LLVM {
+		return opt, errors.New("the VSL runtime library requires assembler output")
+	}
+
+	// LIR objects are generated and linked by the compiler's own code generator only.
+	if opt.Compile {
+		n := 0 // Number of LIR objects written.
+		for _, e1 := range opt.Srcs {
+			if !IsObject(e1) {
+				n++
+			}
+		}
+		switch {
+		case opt.LLVM || opt.Run || opt.Link || len(opt.Emit) > 0:
+			return opt, errors.New("-c writes LIR objects only")
+		case len(opt.Out) > 0 && n > 1:
+			return opt, errors.New("-o can't name the LIR objects of several source files")
+		}
+	} else if opt.Linking() {
+		if opt.LLVM {
+			return opt, errors.New("can't link LIR objects when generating code using LLVM")
+		}
+		for _, e1 := range opt.Emit {
+			if stage(e1.Kind) < stage(EmitLIR) {
+				return opt, fmt.Errorf("can't emit %s when linking LIR objects", e1.Kind)
+			}
+		}
 	}
 	return opt, nil
 }
 
-// printHelp prints a helpful usage message to stdout.
-func printHelp() {
-	w := tabwriter.NewWriter(os.Stdout, 6, 1, 1, 0, 0)
+// Artifacts returns the artifacts emitted by the compiler. Target assembler, or an object file if LLVM generates the
+// code, is emitted if no artifacts are given, unless the compiler links an executable.
+func (opt Options) Artifacts() []Artifact {
+	if len(opt.Emit) > 0 || opt.Link {
+		return opt.Emit
+	}
+	if opt.LLVM {
+		return []Artifact{{Kind: EmitObj}}
+	}
+	return []Artifact{{Kind: EmitAsm}}
+}
+
+// Artifact returns the artifact of the given kind, and true if it's emitted.
+func (opt Options) Artifact(kind string) (Artifact, bool) {
+	for _, e1 := range opt.Artifacts() {
+		if e1.Kind == kind {
+			return e1, true
+		}
+	}
+	return Artifact{}, false
+}
+
+// LastStage returns the kind of the artifact produced by the last compiler stage that must run to emit all artifacts
+// and link the executable, if any.
+func (opt Options) LastStage() string {
+	if opt.Link {
+		return EmitObj
+	}
+	last := 0
+	for _, e1 := range opt.Artifacts() {
+		if s := stage(e1.Kind); s > last {
+			last = s
+		}
+	}
+	return emitKinds[last].kind
+}
+
+// Output returns the path that artifact a is written to, or an empty string for stdout. An artifact without a path is
+// written to the output file, or stdout, if it's the only artifact and no output directory is given. Otherwise, it's
+// written to a file named after the output file, or after the source file in the output directory, with the
+// artifact's extension.
+func (opt Options) Output(a Artifact) string {
+	if len(a.Out) > 0 {
+		return a.Out
+	}
+	if len(opt.Artifacts()) == 1 && !opt.Link && (len(opt.Out) > 0 || a.Kind != EmitObj && len(opt.OutDir) == 0) {
+		return opt.Out
+	}
+	if len(opt.Out) > 0 {
+		return strings.TrimSuffix(opt.Out, filepath.Ext(opt.Out)) + emitKinds[stage(a.Kind)].ext
+	}
+	return filepath.Join(opt.OutDir, stem(opt.Src)+emitKinds[stage(a.Kind)].ext)
+}
+
+// Context returns the context that cancels the compiler stages of opt, which is never cancelled if opt.Ctx is nil.
+func (opt Options) Context() context.Context {
+	if opt.Ctx == nil {
+		return context.Background()
+	}
+	return opt.Ctx
+}
+
+// NewPool returns a Pool that runs the jobs of the compiler stage name on at most opt.Threads worker go routines,
+// which log to opt.Log and are timed by opt.Timing.
+func (opt Options) NewPool(name string) *Pool {
+	p := NewPool(name, opt.Threads)
+	p.log, p.timing = opt.Log, opt.Timing
+	return p
+}
+
+// NewPipeline returns a Pipeline that runs the jobs of stages, in order, on at most opt.Threads worker go routines per
+// stage, which log to opt.Log and are timed by opt.Timing.
+func (opt Options) NewPipeline(stages ...PipeStage) *Pipeline {
+	p := NewPipeline(opt.Threads, stages...)
+	p.log, p.timing = opt.Log, opt.Timing
+	return p
+}
+
+// NewWriter returns a Writer of the Output of opt, which writes to stdout if opt.Dst is nil.
+func (opt Options) NewWriter() Writer {
+	if opt.Dst == nil {
+		return stdout.NewWriter()
+	}
+	return opt.Dst.NewWriter()
+}
+
+// Linking returns true if any source file of opt is a LIR object, such that the program is linked from LIR objects.
+func (opt Options) Linking() bool {
+	for _, e1 := range opt.Srcs {
+		if IsObject(e1) {
+			return true
+		}
+	}
+	return false
+}
+
+// Object returns the path that the LIR object of the source file src is written to by -c. The object is written to
+// the output file if it's given, or to a file in the output directory named after the source file.
+func (opt Options) Object(src string) string {
+	if len(opt.Out) > 0 {
+		return opt.Out
+	}
+	return filepath.Join(opt.OutDir, stem(src)+ObjectExt)
+}
+
+// stem returns the base name of the source file src without its extension, which names its output files. It returns
+// stdin if the source code is read from stdin, and app if there's no source file.
+func stem(src string) string {
+	switch src {
+	case "":
+		return "app"
+	case Stdin:
+		return "stdin"
+	}
+	return strings.TrimSuffix(filepath.Base(src), filepath.Ext(src))
+}
+
+// IsObject returns true if the file at path is a LIR object, by its extension ObjectExt.
+func IsObject(path string) bool {
+	return filepath.Ext(path) == ObjectExt
+}
+
+// SoftFloat returns true if floats should be computed by calls to soft-float runtime routines and passed in integer
+// registers, because the RISC-V ISA opt.March lacks the D extension.
+func (opt Options) SoftFloat() bool {
+	if opt.TargetArch != Riscv32 && opt.TargetArch != Riscv64 || len(opt.March) == 0 {
+		return false
+	}
+	return !Extensions(opt.March)['d']
+}
+
+// TargetABI returns the target ABI opt.ABI, or the default ABI of the target architecture if it isn't set. RISC-V
+// targets default to the lp64 or ilp32 calling conventions, with floats in floating point registers if the ISA
+// opt.March has the D extension. Other targets have no default ABI name, and return an empty string.
+func (opt Options) TargetABI() string {
+	if len(opt.ABI) > 0 {
+		return opt.ABI
+	}
+	var abi string
+	switch opt.TargetArch {
+	case Riscv64:
+		abi = "lp64"
+	case Riscv32:
+		abi = "ilp32"
+	default:
+		return ""
+	}
+	if !opt.SoftFloat() {
+		abi += "d"
+	}
+	return abi
+}
+
+// IntBits returns the number of bits of VSL integers, which is opt.IntWidth if -m32 or -m64 is given. Integers default
+// to the word size of 32-bit target architectures, and to 64 bits otherwise.
+func (opt Options) IntBits() int {
+	if opt.IntWidth > 0 {
+		return opt.IntWidth
+	}
+	switch opt.TargetArch {
+	case X86_32, Riscv32, Armv7:
+		return 32
+	}
+	return 64
+}
+
+// Extensions returns the set of single-letter extensions of the RISC-V ISA string march, such as rv32imac or
+// rv64i2p1_m2p0_zicsr. The G extension is expanded to IMAFD. Multi-letter extensions, which start with z, s or x,
+// and version numbers are skipped.
+func Extensions(march string) map[rune]bool {
+	ext := make(map[rune]bool)
+	if len(march) < 4 {
+		return ext
+	}
+	for _, e1 := range strings.Split(march[4:], "_") {
+		if len(e1) == 0 || strings.ContainsAny(e1[:1], "zsx") {
+			continue
+		}
+		digit := false
+		for _, e2 := range e1 {
+			if e2 >= '0' && e2 <= '9' || (digit && e2 == 'p') {
+				digit = e2 != 'p'
+				continue
+			}
+			digit = false
+			if e2 == 'g' {
+				for _, e3 := range "imafd" {
+					ext[e3] = true
+				}
+			}
+			ext[e2] = true
+		}
+	}
+	return ext
+}
+
+// addArtifact adds artifact a to the artifacts emitted by opt. An error is returned if the kind of a is unknown or
+// already emitted.
+func addArtifact(opt *Options, a Artifact) error {
+	if stage(a.Kind) < 0 {
+		return fmt.Errorf("unexpected output kind: %s", a.Kind)
+	}
+	for _, e1 := range opt.Emit {
+		if e1.Kind == a.Kind {
+			return fmt.Errorf("output kind %s given more than once", a.Kind)
+		}
+	}
+	opt.Emit = append(opt.Emit, a)
+	return nil
+}
+
+// stage returns the position of the compiler stage that produces artifacts of the given kind, or -1 if kind is
+// unknown.
+func stage(kind string) int {
+	for i1, e1 := range emitKinds {
+		if e1.kind == kind {
+			return i1
+		}
+	}
+	return -1
+}
+
+// parseOS sets the target operating system of opt to the operating system identified by id.
+func parseOS(opt *Options, id string) error {
+	switch id {
+	case "linux":
+		opt.TargetOS = Linux
+	case "windows":
+		opt.TargetOS = Windows
+	case "mac", "darwin":
+		opt.TargetOS = MAC
+	default:
+		return fmt.Errorf("unexpected operating system identifier: %s", id)
+	}
+	return nil
+}
+
+// parseTriple sets the target triple of opt to triple, and the target architecture, vendor and operating system to
+// those named by its components. The architecture must be supported, while unknown vendors and operating systems, such
+// as freebsd or none, are passed on to the C compiler driver and LLVM as is.
+func parseTriple(opt *Options, triple string) error {
+	parts := strings.Split(strings.ToLower(triple), "-")
+	if len(parts) < 2 || len(parts[0]) == 0 {
+		return fmt.Errorf("expected target triple <arch>-<vendor>-<os>[-<env>], got: %s", triple)
+	}
+	switch arch := parts[0]; {
+	case arch == "aarch64" || arch == "arm64":
+		opt.TargetArch = Aarch64
+	case arch == "riscv64":
+		opt.TargetArch = Riscv64
+	case arch == "riscv32":
+		opt.TargetArch = Riscv32
+	case arch == "x86_64" || arch == "amd64":
+		opt.TargetArch = X86_64
+	case arch == "x86" || len(arch) == 4 && arch[0] == 'i' && strings.HasSuffix(arch, "86"):
+		opt.TargetArch = X86_32
+	case strings.HasPrefix(arch, "armv7") || arch == "arm":
+		opt.TargetArch = Armv7
+	case arch == "wasm32":
+		opt.TargetArch = Wasm
+	default:
+		return fmt.Errorf("unexpected architecture in target triple: %s", triple)
+	}
+
+	// The vendor is optional, such as in riscv64-linux-gnu.
+	opt.TargetVendor = UnknownVendor
+	opt.TargetOS = UnknownOS
+	for _, e1 := range parts[1:] {
+		switch {
+		case e1 == "pc":
+			opt.TargetVendor = PC
+		case e1 == "apple":
+			opt.TargetVendor = Apple
+		case e1 == "ibm":
+			opt.TargetVendor = IBM
+		case e1 == "linux":
+			opt.TargetOS = Linux
+		case e1 == "windows" || e1 == "win32" || strings.HasPrefix(e1, "mingw"):
+			opt.TargetOS = Windows
+		case strings.HasPrefix(e1, "darwin") || strings.HasPrefix(e1, "macos"):
+			opt.TargetOS = MAC
+		}
+	}
+	opt.Triple = triple
+	return nil
+}
+
+// cutPrefix returns s without the first of the prefixes that s starts with, and true. If s doesn't start with any of
+// the prefixes, s and false is returned.
+func cutPrefix(s string, prefixes ...string) (string, bool) {
+	for _, e1 := range prefixes {
+		if strings.HasPrefix(s, e1) {
+			return strings.TrimPrefix(s, e1), true
+		}
+	}
+	return s, false
+}
+
+// info prints the help message, or the compiler version and build metadata, to w if the command line arguments args
+// hold -h or -version, or one of their variants, and returns true if so. Every argument is checked, including the last
+// one, which is the source file otherwise, such that vslc --version works without a source file.
+func info(args []string, w io.Writer) bool {
+	for _, e1 := range args {
+		switch e1 {
+		case "-h", "--h", "-help", "--help":
+			// Help and usage.
+			printHelp(w)
+			return true
+		case "-version", "--version":
+			// Application version and build metadata.
+			_, _ = fmt.Fprint(w, Build().String())
+			return true
+		}
+	}
+	return false
+}
+
+// printHelp prints a helpful usage message to out.
+func printHelp(out io.Writer) {
+	w := tabwriter.NewWriter(out, 6, 1, 1, 0, 0)
 	_, _ = fmt.Fprintln(w, "-h, -help\tPrints this help message and exits the application.")
 	_, _ = fmt.Fprintln(w, "--h, --help")
+	_, _ = fmt.Fprintln(w, "-c\tWrite a LIR object of each source file to <file>.lo, or -o, instead of a program. Source files ending in .lo are linked into the program.")
+	_, _ = fmt.Fprintln(w, "-args\tWhite space separated program arguments passed to the program when using -run.")
+	_, _ = fmt.Fprintln(w, "--emit=<kind>[=<path>],...\tComma separated kinds of output: 'tokens', 'ast', 'lir', 'llvm-ir', 'llvm-bc', 'asm' or 'obj'. Defaults to 'asm', or 'obj' with -ll. LLVM IR is generated without the LLVM framework unless -ll is given, which is required by 'llvm-bc'. With -ll, 'asm' is generated by the LLVM code generator.")
+	_, _ = fmt.Fprintln(w, "-dump-regalloc\tPrint register allocation statistics and interference graphs in dot format to stdout.")
+	_, _ = fmt.Fprintln(w, "--dump-regalloc")
+	_, _ = fmt.Fprintln(w, "-freestanding\tRISC-V only: enter at _start and print and exit by ecall system calls instead of the C library.")
+	_, _ = fmt.Fprintln(w, "--freestanding")
+	_, _ = fmt.Fprintln(w, "-fomit-frame-pointer\tDon't save FP and LR in leaf functions without local variables and spills.")
+	_, _ = fmt.Fprintln(w, "-fverbose-asm\tComment assembler with the source lines and LIR instructions it's generated from.")
+	_, _ = fmt.Fprintln(w, "-fsyntax-only\tCheck the syntax and semantics of the program, such as types, without generating output.")
+	_, _ = fmt.Fprintln(w, "-ftime-report\tPrint the time spent in each compiler stage, and by the worker go routines of parallel stages, to stderr.")
+	_, _ = fmt.Fprintln(w, "-fno-pipeline\tFinish every compiler stage for all functions before the next stage starts, instead of streaming the functions through the stages.")
+	_, _ = fmt.Fprintln(w, "-fstack-protector\tStore a canary in stack frames and call __stack_chk_fail if it's overwritten.")
+	_, _ = fmt.Fprintln(w, "-ftrapv\tAbort with an error message if integer addition, subtraction, negation or multiplication overflows.")
+	_, _ = fmt.Fprintln(w, "-m32, -m64\tWidth of VSL integers in bits. Integer literals must fit. Defaults to 32 on Armv7 and Riscv32, and to 64 otherwise.")
+	_, _ = fmt.Fprintln(w, "-fpic\tGenerate position-independent code, addressing global data through the global offset table. Selects the PIC relocation model of LLVM.")
+	_, _ = fmt.Fprintln(w, "--linker-script=<path>\tWrite a linker script for freestanding output, which loads it at 0x80000000.")
+	_, _ = fmt.Fprintln(w, "-march=<isa>\tRISC-V ISA string, such as 'rv64gc'. Must include M. Floats are soft-float without D. C enables compressed instructions.")
+	_, _ = fmt.Fprintln(w, "-mcpu=<cpu>\tLLVM target CPU, such as 'cortex-a53'. Defaults to the generic CPU of the target architecture.")
+	_, _ = fmt.Fprintln(w, "-mattr=<features>\tComma separated LLVM target features, such as '+neon'. Defaults to the extensions of -march on RISC-V.")
+	_, _ = fmt.Fprintln(w, "-mabi=<abi>\tTarget ABI of LLVM output, such as 'lp64d'. Defaults to lp64d or ilp32d on RISC-V, or lp64 or ilp32 without D.")
+	_, _ = fmt.Fprintln(w, "-mcode-model=<model>\tCode model of LLVM output: 'tiny', 'small', 'kernel', 'medium' or 'large'. RISC-V accepts 'medlow' and 'medany'.")
+	_, _ = fmt.Fprintln(w, "-mcmodel=<model>")
+	_, _ = fmt.Fprintln(w, "-link, --link\tAssemble and link an executable using the C compiler driver of the target, or CC if set.")
 	_, _ = fmt.Fprintln(w, "-ll\tUse LLVM to optimise and generate output code.")
-	_, _ = fmt.Fprintln(w, "-o\tPath and name of the output file.")
+	_, _ = fmt.Fprintln(w, "-O0, -O1, -O2, -O3\tOptimisation level of the LLVM pass pipeline and code generator, used with -ll. Defaults to -O0.")
+	_, _ = fmt.Fprintln(w, "-Os\tPrefer smaller code over faster code, such as loading large constants from memory.")
+	_, _ = fmt.Fprintln(w, "-o\tPath and name of the output file. Defaults to 'app.out' when linking. Names the files of multiple --emit artifacts without paths.")
+	_, _ = fmt.Fprintln(w, "--out-dir=<dir>\tDirectory of output files without paths, named after the source file, such as <dir>/prog.s. Created if it doesn't exist.")
+	_, _ = fmt.Fprintln(w, "--cpuprofile=<file>\tWrite a pprof CPU profile of the compiler to file.")
+	_, _ = fmt.Fprintln(w, "--memprofile=<file>\tWrite a pprof heap profile of the compiler to file after compiling.")
+	_, _ = fmt.Fprintln(w, "--trace=<file>\tWrite an execution trace of the compiler's go routines to file, viewed with 'go tool trace'.")
+	_, _ = fmt.Fprintln(w, "-os\tOutput operating system. Can be either 'linux', 'windows' or 'darwin'. Darwin emits Apple assembler syntax.")
+	_, _ = fmt.Fprintln(w, "--target-os=<os>")
+	_, _ = fmt.Fprintln(w, "--target=<triple>\tTarget triple, such as 'riscv64-unknown-freebsd'. Sets the architecture and operating system, and is passed as is to LLVM and the C compiler driver.")
+	_, _ = fmt.Fprintln(w, "--timeout=<duration>\tCancel compilation after the given duration, such as '10s' or '1m30s'.")
 	_, _ = fmt.Fprintf(w, "-t\tNumber of threads to run in parallel. Must be in range [1, %d].\n", maxThreads)
-	_, _ = fmt.Fprintln(w, "-target\tOutput architecture type. Can be either 'Aarch64', 'Riscv32' or 'Riscv64'. Defaults to 'Aarch64'.")
-	_, _ = fmt.Fprintln(w, "-ts\tOutput the tokens of the source code and exit.")
-	_, _ = fmt.Fprintln(w, "-v, -version\tPrints application version and exits the application.")
-	_, _ = fmt.Fprintln(w, "--v, --version")
-	_, _ = fmt.Fprintln(w, "-vb\tVerbose mode: print compiler statistics to stdout.")
+	_, _ = fmt.Fprintln(w, "-target\tOutput architecture type. Can be either 'Aarch64', 'Armv7', 'Riscv32', 'Riscv64' or 'Wasm'. Defaults to 'Aarch64'. Wasm emits WebAssembly text.")
+	_, _ = fmt.Fprintln(w, "-run, --run\tInterpret the program and exit with its return value instead of generating code.")
+	_, _ = fmt.Fprintln(w, "-vslrt, --vslrt\tParse command line arguments using the VSL runtime library, which is compiled and linked by --link.")
+	_, _ = fmt.Fprintln(w, "-ssa\tPromote local variables to virtual registers in SSA form before code generation.")
+	_, _ = fmt.Fprintln(w, "-ts\tOutput the tokens of the source code. Same as --emit=tokens.")
+	_, _ = fmt.Fprintln(w, "-version, --version\tPrints the compiler version and build metadata, such as the git commit and compiled in backends, and exits the application.")
+	_, _ = fmt.Fprintln(w, "-v, -vb\tVerbose mode: log the progress of compiler stages and their worker threads to stderr.")
+	_, _ = fmt.Fprintln(w, "-vv\tLog the syntax tree, LIR and LLVM IR to stderr too.")
 	_ = w.Flush()
 }
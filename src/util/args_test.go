@@ -0,0 +1,268 @@
+package util
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestOutput verifies the output paths of artifacts with and without explicit paths.
+func TestOutput(t *testing.T) {
+	tests := []struct {
+		opt Options
+		a   Artifact
+		exp string
+	}{
+		{Options{Src: "dir/prog.vsl"}, Artifact{Kind: EmitAsm}, ""},
+		{Options{Src: "dir/prog.vsl", Out: "a.s"}, Artifact{Kind: EmitAsm}, "a.s"},
+		{Options{Src: "dir/prog.vsl", Emit: []Artifact{{Kind: EmitObj}}}, Artifact{Kind: EmitObj}, "prog.o"},
+		{Options{Src: "dir/prog.vsl", Emit: []Artifact{{Kind: EmitLIR}, {Kind: EmitAsm}}}, Artifact{Kind: EmitLIR}, "prog.lir"},
+		{Options{Out: "out/app.s", Emit: []Artifact{{Kind: EmitLLVMIR}, {Kind: EmitAsm}}}, Artifact{Kind: EmitLLVMIR}, "out/app.ll"},
+		{Options{Src: "dir/prog.vsl", LLVM: true}, Artifact{Kind: EmitObj}, "prog.o"},
+		{Options{Src: "dir/prog.vsl", LLVM: true, Emit: []Artifact{{Kind: EmitLLVMBC}}}, Artifact{Kind: EmitLLVMBC}, ""},
+		{Options{Src: "dir/prog.vsl", LLVM: true, Emit: []Artifact{{Kind: EmitAsm}, {Kind: EmitObj}}}, Artifact{Kind: EmitAsm}, "prog.s"},
+		{Options{Out: "prog", Link: true, Emit: []Artifact{{Kind: EmitAsm}}}, Artifact{Kind: EmitAsm}, "prog.s"},
+		{Options{Emit: []Artifact{{Kind: EmitAST, Out: "tree.txt"}, {Kind: EmitAsm}}}, Artifact{Kind: EmitAST, Out: "tree.txt"}, "tree.txt"},
+		{Options{Src: "dir/prog.vsl", OutDir: "build"}, Artifact{Kind: EmitAsm}, "build/prog.s"},
+		{Options{Src: "dir/prog.vsl", OutDir: "build", Emit: []Artifact{{Kind: EmitAsm}, {Kind: EmitObj}}}, Artifact{Kind: EmitObj}, "build/prog.o"},
+		{Options{Src: "dir/prog.vsl", OutDir: "build", Out: "a.s"}, Artifact{Kind: EmitAsm}, "a.s"},
+		{Options{OutDir: "build", Link: true, Emit: []Artifact{{Kind: EmitAsm}}}, Artifact{Kind: EmitAsm}, "build/app.s"},
+		{Options{Src: Stdin, Emit: []Artifact{{Kind: EmitLIR}, {Kind: EmitAsm}}}, Artifact{Kind: EmitAsm}, "stdin.s"},
+	}
+	for _, e1 := range tests {
+		if res := e1.opt.Output(e1.a); res != e1.exp {
+			t.Errorf("%s: expected %q, got %q", e1.a.Kind, e1.exp, res)
+		}
+	}
+}
+
+// TestObject verifies the paths of the LIR objects written by -c.
+func TestObject(t *testing.T) {
+	tests := []struct {
+		opt Options
+		src string
+		exp string
+	}{
+		{Options{}, "dir/prog.vsl", "prog.lo"},
+		{Options{Out: "out/lib.lo"}, "dir/prog.vsl", "out/lib.lo"},
+		{Options{}, Stdin, "stdin.lo"},
+		{Options{OutDir: "build"}, "dir/prog.vsl", "build/prog.lo"},
+	}
+	for _, e1 := range tests {
+		if res := e1.opt.Object(e1.src); res != e1.exp {
+			t.Errorf("%s: expected %q, got %q", e1.src, e1.exp, res)
+		}
+	}
+}
+
+// TestLastStage verifies that compiler stages run until the last requested artifact is produced.
+func TestLastStage(t *testing.T) {
+	if res := (Options{}).LastStage(); res != EmitAsm {
+		t.Errorf("expected %s by default, got %s", EmitAsm, res)
+	}
+	if res := (Options{Emit: []Artifact{{Kind: EmitLIR}, {Kind: EmitTokens}}}).LastStage(); res != EmitLIR {
+		t.Errorf("expected %s, got %s", EmitLIR, res)
+	}
+	if res := (Options{LLVM: true}).LastStage(); res != EmitObj {
+		t.Errorf("expected %s by default using LLVM, got %s", EmitObj, res)
+	}
+	if res := (Options{Link: true, Emit: []Artifact{{Kind: EmitAST}}}).LastStage(); res != EmitObj {
+		t.Errorf("expected %s when linking, got %s", EmitObj, res)
+	}
+}
+
+// TestTargetABI verifies the default ABIs of the target architectures and ISAs.
+func TestTargetABI(t *testing.T) {
+	tests := []struct {
+		opt Options
+		exp string
+	}{
+		{Options{TargetArch: Aarch64}, ""},
+		{Options{TargetArch: Aarch64, ABI: "darwinpcs"}, "darwinpcs"},
+		{Options{TargetArch: Riscv64}, "lp64d"},
+		{Options{TargetArch: Riscv32}, "ilp32d"},
+		{Options{TargetArch: Riscv64, March: "rv64imac"}, "lp64"},
+		{Options{TargetArch: Riscv64, March: "rv64imafdc", ABI: "lp64f"}, "lp64f"},
+	}
+	for _, e1 := range tests {
+		if res := e1.opt.TargetABI(); res != e1.exp {
+			t.Errorf("%d %q: expected ABI %q, got %q", e1.opt.TargetArch, e1.opt.March, e1.exp, res)
+		}
+	}
+}
+
+// TestIntBits verifies the default integer width of the target architectures, and that -m32 and -m64 override it.
+func TestIntBits(t *testing.T) {
+	tests := []struct {
+		opt Options
+		exp int
+	}{
+		{Options{TargetArch: Aarch64}, 64},
+		{Options{TargetArch: Riscv32}, 32},
+		{Options{TargetArch: Armv7}, 32},
+		{Options{TargetArch: Wasm}, 64},
+		{Options{TargetArch: Aarch64, IntWidth: 32}, 32},
+		{Options{TargetArch: Riscv32, IntWidth: 64}, 64},
+	}
+	for _, e1 := range tests {
+		if res := e1.opt.IntBits(); res != e1.exp {
+			t.Errorf("%d -m%d: expected %d-bit integers, got %d", e1.opt.TargetArch, e1.opt.IntWidth, e1.exp, res)
+		}
+	}
+}
+
+// TestParseTriple verifies the target architecture and operating system taken from target triples.
+func TestParseTriple(t *testing.T) {
+	tests := []struct {
+		triple string
+		arch   int
+		os     int
+	}{
+		{"aarch64-unknown-linux-musl", Aarch64, Linux},
+		{"arm64-apple-darwin21.1.0", Aarch64, MAC},
+		{"riscv64-unknown-freebsd", Riscv64, UnknownOS},
+		{"riscv32-none-elf", Riscv32, UnknownOS},
+		{"armv7a-linux-gnueabihf", Armv7, Linux},
+		{"x86_64-w64-mingw32", X86_64, Windows},
+		{"i686-pc-linux-gnu", X86_32, Linux},
+	}
+	for _, e1 := range tests {
+		opt := Options{}
+		if err := parseTriple(&opt, e1.triple); err != nil {
+			t.Errorf("%s: %s", e1.triple, err)
+		} else if opt.TargetArch != e1.arch || opt.TargetOS != e1.os || opt.Triple != e1.triple {
+			t.Errorf("%s: expected arch %d and os %d, got %d and %d", e1.triple, e1.arch, e1.os, opt.TargetArch,
+				opt.TargetOS)
+		}
+	}
+	for _, e1 := range []string{"", "mips-linux-gnu", "aarch64"} {
+		if err := parseTriple(&Options{}, e1); err == nil {
+			t.Errorf("%q: expected error", e1)
+		}
+	}
+}
+
+// TestParseArgsEnv verifies that the default flags of VSLCFLAGS precede the command line arguments, such that the
+// command line takes precedence.
+func TestParseArgsEnv(t *testing.T) {
+	args, env := os.Args, os.Getenv(envFlags)
+	defer func() {
+		os.Args = args
+		_ = os.Setenv(envFlags, env)
+	}()
+
+	_ = os.Setenv(envFlags, " -t 2\t-arch riscv64 ")
+	os.Args = []string{"vslc", "-t", "4", "prog.vsl"}
+	opt, err := ParseArgs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opt.Threads != 4 || opt.TargetArch != Riscv64 || opt.Src != "prog.vsl" {
+		t.Errorf("expected 4 threads, riscv64 and prog.vsl, got %d threads, arch %d and %q", opt.Threads,
+			opt.TargetArch, opt.Src)
+	}
+
+	// Without arguments, source code is read from stdin.
+	os.Args = []string{"vslc"}
+	if opt, err = ParseArgs(); err != nil {
+		t.Fatal(err)
+	}
+	if opt.Threads != 2 || len(opt.Srcs) != 0 {
+		t.Errorf("expected 2 threads and stdin, got %d threads and %v", opt.Threads, opt.Srcs)
+	}
+
+	_ = os.Setenv(envFlags, "-t 99")
+	os.Args = []string{"vslc", "prog.vsl"}
+	if _, err := ParseArgs(); err == nil {
+		t.Errorf("expected invalid thread count of %s to be rejected", envFlags)
+	}
+}
+
+// TestParseArgsEnvEmit verifies that the artifacts selected on the command line replace those selected by VSLCFLAGS,
+// and that the artifacts of VSLCFLAGS are emitted if none are selected on the command line.
+func TestParseArgsEnvEmit(t *testing.T) {
+	args, env := os.Args, os.Getenv(envFlags)
+	defer func() {
+		os.Args = args
+		_ = os.Setenv(envFlags, env)
+	}()
+
+	tests := []struct {
+		env  string
+		args []string
+		exp  []Artifact
+	}{
+		{"--emit=asm", []string{"--emit=lir", "x.vsl"}, []Artifact{{Kind: EmitLIR}}},
+		{"--emit=asm,ast", []string{"--emit=lir", "-ts", "x.vsl"}, []Artifact{{Kind: EmitLIR}, {Kind: EmitTokens}}},
+		{"-ts", []string{"--emit=asm=out.s", "x.vsl"}, []Artifact{{Kind: EmitAsm, Out: "out.s"}}},
+		{"--emit=lir,asm", []string{"-t", "2", "x.vsl"}, []Artifact{{Kind: EmitLIR}, {Kind: EmitAsm}}},
+		{"", []string{"--emit=lir", "x.vsl"}, []Artifact{{Kind: EmitLIR}}},
+	}
+	for _, e1 := range tests {
+		_ = os.Setenv(envFlags, e1.env)
+		os.Args = append([]string{"vslc"}, e1.args...)
+		opt, err := ParseArgs()
+		if err != nil {
+			t.Fatalf("%s %v: %s", e1.env, e1.args, err)
+		}
+		if !reflect.DeepEqual(opt.Emit, e1.exp) {
+			t.Errorf("%s %v: expected artifacts %v, got %v", e1.env, e1.args, e1.exp, opt.Emit)
+		}
+	}
+
+	// Artifacts given twice on the command line are still rejected.
+	_ = os.Setenv(envFlags, "--emit=lir")
+	os.Args = []string{"vslc", "--emit=asm", "--emit=asm", "x.vsl"}
+	if _, err := ParseArgs(); err == nil {
+		t.Error("expected artifact given twice to be rejected")
+	}
+}
+
+// TestParseArgsSyntaxOnly verifies that -fsyntax-only is rejected with flags that generate output.
+func TestParseArgsSyntaxOnly(t *testing.T) {
+	args := os.Args
+	defer func() {
+		os.Args = args
+	}()
+
+	tests := []struct {
+		args []string
+		ok   bool
+	}{
+		{[]string{"-fsyntax-only", "prog.vsl"}, true},
+		{[]string{"-fsyntax-only", "prog.vsl", "lib.lo"}, true},
+		{[]string{"-fsyntax-only", "-run", "prog.vsl"}, false},
+		{[]string{"-fsyntax-only", "--emit=asm", "prog.vsl"}, false},
+		{[]string{"-fsyntax-only", "-c", "prog.vsl"}, false},
+	}
+	for _, e1 := range tests {
+		os.Args = append([]string{"vslc"}, e1.args...)
+		if _, err := ParseArgs(); (err == nil) != e1.ok {
+			t.Errorf("%v: expected ok %t, got %v", e1.args, e1.ok, err)
+		}
+	}
+}
+
+// TestInfo verifies that -version and -help are handled as the only argument, which is the source file otherwise, and
+// anywhere else on the command line.
+func TestInfo(t *testing.T) {
+	tests := []struct {
+		args []string
+		exp  string
+	}{
+		{[]string{"--version"}, "vsl compiler"},
+		{[]string{"-version"}, "vsl compiler"},
+		{[]string{"-t", "2", "--version", "prog.vsl"}, "vsl compiler"},
+		{[]string{"-h"}, "-help"},
+		{[]string{"prog.vsl", "--help"}, "-help"},
+		{[]string{"prog.vsl"}, ""},
+		{nil, ""},
+	}
+	for _, e1 := range tests {
+		sb := strings.Builder{}
+		if res := info(e1.args, &sb); res != (len(e1.exp) > 0) || !strings.Contains(sb.String(), e1.exp) {
+			t.Errorf("%v: expected output containing %q, got %t and %q", e1.args, e1.exp, res, sb.String())
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// errors.go defines the errors reported for the compiled program, which tell the stage that failed and the location of
+// the error in the source code, such that callers assert on the kind of an error rather than on its message.
+
+package util
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Position is the location of an error in the source code. The zero Position is unknown.
+type Position struct {
+	File string // File is the name of the source file, or empty if unknown or if only one file is compiled.
+	Line int    // Line is the source line, starting at 1, or 0 if unknown.
+	Pos  int    // Pos is the position on the line, starting at 1.
+}
+
+// SyntaxError is a lexical or syntax error in the source code, such as an unexpected token, or a function or global
+// variable declared in more than one source file.
+type SyntaxError struct {
+	Position
+	Err error // Err describes the error.
+}
+
+// TypeError is a semantic error in the source code, such as an undeclared variable, a type mismatch or a constant
+// division by zero.
+type TypeError struct {
+	Position
+	Err error // Err describes the error.
+}
+
+// CodegenError is an error reported by a backend when generating code for an instruction or function, such as an
+// unsupported instruction.
+type CodegenError struct {
+	Position
+	Err error // Err describes the error.
+}
+
+// positioner is implemented by the errors that hold a Position.
+type positioner interface {
+	position() *Position
+}
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// Sentinel errors matched by errors.Is for every SyntaxError, TypeError and CodegenError respectively.
+var (
+	ErrSyntax  = errors.New("syntax error")
+	ErrType    = errors.New("type error")
+	ErrCodegen = errors.New("code generation error")
+)
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// SyntaxErrorf returns a SyntaxError at line and pos of the source code, whose message is formatted like fmt.Errorf.
+func SyntaxErrorf(line, pos int, format string, args ...interface{}) error {
+	return &SyntaxError{Position: Position{Line: line, Pos: pos}, Err: fmt.Errorf(format, args...)}
+}
+
+// TypeErrorf returns a TypeError at line and pos of the source code, whose message is formatted like fmt.Errorf.
+func TypeErrorf(line, pos int, format string, args ...interface{}) error {
+	return &TypeError{Position: Position{Line: line, Pos: pos}, Err: fmt.Errorf(format, args...)}
+}
+
+// CodegenErrorf returns a CodegenError at line and pos of the source code, whose message is formatted like
+// fmt.Errorf.
+func CodegenErrorf(line, pos int, format string, args ...interface{}) error {
+	return &CodegenError{Position: Position{Line: line, Pos: pos}, Err: fmt.Errorf(format, args...)}
+}
+
+// PositionOf returns the Position of the first SyntaxError, TypeError or CodegenError in the chain of err. It returns
+// false if there's none.
+func PositionOf(err error) (Position, bool) {
+	var p positioner
+	if errors.As(err, &p) {
+		return *p.position(), true
+	}
+	return Position{}, false
+}
+
+// InFile sets the file of the Position of the first SyntaxError, TypeError or CodegenError in the chain of err to
+// file, if it's not set, and returns err. Other errors are prefixed by file.
+func InFile(err error, file string) error {
+	var p positioner
+	if errors.As(err, &p) {
+		if pos := p.position(); len(pos.File) == 0 {
+			pos.File = file
+		}
+		return err
+	}
+	return fmt.Errorf("%s: %w", file, err)
+}
+
+// position returns Position p, which is embedded in the errors that hold a Position.
+func (p *Position) position() *Position {
+	return p
+}
+
+// String returns the textual representation of Position p, as used by compiler error messages: file:line:pos if the
+// file is known, else line line:pos. Only the file is returned if the line is unknown.
+func (p Position) String() string {
+	switch {
+	case p.Line == 0:
+		return p.File
+	case len(p.File) == 0:
+		return fmt.Sprintf("line %d:%d", p.Line, p.Pos)
+	}
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Pos)
+}
+
+// locate prefixes the message of err with Position p, if known.
+func locate(p Position, err error) string {
+	if s := p.String(); len(s) > 0 {
+		return s + ": " + err.Error()
+	}
+	return err.Error()
+}
+
+// Error returns the message of SyntaxError e, prefixed by its Position.
+func (e *SyntaxError) Error() string {
+	return locate(e.Position, e.Err)
+}
+
+// Unwrap returns the error wrapped by SyntaxError e.
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if target is ErrSyntax.
+func (e *SyntaxError) Is(target error) bool {
+	return target == ErrSyntax
+}
+
+// Error returns the message of TypeError e, prefixed by its Position.
+func (e *TypeError) Error() string {
+	return locate(e.Position, e.Err)
+}
+
+// Unwrap returns the error wrapped by TypeError e.
+func (e *TypeError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if target is ErrType.
+func (e *TypeError) Is(target error) bool {
+	return target == ErrType
+}
+
+// Error returns the message of CodegenError e, prefixed by its Position.
+func (e *CodegenError) Error() string {
+	return locate(e.Position, e.Err)
+}
+
+// Unwrap returns the error wrapped by CodegenError e.
+func (e *CodegenError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if target is ErrCodegen.
+func (e *CodegenError) Is(target error) bool {
+	return target == ErrCodegen
+}
@@ -0,0 +1,55 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestErrors verifies the messages of the errors of the compiled program, and that they're matched by kind.
+func TestErrors(t *testing.T) {
+	exp := []struct {
+		err  error
+		kind error
+		msg  string
+	}{
+		{err: SyntaxErrorf(1, 8, "unexpected %s", "TYPE"), kind: ErrSyntax, msg: "line 1:8: unexpected TYPE"},
+		{err: TypeErrorf(0, 0, "undeclared variable %q", "x"), kind: ErrType, msg: "undeclared variable \"x\""},
+		{
+			err:  InFile(CodegenErrorf(3, 2, "unexpected instruction"), "a.vsl"),
+			kind: ErrCodegen,
+			msg:  "a.vsl:3:2: unexpected instruction",
+		},
+		{
+			err:  fmt.Errorf("syntax tree error: %w", InFile(TypeErrorf(0, 0, "undefined function"), "b.vsl")),
+			kind: ErrType,
+			msg:  "syntax tree error: b.vsl: undefined function",
+		},
+	}
+	kinds := []error{ErrSyntax, ErrType, ErrCodegen}
+	for _, e1 := range exp {
+		if e1.err.Error() != e1.msg {
+			t.Errorf("expected %q, got %q", e1.msg, e1.err.Error())
+		}
+		for _, e2 := range kinds {
+			if errors.Is(e1.err, e2) != (e2 == e1.kind) {
+				t.Errorf("%q: expected errors.Is(%v) to be %t", e1.msg, e2, e2 == e1.kind)
+			}
+		}
+	}
+
+	// The typed errors are found in the chain of wrapped errors.
+	var te *TypeError
+	if err := fmt.Errorf("wrapped: %w", TypeErrorf(4, 5, "type mismatch")); !errors.As(err, &te) || te.Line != 4 {
+		t.Errorf("expected TypeError at line 4, got %v", err)
+	}
+	if pos, ok := PositionOf(exp[2].err); !ok || pos != (Position{File: "a.vsl", Line: 3, Pos: 2}) {
+		t.Errorf("expected position a.vsl:3:2, got %v", pos)
+	}
+	if _, ok := PositionOf(errors.New("no position")); ok {
+		t.Error("expected no position of untyped error")
+	}
+	if err := InFile(errors.New("untyped"), "c.vsl"); err.Error() != "c.vsl: untyped" {
+		t.Errorf("expected %q, got %q", "c.vsl: untyped", err.Error())
+	}
+}
@@ -0,0 +1,62 @@
+// exit.go defines the exit codes of the compiler, which tell scripts whether compilation failed because of the
+// compiled program, the command line, the file system or a compiler bug.
+
+package util
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// InternalError is an internal compiler error, such as a recovered panic or an error reported by register allocation
+// or code generation of a valid program. It's a compiler bug rather than an error in the compiled program.
+type InternalError struct {
+	Err error // Err describes the error.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// Process exit codes of the compiler.
+const (
+	ExitOK       = 0  // ExitOK is returned if compilation succeeded.
+	ExitFailure  = 1  // ExitFailure is returned for failures of no other category, such as cancelled compilation.
+	ExitUsage    = 2  // ExitUsage is returned for invalid command line arguments.
+	ExitSyntax   = 3  // ExitSyntax is returned for lexical and syntax errors in the source code.
+	ExitType     = 4  // ExitType is returned for semantic errors, such as undeclared variables and type mismatches.
+	ExitIO       = 5  // ExitIO is returned if a file can't be read or written, or the assembler or linker failed.
+	ExitInternal = 70 // ExitInternal is returned for internal compiler errors, which are compiler bugs.
+)
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// Internal returns err as an InternalError, unless it's nil.
+func Internal(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &InternalError{Err: err}
+}
+
+// Recovered returns an InternalError for the value r of a recovered panic, which includes the stack trace of the
+// panicking go routine for bug reports. It must be called by the deferred function that recovered.
+func Recovered(r interface{}) error {
+	return &InternalError{Err: fmt.Errorf("%v\n%s", r, debug.Stack())}
+}
+
+// Error returns the message of InternalError e.
+func (e *InternalError) Error() string {
+	return "internal compiler error: " + e.Err.Error()
+}
+
+// Unwrap returns the error wrapped by InternalError e.
+func (e *InternalError) Unwrap() error {
+	return e.Err
+}
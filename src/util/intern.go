@@ -0,0 +1,51 @@
+// intern.go provides the string interner of the frontend, which keeps one copy of every identifier, such that the
+// syntax tree, the symbol tables and the names of LIR share the memory of equal identifiers.
+
+package util
+
+import "sync"
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Interner stores one copy of every distinct string it's given. Equal strings returned by Intern share their memory,
+// such that comparing them compares their pointers, and they don't keep alive the source code that they were sliced
+// from. An Interner is safe for concurrent use, such as by source files parsed in parallel. The zero value is an
+// empty Interner.
+type Interner struct {
+	m map[string]string // m maps strings to their interned copy.
+	sync.RWMutex
+}
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// Intern returns the interned copy of s, which is copied and stored if it's the first string equal to s.
+func (in *Interner) Intern(s string) string {
+	in.RLock()
+	res, ok := in.m[s]
+	in.RUnlock()
+	if ok {
+		return res
+	}
+	in.Lock()
+	defer in.Unlock()
+	if res, ok := in.m[s]; ok {
+		return res // Interned by another go routine since the lookup.
+	}
+	if in.m == nil {
+		in.m = make(map[string]string)
+	}
+	res = string(append([]byte(nil), s...))
+	in.m[res] = res
+	return res
+}
+
+// Len returns the number of distinct strings interned.
+func (in *Interner) Len() int {
+	in.RLock()
+	defer in.RUnlock()
+	return len(in.m)
+}
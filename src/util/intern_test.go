@@ -0,0 +1,47 @@
+package util
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"unsafe"
+)
+
+// data returns the pointer to the bytes of s.
+func data(s string) uintptr {
+	return (*reflect.StringHeader)(unsafe.Pointer(&s)).Data
+}
+
+// TestInterner verifies that equal strings share the copy interned first, also when interned concurrently, and that
+// the copy doesn't share the memory of the string it was sliced from.
+func TestInterner(t *testing.T) {
+	src := "abc abc def"
+	in := Interner{}
+	a, b := in.Intern(src[0:3]), in.Intern(src[4:7])
+	if a != "abc" || data(a) != data(b) {
+		t.Errorf("expected shared copy of %q, got %q at %x and %q at %x", "abc", a, data(a), b, data(b))
+	}
+	if data(a) == data(src) {
+		t.Errorf("expected copy of %q, got the source code", a)
+	}
+
+	wg := sync.WaitGroup{}
+	res := make([]string, 8)
+	for i1 := range res {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res[i] = in.Intern(strings.Repeat("d", 1) + "ef")
+		}(i1)
+	}
+	wg.Wait()
+	for _, e1 := range res {
+		if data(e1) != data(res[0]) {
+			t.Errorf("expected every go routine to get the same copy of %q", e1)
+		}
+	}
+	if in.Len() != 2 {
+		t.Errorf("expected 2 strings, got %d", in.Len())
+	}
+}
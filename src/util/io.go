@@ -2,42 +2,41 @@ package util
 
 import (
 	"bufio"
-	"errors"
+	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
-	"strings"
 	"sync"
-	"time"
 )
 
 // ----------------------------
 // ----- Type definitions -----
 // ----------------------------
 
-// Writer buffers output from threads in a strings.Buffer.
-// When the Flush or Close method is called the buffer is emptied and sent to
-// the assigned output writer through channel c.
+// Writer buffers the output of a compiler stage, which is written as a whole to the Output the Writer was created for
+// when the Writer is closed. The functions of a Module are generated to the Writers returned by Split, such that worker
+// threads write them concurrently without sharing a buffer, and they're written in order.
 type Writer struct {
-	sb strings.Builder
-	c  chan string
+	buf   *bytes.Buffer   // buf receives what's written to the Writer. Nil until written, or following Split.
+	parts []*bytes.Buffer // parts holds the buffers of the Writer and its split Writers, in order of output.
+	out   *Output
 }
 
-// syncer is a sync.Mutex synchronised structure that keeps track of two counters. One counter counts the number of
-// worker go routines that have registered a Writer. The other counter keeps track of the number of write operations.
-type syncer struct {
-	active  int // active keeps track of the number of active go worker threads.
-	writing int // writing keeps track of the number of write operations.
+// Output is the destination of the Writers of one compilation, such as an output file or stdout. The buffers of its
+// Writers are written as a whole, in the order they're closed.
+type Output struct {
+	w   *bufio.Writer
+	err error // err is the first error returned when writing to w.
 	sync.Mutex
 }
 
-// ---------------------
-// ----- Constants -----
-// ---------------------
+// -------------------
+// ----- globals -----
+// -------------------
 
-var wc chan string // wc is the writer channel used for receiving data from worker go routines.
-var cc chan error  // cc is the close channel used by main thread to signal to end write operations.
-var sc syncer
+// stdout is the Output of Writers created for Options without an Output.
+var stdout = NewOutput(os.Stdout)
 
 // ---------------------
 // ----- functions -----
@@ -45,213 +44,128 @@ var sc syncer
 
 // Write writes a format string to the Writer's buffer.
 func (w *Writer) Write(format string, args ...interface{}) {
-	w.sb.WriteString(fmt.Sprintf(format, args...))
+	_, _ = fmt.Fprintf(w.buffer(), format, args...)
 }
 
 // WriteString writes a plain string to the Writer's buffer.
 func (w *Writer) WriteString(s string) {
-	w.sb.WriteString(s)
+	w.buffer().WriteString(s)
 }
 
 // Label writes a one-line label with the given name.
 func (w *Writer) Label(name string) {
-	w.sb.WriteString(fmt.Sprintf("%s:\n", name))
+	b := w.buffer()
+	b.WriteString(name)
+	b.WriteString(":\n")
 }
 
-// Len returns the result of calling the Len function on the underlying strings.Builder.
+// Len returns the number of bytes written to the Writer, including its split Writers.
 func (w *Writer) Len() int {
-	return w.sb.Len()
-}
-
-// Flush empties the Writer's buffer and sends the buffer data to the
-// designated output writer over the Writer's channel.
-func (w *Writer) Flush() {
-	if w.sb.Len() < 1 {
+	n := 0
+	for _, e1 := range w.parts {
+		n += e1.Len()
 	}
-	sc.addWriteOperation()
-	w.c <- w.sb.String()
-	w.sb.Reset()
+	return n
 }
 
-// Close flushes the Writer's buffer and then closes the Writer's channel.
-func (w *Writer) Close() {
-	w.Flush()
-	w.c = nil
-	sc.subWriter()
+// Transform replaces the contents of the Writer's buffer with the result of calling f on them. Only what's written to
+// the Writer since it was last split is transformed.
+func (w *Writer) Transform(f func(string) string) {
+	b := w.buffer()
+	s := f(b.String())
+	b.Reset()
+	b.WriteString(s)
 }
 
-// NewWriter returns a new Writer to be used by worker threads to write strings concurrently to the output buffer.
-// Must not be called before main thread has called ListenWrite.
-func NewWriter() Writer {
-	sc.addWriter()
-	return Writer{
-		sb: strings.Builder{},
-		c:  wc,
+// Split returns n Writers, such as one for each function of a Module, whose output follows what's written to Writer w
+// so far, in order, and precedes what's written to w afterwards. Each of them may be written by a different go
+// routine, but only w is closed.
+func (w *Writer) Split(n int) []Writer {
+	ws := make([]Writer, n)
+	for i1 := range ws {
+		w.parts = append(w.parts, ws[i1].buffer())
 	}
+	w.buf = nil
+	return ws
 }
 
-// ReadSource reads source code from file or stdin.
-// If the Options structure holds a string for source the file will be opened and read.
-// Else the function waits for a short period for input on stdin. If no input on stdin is
-// provided the function returns an error.
-func ReadSource(opt Options) (string, error) {
-	if len(opt.Src) > 0 {
-		// Read from file.
-		b, err := ioutil.ReadFile(opt.Src)
-		return string(b), err
-	} else {
-		// Read stdin.
-		c := make(chan string)
-		cerr := make(chan error)
-
-		// Concurrently wait for input on stdin.
-		go func(c chan string, cerr chan error) {
-			defer close(c)
-			defer close(cerr)
-			reader := bufio.NewReader(os.Stdin)
-			text, err := reader.ReadString(0)
-			if err == nil {
-				c <- text
-			} else {
-				cerr <- err
-			}
-		}(c, cerr)
-
-		// Select between input from stdin or timer expiry.
-		select {
-		case <-time.After(500 * time.Millisecond):
-			return "", errors.New("expected input from stdin, got none")
-		case s := <-c:
-			return s, nil
-		}
-	}
+// Close writes the buffers of the Writer and its split Writers to the Writer's Output, in order, and then detaches the
+// Writer from its Output.
+func (w *Writer) Close() {
+	w.out.write(w.parts)
+	w.buf, w.parts, w.out = nil, nil, nil
 }
 
-// ListenWrite listens for worker thread outputs. The received data is written to either file
-// if File pointer f is not nil or stdout if File pointer f is nil. The function loops until
-// a termination signal is sent using the Close function.
-func ListenWrite(opt Options, f *os.File) {
-	if opt.Threads > 1 && !opt.LLVM && !opt.TokenStream {
-		// LLVM IR can't be output in parallel.
-		wc = make(chan string, opt.Threads+1)
-	} else {
-		wc = make(chan string, 1)
-	}
-	cc = make(chan error)
-	var w *bufio.Writer
-	if f != nil {
-		// Write output to file.
-		w = bufio.NewWriter(f)
-	} else {
-		// Write output to stdout.
-		w = bufio.NewWriter(os.Stdout)
+// buffer returns the buffer receiving what's written to Writer w, which is appended to its parts once written.
+func (w *Writer) buffer() *bytes.Buffer {
+	if w.buf == nil {
+		w.buf = &bytes.Buffer{}
+		w.parts = append(w.parts, w.buf)
 	}
-
-	// Listen for input and termination signal.
-	go func(wc chan string, cc chan error) {
-		defer close(wc)
-		defer close(cc)
-		stop := false
-		for {
-			if stop {
-				// Got stop signal. Check for pending jobs.
-				sc.Lock()
-				if sc.writing == 0 && sc.active == 0 {
-					// No more jobs, no active writers: close the listener and tell
-					// the main thread over the close channel.
-					sc.Unlock()
-					cc <- nil
-					return // Stop the listener writer go routine.
-				}
-				sc.Unlock()
-			}
-			select {
-			case s := <-wc:
-				if _, err := w.WriteString(s); err != nil {
-					fmt.Println(err)
-					os.Exit(1)
-				}
-				if err := w.Flush(); err != nil {
-					fmt.Println(err)
-					os.Exit(1)
-				}
-				sc.subWriteOperation()
-			case <-cc:
-				stop = true
-			}
-		}
-	}(wc, cc)
+	return w.buf
 }
 
-// ListenWriteBench is equal to ListenWrite, but it doesn't write the contents to the destination file.
-// This function is used for benchmarking, where writing multiple gigabytes to disk is undesirable.
-func ListenWriteBench(opt Options) {
-	if opt.Threads > 1 && !opt.LLVM && !opt.TokenStream {
-		// LLVM IR can't be output in parallel.
-		wc = make(chan string, opt.Threads+1)
-	} else {
-		wc = make(chan string, 1)
-	}
-	cc = make(chan error)
-
-	// Listen for input and termination signal.
-	go func(wc chan string, cc chan error) {
-		defer close(wc)
-		defer close(cc)
-		stop := false
-		for {
-			if stop {
-				// Got stop signal. Check for pending jobs.
-				sc.Lock()
-				if sc.writing == 0 && sc.active == 0 {
-					// No more jobs, no active writers: close the listener and tell
-					// the main thread over the close channel.
-					sc.Unlock()
-					cc <- nil
-					return // Stop the listener writer go routine.
-				}
-				sc.Unlock()
-			}
-			select {
-			case _ = <-wc:
-				sc.subWriteOperation()
-			case <-cc:
-				stop = true
-			}
-		}
-	}(wc, cc)
+// NewOutput returns an Output that writes the output of its Writers to w.
+func NewOutput(w io.Writer) *Output {
+	return &Output{w: bufio.NewWriter(w)}
 }
 
-// Close sends the termination signal to the writer listener.
-func Close() {
-	cc <- nil // Send close signal to writer listener.
-	<-cc      // Wait for clear signal from writer listener go routine.
+// NewWriter returns a new Writer to be used by worker threads to write strings concurrently to Output o.
+func (o *Output) NewWriter() Writer {
+	return Writer{out: o}
 }
 
-// addWriter increments the registered writers on the syncer.
-func (sc *syncer) addWriter() {
-	sc.Lock()
-	sc.active++
-	sc.Unlock()
+// Err returns the first error that occurred when writing to Output o, if any.
+func (o *Output) Err() error {
+	o.Lock()
+	defer o.Unlock()
+	return o.err
 }
 
-// subWriter decrements the registered writers on the syncer.
-func (sc *syncer) subWriter() {
-	sc.Lock()
-	sc.active--
-	sc.Unlock()
+// write writes the buffers parts to Output o, in order, and flushes it once. Nothing is written after the first error.
+func (o *Output) write(parts []*bytes.Buffer) {
+	o.Lock()
+	defer o.Unlock()
+	for _, e1 := range parts {
+		if o.err != nil {
+			return
+		}
+		_, o.err = e1.WriteTo(o.w)
+	}
+	if o.err == nil {
+		o.err = o.w.Flush()
+	}
 }
 
-// addWriteOperation increments the number of write operations on the syncer.
-func (sc *syncer) addWriteOperation() {
-	sc.Lock()
-	sc.writing++
-	sc.Unlock()
+// ReadSource reads source code from file or stdin.
+// If the Options structure holds a source file other than Stdin the file will be opened and read.
+// Else stdin is read until EOF, such that source code may be piped from slow producers.
+func ReadSource(opt Options) (string, error) {
+	var b []byte
+	var err error
+	if len(opt.Src) > 0 && opt.Src != Stdin {
+		// Read from file.
+		b, err = ioutil.ReadFile(opt.Src)
+	} else {
+		// Read stdin.
+		b, err = ioutil.ReadAll(os.Stdin)
+	}
+	return string(b), err
 }
 
-// subWriteOperation decrements the number of write operations on the syncer.
-func (sc *syncer) subWriteOperation() {
-	sc.Lock()
-	sc.writing--
-	sc.Unlock()
+// ReadSources reads the source code of every source file of opt, or stdin if no source file is given.
+func ReadSources(opt Options) ([]string, error) {
+	if len(opt.Srcs) < 2 {
+		src, err := ReadSource(opt)
+		return []string{src}, err
+	}
+	res := make([]string, len(opt.Srcs))
+	for i1, e1 := range opt.Srcs {
+		b, err := ioutil.ReadFile(e1)
+		if err != nil {
+			return nil, err
+		}
+		res[i1] = string(b)
+	}
+	return res, nil
 }
@@ -0,0 +1,70 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestReadSourceStdin verifies that source code is read from stdin until EOF, even if it's written slowly.
+func TestReadSourceStdin(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdin := os.Stdin
+	os.Stdin = r
+	defer func() {
+		os.Stdin = stdin
+		_ = r.Close()
+	}()
+
+	exp := "def f() int\nbegin\n\treturn 1\nend\n"
+	go func() {
+		_, _ = w.WriteString(exp[:12])
+		time.Sleep(600 * time.Millisecond)
+		_, _ = w.WriteString(exp[12:])
+		_ = w.Close()
+	}()
+	res, err := ReadSource(Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != exp {
+		t.Errorf("expected %q, got %q", exp, res)
+	}
+}
+
+// TestWriter verifies that the Writers of a split Writer are written in order, between what's written to the Writer
+// before and after it was split, also when they're written concurrently.
+func TestWriter(t *testing.T) {
+	buf := bytes.Buffer{}
+	o := NewOutput(&buf)
+	wr := o.NewWriter()
+	wr.WriteString("head\n")
+	ws := wr.Split(16)
+	err := NewPool("test", 4).Run(context.Background(), len(ws), func(w *Worker, i int) error {
+		ws[i].Write("f%d\n", i)
+		ws[i].Transform(strings.ToUpper)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wr.Label("main")
+	wr.Close()
+
+	exp := strings.Builder{}
+	exp.WriteString("head\n")
+	for i1 := range ws {
+		exp.WriteString(fmt.Sprintf("F%d\n", i1))
+	}
+	exp.WriteString("main:\n")
+	if o.Err() != nil || buf.String() != exp.String() {
+		t.Errorf("expected %q, got %q, error %v", exp.String(), buf.String(), o.Err())
+	}
+}
@@ -0,0 +1,108 @@
+// log.go provides leveled logging of compiler progress and intermediate representations. Log messages are written to
+// stderr, such that they never interleave with the generated output written to stdout.
+
+package util
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Logger writes log messages of the compiler stage or worker go routine named by its prefix. The Loggers of a
+// compilation share their output, such that messages of worker go routines don't interleave. The zero Logger logs
+// nothing.
+type Logger struct {
+	prefix string  // prefix names the compiler stage or worker go routine that logs, such as lir/2.
+	level  int     // level is the highest level of messages that are logged.
+	out    *logOut // out is the output shared by the Loggers of a compilation. Nil for the zero Logger.
+}
+
+// logOut is the writer that the log messages of a compilation are written to.
+type logOut struct {
+	w          io.Writer
+	sync.Mutex // Serialises log messages, such that messages of worker go routines don't interleave.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// Log levels, selected by the -v and -vv flags.
+const (
+	LogError = iota // LogError logs errors only, which is the default.
+	LogInfo         // LogInfo logs the progress of compiler stages, selected by -v.
+	LogDebug        // LogDebug logs the intermediate representations of the program too, selected by -vv.
+)
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// NewLogger returns the Logger of a compilation, which writes messages up to the given level, such as LogInfo, to w.
+func NewLogger(w io.Writer, level int) Logger {
+	return Logger{level: level, out: &logOut{w: w}}
+}
+
+// Logging returns true if messages of the given level are logged by Logger l, such that expensive dumps can be
+// skipped.
+func (l Logger) Logging(level int) bool {
+	return l.out != nil && level <= l.level
+}
+
+// Stage returns a Logger whose messages are prefixed by the compiler stage name, which writes to the output of l.
+func (l Logger) Stage(name string) Logger {
+	l.prefix = name
+	return l
+}
+
+// Worker returns a Logger for worker go routine i of the compiler stage of Logger l, whose messages are prefixed by
+// the stage and the worker, such as lir/2.
+func (l Logger) Worker(i int) Logger {
+	l.prefix = fmt.Sprintf("%s/%d", l.prefix, i)
+	return l
+}
+
+// Infof logs the formatted message at level LogInfo.
+func (l Logger) Infof(format string, args ...interface{}) {
+	l.log(LogInfo, "info", fmt.Sprintf(format, args...))
+}
+
+// Debugf logs the formatted message at level LogDebug.
+func (l Logger) Debugf(format string, args ...interface{}) {
+	l.log(LogDebug, "debug", fmt.Sprintf(format, args...))
+}
+
+// Dump logs the multi-line text s, such as a syntax tree or LIR, under the heading title at level LogDebug.
+func (l Logger) Dump(title, s string) {
+	l.log(LogDebug, "debug", title+":\n"+strings.TrimRight(s, "\n"))
+}
+
+// log writes the message msg with the name of its level to the output of l, if level is logged. Every line of the
+// message is written at once.
+func (l Logger) log(level int, name, msg string) {
+	if !l.Logging(level) {
+		return
+	}
+	sb := strings.Builder{}
+	sb.WriteString(name)
+	if len(l.prefix) > 0 {
+		sb.WriteRune(' ')
+		sb.WriteString(l.prefix)
+	}
+	sb.WriteString(": ")
+	sb.WriteString(msg)
+	sb.WriteRune('\n')
+	l.out.Lock()
+	defer l.out.Unlock()
+	_, _ = io.WriteString(l.out.w, sb.String())
+}
@@ -0,0 +1,30 @@
+package util
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestLogger verifies that messages are prefixed by their level and worker, and that messages above the log level are
+// dropped.
+func TestLogger(t *testing.T) {
+	buf := bytes.Buffer{}
+	log := NewLogger(&buf, LogInfo)
+	log.Infof("generated %d functions", 2)
+	log.Stage("lir").Worker(3).Infof("generating function %s", "f")
+	log.Dump("LIR", "function f\n")
+	if exp, res := "info: generated 2 functions\ninfo lir/3: generating function f\n", buf.String(); res != exp {
+		t.Errorf("expected %q, got %q", exp, res)
+	}
+
+	buf.Reset()
+	NewLogger(&buf, LogDebug).Dump("LIR", "function f\n")
+	if exp, res := "debug: LIR:\nfunction f\n", buf.String(); res != exp {
+		t.Errorf("expected %q, got %q", exp, res)
+	}
+
+	Logger{}.Infof("generated %d functions", 2)
+	if (Logger{}).Logging(LogError) {
+		t.Error("expected the zero Logger to log nothing")
+	}
+}
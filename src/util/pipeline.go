@@ -0,0 +1,159 @@
+// pipeline.go provides the pipeline that streams the jobs of consecutive compiler stages, such as the functions of a
+// module, through worker go routines connected by channels, such that later stages don't wait for earlier stages to
+// finish every job.
+
+package util
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Pipeline runs the jobs of consecutive compiler stages on worker go routines. The jobs are indices in range [0, n),
+// such as the indices of the functions of a module. Every stage has its own workers, which receive the jobs that
+// completed the previous stage by a channel, such that a job enters the next stage as soon as it completes a stage,
+// while the other jobs are still in the earlier stages.
+type Pipeline struct {
+	threads int         // threads is the maximum number of worker go routines of each stage.
+	stages  []PipeStage // stages are the compiler stages, in the order the jobs pass through them.
+	log     Logger      // log is the Logger of the compilation, which the Loggers of the workers write to.
+	timing  *Timing     // timing accumulates the time of the stages. Nil if the compilation isn't timed.
+}
+
+// PipeStage is a compiler stage of a Pipeline, which calls Job for every job that completed the previous stage.
+type PipeStage struct {
+	Name string                       // Name of the compiler stage, such as lir, used for logging and timing.
+	Job  func(w *Worker, i int) error // Job runs the stage for job i on Worker w.
+}
+
+// StageError is the error of the jobs that failed in a stage of a Pipeline.
+type StageError struct {
+	Stage string // Stage is the name of the compiler stage that failed.
+	Err   error  // Err is the error of the failed job, or a PoolError if more than one job failed.
+}
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// NewPipeline returns a Pipeline that runs the jobs of stages, in order, on at most threads worker go routines per
+// stage, which neither log nor are timed. The compiler creates its Pipeline by Options.NewPipeline.
+func NewPipeline(threads int, stages ...PipeStage) *Pipeline {
+	if threads < 1 {
+		threads = 1
+	}
+	return &Pipeline{threads: threads, stages: stages}
+}
+
+// Run passes every job i in range [0, n) through the stages of the Pipeline, and waits for the jobs to complete. A job
+// that fails in a stage isn't passed to the later stages, and cancels the jobs that haven't started that stage or any
+// later stage yet, while the earlier stages complete their jobs. Hence, like for stages run one after another, Run
+// returns a StageError of the earliest stage that any job failed in, which holds the error of the failed job, or a
+// PoolError of the failed jobs of the stage in the order of the jobs. If no job failed, the error of ctx is returned
+// if it was cancelled.
+//
+// The wall time of a stage is timed from when its first job starts until its last job completes, such that the wall
+// times of stages that overlap add up to more than the elapsed time of the Pipeline.
+func (p *Pipeline) Run(ctx context.Context, n int) error {
+	jobs := make(chan int, n)
+	for i1 := 0; i1 < n; i1++ {
+		jobs <- i1
+	}
+	close(jobs)
+	var in <-chan int = jobs
+
+	// Every stage is cancelled by the failure of its own jobs, and of the jobs of the stages before it.
+	errs := make([][]error, len(p.stages))
+	run := ctx
+	for i1, e1 := range p.stages {
+		var cancel context.CancelFunc
+		run, cancel = context.WithCancel(run)
+		defer cancel()
+		errs[i1] = make([]error, n) // Every job writes its own error, such that no synchronisation is needed.
+		in = p.stage(run, cancel, e1, in, errs[i1])
+	}
+	for range in {
+		// Wait for the last stage to complete.
+	}
+
+	for i1, e1 := range errs {
+		var res PoolError
+		for _, e2 := range e1 {
+			if e2 != nil {
+				res = append(res, e2)
+			}
+		}
+		switch len(res) {
+		case 0:
+			continue
+		case 1:
+			return &StageError{Stage: p.stages[i1].Name, Err: res[0]}
+		}
+		return &StageError{Stage: p.stages[i1].Name, Err: res}
+	}
+	return ctx.Err()
+}
+
+// stage starts the worker go routines of PipeStage s, which run the jobs received from in until in is closed, and
+// returns the channel of the jobs that completed s, which is closed once the workers are done. The error of every job
+// is stored in errs, and a job that fails cancels run by cancel. The jobs received once run is cancelled are dropped.
+func (p *Pipeline) stage(run context.Context, cancel context.CancelFunc, s PipeStage, in <-chan int,
+	errs []error) <-chan int {
+	out := make(chan int, cap(in)) // Buffered for every job, such that no stage waits for the next.
+	t := p.threads
+	if t > cap(in) {
+		t = cap(in)
+	}
+
+	var st Stage
+	once := sync.Once{}
+	wg := sync.WaitGroup{}
+	wg.Add(t)
+	for i1 := 0; i1 < t; i1++ {
+		go func(w *Worker) {
+			defer wg.Done()
+			work := time.Duration(0) // work is the time spent running jobs, excluding waiting for them.
+			defer func() {
+				if work > 0 {
+					p.timing.addWork(s.Name, work)
+				}
+			}()
+			for i := range in {
+				if run.Err() != nil {
+					continue // Drain the jobs of the previous stage.
+				}
+				once.Do(func() { st = p.timing.StartStage(s.Name) })
+				start := time.Now()
+				if errs[i] = w.run(s.Job, i); errs[i] != nil {
+					cancel() // Cancel the jobs of this stage and of the later stages.
+				} else {
+					out <- i
+				}
+				work += time.Since(start)
+			}
+		}(&Worker{ID: i1, Log: p.log.Stage(s.Name).Worker(i1)})
+	}
+	go func() {
+		wg.Wait()
+		if !st.start.IsZero() {
+			st.Stop()
+		}
+		close(out)
+	}()
+	return out
+}
+
+// Error returns the message of the error of StageError e.
+func (e *StageError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error wrapped by StageError e.
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
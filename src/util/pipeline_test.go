@@ -0,0 +1,135 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// TestPipeline verifies that every job passes every stage exactly once, in the order of the stages, and that a job
+// enters the next stage before the earlier stages have completed every job.
+func TestPipeline(t *testing.T) {
+	tests := []struct {
+		threads int
+		n       int
+	}{
+		{4, 0},
+		{4, 1},
+		{3, 10},
+		{8, 64},
+		{1, 5},
+	}
+	for _, e1 := range tests {
+		runs := make([][3]int32, e1.n)
+		stages := make([]PipeStage, 3)
+		for i2 := range stages {
+			s := i2
+			stages[i2] = PipeStage{Name: "test", Job: func(w *Worker, i int) error {
+				for i3 := 0; i3 < s; i3++ {
+					if atomic.LoadInt32(&runs[i][i3]) != 1 {
+						t.Errorf("threads %d, jobs %d: job %d entered stage %d before stage %d", e1.threads, e1.n, i, s,
+							i3)
+					}
+				}
+				atomic.AddInt32(&runs[i][s], 1)
+				return nil
+			}}
+		}
+		if err := NewPipeline(e1.threads, stages...).Run(context.Background(), e1.n); err != nil {
+			t.Errorf("threads %d, jobs %d: unexpected error %s", e1.threads, e1.n, err)
+		}
+		for i2, e2 := range runs {
+			if e2 != [3]int32{1, 1, 1} {
+				t.Errorf("threads %d, jobs %d: expected job %d to run once per stage, ran %v times", e1.threads, e1.n,
+					i2, e2)
+			}
+		}
+	}
+
+	// The last job of the first stage waits for the first job to complete the second stage.
+	done := make(chan struct{})
+	err := NewPipeline(2, PipeStage{Name: "first", Job: func(w *Worker, i int) error {
+		if i == 3 {
+			<-done
+		}
+		return nil
+	}}, PipeStage{Name: "second", Job: func(w *Worker, i int) error {
+		if i == 0 {
+			close(done)
+		}
+		return nil
+	}}).Run(context.Background(), 4)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+}
+
+// TestPipelineErrors verifies that a failed job isn't passed to the later stages, and that the errors of the earliest
+// stage that failed are returned, also when a later stage failed first.
+func TestPipelineErrors(t *testing.T) {
+	failed := make(chan struct{})
+	seen := int32(0) // seen is set if the second stage received the failed job.
+	err := NewPipeline(2, PipeStage{Name: "first", Job: func(w *Worker, i int) error {
+		if i == 3 {
+			<-failed // Fail after the second stage has failed.
+			return errors.New("d")
+		}
+		return nil
+	}}, PipeStage{Name: "second", Job: func(w *Worker, i int) error {
+		switch i {
+		case 0:
+			defer close(failed)
+			return errors.New("a")
+		case 3:
+			atomic.StoreInt32(&seen, 1)
+		}
+		return nil
+	}}).Run(context.Background(), 4)
+	var se *StageError
+	if !errors.As(err, &se) || se.Stage != "first" || err.Error() != "d" {
+		t.Errorf("expected StageError of stage first %q, got %v", "d", err)
+	}
+	if seen != 0 {
+		t.Error("expected the failed job to skip the second stage")
+	}
+
+	err = NewPipeline(1, PipeStage{Name: "first", Job: func(w *Worker, i int) error {
+		return nil
+	}}, PipeStage{Name: "second", Job: func(w *Worker, i int) error {
+		panic("c")
+	}}).Run(context.Background(), 1)
+	var ie *InternalError
+	if !errors.As(err, &se) || se.Stage != "second" || !errors.As(err, &ie) {
+		t.Errorf("expected StageError of stage second holding an InternalError, got %v", err)
+	}
+}
+
+// TestPipelineCancel verifies that no jobs are run once the context is cancelled.
+func TestPipelineCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	runs := int32(0)
+	job := func(w *Worker, i int) error {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	}
+	err := NewPipeline(4, PipeStage{Name: "first", Job: job}, PipeStage{Name: "second", Job: job}).Run(ctx, 16)
+	if err != context.Canceled || runs != 0 {
+		t.Errorf("expected %v without jobs run, got %v after %d jobs", context.Canceled, err, runs)
+	}
+
+	// Cancelling while the first stage runs drops the jobs that haven't started.
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	runs = 0
+	once := sync.Once{}
+	err = NewPipeline(1, PipeStage{Name: "first", Job: func(w *Worker, i int) error {
+		once.Do(cancel)
+		return job(w, i)
+	}}).Run(ctx, 16)
+	if err != context.Canceled || runs != 1 {
+		t.Errorf("expected %v after 1 job, got %v after %d jobs", context.Canceled, err, runs)
+	}
+}
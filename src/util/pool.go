@@ -0,0 +1,187 @@
+// pool.go provides the worker pool that runs the jobs of the parallel compiler stages, such as the functions of a
+// module, on worker go routines.
+
+package util
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Pool runs the jobs of a compiler stage on at most a fixed number of worker go routines. The jobs are indices in
+// range [0, n), such as the indices of the functions of a module. Every worker is given a contiguous range of jobs
+// up front. A worker that has finished its range steals half the remaining range of another worker, such that
+// workers that were given cheap jobs help the workers that were given expensive jobs.
+type Pool struct {
+	name    string  // name of the compiler stage, such as lir, used for logging and timing.
+	threads int     // threads is the maximum number of worker go routines.
+	log     Logger  // log is the Logger of the compilation, which the Loggers of the workers write to.
+	timing  *Timing // timing accumulates the work time of the workers. Nil if the compilation isn't timed.
+}
+
+// Worker is a worker go routine of a Pool.
+type Worker struct {
+	ID      int       // ID is the index of the worker in range [0, threads).
+	Log     Logger    // Log is the Logger of the worker, whose messages are prefixed by the stage and the ID.
+	lo, hi  int       // lo and hi delimit the range [lo, hi) of jobs that are left to the worker.
+	workers []*Worker // workers holds every worker of the run, including this one, which is stolen from.
+	sync.Mutex
+}
+
+// PoolError holds the errors returned by more than one job of a Pool run, in the order of the jobs.
+type PoolError []error
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// NewPool returns a Pool that runs the jobs of the compiler stage name on at most threads worker go routines, which
+// neither log nor are timed. Compiler stages create their Pool by Options.NewPool.
+func NewPool(name string, threads int) *Pool {
+	if threads < 1 {
+		threads = 1
+	}
+	return &Pool{name: name, threads: threads}
+}
+
+// Workers returns the number of worker go routines that run n jobs, which is at most one per job.
+func (p *Pool) Workers(n int) int {
+	if n < p.threads {
+		return n
+	}
+	return p.threads
+}
+
+// Run calls job for every job i in range [0, n) on the worker go routines of the Pool, and waits for the jobs to
+// complete. A job that fails cancels the jobs that haven't started yet, as does cancelling ctx. Run returns the error
+// of the failed job if one job failed, or a PoolError holding the errors of the failed jobs if more than one failed
+// before the others were cancelled. If no job failed, the error of ctx is returned if it was cancelled.
+func (p *Pool) Run(ctx context.Context, n int, job func(w *Worker, i int) error) error {
+	t := p.Workers(n)
+	if t == 0 {
+		return ctx.Err()
+	}
+	run, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Give every worker a contiguous range of jobs. The first n % t workers are given one residual job each.
+	workers := make([]*Worker, t)
+	start := 0
+	for i1 := range workers {
+		end := start + n/t
+		if i1 < n%t {
+			end++
+		}
+		workers[i1] = &Worker{
+			ID:      i1,
+			Log:     p.log.Stage(p.name).Worker(i1),
+			lo:      start,
+			hi:      end,
+			workers: workers,
+		}
+		start = end
+	}
+
+	errs := make([]error, n) // Every job writes its own error, such that no synchronisation is needed.
+	wg := sync.WaitGroup{}
+	wg.Add(t)
+	for _, e1 := range workers {
+		go func(w *Worker) {
+			defer wg.Done()
+			defer p.timing.TimeWorker(p.name, time.Now())
+			for run.Err() == nil {
+				i, ok := w.next()
+				if !ok {
+					return
+				}
+				if errs[i] = w.run(job, i); errs[i] != nil {
+					cancel() // Cancel the jobs of the other workers.
+				}
+			}
+		}(e1)
+	}
+	wg.Wait()
+
+	var res PoolError
+	for _, e1 := range errs {
+		if e1 != nil {
+			res = append(res, e1)
+		}
+	}
+	switch len(res) {
+	case 0:
+		return ctx.Err()
+	case 1:
+		return res[0]
+	}
+	return res
+}
+
+// run calls job for job i on Worker w, and returns its error. A panicking job is returned as an InternalError.
+func (w *Worker) run(job func(w *Worker, i int) error, i int) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = Recovered(r)
+		}
+	}()
+	return job(w, i)
+}
+
+// next returns the next job of Worker w, which is stolen from another worker if w has no jobs left. It returns false
+// if no worker has jobs left.
+func (w *Worker) next() (int, bool) {
+	w.Lock()
+	if w.lo < w.hi {
+		w.lo++
+		w.Unlock()
+		return w.lo - 1, true
+	}
+	w.Unlock()
+
+	// Steal the upper half of the jobs left to the next worker that has any, starting with the worker after w.
+	for i1 := 1; i1 < len(w.workers); i1++ {
+		v := w.workers[(w.ID+i1)%len(w.workers)]
+		v.Lock()
+		if v.lo == v.hi {
+			v.Unlock()
+			continue
+		}
+		lo, hi := v.lo+(v.hi-v.lo)/2, v.hi
+		v.hi = lo
+		v.Unlock()
+
+		// Keep the first stolen job, and make the rest available to other thieves.
+		w.Lock()
+		w.lo, w.hi = lo+1, hi
+		w.Unlock()
+		return lo, true
+	}
+	return 0, false
+}
+
+// As finds the first error of PoolError e that matches target, as errors.As does, such that internal compiler errors
+// of any job are found.
+func (e PoolError) As(target interface{}) bool {
+	for _, e1 := range e {
+		if errors.As(e1, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Error returns the messages of the errors of PoolError e, one per line.
+func (e PoolError) Error() string {
+	msgs := make([]string, len(e))
+	for i1, e1 := range e {
+		msgs[i1] = e1.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
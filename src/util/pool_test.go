@@ -0,0 +1,115 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestPool verifies that every job is run exactly once, also when the number of jobs isn't a multiple of the number of
+// worker go routines, and when jobs are stolen from a slow worker.
+func TestPool(t *testing.T) {
+	tests := []struct {
+		threads int
+		n       int
+	}{
+		{4, 0},
+		{4, 1},
+		{4, 3},
+		{3, 10},
+		{8, 64},
+		{1, 5},
+	}
+	for _, e1 := range tests {
+		runs := make([]int32, e1.n)
+		p := NewPool("test", e1.threads)
+		if err := p.Run(context.Background(), e1.n, func(w *Worker, i int) error {
+			if w.ID >= p.Workers(e1.n) {
+				t.Errorf("threads %d, jobs %d: unexpected worker %d", e1.threads, e1.n, w.ID)
+			}
+			if w.ID == 0 {
+				time.Sleep(time.Millisecond) // Let the other workers steal from worker 0.
+			}
+			atomic.AddInt32(&runs[i], 1)
+			return nil
+		}); err != nil {
+			t.Errorf("threads %d, jobs %d: unexpected error %s", e1.threads, e1.n, err)
+		}
+		for i1, e2 := range runs {
+			if e2 != 1 {
+				t.Errorf("threads %d, jobs %d: expected job %d to run once, ran %d times", e1.threads, e1.n, i1, e2)
+			}
+		}
+	}
+}
+
+// TestPoolErrors verifies that the errors of the failed jobs are returned in the order of the jobs, and that a failed
+// job cancels the jobs that haven't started.
+func TestPoolErrors(t *testing.T) {
+	// The first job of every worker fails once every worker has started it.
+	wg := sync.WaitGroup{}
+	wg.Add(3)
+	err := NewPool("test", 3).Run(context.Background(), 9, func(w *Worker, i int) error {
+		if i%3 == 0 {
+			wg.Done()
+			wg.Wait()
+			return errors.New(string(rune('a' + i)))
+		}
+		return nil
+	})
+	if _, ok := err.(PoolError); !ok || err.Error() != "a\nd\ng" {
+		t.Errorf("expected PoolError %q, got %v", "a\nd\ng", err)
+	}
+
+	runs := 0
+	err = NewPool("test", 1).Run(context.Background(), 5, func(w *Worker, i int) error {
+		runs++
+		if i == 1 {
+			return errors.New("b")
+		}
+		return nil
+	})
+	if err == nil || err.Error() != "b" || runs != 2 {
+		t.Errorf("expected error %q after 2 jobs, got %v after %d jobs", "b", err, runs)
+	}
+}
+
+// TestPoolCancel verifies that no jobs are run once the context is cancelled.
+func TestPoolCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	runs := int32(0)
+	err := NewPool("test", 4).Run(ctx, 16, func(w *Worker, i int) error {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	})
+	if err != context.Canceled || runs != 0 {
+		t.Errorf("expected %v without jobs run, got %v after %d jobs", context.Canceled, err, runs)
+	}
+}
+
+// TestPoolPanic verifies that a panicking job is returned as an internal compiler error, which is found among the
+// errors of other jobs.
+func TestPoolPanic(t *testing.T) {
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	err := NewPool("test", 2).Run(context.Background(), 2, func(w *Worker, i int) error {
+		wg.Done()
+		wg.Wait()
+		if i == 0 {
+			return errors.New("a")
+		}
+		panic("b")
+	})
+	var ie *InternalError
+	if _, ok := err.(PoolError); !ok || !errors.As(err, &ie) {
+		t.Fatalf("expected PoolError holding an InternalError, got %v", err)
+	}
+	if !strings.HasPrefix(ie.Error(), "internal compiler error: b\n") {
+		t.Errorf("expected panic value and stack trace, got %q", ie.Error())
+	}
+}
@@ -0,0 +1,82 @@
+// profile.go provides profiling of the compiler itself, such that the scaling of the parallel compiler stages can be
+// investigated with go tool pprof and go tool trace.
+
+package util
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// StartProfiling starts the CPU profile and execution trace requested by opt. The returned function stops them, and
+// writes the heap profile if requested. It must be called before the compiler exits, even if compilation failed.
+func StartProfiling(opt Options) (func() error, error) {
+	var cpu, tr *os.File
+	stop := func() error {
+		var err error
+		if cpu != nil {
+			pprof.StopCPUProfile()
+			err = cpu.Close()
+		}
+		if tr != nil {
+			trace.Stop()
+			if err2 := tr.Close(); err == nil {
+				err = err2
+			}
+		}
+		if len(opt.MemProfile) > 0 {
+			if err2 := writeMemProfile(opt.MemProfile); err == nil {
+				err = err2
+			}
+		}
+		return err
+	}
+
+	if len(opt.CPUProfile) > 0 {
+		f, err := os.Create(opt.CPUProfile)
+		if err != nil {
+			return nil, fmt.Errorf("could not create CPU profile: %s", err)
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("could not start CPU profile: %s", err)
+		}
+		cpu = f
+	}
+	if len(opt.Trace) > 0 {
+		f, err := os.Create(opt.Trace)
+		if err == nil {
+			if err = trace.Start(f); err != nil {
+				_ = f.Close()
+			}
+		}
+		if err != nil {
+			opt.MemProfile = ""
+			_ = stop()
+			return nil, fmt.Errorf("could not start execution trace: %s", err)
+		}
+		tr = f
+	}
+	return stop, nil
+}
+
+// writeMemProfile writes a heap profile of the allocations made by the compiler to the file at path.
+func writeMemProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("could not create heap profile: %s", err)
+	}
+	runtime.GC() // Collect garbage, such that the profile shows live data.
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("could not write heap profile: %s", err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,39 @@
+package util
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestStartProfiling verifies that the requested profiles and trace are written when profiling stops.
+func TestStartProfiling(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vslc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	opt := Options{
+		CPUProfile: filepath.Join(dir, "cpu.prof"),
+		MemProfile: filepath.Join(dir, "mem.prof"),
+		Trace:      filepath.Join(dir, "trace.out"),
+	}
+	stop, err := StartProfiling(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stop(); err != nil {
+		t.Fatal(err)
+	}
+	for _, e1 := range []string{opt.CPUProfile, opt.MemProfile, opt.Trace} {
+		if fi, err := os.Stat(e1); err != nil || fi.Size() == 0 {
+			t.Errorf("expected %s to be written, got %v", e1, err)
+		}
+	}
+
+	if _, err := StartProfiling(Options{CPUProfile: filepath.Join(dir, "none", "cpu.prof")}); err == nil {
+		t.Errorf("expected error creating CPU profile in missing directory")
+	}
+}
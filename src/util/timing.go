@@ -0,0 +1,139 @@
+// timing.go measures the time spent in each compiler stage, which is printed as a table after compilation by
+// -ftime-report.
+
+package util
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Timing holds the time spent in each compiler stage of a compilation, in the order the stages were first started.
+// The methods of Timing do nothing for a nil Timing, such that compilations that aren't timed pass nil.
+type Timing struct {
+	stages []*StageTime
+	sync.Mutex
+}
+
+// Stage measures the wall time of one run of a compiler stage, from StartStage until Stop is called.
+type Stage struct {
+	name  string    // name of the compiler stage, such as lir.
+	start time.Time // start is the time the stage was started.
+	t     *Timing   // t accumulates the time of the stage. Nil if the compilation isn't timed.
+}
+
+// StageTime holds the accumulated time spent in a compiler stage.
+type StageTime struct {
+	Name    string        // Name of the compiler stage.
+	Wall    time.Duration // Wall is the elapsed time of the stage.
+	Work    time.Duration // Work is the sum of the elapsed times of the stage's worker go routines.
+	Workers int           // Workers is the number of worker go routines that ran the stage.
+}
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// NewTiming returns a Timing that measures the time spent in the compiler stages of a compilation.
+func NewTiming() *Timing {
+	return &Timing{}
+}
+
+// StartStage starts measuring the wall time of the compiler stage name. Stages that run more than once, such as for
+// every source file, accumulate their time.
+func (t *Timing) StartStage(name string) Stage {
+	return Stage{name: name, start: time.Now(), t: t}
+}
+
+// Stop adds the time elapsed since Stage s was started to the wall time of its compiler stage.
+func (s Stage) Stop() {
+	if s.t == nil {
+		return
+	}
+	d := time.Since(s.start)
+	s.t.Lock()
+	defer s.t.Unlock()
+	s.t.lookupStage(s.name).Wall += d
+}
+
+// TimeWorker adds the time elapsed since start to the work time of the compiler stage name. It's deferred by worker
+// go routines, such that the work time of parallel stages is aggregated across go routines.
+func (t *Timing) TimeWorker(name string, start time.Time) {
+	t.addWork(name, time.Since(start))
+}
+
+// addWork adds the time d spent by a worker go routine to the work time of the compiler stage name.
+func (t *Timing) addWork(name string, d time.Duration) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	st := t.lookupStage(name)
+	st.Work += d
+	st.Workers++
+}
+
+// StageTimes returns the time spent in each compiler stage of Timing t, in the order the stages were first started.
+// It returns nil if t is nil.
+func (t *Timing) StageTimes() []StageTime {
+	if t == nil {
+		return nil
+	}
+	t.Lock()
+	defer t.Unlock()
+	var res []StageTime
+	for _, e1 := range t.stages {
+		res = append(res, *e1)
+	}
+	return res
+}
+
+// lookupStage returns the StageTime of the compiler stage name, which is created if it doesn't exist. The caller must
+// hold the lock of t.
+func (t *Timing) lookupStage(name string) *StageTime {
+	for _, e1 := range t.stages {
+		if e1.Name == name {
+			return e1
+		}
+	}
+	st := &StageTime{Name: name}
+	t.stages = append(t.stages, st)
+	return st
+}
+
+// TimeReport writes a table of the time spent in each compiler stage of Timing t to w. The work time of a stage is
+// the sum of the time spent by its worker go routines, and is only given for stages that ran in parallel.
+func (t *Timing) TimeReport(w io.Writer) {
+	t.Lock()
+	defer t.Unlock()
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	_, _ = fmt.Fprintln(tw, "stage\twall\twork\tworkers\t")
+	total := time.Duration(0)
+	for _, e1 := range t.stages {
+		if e1.Workers == 0 {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t-\t-\t\n", e1.Name, fmtDuration(e1.Wall))
+		} else {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t\n", e1.Name, fmtDuration(e1.Wall), fmtDuration(e1.Work), e1.Workers)
+		}
+		total += e1.Wall
+	}
+	_, _ = fmt.Fprintf(tw, "total\t%s\t\t\t\n", fmtDuration(total))
+	_ = tw.Flush()
+}
+
+// fmtDuration formats d in milliseconds with microsecond precision.
+func fmtDuration(d time.Duration) string {
+	return fmt.Sprintf("%.3fms", float64(d)/float64(time.Millisecond))
+}
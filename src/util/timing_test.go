@@ -0,0 +1,44 @@
+package util
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestTimeReport verifies that the time of stages run more than once and the work time of their worker go routines
+// are accumulated, and that nothing is measured by a nil Timing.
+func TestTimeReport(t *testing.T) {
+	var none *Timing
+	none.StartStage("parse").Stop()
+	none.TimeWorker("parse", time.Now())
+	if st := none.StageTimes(); st != nil {
+		t.Errorf("expected no stages measured by a nil Timing, got %v", st)
+	}
+
+	tm := NewTiming()
+	tm.StartStage("parse").Stop()
+	for i1 := 0; i1 < 2; i1++ {
+		st := tm.StartStage("lir")
+		tm.TimeWorker("lir", time.Now())
+		tm.TimeWorker("lir", time.Now())
+		st.Stop()
+	}
+	if st := tm.StageTimes(); len(st) != 2 || st[0].Name != "parse" || st[1].Workers != 4 {
+		t.Fatalf("expected stages parse and lir with 4 workers, got %v", st)
+	}
+
+	buf := bytes.Buffer{}
+	tm.TimeReport(&buf)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected header, 2 stages and total, got:\n%s", buf.String())
+	}
+	if f := strings.Fields(lines[1]); len(f) != 4 || f[0] != "parse" || f[2] != "-" {
+		t.Errorf("expected parse without workers, got %q", lines[1])
+	}
+	if f := strings.Fields(lines[2]); len(f) != 4 || f[0] != "lir" || f[3] != "4" {
+		t.Errorf("expected lir with 4 workers, got %q", lines[2])
+	}
+}
@@ -0,0 +1,95 @@
+// version.go provides the build metadata of the compiler, which is printed by --version and included in bug reports.
+
+package util
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// BuildInfo describes the build of the compiler.
+type BuildInfo struct {
+	Version   string   // Version is the compiler version.
+	Commit    string   // Commit is the git commit the compiler was built from, or unknown.
+	GoVersion string   // GoVersion is the version of the Go toolchain that built the compiler, such as go1.15.2.
+	Backends  []string // Backends holds the names of the compiled in target architectures, in alphabetical order.
+	LLVM      string   // LLVM is the version of the compiled in LLVM framework, or empty without LLVM support.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// commit is the git commit the compiler was built from. It's set at build time by
+// go build -ldflags "-X vslc/src/util.commit=$(git rev-parse --short HEAD)".
+var commit = "unknown"
+
+// build holds the backends and LLVM version registered by the init functions of the compiled in packages.
+var build = struct {
+	backends []string
+	llvm     string
+	sync.Mutex
+}{}
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// RegisterBackend records that the target architecture called name is compiled in. It's called when the target is
+// registered.
+func RegisterBackend(name string) {
+	build.Lock()
+	defer build.Unlock()
+	build.backends = append(build.backends, name)
+}
+
+// RegisterLLVM records that the LLVM framework of the given version is compiled in.
+func RegisterLLVM(version string) {
+	build.Lock()
+	defer build.Unlock()
+	build.llvm = version
+}
+
+// Build returns the BuildInfo of the running compiler.
+func Build() BuildInfo {
+	build.Lock()
+	defer build.Unlock()
+	backends := append([]string{}, build.backends...)
+	sort.Strings(backends)
+	return BuildInfo{
+		Version:   version,
+		Commit:    commit,
+		GoVersion: runtime.Version(),
+		Backends:  backends,
+		LLVM:      build.llvm,
+	}
+}
+
+// String returns the BuildInfo as printed by --version, with one property per line.
+func (b BuildInfo) String() string {
+	llvm := b.LLVM
+	if len(llvm) == 0 {
+		llvm = "not compiled in"
+	}
+	sb := strings.Builder{}
+	w := tabwriter.NewWriter(&sb, 0, 8, 1, ' ', 0)
+	_, _ = fmt.Fprintf(w, "vsl compiler %s\n", b.Version)
+	_, _ = fmt.Fprintf(w, "commit:\t%s\n", b.Commit)
+	_, _ = fmt.Fprintf(w, "go:\t%s\n", b.GoVersion)
+	_, _ = fmt.Fprintf(w, "backends:\t%s\n", strings.Join(b.Backends, ", "))
+	_, _ = fmt.Fprintf(w, "llvm:\t%s\n", llvm)
+	_ = w.Flush()
+	return sb.String()
+}
@@ -0,0 +1,28 @@
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestBuild verifies that registered backends and the LLVM version are reported by the BuildInfo.
+func TestBuild(t *testing.T) {
+	defer func() {
+		build.backends = nil
+		RegisterLLVM("")
+	}()
+	RegisterBackend("riscv64")
+	RegisterBackend("aarch64")
+	b := Build()
+	if res := strings.Join(b.Backends, ","); res != "aarch64,riscv64" {
+		t.Errorf("expected backends %q, got %q", "aarch64,riscv64", res)
+	}
+	if !strings.Contains(b.String(), "llvm:     not compiled in\n") {
+		t.Errorf("expected LLVM not compiled in, got:\n%s", b.String())
+	}
+	RegisterLLVM("13.0.0")
+	if res := Build().String(); !strings.HasPrefix(res, "vsl compiler "+version+"\n") ||
+		!strings.Contains(res, "llvm:     13.0.0\n") {
+		t.Errorf("unexpected build info:\n%s", res)
+	}
+}
@@ -0,0 +1,136 @@
+// bench.go provides the stage benchmarks of the compiler, which time every compiler stage compiling VSL source files
+// with increasing numbers of worker threads, and write the timings as JSON or CSV for the parallelism experiments.
+
+package vslc
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"time"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// BenchResult is the time spent in a compiler stage compiling a VSL source file with a number of worker threads,
+// averaged over the runs of the benchmark.
+type BenchResult struct {
+	File    string        `json:"file"`        // File is the path of the source file.
+	Threads int           `json:"threads"`     // Threads is the number of worker threads of the parallel stages.
+	Stage   string        `json:"stage"`       // Stage is the name of the compiler stage, or total for the compilation.
+	Runs    int           `json:"runs"`        // Runs is the number of times the source file was compiled.
+	Wall    time.Duration `json:"wall_ns"`     // Wall is the mean elapsed time of the stage.
+	MinWall time.Duration `json:"min_wall_ns"` // MinWall is the shortest elapsed time of the stage.
+	Work    time.Duration `json:"work_ns"`     // Work is the mean time spent by the worker go routines of the stage.
+	Workers int           `json:"workers"`     // Workers is the number of worker go routines of one run of the stage.
+}
+
+// ---------------------
+// ----- Constants -----
+// ---------------------
+
+// benchTotal is the name of the BenchResult of the elapsed time of the compilation, which is less than the sum of the
+// stages if they're pipelined.
+const benchTotal = "total"
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Bench compiles every VSL source file of paths to assembler for the target of opt, runs times for each number of
+// worker threads, and returns the mean time spent in each compiler stage by file, number of threads and stage, in
+// that order, followed by the total elapsed time. Every file is compiled once before it's measured. Every run is
+// timed by its own Timing. An error is returned if a file can't be read or compiled, or if ctx is cancelled.
+func Bench(ctx context.Context, paths []string, threads []int, runs int, opt Options) ([]BenchResult, error) {
+	opt.Emit = []util.Artifact{{Kind: util.EmitAsm}}
+	opt.Ctx = ctx
+	var res []BenchResult
+	for _, e1 := range paths {
+		b, err := ioutil.ReadFile(e1)
+		if err != nil {
+			return res, err
+		}
+		for _, e2 := range threads {
+			opt.Threads = e2
+			var stages []BenchResult // Stages in the order they were first run.
+			for i3 := -1; i3 < runs; i3++ {
+				if err := ctx.Err(); err != nil {
+					return res, err
+				}
+				opt.Timing = util.NewTiming()
+				start := time.Now()
+				if _, diags := Compile(string(b), opt); len(diags) > 0 {
+					return res, util.InFile(diags[0], e1)
+				}
+				total := time.Since(start)
+				if i3 < 0 {
+					continue // Warm-up run.
+				}
+				for _, e4 := range opt.Timing.StageTimes() {
+					stages = benchAdd(stages, BenchResult{Stage: e4.Name, Wall: e4.Wall, Work: e4.Work, Workers: e4.Workers})
+				}
+				stages = benchAdd(stages, BenchResult{Stage: benchTotal, Wall: total})
+			}
+			for _, e3 := range stages {
+				e3.File, e3.Threads = e1, e2
+				e3.Wall /= time.Duration(e3.Runs)
+				e3.Work /= time.Duration(e3.Runs)
+				res = append(res, e3)
+			}
+		}
+	}
+	return res, nil
+}
+
+// benchAdd adds the timing of one run of the stage of r to the BenchResult of the stage in stages, which is appended
+// if it's the first run of the stage. The total is kept last.
+func benchAdd(stages []BenchResult, r BenchResult) []BenchResult {
+	for i1, e1 := range stages {
+		if e1.Stage != r.Stage {
+			continue
+		}
+		stages[i1].Runs++
+		stages[i1].Wall += r.Wall
+		stages[i1].Work += r.Work
+		if r.Wall < e1.MinWall {
+			stages[i1].MinWall = r.Wall
+		}
+		return stages
+	}
+	r.Runs, r.MinWall = 1, r.Wall
+	if n := len(stages); n > 0 && stages[n-1].Stage == benchTotal {
+		return append(stages[:n-1], r, stages[n-1])
+	}
+	return append(stages, r)
+}
+
+// WriteBenchJSON writes the BenchResults res to w as an indented JSON array. Durations are given in nanoseconds.
+func WriteBenchJSON(w io.Writer, res []BenchResult) error {
+	if res == nil {
+		res = []BenchResult{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(res)
+}
+
+// WriteBenchCSV writes the BenchResults res to w as CSV, with a header line of the JSON field names. Durations are
+// given in nanoseconds.
+func WriteBenchCSV(w io.Writer, res []BenchResult) error {
+	wr := csv.NewWriter(w)
+	_ = wr.Write([]string{"file", "threads", "stage", "runs", "wall_ns", "min_wall_ns", "work_ns", "workers"})
+	for _, e1 := range res {
+		_ = wr.Write([]string{e1.File, strconv.Itoa(e1.Threads), e1.Stage, strconv.Itoa(e1.Runs),
+			fmt.Sprint(int64(e1.Wall)), fmt.Sprint(int64(e1.MinWall)), fmt.Sprint(int64(e1.Work)),
+			strconv.Itoa(e1.Workers)})
+	}
+	wr.Flush()
+	return wr.Error()
+}
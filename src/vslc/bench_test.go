@@ -0,0 +1,60 @@
+package vslc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestBench verifies that every compiler stage is timed for each number of threads, followed by the total, and that
+// the timings are written as JSON and CSV.
+func TestBench(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "f.vsl")
+	if err := ioutil.WriteFile(path, []byte("def f() int\nbegin\nprint 1 + 2\nreturn 0\nend\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	res, err := Bench(context.Background(), []string{path}, []int{1, 2}, 2, Options{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var stages []string
+	for _, e1 := range res {
+		if e1.Threads == 1 {
+			stages = append(stages, e1.Stage)
+		}
+		if e1.File != path || e1.Runs != 2 || e1.MinWall > e1.Wall {
+			t.Errorf("expected 2 runs of %s with minimum below mean, got %+v", path, e1)
+		}
+	}
+	if exp := "parse,optimise,lir,regalloc,codegen,total"; strings.Join(stages, ",") != exp {
+		t.Errorf("expected %q, got %q", exp, strings.Join(stages, ","))
+	}
+	if len(res) != 2*len(stages) || res[len(stages)].Threads != 2 {
+		t.Errorf("expected stages for 1 and 2 threads, got %+v", res)
+	}
+
+	buf := bytes.Buffer{}
+	if err := WriteBenchJSON(&buf, res); err != nil {
+		t.Fatal(err)
+	}
+	var dec []BenchResult
+	if err := json.Unmarshal(buf.Bytes(), &dec); err != nil || len(dec) != len(res) || dec[0] != res[0] {
+		t.Errorf("expected JSON of %d results, got %v: %s", len(res), err, buf.String())
+	}
+	buf.Reset()
+	if err := WriteBenchCSV(&buf, res); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if exp := "file,threads,stage,runs,wall_ns,min_wall_ns,work_ns,workers"; lines[0] != exp || len(lines) != len(res)+1 {
+		t.Errorf("expected header %q and %d lines, got %q", exp, len(res), buf.String())
+	}
+
+	if _, err := Bench(context.Background(), []string{filepath.Join(t.TempDir(), "g.vsl")}, []int{1}, 1, Options{}); err == nil {
+		t.Errorf("expected error for missing file, got none")
+	}
+}
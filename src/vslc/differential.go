@@ -0,0 +1,236 @@
+// differential.go provides the differential tests of the compiler, which run the programs compiled by different
+// backends, and by the interpreter, with the same arguments, such that miscompiles show as differing output.
+
+package vslc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Result is the output and exit code of a program.
+type Result struct {
+	Output   string // Output is the standard output of the program.
+	ExitCode int    // ExitCode is the exit code of the program, truncated to 8 bits like the exit status of a process.
+}
+
+// Runner compiles and runs VSL programs in one way, such as by the interpreter or by a backend.
+type Runner struct {
+	Name string // Name identifies the Runner in a Divergence.
+
+	// Run compiles the VSL source file at path, and runs the program with the program arguments args. An error is
+	// returned if the program can't be compiled or run.
+	Run func(ctx context.Context, path string, args []string) (Result, error)
+}
+
+// Divergence is a program whose Result of a Runner differs from the Result of the reference Runner.
+type Divergence struct {
+	Src    string // Src is the path of the VSL source file.
+	Runner string // Runner is the name of the Runner whose Result differs.
+	Msg    string // Msg tells how the Result differs, or why the program couldn't be compiled or run.
+}
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// hostArch maps the architectures of Go to the target architectures that are run without an emulator.
+var hostArch = map[string]int{
+	"amd64":   util.X86_64,
+	"386":     util.X86_32,
+	"arm64":   util.Aarch64,
+	"arm":     util.Armv7,
+	"riscv64": util.Riscv64,
+}
+
+// argsComment matches the numbers of the comment on the first line of a VSL source file, which are the arguments the
+// program is run with.
+var argsComment = regexp.MustCompile(`-?[0-9]+(\.[0-9]+)?`)
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// Differential runs the program of every VSL source file in directory src with each Runner, and compares the Result
+// with the Result of the first Runner, which is the reference. The programs are run with the numbers of the comment on
+// their first line as arguments, if any. A Divergence is returned for every Result that differs, and for every
+// program a Runner failed to compile or run. An error is returned if the source files can't be read or if ctx is
+// cancelled.
+func Differential(ctx context.Context, src string, runners []Runner) ([]Divergence, error) {
+	if len(runners) < 2 {
+		return nil, errors.New("differential testing requires at least two runners")
+	}
+	paths, err := sources(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Divergence
+	for _, e1 := range paths {
+		b, err := ioutil.ReadFile(e1)
+		if err != nil {
+			return res, err
+		}
+		args := programArgs(string(b))
+		ref, err := runners[0].Run(ctx, e1, args)
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+		if err != nil {
+			res = append(res, Divergence{Src: e1, Runner: runners[0].Name, Msg: err.Error()})
+			continue
+		}
+		for _, e2 := range runners[1:] {
+			r, err := e2.Run(ctx, e1, args)
+			if err := ctx.Err(); err != nil {
+				return res, err
+			}
+			switch {
+			case err != nil:
+				res = append(res, Divergence{Src: e1, Runner: e2.Name, Msg: err.Error()})
+			case r.ExitCode != ref.ExitCode:
+				msg := fmt.Sprintf("expected exit code %d of %s, got %d", ref.ExitCode, runners[0].Name, r.ExitCode)
+				res = append(res, Divergence{Src: e1, Runner: e2.Name, Msg: msg})
+			case r.Output != ref.Output:
+				res = append(res, Divergence{Src: e1, Runner: e2.Name, Msg: diff(runners[0].Name, ref.Output, r.Output)})
+			}
+		}
+	}
+	return res, nil
+}
+
+// Interpreter returns the Runner that interprets the LIR of programs.
+func Interpreter() Runner {
+	return Runner{
+		Name: "interp",
+		Run: func(ctx context.Context, path string, args []string) (Result, error) {
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				return Result{}, err
+			}
+			res, diags := Compile(string(b), Options{Threads: 1, Run: true, Args: args, Ctx: ctx})
+			if len(diags) > 0 {
+				return Result{}, diags[0]
+			}
+			return Result{Output: res.Output, ExitCode: res.ExitCode & 0xff}, nil
+		},
+	}
+}
+
+// LLVMIR returns the Runner that generates textual LLVM IR from the LIR of programs for the host, and runs it by the
+// LLVM interpreter, whose command line is lli. See LLI.
+func LLVMIR(lli []string) Runner {
+	return Runner{
+		Name: "llvm-ir",
+		Run: func(ctx context.Context, path string, args []string) (Result, error) {
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				return Result{}, err
+			}
+			opt := Options{
+				Threads:    1,
+				TargetArch: HostArch(),
+				Emit:       []util.Artifact{{Kind: util.EmitLLVMIR}},
+				Ctx:        ctx,
+			}
+			res, diags := Compile(string(b), opt)
+			if len(diags) > 0 {
+				return Result{}, diags[0]
+			}
+			dir, err := ioutil.TempDir("", "vslc")
+			if err != nil {
+				return Result{}, err
+			}
+			defer os.RemoveAll(dir)
+			ll := filepath.Join(dir, "prog.ll")
+			if err := ioutil.WriteFile(ll, []byte(res.LLVMIR), 0644); err != nil {
+				return Result{}, err
+			}
+			return Exec(ctx, append(append([]string{}, lli...), ll), args)
+		},
+	}
+}
+
+// Exec runs the command line cmd followed by the program arguments args, and returns its standard output and exit
+// code. An error is returned if the command couldn't be run, or if it was killed.
+func Exec(ctx context.Context, cmd, args []string) (Result, error) {
+	out, err := exec.CommandContext(ctx, cmd[0], append(cmd[1:], args...)...).Output()
+	var ee *exec.ExitError
+	if errors.As(err, &ee) && ee.ExitCode() >= 0 {
+		return Result{Output: string(out), ExitCode: ee.ExitCode()}, nil
+	} else if err != nil {
+		return Result{}, fmt.Errorf("%s failed: %w", cmd[0], err)
+	}
+	return Result{Output: string(out)}, nil
+}
+
+// LLI returns the command line of the LLVM interpreter, which is taken from the LLI environment variable if it's set.
+// Otherwise lli is used, with opaque pointers enabled for LLVM versions before 15. LLI returns nil if lli isn't found.
+func LLI() []string {
+	if lli := strings.Fields(os.Getenv("LLI")); len(lli) > 0 {
+		return lli
+	}
+	path, err := exec.LookPath("lli")
+	if err != nil {
+		return nil
+	}
+	out, err := exec.Command(path, "--version").Output()
+	if err != nil {
+		return nil
+	}
+	if m := regexp.MustCompile(`LLVM version ([0-9]+)`).FindSubmatch(out); m != nil {
+		if v, _ := strconv.Atoi(string(m[1])); v < 15 {
+			return []string{path, "-opaque-pointers"}
+		}
+	}
+	return []string{path}
+}
+
+// HostArch returns the target architecture identifier of the host, or util.UnknownArch if it isn't known.
+func HostArch() int {
+	return hostArch[runtime.GOARCH]
+}
+
+// programArgs returns the program arguments given by the comment on the first line of VSL source code src.
+func programArgs(src string) []string {
+	line := strings.SplitN(src, "\n", 2)[0]
+	if !strings.HasPrefix(line, "//") {
+		return nil
+	}
+	return argsComment.FindAllString(line, -1)
+}
+
+// diff returns the first line of output got that differs from the expected output exp of ref.
+func diff(ref, exp, got string) string {
+	e, g := strings.Split(exp, "\n"), strings.Split(got, "\n")
+	for i1 := 0; i1 < len(e) && i1 < len(g); i1++ {
+		if e[i1] != g[i1] {
+			return fmt.Sprintf("line %d: expected %q of %s, got %q", i1+1, e[i1], ref, g[i1])
+		}
+	}
+	return fmt.Sprintf("expected %d lines of %s, got %d", len(e), ref, len(g))
+}
+
+// String returns the textual representation of Result r: the output of the program followed by its exit code.
+func (r Result) String() string {
+	return fmt.Sprintf("%s[exit %d]\n", r.Output, r.ExitCode)
+}
+
+// String returns the textual representation of Divergence d.
+func (d Divergence) String() string {
+	return fmt.Sprintf("%s: %s: %s", d.Src, d.Runner, d.Msg)
+}
@@ -0,0 +1,44 @@
+package vslc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestDifferential verifies that the bundled VSL programs print the same output and return the same exit code when
+// interpreted and when run as LLVM IR by lli.
+func TestDifferential(t *testing.T) {
+	lli := LLI()
+	if lli == nil {
+		t.Skip("lli not found")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	res, err := Differential(ctx, "../../resources/vsl_typed", []Runner{Interpreter(), LLVMIR(lli)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e1 := range res {
+		t.Error(e1)
+	}
+}
+
+// TestProgramArgs verifies that program arguments are read from the comment on the first line of source code.
+func TestProgramArgs(t *testing.T) {
+	exp := []struct {
+		src  string
+		args string
+	}{
+		{src: "// 45 -2\ndef f(a, b int) int", args: "45,-2"},
+		{src: "// 3.14 3.83\n// Floats", args: "3.14,3.83"},
+		{src: "//\ndef f() int", args: ""},
+		{src: "def f() int\n// 1 2", args: ""},
+	}
+	for _, e1 := range exp {
+		if args := strings.Join(programArgs(e1.src), ","); args != e1.args {
+			t.Errorf("expected %q, got %q", e1.args, args)
+		}
+	}
+}
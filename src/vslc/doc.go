@@ -0,0 +1,203 @@
+// doc.go provides the documentation generator of VSL source files, which lists their global variables and functions
+// with their types, and the comments that precede their declarations, as Markdown or HTML.
+
+package vslc
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"vslc/src/frontend"
+	"vslc/src/ir"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// docFile is the documentation of a VSL source file.
+type docFile struct {
+	name    string     // name is the name of the source file.
+	comment []string   // comment is the first comment of the file, unless it documents a declaration.
+	globals []docEntry // globals are the global variable declarations, in order of declaration.
+	funcs   []docEntry // funcs are the functions, in order of declaration.
+}
+
+// docEntry is the documentation of a global variable declaration or function.
+type docEntry struct {
+	name    string   // name is the name of the function, or the names of the variables of the declaration.
+	decl    string   // decl is the declaration, such as def f(a, b int) float.
+	comment []string // comment is the comment that precedes the declaration, without the leading //.
+}
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Doc returns the documentation of the VSL source files srcs, named names, as Markdown, or as an HTML page if html is
+// set. Global variables and functions are listed in order of declaration with their types, and documented by the
+// comment on the lines that precede their declaration. The first comment of a file that doesn't precede a declaration
+// documents the file. An error is returned if a file can't be parsed.
+func Doc(names, srcs []string, html bool) (string, error) {
+	files := make([]docFile, len(srcs))
+	for i1, e1 := range srcs {
+		f, err := document(names[i1], e1)
+		if err != nil {
+			return "", util.InFile(err, names[i1])
+		}
+		files[i1] = f
+	}
+	if html {
+		return docHTML(files), nil
+	}
+	return docMarkdown(files), nil
+}
+
+// document returns the documentation of the VSL source code src of the file named name.
+func document(name, src string) (docFile, error) {
+	root, comments, err := frontend.ParseComments(src)
+	if err != nil {
+		return docFile{}, err
+	}
+	if err := ir.Optimise(Options{Threads: 1}, root); err != nil {
+		return docFile{}, err
+	}
+
+	// Comments that are alone on their line, by line.
+	lines := strings.Split(src, "\n")
+	byLine := map[int]string{}
+	for _, e1 := range comments {
+		if strings.TrimSpace(lines[e1.Line-1]) == e1.Text {
+			byLine[e1.Line] = strings.TrimPrefix(strings.TrimPrefix(e1.Text, "//"), " ")
+		}
+	}
+	comment := func(line int) []string {
+		first := line
+		for ; hasLine(byLine, first-1); first-- {
+		}
+		var res []string
+		for i1 := first; i1 < line; i1++ {
+			res = append(res, byLine[i1])
+			delete(byLine, i1)
+		}
+		return res
+	}
+
+	res := docFile{name: name}
+	for _, e1 := range root.Children {
+		e := docEntry{comment: comment(e1.Line)}
+		if e1.Typ == ir.FUNCTION {
+			var params []string
+			for _, e2 := range e1.Children[2].Children {
+				params = append(params, fmt.Sprintf("%s %s", identifiers(e2), e2.Data))
+			}
+			e.name = e1.Children[0].Data.Str
+			e.decl = fmt.Sprintf("def %s(%s) %s", e.name, strings.Join(params, ", "), e1.Children[1].Data)
+			res.funcs = append(res.funcs, e)
+		} else {
+			e.name = identifiers(e1.Children[0])
+			e.decl = fmt.Sprintf("var %s %s", e.name, e1.Data)
+			res.globals = append(res.globals, e)
+		}
+	}
+
+	// The first comment of the file, which is left unless it documents a declaration.
+	for i1 := 1; i1 <= len(lines); i1++ {
+		if hasLine(byLine, i1) {
+			for ; hasLine(byLine, i1); i1++ {
+				res.comment = append(res.comment, byLine[i1])
+			}
+			break
+		}
+	}
+	return res, nil
+}
+
+// hasLine returns true if there's a comment on line.
+func hasLine(comments map[int]string, line int) bool {
+	_, ok := comments[line]
+	return ok
+}
+
+// identifiers returns the comma separated identifiers of the VARIABLE_LIST or TYPED_VARIABLE_LIST n.
+func identifiers(n *ir.Node) string {
+	names := make([]string, len(n.Children))
+	for i1, e1 := range n.Children {
+		names[i1] = e1.Data.Str
+	}
+	return strings.Join(names, ", ")
+}
+
+// docMarkdown returns the documentation of files as Markdown, with one section per file.
+func docMarkdown(files []docFile) string {
+	sb := strings.Builder{}
+	paragraphs := func(lines []string) {
+		if len(lines) > 0 {
+			sb.WriteString(strings.TrimSpace(strings.Join(lines, "\n")))
+			sb.WriteString("\n\n")
+		}
+	}
+	for i1, e1 := range files {
+		if i1 > 0 {
+			sb.WriteRune('\n')
+		}
+		sb.WriteString(fmt.Sprintf("# %s\n\n", e1.name))
+		paragraphs(e1.comment)
+		for _, e2 := range []struct {
+			title   string
+			entries []docEntry
+		}{{"Global variables", e1.globals}, {"Functions", e1.funcs}} {
+			if len(e2.entries) == 0 {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("## %s\n\n", e2.title))
+			for _, e3 := range e2.entries {
+				sb.WriteString(fmt.Sprintf("### %s\n\n```vsl\n%s\n```\n\n", e3.name, e3.decl))
+				paragraphs(e3.comment)
+			}
+		}
+	}
+	return strings.TrimRight(sb.String(), "\n") + "\n"
+}
+
+// docHTML returns the documentation of files as an HTML page, with one section per file.
+func docHTML(files []docFile) string {
+	sb := strings.Builder{}
+	paragraphs := func(lines []string) {
+		// Empty comment lines separate paragraphs.
+		for _, e1 := range strings.Split(strings.TrimSpace(strings.Join(lines, "\n")), "\n\n") {
+			if len(e1) > 0 {
+				sb.WriteString(fmt.Sprintf("<p>%s</p>\n", html.EscapeString(e1)))
+			}
+		}
+	}
+	title := "VSL documentation"
+	if len(files) == 1 {
+		title = files[0].name
+	}
+	sb.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	sb.WriteString(fmt.Sprintf("<title>%s</title>\n</head>\n<body>\n", html.EscapeString(title)))
+	for _, e1 := range files {
+		sb.WriteString(fmt.Sprintf("<section>\n<h1>%s</h1>\n", html.EscapeString(e1.name)))
+		paragraphs(e1.comment)
+		for _, e2 := range []struct {
+			title   string
+			entries []docEntry
+		}{{"Global variables", e1.globals}, {"Functions", e1.funcs}} {
+			if len(e2.entries) == 0 {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("<h2>%s</h2>\n", e2.title))
+			for _, e3 := range e2.entries {
+				sb.WriteString(fmt.Sprintf("<h3 id=\"%s\">%s</h3>\n<pre><code>%s</code></pre>\n",
+					html.EscapeString(e1.name+"."+strings.ReplaceAll(e3.name, ", ", ".")),
+					html.EscapeString(e3.name), html.EscapeString(e3.decl)))
+				paragraphs(e3.comment)
+			}
+		}
+		sb.WriteString("</section>\n")
+	}
+	sb.WriteString("</body>\n</html>\n")
+	return sb.String()
+}
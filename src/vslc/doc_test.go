@@ -0,0 +1,60 @@
+package vslc
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestDoc verifies that the documentation lists global variables and functions with their types and the comments that
+// precede them, and that the first comment documents the file unless it precedes a declaration.
+func TestDoc(t *testing.T) {
+	src := `// Package comment.
+//
+// Second paragraph.
+
+// n counts.
+var n, m int
+
+def main() int
+begin
+    // Not documentation.
+    return f(1, 2, 3.0)
+end
+
+// f returns <a>.
+def f(a, b int, c float) float // Trailing.
+    return c
+`
+	exp := "# f.vsl\n\nPackage comment.\n\nSecond paragraph.\n\n## Global variables\n\n### n, m\n\n```vsl\n" +
+		"var n, m int\n```\n\nn counts.\n\n## Functions\n\n### main\n\n```vsl\ndef main() int\n```\n\n### f\n\n" +
+		"```vsl\ndef f(a, b int, c float) float\n```\n\nf returns <a>.\n"
+	out, err := Doc([]string{"f.vsl"}, []string{src}, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out != exp {
+		t.Errorf("expected %q, got %q", exp, out)
+	}
+
+	out, err = Doc([]string{"f.vsl"}, []string{src}, true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, e1 := range []string{"<p>Package comment.</p>\n<p>Second paragraph.</p>\n", "<h3 id=\"f.vsl.n.m\">n, m</h3>",
+		"<pre><code>def f(a, b int, c float) float</code></pre>\n<p>f returns &lt;a&gt;.</p>\n"} {
+		if !strings.Contains(out, e1) {
+			t.Errorf("expected %q in %q", e1, out)
+		}
+	}
+
+	// Declarations directly after the first comment are documented by it.
+	out, _ = Doc([]string{"g.vsl"}, []string{"// g returns 1.\ndef g() int\nreturn 1\n"}, false)
+	if exp := "# g.vsl\n\n## Functions\n\n### g\n\n```vsl\ndef g() int\n```\n\ng returns 1.\n"; out != exp {
+		t.Errorf("expected %q, got %q", exp, out)
+	}
+
+	if _, err := Doc([]string{"h.vsl"}, []string{"def h() int\nreturn\n"}, false); err == nil ||
+		!strings.HasPrefix(err.Error(), "h.vsl") {
+		t.Errorf("expected error in h.vsl, got %v", err)
+	}
+}
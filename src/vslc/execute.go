@@ -0,0 +1,146 @@
+// execute.go provides the execution tests of the compiler, which assemble and link the generated assembler by a
+// cross toolchain, and run the executables by the QEMU user mode emulator, such that the generated code is tested on
+// hosts of other architectures.
+
+package vslc
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"vslc/src/backend"
+	"vslc/src/util"
+)
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// qemu maps target architectures to the QEMU user mode emulator that runs their executables on other hosts.
+var qemu = map[int]string{
+	util.Aarch64: "qemu-aarch64",
+	util.Riscv64: "qemu-riscv64",
+	util.Riscv32: "qemu-riscv32",
+	util.Armv7:   "qemu-arm",
+}
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// Execute links the assembler generated for target architecture arch from every VSL source file in directory src,
+// runs the executables with the arguments of the comment on the first line of their source file, and compares their
+// output and exit code with the golden files out/<name>.out in directory dir, which are written by Golden. A Mismatch
+// is returned for every executable whose Result differs, and for every program that can't be compiled, linked or run.
+// An error is returned if the toolchain of arch isn't found, see Toolchain, if the files can't be read or if ctx is
+// cancelled.
+func Execute(ctx context.Context, src, dir string, arch int) ([]Mismatch, error) {
+	if _, err := Toolchain(arch); err != nil {
+		return nil, err
+	}
+	paths, err := sources(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Mismatch
+	run := Native(arch)
+	for _, e1 := range paths {
+		b, err := ioutil.ReadFile(e1)
+		if err != nil {
+			return res, err
+		}
+		path := filepath.Join(dir, "out", strings.TrimSuffix(filepath.Base(e1), ".vsl")+".out")
+		exp, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			res = append(res, Mismatch{Src: e1, Golden: path, Msg: "missing golden file"})
+			continue
+		} else if err != nil {
+			return res, err
+		}
+		r, err := run.Run(ctx, e1, programArgs(string(b)))
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+		switch {
+		case err != nil:
+			res = append(res, Mismatch{Src: e1, Golden: path, Msg: fmt.Sprintf("%s: %s", run.Name, err)})
+		case r.String() != string(exp):
+			msg := fmt.Sprintf("%s: %s", run.Name, diff("the golden file", string(exp), r.String()))
+			res = append(res, Mismatch{Src: e1, Golden: path, Msg: msg})
+		}
+	}
+	return res, nil
+}
+
+// Native returns the Runner that generates assembler for target architecture arch, links it by the C compiler driver
+// of the target, and runs the executable by the command line returned by Toolchain.
+func Native(arch int) Runner {
+	name := strconv.Itoa(arch)
+	if t, err := backend.Lookup(arch); err == nil {
+		name = t.Name()
+	}
+	return Runner{
+		Name: name,
+		Run: func(ctx context.Context, path string, args []string) (Result, error) {
+			emu, err := Toolchain(arch)
+			if err != nil {
+				return Result{}, err
+			}
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				return Result{}, err
+			}
+			opt := Options{Threads: 1, TargetArch: arch, Ctx: ctx}
+			res, diags := Compile(string(b), opt)
+			if len(diags) > 0 {
+				return Result{}, diags[0]
+			}
+			dir, err := ioutil.TempDir("", "vslc")
+			if err != nil {
+				return Result{}, err
+			}
+			defer os.RemoveAll(dir)
+			s, exe := filepath.Join(dir, "prog.s"), filepath.Join(dir, "prog")
+			if err := ioutil.WriteFile(s, []byte(res.Asm), 0644); err != nil {
+				return Result{}, err
+			}
+			if err := backend.Link(opt, s, exe); err != nil {
+				return Result{}, err
+			}
+			return Exec(ctx, append(emu, exe), args)
+		},
+	}
+}
+
+// Toolchain verifies that executables of target architecture arch can be linked and run on the host, and returns the
+// command line that precedes the executable when it's run. It's empty if arch is the host's. Otherwise executables
+// are run by the QEMU user mode emulator, with the sysroot of the cross-compiler, /usr/<triple>, as the path of the
+// dynamic libraries if it exists. An error tells which tool is missing, such that callers skip the target.
+func Toolchain(arch int) ([]string, error) {
+	_, triple, err := backend.Driver(Options{TargetArch: arch})
+	if err != nil {
+		return nil, err
+	}
+	if HostArch() == arch {
+		return nil, nil
+	}
+	name, ok := qemu[arch]
+	if !ok {
+		return nil, fmt.Errorf("no QEMU user mode emulator runs %s executables", triple)
+	}
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return nil, fmt.Errorf("%s not found, install QEMU user mode emulation to run %s executables", name, triple)
+	}
+	emu := []string{path}
+	if fi, err := os.Stat(filepath.Join("/usr", triple)); err == nil && fi.IsDir() {
+		emu = append(emu, "-L", filepath.Join("/usr", triple))
+	}
+	return emu, nil
+}
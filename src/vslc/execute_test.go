@@ -0,0 +1,34 @@
+package vslc
+
+import (
+	"context"
+	"testing"
+	"time"
+	"vslc/src/backend"
+	"vslc/src/util"
+)
+
+// TestExecute verifies that the executables of the bundled VSL programs print the output and return the exit code of
+// their golden files. Targets whose cross toolchain or emulator isn't installed are skipped.
+func TestExecute(t *testing.T) {
+	for _, e1 := range []int{util.Aarch64, util.Riscv64} {
+		tg, err := backend.Lookup(e1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Run(tg.Name(), func(t *testing.T) {
+			if _, err := Toolchain(e1); err != nil {
+				t.Skip(err)
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			res, err := Execute(ctx, "../../resources/vsl_typed", "../../resources/golden", e1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e2 := range res {
+				t.Error(e2)
+			}
+		})
+	}
+}
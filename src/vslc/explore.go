@@ -0,0 +1,125 @@
+// explore.go provides the compiler explorer report, which shows the LIR instructions and target assembler generated
+// from each line of VSL source code side by side, for teaching and for debugging code generation.
+
+package vslc
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"vslc/src/backend"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// exploreLine is the code generated from a line of source code.
+type exploreLine struct {
+	lir []string // lir are the LIR instructions generated from the line, in order of generation.
+	asm []string // asm are the assembler instructions generated from the LIR instructions of the line.
+}
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// Comment lines of annotated assembler, written by -fverbose-asm, holding the source line and the LIR instruction
+// that the assembler below them is generated from. Line comments start with //, #, @ or ;; depending on the target.
+var (
+	annotatedLine = regexp.MustCompile(`^\s+(?://|#|@|;;) (\d+):`)
+	annotatedLIR  = regexp.MustCompile(`^\s+(?://|#|@|;;)\t(.*)$`)
+)
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Explore returns an HTML page that shows every line of the VSL source code src, named name, next to the LIR
+// instructions and target assembler generated from it, like a compiler explorer. The program is compiled for the
+// target of opt, whose assembler is annotated with the source lines and LIR instructions it's generated from, such
+// that assembler outside of any LIR instruction, such as function prologues and data, isn't shown. The first
+// Diagnostic is returned if the program doesn't compile.
+func Explore(name, src string, opt Options) (string, error) {
+	if opt.TargetArch == util.UnknownArch {
+		opt.TargetArch = util.Aarch64
+	}
+	opt.Annotate = true
+	opt.Emit = []util.Artifact{{Kind: util.EmitAsm}}
+	out, diags := Compile(src, opt)
+	if len(diags) > 0 {
+		return "", diags[0]
+	}
+	t, err := backend.Lookup(opt.TargetArch)
+	if err != nil {
+		return "", err
+	}
+	lines := exploreLines(out.Asm)
+
+	sb := strings.Builder{}
+	cell := func(lines []string) {
+		sb.WriteString(fmt.Sprintf("<td><pre>%s</pre></td>", html.EscapeString(strings.Join(lines, "\n"))))
+	}
+	title := fmt.Sprintf("%s (%s)", name, t.Name())
+	sb.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	sb.WriteString(fmt.Sprintf("<title>%s</title>\n", html.EscapeString(title)))
+	sb.WriteString("<style>\ntable { border-collapse: collapse; }\ntd, th { border: 1px solid #ccc; padding: 0 0.5em; " +
+		"text-align: left; vertical-align: top; }\npre { margin: 0; }\ntr.code { background: #f3f6fa; }\n</style>\n")
+	sb.WriteString(fmt.Sprintf("</head>\n<body>\n<h1>%s</h1>\n<table>\n", html.EscapeString(title)))
+	sb.WriteString("<tr><th>Line</th><th>Source</th><th>LIR</th><th>Assembler</th></tr>\n")
+	for i1, e1 := range strings.Split(strings.TrimRight(src, "\n"), "\n") {
+		l := lines[i1+1]
+		if l == nil {
+			sb.WriteString(fmt.Sprintf("<tr id=\"L%d\"><td>%d</td>", i1+1, i1+1))
+			l = &exploreLine{}
+		} else {
+			sb.WriteString(fmt.Sprintf("<tr id=\"L%d\" class=\"code\"><td>%d</td>", i1+1, i1+1))
+		}
+		cell([]string{strings.TrimRight(e1, "\r")})
+		cell(l.lir)
+		cell(l.asm)
+		sb.WriteString("</tr>\n")
+	}
+	sb.WriteString("</table>\n</body>\n</html>\n")
+	return sb.String(), nil
+}
+
+// exploreLines returns the code generated from each source line of the annotated assembler asm, by line. Assembler
+// belongs to the LIR instruction annotated above it, which belongs to the source line annotated last in its function.
+// Labels end the assembler of a LIR instruction, such that function prologues aren't taken for the code of the source
+// line before them, and directives are left out.
+func exploreLines(asm string) map[int]*exploreLine {
+	res := map[int]*exploreLine{}
+	line := 0            // line is the source line annotated last.
+	var cur *exploreLine // cur is the code of the source line of the last LIR instruction, or nil after a label.
+	for _, e1 := range strings.Split(asm, "\n") {
+		if m := annotatedLine.FindStringSubmatch(e1); m != nil {
+			line, _ = strconv.Atoi(m[1])
+			continue
+		}
+		if m := annotatedLIR.FindStringSubmatch(e1); m != nil {
+			if line == 0 {
+				continue
+			}
+			if res[line] == nil {
+				res[line] = &exploreLine{}
+			}
+			cur = res[line]
+			cur.lir = append(cur.lir, m[1])
+			continue
+		}
+		switch s := strings.TrimSpace(e1); {
+		case strings.HasSuffix(s, ":") || strings.HasPrefix(s, "(") || strings.HasPrefix(s, ")"):
+			// Labels, and the S-expressions that open and close WebAssembly functions, begin new basic blocks or
+			// functions.
+			cur = nil
+		case len(s) > 0 && s[0] != '.' && cur != nil:
+			// Directives, such as call frame information, aren't generated from LIR instructions.
+			cur.asm = append(cur.asm, s)
+		}
+	}
+	return res
+}
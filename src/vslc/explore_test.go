@@ -0,0 +1,37 @@
+package vslc
+
+import (
+	"strings"
+	"testing"
+	"vslc/src/util"
+)
+
+// TestExplore verifies that the LIR and assembler of every target are shown next to the source line they're generated
+// from, and that lines without code, such as comments, have none.
+func TestExplore(t *testing.T) {
+	src := "// Comment.\ndef f() int\nbegin\n    var a int\n    a := 42\n    return a\nend\n"
+	exp := map[int]string{
+		util.Aarch64: "mov\tx8, #42",
+		util.Riscv64: "li\tt0, 42",
+		util.Armv7:   "movw\tr4, #42",
+		util.Wasm:    "i64.const 42",
+	}
+	for arch, e1 := range exp {
+		out, err := Explore("f.vsl", src, Options{Threads: 1, TargetArch: arch})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !strings.Contains(out, "<tr id=\"L1\"><td>1</td><td><pre>// Comment.</pre></td><td><pre></pre></td><td><pre></pre></td></tr>") {
+			t.Errorf("expected no code for line 1 in %q", out)
+		}
+		i1 := strings.Index(out, "<tr id=\"L5\" class=\"code\">")
+		i2 := strings.Index(out, "<tr id=\"L6\"")
+		if i1 < 0 || i2 < i1 || !strings.Contains(out[i1:i2], "Int(42)") || !strings.Contains(out[i1:i2], e1) {
+			t.Errorf("expected %q generated from line 5 in %q", e1, out)
+		}
+	}
+
+	if _, err := Explore("g.vsl", "def g() int\nreturn x\n", Options{Threads: 1}); err == nil {
+		t.Errorf("expected error, got none")
+	}
+}
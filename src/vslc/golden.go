@@ -0,0 +1,158 @@
+// golden.go provides the golden file tests of the compiler, which compare the LIR and assembler generated for VSL
+// source files with the output checked in as golden files, such that changes to the generated code are reviewed.
+
+package vslc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"vslc/src/backend"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Mismatch is a golden file that doesn't match the output of the compiler.
+type Mismatch struct {
+	Src    string // Src is the path of the VSL source file.
+	Golden string // Golden is the path of the golden file.
+	Msg    string // Msg tells the first line that differs, or why the output couldn't be compared.
+}
+
+// goldenFile is the output compared with a golden file, or the error that prevented it from being generated.
+type goldenFile struct {
+	path string
+	out  string
+	err  error
+}
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// GoldenTargets are the target architectures whose output is compared with golden files.
+var GoldenTargets = []int{util.Aarch64, util.Riscv64, util.Riscv32, util.Armv7, util.Wasm}
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// Golden compiles every VSL source file in directory src for each of the GoldenTargets, and compares the LIR and
+// assembler generated with the golden files lir/<name>.lir and <target>/<name>.s, or wasm/<name>.wat, in directory
+// dir. The output and exit code of the interpreted program, run with the arguments of the comment on its first line,
+// are compared with the golden file out/<name>.out, which Execute compares the executables with. The golden files are
+// written instead if update is set. A Mismatch is returned for every golden file that differs from the output, is
+// missing, or whose source file can't be compiled. An error is returned if the files can't be read or written.
+func Golden(src, dir string, update bool) ([]Mismatch, error) {
+	paths, err := sources(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Mismatch
+	for _, e1 := range paths {
+		b, err := ioutil.ReadFile(e1)
+		if err != nil {
+			return res, err
+		}
+		name := strings.TrimSuffix(filepath.Base(e1), ".vsl")
+		var files []goldenFile
+		for i2, e2 := range GoldenTargets {
+			t, err := backend.Lookup(e2)
+			if err != nil {
+				return res, err
+			}
+			// The source file is named by its base name, which is recorded by the LIR module and the assembler.
+			opt := Options{
+				Threads:    1,
+				TargetArch: e2,
+				Src:        filepath.Base(e1),
+				Emit:       []util.Artifact{{Kind: util.EmitLIR}, {Kind: util.EmitAsm}},
+			}
+			out, diags := Compile(string(b), opt)
+			if len(diags) > 0 {
+				err = diags[0]
+			}
+
+			// LIR is generated before it's lowered for the target, hence it's compared once.
+			files = append(files, goldenFile{path: filepath.Join(dir, t.Name(), name+goldenExt(e2)), out: out.Asm, err: err})
+			if i2 == 0 {
+				files = append(files, goldenFile{path: filepath.Join(dir, "lir", name+".lir"), out: out.LIR, err: err})
+			}
+		}
+		r, err := Interpreter().Run(context.Background(), e1, programArgs(string(b)))
+		files = append(files, goldenFile{path: filepath.Join(dir, "out", name+".out"), out: r.String(), err: err})
+
+		for _, e2 := range files {
+			if e2.err != nil {
+				res = append(res, Mismatch{Src: e1, Golden: e2.path, Msg: e2.err.Error()})
+				continue
+			}
+			msg, err := golden(e2.path, e2.out, update)
+			if err != nil {
+				return res, err
+			}
+			if len(msg) > 0 {
+				res = append(res, Mismatch{Src: e1, Golden: e2.path, Msg: msg})
+			}
+		}
+	}
+	return res, nil
+}
+
+// goldenExt returns the extension of the golden files of the output of target architecture arch, which is .wat for
+// the WebAssembly text format, and .s for assembler.
+func goldenExt(arch int) string {
+	if arch == util.Wasm {
+		return ".wat"
+	}
+	return ".s"
+}
+
+// sources returns the paths of the VSL source files in directory src, in lexical order. An error is returned if there
+// are none.
+func sources(src string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(src, "*.vsl"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no VSL source files in %s", src)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// golden compares output out with the golden file at path, or writes out to the golden file if update is set. It
+// returns the difference, if any.
+func golden(path, out string, update bool) (string, error) {
+	if update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return "", err
+		}
+		return "", ioutil.WriteFile(path, []byte(out), 0644)
+	}
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "missing golden file", nil
+	} else if err != nil {
+		return "", err
+	}
+	if bytes.Equal(b, []byte(out)) {
+		return "", nil
+	}
+	return diff("the golden file", string(b), out), nil
+}
+
+// String returns the textual representation of Mismatch m.
+func (m Mismatch) String() string {
+	return fmt.Sprintf("%s: %s: %s", m.Src, m.Golden, m.Msg)
+}
@@ -0,0 +1,20 @@
+package vslc
+
+import (
+	"flag"
+	"testing"
+)
+
+// update is set by go test -update to write the golden files of TestGolden instead of comparing them.
+var update = flag.Bool("update", false, "update the golden files")
+
+// TestGolden verifies that the LIR and assembler generated for the bundled VSL source files match their golden files.
+func TestGolden(t *testing.T) {
+	res, err := Golden("../../resources/vsl_typed", "../../resources/golden", *update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e1 := range res {
+		t.Error(e1)
+	}
+}
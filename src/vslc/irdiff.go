@@ -0,0 +1,285 @@
+// irdiff.go provides the structural difference between two LIR modules, such that the effect of optimisation passes
+// and other changes of the compiler on the generated LIR is reviewed without the noise of renumbered values.
+
+package vslc
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// irModule is textual LIR, split into the global declarations and the functions of the module.
+type irModule struct {
+	globals []string      // globals are the declarations of global variables and strings.
+	funcs   []*irFunction // funcs are the functions of the module, in order of declaration.
+}
+
+// irFunction is a function of textual LIR, whose values and basic blocks are renumbered in order of appearance.
+type irFunction struct {
+	name   string    // name is the name of the function.
+	sig    string    // sig is the first line of the function, which holds its name, parameters and return type.
+	blocks []irBlock // blocks are the basic blocks of the function, following its local variables.
+}
+
+// irBlock is a basic block of textual LIR, or the local variables of a function, which have no label.
+type irBlock struct {
+	label string   // label is the renumbered label of the basic block.
+	lines []string // lines are the instructions of the basic block.
+}
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// Patterns of the names that are renumbered, or replaced by their value, when textual LIR is compared.
+var (
+	irString   = regexp.MustCompile(`^(_STR_[0-9]+) \(String\): (".*")$`)
+	irStringId = regexp.MustCompile(`_STR_[0-9]+`)
+	irValue    = regexp.MustCompile(`%[0-9]+\b`)
+	irLabel    = regexp.MustCompile(`\bblock[0-9]+\b`)
+)
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// LoadIR returns the textual LIR of the file at path. VSL source files, ending in .vsl, are compiled to LIR with opt,
+// LIR objects, ending in .lo, are decoded, and other files are read as textual LIR, such as written by --emit=lir.
+func LoadIR(path string, opt Options) (string, error) {
+	switch filepath.Ext(path) {
+	case ".vsl":
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		opt.Emit = []util.Artifact{{Kind: util.EmitLIR}}
+		res, diags := Compile(string(b), opt)
+		if len(diags) > 0 {
+			return "", fmt.Errorf("%s: %w", path, diags[0])
+		}
+		return res.LIR, nil
+	case ".lo":
+		f, err := os.Open(path)
+		if err != nil {
+			return "", err
+		}
+		defer f.Close()
+		m, err := lir.Decode(bufio.NewReader(f))
+		if err != nil {
+			return "", fmt.Errorf("%s: %s", path, err)
+		}
+		return m.String(), nil
+	}
+	b, err := ioutil.ReadFile(path)
+	return string(b), err
+}
+
+// DiffIR returns the structural difference between the textual LIR old and new, or an empty string if they're
+// equivalent. Basic blocks are renumbered in order of appearance in each function, virtual registers in order of
+// appearance in each basic block, such as %1.0 for the first of block1, and strings are referred to by their value,
+// such that only changed instructions are shown. Functions are matched by name, and the basic blocks of functions in
+// both modules by their number. Removed lines are prefixed by -, and added lines by +.
+func DiffIR(old, new string) string {
+	a, b := parseIR(old), parseIR(new)
+	sb := strings.Builder{}
+	changed, added, removed := 0, 0, 0
+
+	// Global variables and strings.
+	for _, e1 := range diffLines(a.globals, b.globals) {
+		if e1[0] != ' ' {
+			sb.WriteString(e1)
+			sb.WriteRune('\n')
+		}
+	}
+
+	// Functions of the old module, followed by the functions added by the new.
+	funcs := make(map[string]*irFunction, len(b.funcs))
+	for _, e1 := range b.funcs {
+		funcs[e1.name] = e1
+	}
+	for _, e1 := range a.funcs {
+		f, ok := funcs[e1.name]
+		if !ok {
+			sb.WriteString(fmt.Sprintf("-%s\n", e1.sig))
+			removed++
+			continue
+		}
+		delete(funcs, e1.name)
+		if d := diffFunction(e1, f); len(d) > 0 {
+			sb.WriteString(d)
+			changed++
+		}
+	}
+	for _, e1 := range b.funcs {
+		if _, ok := funcs[e1.name]; ok {
+			sb.WriteString(fmt.Sprintf("+%s\n", e1.sig))
+			added++
+		}
+	}
+	if sb.Len() == 0 {
+		return ""
+	}
+	sb.WriteString(fmt.Sprintf("%d functions changed, %d added, %d removed\n", changed, added, removed))
+	return sb.String()
+}
+
+// diffFunction returns the difference between the functions a and b, which have the same name. Basic blocks are
+// matched by their renumbered labels, and the changed lines are preceded by the label of their basic block.
+func diffFunction(a, b *irFunction) string {
+	sb := strings.Builder{}
+	if a.sig != b.sig {
+		sb.WriteString(fmt.Sprintf("-%s\n+%s\n", a.sig, b.sig))
+	}
+	blocks := make(map[string]irBlock, len(b.blocks))
+	for _, e1 := range b.blocks {
+		blocks[e1.label] = e1
+	}
+	diff := func(a, b irBlock, prefix string) {
+		shown := false
+		for _, e1 := range diffLines(a.lines, b.lines) {
+			if e1[0] == ' ' {
+				continue
+			}
+			if !shown && len(a.label)+len(b.label) > 0 {
+				sb.WriteString(prefix + a.label + b.label + ":\n")
+			}
+			shown = true
+			sb.WriteString(e1)
+			sb.WriteRune('\n')
+		}
+	}
+	for _, e1 := range a.blocks {
+		if e2, ok := blocks[e1.label]; ok {
+			diff(e1, irBlock{lines: e2.lines}, " ")
+			delete(blocks, e1.label)
+		} else {
+			diff(e1, irBlock{}, "-")
+		}
+	}
+	for _, e1 := range b.blocks {
+		if _, ok := blocks[e1.label]; ok {
+			diff(irBlock{}, e1, "+")
+		}
+	}
+	if sb.Len() == 0 {
+		return ""
+	}
+	if a.sig == b.sig {
+		return fmt.Sprintf(" %s\n%s", a.sig, sb.String())
+	}
+	return sb.String()
+}
+
+// diffLines returns the lines of a and b prefixed by a space if they're in both, - if they're only in a, and + if
+// they're only in b, in the order of a and b. The lines in both are the longest common subsequence of a and b.
+func diffLines(a, b []string) []string {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i1 := range lcs {
+		lcs[i1] = make([]int, len(b)+1)
+	}
+	for i1 := len(a) - 1; i1 >= 0; i1-- {
+		for i2 := len(b) - 1; i2 >= 0; i2-- {
+			if a[i1] == b[i2] {
+				lcs[i1][i2] = lcs[i1+1][i2+1] + 1
+			} else if lcs[i1+1][i2] >= lcs[i1][i2+1] {
+				lcs[i1][i2] = lcs[i1+1][i2]
+			} else {
+				lcs[i1][i2] = lcs[i1][i2+1]
+			}
+		}
+	}
+
+	res := make([]string, 0, len(a)+len(b))
+	i1, i2 := 0, 0
+	for i1 < len(a) || i2 < len(b) {
+		switch {
+		case i1 < len(a) && i2 < len(b) && a[i1] == b[i2]:
+			res = append(res, " "+a[i1])
+			i1++
+			i2++
+		case i2 == len(b) || i1 < len(a) && lcs[i1+1][i2] >= lcs[i1][i2+1]:
+			res = append(res, "-"+a[i1])
+			i1++
+		default:
+			res = append(res, "+"+b[i2])
+			i2++
+		}
+	}
+	return res
+}
+
+// parseIR splits the textual LIR s, as written by the String method of lir.Module, into its global declarations and
+// functions. Strings are replaced by their value, and the virtual registers and basic blocks of every function are
+// renumbered, see DiffIR.
+func parseIR(s string) irModule {
+	lines := strings.Split(s, "\n")
+	strs := map[string]string{}
+	for _, e1 := range lines {
+		if m := irString.FindStringSubmatch(e1); m != nil {
+			strs[m[1]] = m[2]
+		}
+	}
+	str := func(s string) string {
+		return irStringId.ReplaceAllStringFunc(s, func(id string) string {
+			if v, ok := strs[id]; ok {
+				return v
+			}
+			return id
+		})
+	}
+
+	res := irModule{}
+	var f *irFunction
+	var values, blocks map[string]string
+	block, n := "", 0 // The number of the current basic block, and of the values that first appear in it.
+	for _, e1 := range lines {
+		switch {
+		case f != nil && e1 == "}":
+			f = nil
+		case f != nil:
+			e1 = irLabel.ReplaceAllStringFunc(e1, func(b string) string {
+				if _, ok := blocks[b]; !ok {
+					blocks[b] = fmt.Sprintf("block%d", len(blocks))
+				}
+				return blocks[b]
+			})
+			if strings.HasSuffix(e1, ":") {
+				f.blocks = append(f.blocks, irBlock{label: strings.TrimSuffix(e1, ":")})
+				block, n = strings.TrimPrefix(f.blocks[len(f.blocks)-1].label, "block")+".", 0
+				break
+			}
+			e1 = irValue.ReplaceAllStringFunc(e1, func(v string) string {
+				if _, ok := values[v]; !ok {
+					values[v] = fmt.Sprintf("%%%s%d", block, n)
+					n++
+				}
+				return values[v]
+			})
+			f.blocks[len(f.blocks)-1].lines = append(f.blocks[len(f.blocks)-1].lines, str(e1))
+		case strings.HasPrefix(e1, "function "):
+			name := strings.SplitN(strings.TrimPrefix(e1, "function "), "(", 2)[0]
+			res.funcs = append(res.funcs, &irFunction{name: name, sig: strings.TrimSuffix(e1, " {"), blocks: []irBlock{{}}})
+			if strings.HasSuffix(e1, "{") {
+				f = res.funcs[len(res.funcs)-1]
+				values, blocks, block, n = map[string]string{}, map[string]string{}, "", 0
+			}
+		case irString.MatchString(e1):
+			res.globals = append(res.globals, "string "+irString.FindStringSubmatch(e1)[2])
+		case len(strings.TrimSpace(e1)) > 0 && !strings.HasPrefix(e1, "module: "):
+			res.globals = append(res.globals, str(e1))
+		}
+	}
+	return res
+}
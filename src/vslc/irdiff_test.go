@@ -0,0 +1,52 @@
+package vslc
+
+import (
+	"testing"
+	"vslc/src/util"
+)
+
+// TestDiffIR verifies that LIR modules that only differ in the numbering of values, blocks and strings are
+// equivalent, and that changed instructions are shown with their function and basic block.
+func TestDiffIR(t *testing.T) {
+	old := "def f() int\nbegin\n\tprint \"a\", g(1)\n\treturn 0\nend\n" +
+		"def g(a int) int\nbegin\n\tif a > 2 then\n\t\treturn a * 2\n\treturn a\nend\n"
+	exp := []struct {
+		src  string
+		diff string
+	}{
+		{
+			// Strings, blocks and values are numbered after the new function h.
+			src:  "def h() int\nbegin\n\tprint \"b\"\n\treturn 1\nend\n" + old,
+			diff: "+string \"b\"\n+string \"b\\n\"\n+function h(): Int\n0 functions changed, 1 added, 0 removed\n",
+		},
+		{
+			src: "def f() int\nbegin\n\tprint \"a\", g(1)\n\treturn 0\nend\n" +
+				"def g(a int) int\nbegin\n\tif a > 2 then\n\t\treturn a * 3\n\treturn a\nend\n",
+			diff: " function g(a: Int): Int\n block1:\n-\tret %1.2\n+\t%1.3 = load a\n+\t%1.4 = add %1.2, %1.3\n" +
+				"+\tret %1.4\n1 functions changed, 0 added, 0 removed\n",
+		},
+	}
+	a, diags := Compile(old, Options{Threads: 1, Emit: []util.Artifact{{Kind: util.EmitLIR}}})
+	if len(diags) > 0 {
+		t.Fatal(diags)
+	}
+	if d := DiffIR(a.LIR, a.LIR); len(d) > 0 {
+		t.Errorf("expected no difference, got:\n%s", d)
+	}
+	for _, e1 := range exp {
+		b, diags := Compile(e1.src, Options{Threads: 1, Emit: []util.Artifact{{Kind: util.EmitLIR}}})
+		if len(diags) > 0 {
+			t.Fatal(diags)
+		}
+		if d := DiffIR(a.LIR, b.LIR); d != e1.diff {
+			t.Errorf("expected difference:\n%s\ngot:\n%s", e1.diff, d)
+		}
+	}
+
+	// Removed functions and strings.
+	b, _ := Compile(exp[0].src, Options{Threads: 1, Emit: []util.Artifact{{Kind: util.EmitLIR}}})
+	diff := "-string \"b\"\n-string \"b\\n\"\n-function h(): Int\n0 functions changed, 0 added, 1 removed\n"
+	if d := DiffIR(b.LIR, a.LIR); d != diff {
+		t.Errorf("expected difference:\n%s\ngot:\n%s", diff, d)
+	}
+}
@@ -0,0 +1,108 @@
+// pipeline.go provides the pipelined compiler stages, which stream the functions of a program through optimisation,
+// LIR generation and register allocation, such that the later stages start while the earlier stages are still working.
+
+package vslc
+
+import (
+	"errors"
+	"vslc/src/backend"
+	lir2 "vslc/src/backend/lir"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Pipelined returns true if the compiler stages from optimisation to register allocation are run by Pipeline for opt,
+// which requires more than one worker thread, and that no artifact or flag of opt needs the syntax tree or LIR of the
+// whole program in between the stages.
+func Pipelined(opt Options) bool {
+	if opt.Threads < 2 || opt.NoPipeline || opt.Run || opt.SyntaxOnly || opt.LLVM || opt.Log.Logging(util.LogDebug) {
+		return false
+	}
+	for _, e1 := range opt.Artifacts() {
+		if e1.Kind != util.EmitTokens && e1.Kind != util.EmitAsm && e1.Kind != util.EmitObj {
+			return false
+		}
+	}
+	return true
+}
+
+// Pipeline optimises the parsed syntax tree root, generates its LIR, lowers it and allocates its registers for the
+// target of opt, like the optimise, lir and regalloc stages run one after another, and returns the LIR Module ready
+// for code generation. Rather than running every stage for all functions before the next stage starts, the functions
+// stream through the stages: the LIR of a function is generated as soon as it's optimised, and its registers are
+// allocated as soon as its LIR is lowered. Only the declarations of the global variables and functions, which the
+// function bodies refer to, are generated before the stages start, and the registers of the routines declared while
+// lowering are allocated after the stages complete. An error is returned like by the stages run one after another,
+// with the exit code of vslc for its category.
+func Pipeline(opt Options, root *ir.Node) (*lir.Module, int, error) {
+	target, err := backend.Lookup(opt.TargetArch)
+	if err != nil {
+		return nil, util.ExitUsage, err
+	}
+
+	st := opt.Timing.StartStage("optimise")
+	globals, err := ir.OptimiseHeaders(opt, root)
+	st.Stop()
+	if err != nil {
+		return nil, util.ExitType, err
+	}
+	st = opt.Timing.StartStage("lir")
+	m, funcs, declErr := lir.GenHeaders(opt, root)
+	st.Stop()
+	jobs := make([]int, 0, len(globals)) // jobs holds the indices of the functions of globals.
+	for i1, e1 := range globals {
+		if e1.Typ == ir.FUNCTION {
+			jobs = append(jobs, i1)
+		}
+	}
+
+	// Function bodies are optimised before the functions are declared in the stages run one after another, hence
+	// their errors precede the error of the declarations.
+	stages := []util.PipeStage{{Name: "optimise", Job: func(w *util.Worker, i int) error {
+		return ir.OptimiseBody(opt, globals[jobs[i]])
+	}}}
+	var a *lir2.Allocator
+	if declErr == nil {
+		if a, err = lir2.NewAllocator(opt, m); err != nil {
+			return nil, util.ExitUsage, err
+		}
+		stages = append(stages, util.PipeStage{Name: "lir", Job: func(w *util.Worker, i int) error {
+			f := funcs[jobs[i]]
+			w.Log.Infof("generating function %s", f.Name())
+			if err := lir.GenFunction(opt, f, globals[jobs[i]]); err != nil {
+				return err
+			}
+			lir.Lower(opt, f, target.Select())
+			return nil
+		}}, util.PipeStage{Name: "regalloc", Job: func(w *util.Worker, i int) error {
+			w.Log.Infof("allocating registers of function %s", funcs[jobs[i]].Name())
+			return a.Allocate(funcs[jobs[i]])
+		}})
+	}
+	if err := opt.NewPipeline(stages...).Run(opt.Context(), len(jobs)); err != nil {
+		var se *util.StageError
+		switch {
+		case !errors.As(err, &se):
+			return nil, util.ExitFailure, err // Cancelled.
+		case se.Stage == "regalloc":
+			return nil, util.ExitInternal, se.Err
+		}
+		return nil, util.ExitType, se.Err
+	}
+	if declErr != nil {
+		return nil, util.ExitType, declErr
+	}
+
+	st = opt.Timing.StartStage("regalloc")
+	err = a.Finish()
+	st.Stop()
+	if err != nil {
+		return nil, util.ExitInternal, err
+	}
+	return m, 0, nil
+}
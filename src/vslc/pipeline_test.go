@@ -0,0 +1,80 @@
+package vslc
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"vslc/src/util"
+)
+
+// moduleIDs matches the identifiers of the global LIR objects, such as labels of basic blocks and strings, which are
+// numbered in the order the objects are created.
+var moduleIDs = regexp.MustCompile(`\d{7,}`)
+
+// TestPipeline verifies that the pipelined compiler stages generate the same assembler as the stages run one after
+// another. The functions of the pipeline are generated concurrently, which numbers the global LIR objects and orders
+// the functions and data differently, hence the assembler is compared line by line, ignoring their order and numbers.
+func TestPipeline(t *testing.T) {
+	paths, err := filepath.Glob("../../resources/vsl_typed/*.vsl")
+	if err != nil || len(paths) == 0 {
+		t.Fatalf("no test programs found: %v", err)
+	}
+	targets := []Options{
+		{TargetArch: util.Aarch64},
+		{TargetArch: util.Riscv64, SSA: true},
+		{TargetArch: util.Riscv32, March: "rv32imac"},
+		{TargetArch: util.Armv7},
+	}
+	for _, e1 := range paths {
+		b, err := ioutil.ReadFile(e1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e2 := range targets {
+			e2.Threads = 1
+			seq, diags := Compile(string(b), e2)
+			if len(diags) > 0 {
+				t.Fatalf("%s: %v", e1, diags)
+			}
+			e2.Threads = 4
+			if !Pipelined(e2) {
+				t.Fatal("expected pipelined compilation")
+			}
+			res, diags := Compile(string(b), e2)
+			if len(diags) > 0 {
+				t.Fatalf("%s: %v", e1, diags)
+			}
+			if exp, got := asmLines(seq.Asm), asmLines(res.Asm); exp != got {
+				t.Errorf("%s, arch %d: pipelined assembler differs:\n%s", e1, e2.TargetArch, res.Asm)
+			}
+		}
+	}
+}
+
+// TestPipelineDiagnostics verifies that the pipelined compiler stages report the first error of the stages run one
+// after another.
+func TestPipelineDiagnostics(t *testing.T) {
+	srcs := []string{
+		"def f() int\nbegin\n\treturn y\nend\ndef g() int\nbegin\n\treturn 1 / 0\nend\n",
+		"def f() int\nbegin\n\treturn 1 / 0\nend\ndef f() int\nbegin\n\treturn 0\nend\n",
+		"var f int\ndef g() int\nbegin\n\treturn y\nend\ndef f() int\nbegin\n\treturn 0\nend\n",
+		"def f() int\nbegin\n\treturn y\nend\ndef g() int\nbegin\n\treturn z\nend\n",
+	}
+	for _, e1 := range srcs {
+		_, exp := Compile(e1, Options{Threads: 1})
+		_, diags := Compile(e1, Options{Threads: 4})
+		if len(exp) == 0 || len(diags) == 0 || diags[0] != exp[0] {
+			t.Errorf("expected diagnostics %+v, got %+v", exp, diags)
+		}
+	}
+}
+
+// asmLines returns the sorted lines of the assembler asm, whose identifiers of global LIR objects are removed.
+func asmLines(asm string) string {
+	lines := strings.Split(moduleIDs.ReplaceAllString(asm, "N"), "\n")
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,149 @@
+// repl.go provides the sessions of the interactive REPL, which compile function definitions, global variables and
+// statements incrementally and interpret them, such that the language is explored without writing source files.
+
+package vslc
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"vslc/src/frontend"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Session is the state of the REPL: the functions, global variables and statements entered so far. Statements are
+// run by an entry function, which is run anew with every statement entered, such that the global variables assigned
+// by earlier statements keep their values. The zero Session is empty and ready to use.
+type Session struct {
+	Opt     Options  // Opt configures the compilations of the Session. Run and Emit are ignored.
+	globals []string // globals are the declarations of global variables, in order of entry.
+	funcs   []string // funcs are the function definitions, in order of entry. A redefined function is replaced.
+	stmts   []string // stmts are the statements run by the entry function, in order of entry.
+	out     int      // out is the length of the output of the statements run so far.
+}
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ErrIncomplete is returned by Eval when the input ends before the definition or statement does, such as after
+// `while a < 10 do` or an unterminated block. The caller continues reading the input on the next line.
+var ErrIncomplete = errors.New("incomplete input")
+
+// replEntry is the name of the entry function which runs the statements of a Session.
+const replEntry = "repl"
+
+// Patterns of the function definitions and global variable declarations entered, which aren't statements.
+var (
+	funcName = regexp.MustCompile(`^def\s+(\w+)`)
+	varDecl  = regexp.MustCompile(`^var\s`)
+)
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// Eval compiles and interprets input, which is one function definition, global variable declaration or statement, and
+// returns the output it printed. An expression is printed. A function that is defined again replaces the previous
+// definition. If input can't be compiled, the Session is left unchanged and a Diagnostic is returned, whose line is
+// relative to input. ErrIncomplete is returned if input is the beginning of a definition or statement.
+func (s *Session) Eval(ctx context.Context, input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if len(input) == 0 {
+		return "", nil
+	}
+	t, shift := *s, 0 // shift is the length of the keyword prepended to the first line of input.
+	switch {
+	case funcName.MatchString(input), varDecl.MatchString(input):
+		if incomplete(input) {
+			return "", ErrIncomplete
+		}
+		if varDecl.MatchString(input) {
+			t.globals = append(s.globals[:len(s.globals):len(s.globals)], input)
+			break
+		}
+		name := funcName.FindStringSubmatch(input)[1]
+		t.funcs = make([]string, 0, len(s.funcs)+1)
+		for _, e1 := range s.funcs {
+			if funcName.FindStringSubmatch(e1)[1] != name {
+				t.funcs = append(t.funcs, e1)
+			}
+		}
+		t.funcs = append(t.funcs, input)
+	default:
+		// Expressions aren't statements, hence they're printed instead.
+		stmt := "def " + replEntry + "() int\n"
+		if incomplete(stmt + input) {
+			return "", ErrIncomplete
+		} else if _, err := frontend.Parse(stmt + input); err != nil {
+			if incomplete(stmt + "print " + input) {
+				return "", ErrIncomplete
+			} else if _, err := frontend.Parse(stmt + "print " + input); err == nil {
+				input, shift = "print "+input, len("print ")
+			}
+		}
+		t.stmts = append(s.stmts[:len(s.stmts):len(s.stmts)], input)
+	}
+
+	src, line := t.program(input)
+	opt := t.Opt
+	opt.Run, opt.Emit, opt.Args, opt.Ctx = true, nil, nil, ctx
+	res, diags := Compile(src, opt)
+	if len(diags) > 0 {
+		d := diags[0]
+		if n := strings.Count(input, "\n") + 1; d.Line > line && d.Line <= line+n {
+			d.Line -= line
+			if d.Line == 1 && d.Pos > shift {
+				d.Pos -= shift
+			}
+		} else {
+			d.Line, d.Pos = 0, 0
+		}
+		return "", d
+	}
+
+	// Definitions print nothing, though a redefined function may change the output of the statements run before.
+	out := ""
+	if len(t.stmts) > len(s.stmts) {
+		out = res.Output[t.out:]
+	}
+	t.out = len(res.Output)
+	*s = t
+	return out, nil
+}
+
+// Reset forgets the functions, global variables and statements of Session s.
+func (s *Session) Reset() {
+	*s = Session{Opt: s.Opt}
+}
+
+// program returns the VSL source code of Session s: its global variables, the entry function which runs its
+// statements, and its functions. The line preceding input in the source code is returned as well.
+func (s *Session) program(input string) (string, int) {
+	var lines []string
+	line := 0
+	add := func(src ...string) {
+		for _, e1 := range src {
+			if e1 == input {
+				line = len(lines)
+			}
+			lines = append(lines, strings.Split(e1, "\n")...)
+		}
+	}
+	add(s.globals...)
+	add("def "+replEntry+"() int", "begin")
+	add(s.stmts...)
+	add("return 0", "end")
+	add(s.funcs...)
+	return strings.Join(lines, "\n") + "\n", line
+}
+
+// incomplete returns true if VSL source code src ends before its last function definition or statement does.
+func incomplete(src string) bool {
+	_, err := frontend.Parse(src)
+	return err != nil && strings.HasSuffix(err.Error(), "unexpected $end")
+}
@@ -0,0 +1,50 @@
+package vslc
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestSession verifies that the REPL prints expressions, runs statements with the global variables assigned before,
+// replaces redefined functions, waits for incomplete input and leaves the Session unchanged on errors.
+func TestSession(t *testing.T) {
+	exp := []struct {
+		input string
+		out   string
+		err   string
+	}{
+		{input: "1 + 2 * 3", out: "7\n"},
+		{input: "def sq(x int) int", err: ErrIncomplete.Error()},
+		{input: "def sq(x int) int\nreturn x * x", out: ""},
+		{input: "sq(4)", out: "16\n"},
+		{input: "var n int", out: ""},
+		{input: "n := sq(3)", out: ""},
+		{input: "print \"n is\", n", out: "n is 9\n"},
+		{input: "def sq(x int) int\nreturn x * x * x", out: ""},
+		{input: "n", out: "27\n"},
+		{input: "while n > 25 do", err: ErrIncomplete.Error()},
+		{input: "while n > 25 do\nn := n - 1", out: ""},
+		{input: "n", out: "25\n"},
+		{input: "m + 1", err: "line 1:1: undeclared variable \"m\""},
+		{input: "n +", err: ErrIncomplete.Error()},
+		{input: "n", out: "25\n"},
+	}
+	s := Session{Opt: Options{Threads: 1}}
+	for _, e1 := range exp {
+		out, err := s.Eval(context.Background(), e1.input)
+		switch {
+		case err != nil && err.Error() != e1.err:
+			t.Errorf("%q: expected error %q, got %q", e1.input, e1.err, err)
+		case err == nil && len(e1.err) > 0:
+			t.Errorf("%q: expected error %q, got none", e1.input, e1.err)
+		case out != e1.out:
+			t.Errorf("%q: expected %q, got %q", e1.input, e1.out, out)
+		}
+	}
+
+	s.Reset()
+	if _, err := s.Eval(context.Background(), "n"); err == nil || errors.Is(err, ErrIncomplete) {
+		t.Errorf("expected undeclared variable after reset, got %v", err)
+	}
+}
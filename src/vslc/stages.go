@@ -0,0 +1,223 @@
+// stages.go provides the compiler stages from the parsed syntax tree to the target assembler, which are shared by
+// the vslc binary and Compile. Callers decide where the artifacts go through a Sink.
+
+package vslc
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"vslc/src/backend"
+	"vslc/src/backend/interp"
+	lir2 "vslc/src/backend/lir"
+	"vslc/src/backend/llvmir"
+	"vslc/src/ir"
+	"vslc/src/ir/lir"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Sink receives the artifacts of the compiler stages run by Build and Generate, and the output of interpreted
+// programs.
+type Sink struct {
+	// Emit writes the output of gen as the artifact of the given kind, if it's selected by opt. gen writes its output
+	// using Writers returned by the NewWriter method of the Options it's called with.
+	Emit func(opt Options, kind string, gen func(opt Options) error) error
+
+	// LLVM generates the program from the optimised syntax tree root with the LLVM framework if Options.LLVM is set,
+	// and emits the remaining artifacts. It's nil if the LLVM framework isn't linked.
+	LLVM func(opt Options, root *ir.Node) error
+
+	// Assemble assembles and links the target assembler written by gen, if artifacts beyond the assembler are
+	// selected. The assembler is emitted by Emit if Assemble is nil.
+	Assemble func(opt Options, gen func(opt Options) error) error
+
+	Stdout io.Writer // Stdout receives the output of the program interpreted if Options.Run is set.
+	Stderr io.Writer // Stderr receives the output to standard error of the program interpreted if Options.Run is set.
+}
+
+// ---------------------
+// ----- Functions -----
+// ---------------------
+
+// Validate returns an error if the target of opt doesn't support the configuration opt, such as an option of another
+// target, and opt selects an artifact generated by the target. It's a usage error, which is reported before any source
+// code is compiled, whereas errors of the target's code generation are internal compiler errors.
+func Validate(opt Options) error {
+	if opt.Run || opt.LLVM || opt.SyntaxOnly || opt.Compile {
+		return nil
+	}
+	if last := opt.LastStage(); last != util.EmitAsm && last != util.EmitObj {
+		return nil
+	}
+	return backend.Validate(opt)
+}
+
+// Build runs the compiler stages on the parsed syntax tree root until every artifact of opt has been emitted to s,
+// and returns the exit code of vslc for the category of the error, if any. The functions stream through the stages
+// up to register allocation if Pipelined returns true for opt. If opt.Run is set, the program is interpreted instead
+// of generated, and its exit code is returned. The configuration opt must have been validated by Validate.
+func Build(opt Options, root *ir.Node, s Sink) (int, error) {
+	last := opt.LastStage()
+
+	// Stream the functions from the optimiser to register allocation, unless an artifact needs the whole program in
+	// between the stages.
+	if Pipelined(opt) {
+		m, code, err := Pipeline(opt, root)
+		if err != nil {
+			return code, err
+		}
+		return codegen(opt, m, root, s)
+	}
+
+	// Optimise syntax tree.
+	st := opt.Timing.StartStage("optimise")
+	err := ir.Optimise(opt, root)
+	st.Stop()
+	if err != nil {
+		return util.ExitType, err
+	}
+	if opt.Log.Logging(util.LogDebug) {
+		sb := strings.Builder{}
+		root.Fprint(&sb, 0, true)
+		opt.Log.Dump("Syntax tree", sb.String())
+	}
+	if err := s.Emit(opt, util.EmitAST, func(opt Options) error {
+		sb := strings.Builder{}
+		root.Fprint(&sb, 0, true)
+		wr := opt.NewWriter()
+		wr.WriteString(sb.String())
+		wr.Close()
+		return nil
+	}); err != nil {
+		return util.ExitIO, err
+	}
+	if last == util.EmitAST && !opt.Run {
+		return 0, nil
+	}
+
+	// Stop if compilation was cancelled while optimising sequentially.
+	if err := opt.Context().Err(); err != nil {
+		return util.ExitFailure, err
+	}
+
+	// LLVM emits the remaining artifacts from the syntax tree.
+	if opt.LLVM {
+		if s.LLVM == nil {
+			return util.ExitUsage, errors.New("the LLVM framework isn't supported")
+		}
+		defer opt.Timing.StartStage("llvm").Stop()
+		if err := s.LLVM(opt, root); err != nil {
+			return util.ExitType, err
+		}
+		return 0, nil
+	}
+
+	// Generate LIR from the optimised syntax tree, and complete semantic analysis.
+	st = opt.Timing.StartStage("lir")
+	m, err := lir.GenLIR(opt, root)
+	if err != nil {
+		st.Stop()
+		return util.ExitType, err
+	}
+	if opt.SyntaxOnly {
+		st.Stop()
+		return 0, nil
+	}
+	lir.SimplifyCFG(opt, m)
+	if opt.SSA {
+		lir.Mem2Reg(opt, m)
+	}
+	st.Stop()
+	return Generate(opt, m, root, s)
+}
+
+// Generate emits the artifacts of opt that are produced from LIR Module m to s, and interprets the program if opt.Run
+// is set, or lowers m for the target, allocates its registers and generates its assembler otherwise. The first
+// function of the syntax tree root is the program's entry function. It returns the exit code of vslc for the category
+// of the error, if any, or the exit code of the interpreted program.
+func Generate(opt Options, m *lir.Module, root *ir.Node, s Sink) (int, error) {
+	last := opt.LastStage()
+	opt.Log.Infof("generated LIR module %s with %d functions", m.Name(), len(m.Functions()))
+	if opt.Log.Logging(util.LogDebug) {
+		opt.Log.Dump("LIR intermediate representation", m.String())
+	}
+	if err := s.Emit(opt, util.EmitLIR, func(opt Options) error {
+		wr := opt.NewWriter()
+		wr.WriteString(m.String())
+		wr.Close()
+		return nil
+	}); err != nil {
+		return util.ExitIO, err
+	}
+
+	// Interpret the program instead of generating it.
+	if opt.Run {
+		return interp.Run(opt.Context(), m, root, opt.Args, opt.Trapv, s.Stdout, s.Stderr)
+	}
+	if last == util.EmitLIR {
+		return 0, nil
+	}
+
+	if err := s.Emit(opt, util.EmitLLVMIR, func(opt Options) error {
+		defer opt.Timing.StartStage("llvm-ir").Stop()
+		return util.Internal(llvmir.GenLLVMIR(opt, m, root))
+	}); err != nil {
+		return util.ExitIO, err
+	}
+	if last == util.EmitLLVMIR {
+		return 0, nil
+	}
+
+	// Lower LIR for the target, like the functions are lowered by Pipeline.
+	target, err := backend.Lookup(opt.TargetArch)
+	if err != nil {
+		return util.ExitUsage, err
+	}
+	st := opt.Timing.StartStage("lir")
+	lir.LowerModule(opt, m, target.Select())
+	st.Stop()
+
+	// Stop if compilation was cancelled while generating LIR sequentially.
+	if err := opt.Context().Err(); err != nil {
+		return util.ExitFailure, err
+	}
+
+	// Allocate hardware registers to LIR virtual registers, if the target has any.
+	opt.Log.Infof("allocating registers and generating code for target %s", target.Name())
+	st = opt.Timing.StartStage("regalloc")
+	err = lir2.AllocateRegisters(opt, m)
+	st.Stop()
+	if err != nil {
+		return util.ExitInternal, err
+	}
+
+	// Stop if compilation was cancelled while allocating registers sequentially.
+	if err := opt.Context().Err(); err != nil {
+		return util.ExitFailure, err
+	}
+	return codegen(opt, m, root, s)
+}
+
+// codegen generates the assembler of LIR Module m, whose registers are allocated, and emits it to s, or passes it to
+// s.Assemble if artifacts beyond the assembler are selected by opt. The first function of the syntax tree root is the
+// program's entry function.
+func codegen(opt Options, m *lir.Module, root *ir.Node, s Sink) (int, error) {
+	gen := func(opt Options) error {
+		defer opt.Timing.StartStage("codegen").Stop()
+		return util.Internal(backend.GenerateAssembler(opt, m, root))
+	}
+	if opt.LastStage() == util.EmitAsm || s.Assemble == nil {
+		if err := s.Emit(opt, util.EmitAsm, gen); err != nil {
+			return util.ExitIO, err
+		}
+		return 0, nil
+	}
+	if err := s.Assemble(opt, gen); err != nil {
+		return util.ExitIO, err
+	}
+	return 0, nil
+}
@@ -0,0 +1,62 @@
+package vslc
+
+import (
+	"strings"
+	"testing"
+	"vslc/src/frontend"
+	"vslc/src/util"
+)
+
+// TestBuild verifies that Build passes the assembler to Sink.Assemble if an object file is selected, and emits it
+// otherwise, for both the pipelined stages and the stages run one after another, and that the LLVM framework is
+// rejected if the Sink doesn't provide it.
+func TestBuild(t *testing.T) {
+	src := "def f() int\nbegin\n\tprint 12 / 4\n\treturn 0\nend\n"
+	for _, e1 := range []int{1, 4} {
+		for _, e2 := range []string{util.EmitAsm, util.EmitObj} {
+			root, err := frontend.Parse(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var emitted, assembled []string
+			s := Sink{
+				Emit: func(opt Options, kind string, gen func(opt Options) error) error {
+					if _, ok := opt.Artifact(kind); ok {
+						emitted = append(emitted, kind)
+					}
+					return nil
+				},
+				Assemble: func(opt Options, gen func(opt Options) error) error {
+					sb := strings.Builder{}
+					opt.Dst = util.NewOutput(&sb)
+					if err := gen(opt); err != nil {
+						return err
+					}
+					assembled = append(assembled, sb.String())
+					return nil
+				},
+			}
+			opt := Options{Threads: e1, TargetArch: util.Aarch64, Emit: []util.Artifact{{Kind: e2}}}
+			if _, err := Build(opt, root, s); err != nil {
+				t.Fatal(err)
+			}
+			if e2 == util.EmitAsm && (len(emitted) != 1 || len(assembled) != 0) {
+				t.Errorf("threads=%d: expected assembler to be emitted, got %v and %d", e1, emitted, len(assembled))
+			}
+			if e2 == util.EmitObj && (len(emitted) != 0 || len(assembled) != 1 || !strings.Contains(assembled[0], "f:")) {
+				t.Errorf("threads=%d: expected assembler to be assembled, got %v and %q", e1, emitted, assembled)
+			}
+		}
+	}
+
+	root, err := frontend.Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := Sink{Emit: func(opt Options, kind string, gen func(opt Options) error) error {
+		return nil
+	}}
+	if code, err := Build(Options{Threads: 1, LLVM: true}, root, s); err == nil || code != util.ExitUsage {
+		t.Errorf("expected LLVM to be rejected, got %d: %v", code, err)
+	}
+}
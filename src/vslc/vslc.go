@@ -0,0 +1,185 @@
+// vslc.go provides the library API of the compiler, which compiles VSL source code to in-memory artifacts, such that
+// other Go programs and tests can compile programs without spawning the vslc binary.
+
+package vslc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	_ "vslc/src/backend/arm"
+	_ "vslc/src/backend/armv7"
+	_ "vslc/src/backend/riscv"
+	_ "vslc/src/backend/wasm"
+	"vslc/src/frontend"
+	"vslc/src/ir"
+	"vslc/src/util"
+)
+
+// ----------------------------
+// ----- Type definitions -----
+// ----------------------------
+
+// Options configures a compilation, like the command line flags of vslc. Artifacts selected by Emit are returned in
+// memory, and their output paths are ignored. Target assembler is returned if Emit is empty.
+type Options = util.Options
+
+// Artifacts holds the artifacts of a compilation, which are empty unless selected by the Options.
+type Artifacts struct {
+	Tokens   string // Tokens is the token stream of the source code.
+	AST      string // AST is the optimised syntax tree.
+	LIR      string // LIR is the LIR, before it's lowered for the target.
+	LLVMIR   string // LLVMIR is the textual LLVM IR generated from LIR.
+	Asm      string // Asm is the target assembler.
+	Output   string // Output is the output of the program interpreted if Options.Run is set.
+	Errors   string // Errors is the output to standard error of the program interpreted if Options.Run is set.
+	ExitCode int    // ExitCode is the exit code of the program interpreted if Options.Run is set.
+}
+
+// Diagnostic is an error reported by the compiler.
+type Diagnostic struct {
+	Line int    // Line is the source line of the error, or 0 if unknown.
+	Pos  int    // Pos is the position of the error on its line, or 0 if unknown.
+	Msg  string // Msg is the error message, without its location.
+	Code int    // Code is the exit code of vslc for the category of the error, such as util.ExitSyntax.
+}
+
+// -------------------
+// ----- globals -----
+// -------------------
+
+// ---------------------
+// ----- functions -----
+// ---------------------
+
+// Compile compiles the VSL source code src, and returns the artifacts selected by opt. The program is interpreted
+// instead if opt.Run is set. A Diagnostic is returned for every error reported by the compiler, in which case the
+// artifacts emitted before the error are returned. Compile doesn't read or write files, and artifacts that only exist
+// as files, such as object files and executables, and the LLVM framework aren't supported.
+func Compile(src string, opt Options) (*Artifacts, []Diagnostic) {
+	if opt.TargetArch == util.UnknownArch {
+		opt.TargetArch = util.Aarch64
+	}
+	res := &Artifacts{}
+	if code, err := compile(src, opt, res); err != nil {
+		return res, diagnose(code, err)
+	}
+	return res, nil
+}
+
+// compile runs the compiler stages on source code src until every artifact of opt has been stored in res. It returns
+// the exit code of vslc for the category of the error, if any. Panics are recovered as internal compiler errors.
+func compile(src string, opt Options, res *Artifacts) (code int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			code, err = util.ExitInternal, util.Recovered(r)
+		}
+	}()
+	switch {
+	case opt.LLVM:
+		return util.ExitUsage, errors.New("the LLVM framework isn't supported by Compile")
+	case opt.Link || opt.Compile:
+		return util.ExitUsage, errors.New("linking and LIR objects aren't supported by Compile")
+	}
+	for _, e1 := range opt.Emit {
+		if e1.Kind == util.EmitObj || e1.Kind == util.EmitLLVMBC {
+			return util.ExitUsage, fmt.Errorf("artifact %s isn't supported by Compile", e1.Kind)
+		}
+	}
+	if err := Validate(opt); err != nil {
+		return util.ExitUsage, err
+	}
+	if err := res.capture(opt, util.EmitTokens, func(opt Options) error {
+		return frontend.TokenStream(opt, src)
+	}); err != nil {
+		return util.ExitSyntax, fmt.Errorf("syntax error: %w", err)
+	}
+	if opt.LastStage() == util.EmitTokens && !opt.Run {
+		return 0, nil
+	}
+
+	// The syntax tree isn't returned, hence its Nodes are reused by the next compilation.
+	a := &ir.Arena{}
+	defer a.Free()
+	st := opt.Timing.StartStage("parse")
+	root, err := frontend.ParseParallel(opt, src, a)
+	st.Stop()
+	if err != nil {
+		return util.ExitSyntax, err
+	}
+
+	// An interpreted program's output and output to standard error are returned with its exit code.
+	sb, eb := strings.Builder{}, strings.Builder{}
+	code, err = Build(opt, root, Sink{Emit: res.capture, Stdout: &sb, Stderr: &eb})
+	if opt.Run {
+		res.Output, res.Errors = sb.String(), eb.String()
+		if err == nil {
+			res.ExitCode, code = code, 0
+		}
+	}
+	return code, err
+}
+
+// capture stores the output of gen in the field of res that holds the artifact of the given kind, if it's selected by
+// opt. gen writes its output using Writers returned by the NewWriter method of the Options it's called with.
+func (res *Artifacts) capture(opt Options, kind string, gen func(opt Options) error) error {
+	if _, ok := opt.Artifact(kind); !ok {
+		return nil
+	}
+	var s *string
+	switch kind {
+	case util.EmitTokens:
+		s = &res.Tokens
+	case util.EmitAST:
+		s = &res.AST
+	case util.EmitLIR:
+		s = &res.LIR
+	case util.EmitLLVMIR:
+		s = &res.LLVMIR
+	case util.EmitAsm:
+		s = &res.Asm
+	default:
+		return fmt.Errorf("artifact %s isn't supported by Compile", kind)
+	}
+	sb := strings.Builder{}
+	opt.Dst = util.NewOutput(&sb)
+	err := gen(opt)
+	*s = sb.String()
+	return err
+}
+
+// diagnose returns a Diagnostic for every error held by err, of category code unless the kind of the error tells the
+// category.
+func diagnose(code int, err error) []Diagnostic {
+	errs := []error{err}
+	var pe util.PoolError
+	if errors.As(err, &pe) {
+		errs = pe
+	}
+	res := make([]Diagnostic, len(errs))
+	for i1, e1 := range errs {
+		res[i1] = Diagnostic{Msg: e1.Error(), Code: code}
+		var ie *util.InternalError
+		switch {
+		case errors.As(e1, &ie):
+			res[i1].Code = util.ExitInternal
+		case errors.Is(e1, util.ErrSyntax):
+			res[i1].Code = util.ExitSyntax
+		case errors.Is(e1, util.ErrType):
+			res[i1].Code = util.ExitType
+		}
+		if pos, ok := util.PositionOf(e1); ok && pos.Line > 0 {
+			res[i1].Line, res[i1].Pos = pos.Line, pos.Pos
+			res[i1].Msg = strings.TrimPrefix(res[i1].Msg, pos.String()+": ")
+		}
+	}
+	return res
+}
+
+// Error returns the message of Diagnostic d, prefixed by its source location if known.
+func (d Diagnostic) Error() string {
+	if d.Line > 0 {
+		return fmt.Sprintf("line %d:%d: %s", d.Line, d.Pos, d.Msg)
+	}
+	return d.Msg
+}
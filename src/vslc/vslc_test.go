@@ -0,0 +1,336 @@
+package vslc
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"vslc/src/util"
+)
+
+// TestCompile verifies that the selected artifacts are returned in memory, and that programs are interpreted.
+func TestCompile(t *testing.T) {
+	src := "def f() int\nbegin\n\tprint \"x =\", g(7)\n\treturn 0\nend\ndef g(a int) int\nbegin\n\treturn a * 2\nend\n"
+	for _, e1 := range []int{1, 4} {
+		res, diags := Compile(src, Options{Threads: e1})
+		if len(diags) > 0 {
+			t.Fatal(diags)
+		}
+		if !strings.Contains(res.Asm, "f:") || !strings.Contains(res.Asm, "g:") || len(res.LIR) > 0 {
+			t.Errorf("threads=%d: expected assembler of f and g only, got:\n%s%s", e1, res.LIR, res.Asm)
+		}
+
+		opt := Options{Threads: e1, Emit: []util.Artifact{{Kind: util.EmitAST}, {Kind: util.EmitLIR}}}
+		res, diags = Compile(src, opt)
+		if len(diags) > 0 {
+			t.Fatal(diags)
+		}
+		if len(res.AST) == 0 || !strings.Contains(res.LIR, "g") || len(res.Asm) > 0 {
+			t.Errorf("threads=%d: expected syntax tree and LIR only, got:\n%s%s%s", e1, res.AST, res.LIR, res.Asm)
+		}
+
+		res, diags = Compile(src, Options{Threads: e1, Run: true})
+		if len(diags) > 0 {
+			t.Fatal(diags)
+		}
+		if res.Output != "x = 14\n" || res.ExitCode != 0 {
+			t.Errorf("threads=%d: expected output %q and exit code 0, got %q and %d", e1, "x = 14\n", res.Output,
+				res.ExitCode)
+		}
+	}
+}
+
+// TestCompilePrintf verifies that the format string of a printf statement is passed to printf with the length modifier
+// of 64-bit integers, and that print statements print string literals with percent signs as is.
+func TestCompilePrintf(t *testing.T) {
+	src := "def f(a int) int\nbegin\n\tprintf \"%d%% of %d is %f\", a, 10, a * 0.1\n\tprint \"%d\"\n" +
+		"\tprint \"50%\", a\n\treturn 0\nend\n"
+	res, diags := Compile(src, Options{Threads: 1, Run: true, Args: []string{"50"}})
+	if len(diags) > 0 {
+		t.Fatal(diags)
+	}
+	if exp := "50% of 10 is 5.000000\n%d\n50% 50\n"; res.Output != exp {
+		t.Errorf("expected output %q, got %q", exp, res.Output)
+	}
+	tests := []struct {
+		arch   int
+		format string
+	}{
+		{util.Aarch64, `"%lld%% of %lld is %f\n"`},
+		{util.Armv7, `"%d%% of %d is %f\n"`},
+		{util.Riscv64, `"%lld%% of %lld is %f\n"`},
+	}
+	for _, e1 := range tests {
+		res, diags = Compile(src, Options{Threads: 1, TargetArch: e1.arch})
+		if len(diags) > 0 {
+			t.Fatal(diags)
+		}
+		if !strings.Contains(res.Asm, e1.format) {
+			t.Errorf("arch %d: expected format string %s in assembler, got:\n%s", e1.arch, e1.format, res.Asm)
+		}
+	}
+}
+
+// TestCompileStderr verifies that eprint, eprintf and print statements redirected to file descriptor 2 are printed to
+// the standard error of the program, and that they call dprintf in assembler.
+func TestCompileStderr(t *testing.T) {
+	src := "def f(a int) int\nbegin\n\tprint \"out\", a\n\teprint \"err\", a\n\tprintf > 2 \"a = %d\", a\n" +
+		"\tprintf > 1 \"a = %d\", a\n\teprintf \"%d%%\", a\n\treturn 0\nend\n"
+	res, diags := Compile(src, Options{Threads: 1, Run: true, Args: []string{"5"}})
+	if len(diags) > 0 {
+		t.Fatal(diags)
+	}
+	if exp := "out 5\na = 5\n"; res.Output != exp {
+		t.Errorf("expected output %q, got %q", exp, res.Output)
+	}
+	if exp := "err 5\na = 5\n5%\n"; res.Errors != exp {
+		t.Errorf("expected errors %q, got %q", exp, res.Errors)
+	}
+	for _, e1 := range []int{util.Aarch64, util.Armv7, util.Riscv64} {
+		res, diags = Compile(src, Options{Threads: 1, TargetArch: e1})
+		if len(diags) > 0 {
+			t.Fatal(diags)
+		}
+		if strings.Count(res.Asm, "dprintf") != 3 {
+			t.Errorf("arch %d: expected 3 calls to dprintf in assembler, got:\n%s", e1, res.Asm)
+		}
+	}
+}
+
+// TestCompileArgs verifies that the builtin functions argc and arg read the program arguments of an entry function
+// without parameters, and that the runtime routines that implement them are defined in assembler.
+func TestCompileArgs(t *testing.T) {
+	src := "def f() int\nbegin\n\tvar x float\n\tx := arg(2)\n\tprint argc(), arg(1), x\n\treturn arg(9)\nend\n"
+	res, diags := Compile(src, Options{Threads: 1, Run: true, Args: []string{"-7", "0.5e1", "x"}})
+	if len(diags) > 0 {
+		t.Fatal(diags)
+	}
+	if exp := "3 -7 5.000000\n"; res.Output != exp || res.ExitCode != 0 {
+		t.Errorf("expected output %q and exit code 0, got %q and %d", exp, res.Output, res.ExitCode)
+	}
+	for _, e1 := range []int{util.Aarch64, util.Armv7, util.Riscv64} {
+		res, diags = Compile(src, Options{Threads: 1, TargetArch: e1})
+		if len(diags) > 0 {
+			t.Fatal(diags)
+		}
+		for _, e2 := range []string{"vsl_argc:", "vsl_args:", "vsl_argi:", "vsl_argf:"} {
+			if !strings.Contains(res.Asm, e2) {
+				t.Errorf("arch %d: expected %s in assembler, got:\n%s", e1, e2, res.Asm)
+			}
+		}
+	}
+}
+
+// TestCompileMainSaved verifies that the main function generated for aarch64 restores the callee-saved registers x19
+// and x20, which it writes while parsing and passing the program arguments, on every return.
+func TestCompileMainSaved(t *testing.T) {
+	src := "def f(a, b, c, d, e, g, h, i, j, k int) int\nbegin\n\treturn a + k\nend\n"
+	res, diags := Compile(src, Options{Threads: 1, TargetArch: util.Aarch64})
+	if len(diags) > 0 {
+		t.Fatal(diags)
+	}
+	main := res.Asm[strings.Index(res.Asm, "\nmain:"):]
+	main = main[:strings.Index(main, ".size\tmain")]
+	if !strings.Contains(main, "\tstp\tx19, x20, [sp, #0]\n") {
+		t.Errorf("expected x19 and x20 to be saved by main, got:\n%s", main)
+	}
+	if rets := strings.Count(main, "\tret\n"); rets != 3 || strings.Count(main, "\tldp\tx19, x20, [sp, #0]\n") != rets {
+		t.Errorf("expected x19 and x20 to be restored before each of 3 returns of main, got:\n%s", main)
+	}
+}
+
+// TestCompileLog verifies that verbose logging names the functions generated by the backends, and not the external
+// functions, such as printf, which are only declared.
+func TestCompileLog(t *testing.T) {
+	src := "def f(a int) int\nbegin\n\tprint a\n\treturn 0\nend\n"
+	for _, e1 := range []int{util.Aarch64, util.Riscv64, util.Wasm} {
+		buf := bytes.Buffer{}
+		_, diags := Compile(src, Options{Threads: 4, TargetArch: e1, Log: util.NewLogger(&buf, util.LogInfo)})
+		if len(diags) > 0 {
+			t.Fatal(diags)
+		}
+		if res := buf.String(); !strings.Contains(res, ": generating function f\n") ||
+			strings.Contains(res, "generating function printf") {
+			t.Errorf("arch=%d: expected function f to be generated, and printf not, got:\n%s", e1, res)
+		}
+	}
+}
+
+// TestCompileTrapv verifies that -ftrapv reports the overflow of an interpreted program, and that the native backends
+// that support it define the overflow handler.
+func TestCompileTrapv(t *testing.T) {
+	src := "def f(a, b int) int\nbegin\n\tprint a * b\n\treturn a + b\nend\n"
+	_, diags := Compile(src, Options{Threads: 1, Run: true, Trapv: true, Args: []string{"4611686018427387904", "2"}})
+	if len(diags) != 1 || !strings.HasSuffix(diags[0].Msg, "integer overflow") {
+		t.Errorf("expected integer overflow, got %v", diags)
+	}
+	for _, e1 := range []int{util.Aarch64, util.Riscv64, util.Riscv32} {
+		res, diags := Compile(src, Options{Threads: 1, Trapv: true, TargetArch: e1})
+		if len(diags) > 0 {
+			t.Fatal(diags)
+		}
+		if !strings.Contains(res.Asm, "vsl.overflow:") {
+			t.Errorf("arch %d: expected overflow handler in assembler, got:\n%s", e1, res.Asm)
+		}
+	}
+	for _, e1 := range []int{util.Armv7, util.Wasm} {
+		_, diags := Compile(src, Options{Threads: 1, Trapv: true, TargetArch: e1})
+		if len(diags) != 1 || diags[0].Code != util.ExitUsage {
+			t.Errorf("arch %d: expected -ftrapv to be rejected as a usage error, got %v", e1, diags)
+		}
+	}
+
+	// Only targets that generate code reject the option.
+	if _, diags := Compile(src, Options{Threads: 1, Trapv: true, TargetArch: util.Armv7,
+		Emit: []util.Artifact{{Kind: util.EmitLIR}}}); len(diags) > 0 {
+		t.Errorf("expected LIR to be emitted with -ftrapv for ARMv7, got %v", diags)
+	}
+}
+
+// TestCompileIntWidth verifies that -m32 wraps interpreted integers to 32 bits, that the backends compute in 32 bits,
+// and that integer literals and targets that don't fit the width are rejected.
+func TestCompileIntWidth(t *testing.T) {
+	src := "def f(a, b int) int\nbegin\n\tprint a + b\n\treturn a * b\nend\n"
+	res, diags := Compile(src, Options{Threads: 1, Run: true, IntWidth: 32, Args: []string{"2147483647", "1"}})
+	if len(diags) > 0 {
+		t.Fatal(diags)
+	}
+	if exp := "-2147483648\n"; res.Output != exp {
+		t.Errorf("expected output %q, got %q", exp, res.Output)
+	}
+	for _, e1 := range []struct {
+		arch int
+		exp  string
+	}{{util.Aarch64, "sxtw"}, {util.Riscv64, "addw"}, {util.Wasm, "i32.add"}} {
+		res, diags := Compile(src, Options{Threads: 1, IntWidth: 32, TargetArch: e1.arch})
+		if len(diags) > 0 {
+			t.Fatal(diags)
+		}
+		if !strings.Contains(res.Asm, e1.exp) {
+			t.Errorf("arch %d: expected %s in assembler, got:\n%s", e1.arch, e1.exp, res.Asm)
+		}
+	}
+	if _, diags := Compile(src, Options{Threads: 1, IntWidth: 64, TargetArch: util.Armv7}); len(diags) != 1 ||
+		diags[0].Code != util.ExitUsage {
+		t.Errorf("expected -m64 to be rejected for ARMv7 as a usage error, got %v", diags)
+	}
+	big := "def f() int\nbegin\n\treturn 2147483648\nend\n"
+	if _, diags := Compile(big, Options{Threads: 1, IntWidth: 32}); len(diags) != 1 {
+		t.Errorf("expected integer literal to overflow 32-bit integers, got %v", diags)
+	}
+}
+
+// TestCompileDiagnostics verifies the location and category of the errors reported by Compile.
+func TestCompileDiagnostics(t *testing.T) {
+	exp := []struct {
+		src  string
+		diag Diagnostic
+	}{
+		{
+			src:  "def f() int\nbegin\n\treturn 1 / 0\nend\n",
+			diag: Diagnostic{Line: 3, Pos: 13, Msg: "expression 1 / 0 not allowed: cannot divide by zero", Code: util.ExitType},
+		},
+		{
+			src:  "def f() int\nbegin\n\treturn y\nend\n",
+			diag: Diagnostic{Line: 3, Pos: 9, Msg: "undeclared variable \"y\"", Code: util.ExitType},
+		},
+		{
+			src:  "def f( int\n",
+			diag: Diagnostic{Line: 1, Pos: 8, Msg: "syntax error: unexpected TYPE, expecting ',' or ')'", Code: util.ExitSyntax},
+		},
+		{
+			src:  "def f() int\nbegin\n\treturn 0\nend\n",
+			diag: Diagnostic{Msg: "the LLVM framework isn't supported by Compile", Code: util.ExitUsage},
+		},
+		{
+			src: "def f(x float) int\nbegin\n\tprintf \"x = %d\", x\n\treturn 0\nend\n",
+			diag: Diagnostic{Line: 3, Pos: 19, Msg: "argument 1 of format string \"x = %d\" is float, expected %d",
+				Code: util.ExitType},
+		},
+		{
+			src:  "def f() int\nbegin\n\tprintf \"%d %d\", 1\n\treturn 0\nend\n",
+			diag: Diagnostic{Line: 3, Pos: 2, Msg: "format string \"%d %d\" expects 2 arguments, got 1", Code: util.ExitType},
+		},
+		{
+			src:  "def f() int\nbegin\n\treturn arg()\nend\n",
+			diag: Diagnostic{Line: 3, Pos: 9, Msg: "function \"arg\" expects 1 parameter, got 0", Code: util.ExitType},
+		},
+		{
+			src:  "def f() int\nbegin\n\tprintf 1, 2\n\treturn 0\nend\n",
+			diag: Diagnostic{Line: 3, Pos: 9, Msg: "expected string literal as format string of printf", Code: util.ExitType},
+		},
+	}
+	for i1, e1 := range exp {
+		_, diags := Compile(e1.src, Options{Threads: 1, LLVM: i1 == 3})
+		if len(diags) != 1 || diags[0] != e1.diag {
+			t.Errorf("expected diagnostic %+v, got %+v", e1.diag, diags)
+		}
+	}
+}
+
+// TestCompileConcurrent verifies that concurrent compilations don't share their output.
+func TestCompileConcurrent(t *testing.T) {
+	srcs := make([]string, 8)
+	for i1 := range srcs {
+		srcs[i1] = fmt.Sprintf("def f%d() int\nbegin\n\tprint %d\n\treturn 0\nend\n", i1, i1)
+	}
+	res := make([]*Artifacts, len(srcs))
+	wg := sync.WaitGroup{}
+	wg.Add(len(srcs))
+	for i1 := range srcs {
+		go func(i int) {
+			defer wg.Done()
+			opt := Options{Threads: 2, Emit: []util.Artifact{{Kind: util.EmitLIR}, {Kind: util.EmitAsm}}}
+			res[i], _ = Compile(srcs[i], opt)
+		}(i1)
+	}
+	wg.Wait()
+	for i1, e1 := range res {
+		for i2 := range res {
+			name := fmt.Sprintf("f%d", i2)
+			if (i1 == i2) != (strings.Contains(e1.LIR, name) && strings.Contains(e1.Asm, name+":")) {
+				t.Errorf("compilation %d: unexpected output of %s:\n%s%s", i1, name, e1.LIR, e1.Asm)
+			}
+		}
+	}
+}
+
+// TestCompileConcurrentTargets verifies that concurrent compilations for different targets, integer widths and
+// overflow checks generate the same assembler as sequential ones, as the backends keep no state between compilations.
+func TestCompileConcurrentTargets(t *testing.T) {
+	src := "def f(a int) int\nbegin\n\tprint a + 1, a * 3, a << 40\n\treturn 0\nend\n"
+	opts := make([]Options, 0, 12)
+	for _, e1 := range []int{util.Aarch64, util.Riscv64, util.Riscv32} {
+		for _, e2 := range []int{0, 32} {
+			for _, e3 := range []bool{false, true} {
+				opts = append(opts, Options{Threads: 1, TargetArch: e1, IntWidth: e2, Trapv: e3})
+			}
+		}
+	}
+	exp := make([]string, len(opts))
+	for i1, e1 := range opts {
+		res, diags := Compile(src, e1)
+		if len(diags) > 0 {
+			t.Fatalf("target %d, %d-bit, trapv %t: %v", e1.TargetArch, e1.IntBits(), e1.Trapv, diags)
+		}
+		exp[i1] = res.Asm
+	}
+
+	res := make([]*Artifacts, len(opts))
+	wg := sync.WaitGroup{}
+	wg.Add(len(opts))
+	for i1 := range opts {
+		go func(i int) {
+			defer wg.Done()
+			res[i], _ = Compile(src, opts[i])
+		}(i1)
+	}
+	wg.Wait()
+	for i1, e1 := range res {
+		if e1 == nil || e1.Asm != exp[i1] {
+			t.Errorf("target %d, %d-bit, trapv %t: concurrent compilation differs from sequential one",
+				opts[i1].TargetArch, opts[i1].IntBits(), opts[i1].Trapv)
+		}
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"strings"
 	"testing"
 	"vslc/src/backend"
 	lir2 "vslc/src/backend/lir"
@@ -14,6 +13,7 @@ import (
 	"vslc/src/ir/lir"
 	"vslc/src/ir/llvm"
 	"vslc/src/util"
+	"vslc/src/vslc"
 )
 
 // -----------------------------
@@ -67,6 +67,8 @@ func BenchmarkAarch64(b *testing.B) {
 	opt := util.Options{
 		Threads:    1,
 		TargetArch: util.Aarch64,
+		OutDir:     dstp,
+		Dst:        util.NewOutput(ioutil.Discard), // Don't write gigabytes of assembler to disk.
 	}
 
 	benchmarks := make([]benchType, len(files))
@@ -74,7 +76,7 @@ func BenchmarkAarch64(b *testing.B) {
 		benchmarks[i1] = benchType{
 			name: e1.Name(),
 			src:  src[i1],
-			out:  filepath.Join(dstp, strings.Split(files[i1].Name(), ".")[0]+".s"),
+			out:  helperOutput(opt, e1.Name()),
 		}
 	}
 
@@ -87,11 +89,9 @@ func BenchmarkAarch64(b *testing.B) {
 			opt.Threads = i2
 			b.Run(fmt.Sprintf("%s-threads=%d", e1.name, i2), func(b *testing.B) {
 				for n := 0; n < b.N; n++ {
-					util.ListenWriteBench(opt)
 					if err := benchRun(e1.src, opt); err != nil {
 						b.Fatalf("Compiler error: %s\n", err)
 					}
-					util.Close()
 				}
 			})
 		}
@@ -114,6 +114,7 @@ func BenchmarkASTOptimisation(b *testing.B) {
 	// The benchmark relies solely on maximum thread count.
 	opt := util.Options{
 		Threads: 1, // Re-configured in benchmark inner loop.
+		OutDir:  dstPath,
 	}
 
 	benchmarks := make([]benchType, len(files))
@@ -121,7 +122,7 @@ func BenchmarkASTOptimisation(b *testing.B) {
 		benchmarks[i1] = benchType{
 			name: e1.Name(),
 			src:  src[i1],
-			out:  filepath.Join(dstPath, strings.Split(files[i1].Name(), ".")[0]+".s"),
+			out:  helperOutput(opt, e1.Name()),
 		}
 	}
 
@@ -134,10 +135,11 @@ func BenchmarkASTOptimisation(b *testing.B) {
 			opt.Threads = i2
 			b.Run(fmt.Sprintf("%s-threads=%d", e1.name, i2), func(b *testing.B) {
 				for n := 0; n < b.N; n++ {
-					if err := frontend.Parse(e1.src); err != nil {
+					root, err := frontend.Parse(e1.src)
+					if err != nil {
 						b.Fatalf("Could not parse syntax tree: %s\n", err)
 					}
-					if err := ir.Optimise(opt); err != nil {
+					if err := ir.Optimise(opt, root); err != nil {
 						b.Fatalf("Could not optimise syntax tree: %s\n", err)
 					}
 				}
@@ -160,6 +162,7 @@ func BenchmarkLIRGeneration(b *testing.B) {
 	// The benchmark relies solely on maximum thread count.
 	opt := util.Options{
 		Threads: 1, // Re-configured in benchmark inner loop.
+		OutDir:  dstPath,
 	}
 
 	benchmarks := make([]benchType, len(files))
@@ -167,7 +170,7 @@ func BenchmarkLIRGeneration(b *testing.B) {
 		benchmarks[i1] = benchType{
 			name: e1.Name(),
 			src:  src[i1],
-			out:  filepath.Join(dstPath, strings.Split(files[i1].Name(), ".")[0]+".s"),
+			out:  helperOutput(opt, e1.Name()),
 		}
 	}
 
@@ -178,15 +181,16 @@ func BenchmarkLIRGeneration(b *testing.B) {
 		// Test for 1 to q parallel worker go routines.
 		for i2 := p; i2 <= q; i2++ {
 			opt.Threads = i2
-			if err := frontend.Parse(e1.src); err != nil {
+			root, err := frontend.Parse(e1.src)
+			if err != nil {
 				b.Fatalf("Could not parse syntax tree: %s\n", err)
 			}
-			if err := ir.Optimise(opt); err != nil {
+			if err := ir.Optimise(opt, root); err != nil {
 				b.Fatalf("Could not optimise syntax tree: %s\n", err)
 			}
 			b.Run(fmt.Sprintf("%s-threads=%d", e1.name, i2), func(b *testing.B) {
 				for n := 0; n < b.N; n++ {
-					if _, err := lir.GenLIR(opt, ir.Root); err != nil {
+					if _, err := lir.GenLIR(opt, root); err != nil {
 						b.Fatalf("Could not generate LIR: %s\n", err)
 					}
 				}
@@ -211,6 +215,7 @@ func BenchmarkRegisterAllocation(b *testing.B) {
 	opt := util.Options{
 		Threads:    1, // Re-configured in benchmark inner loop.
 		TargetArch: util.Aarch64,
+		OutDir:     dstPath,
 	}
 
 	benchmarks := make([]benchType, len(files))
@@ -218,7 +223,7 @@ func BenchmarkRegisterAllocation(b *testing.B) {
 		benchmarks[i1] = benchType{
 			name: e1.Name(),
 			src:  src[i1],
-			out:  filepath.Join(dstPath, strings.Split(files[i1].Name(), ".")[0]+".s"),
+			out:  helperOutput(opt, e1.Name()),
 		}
 	}
 
@@ -229,13 +234,14 @@ func BenchmarkRegisterAllocation(b *testing.B) {
 		// Test for 1 to q parallel worker go routines.
 		for i2 := p; i2 <= q; i2++ {
 			opt.Threads = i2
-			if err := frontend.Parse(e1.src); err != nil {
+			root, err := frontend.Parse(e1.src)
+			if err != nil {
 				b.Fatalf("Could not parse syntax tree: %s\n", err)
 			}
-			if err := ir.Optimise(opt); err != nil {
+			if err := ir.Optimise(opt, root); err != nil {
 				b.Fatalf("Could not optimise syntax tree: %s\n", err)
 			}
-			m, err := lir.GenLIR(opt, ir.Root)
+			m, err := lir.GenLIR(opt, root)
 			if err != nil {
 				b.Fatalf("Could not generate LIR: %s\n", err)
 			}
@@ -266,6 +272,8 @@ func BenchmarkAssemblerGeneration(b *testing.B) {
 	opt := util.Options{
 		Threads:    1,
 		TargetArch: util.Aarch64,
+		OutDir:     dstp,
+		Dst:        util.NewOutput(ioutil.Discard), // Don't write gigabytes of assembler to disk.
 	}
 
 	benchmarks := make([]benchType, len(files))
@@ -273,7 +281,7 @@ func BenchmarkAssemblerGeneration(b *testing.B) {
 		benchmarks[i1] = benchType{
 			name: e1.Name(),
 			src:  src[i1],
-			out:  filepath.Join(dstp, strings.Split(files[i1].Name(), ".")[0]+".s"),
+			out:  helperOutput(opt, e1.Name()),
 		}
 	}
 
@@ -284,13 +292,14 @@ func BenchmarkAssemblerGeneration(b *testing.B) {
 		// Test for 1 to q parallel worker go routines.
 		for i2 := p; i2 <= q; i2++ {
 			opt.Threads = i2
-			if err := frontend.Parse(e1.src); err != nil {
+			root, err := frontend.Parse(e1.src)
+			if err != nil {
 				b.Fatalf("Could not parse syntax tree: %s\n", err)
 			}
-			if err := ir.Optimise(opt); err != nil {
+			if err := ir.Optimise(opt, root); err != nil {
 				b.Fatalf("Could not optimise syntax tree: %s\n", err)
 			}
-			m, err := lir.GenLIR(opt, ir.Root)
+			m, err := lir.GenLIR(opt, root)
 			if err != nil {
 				b.Fatalf("Could not generate LIR: %s\n", err)
 			}
@@ -299,63 +308,33 @@ func BenchmarkAssemblerGeneration(b *testing.B) {
 			}
 			b.Run(fmt.Sprintf("%s-threads=%d", e1.name, i2), func(b *testing.B) {
 				for n := 0; n < b.N; n++ {
-					util.ListenWriteBench(opt)
-					if err := backend.GenerateAssembler(opt, m, ir.Root); err != nil {
+					if err := backend.GenerateAssembler(opt, m, root); err != nil {
 						b.Fatalf("Could not generate assembler: %s\n", err)
 					}
-					util.Close()
 				}
 			})
 		}
 	}
 }
 
-// benchRun runs the compiler, exactly like the run function, but without reading the source code.
+// benchRun runs the compiler, exactly like the run function, but without reading the source code. The assembler is
+// written to opt.Dst.
 func benchRun(src string, opt util.Options) error {
 	// Generate syntax tree by lexing and parsing source code.
-	if err := frontend.Parse(src); err != nil {
-		return fmt.Errorf("parse error: %s\n", err)
-	}
-
-	// Optimise syntax tree.
-	if err := ir.Optimise(opt); err != nil {
-		return fmt.Errorf("syntax tree error: %s\n", err)
-	}
-
-	if opt.Verbose {
-		fmt.Println("Syntax tree:")
-		ir.Root.Print(0, true)
-	}
-
-	// Gen LLVM and exit, if flag is passed.
-	if opt.LLVM {
-		if err := llvm.GenLLVM(opt, ir.Root); err != nil {
-			return fmt.Errorf("error reported by LLVM: %s", err)
-		}
-		return nil
-	}
-
-	// Generate SSA from optimised and validated parse tree.
-	m, err := lir.GenLIR(opt, ir.Root)
+	root, err := frontend.Parse(src)
 	if err != nil {
-		return err
-	}
-
-	if opt.Verbose {
-		fmt.Println("\nLIR intermediate representation:")
-		fmt.Println(m.String())
-	}
-
-	// Allocate hardware registers to LIR virtual registers.
-	if err := lir2.AllocateRegisters(opt, m); err != nil {
-		return err
-	}
-
-	// Generate assembler.
-	if err := backend.GenerateAssembler(opt, m, ir.Root); err != nil {
-		return err
+		return fmt.Errorf("parse error: %s\n", err)
 	}
-	return nil
+	_, err = vslc.Build(opt, root, vslc.Sink{
+		Emit: func(opt util.Options, kind string, gen func(opt util.Options) error) error {
+			if _, ok := opt.Artifact(kind); !ok {
+				return nil
+			}
+			return gen(opt)
+		},
+		LLVM: llvm.GenLLVM,
+	})
+	return err
 }
 
 // helperReadFiles reads all typed VSL source files into memory. This helper function is ignored by the test metric
@@ -377,11 +356,18 @@ func helperReadFiles(srcp string, b *testing.B) ([]string, []os.FileInfo) {
 	return src, files
 }
 
+// helperOutput returns the path of the assembler that the compiler configured by opt writes for the source file
+// named name to the output directory opt.OutDir.
+func helperOutput(opt util.Options, name string) string {
+	opt.Src = name
+	return opt.Output(util.Artifact{Kind: util.EmitAsm})
+}
+
 // helperDeleteFiles deletes the files in dstPath directory pointed to by the []os.FileInfo files.
 func helperDeleteFiles(dstp string, files []os.FileInfo, b *testing.B) {
 	b.Helper()
 	for _, e1 := range files {
-		if err := os.Remove(filepath.Join(dstp, strings.Split(e1.Name(), ".")[0]+".s")); err != nil {
+		if err := os.Remove(helperOutput(util.Options{OutDir: dstp}, e1.Name())); err != nil {
 			fmt.Println(err)
 			b.Fail()
 		}